@@ -6,13 +6,11 @@ import (
 	"fmt"
 	"maps"
 	"os"
-	"os/signal"
 	"reflect"
 	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
-	"syscall"
 	"time"
 )
 
@@ -267,6 +265,12 @@ type ReloadableApp interface {
 	RequestReload(ctx context.Context, trigger ReloadTrigger, diff ConfigDiff) error
 }
 
+// ReadinessWaiter is an optional interface for applications that can wait for their
+// modules to become ready.
+type ReadinessWaiter interface {
+	WaitReady(ctx context.Context) error
+}
+
 // MetricsCollector is an optional interface for applications that aggregate module metrics.
 type MetricsCollector interface {
 	CollectAllMetrics(ctx context.Context) []ModuleMetrics
@@ -348,20 +352,36 @@ type StdApplication struct {
 	logger              Logger
 	ctx                 context.Context
 	cancel              context.CancelFunc
-	tenantService       TenantService             // Added tenant service reference
-	verboseConfig       bool                      // Flag for verbose configuration debugging
-	initialized         bool                      // Tracks whether Init has already been successfully executed
-	configFeeders       []Feeder                  // Optional per-application feeders (override global ConfigFeeders if non-nil)
-	startTime           time.Time                 // Tracks when the application was started
-	configLoadedHooks   []func(Application) error // Hooks to run after config loading but before module initialization
-	dependencyHints     []DependencyEdge          // Config-driven dependency edges injected via WithModuleDependency
-	drainTimeout        time.Duration             // Timeout for pre-stop drain phase
-	phase               atomic.Int32              // Current lifecycle phase (AppPhase)
-	parallelInit        bool                      // Enable parallel module initialization at same topo depth
-	initMu              sync.Mutex                // Guards SetCurrentModule/ClearCurrentModule in parallel init
-	dynamicReload       bool                      // Enable dynamic reload orchestrator
-	reloadOrchestrator  *ReloadOrchestrator       // Coordinates config reload across Reloadable modules
-	phaseChangeHook     func(old, new AppPhase)   // Optional hook called on phase transitions (used by ObservableApplication)
+	tenantService       TenantService                    // Added tenant service reference
+	verboseConfig       bool                             // Flag for verbose configuration debugging
+	initialized         bool                             // Tracks whether Init has already been successfully executed
+	configFeeders       []Feeder                         // Optional per-application feeders (nil selects the default feeders)
+	startTime           time.Time                        // Tracks when the application was started
+	configLoadedHooks   []func(Application) error        // Hooks to run after config loading but before module initialization
+	configValidators    []func(Application) error        // Cross-section config validators run before module initialization
+	secretResolvers     []SecretResolver                 // Resolvers for config fields tagged secret:"true"
+	dependencyHints     []DependencyEdge                 // Config-driven dependency edges injected via WithModuleDependency
+	drainTimeout        time.Duration                    // Timeout for pre-stop drain phase
+	startTimeout        time.Duration                    // Bound on the whole Start, zero for none
+	stopTimeout         time.Duration                    // Bound on the whole Stop, zero for none
+	phase               atomic.Int32                     // Current lifecycle phase (AppPhase)
+	parallelInit        bool                             // Enable parallel module initialization at same topo depth
+	configCheckMode     bool                             // Honor ConfigCheckEnv in Init, see WithConfigCheckMode
+	initMu              sync.Mutex                       // Guards SetCurrentModule/ClearCurrentModule in parallel init
+	dynamicReload       bool                             // Enable dynamic reload orchestrator
+	reloadOrchestrator  *ReloadOrchestrator              // Coordinates config reload across Reloadable modules
+	reloadConfig        DynamicReloadConfig              // Dynamic reload options, such as the config files to watch
+	fileReloader        *configFileReloader              // Reloads config when watched files change or on SIGHUP
+	initialConfigs      map[string]any                   // Copies of the registered configs before feeding, seeding reloads
+	phaseChangeHook     func(old, new AppPhase)          // Optional hook called on phase transitions (used by ObservableApplication)
+	observers           map[string]*observerRegistration // Observers of application events, keyed by observer ID
+	observerMutex       sync.RWMutex                     // Guards observers
+	observerPool        observerWorkerPool               // Delivers events to observers asynchronously
+	configProvenance    map[string]FieldProvenance       // Feeder that set each config field during the last config load
+	provenanceMu        sync.RWMutex                     // Guards configProvenance
+
+	serviceDecorators map[string][]ServiceDecorator // Decorators applied to services as they are registered
+	decoratorMu       sync.RWMutex                  // Guards serviceDecorators
 }
 
 // NewStdApplication creates a new application instance with the provided configuration and logger.
@@ -370,6 +390,8 @@ type StdApplication struct {
 // Parameters:
 //   - cp: ConfigProvider for application-level configuration
 //   - logger: Logger implementation for framework and module logging
+//   - feeders: optional per-application configuration feeders (see SetConfigFeeders);
+//     when omitted the default feeders are used
 //
 // The created application will have empty registries that can be populated by
 // registering modules and services. The application must be initialized with
@@ -385,7 +407,8 @@ type StdApplication struct {
 //	logger := &MyLogger{}
 //
 //	// Create application
-//	app := modular.NewStdApplication(configProvider, logger)
+//	app := modular.NewStdApplication(configProvider, logger,
+//	    feeders.NewYamlFeeder("config.yaml"), feeders.NewEnvFeeder())
 //
 //	// Register modules
 //	app.RegisterModule(&DatabaseModule{})
@@ -395,7 +418,7 @@ type StdApplication struct {
 //	if err := app.Run(); err != nil {
 //	    log.Fatal(err)
 //	}
-func NewStdApplication(cp ConfigProvider, logger Logger) Application {
+func NewStdApplication(cp ConfigProvider, logger Logger, feeders ...Feeder) Application {
 	enhancedRegistry := NewEnhancedServiceRegistry()
 
 	app := &StdApplication{
@@ -405,9 +428,12 @@ func NewStdApplication(cp ConfigProvider, logger Logger) Application {
 		svcRegistry:         enhancedRegistry.AsServiceRegistry(), // Backwards compatible view
 		moduleRegistry:      make(ModuleRegistry),
 		logger:              logger,
-		configFeeders:       nil,                                // default to nil to signal use of package-level ConfigFeeders
+		configFeeders:       nil,                                // nil signals use of the default feeders
 		configLoadedHooks:   make([]func(Application) error, 0), // Initialize hooks slice
 	}
+	if len(feeders) > 0 {
+		app.configFeeders = feeders
+	}
 
 	// Register the logger as a service so modules can depend on it
 	if app.enhancedSvcRegistry != nil {
@@ -452,19 +478,25 @@ func (app *StdApplication) GetConfigSection(section string) (ConfigProvider, err
 	return cp, nil
 }
 
-// SetConfigFeeders sets per-application configuration feeders overriding the package-level ConfigFeeders for this app's Init lifecycle.
-// Passing nil resets to use the global ConfigFeeders again.
+// SetConfigFeeders sets per-application configuration feeders for this app's Init lifecycle.
+// This is the preferred way to configure feeders; applications in the same process
+// keep independent feeder sets. Passing nil resets the app to the default feeders
+// (an environment feeder, or the deprecated global ConfigFeeders if it was modified).
 func (app *StdApplication) SetConfigFeeders(feeders []Feeder) {
 	app.configFeeders = feeders
 }
 
 // RegisterService adds a service with type checking
 func (app *StdApplication) RegisterService(name string, service any) error {
+	service, err := app.decorateService(name, service)
+	if err != nil {
+		return err
+	}
+
 	var actualName string
 
 	// Register with enhanced registry if available (handles automatic conflict resolution)
 	if app.enhancedSvcRegistry != nil {
-		var err error
 		actualName, err = app.enhancedSvcRegistry.RegisterService(name, service)
 		if err != nil {
 			return err
@@ -629,7 +661,9 @@ func (app *StdApplication) initModule(appToPass Application, moduleName string)
 	}
 	app.initMu.Unlock()
 
+	moduleInit := time.Now()
 	if err := module.Init(appToPass); err != nil {
+		app.notifyModuleLifecycle(moduleName, "init", time.Since(moduleInit), err)
 		return fmt.Errorf("module '%s' failed to initialize: %w", moduleName, err)
 	}
 
@@ -637,12 +671,16 @@ func (app *StdApplication) initModule(appToPass Application, moduleName string)
 	if sa, ok := module.(ServiceAware); ok {
 		for _, svc := range sa.ProvidesServices() {
 			if app.enhancedSvcRegistry != nil {
-				actualName, err := app.enhancedSvcRegistry.RegisterServiceForModule(svc.Name, svc.Instance, module)
+				instance, err := app.decorateService(svc.Name, svc.Instance)
+				if err != nil {
+					return fmt.Errorf("module '%s' failed to register service '%s': %w", moduleName, svc.Name, err)
+				}
+				actualName, err := app.enhancedSvcRegistry.RegisterServiceForModule(svc.Name, instance, module)
 				if err != nil {
 					return fmt.Errorf("module '%s' failed to register service '%s': %w", moduleName, svc.Name, err)
 				}
 				app.initMu.Lock()
-				app.svcRegistry[actualName] = svc.Instance
+				app.svcRegistry[actualName] = instance
 				app.initMu.Unlock()
 			} else {
 				if err := app.RegisterService(svc.Name, svc.Instance); err != nil {
@@ -653,6 +691,7 @@ func (app *StdApplication) initModule(appToPass Application, moduleName string)
 	}
 
 	app.logger.Info(fmt.Sprintf("Initialized module %s of type %T", moduleName, module))
+	app.notifyModuleLifecycle(moduleName, "init", time.Since(moduleInit), nil)
 	return nil
 }
 
@@ -675,43 +714,14 @@ func (app *StdApplication) InitWithApp(appToPass Application) error {
 
 	app.setPhase(PhaseInitializing)
 
-	errs := make([]error, 0)
-	for name, module := range app.moduleRegistry {
-		configurableModule, ok := module.(Configurable)
-		if !ok {
-			if app.logger != nil {
-				app.logger.Debug("Module does not implement Configurable, skipping", "module", name)
-			}
-			continue
-		}
-		err := configurableModule.RegisterConfig(appToPass)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("module %s failed to register config: %w", name, err))
-			continue
-		}
-		if app.logger != nil {
-			app.logger.Debug("Registering module", "name", name)
-		}
-	}
-
-	// Configuration loading (AppConfigLoader will consult app.configFeeders directly now)
-	if err := AppConfigLoader(app); err != nil {
-		errs = append(errs, fmt.Errorf("failed to load app config: %w", err))
+	// Tools such as "modcli config validate" only need the configuration
+	if checkFile, ok := os.LookupEnv(ConfigCheckEnv); ok && app.configCheckMode {
+		return app.runConfigCheck(appToPass, checkFile)
 	}
 
-	// Execute config loaded hooks after configuration is loaded but before modules initialize
-	if len(app.configLoadedHooks) > 0 {
-		if app.logger != nil {
-			app.logger.Debug("Executing config loaded hooks", "count", len(app.configLoadedHooks))
-		}
-		for i, hook := range app.configLoadedHooks {
-			if err := hook(appToPass); err != nil {
-				errs = append(errs, fmt.Errorf("config loaded hook %d failed: %w", i, err))
-			}
-		}
-		if app.logger != nil {
-			app.logger.Debug("Config loaded hooks executed successfully")
-		}
+	errs, configValid := app.initConfig(appToPass)
+	if !configValid {
+		return errors.Join(errs...)
 	}
 
 	// Build dependency graph
@@ -779,6 +789,7 @@ func (app *StdApplication) InitWithApp(appToPass Application) error {
 				app.reloadOrchestrator.RegisterReloadable(name, reloadable)
 			}
 		}
+		app.fileReloader = newConfigFileReloader(app, app.reloadConfig)
 	}
 
 	// Mark as initialized only after completing Init flow
@@ -838,6 +849,15 @@ func (app *StdApplication) Start() error {
 		return err
 	}
 
+	// Bound the whole startup when a start timeout is configured
+	startCtx := ctx
+	if app.startTimeout > 0 {
+		var startCancel context.CancelFunc
+		startCtx, startCancel = context.WithTimeout(ctx, app.startTimeout)
+		defer startCancel()
+	}
+
+	started := make([]string, 0, len(modules))
 	for _, name := range modules {
 		module := app.moduleRegistry[name]
 		startableModule, ok := module.(Startable)
@@ -846,21 +866,148 @@ func (app *StdApplication) Start() error {
 			continue
 		}
 		app.logger.Info("Starting module", "module", name)
-		if err := startableModule.Start(ctx); err != nil {
+		moduleStart := time.Now()
+		timedOut, err := startModule(ctx, startCtx, startableModule)
+		if timedOut {
+			err = ErrStartTimeout
+		}
+		app.notifyModuleLifecycle(name, "start", time.Since(moduleStart), err)
+		if timedOut {
+			app.logger.Error("Timed out starting module", "module", name, "timeout", app.startTimeout)
+			// The module is still starting, so it is stopped too
+			app.rollbackStart(append(started, name))
+			return fmt.Errorf("%w: module %s still starting after %v", ErrStartTimeout, name, app.startTimeout)
+		}
+		if err != nil {
+			// The failing module may have started some of its resources
+			// before returning the error, so it is stopped too
+			app.rollbackStart(append(started, name))
 			return fmt.Errorf("failed to start module %s: %w", name, err)
 		}
+		started = append(started, name)
 	}
 
 	if app.reloadOrchestrator != nil {
 		app.reloadOrchestrator.Start(ctx)
 	}
+	if app.fileReloader != nil {
+		if err := app.fileReloader.start(ctx); err != nil {
+			app.rollbackStart(started)
+			return fmt.Errorf("failed to watch config files: %w", err)
+		}
+	}
 
 	app.setPhase(PhaseRunning)
 	return nil
 }
 
+// rollbackStart stops the given modules in reverse order after a module
+// failed to start, so a failed Start does not leak running modules. started
+// includes the failed module itself, whose Stop must tolerate a partial start.
+// Modules are stopped as by Stop, within the stop timeout.
+func (app *StdApplication) rollbackStart(started []string) {
+	app.setPhase(PhaseStopping)
+	app.logger.Info("Stopping started modules after failed start", "modules", len(started))
+
+	stopCtx, stopCancel := app.stopTimeoutContext()
+	defer stopCancel()
+	ctx, cancel := app.moduleStopContext(stopCtx)
+	defer cancel()
+
+	// ctx always has a deadline, so a module whose Stop ignores it cannot
+	// block the failed Start. Errors are logged by stopModules, and the start
+	// error is the one returned.
+	stopOrder := slices.Clone(started)
+	slices.Reverse(stopOrder)
+	_ = app.stopModules(ctx, ctx, stopOrder)
+
+	if err := app.observerPool.drain(ctx); err != nil {
+		app.logger.Warn("Timed out delivering events to observers", "error", err)
+	}
+
+	if app.cancel != nil {
+		app.cancel()
+	}
+	app.setPhase(PhaseStopped)
+}
+
+// notifyModuleLifecycle emits the module initialized, started, stopped or
+// failed event after a module's Init, Start or Stop returns, with phase
+// "init", "start" or "stop", how long the call took, and its error. Events are
+// delivered synchronously so that observers see modules in dependency order,
+// and only built when an observer is registered.
+func (app *StdApplication) notifyModuleLifecycle(module, phase string, duration time.Duration, err error) {
+	if !app.hasObservers() {
+		return
+	}
+	metadata := map[string]any{"duration_seconds": duration.Seconds()}
+	var action string
+	switch {
+	case err != nil:
+		metadata["phase"] = phase
+		metadata["error"] = err.Error()
+		action = "failed"
+	case phase == "init":
+		action = "initialized"
+	case phase == "start":
+		action = "started"
+	default:
+		action = "stopped"
+	}
+	evt := NewModuleLifecycleEvent("application", "module", module, "", action, metadata)
+	// Observer failures are logged by NotifyObservers and must not fail the lifecycle
+	_ = app.NotifyObservers(WithSynchronousNotification(context.Background()), evt)
+}
+
+// startModule calls module.Start, giving up once startCtx is done. The module
+// receives a context derived from appCtx that is cancelled if startCtx ends
+// while the module is still starting, so it can abandon slow work. Otherwise
+// the context stays live after Start returns, and modules may tie background
+// work to it.
+func startModule(appCtx, startCtx context.Context, module Startable) (timedOut bool, err error) {
+	moduleCtx, cancel := context.WithCancel(appCtx)
+	stop := context.AfterFunc(startCtx, cancel)
+	timedOut, err = runBounded(startCtx, func() error { return module.Start(moduleCtx) })
+	if !stop() && !timedOut {
+		// startCtx ended just as Start returned, so moduleCtx is already cancelled
+		timedOut = true
+	}
+	return timedOut, err
+}
+
+// runBounded runs fn until it returns or ctx is done, whichever comes first,
+// reporting whether ctx ended the wait. A context without a deadline or
+// cancellation never ends the wait. When ctx ends the wait, fn keeps running in
+// the background.
+func runBounded(ctx context.Context, fn func() error) (timedOut bool, err error) {
+	if ctx.Done() == nil {
+		return false, fn()
+	}
+	done := make(chan error, 1)
+	go func() { done <- fn() }()
+	select {
+	case err := <-done:
+		return false, err
+	case <-ctx.Done():
+		// Prefer the result if fn finished just as ctx ended
+		select {
+		case err := <-done:
+			return false, err
+		default:
+			return true, nil
+		}
+	}
+}
+
+// stopTimeoutGrace is how long each module still to be stopped may take once
+// the stop timeout has passed
+const stopTimeoutGrace = 500 * time.Millisecond
+
 // Stop stops the application
 func (app *StdApplication) Stop() error {
+	if app.fileReloader != nil {
+		app.fileReloader.stop()
+	}
 	if app.reloadOrchestrator != nil {
 		app.reloadOrchestrator.Stop()
 	}
@@ -876,19 +1023,25 @@ func (app *StdApplication) Stop() error {
 	// Reverse the slice
 	slices.Reverse(modules)
 
+	stopCtx, stopCancel := app.stopTimeoutContext()
+	defer stopCancel()
+
 	// Phase 1: Drain
 	drainTimeout := app.drainTimeout
 	if drainTimeout <= 0 {
 		drainTimeout = defaultDrainTimeout
 	}
-	drainCtx, drainCancel := context.WithTimeout(context.Background(), drainTimeout)
+	drainCtx, drainCancel := context.WithTimeout(stopCtx, drainTimeout)
 	defer drainCancel()
 
 	for _, name := range modules {
 		module := app.moduleRegistry[name]
 		if drainable, ok := module.(Drainable); ok {
 			app.logger.Info("Draining module", "module", name)
-			if err := drainable.PreStop(drainCtx); err != nil {
+			timedOut, err := runBounded(stopCtx, func() error { return drainable.PreStop(drainCtx) })
+			if timedOut {
+				app.logger.Error("Timed out draining module", "module", name, "timeout", app.stopTimeout)
+			} else if err != nil {
 				app.logger.Error("Error draining module", "module", name, "error", err)
 			}
 		}
@@ -897,11 +1050,48 @@ func (app *StdApplication) Stop() error {
 	app.setPhase(PhaseStopping)
 
 	// Phase 2: Stop
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := app.moduleStopContext(stopCtx)
 	defer cancel()
+	errs := app.stopModules(stopCtx, ctx, modules)
+
+	// Let observers receive the events emitted while stopping
+	if err := app.observerPool.drain(ctx); err != nil {
+		app.logger.Warn("Timed out delivering events to observers", "error", err)
+	}
+
+	// Cancel the main application context
+	if app.cancel != nil {
+		app.cancel()
+	}
+
+	app.setPhase(PhaseStopped)
+	return errors.Join(errs...)
+}
+
+// stopTimeoutContext bounds the whole shutdown when a stop timeout is
+// configured.
+func (app *StdApplication) stopTimeoutContext() (context.Context, context.CancelFunc) {
+	if app.stopTimeout > 0 {
+		return context.WithTimeout(context.Background(), app.stopTimeout)
+	}
+	return context.Background(), func() {}
+}
+
+// moduleStopContext returns the context passed to module Stop calls: stopCtx
+// when a stop timeout is configured, otherwise one giving them 30 seconds.
+func (app *StdApplication) moduleStopContext(stopCtx context.Context) (context.Context, context.CancelFunc) {
+	if app.stopTimeout > 0 {
+		return stopCtx, func() {}
+	}
+	return context.WithTimeout(stopCtx, 30*time.Second)
+}
 
-	// Stop modules in reverse order
-	var lastErr error
+// stopModules stops modules in the given order, emitting a lifecycle event for
+// each, and returns their errors. A module that times out does not keep the
+// rest from being stopped: once stopCtx is done, each remaining module gets
+// stopTimeoutGrace to return.
+func (app *StdApplication) stopModules(stopCtx, ctx context.Context, modules []string) []error {
+	var errs []error
 	for _, name := range modules {
 		module := app.moduleRegistry[name]
 		stoppableModule, ok := module.(Stoppable)
@@ -909,19 +1099,28 @@ func (app *StdApplication) Stop() error {
 			continue
 		}
 		app.logger.Info("Stopping module", "module", name)
-		if err = stoppableModule.Stop(ctx); err != nil {
+		moduleStop := time.Now()
+		boundCtx, boundCancel := stopCtx, context.CancelFunc(func() {})
+		if stopCtx.Err() != nil {
+			boundCtx, boundCancel = context.WithTimeout(context.Background(), stopTimeoutGrace)
+		}
+		timedOut, err := runBounded(boundCtx, func() error { return stoppableModule.Stop(ctx) })
+		boundCancel()
+		if timedOut {
+			err = ErrStopTimeout
+		}
+		app.notifyModuleLifecycle(name, "stop", time.Since(moduleStop), err)
+		if timedOut {
+			app.logger.Error("Timed out stopping module", "module", name, "timeout", app.stopTimeout)
+			errs = append(errs, fmt.Errorf("%w: module %s still stopping after %v", ErrStopTimeout, name, app.stopTimeout))
+			continue
+		}
+		if err != nil {
 			app.logger.Error("Error stopping module", "module", name, "error", err)
-			lastErr = err
+			errs = append(errs, err)
 		}
 	}
-
-	// Cancel the main application context
-	if app.cancel != nil {
-		app.cancel()
-	}
-
-	app.setPhase(PhaseStopped)
-	return lastErr
+	return errs
 }
 
 // RequestReload enqueues a configuration reload request with the ReloadOrchestrator.
@@ -933,7 +1132,9 @@ func (app *StdApplication) RequestReload(ctx context.Context, trigger ReloadTrig
 	return app.reloadOrchestrator.RequestReload(ctx, trigger, diff)
 }
 
-// Run starts the application and blocks until termination
+// Run starts the application and blocks until termination. SIGINT and
+// SIGTERM stop the application, and SIGHUP reloads its configuration when
+// dynamic reload is enabled (see InstallSignalHandlers).
 func (app *StdApplication) Run() error {
 	// Initialize
 	if err := app.Init(); err != nil {
@@ -945,16 +1146,11 @@ func (app *StdApplication) Run() error {
 		return err
 	}
 
-	// Setup signal handling
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-
-	// Wait for termination signal
-	sig := <-sigChan
-	app.logger.Info("Received signal, shutting down", "signal", sig)
-
-	// Stop all modules
-	return app.Stop()
+	// Stop all modules on a termination signal
+	stopped := make(chan error, 1)
+	cancel := InstallSignalHandlers(app, SignalOptions{OnStop: func(err error) { stopped <- err }})
+	defer cancel()
+	return <-stopped
 }
 
 // injectServices injects required services into a module
@@ -1863,6 +2059,143 @@ func (app *StdApplication) CollectAllMetrics(ctx context.Context) []ModuleMetric
 	return results
 }
 
+// readinessPollInterval is how often WaitReady re-checks modules that are not ready.
+const readinessPollInterval = 50 * time.Millisecond
+
+// WaitReady blocks until every module implementing ReadinessProvider reports
+// ready, except those whose readiness is optional (see OptionalReadiness). If
+// ctx is done first, it returns ErrApplicationNotReady naming the required
+// modules that are still not ready and their reasons.
+func (app *StdApplication) WaitReady(ctx context.Context) error {
+	// Snapshot module registry under lock to avoid races with parallel init.
+	app.initMu.Lock()
+	providers := make(map[string]ReadinessProvider)
+	for name, module := range app.moduleRegistry {
+		if rp, ok := module.(ReadinessProvider); ok && isReadinessRequired(rp) {
+			providers[name] = rp
+		}
+	}
+	app.initMu.Unlock()
+
+	ticker := time.NewTicker(readinessPollInterval)
+	defer ticker.Stop()
+	for {
+		notReady := checkReadiness(ctx, providers)
+		if len(notReady) == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			reasons := make([]string, 0, len(notReady))
+			for _, name := range slices.Sorted(maps.Keys(notReady)) {
+				reasons = append(reasons, fmt.Sprintf("%s (%s)", name, notReady[name]))
+			}
+			return fmt.Errorf("%w: %s: %w", ErrApplicationNotReady, strings.Join(reasons, ", "), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// AddConfigValidator registers an application-level config validator for
+// constraints spanning several config sections, e.g. "if cache is enabled
+// then the redis URL is required". Validators run during Init after all
+// sections are loaded and the OnConfigLoaded hooks have run, but before any
+// module is initialized. Failures of all validators are aggregated into a
+// single error wrapping ErrConfigValidationFailed, and Init stops before
+// initializing modules.
+func (app *StdApplication) AddConfigValidator(validator func(Application) error) {
+	if validator != nil {
+		app.configValidators = append(app.configValidators, validator)
+	}
+}
+
+// AddSecretResolver registers a resolver for the references held by config
+// fields tagged `secret:"true"`, such as ${vault:secret/db-password}. Custom
+// resolvers are consulted before the built-in env and file resolvers.
+func (app *StdApplication) AddSecretResolver(resolver SecretResolver) {
+	if resolver != nil {
+		app.secretResolvers = append(app.secretResolvers, resolver)
+	}
+}
+
+// initConfig registers the config sections of all modules, then loads and
+// validates the configuration. It returns the errors found and whether the
+// configuration passed validation; modules must not be initialized if not.
+func (app *StdApplication) initConfig(appToPass Application) ([]error, bool) {
+	errs := make([]error, 0)
+
+	// Drop modules disabled by config before they register config or initialize.
+	// Feeder errors are reported by the config loading below.
+	if disabled, err := app.disabledModules(); err != nil {
+		app.logger.Warn("Failed to read module toggles, keeping all modules enabled", "error", err)
+	} else {
+		for _, name := range disabled {
+			app.logger.Info("Module disabled by config, skipping", "module", name)
+			delete(app.moduleRegistry, name)
+		}
+	}
+
+	for name, module := range app.moduleRegistry {
+		configurableModule, ok := module.(Configurable)
+		if !ok {
+			if app.logger != nil {
+				app.logger.Debug("Module does not implement Configurable, skipping", "module", name)
+			}
+			continue
+		}
+		err := configurableModule.RegisterConfig(appToPass)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("module %s failed to register config: %w", name, err))
+			continue
+		}
+		if app.logger != nil {
+			app.logger.Debug("Registering module", "name", name)
+		}
+	}
+
+	// Configuration loading (AppConfigLoader will consult app.configFeeders directly now)
+	if err := AppConfigLoader(app); err != nil {
+		errs = append(errs, fmt.Errorf("failed to load app config: %w", err))
+	}
+
+	// Execute config loaded hooks after configuration is loaded but before modules initialize
+	if len(app.configLoadedHooks) > 0 {
+		if app.logger != nil {
+			app.logger.Debug("Executing config loaded hooks", "count", len(app.configLoadedHooks))
+		}
+		for i, hook := range app.configLoadedHooks {
+			if err := hook(appToPass); err != nil {
+				errs = append(errs, fmt.Errorf("config loaded hook %d failed: %w", i, err))
+			}
+		}
+		if app.logger != nil {
+			app.logger.Debug("Config loaded hooks executed successfully")
+		}
+	}
+
+	// Invalid configuration must not reach module Init
+	if err := app.runConfigValidators(appToPass); err != nil {
+		errs = append(errs, err)
+		return errs, false
+	}
+
+	return errs, true
+}
+
+// runConfigValidators runs all config validators and aggregates their failures
+func (app *StdApplication) runConfigValidators(appToPass Application) error {
+	var errs []error
+	for i, validator := range app.configValidators {
+		if err := validator(appToPass); err != nil {
+			errs = append(errs, fmt.Errorf("config validator %d: %w", i, err))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %w", ErrConfigValidationFailed, errors.Join(errs...))
+}
+
 // OnConfigLoaded registers a callback to run after config loading but before module initialization.
 // This allows reconfiguring dependencies based on loaded configuration values.
 // Multiple hooks can be registered and will be executed in registration order.
@@ -1,6 +1,7 @@
 package modular
 
 import (
+	"errors"
 	"log/slog"
 	"testing"
 )
@@ -83,6 +84,44 @@ func Test_ApplicationLifecycle(t *testing.T) {
 		}
 	})
 
+	// Test that modules started before a failure are stopped again
+	t.Run("Start failure stops started modules", func(t *testing.T) {
+		app := &StdApplication{
+			cfgProvider:    NewStdConfigProvider(testCfg{Str: "test"}),
+			cfgSections:    make(map[string]ConfigProvider),
+			svcRegistry:    make(ServiceRegistry),
+			moduleRegistry: make(ModuleRegistry),
+			logger:         &logger{t},
+		}
+
+		startedModule := &lifecycleTestModule{testModule: testModule{name: "started"}}
+		failingModule := &lifecycleTestModule{
+			testModule: testModule{name: "failing", dependencies: []string{"started"}},
+			startError: ErrModuleStartFailed,
+		}
+		notStartedModule := &lifecycleTestModule{testModule: testModule{name: "not-started", dependencies: []string{"failing"}}}
+
+		app.RegisterModule(startedModule)
+		app.RegisterModule(failingModule)
+		app.RegisterModule(notStartedModule)
+
+		if err := app.Start(); !errors.Is(err, ErrModuleStartFailed) {
+			t.Fatalf("Start() error = %v, expected %v", err, ErrModuleStartFailed)
+		}
+		if !startedModule.stopCalled {
+			t.Error("Start() did not stop the module started before the failure")
+		}
+		if !failingModule.stopCalled {
+			t.Error("Start() did not stop the module whose Start failed")
+		}
+		if notStartedModule.stopCalled {
+			t.Error("Start() stopped a module that never started")
+		}
+		if app.Phase() != PhaseStopped {
+			t.Errorf("expected PhaseStopped after failed Start, got %v", app.Phase())
+		}
+	})
+
 	// Test Stop with error
 	t.Run("Stop with error", func(t *testing.T) {
 		app := &StdApplication{
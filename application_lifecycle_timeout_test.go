@@ -0,0 +1,239 @@
+package modular
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// blockingLifecycleModule blocks in Start and Stop for the configured delays,
+// ignoring context cancellation.
+type blockingLifecycleModule struct {
+	name       string
+	deps       []string
+	startDelay time.Duration
+	stopDelay  time.Duration
+	started    atomic.Bool
+	stopped    atomic.Bool
+}
+
+func (m *blockingLifecycleModule) Name() string             { return m.name }
+func (m *blockingLifecycleModule) Init(_ Application) error { return nil }
+func (m *blockingLifecycleModule) Dependencies() []string   { return m.deps }
+
+func (m *blockingLifecycleModule) Start(_ context.Context) error {
+	time.Sleep(m.startDelay)
+	m.started.Store(true)
+	return nil
+}
+
+func (m *blockingLifecycleModule) Stop(_ context.Context) error {
+	time.Sleep(m.stopDelay)
+	m.stopped.Store(true)
+	return nil
+}
+
+func TestApplication_StartTimeout(t *testing.T) {
+	fast := &blockingLifecycleModule{name: "fast"}
+	slow := &blockingLifecycleModule{name: "slow", deps: []string{"fast"}, startDelay: 2 * time.Second}
+
+	app, err := NewApplication(
+		WithLogger(&reloadTestLogger{}),
+		WithModules(fast, slow),
+		WithStartTimeout(100*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("NewApplication failed: %v", err)
+	}
+	if err := app.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	start := time.Now()
+	err = app.Start()
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected Start to return after the timeout, took %v", elapsed)
+	}
+	if !errors.Is(err, ErrStartTimeout) {
+		t.Fatalf("expected ErrStartTimeout, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "module slow") {
+		t.Errorf("expected the error to name the module still starting, got %v", err)
+	}
+	if !fast.stopped.Load() {
+		t.Error("expected the started module to be stopped after the start timeout")
+	}
+	if !slow.stopped.Load() {
+		t.Error("expected the module still starting to be stopped after the start timeout")
+	}
+	if phase := app.(PhaseAware).Phase(); phase != PhaseStopped {
+		t.Errorf("expected phase %v after a start timeout, got %v", PhaseStopped, phase)
+	}
+}
+
+func TestApplication_StopTimeout(t *testing.T) {
+	first := &blockingLifecycleModule{name: "first"}
+	blocking := &blockingLifecycleModule{name: "blocking", deps: []string{"first"}, stopDelay: 2 * time.Second}
+
+	app, err := NewApplication(
+		WithLogger(&reloadTestLogger{}),
+		WithModules(first, blocking),
+		WithStartTimeout(time.Second),
+		WithStopTimeout(100*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("NewApplication failed: %v", err)
+	}
+	if err := app.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	if err := app.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	start := time.Now()
+	err = app.Stop()
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected Stop to return after the timeout, took %v", elapsed)
+	}
+	if !errors.Is(err, ErrStopTimeout) {
+		t.Fatalf("expected ErrStopTimeout, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "module blocking") {
+		t.Errorf("expected the error to name the module still stopping, got %v", err)
+	}
+	if !first.stopped.Load() {
+		t.Error("expected the modules after the one that timed out to still be stopped")
+	}
+}
+
+func TestApplication_StartTimeoutRollbackIsBounded(t *testing.T) {
+	// first ignores its Stop context, so only the stop timeout bounds the rollback
+	first := &blockingLifecycleModule{name: "first", stopDelay: 5 * time.Second}
+	slow := &blockingLifecycleModule{name: "slow", deps: []string{"first"}, startDelay: 2 * time.Second}
+
+	app, err := NewApplication(
+		WithLogger(&reloadTestLogger{}),
+		WithModules(first, slow),
+		WithStartTimeout(100*time.Millisecond),
+		WithStopTimeout(100*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("NewApplication failed: %v", err)
+	}
+
+	var (
+		mu     sync.Mutex
+		events []string
+	)
+	observer := NewFunctionalObserver("rollback-observer", func(ctx context.Context, event cloudevents.Event) error {
+		var payload ModuleLifecyclePayload
+		if err := event.DataAs(&payload); err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event.Type()+" "+payload.Name)
+		return nil
+	})
+	if err := app.(Subject).RegisterObserver(observer, EventTypeModuleStopped, EventTypeModuleFailed); err != nil {
+		t.Fatalf("RegisterObserver failed: %v", err)
+	}
+	if err := app.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	start := time.Now()
+	if err := app.Start(); !errors.Is(err, ErrStartTimeout) {
+		t.Fatalf("expected ErrStartTimeout, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 1500*time.Millisecond {
+		t.Errorf("expected the rollback to be bounded by the stop timeout, Start took %v", elapsed)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{
+		EventTypeModuleFailed + " slow", // Start timed out
+		EventTypeModuleStopped + " slow",
+		EventTypeModuleFailed + " first", // Stop timed out
+	}
+	if !slices.Equal(events, want) {
+		t.Errorf("expected rollback lifecycle events %v, got %v", want, events)
+	}
+}
+
+// contextStartModule records the context its Start received
+type contextStartModule struct {
+	name       string
+	startDelay time.Duration
+	ctx        atomic.Value
+}
+
+func (m *contextStartModule) Name() string             { return m.name }
+func (m *contextStartModule) Init(_ Application) error { return nil }
+
+func (m *contextStartModule) Start(ctx context.Context) error {
+	m.ctx.Store(ctx)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(m.startDelay):
+		return nil
+	}
+}
+
+func (m *contextStartModule) startContext() context.Context {
+	ctx, _ := m.ctx.Load().(context.Context)
+	return ctx
+}
+
+func TestApplication_StartTimeoutContext(t *testing.T) {
+	t.Run("cancelled when the timeout expires", func(t *testing.T) {
+		slow := &contextStartModule{name: "slow", startDelay: 2 * time.Second}
+		app, err := NewApplication(WithLogger(&reloadTestLogger{}), WithModules(slow), WithStartTimeout(50*time.Millisecond))
+		if err != nil {
+			t.Fatalf("NewApplication failed: %v", err)
+		}
+		if err := app.Init(); err != nil {
+			t.Fatalf("Init failed: %v", err)
+		}
+		if err := app.Start(); !errors.Is(err, ErrStartTimeout) {
+			t.Fatalf("expected ErrStartTimeout, got %v", err)
+		}
+		if ctx := slow.startContext(); ctx == nil || ctx.Err() == nil {
+			t.Error("expected the context passed to Start to be cancelled by the start timeout")
+		}
+	})
+
+	t.Run("stays live after a successful start", func(t *testing.T) {
+		fast := &contextStartModule{name: "fast"}
+		app, err := NewApplication(WithLogger(&reloadTestLogger{}), WithModules(fast), WithStartTimeout(50*time.Millisecond))
+		if err != nil {
+			t.Fatalf("NewApplication failed: %v", err)
+		}
+		if err := app.Init(); err != nil {
+			t.Fatalf("Init failed: %v", err)
+		}
+		if err := app.Start(); err != nil {
+			t.Fatalf("Start failed: %v", err)
+		}
+		time.Sleep(100 * time.Millisecond)
+		if err := fast.startContext().Err(); err != nil {
+			t.Errorf("expected the start context to outlive the start timeout, got %v", err)
+		}
+		if err := app.Stop(); err != nil {
+			t.Fatalf("Stop failed: %v", err)
+		}
+		if fast.startContext().Err() == nil {
+			t.Error("expected the start context to be cancelled when the application stops")
+		}
+	})
+}
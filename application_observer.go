@@ -2,7 +2,8 @@ package modular
 
 import (
 	"context"
-	"sync"
+	"errors"
+	"fmt"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -11,27 +12,28 @@ import (
 // observerRegistration holds information about a registered observer
 type observerRegistration struct {
 	observer     Observer
-	eventTypes   map[string]bool // set of event types this observer is interested in
+	eventTypes   map[string]bool // set of event types and patterns this observer is interested in
+	filter       eventTypeFilter
 	registeredAt time.Time
 }
 
 // ObservableApplication extends StdApplication with observer pattern capabilities.
-// This struct embeds StdApplication and adds observer management functionality.
+// On top of the module lifecycle events emitted by StdApplication, it emits
+// events for module and service registration, configuration and the
+// application lifecycle, and registers the observers of ObservableModules.
 // It uses CloudEvents specification for standardized event handling and interoperability.
 type ObservableApplication struct {
 	*StdApplication
-	observers     map[string]*observerRegistration // key is observer ID
-	observerMutex sync.RWMutex
 }
 
 // NewObservableApplication creates a new application instance with observer pattern support.
 // This wraps the standard application with observer capabilities while maintaining
-// all existing functionality.
-func NewObservableApplication(cp ConfigProvider, logger Logger) *ObservableApplication {
-	stdApp := NewStdApplication(cp, logger).(*StdApplication)
+// all existing functionality. Optional feeders are applied as per-application
+// configuration feeders, as with NewStdApplication.
+func NewObservableApplication(cp ConfigProvider, logger Logger, feeders ...Feeder) *ObservableApplication {
+	stdApp := NewStdApplication(cp, logger, feeders...).(*StdApplication)
 	obsApp := &ObservableApplication{
 		StdApplication: stdApp,
-		observers:      make(map[string]*observerRegistration),
 	}
 	// Wire phase change hook to emit CloudEvents.
 	stdApp.phaseChangeHook = func(old, new AppPhase) {
@@ -45,13 +47,22 @@ func NewObservableApplication(cp ConfigProvider, logger Logger) *ObservableAppli
 }
 
 // RegisterObserver adds an observer to receive notifications from the application.
-// Observers can optionally filter events by type using the eventTypes parameter.
-// If eventTypes is empty, the observer receives all events.
-func (app *ObservableApplication) RegisterObserver(observer Observer, eventTypes ...string) error {
+// Observers can optionally filter events by type using the eventTypes parameter,
+// which holds event types or glob patterns such as "com.modular.module.*" (see
+// path.Match, where "*" also matches dots). If eventTypes is empty, the
+// observer receives all events. Malformed patterns return ErrInvalidEventTypePattern.
+func (app *StdApplication) RegisterObserver(observer Observer, eventTypes ...string) error {
+	filter, err := newEventTypeFilter(eventTypes)
+	if err != nil {
+		return err
+	}
+
 	app.observerMutex.Lock()
 	defer app.observerMutex.Unlock()
+	if app.observers == nil {
+		app.observers = make(map[string]*observerRegistration)
+	}
 
-	// Convert event types slice to map for O(1) lookups
 	eventTypeMap := make(map[string]bool)
 	for _, eventType := range eventTypes {
 		eventTypeMap[eventType] = true
@@ -60,6 +71,7 @@ func (app *ObservableApplication) RegisterObserver(observer Observer, eventTypes
 	app.observers[observer.ObserverID()] = &observerRegistration{
 		observer:     observer,
 		eventTypes:   eventTypeMap,
+		filter:       filter,
 		registeredAt: time.Now(),
 	}
 
@@ -69,7 +81,7 @@ func (app *ObservableApplication) RegisterObserver(observer Observer, eventTypes
 
 // UnregisterObserver removes an observer from receiving notifications.
 // This method is idempotent and won't error if the observer wasn't registered.
-func (app *ObservableApplication) UnregisterObserver(observer Observer) error {
+func (app *StdApplication) UnregisterObserver(observer Observer) error {
 	app.observerMutex.Lock()
 	defer app.observerMutex.Unlock()
 
@@ -83,10 +95,20 @@ func (app *ObservableApplication) UnregisterObserver(observer Observer) error {
 
 // NotifyObservers sends a CloudEvent to all registered observers.
 // The notification process is non-blocking for the caller and handles observer errors gracefully.
-func (app *ObservableApplication) NotifyObservers(ctx context.Context, event cloudevents.Event) error {
-	app.observerMutex.RLock()
-	defer app.observerMutex.RUnlock()
-
+//
+// Observers declaring DeliverySync (see DeliveryModeObserver) receive the
+// event inline, in the order events are notified. Observers declaring
+// DeliveryAsync receive it on a bounded worker pool, which Stop drains. Other
+// observers receive it inline when the context requests synchronous delivery
+// (see WithSynchronousNotification), and on the worker pool otherwise.
+//
+// Each observer is isolated from the others: a panic is recovered and an
+// error does not stop delivery to the remaining observers. Observers
+// implementing RetryableObserver, such as those wrapped with
+// WithObserverRetry, are retried on error as their policy allows. The errors
+// and panics of the observers receiving the event inline are returned joined;
+// those of asynchronous deliveries are only logged.
+func (app *StdApplication) NotifyObservers(ctx context.Context, event cloudevents.Event) error {
 	// Ensure timestamp is set
 	if event.Time().IsZero() {
 		event.SetTime(time.Now())
@@ -98,56 +120,95 @@ func (app *ObservableApplication) NotifyObservers(ctx context.Context, event clo
 		return err
 	}
 
-	// If the context requests synchronous delivery, invoke observers directly.
-	// Otherwise, notify observers in goroutines to avoid blocking.
-	synchronous := IsSynchronousNotification(ctx)
+	// Snapshot the interested observers so that slow or retried deliveries
+	// don't hold the lock
+	app.observerMutex.RLock()
+	observers := make([]Observer, 0, len(app.observers))
 	for _, registration := range app.observers {
-
-		// Check if observer is interested in this event type
-		if len(registration.eventTypes) > 0 && !registration.eventTypes[event.Type()] {
+		if !registration.filter.matches(event.Type()) {
 			continue // observer not interested in this event type
 		}
+		observers = append(observers, registration.observer)
+	}
+	app.observerMutex.RUnlock()
 
-		notify := func() {
-			defer func() {
-				if r := recover(); r != nil {
-					app.logger.Error("Observer panicked", "observerID", registration.observer.ObserverID(), "event", event.Type(), "panic", r)
-				}
-			}()
-
-			if err := registration.observer.OnEvent(ctx, event); err != nil {
-				app.logger.Error("Observer error", "observerID", registration.observer.ObserverID(), "event", event.Type(), "error", err)
+	synchronous := IsSynchronousNotification(ctx)
+	var errs []error
+	for _, observer := range observers {
+		mode := observerDeliveryMode(observer)
+		if mode == DeliverySync || (mode == DeliveryDefault && synchronous) {
+			if err := app.deliverEvent(ctx, observer, event); err != nil {
+				errs = append(errs, err)
 			}
+			continue
 		}
+		// Asynchronous deliveries outlive the notifier's context
+		asyncCtx := context.WithoutCancel(ctx)
+		app.observerPool.submit(func() { _ = app.deliverEvent(asyncCtx, observer, event) })
+	}
+	return errors.Join(errs...)
+}
 
-		if synchronous {
-			notify()
-		} else {
-			go notify()
-		}
+// deliverEvent passes the event to the observer, retrying as its retry policy
+// allows, and logs the final failure
+func (app *StdApplication) deliverEvent(ctx context.Context, observer Observer, event cloudevents.Event) error {
+	var policy ObserverRetryPolicy
+	if retryable, ok := observer.(RetryableObserver); ok {
+		policy = retryable.RetryPolicy()
 	}
 
-	return nil
-}
+	for attempt := 1; ; attempt++ {
+		err := callObserver(ctx, observer, event)
+		if err == nil {
+			return nil
+		}
+		if errors.Is(err, ErrObserverPanicked) {
+			app.logger.Error("Observer panicked", "observerID", observer.ObserverID(), "event", event.Type(), "error", err)
+			return fmt.Errorf("observer %s: %w", observer.ObserverID(), err)
+		}
 
-// emitEvent is a helper method to emit CloudEvents with proper source information
-func (app *ObservableApplication) emitEvent(ctx context.Context, event cloudevents.Event) {
-	// Use a separate goroutine to avoid blocking application operations
-	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				app.logger.Error("panic recovered in application event emission", "error", r)
+		delay, retry := policy.next(attempt, err)
+		if retry {
+			app.logger.Debug("Retrying observer", "observerID", observer.ObserverID(), "event", event.Type(),
+				"attempt", attempt, "error", err)
+			select {
+			case <-time.After(delay):
+				continue
+			case <-ctx.Done():
 			}
-		}()
-		if err := app.NotifyObservers(ctx, event); err != nil {
-			app.logger.Error("Failed to notify observers", "event", event.Type(), "error", err)
+		}
+		app.logger.Error("Observer error", "observerID", observer.ObserverID(), "event", event.Type(), "error", err)
+		return fmt.Errorf("observer %s: %w", observer.ObserverID(), err)
+	}
+}
+
+// callObserver calls the observer, turning a panic into an ErrObserverPanicked error
+func callObserver(ctx context.Context, observer Observer, event cloudevents.Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrObserverPanicked, r)
 		}
 	}()
+	return observer.OnEvent(ctx, event)
+}
+
+// emitEvent notifies the observers of an application event. Only observers
+// receiving it synchronously block the caller, so that they see application
+// events in order; their failures are logged by NotifyObservers.
+func (app *ObservableApplication) emitEvent(ctx context.Context, event cloudevents.Event) {
+	_ = app.NotifyObservers(ctx, event)
+}
+
+// hasObservers reports whether any observer is registered
+func (app *StdApplication) hasObservers() bool {
+	app.observerMutex.RLock()
+	defer app.observerMutex.RUnlock()
+	return len(app.observers) > 0
 }
 
 // GetObservers returns information about currently registered observers.
 // This is useful for debugging and monitoring.
-func (app *ObservableApplication) GetObservers() []ObserverInfo {
+func (app *StdApplication) GetObservers() []ObserverInfo {
 	app.observerMutex.RLock()
 	defer app.observerMutex.RUnlock()
 
@@ -281,6 +342,13 @@ func (app *ObservableApplication) Stop() error {
 	stoppedEvt := NewModuleLifecycleEvent("application", "application", "", "", "stopped", nil)
 	app.emitEvent(ctx, stoppedEvt)
 
+	// Deliver the stopped event to asynchronous observers before returning
+	drainCtx, cancel := context.WithTimeout(ctx, observerDrainTimeout)
+	defer cancel()
+	if err := app.observerPool.drain(drainCtx); err != nil {
+		app.logger.Warn("Timed out delivering events to observers", "error", err)
+	}
+
 	return nil
 }
 
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var errObserver = errors.New("observer error")
@@ -365,3 +367,296 @@ func (l *TestObserverLogger) Warn(msg string, args ...any) {
 }
 
 type TestObserverStorage struct{}
+
+// lifecycleObserverModule is a startable and stoppable module for lifecycle event tests
+type lifecycleObserverModule struct {
+	TestObserverModule
+	stopErr error
+}
+
+func (m *lifecycleObserverModule) Start(context.Context) error { return nil }
+func (m *lifecycleObserverModule) Stop(context.Context) error  { return m.stopErr }
+
+func TestObservableApplication_ModuleStartStopEvents(t *testing.T) {
+	t.Parallel()
+	app := NewObservableApplication(NewStdConfigProvider(&struct{}{}), &TestObserverLogger{})
+
+	var mu sync.Mutex
+	payloads := make(map[string]ModuleLifecyclePayload)
+	observer := NewFunctionalObserver("lifecycle-observer", func(ctx context.Context, event cloudevents.Event) error {
+		var payload ModuleLifecyclePayload
+		if err := event.DataAs(&payload); err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		payloads[event.Type()] = payload
+		return nil
+	})
+	require.NoError(t, app.RegisterObserver(observer, EventTypeModuleStarted, EventTypeModuleStopped, EventTypeModuleFailed))
+
+	app.RegisterModule(&lifecycleObserverModule{
+		TestObserverModule: TestObserverModule{name: "worker"},
+		stopErr:            errors.New("flush failed"),
+	})
+	require.NoError(t, app.Init())
+	require.NoError(t, app.Start())
+	require.Error(t, app.Stop())
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(payloads) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	started := payloads[EventTypeModuleStarted]
+	assert.Equal(t, "worker", started.Name)
+	assert.Contains(t, started.Metadata, "duration_seconds")
+
+	failed := payloads[EventTypeModuleFailed]
+	assert.Equal(t, "worker", failed.Name)
+	assert.Equal(t, "stop", failed.Metadata["phase"])
+	assert.Equal(t, "flush failed", failed.Metadata["error"])
+	assert.Contains(t, failed.Metadata, "duration_seconds")
+}
+
+// dependentObserverModule is a lifecycle module depending on other modules
+type dependentObserverModule struct {
+	lifecycleObserverModule
+	deps    []string
+	initErr error
+}
+
+func (m *dependentObserverModule) Dependencies() []string { return m.deps }
+func (m *dependentObserverModule) Init(Application) error { return m.initErr }
+
+func TestStdApplication_ModuleLifecycleEvents(t *testing.T) {
+	t.Parallel()
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &TestObserverLogger{}).(*StdApplication)
+
+	var (
+		mu     sync.Mutex
+		events []string
+	)
+	observer := NewFunctionalObserver("lifecycle-observer", func(ctx context.Context, event cloudevents.Event) error {
+		var payload ModuleLifecyclePayload
+		if err := event.DataAs(&payload); err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event.Type()+" "+payload.Name)
+		return nil
+	})
+	require.NoError(t, app.RegisterObserver(observer))
+
+	// api depends on cache, which depends on db
+	app.RegisterModule(&dependentObserverModule{
+		lifecycleObserverModule: lifecycleObserverModule{TestObserverModule: TestObserverModule{name: "api"}},
+		deps:                    []string{"cache"},
+	})
+	app.RegisterModule(&dependentObserverModule{
+		lifecycleObserverModule: lifecycleObserverModule{TestObserverModule: TestObserverModule{name: "db"}},
+	})
+	app.RegisterModule(&dependentObserverModule{
+		lifecycleObserverModule: lifecycleObserverModule{TestObserverModule: TestObserverModule{name: "cache"}},
+		deps:                    []string{"db"},
+	})
+	require.NoError(t, app.Init())
+	require.NoError(t, app.Start())
+	require.NoError(t, app.Stop())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{
+		EventTypeModuleInitialized + " db",
+		EventTypeModuleInitialized + " cache",
+		EventTypeModuleInitialized + " api",
+		EventTypeModuleStarted + " db",
+		EventTypeModuleStarted + " cache",
+		EventTypeModuleStarted + " api",
+		EventTypeModuleStopped + " api",
+		EventTypeModuleStopped + " cache",
+		EventTypeModuleStopped + " db",
+	}, events)
+}
+
+func TestStdApplication_ModuleInitFailedEvent(t *testing.T) {
+	t.Parallel()
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &TestObserverLogger{})
+
+	var (
+		mu     sync.Mutex
+		failed []ModuleLifecyclePayload
+	)
+	subject, ok := app.(Subject)
+	require.True(t, ok, "StdApplication should be a Subject")
+	require.NoError(t, subject.RegisterObserver(NewFunctionalObserver("failure-observer",
+		func(ctx context.Context, event cloudevents.Event) error {
+			var payload ModuleLifecyclePayload
+			if err := event.DataAs(&payload); err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			failed = append(failed, payload)
+			return nil
+		}), EventTypeModuleFailed))
+
+	app.RegisterModule(&dependentObserverModule{
+		lifecycleObserverModule: lifecycleObserverModule{TestObserverModule: TestObserverModule{name: "db"}},
+		initErr:                 errors.New("connection refused"),
+	})
+	require.Error(t, app.Init())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "db", failed[0].Name)
+	assert.Equal(t, "init", failed[0].Metadata["phase"])
+	assert.Equal(t, "connection refused", failed[0].Metadata["error"])
+}
+
+func TestStdApplication_NotifyObserversIsolation(t *testing.T) {
+	t.Parallel()
+	logger := &TestObserverLogger{}
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), logger).(*StdApplication)
+
+	var (
+		mu       sync.Mutex
+		received []string
+	)
+	record := func(id string) Observer {
+		return NewFunctionalObserver(id, func(ctx context.Context, event cloudevents.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+			received = append(received, id)
+			return nil
+		})
+	}
+	require.NoError(t, app.RegisterObserver(record("first")))
+	require.NoError(t, app.RegisterObserver(NewFunctionalObserver("panicking", func(context.Context, cloudevents.Event) error {
+		panic("boom")
+	})))
+	require.NoError(t, app.RegisterObserver(NewFunctionalObserver("failing", func(context.Context, cloudevents.Event) error {
+		return errObserver
+	})))
+	require.NoError(t, app.RegisterObserver(record("second")))
+
+	event := NewCloudEvent("test.event", "test", "test data", nil)
+
+	t.Run("synchronous", func(t *testing.T) {
+		err := app.NotifyObservers(WithSynchronousNotification(context.Background()), event)
+		require.Error(t, err)
+		require.ErrorIs(t, err, ErrObserverPanicked)
+		require.ErrorIs(t, err, errObserver)
+		assert.Contains(t, err.Error(), "observer panicking")
+		assert.Contains(t, err.Error(), "observer failing")
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.ElementsMatch(t, []string{"first", "second"}, received)
+		received = nil
+	})
+
+	t.Run("asynchronous", func(t *testing.T) {
+		require.NoError(t, app.NotifyObservers(context.Background(), event))
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(received) == 2
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestStdApplication_NotifyObserversRetry(t *testing.T) {
+	t.Parallel()
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &TestObserverLogger{}).(*StdApplication)
+	errTransient := errors.New("transient")
+
+	var (
+		mu       sync.Mutex
+		attempts = map[string]int{}
+	)
+	flaky := func(id string, failures int, err error) Observer {
+		return NewFunctionalObserver(id, func(context.Context, cloudevents.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+			attempts[id]++
+			if attempts[id] <= failures {
+				return err
+			}
+			return nil
+		})
+	}
+	onlyTransient := func(err error) bool { return errors.Is(err, errTransient) }
+
+	require.NoError(t, app.RegisterObserver(WithObserverRetry(flaky("recovers", 2, errTransient), ObserverRetryPolicy{
+		MaxAttempts: 3, Backoff: time.Millisecond, Retryable: onlyTransient,
+	})))
+	require.NoError(t, app.RegisterObserver(WithObserverRetry(flaky("exhausted", 5, errTransient), ObserverRetryPolicy{
+		MaxAttempts: 2, Backoff: time.Millisecond,
+	})))
+	require.NoError(t, app.RegisterObserver(WithObserverRetry(flaky("permanent", 5, errObserver), ObserverRetryPolicy{
+		MaxAttempts: 3, Backoff: time.Millisecond, Retryable: onlyTransient,
+	})))
+	require.NoError(t, app.RegisterObserver(flaky("no-policy", 5, errTransient)))
+
+	err := app.NotifyObservers(WithSynchronousNotification(context.Background()),
+		NewCloudEvent("test.event", "test", "test data", nil))
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "observer recovers")
+	assert.Contains(t, err.Error(), "observer exhausted")
+	assert.Contains(t, err.Error(), "observer permanent")
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, map[string]int{"recovers": 3, "exhausted": 2, "permanent": 1, "no-policy": 1}, attempts)
+}
+
+func TestStdApplication_ObserverEventTypeFilter(t *testing.T) {
+	t.Parallel()
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &TestObserverLogger{}).(*StdApplication)
+
+	received := map[string][]string{} // only written inline, by the notifying goroutine
+	record := func(id string) Observer {
+		return NewFunctionalObserver(id, func(ctx context.Context, event cloudevents.Event) error {
+			received[id] = append(received[id], event.Type())
+			return nil
+		})
+	}
+	require.NoError(t, app.RegisterObserver(record("catch-all")))
+	require.NoError(t, app.RegisterObserver(record("exact"), EventTypeModuleStarted))
+	require.NoError(t, app.RegisterObserver(record("modules"), "com.modular.module.*"))
+	require.NoError(t, app.RegisterObserver(record("mixed"), EventTypeServiceRegistered, "com.modular.*.stopped"))
+
+	eventTypes := []string{
+		EventTypeModuleStarted,
+		EventTypeModuleStopped,
+		EventTypeServiceRegistered,
+		EventTypeApplicationStopped,
+		"com.example.custom",
+	}
+	ctx := WithSynchronousNotification(context.Background())
+	for _, eventType := range eventTypes {
+		require.NoError(t, app.NotifyObservers(ctx, NewCloudEvent(eventType, "test", nil, nil)))
+	}
+
+	assert.Equal(t, eventTypes, received["catch-all"])
+	assert.Equal(t, []string{EventTypeModuleStarted}, received["exact"])
+	assert.Equal(t, []string{EventTypeModuleStarted, EventTypeModuleStopped}, received["modules"])
+	assert.Equal(t, []string{EventTypeModuleStopped, EventTypeServiceRegistered, EventTypeApplicationStopped}, received["mixed"])
+}
+
+func TestStdApplication_RegisterObserverInvalidPattern(t *testing.T) {
+	t.Parallel()
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &TestObserverLogger{}).(*StdApplication)
+
+	err := app.RegisterObserver(NewFunctionalObserver("bad", func(context.Context, cloudevents.Event) error {
+		return nil
+	}), "com.modular.[module")
+	require.ErrorIs(t, err, ErrInvalidEventTypePattern)
+	assert.Empty(t, app.GetObservers())
+}
@@ -0,0 +1,70 @@
+package modular
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// warmupModule is a module that becomes ready after a delay.
+type warmupModule struct {
+	warmupReadinessProvider
+	name string
+}
+
+func (m *warmupModule) Name() string             { return m.name }
+func (m *warmupModule) Init(_ Application) error { return nil }
+
+// optionalWarmupModule is a warmup module whose readiness is optional.
+type optionalWarmupModule struct {
+	warmupModule
+}
+
+func (m *optionalWarmupModule) ReadinessOptional() bool { return true }
+
+func TestStdApplication_WaitReady(t *testing.T) {
+	t.Run("blocks until modules are ready", func(t *testing.T) {
+		app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &reloadTestLogger{}).(*StdApplication)
+		app.RegisterModule(&warmupModule{name: "cache", warmupReadinessProvider: warmupReadinessProvider{readyAt: time.Now().Add(150 * time.Millisecond)}})
+		app.RegisterModule(&warmupModule{name: "db", warmupReadinessProvider: warmupReadinessProvider{readyAt: time.Now()}})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		start := time.Now()
+		if err := app.WaitReady(ctx); err != nil {
+			t.Fatalf("WaitReady failed: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+			t.Errorf("expected WaitReady to wait for the slowest module, returned after %v", elapsed)
+		}
+	})
+
+	t.Run("reports modules still warming up", func(t *testing.T) {
+		app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &reloadTestLogger{}).(*StdApplication)
+		app.RegisterModule(&warmupModule{name: "cache", warmupReadinessProvider: warmupReadinessProvider{readyAt: time.Now().Add(time.Hour)}})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		var waiter ReadinessWaiter = app
+		err := waiter.WaitReady(ctx)
+		if !errors.Is(err, ErrApplicationNotReady) || !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected ErrApplicationNotReady wrapping the deadline, got %v", err)
+		}
+		if got := err.Error(); got != "application not ready: cache (warming up): context deadline exceeded" {
+			t.Errorf("unexpected error message: %q", got)
+		}
+	})
+
+	t.Run("does not wait for optional modules", func(t *testing.T) {
+		app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &reloadTestLogger{}).(*StdApplication)
+		app.RegisterModule(&warmupModule{name: "db", warmupReadinessProvider: warmupReadinessProvider{readyAt: time.Now()}})
+		app.RegisterModule(&optionalWarmupModule{warmupModule{name: "search", warmupReadinessProvider: warmupReadinessProvider{readyAt: time.Now().Add(time.Hour)}}})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := app.WaitReady(ctx); err != nil {
+			t.Fatalf("expected WaitReady to ignore the optional module, got %v", err)
+		}
+	})
+}
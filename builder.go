@@ -27,9 +27,16 @@ type ApplicationBuilder struct {
 	tenantGuardConfig *TenantGuardConfig
 	dependencyHints   []DependencyEdge
 	drainTimeout      time.Duration
+	startTimeout      time.Duration
+	stopTimeout       time.Duration
 	parallelInit      bool
+	configCheckMode   bool
 	dynamicReload     bool
+	reloadConfig      DynamicReloadConfig
 	plugins           []Plugin
+	configFeeders     []Feeder
+	configValidators  []func(Application) error
+	secretResolvers   []SecretResolver
 }
 
 // ObserverFunc is a functional observer that can be registered with the application
@@ -137,6 +144,41 @@ func (b *ApplicationBuilder) Build() (Application, error) {
 		}
 	}
 
+	// Propagate per-application config feeders
+	if b.configFeeders != nil {
+		if stdApp, ok := baseApp.(*StdApplication); ok {
+			stdApp.SetConfigFeeders(b.configFeeders)
+		} else if obsApp, ok := baseApp.(*ObservableApplication); ok {
+			obsApp.SetConfigFeeders(b.configFeeders)
+		}
+	}
+
+	// Propagate secret resolvers
+	if len(b.secretResolvers) > 0 {
+		if stdApp, ok := baseApp.(*StdApplication); ok {
+			for _, resolver := range b.secretResolvers {
+				stdApp.AddSecretResolver(resolver)
+			}
+		} else if obsApp, ok := baseApp.(*ObservableApplication); ok {
+			for _, resolver := range b.secretResolvers {
+				obsApp.AddSecretResolver(resolver)
+			}
+		}
+	}
+
+	// Propagate cross-section config validators
+	if len(b.configValidators) > 0 {
+		if stdApp, ok := baseApp.(*StdApplication); ok {
+			for _, validator := range b.configValidators {
+				stdApp.AddConfigValidator(validator)
+			}
+		} else if obsApp, ok := baseApp.(*ObservableApplication); ok {
+			for _, validator := range b.configValidators {
+				obsApp.AddConfigValidator(validator)
+			}
+		}
+	}
+
 	// Propagate drain timeout
 	if b.drainTimeout > 0 {
 		if stdApp, ok := baseApp.(*StdApplication); ok {
@@ -146,12 +188,25 @@ func (b *ApplicationBuilder) Build() (Application, error) {
 		}
 	}
 
+	// Propagate lifecycle timeouts
+	if b.startTimeout > 0 || b.stopTimeout > 0 {
+		if stdApp, ok := baseApp.(*StdApplication); ok {
+			stdApp.startTimeout = b.startTimeout
+			stdApp.stopTimeout = b.stopTimeout
+		} else if obsApp, ok := baseApp.(*ObservableApplication); ok {
+			obsApp.startTimeout = b.startTimeout
+			obsApp.stopTimeout = b.stopTimeout
+		}
+	}
+
 	// Propagate dynamic reload
 	if b.dynamicReload {
 		if stdApp, ok := baseApp.(*StdApplication); ok {
 			stdApp.dynamicReload = true
+			stdApp.reloadConfig = b.reloadConfig
 		} else if obsApp, ok := baseApp.(*ObservableApplication); ok {
 			obsApp.dynamicReload = true
+			obsApp.reloadConfig = b.reloadConfig
 		}
 	}
 
@@ -164,6 +219,15 @@ func (b *ApplicationBuilder) Build() (Application, error) {
 		}
 	}
 
+	// Propagate config check mode
+	if b.configCheckMode {
+		if stdApp, ok := baseApp.(*StdApplication); ok {
+			stdApp.configCheckMode = true
+		} else if obsApp, ok := baseApp.(*ObservableApplication); ok {
+			obsApp.configCheckMode = true
+		}
+	}
+
 	// Process plugins
 	for _, plugin := range b.plugins {
 		for _, mod := range plugin.Modules() {
@@ -241,6 +305,15 @@ func WithModuleDependency(from, to string) Option {
 	}
 }
 
+// WithConfigFeeders sets the configuration feeders for this application only.
+// This is the preferred alternative to mutating the global ConfigFeeders slice.
+func WithConfigFeeders(feeders ...Feeder) Option {
+	return func(b *ApplicationBuilder) error {
+		b.configFeeders = append(make([]Feeder, 0, len(feeders)), feeders...)
+		return nil
+	}
+}
+
 // WithDrainTimeout sets the timeout for the pre-stop drain phase during shutdown.
 func WithDrainTimeout(d time.Duration) Option {
 	return func(b *ApplicationBuilder) error {
@@ -249,6 +322,31 @@ func WithDrainTimeout(d time.Duration) Option {
 	}
 }
 
+// WithStartTimeout bounds how long Start may take. The context passed to a
+// module's Start is cancelled if the timeout expires while that module is
+// still starting. If the modules have not all started when the
+// timeout expires, the module still starting and those already started are
+// stopped in reverse order, as by Stop and within the stop timeout, and Start
+// returns an error wrapping ErrStartTimeout that names the module still
+// starting.
+func WithStartTimeout(d time.Duration) Option {
+	return func(b *ApplicationBuilder) error {
+		b.startTimeout = d
+		return nil
+	}
+}
+
+// WithStopTimeout bounds how long Stop may take, including the drain phase.
+// If a module is still stopping when the timeout expires, Stop moves on to the
+// remaining modules, giving each a short grace period, and returns an error
+// wrapping ErrStopTimeout that names every module that did not stop in time.
+func WithStopTimeout(d time.Duration) Option {
+	return func(b *ApplicationBuilder) error {
+		b.stopTimeout = d
+		return nil
+	}
+}
+
 // WithParallelInit enables concurrent module initialization at the same topological depth.
 func WithParallelInit() Option {
 	return func(b *ApplicationBuilder) error {
@@ -257,6 +355,16 @@ func WithParallelInit() Option {
 	}
 }
 
+// WithConfigCheckMode lets tools such as "modcli config validate" run the
+// application in config check mode by setting ConfigCheckEnv. Without it
+// the config check environment variables are ignored.
+func WithConfigCheckMode() Option {
+	return func(b *ApplicationBuilder) error {
+		b.configCheckMode = true
+		return nil
+	}
+}
+
 // WithDynamicReload enables the ReloadOrchestrator, which coordinates
 // configuration reloading across all registered Reloadable modules.
 func WithDynamicReload() Option {
@@ -266,6 +374,16 @@ func WithDynamicReload() Option {
 	}
 }
 
+// WithDynamicReloadConfig enables the ReloadOrchestrator like WithDynamicReload,
+// additionally reloading configuration when any of cfg.WatchFiles changes.
+func WithDynamicReloadConfig(cfg DynamicReloadConfig) Option {
+	return func(b *ApplicationBuilder) error {
+		b.dynamicReload = true
+		b.reloadConfig = cfg
+		return nil
+	}
+}
+
 // WithPlugins adds plugins to the application. Each plugin's modules, services,
 // and init hooks are registered during Build().
 func WithPlugins(plugins ...Plugin) Option {
@@ -327,6 +445,51 @@ func WithOnConfigLoaded(hooks ...func(Application) error) Option {
 	}
 }
 
+// WithConfigValidator registers application-level validators for config
+// constraints spanning several sections. They run after all config sections
+// are loaded and before any module is initialized; their failures are
+// aggregated into one error wrapping ErrConfigValidationFailed.
+//
+// Example:
+//
+//	app, err := modular.NewApplication(
+//	    modular.WithConfigValidator(func(app modular.Application) error {
+//	        cacheCfg, err := app.GetConfigSection("cache")
+//	        if err != nil {
+//	            return err
+//	        }
+//	        if cacheCfg.GetConfig().(*CacheConfig).Engine == "redis" && redisURL(app) == "" {
+//	            return errors.New("redis.url is required when the cache uses redis")
+//	        }
+//	        return nil
+//	    }),
+//	    modular.WithModules(modules...),
+//	)
+func WithConfigValidator(validators ...func(Application) error) Option {
+	return func(b *ApplicationBuilder) error {
+		b.configValidators = append(b.configValidators, validators...)
+		return nil
+	}
+}
+
+// WithSecretResolvers registers resolvers for the references held by config
+// fields tagged `secret:"true"`. The env and file resolvers are built in, so
+// this is only needed for external stores such as Vault.
+//
+// Example:
+//
+//	vault := modular.NewExternalSecretResolver("vault", fetchFromVault)
+//	app, err := modular.NewApplication(
+//	    modular.WithSecretResolvers(vault),
+//	    modular.WithModules(modules...),
+//	)
+func WithSecretResolvers(resolvers ...SecretResolver) Option {
+	return func(b *ApplicationBuilder) error {
+		b.secretResolvers = append(b.secretResolvers, resolvers...)
+		return nil
+	}
+}
+
 // WithTenantGuardMode enables the tenant guard with the specified mode using default config.
 func WithTenantGuardMode(mode TenantGuardMode) Option {
 	return func(b *ApplicationBuilder) error {
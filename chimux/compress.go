@@ -0,0 +1,371 @@
+package chimux
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+)
+
+// Content encodings supported by the Compress middleware
+const (
+	EncodingBrotli = "br"
+	EncodingGzip   = "gzip"
+)
+
+// DefaultCompressMinSize is the smallest response body, in bytes, the
+// Compress middleware compresses when CompressOptions.MinSize is not set
+const DefaultCompressMinSize = 1024
+
+// DefaultCompressContentTypes are the content types compressed when
+// CompressOptions.ContentTypes is not set. Images, archives and other types
+// that are already compressed are deliberately absent.
+var DefaultCompressContentTypes = []string{
+	"text/*",
+	"application/json",
+	"application/problem+json",
+	"application/x-ndjson",
+	"application/javascript",
+	"application/xml",
+	"image/svg+xml",
+}
+
+// CompressOptions configures the Compress middleware.
+//
+// Example YAML configuration:
+//
+//	compress:
+//	  min_size: 2048
+//	  content_types: ["application/json", "text/*"]
+type CompressOptions struct {
+	// MinSize is the smallest response body, in bytes, worth compressing.
+	// Smaller responses are sent uncompressed.
+	// Default: 1024
+	MinSize int `yaml:"min_size" desc:"Smallest response body in bytes that is compressed."`
+
+	// ContentTypes lists the media types that are compressed. An entry may
+	// end in /* to match a whole type, such as text/*. Responses of any
+	// other type are sent as is.
+	// Default: DefaultCompressContentTypes
+	ContentTypes []string `yaml:"content_types" desc:"Media types that are compressed, e.g. application/json or text/*."`
+
+	// Encodings lists the encodings to offer in order of preference, used
+	// when a client accepts several with the same quality. Names other than
+	// br and gzip are ignored.
+	// Default: br, gzip
+	Encodings []string `yaml:"encodings" desc:"Supported content encodings in order of preference: br, gzip."`
+}
+
+// compressor holds the negotiated settings and encoder pools of a Compress
+// middleware
+type compressor struct {
+	minSize      int
+	contentTypes []string
+	encodings    []string
+	gzipPool     sync.Pool
+	brotliPool   sync.Pool
+}
+
+// Compress returns middleware that compresses responses with gzip or brotli,
+// as negotiated from the request's Accept-Encoding header. A response is
+// compressed only when its body reaches MinSize and its Content-Type is in
+// ContentTypes; responses that already carry a Content-Encoding, such as
+// compressed responses passed through by the reverseproxy module, are never
+// compressed again. Vary: Accept-Encoding is added to every response.
+//
+// Example:
+//
+//	router.Use(chimux.Compress(chimux.CompressOptions{MinSize: 2048}))
+func Compress(opts CompressOptions) Middleware {
+	c := &compressor{
+		minSize:      opts.MinSize,
+		contentTypes: opts.ContentTypes,
+	}
+	if c.minSize <= 0 {
+		c.minSize = DefaultCompressMinSize
+	}
+	if len(c.contentTypes) == 0 {
+		c.contentTypes = DefaultCompressContentTypes
+	}
+	for _, encoding := range opts.Encodings {
+		if encoding = strings.ToLower(encoding); encoding == EncodingBrotli || encoding == EncodingGzip {
+			c.encodings = append(c.encodings, encoding)
+		}
+	}
+	if len(c.encodings) == 0 {
+		c.encodings = []string{EncodingBrotli, EncodingGzip}
+	}
+	c.gzipPool.New = func() any {
+		return gzip.NewWriter(io.Discard)
+	}
+	c.brotliPool.New = func() any {
+		return brotli.NewWriter(io.Discard)
+	}
+	return c.middleware
+}
+
+// middleware wraps the response writer of requests that accept a supported
+// encoding
+func (c *compressor) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		addVary(w.Header(), "Accept-Encoding")
+		encoding := c.negotiate(r.Header.Get("Accept-Encoding"))
+		if encoding == "" || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+		cw := &compressWriter{ResponseWriter: w, c: c, encoding: encoding, status: http.StatusOK}
+		defer cw.Close()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// negotiate picks the supported encoding the client accepts with the highest
+// quality, preferring earlier entries of c.encodings on ties. It returns ""
+// if the client accepts none of them.
+func (c *compressor) negotiate(acceptEncoding string) string {
+	if acceptEncoding == "" {
+		return ""
+	}
+	qualities := make(map[string]float64)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if ok && strings.EqualFold(strings.TrimSpace(key), "q") {
+				if parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		qualities[name] = q
+	}
+
+	best, bestQ := "", 0.0
+	for _, encoding := range c.encodings {
+		q, ok := qualities[encoding]
+		if !ok {
+			q, ok = qualities["*"]
+		}
+		if ok && q > bestQ {
+			best, bestQ = encoding, q
+		}
+	}
+	return best
+}
+
+// compressible reports whether a response with the given Content-Type is in
+// the content type allowlist
+func (c *compressor) compressible(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range c.contentTypes {
+		allowed = strings.ToLower(allowed)
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok {
+			if strings.HasPrefix(mediaType, prefix+"/") {
+				return true
+			}
+		} else if mediaType == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// compressWriter buffers the start of a response until it can decide whether
+// to compress it, then writes it either through an encoder or unchanged
+type compressWriter struct {
+	http.ResponseWriter
+	c        *compressor
+	encoding string
+
+	status      int
+	wroteHeader bool
+	decided     bool
+	buf         []byte
+	encoder     io.WriteCloser
+}
+
+// WriteHeader records the status code; it is sent once the response body
+// shows whether the response will be compressed
+func (cw *compressWriter) WriteHeader(code int) {
+	if cw.wroteHeader || cw.decided {
+		return
+	}
+	// Informational responses are sent straight through; after switching
+	// protocols the connection no longer carries an HTTP body
+	if code >= 100 && code < 200 {
+		cw.decided = code == http.StatusSwitchingProtocols
+		cw.ResponseWriter.WriteHeader(code)
+		return
+	}
+	cw.status = code
+	cw.wroteHeader = true
+}
+
+// Write buffers the body until MinSize bytes are available, then compresses
+// or passes it through
+func (cw *compressWriter) Write(p []byte) (int, error) {
+	cw.wroteHeader = true
+	if !cw.decided {
+		if len(cw.buf)+len(p) < cw.c.minSize && cw.Header().Get("Content-Length") == "" {
+			cw.buf = append(cw.buf, p...)
+			return len(p), nil
+		}
+		cw.decide(len(cw.buf) + len(p))
+		if err := cw.flushBuffer(); err != nil {
+			return 0, err
+		}
+	}
+	if cw.encoder != nil {
+		return cw.encoder.Write(p) //nolint:wrapcheck // errors are reported as the writer's own
+	}
+	return cw.ResponseWriter.Write(p) //nolint:wrapcheck // errors are reported as the writer's own
+}
+
+// decide chooses whether to compress a response whose body is known to be at
+// least size bytes, and sends the status line and headers
+func (cw *compressWriter) decide(size int) {
+	cw.decided = true
+	h := cw.Header()
+	if h.Get("Content-Type") == "" && len(cw.buf) > 0 {
+		h.Set("Content-Type", http.DetectContentType(cw.buf))
+	}
+	if cw.shouldCompress(size) {
+		h.Set("Content-Encoding", cw.encoding)
+		h.Del("Content-Length")
+		h.Del("Accept-Ranges")
+		cw.encoder = cw.c.getEncoder(cw.encoding, cw.ResponseWriter)
+	}
+	cw.ResponseWriter.WriteHeader(cw.status)
+}
+
+// shouldCompress applies the encoding, status, size and content type rules
+func (cw *compressWriter) shouldCompress(size int) bool {
+	h := cw.Header()
+	if h.Get("Content-Encoding") != "" || h.Get("Content-Range") != "" {
+		return false
+	}
+	if cw.status < http.StatusOK || cw.status == http.StatusNoContent ||
+		cw.status == http.StatusNotModified || cw.status == http.StatusPartialContent {
+		return false
+	}
+	if length, err := strconv.Atoi(h.Get("Content-Length")); err == nil {
+		size = length
+	}
+	if size < cw.c.minSize {
+		return false
+	}
+	return cw.c.compressible(h.Get("Content-Type"))
+}
+
+// flushBuffer writes the buffered start of the body
+func (cw *compressWriter) flushBuffer() error {
+	if len(cw.buf) == 0 {
+		return nil
+	}
+	buf := cw.buf
+	cw.buf = nil
+	var err error
+	if cw.encoder != nil {
+		_, err = cw.encoder.Write(buf)
+	} else {
+		_, err = cw.ResponseWriter.Write(buf)
+	}
+	return err //nolint:wrapcheck // errors are reported as the writer's own
+}
+
+// Flush sends any buffered data to the client. A response flushed before
+// reaching MinSize is treated as a stream and compressed if its content type
+// allows.
+func (cw *compressWriter) Flush() {
+	if !cw.decided {
+		cw.decide(cw.c.minSize)
+		_ = cw.flushBuffer()
+	}
+	if f, ok := cw.encoder.(interface{ Flush() error }); ok {
+		_ = f.Flush()
+	}
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Close completes the response, sending a short body uncompressed and
+// finishing the encoded stream
+func (cw *compressWriter) Close() {
+	if !cw.decided {
+		if !cw.wroteHeader {
+			return
+		}
+		cw.decide(len(cw.buf))
+		_ = cw.flushBuffer()
+	}
+	if cw.encoder != nil {
+		_ = cw.encoder.Close()
+		cw.c.putEncoder(cw.encoding, cw.encoder)
+		cw.encoder = nil
+	}
+}
+
+// Hijack lets protocols such as WebSocket take over the connection
+func (cw *compressWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := cw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, http.ErrNotSupported
+	}
+	cw.decided = true
+	return hijacker.Hijack() //nolint:wrapcheck // errors are reported as the writer's own
+}
+
+// Unwrap returns the underlying writer for http.ResponseController
+func (cw *compressWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+// getEncoder returns a pooled encoder writing to w
+func (c *compressor) getEncoder(encoding string, w io.Writer) io.WriteCloser {
+	if encoding == EncodingBrotli {
+		bw := c.brotliPool.Get().(*brotli.Writer)
+		bw.Reset(w)
+		return bw
+	}
+	gw := c.gzipPool.Get().(*gzip.Writer)
+	gw.Reset(w)
+	return gw
+}
+
+// putEncoder returns a closed encoder to its pool
+func (c *compressor) putEncoder(encoding string, encoder io.WriteCloser) {
+	if encoding == EncodingBrotli {
+		c.brotliPool.Put(encoder)
+		return
+	}
+	c.gzipPool.Put(encoder)
+}
+
+// addVary adds value to the Vary header unless it is already listed
+func addVary(h http.Header, value string) {
+	for _, existing := range h.Values("Vary") {
+		for _, v := range strings.Split(existing, ",") {
+			if strings.EqualFold(strings.TrimSpace(v), value) {
+				return
+			}
+		}
+	}
+	h.Add("Vary", value)
+}
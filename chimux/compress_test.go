@@ -0,0 +1,166 @@
+package chimux
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// largeJSON is a JSON body above the default compression threshold
+var largeJSON = `{"items":[` + strings.Repeat(`{"name":"item","value":12345},`, 100) + `{}]}`
+
+// compressRequest serves a GET with the given Accept-Encoding through handler
+func compressRequest(handler http.Handler, acceptEncoding string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
+// decodeBody decompresses a response body according to its Content-Encoding
+func decodeBody(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+	var r io.Reader = w.Body
+	switch w.Header().Get("Content-Encoding") {
+	case EncodingGzip:
+		gr, err := gzip.NewReader(w.Body)
+		require.NoError(t, err)
+		r = gr
+	case EncodingBrotli:
+		r = brotli.NewReader(w.Body)
+	}
+	body, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func serveBody(contentType, body string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		_, _ = io.WriteString(w, body)
+	})
+}
+
+func TestCompress_Negotiation(t *testing.T) {
+	handler := Compress(CompressOptions{})(serveBody("application/json", largeJSON))
+
+	tests := []struct {
+		acceptEncoding string
+		want           string
+	}{
+		{"gzip, deflate, br", EncodingBrotli},
+		{"gzip", EncodingGzip},
+		{"br;q=0.5, gzip;q=0.8", EncodingGzip},
+		{"br;q=0, gzip", EncodingGzip},
+		{"*", EncodingBrotli},
+		{"deflate", ""},
+		{"gzip;q=0", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.acceptEncoding, func(t *testing.T) {
+			w := compressRequest(handler, tt.acceptEncoding)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.want, w.Header().Get("Content-Encoding"))
+			assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+			assert.Empty(t, w.Header().Get("Content-Length"))
+			assert.Equal(t, largeJSON, decodeBody(t, w))
+		})
+	}
+
+	gzipFirst := Compress(CompressOptions{Encodings: []string{"gzip", "br"}})(serveBody("application/json", largeJSON))
+	assert.Equal(t, EncodingGzip, compressRequest(gzipFirst, "br, gzip").Header().Get("Content-Encoding"))
+}
+
+func TestCompress_MinSizeThreshold(t *testing.T) {
+	small := `{"ok":true}`
+	handler := Compress(CompressOptions{MinSize: 64})(serveBody("application/json", small))
+
+	w := compressRequest(handler, "gzip")
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+	assert.Equal(t, small, w.Body.String())
+
+	// A declared Content-Length below the threshold is also left alone
+	withLength := Compress(CompressOptions{MinSize: 64})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Length", "11")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, small)
+	}))
+	w = compressRequest(withLength, "gzip")
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "11", w.Header().Get("Content-Length"))
+	assert.Equal(t, small, w.Body.String())
+
+	// Bodies written in chunks are compressed once they reach the threshold
+	chunked := Compress(CompressOptions{MinSize: 64})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		for range 10 {
+			_, _ = io.WriteString(w, "0123456789")
+		}
+	}))
+	w = compressRequest(chunked, "gzip")
+	assert.Equal(t, EncodingGzip, w.Header().Get("Content-Encoding"))
+	assert.Equal(t, strings.Repeat("0123456789", 10), decodeBody(t, w))
+}
+
+func TestCompress_ContentTypeAllowlist(t *testing.T) {
+	png := strings.Repeat("\x89PNG", 512)
+
+	tests := []struct {
+		name        string
+		opts        CompressOptions
+		contentType string
+		compressed  bool
+	}{
+		{"json allowed by default", CompressOptions{}, "application/json; charset=utf-8", true},
+		{"text wildcard", CompressOptions{}, "text/html", true},
+		{"image skipped", CompressOptions{}, "image/png", false},
+		{"archive skipped", CompressOptions{}, "application/zip", false},
+		{"custom allowlist", CompressOptions{ContentTypes: []string{"application/json"}}, "text/html", false},
+		{"custom wildcard", CompressOptions{ContentTypes: []string{"application/*"}}, "application/wasm", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := compressRequest(Compress(tt.opts)(serveBody(tt.contentType, png)), "gzip")
+			if tt.compressed {
+				assert.Equal(t, EncodingGzip, w.Header().Get("Content-Encoding"))
+			} else {
+				assert.Empty(t, w.Header().Get("Content-Encoding"))
+			}
+			assert.Equal(t, png, decodeBody(t, w))
+		})
+	}
+}
+
+func TestCompress_SkipsEncodedResponses(t *testing.T) {
+	// A proxied backend response that is already gzip encoded passes through
+	var encoded strings.Builder
+	gw := gzip.NewWriter(&encoded)
+	_, _ = io.WriteString(gw, largeJSON)
+	require.NoError(t, gw.Close())
+
+	handler := Compress(CompressOptions{})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", EncodingGzip)
+		w.Header().Set("Vary", "Accept-Encoding")
+		_, _ = io.WriteString(w, encoded.String())
+	}))
+
+	w := compressRequest(handler, "br")
+	assert.Equal(t, EncodingGzip, w.Header().Get("Content-Encoding"))
+	assert.Equal(t, []string{"Accept-Encoding"}, w.Header().Values("Vary"))
+	assert.Equal(t, largeJSON, decodeBody(t, w))
+}
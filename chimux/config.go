@@ -1,6 +1,7 @@
 package chimux
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -31,6 +32,13 @@ import (
 //	max_age: 3600
 //	timeout: 30000
 //	basepath: "/api/v1"
+//	rate_limits:
+//	  "*":
+//	    requests_per_second: 50
+//	    burst: 100
+//	  "POST /login":
+//	    requests_per_second: 0.5
+//	    burst: 5
 //
 // Example environment variables:
 //
@@ -80,17 +88,28 @@ type ChiMuxConfig struct {
 	// Example: "/api/v1" would make a route "/users" accessible as "/api/v1/users"
 	// Default: "" (no prefix)
 	BasePath string `yaml:"basepath" desc:"A base path prefix for all routes registered through this module." env:"BASE_PATH"`
+
+	// RateLimits configures per-route rate limiting. Keys are route patterns
+	// as registered with the router, optionally prefixed with a method
+	// ("POST /login"). The "*" key applies to requests whose route has no
+	// entry of its own. Each entry limits its keys independently.
+	// Default: no rate limiting
+	RateLimits map[string]RateLimitOptions `yaml:"rate_limits" desc:"Per-route rate limits keyed by route pattern, optionally prefixed with a method."`
 }
 
 // Validate implements the modular.ConfigValidator interface.
 // This method is called during configuration loading to ensure
 // the configuration values are valid and consistent.
 //
-// Currently performs basic validation but can be extended to include:
+// Currently validates rate limit options and can be extended to include:
 //   - URL validation for allowed origins
 //   - Timeout range validation
 //   - Base path format validation
 func (c *ChiMuxConfig) Validate() error {
-	// Add custom validation logic here
+	for route, opts := range c.RateLimits {
+		if _, err := newRateLimiter(opts); err != nil {
+			return fmt.Errorf("rate limit for route %q: %w", route, err)
+		}
+	}
 	return nil
 }
@@ -9,4 +9,13 @@ import (
 var (
 	// ErrNoSubjectForEventEmission is returned when trying to emit events without a subject
 	ErrNoSubjectForEventEmission = errors.New("no subject available for event emission")
+
+	// ErrInvalidRateLimit is returned when rate limit options are out of range
+	ErrInvalidRateLimit = errors.New("invalid rate limit")
+
+	// ErrInvalidTrustedProxy is returned when a trusted proxy is not a valid CIDR
+	ErrInvalidTrustedProxy = errors.New("invalid trusted proxy CIDR")
+
+	// ErrUnknownRateLimitKey is returned when RateLimitOptions.KeyBy is not recognized
+	ErrUnknownRateLimitKey = errors.New("unknown rate limit key")
 )
@@ -32,4 +32,7 @@ const (
 	EventTypeRequestReceived  = "com.modular.chimux.request.received"
 	EventTypeRequestProcessed = "com.modular.chimux.request.processed"
 	EventTypeRequestFailed    = "com.modular.chimux.request.failed"
+
+	// EventTypeHTTPPanic is emitted by the Recoverer middleware when a handler panics
+	EventTypeHTTPPanic = "com.modular.http.panic"
 )
@@ -0,0 +1,72 @@
+package chimux
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sort"
+	"sync/atomic"
+)
+
+// prioritizedMiddleware is a middleware registered with UseWithPriority
+type prioritizedMiddleware struct {
+	priority int
+	fn       Middleware
+}
+
+// priorityChain is the prioritized middleware composed around a handler,
+// tagged with the registration version it was built from
+type priorityChain struct {
+	version uint64
+	handler http.Handler
+}
+
+// UseWithPriority registers middleware ordered by priority instead of by
+// registration time, so modules can agree on cross-cutting order (e.g. CORS
+// before auth before logging) without coordinating when they register.
+// Lower priorities run outermost; middlewares with equal priority run in the
+// order they were registered.
+//
+// Prioritized middleware runs after the module's built-in middleware and
+// before middleware added with Use. Unlike Use, it may be called after
+// routes have been registered.
+func (m *ChiMuxModule) UseWithPriority(priority int, mw func(http.Handler) http.Handler) {
+	m.priorityMu.Lock()
+	// Insert after any existing middleware with the same priority so ties keep registration order
+	idx := sort.Search(len(m.prioritized), func(i int) bool {
+		return m.prioritized[i].priority > priority
+	})
+	m.prioritized = slices.Insert(m.prioritized, idx, prioritizedMiddleware{priority: priority, fn: mw})
+	m.priorityVersion++
+	total := len(m.prioritized)
+	m.priorityMu.Unlock()
+
+	m.emitEvent(context.Background(), EventTypeMiddlewareAdded, map[string]interface{}{
+		"middleware_count":  1,
+		"total_prioritized": total,
+		"priority":          priority,
+	})
+}
+
+// priorityMiddleware runs the middleware registered with UseWithPriority.
+// The chain is composed once and rebuilt only after a registration changes it.
+func (m *ChiMuxModule) priorityMiddleware() Middleware {
+	return func(next http.Handler) http.Handler {
+		var cached atomic.Pointer[priorityChain]
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			m.priorityMu.RLock()
+			chain := cached.Load()
+			if chain == nil || chain.version != m.priorityVersion {
+				handler := next
+				for i := len(m.prioritized) - 1; i >= 0; i-- {
+					handler = m.prioritized[i].fn(handler)
+				}
+				chain = &priorityChain{version: m.priorityVersion, handler: handler}
+				cached.Store(chain)
+			}
+			m.priorityMu.RUnlock()
+
+			chain.handler.ServeHTTP(w, r)
+		})
+	}
+}
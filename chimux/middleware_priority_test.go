@@ -0,0 +1,107 @@
+package chimux
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// orderRecorder records the order in which middlewares and handlers run
+type orderRecorder struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (o *orderRecorder) middleware(name string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			o.record(name)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (o *orderRecorder) record(name string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.calls = append(o.calls, name)
+}
+
+func (o *orderRecorder) take() []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	calls := o.calls
+	o.calls = nil
+	return calls
+}
+
+func newPriorityTestModule(t *testing.T) *ChiMuxModule {
+	t.Helper()
+	module := NewChiMuxModule().(*ChiMuxModule)
+	mockApp := NewMockApplication()
+	require.NoError(t, module.RegisterConfig(mockApp))
+	require.NoError(t, module.Init(mockApp))
+	return module
+}
+
+func serve(module *ChiMuxModule, path string) int {
+	w := httptest.NewRecorder()
+	module.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w.Code
+}
+
+func TestUseWithPriority_Order(t *testing.T) {
+	module := newPriorityTestModule(t)
+	recorder := &orderRecorder{}
+
+	module.UseWithPriority(30, recorder.middleware("logging"))
+	module.UseWithPriority(10, recorder.middleware("cors"))
+	module.UseWithPriority(20, recorder.middleware("auth"))
+	module.Get("/", func(w http.ResponseWriter, _ *http.Request) {
+		recorder.record("handler")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	require.Equal(t, http.StatusOK, serve(module, "/"))
+	assert.Equal(t, []string{"cors", "auth", "logging", "handler"}, recorder.take())
+}
+
+func TestUseWithPriority_TiesPreserveRegistrationOrder(t *testing.T) {
+	module := newPriorityTestModule(t)
+	recorder := &orderRecorder{}
+
+	module.UseWithPriority(5, recorder.middleware("first"))
+	module.UseWithPriority(1, recorder.middleware("outer"))
+	module.UseWithPriority(5, recorder.middleware("second"))
+	module.UseWithPriority(5, recorder.middleware("third"))
+	module.Get("/", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	require.Equal(t, http.StatusOK, serve(module, "/"))
+	assert.Equal(t, []string{"outer", "first", "second", "third"}, recorder.take())
+}
+
+func TestUseWithPriority_AfterRoutes(t *testing.T) {
+	module := newPriorityTestModule(t)
+	recorder := &orderRecorder{}
+
+	module.Use(recorder.middleware("plain"))
+	module.Get("/", func(w http.ResponseWriter, _ *http.Request) {
+		recorder.record("handler")
+		w.WriteHeader(http.StatusOK)
+	})
+	module.UseWithPriority(20, recorder.middleware("auth"))
+
+	require.Equal(t, http.StatusOK, serve(module, "/"))
+	assert.Equal(t, []string{"auth", "plain", "handler"}, recorder.take())
+
+	// Later registrations are slotted into the existing chain by priority
+	module.UseWithPriority(10, recorder.middleware("cors"))
+	require.Equal(t, http.StatusOK, serve(module, "/"))
+	assert.Equal(t, []string{"cors", "auth", "plain", "handler"}, recorder.take())
+}
@@ -69,6 +69,15 @@
 //	    }
 //	}
 //
+// Middleware that must run in a fixed order across modules can be registered
+// with a priority instead; lower priorities run outermost:
+//
+//	var mux *chimux.ChiMuxModule
+//	_ = app.GetService("chimux.router", &mux)
+//	mux.UseWithPriority(10, corsMiddleware)
+//	mux.UseWithPriority(30, loggingMiddleware)
+//	mux.UseWithPriority(20, authMiddleware)
+//
 // # Tenant Support
 //
 // The module supports tenant-specific configurations:
@@ -162,6 +171,13 @@ type ChiMuxModule struct {
 	middlewareMu    sync.RWMutex
 	middlewares     map[string]*controllableMiddleware
 	middlewareOrder []string
+	// routerMiddlewareNames names router-level middleware by chain position for RouteTable
+	routerMiddlewareNames map[int]string
+	// prioritized holds middleware registered with UseWithPriority, sorted by
+	// priority. priorityVersion changes on every registration.
+	priorityMu      sync.RWMutex
+	prioritized     []prioritizedMiddleware
+	priorityVersion uint64
 }
 
 // NewChiMuxModule creates a new instance of the chimux module.
@@ -318,10 +334,10 @@ func (m *ChiMuxModule) initRouter() error {
 	m.logger.Debug("Created chi router instance", "module", m.Name())
 
 	// Set up default middleware
-	m.router.Use(middleware.RequestID)
-	m.router.Use(middleware.ClientIPFromRemoteAddr)
-	m.router.Use(middleware.Logger)
-	m.router.Use(middleware.Recoverer)
+	m.useRouterMiddleware("RequestID", middleware.RequestID)
+	m.useRouterMiddleware("ClientIPFromRemoteAddr", middleware.ClientIPFromRemoteAddr)
+	m.useRouterMiddleware("Logger", middleware.Logger)
+	m.useRouterMiddleware("Recoverer", middleware.Recoverer)
 
 	middleware.DefaultLogger = func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -331,13 +347,25 @@ func (m *ChiMuxModule) initRouter() error {
 	}
 
 	// Apply CORS middleware using the configuration
-	m.router.Use(m.corsMiddleware())
+	m.useRouterMiddleware("CORS", m.corsMiddleware())
 
 	// Apply disabled routes middleware early so disabled routes short-circuit
-	m.router.Use(m.disabledRouteMiddleware())
+	m.useRouterMiddleware("DisabledRoutes", m.disabledRouteMiddleware())
+
+	// Apply configured per-route rate limits before any further request processing
+	if len(m.config.RateLimits) > 0 {
+		limiters, err := newRouteRateLimiters(m.router, m.config.BasePath, m.config.RateLimits)
+		if err != nil {
+			return err
+		}
+		m.useRouterMiddleware("RateLimit", limiters.middleware)
+	}
 
 	// Apply request monitoring middleware for event emission (after disabled check so we don't emit normal request events for disabled routes)
-	m.router.Use(m.requestMonitoringMiddleware())
+	m.useRouterMiddleware("RequestMonitoring", m.requestMonitoringMiddleware())
+
+	// Apply middleware registered with UseWithPriority, in priority order
+	m.useRouterMiddleware("Prioritized", m.priorityMiddleware())
 
 	// Emit CORS configured event
 	m.emitEvent(context.Background(), EventTypeCorsConfigured, map[string]interface{}{
@@ -670,19 +698,24 @@ func (m *ChiMuxModule) Use(middlewares ...func(http.Handler) http.Handler) {
 	// Backwards compatible: wrap anonymous middlewares assigning generated names
 	for idx, mw := range middlewares {
 		name := fmt.Sprintf("mw_%d_%d", time.Now().UnixNano(), idx)
-		m.UseNamed(name, mw)
+		m.useControllable(name, middlewareName(mw), mw)
 	}
 }
 
 // UseNamed registers a named middleware that can later be disabled via RemoveMiddleware.
 func (m *ChiMuxModule) UseNamed(name string, mw Middleware) {
+	m.useControllable(name, name, mw)
+}
+
+// useControllable registers mw under name, reporting it in the route table as displayName
+func (m *ChiMuxModule) useControllable(name, displayName string, mw Middleware) {
 	cm := &controllableMiddleware{name: name, fn: mw}
 	cm.enabled.Store(true)
 	m.middlewareMu.Lock()
 	m.middlewares[name] = cm
 	m.middlewareOrder = append(m.middlewareOrder, name)
 	m.middlewareMu.Unlock()
-	m.router.Use(cm.Wrap)
+	m.useRouterMiddleware(displayName, cm.Wrap)
 	m.emitEvent(context.Background(), EventTypeMiddlewareAdded, map[string]interface{}{
 		"middleware_count": 1,
 		"total_middleware": len(m.router.Middlewares()),
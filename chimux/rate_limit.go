@@ -0,0 +1,347 @@
+package chimux
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/go-chi/chi/v5"
+)
+
+// Rate limit key extractors for RateLimitOptions.KeyBy
+const (
+	// RateLimitKeyIP limits each client IP address separately
+	RateLimitKeyIP = "ip"
+	// RateLimitKeyHeader limits each value of RateLimitOptions.Header separately
+	RateLimitKeyHeader = "header"
+	// RateLimitKeyTenant limits each tenant separately
+	RateLimitKeyTenant = "tenant"
+)
+
+// rateLimitSweepInterval is how often idle buckets are discarded
+const rateLimitSweepInterval = time.Minute
+
+// maxRateLimitBuckets caps the buckets a limiter tracks. Once reached, the
+// least recently used bucket is evicted to make room for a new key.
+const maxRateLimitBuckets = 10000
+
+// RateLimitOptions configures the RateLimit middleware. Each key gets its own
+// token bucket holding up to Burst tokens and refilled at RequestsPerSecond;
+// a request is allowed if it can take a token.
+//
+// Example YAML configuration, as an entry of ChiMuxConfig.RateLimits:
+//
+//	rate_limits:
+//	  "POST /login":
+//	    requests_per_second: 0.5
+//	    burst: 5
+//	    key_by: ip
+//	    trusted_proxies: ["10.0.0.0/8"]
+type RateLimitOptions struct {
+	// RequestsPerSecond is the steady-state rate at which each key may make
+	// requests. Fractional values allow rates slower than one per second.
+	RequestsPerSecond float64 `yaml:"requests_per_second" desc:"Steady-state requests per second allowed for each key."`
+
+	// Burst is the number of requests a key may make at once before being
+	// limited to RequestsPerSecond.
+	// Default: RequestsPerSecond rounded up, and at least 1
+	Burst int `yaml:"burst" desc:"Maximum number of requests allowed in a burst."`
+
+	// KeyBy selects how requests are grouped: "ip" (default), "header" or
+	// "tenant". Requests without a header or tenant value fall back to the
+	// client IP. The tenant is the one resolved into the request context by
+	// tenant middleware; client-supplied tenant headers are never trusted.
+	KeyBy string `yaml:"key_by" desc:"How requests are grouped for limiting: ip, header or tenant."`
+
+	// Header is the request header to key on when KeyBy is "header".
+	Header string `yaml:"header" desc:"Request header used as the rate limit key."`
+
+	// TrustedProxies lists the CIDRs of proxies whose X-Forwarded-For header
+	// is trusted when determining the client IP. X-Forwarded-For is ignored
+	// for requests from any other address.
+	TrustedProxies []string `yaml:"trusted_proxies" desc:"CIDRs of proxies whose X-Forwarded-For header is trusted."`
+
+	// KeyFunc, if set, overrides KeyBy with a custom key extractor
+	KeyFunc func(r *http.Request) string `yaml:"-"`
+}
+
+// tokenBucket tracks the tokens available to a single key
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter implements token-bucket rate limiting for one set of options
+type rateLimiter struct {
+	rate    float64
+	burst   float64
+	key     func(*http.Request) string
+	trusted []*net.IPNet
+	now     func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+// RateLimit returns middleware that limits how often each client may make
+// requests. Requests over the limit receive 429 Too Many Requests with a
+// Retry-After header giving the seconds until a token is available.
+//
+// Example:
+//
+//	limit, err := chimux.RateLimit(chimux.RateLimitOptions{RequestsPerSecond: 10, Burst: 20})
+//	if err != nil {
+//	    return err
+//	}
+//	router.With(limit).Post("/login", loginHandler)
+func RateLimit(opts RateLimitOptions) (Middleware, error) {
+	limiter, err := newRateLimiter(opts)
+	if err != nil {
+		return nil, err
+	}
+	return limiter.middleware, nil
+}
+
+// newRateLimiter validates opts and creates a limiter for them
+func newRateLimiter(opts RateLimitOptions) (*rateLimiter, error) {
+	if opts.RequestsPerSecond <= 0 || math.IsInf(opts.RequestsPerSecond, 0) || math.IsNaN(opts.RequestsPerSecond) {
+		return nil, fmt.Errorf("%w: requests_per_second must be positive, got %v", ErrInvalidRateLimit, opts.RequestsPerSecond)
+	}
+	if opts.Burst < 0 {
+		return nil, fmt.Errorf("%w: burst must not be negative, got %d", ErrInvalidRateLimit, opts.Burst)
+	}
+
+	limiter := &rateLimiter{
+		rate:    opts.RequestsPerSecond,
+		burst:   float64(opts.Burst),
+		now:     time.Now,
+		buckets: make(map[string]*tokenBucket),
+	}
+	if opts.Burst == 0 {
+		limiter.burst = math.Max(1, math.Ceil(opts.RequestsPerSecond))
+	}
+
+	for _, cidr := range opts.TrustedProxies {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrInvalidTrustedProxy, cidr, err)
+		}
+		limiter.trusted = append(limiter.trusted, network)
+	}
+
+	switch {
+	case opts.KeyFunc != nil:
+		limiter.key = opts.KeyFunc
+	case opts.KeyBy == "" || opts.KeyBy == RateLimitKeyIP:
+		limiter.key = limiter.clientIP
+	case opts.KeyBy == RateLimitKeyHeader:
+		if opts.Header == "" {
+			return nil, fmt.Errorf("%w: header is required when keying by header", ErrInvalidRateLimit)
+		}
+		header := opts.Header
+		limiter.key = func(r *http.Request) string {
+			if value := r.Header.Get(header); value != "" {
+				return "header:" + value
+			}
+			return limiter.clientIP(r)
+		}
+	case opts.KeyBy == RateLimitKeyTenant:
+		limiter.key = func(r *http.Request) string {
+			if tenantID, ok := modular.GetTenantIDFromContext(r.Context()); ok && tenantID != "" {
+				return "tenant:" + string(tenantID)
+			}
+			return limiter.clientIP(r)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownRateLimitKey, opts.KeyBy)
+	}
+	return limiter, nil
+}
+
+// middleware rejects requests whose key has no tokens left
+func (l *rateLimiter) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !l.allowRequest(w, r) {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allowRequest takes a token for r, or writes a 429 response and returns false
+func (l *rateLimiter) allowRequest(w http.ResponseWriter, r *http.Request) bool {
+	allowed, retryAfter := l.allow(l.key(r))
+	if allowed {
+		return true
+	}
+	seconds := max(1, int(math.Ceil(retryAfter.Seconds())))
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+	return false
+}
+
+// allow takes a token from key's bucket. If none is available it reports
+// how long until one will be.
+func (l *rateLimiter) allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxRateLimitBuckets {
+			l.evictOldest()
+		}
+		bucket = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = bucket
+	} else {
+		elapsed := now.Sub(bucket.last).Seconds()
+		bucket.tokens = math.Min(l.burst, bucket.tokens+elapsed*l.rate)
+		bucket.last = now
+	}
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - bucket.tokens) / l.rate * float64(time.Second))
+}
+
+// sweep discards buckets that have refilled completely, since a new bucket
+// would be identical. Callers must hold l.mu.
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, bucket := range l.buckets {
+		if bucket.tokens+now.Sub(bucket.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// evictOldest discards the least recently used bucket. Callers must hold l.mu.
+func (l *rateLimiter) evictOldest() {
+	var oldestKey string
+	var oldest time.Time
+	for key, bucket := range l.buckets {
+		if oldestKey == "" || bucket.last.Before(oldest) {
+			oldestKey, oldest = key, bucket.last
+		}
+	}
+	delete(l.buckets, oldestKey)
+}
+
+// clientIP returns the IP address of the client that made r. The
+// X-Forwarded-For header is only consulted when the connection comes from a
+// trusted proxy, in which case the right-most address not belonging to a
+// trusted proxy is used.
+func (l *rateLimiter) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !l.isTrusted(host) {
+		return "ip:" + host
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(forwarded[i])
+		if addr == "" {
+			continue
+		}
+		host = addr
+		if !l.isTrusted(addr) {
+			break
+		}
+	}
+	return "ip:" + host
+}
+
+// isTrusted reports whether addr belongs to a trusted proxy
+func (l *rateLimiter) isTrusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range l.trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// routeRateLimiters applies the limits configured in ChiMuxConfig.RateLimits
+type routeRateLimiters struct {
+	router   *chi.Mux
+	basePath string
+	limiters map[string]*rateLimiter
+}
+
+// newRouteRateLimiters creates limiters for the configured route limits.
+// Route patterns are matched without basePath.
+func newRouteRateLimiters(router *chi.Mux, basePath string, limits map[string]RateLimitOptions) (*routeRateLimiters, error) {
+	routes := &routeRateLimiters{router: router, basePath: basePath, limiters: make(map[string]*rateLimiter, len(limits))}
+	for route, opts := range limits {
+		limiter, err := newRateLimiter(opts)
+		if err != nil {
+			return nil, fmt.Errorf("rate limit for route %q: %w", route, err)
+		}
+		routes.limiters[normalizeRateLimitRoute(route)] = limiter
+	}
+	return routes, nil
+}
+
+// normalizeRateLimitRoute canonicalizes a RateLimits key to "METHOD /pattern" or "/pattern"
+func normalizeRateLimitRoute(route string) string {
+	method, pattern, found := strings.Cut(strings.TrimSpace(route), " ")
+	if !found {
+		return method
+	}
+	return strings.ToUpper(method) + " " + strings.TrimSpace(pattern)
+}
+
+// middleware limits each request using the limiter for the route it matches
+func (rl *routeRateLimiters) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if limiter := rl.limiterFor(r); limiter != nil && !limiter.allowRequest(w, r) {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// limiterFor returns the most specific limiter for the route r matches:
+// one for its method and pattern, then one for its pattern, then the "*" default.
+// BasePath is stripped when the request reaches the router with it still present.
+func (rl *routeRateLimiters) limiterFor(r *http.Request) *rateLimiter {
+	pattern := rl.router.Find(chi.NewRouteContext(), r.Method, r.URL.Path)
+	if pattern == "" && rl.basePath != "" && strings.HasPrefix(r.URL.Path, rl.basePath) {
+		path := strings.TrimPrefix(r.URL.Path, rl.basePath)
+		if path == "" {
+			path = "/"
+		}
+		pattern = rl.router.Find(chi.NewRouteContext(), r.Method, path)
+	}
+	if pattern != "" {
+		if limiter, ok := rl.limiters[r.Method+" "+pattern]; ok {
+			return limiter
+		}
+		if limiter, ok := rl.limiters[pattern]; ok {
+			return limiter
+		}
+	}
+	return rl.limiters["*"]
+}
@@ -0,0 +1,231 @@
+package chimux
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a manually advanced clock for rate limiter tests
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestRateLimiter(t *testing.T, opts RateLimitOptions) (http.Handler, *fakeClock) {
+	t.Helper()
+	limiter, err := newRateLimiter(opts)
+	require.NoError(t, err)
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+	limiter.now = clock.Now
+	handler := limiter.middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	return handler, clock
+}
+
+// doRequest sends a GET from remoteAddr with optional extra headers
+func doRequest(handler http.Handler, remoteAddr string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = remoteAddr
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
+func TestRateLimit_Burst(t *testing.T) {
+	handler, _ := newTestRateLimiter(t, RateLimitOptions{RequestsPerSecond: 1, Burst: 3})
+
+	for i := range 3 {
+		assert.Equal(t, http.StatusOK, doRequest(handler, "192.0.2.1:1000", nil).Code, "request %d should be within the burst", i+1)
+	}
+	w := doRequest(handler, "192.0.2.1:1000", nil)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+}
+
+func TestRateLimit_SteadyStateRefill(t *testing.T) {
+	handler, clock := newTestRateLimiter(t, RateLimitOptions{RequestsPerSecond: 0.25, Burst: 1})
+
+	require.Equal(t, http.StatusOK, doRequest(handler, "192.0.2.1:1000", nil).Code)
+	w := doRequest(handler, "192.0.2.1:1000", nil)
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "4", w.Header().Get("Retry-After"))
+
+	clock.Advance(3 * time.Second)
+	w = doRequest(handler, "192.0.2.1:1000", nil)
+	require.Equal(t, http.StatusTooManyRequests, w.Code, "bucket should not have refilled yet")
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+
+	// One request every four seconds is sustainable indefinitely
+	for i := range 5 {
+		clock.Advance(4 * time.Second)
+		assert.Equal(t, http.StatusOK, doRequest(handler, "192.0.2.1:1000", nil).Code, "steady-state request %d", i+1)
+	}
+
+	// Idle time never accumulates more than the burst
+	clock.Advance(time.Hour)
+	assert.Equal(t, http.StatusOK, doRequest(handler, "192.0.2.1:1000", nil).Code)
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(handler, "192.0.2.1:1000", nil).Code)
+}
+
+func TestRateLimit_KeyIsolation(t *testing.T) {
+	t.Run("ip", func(t *testing.T) {
+		handler, _ := newTestRateLimiter(t, RateLimitOptions{RequestsPerSecond: 1, Burst: 1})
+		assert.Equal(t, http.StatusOK, doRequest(handler, "192.0.2.1:1000", nil).Code)
+		assert.Equal(t, http.StatusTooManyRequests, doRequest(handler, "192.0.2.1:2000", nil).Code, "ports of one IP share a bucket")
+		assert.Equal(t, http.StatusOK, doRequest(handler, "192.0.2.2:1000", nil).Code)
+	})
+
+	t.Run("header", func(t *testing.T) {
+		handler, _ := newTestRateLimiter(t, RateLimitOptions{RequestsPerSecond: 1, Burst: 1, KeyBy: RateLimitKeyHeader, Header: "X-API-Key"})
+		assert.Equal(t, http.StatusOK, doRequest(handler, "192.0.2.1:1000", map[string]string{"X-API-Key": "a"}).Code)
+		assert.Equal(t, http.StatusTooManyRequests, doRequest(handler, "192.0.2.2:1000", map[string]string{"X-API-Key": "a"}).Code)
+		assert.Equal(t, http.StatusOK, doRequest(handler, "192.0.2.1:1000", map[string]string{"X-API-Key": "b"}).Code)
+		// Without the header the client IP is used
+		assert.Equal(t, http.StatusOK, doRequest(handler, "192.0.2.1:1000", nil).Code)
+	})
+
+	t.Run("tenant", func(t *testing.T) {
+		handler, _ := newTestRateLimiter(t, RateLimitOptions{RequestsPerSecond: 1, Burst: 1, KeyBy: RateLimitKeyTenant})
+		tenantRequest := func(tenant modular.TenantID) int {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req = req.WithContext(modular.NewTenantContext(req.Context(), tenant))
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			return w.Code
+		}
+		assert.Equal(t, http.StatusOK, tenantRequest("tenant-a"))
+		assert.Equal(t, http.StatusTooManyRequests, tenantRequest("tenant-a"))
+		assert.Equal(t, http.StatusOK, tenantRequest("tenant-b"))
+		// A client-supplied tenant header is not trusted: without a resolved
+		// tenant the client IP is used, so rotating the header does not help
+		assert.Equal(t, http.StatusOK, doRequest(handler, "192.0.2.9:1000", map[string]string{"X-Tenant-ID": "tenant-b"}).Code)
+		assert.Equal(t, http.StatusTooManyRequests, doRequest(handler, "192.0.2.9:1000", map[string]string{"X-Tenant-ID": "tenant-c"}).Code)
+	})
+}
+
+func TestRateLimit_TrustedProxies(t *testing.T) {
+	t.Run("untrusted remote ignores X-Forwarded-For", func(t *testing.T) {
+		handler, _ := newTestRateLimiter(t, RateLimitOptions{RequestsPerSecond: 1, Burst: 1, TrustedProxies: []string{"10.0.0.0/8"}})
+		assert.Equal(t, http.StatusOK, doRequest(handler, "192.0.2.1:1000", map[string]string{"X-Forwarded-For": "198.51.100.1"}).Code)
+		// A spoofed header does not earn a fresh bucket
+		assert.Equal(t, http.StatusTooManyRequests, doRequest(handler, "192.0.2.1:1000", map[string]string{"X-Forwarded-For": "198.51.100.2"}).Code)
+	})
+
+	t.Run("trusted proxy forwards client IP", func(t *testing.T) {
+		handler, _ := newTestRateLimiter(t, RateLimitOptions{RequestsPerSecond: 1, Burst: 1, TrustedProxies: []string{"10.0.0.0/8"}})
+		assert.Equal(t, http.StatusOK, doRequest(handler, "10.0.0.1:1000", map[string]string{"X-Forwarded-For": "198.51.100.1"}).Code)
+		assert.Equal(t, http.StatusOK, doRequest(handler, "10.0.0.1:1000", map[string]string{"X-Forwarded-For": "198.51.100.2"}).Code)
+		assert.Equal(t, http.StatusTooManyRequests, doRequest(handler, "10.0.0.2:1000", map[string]string{"X-Forwarded-For": "198.51.100.1"}).Code)
+	})
+
+	t.Run("client-supplied entries before the proxy chain are ignored", func(t *testing.T) {
+		handler, _ := newTestRateLimiter(t, RateLimitOptions{RequestsPerSecond: 1, Burst: 1, TrustedProxies: []string{"10.0.0.0/8"}})
+		assert.Equal(t, http.StatusOK, doRequest(handler, "10.0.0.1:1000", map[string]string{"X-Forwarded-For": "203.0.113.7, 198.51.100.1, 10.0.0.5"}).Code)
+		assert.Equal(t, http.StatusTooManyRequests, doRequest(handler, "10.0.0.1:1000", map[string]string{"X-Forwarded-For": "203.0.113.8, 198.51.100.1"}).Code)
+	})
+}
+
+func TestRateLimit_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts RateLimitOptions
+		err  error
+	}{
+		{"zero rate", RateLimitOptions{}, ErrInvalidRateLimit},
+		{"negative burst", RateLimitOptions{RequestsPerSecond: 1, Burst: -1}, ErrInvalidRateLimit},
+		{"header without name", RateLimitOptions{RequestsPerSecond: 1, KeyBy: RateLimitKeyHeader}, ErrInvalidRateLimit},
+		{"unknown key", RateLimitOptions{RequestsPerSecond: 1, KeyBy: "cookie"}, ErrUnknownRateLimitKey},
+		{"bad CIDR", RateLimitOptions{RequestsPerSecond: 1, TrustedProxies: []string{"10.0.0.1"}}, ErrInvalidTrustedProxy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RateLimit(tt.opts)
+			assert.ErrorIs(t, err, tt.err)
+
+			cfg := &ChiMuxConfig{RateLimits: map[string]RateLimitOptions{"/": tt.opts}}
+			assert.ErrorIs(t, cfg.Validate(), tt.err)
+		})
+	}
+}
+
+func TestRateLimit_PerRouteConfig(t *testing.T) {
+	module := NewChiMuxModule().(*ChiMuxModule)
+	mockApp := NewMockApplication()
+	require.NoError(t, module.RegisterConfig(mockApp))
+	provider, err := mockApp.GetConfigSection(module.Name())
+	require.NoError(t, err)
+	provider.GetConfig().(*ChiMuxConfig).RateLimits = map[string]RateLimitOptions{
+		"*":           {RequestsPerSecond: 1, Burst: 2},
+		"post /login": {RequestsPerSecond: 1, Burst: 1},
+	}
+	require.NoError(t, module.Init(mockApp))
+
+	ok := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+	module.Post("/login", ok)
+	module.Get("/login", ok)
+	module.Get("/users/{id}", ok)
+
+	request := func(method, path string) int {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = "192.0.2.1:1000"
+		w := httptest.NewRecorder()
+		module.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, request(http.MethodPost, "/login"))
+	assert.Equal(t, http.StatusTooManyRequests, request(http.MethodPost, "/login"))
+
+	// Routes without their own entry share the default limit
+	assert.Equal(t, http.StatusOK, request(http.MethodGet, "/users/1"))
+	assert.Equal(t, http.StatusOK, request(http.MethodGet, "/users/2"))
+	assert.Equal(t, http.StatusTooManyRequests, request(http.MethodGet, "/login"))
+}
+
+func TestRateLimit_BucketsAreBounded(t *testing.T) {
+	limiter, err := newRateLimiter(RateLimitOptions{RequestsPerSecond: 0.001, Burst: 1})
+	require.NoError(t, err)
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+	limiter.now = clock.Now
+
+	for i := range maxRateLimitBuckets + 10 {
+		clock.Advance(time.Millisecond)
+		allowed, _ := limiter.allow(fmt.Sprintf("ip:client-%d", i))
+		require.True(t, allowed)
+	}
+	assert.Len(t, limiter.buckets, maxRateLimitBuckets)
+	assert.NotContains(t, limiter.buckets, "ip:client-0", "least recently used bucket should be evicted first")
+	assert.Contains(t, limiter.buckets, fmt.Sprintf("ip:client-%d", maxRateLimitBuckets+9))
+}
+
+func TestRateLimit_PerRouteConfigWithBasePath(t *testing.T) {
+	router := chi.NewRouter()
+	ok := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+	router.Post("/login", ok)
+	router.Get("/users/{id}", ok)
+
+	limiters, err := newRouteRateLimiters(router, "/api", map[string]RateLimitOptions{
+		"*":           {RequestsPerSecond: 1, Burst: 2},
+		"POST /login": {RequestsPerSecond: 1, Burst: 1},
+	})
+	require.NoError(t, err)
+
+	login := httptest.NewRequest(http.MethodPost, "/api/login", nil)
+	assert.Same(t, limiters.limiters["POST /login"], limiters.limiterFor(login))
+	stripped := httptest.NewRequest(http.MethodPost, "/login", nil)
+	assert.Same(t, limiters.limiters["POST /login"], limiters.limiterFor(stripped))
+	users := httptest.NewRequest(http.MethodGet, "/api/users/1", nil)
+	assert.Same(t, limiters.limiters["*"], limiters.limiterFor(users))
+}
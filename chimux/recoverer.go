@@ -0,0 +1,116 @@
+package chimux
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"strings"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/go-chi/chi/v5"
+)
+
+// redactedValue replaces the values of sensitive headers in panic events
+const redactedValue = "[REDACTED]"
+
+// sensitiveHeaders are the request headers whose values are redacted from
+// panic events
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Api-Key":           true,
+	"X-Auth-Token":        true,
+	"X-Csrf-Token":        true,
+}
+
+// Recoverer returns middleware that recovers from panics in later handlers so
+// that one failing request does not take down the goroutine serving it. The
+// panic is logged at Error level with its stack trace, the client receives
+// 500 Internal Server Error, and, if subject is not nil, an
+// EventTypeHTTPPanic event is emitted with the matched route and the
+// request's method, path, request ID and headers. Credentials, cookies and
+// API key headers are redacted, and the query string is left out.
+//
+// Register it first so that it also covers the other middleware; it
+// recovers panics from routes in nested groups and mounted subrouters too.
+// http.ErrAbortHandler panics are passed on, as they are used to abort a
+// response deliberately.
+//
+// Example:
+//
+//	router.Use(chimux.Recoverer(app.Logger(), app))
+func Recoverer(logger modular.Logger, subject modular.Subject) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				recovered := recover()
+				if recovered == nil {
+					return
+				}
+				if recovered == http.ErrAbortHandler { //nolint:errorlint // the sentinel is panicked as is, never wrapped
+					panic(recovered)
+				}
+
+				route := routePattern(r)
+				if logger != nil {
+					logger.Error("Recovered from panic in HTTP handler",
+						"panic", fmt.Sprint(recovered), "method", r.Method, "route", route, "stack", string(debug.Stack()))
+				}
+				if subject != nil {
+					event := modular.NewCloudEvent(EventTypeHTTPPanic, "chimux-service", panicEventData(r, route, recovered), nil)
+					// The request context may already be cancelled; the event should still be delivered
+					if err := subject.NotifyObservers(context.WithoutCancel(r.Context()), event); err != nil && logger != nil {
+						logger.Debug("Failed to emit panic event", "error", err)
+					}
+				}
+
+				// An upgraded connection has no HTTP response to write to
+				if !strings.EqualFold(r.Header.Get("Connection"), "upgrade") {
+					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				}
+			}()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// routePattern returns the chi route pattern matched by r, or its path when
+// no route was matched
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		if pattern := rctx.RoutePattern(); pattern != "" {
+			return pattern
+		}
+	}
+	return r.URL.Path
+}
+
+// panicEventData builds the payload of a panic event with sensitive request
+// headers redacted
+func panicEventData(r *http.Request, route string, recovered any) map[string]interface{} {
+	headers := make(map[string]string, len(r.Header))
+	for name, values := range r.Header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			headers[name] = redactedValue
+			continue
+		}
+		headers[name] = strings.Join(values, ", ")
+	}
+	data := map[string]interface{}{
+		"panic":   fmt.Sprint(recovered),
+		"route":   route,
+		"method":  r.Method,
+		"path":    r.URL.Path,
+		"headers": headers,
+	}
+	if id, ok := RequestIDFromContext(r.Context()); ok {
+		data["request_id"] = id
+	}
+	if tenantID, ok := modular.GetTenantIDFromContext(r.Context()); ok {
+		data["tenant_id"] = string(tenantID)
+	}
+	return data
+}
@@ -0,0 +1,101 @@
+package chimux
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/GoCodeAlone/modular"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSubject records the events it is notified of
+type recordingSubject struct {
+	mu     sync.Mutex
+	events []cloudevents.Event
+}
+
+func (s *recordingSubject) RegisterObserver(modular.Observer, ...string) error { return nil }
+func (s *recordingSubject) UnregisterObserver(modular.Observer) error          { return nil }
+func (s *recordingSubject) GetObservers() []modular.ObserverInfo               { return nil }
+
+func (s *recordingSubject) NotifyObservers(_ context.Context, event cloudevents.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+// errorCaptureLogger records the arguments of Error calls
+type errorCaptureLogger struct {
+	MockLogger
+	errors [][]any
+}
+
+func (l *errorCaptureLogger) Error(msg string, args ...any) {
+	l.errors = append(l.errors, append([]any{msg}, args...))
+}
+
+func TestRecoverer_NestedGroupPanic(t *testing.T) {
+	logger := &errorCaptureLogger{}
+	subject := &recordingSubject{}
+
+	router := chi.NewRouter()
+	router.Use(RequestID(""), Recoverer(logger, subject))
+	router.Route("/api", func(api chi.Router) {
+		api.Group(func(g chi.Router) {
+			g.Group(func(inner chi.Router) {
+				inner.Get("/items/{id}", func(http.ResponseWriter, *http.Request) {
+					panic("boom")
+				})
+			})
+		})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/items/42?token=secret", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set("Accept", "application/json")
+	w := httptest.NewRecorder()
+	require.NotPanics(t, func() { router.ServeHTTP(w, req) })
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	require.Len(t, logger.errors, 1)
+	assert.Contains(t, logger.errors[0], "boom")
+	assert.Contains(t, logger.errors[0], "stack")
+
+	require.Len(t, subject.events, 1)
+	event := subject.events[0]
+	assert.Equal(t, EventTypeHTTPPanic, event.Type())
+	var data struct {
+		Panic     string            `json:"panic"`
+		Route     string            `json:"route"`
+		Method    string            `json:"method"`
+		Path      string            `json:"path"`
+		RequestID string            `json:"request_id"`
+		Headers   map[string]string `json:"headers"`
+	}
+	require.NoError(t, event.DataAs(&data))
+	assert.Equal(t, "boom", data.Panic)
+	assert.Equal(t, "/api/items/{id}", data.Route)
+	assert.Equal(t, http.MethodGet, data.Method)
+	assert.Equal(t, "/api/items/42", data.Path)
+	assert.Equal(t, w.Header().Get(DefaultRequestIDHeader), data.RequestID)
+	assert.Equal(t, redactedValue, data.Headers["Authorization"])
+	assert.Equal(t, "application/json", data.Headers["Accept"])
+	assert.NotContains(t, string(event.Data()), "secret")
+}
+
+func TestRecoverer_PassesAbortHandler(t *testing.T) {
+	handler := Recoverer(nil, nil)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
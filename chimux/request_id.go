@@ -0,0 +1,102 @@
+package chimux
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+// DefaultRequestIDHeader is the header RequestID reads and writes when no
+// header name is given
+const DefaultRequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds incoming request IDs so oversized values are not
+// copied into logs and forwarded to backends
+const maxRequestIDLength = 128
+
+// requestIDContextKey is the context key under which RequestID stores the ID
+type requestIDContextKey struct{}
+
+// RequestID returns middleware that gives every request a stable ID across
+// proxy hops. The ID is read from headerName (default X-Request-ID), or
+// generated if the request has none. It is stored in the request context,
+// set on the request header so proxies such as the reverseproxy module
+// forward it to backends, and echoed in the response header.
+//
+// Incoming IDs longer than 128 characters or containing anything other than
+// printable ASCII are replaced with a generated ID.
+//
+// Example:
+//
+//	router.Use(chimux.RequestID(""))
+//	router.Get("/", func(w http.ResponseWriter, r *http.Request) {
+//	    id, _ := chimux.RequestIDFromContext(r.Context())
+//	    logger.Info("Handling request", "request_id", id)
+//	})
+func RequestID(headerName string) Middleware {
+	if headerName == "" {
+		headerName = DefaultRequestIDHeader
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(headerName)
+			if !validRequestID(id) {
+				id = uuid.NewString()
+				r.Header.Set(headerName, id)
+			}
+			w.Header().Set(headerName, id)
+
+			ctx := context.WithValue(r.Context(), requestIDContextKey{}, id)
+			// Keep chi's request logger and middleware.GetReqID in agreement
+			ctx = context.WithValue(ctx, middleware.RequestIDKey, id)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequestIDFromContext returns the request ID stored by the RequestID middleware
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDContextKey{}).(string)
+	return id, ok && id != ""
+}
+
+// RequestIDLogFields logs the request ID stored by the RequestID middleware as
+// "request_id". It can be combined with other extractors in
+// modular.NewContextLoggerDecorator.
+func RequestIDLogFields(ctx context.Context) []any {
+	if id, ok := RequestIDFromContext(ctx); ok {
+		return []any{"request_id", id}
+	}
+	return nil
+}
+
+// NewRequestLogger wraps logger so that modular.LoggerWithContext adds the
+// request ID, trace ID and tenant ID found in a request context to every log
+// call.
+//
+// Example:
+//
+//	logger := chimux.NewRequestLogger(app.Logger())
+//	router.Get("/", func(w http.ResponseWriter, r *http.Request) {
+//	    modular.LoggerWithContext(r.Context(), logger).Info("Handling request")
+//	})
+func NewRequestLogger(logger modular.Logger) *modular.ContextLoggerDecorator {
+	return modular.NewContextLoggerDecorator(logger,
+		RequestIDLogFields, modular.TraceIDLogFields, modular.TenantIDLogFields)
+}
+
+// validRequestID reports whether id is a non-empty, bounded, printable ASCII value
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
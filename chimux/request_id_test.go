@@ -0,0 +1,123 @@
+package chimux
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// requestIDCapture records what the wrapped handler observed
+type requestIDCapture struct {
+	contextID string
+	found     bool
+	headerID  string
+	chiID     string
+}
+
+func serveWithRequestID(headerName string, incoming map[string]string) (*httptest.ResponseRecorder, *requestIDCapture) {
+	capture := &requestIDCapture{}
+	handler := RequestID(headerName)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capture.contextID, capture.found = RequestIDFromContext(r.Context())
+		header := headerName
+		if header == "" {
+			header = DefaultRequestIDHeader
+		}
+		capture.headerID = r.Header.Get(header)
+		capture.chiID = middleware.GetReqID(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	for name, value := range incoming {
+		req.Header.Set(name, value)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w, capture
+}
+
+func TestRequestID_PassThrough(t *testing.T) {
+	w, capture := serveWithRequestID("", map[string]string{"X-Request-ID": "abc-123"})
+
+	assert.Equal(t, "abc-123", w.Header().Get("X-Request-ID"))
+	assert.True(t, capture.found)
+	assert.Equal(t, "abc-123", capture.contextID)
+	assert.Equal(t, "abc-123", capture.headerID)
+	assert.Equal(t, "abc-123", capture.chiID)
+}
+
+func TestRequestID_Generation(t *testing.T) {
+	w, capture := serveWithRequestID("", nil)
+
+	id := w.Header().Get("X-Request-ID")
+	_, err := uuid.Parse(id)
+	require.NoError(t, err, "generated ID should be a UUID")
+	assert.Equal(t, id, capture.contextID)
+	assert.Equal(t, id, capture.headerID, "generated ID should be set on the request so proxies forward it")
+
+	w2, _ := serveWithRequestID("", nil)
+	assert.NotEqual(t, id, w2.Header().Get("X-Request-ID"), "each request should get its own ID")
+}
+
+func TestRequestID_ReplacesInvalidIDs(t *testing.T) {
+	for name, incoming := range map[string]string{
+		"too long":       strings.Repeat("a", maxRequestIDLength+1),
+		"contains space": "abc 123",
+	} {
+		t.Run(name, func(t *testing.T) {
+			w, capture := serveWithRequestID("", map[string]string{"X-Request-ID": incoming})
+			id := w.Header().Get("X-Request-ID")
+			assert.NotEqual(t, incoming, id)
+			_, err := uuid.Parse(id)
+			assert.NoError(t, err)
+			assert.Equal(t, id, capture.headerID)
+		})
+	}
+}
+
+func TestRequestID_CustomHeader(t *testing.T) {
+	w, capture := serveWithRequestID("X-Correlation-ID", map[string]string{
+		"X-Correlation-ID": "corr-1",
+		"X-Request-ID":     "ignored",
+	})
+
+	assert.Equal(t, "corr-1", w.Header().Get("X-Correlation-ID"))
+	assert.Empty(t, w.Header().Get("X-Request-ID"))
+	assert.Equal(t, "corr-1", capture.contextID)
+}
+
+func TestRequestIDFromContext_Missing(t *testing.T) {
+	id, ok := RequestIDFromContext(httptest.NewRequest(http.MethodGet, "/", nil).Context())
+	assert.False(t, ok)
+	assert.Empty(t, id)
+}
+
+// fieldCaptureLogger records the arguments of the last log call
+type fieldCaptureLogger struct {
+	MockLogger
+	args []any
+}
+
+func (l *fieldCaptureLogger) Info(msg string, args ...any) { l.args = args }
+
+func TestNewRequestLogger_InjectsRequestID(t *testing.T) {
+	inner := &fieldCaptureLogger{}
+	logger := NewRequestLogger(inner)
+
+	handler := RequestID("")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := modular.WithTraceID(r.Context(), "trace-1")
+		modular.LoggerWithContext(ctx, logger).Info("Handling request", "path", r.URL.Path)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/orders", nil)
+	req.Header.Set(DefaultRequestIDHeader, "req-42")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, []any{"request_id", "req-42", "trace_id", "trace-1", "path", "/orders"}, inner.args)
+}
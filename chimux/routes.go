@@ -0,0 +1,98 @@
+package chimux
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// RouteInfo describes a route registered with the router
+type RouteInfo struct {
+	// Method is the HTTP method the route handles
+	Method string `json:"method"`
+	// Pattern is the full route pattern as registered, without BasePath
+	Pattern string `json:"pattern"`
+	// Middlewares names the middleware applied to the route, outermost first
+	Middlewares []string `json:"middlewares"`
+}
+
+// RouteTable returns every route registered with the router, including routes
+// added through Route, Group, Mount and the chi router directly, sorted by
+// pattern and then method.
+//
+// Routes returns the same routes as chi's nested route tree; RouteTable
+// flattens it for introspection, e.g. to verify routing configuration.
+func (m *ChiMuxModule) RouteTable() []RouteInfo {
+	if m.router == nil {
+		return nil
+	}
+
+	rootMiddlewares := len(m.router.Middlewares())
+	m.middlewareMu.RLock()
+	defer m.middlewareMu.RUnlock()
+
+	var routes []RouteInfo
+	_ = chi.Walk(m.router, func(method, route string, _ http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		info := RouteInfo{Method: method, Pattern: route, Middlewares: make([]string, 0, len(middlewares))}
+		for i, mw := range middlewares {
+			name, ok := m.routerMiddlewareNames[i]
+			if !ok || i >= rootMiddlewares {
+				name = middlewareName(mw)
+			}
+			info.Middlewares = append(info.Middlewares, name)
+		}
+		routes = append(routes, info)
+		return nil
+	})
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Pattern != routes[j].Pattern {
+			return routes[i].Pattern < routes[j].Pattern
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes
+}
+
+// RouteTableHandler returns a handler that serves the route table as JSON,
+// suitable for mounting at a debug path:
+//
+//	mux.Get("/debug/routes", mux.RouteTableHandler())
+func (m *ChiMuxModule) RouteTableHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(m.RouteTable()); err != nil && m.logger != nil {
+			m.logger.Debug("Failed to encode route table", "error", err)
+		}
+	}
+}
+
+// useRouterMiddleware adds mw to the router under name, so RouteTable can
+// report it by name rather than by function
+func (m *ChiMuxModule) useRouterMiddleware(name string, mw func(http.Handler) http.Handler) {
+	m.middlewareMu.Lock()
+	if m.routerMiddlewareNames == nil {
+		m.routerMiddlewareNames = make(map[int]string)
+	}
+	m.routerMiddlewareNames[len(m.router.Middlewares())] = name
+	m.middlewareMu.Unlock()
+	m.router.Use(mw)
+}
+
+// middlewareName derives a readable name for mw from its function name
+func middlewareName(mw func(http.Handler) http.Handler) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(mw).Pointer())
+	if fn == nil {
+		return "unknown"
+	}
+	name := fn.Name()
+	if idx := strings.LastIndex(name, "/"); idx >= 0 {
+		name = name[idx+1:]
+	}
+	return name
+}
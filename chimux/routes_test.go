@@ -0,0 +1,74 @@
+package chimux
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func groupMiddleware(next http.Handler) http.Handler { return next }
+
+func TestRouteTable(t *testing.T) {
+	module := newPriorityTestModule(t)
+	ok := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+
+	module.UseNamed("auth", func(next http.Handler) http.Handler { return next })
+	module.Get("/users", ok)
+	module.Post("/users", ok)
+	module.Route("/api", func(r chi.Router) {
+		r.Get("/items/{id}", ok)
+	})
+	module.Group(func(r chi.Router) {
+		r.Use(groupMiddleware)
+		r.Delete("/admin", ok)
+	})
+	module.HandleFunc("/raw", ok)
+
+	routes := module.RouteTable()
+	byRoute := make(map[string]RouteInfo, len(routes))
+	for _, route := range routes {
+		byRoute[route.Method+" "+route.Pattern] = route
+	}
+
+	for _, key := range []string{"GET /users", "POST /users", "GET /api/items/{id}", "DELETE /admin", "GET /raw", "POST /raw"} {
+		assert.Contains(t, byRoute, key)
+	}
+	assert.NotContains(t, byRoute, "DELETE /users")
+
+	users := byRoute["GET /users"]
+	require.NotEmpty(t, users.Middlewares)
+	assert.Equal(t, "RequestID", users.Middlewares[0])
+	assert.Contains(t, users.Middlewares, "CORS")
+	assert.Equal(t, "auth", users.Middlewares[len(users.Middlewares)-1])
+
+	admin := byRoute["DELETE /admin"]
+	assert.Equal(t, append(append([]string{}, users.Middlewares...), "chimux.groupMiddleware"), admin.Middlewares)
+
+	for i := 1; i < len(routes); i++ {
+		prev, cur := routes[i-1], routes[i]
+		assert.True(t, prev.Pattern < cur.Pattern || (prev.Pattern == cur.Pattern && prev.Method <= cur.Method), "routes should be sorted")
+	}
+}
+
+func TestRouteTableHandler(t *testing.T) {
+	module := newPriorityTestModule(t)
+	module.Get("/users", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	module.Get("/debug/routes", module.RouteTableHandler())
+
+	w := httptest.NewRecorder()
+	module.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/routes", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	var routes []RouteInfo
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &routes))
+	require.Len(t, routes, 2)
+	assert.Equal(t, "/debug/routes", routes[0].Pattern)
+	assert.Equal(t, "/users", routes[1].Pattern)
+	assert.Equal(t, http.MethodGet, routes[1].Method)
+}
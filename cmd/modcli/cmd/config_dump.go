@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// NewConfigDumpCommand creates the command printing the effective configuration of an application
+func NewConfigDumpCommand() *cobra.Command {
+	var (
+		environment string
+		configFile  string
+		appPath     string
+		asJSON      bool
+		timeout     time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Print the effective configuration with the source of each value",
+		Long: `Print the configuration a modular application loads, after merging all of its
+feeders, with the feeder and source (file or environment variable) that set
+each field.
+
+With base config (SetBaseConfig), --env selects the environment whose
+overrides are merged over the base config, so the effective configuration of
+any environment can be inspected locally. Fields tagged secret:"true" are
+redacted.
+
+The application is run in config check mode, so no module is initialized.
+--app is the Go package of the application's main function, run with "go run",
+or the path of an already built application binary.
+
+Examples:
+  modcli config dump --env prod
+  modcli config dump --env staging --app ./cmd/server
+  modcli config dump --config local.yaml --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, err := runConfigCheck(configFile, appPath, environment, timeout)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			if asJSON {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(report); err != nil {
+					return err //nolint:wrapcheck // output errors are reported as is
+				}
+				return nil
+			}
+			printConfigDump(cmd, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&environment, "env", "e", "", "Base config environment to merge over the base config")
+	cmd.Flags().StringVarP(&configFile, "config", "c", "", "Extra config file fed after the application's feeders")
+	cmd.Flags().StringVarP(&appPath, "app", "a", ".", "Package of the application's main function, or an application binary")
+	cmd.Flags().BoolVar(&asJSON, "json", false, "Print the report as JSON")
+	cmd.Flags().DurationVar(&timeout, "timeout", 2*time.Minute, "Timeout for building and running the application")
+
+	return cmd
+}
+
+// printConfigDump writes the effective configuration, one field per line
+// followed by its source
+func printConfigDump(cmd *cobra.Command, report *ConfigCheckReport) {
+	out := cmd.OutOrStdout()
+	if report.Environment != "" {
+		fmt.Fprintf(out, "📄 Effective configuration (environment: %s)\n\n", report.Environment)
+	} else {
+		fmt.Fprintf(out, "📄 Effective configuration\n\n")
+	}
+
+	if len(report.Values) == 0 {
+		fmt.Fprintln(out, "No configuration fields are set")
+	}
+	for _, key := range slices.Sorted(maps.Keys(report.Values)) {
+		fmt.Fprintf(out, "%s = %v  (%s)\n", key, report.Values[key], describeConfigSource(report.Provenance, key))
+	}
+
+	if !report.Valid {
+		fmt.Fprintf(out, "\n⚠️  The configuration is invalid, %d problem(s) found:\n", len(report.Problems))
+		for _, problem := range report.Problems {
+			fmt.Fprintf(out, "  - %s\n", problem)
+		}
+	}
+}
+
+// describeConfigSource describes where the value of the field at key came from
+func describeConfigSource(provenance map[string]ConfigFieldProvenance, key string) string {
+	p, ok := provenance[key]
+	switch {
+	case !ok:
+		return "not set"
+	case p.Source != "":
+		return p.Source
+	default:
+		return p.Feeder
+	}
+}
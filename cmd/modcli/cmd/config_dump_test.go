@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// configDumpAppMain is an application using base config, with a secret password
+const configDumpAppMain = `package main
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/GoCodeAlone/modular"
+)
+
+type AppConfig struct {
+	Name     string ` + "`yaml:\"name\"`" + `
+	Database struct {
+		Host     string ` + "`yaml:\"host\"`" + `
+		Port     int    ` + "`yaml:\"port\"`" + `
+		Password string ` + "`yaml:\"password\" secret:\"true\"`" + `
+	} ` + "`yaml:\"database\"`" + `
+}
+
+func main() {
+	modular.SetBaseConfig("config", "dev")
+	app, err := modular.NewApplication(
+		modular.WithLogger(slog.New(slog.NewTextHandler(os.Stderr, nil))),
+		modular.WithConfigProvider(modular.NewStdConfigProvider(&AppConfig{})),
+		modular.WithConfigCheckMode(),
+	)
+	if err != nil {
+		os.Exit(1)
+	}
+	if err := app.Run(); err != nil {
+		os.Exit(1)
+	}
+}
+`
+
+func TestConfigDumpCommand(t *testing.T) {
+	appDir := newConfigCheckApp(t, configDumpAppMain)
+	files := map[string]string{
+		"config/base/default.yaml":                "name: shop\ndatabase:\n  host: localhost\n  port: 5432\n  password: dev-password\n",
+		"config/environments/dev/overrides.yaml":  "database:\n  host: db.dev.internal\n",
+		"config/environments/prod/overrides.yaml": "database:\n  host: db.prod.internal\n  password: prod-password\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(appDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+	basePath := filepath.Join("config", "base", "default.yaml")
+	prodPath := filepath.Join("config", "environments", "prod", "overrides.yaml")
+
+	dump := func(t *testing.T, args ...string) string {
+		t.Helper()
+		cmd := NewRootCommand()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(append([]string{"config", "dump", "--app", appDir}, args...))
+		require.NoError(t, cmd.Execute(), out.String())
+		return out.String()
+	}
+
+	t.Run("overlay wins", func(t *testing.T) {
+		out := dump(t, "--env", "prod")
+		assert.Contains(t, out, "environment: prod")
+		assert.Contains(t, out, "Database.Host = db.prod.internal  ("+prodPath+")")
+		assert.Contains(t, out, "Database.Port = 5432  ("+basePath+")")
+		assert.Contains(t, out, "Name = shop  ("+basePath+")")
+		assert.Contains(t, out, "Database.Password = [REDACTED]  ("+prodPath+")")
+		assert.NotContains(t, out, "prod-password")
+	})
+
+	t.Run("JSON report", func(t *testing.T) {
+		out := dump(t, "--env", "prod", "--json")
+		assert.NotContains(t, out, "prod-password")
+		report := &ConfigCheckReport{}
+		require.NoError(t, json.Unmarshal([]byte(out), report))
+		assert.True(t, report.Valid)
+		assert.Equal(t, "db.prod.internal", report.Values["Database.Host"])
+		assert.Equal(t, prodPath, report.Provenance["Database.Host"].Source)
+		assert.Equal(t, "*feeders.BaseConfigFeeder", report.Provenance["Database.Host"].Feeder)
+	})
+
+	t.Run("application environment by default", func(t *testing.T) {
+		out := dump(t)
+		assert.Contains(t, out, "environment: dev")
+		assert.Contains(t, out, "Database.Host = db.dev.internal")
+	})
+}
@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// Environment variables of the modular config check mode, mirroring
+// modular.ConfigCheckEnv, modular.ConfigCheckReportEnv and
+// modular.ConfigCheckEnvironmentEnv
+const (
+	configCheckEnv            = "MODULAR_CONFIG_CHECK"
+	configCheckReportEnv      = "MODULAR_CONFIG_CHECK_REPORT"
+	configCheckEnvironmentEnv = "MODULAR_CONFIG_CHECK_ENVIRONMENT"
+)
+
+var (
+	// ErrConfigInvalid is returned when the checked config file has problems
+	ErrConfigInvalid = errors.New("configuration is invalid")
+	// ErrConfigCheckNoReport is returned when the application did not write a config check report
+	ErrConfigCheckNoReport = errors.New("application did not write a config check report")
+)
+
+// ConfigCheckReport is the report written by an application in config check
+// mode, as defined by modular.ConfigCheckReport
+type ConfigCheckReport struct {
+	ConfigFile  string                           `json:"config_file"`
+	Valid       bool                             `json:"valid"`
+	Sections    []string                         `json:"sections"`
+	Problems    []string                         `json:"problems,omitempty"`
+	Environment string                           `json:"environment,omitempty"`
+	Values      map[string]any                   `json:"values,omitempty"`
+	Provenance  map[string]ConfigFieldProvenance `json:"provenance,omitempty"`
+}
+
+// ConfigFieldProvenance tells which feeder and source set a config field, as
+// defined by modular.FieldProvenance
+type ConfigFieldProvenance struct {
+	Section   string `json:"section,omitempty"`
+	FieldPath string `json:"field_path"`
+	Feeder    string `json:"feeder"`
+	Source    string `json:"source,omitempty"`
+	RawValue  any    `json:"raw_value"`
+}
+
+// NewConfigCommand creates the config command
+func NewConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Work with application configuration",
+		Long: `Validate configuration files against the config structs of a modular application,
+and inspect the effective configuration it loads.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(NewConfigValidateCommand())
+	cmd.AddCommand(NewConfigDumpCommand())
+
+	return cmd
+}
+
+// NewConfigValidateCommand creates the command validating a config file against an application
+func NewConfigValidateCommand() *cobra.Command {
+	var (
+		configFile string
+		appPath    string
+		asJSON     bool
+		timeout    time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate a config file against the application's config structs",
+		Long: `Validate a config file against the config structs registered by a modular
+application, without starting it.
+
+The application is run in config check mode: Init registers the config sections
+of all modules, feeds them from the application's feeders followed by the given
+file, and runs the required-field checks, Validate methods and config validators.
+No module is initialized. The problems found are reported and the command exits
+with a non-zero status if there are any, so it can gate CI pipelines.
+
+--app is the Go package of the application's main function, run with "go run",
+or the path of an already built application binary.
+
+Examples:
+  modcli config validate --config config.yaml
+  modcli config validate --config deploy/prod.yaml --app ./cmd/server
+  modcli config validate --config config.yaml --app ./bin/server --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, err := runConfigCheck(configFile, appPath, "", timeout)
+			if err != nil {
+				return err
+			}
+
+			// The report explains any failure, usage would only hide it
+			cmd.SilenceUsage = true
+			if asJSON {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(report); err != nil {
+					return err //nolint:wrapcheck // output errors are reported as is
+				}
+			} else {
+				printConfigCheckReport(cmd, report)
+			}
+			if !report.Valid {
+				return fmt.Errorf("%w: %d problem(s) in %s", ErrConfigInvalid, len(report.Problems), configFile)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&configFile, "config", "c", "", "Config file to validate (YAML, JSON or TOML)")
+	cmd.Flags().StringVarP(&appPath, "app", "a", ".", "Package of the application's main function, or an application binary")
+	cmd.Flags().BoolVar(&asJSON, "json", false, "Print the report as JSON")
+	cmd.Flags().DurationVar(&timeout, "timeout", 2*time.Minute, "Timeout for building and running the application")
+	_ = cmd.MarkFlagRequired("config")
+
+	return cmd
+}
+
+// runConfigCheck runs the application in config check mode and reads its
+// report. configFile is fed after the application's feeders unless empty, and
+// environment, if set, selects the base config environment.
+func runConfigCheck(configFile, appPath, environment string, timeout time.Duration) (*ConfigCheckReport, error) {
+	var absConfig string
+	if configFile != "" {
+		var err error
+		if absConfig, err = filepath.Abs(configFile); err != nil {
+			return nil, fmt.Errorf("resolving config path: %w", err)
+		}
+		if _, err := os.Stat(absConfig); err != nil {
+			return nil, fmt.Errorf("config file: %w", err)
+		}
+	}
+
+	reportDir, err := os.MkdirTemp("", "modcli-config-check-*")
+	if err != nil {
+		return nil, fmt.Errorf("creating report directory: %w", err)
+	}
+	defer os.RemoveAll(reportDir)
+	reportPath := filepath.Join(reportDir, "report.json")
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var appCmd *exec.Cmd
+	info, statErr := os.Stat(appPath)
+	switch {
+	case statErr == nil && info.IsDir():
+		// Run from the package directory so it resolves within its own module
+		appCmd = exec.CommandContext(ctx, "go", "run", ".")
+		appCmd.Dir = appPath
+	case statErr == nil && info.Mode().IsRegular():
+		appCmd = exec.CommandContext(ctx, appPath) //nolint:gosec // running the user's application is the point
+	default:
+		appCmd = exec.CommandContext(ctx, "go", "run", appPath) //nolint:gosec // running the user's application is the point
+	}
+	appCmd.Env = append(os.Environ(), configCheckEnv+"="+absConfig, configCheckReportEnv+"="+reportPath)
+	if environment != "" {
+		appCmd.Env = append(appCmd.Env, configCheckEnvironmentEnv+"="+environment)
+	}
+	var output strings.Builder
+	appCmd.Stdout = &output
+	appCmd.Stderr = &output
+	// The application is expected to exit with an error after the check, the report tells the outcome
+	_ = appCmd.Run()
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w (the application must be built with modular.WithConfigCheckMode and call Init)\nOutput:\n%s",
+			ErrConfigCheckNoReport, output.String())
+	}
+	report := &ConfigCheckReport{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return nil, fmt.Errorf("%w: invalid report: %w", ErrConfigCheckNoReport, err)
+	}
+	report.ConfigFile = configFile
+	return report, nil
+}
+
+// printConfigCheckReport writes a human-readable config check report
+func printConfigCheckReport(cmd *cobra.Command, report *ConfigCheckReport) {
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "🔍 Validating %s\n", report.ConfigFile)
+	if len(report.Sections) > 0 {
+		fmt.Fprintf(out, "Sections: %s\n", strings.Join(report.Sections, ", "))
+	}
+	fmt.Fprintln(out)
+
+	if report.Valid {
+		fmt.Fprintf(out, "✅ Configuration is valid\n")
+		return
+	}
+	fmt.Fprintf(out, "❌ Configuration is invalid, %d problem(s) found:\n", len(report.Problems))
+	for _, problem := range report.Problems {
+		fmt.Fprintf(out, "  - %s\n", problem)
+	}
+}
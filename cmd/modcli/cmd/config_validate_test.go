@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// configCheckAppMain is an application whose database module requires a host
+// and validates its port
+const configCheckAppMain = `package main
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/GoCodeAlone/modular/feeders"
+)
+
+type DatabaseConfig struct {
+	Host string ` + "`yaml:\"host\" required:\"true\"`" + `
+	Port int    ` + "`yaml:\"port\" default:\"5432\"`" + `
+}
+
+func (c *DatabaseConfig) Validate() error {
+	if c.Port <= 0 {
+		return errors.New("database port must be positive")
+	}
+	return nil
+}
+
+type DatabaseModule struct{}
+
+func (m *DatabaseModule) Name() string { return "database" }
+
+func (m *DatabaseModule) RegisterConfig(app modular.Application) error {
+	app.RegisterConfigSection("database", modular.NewStdConfigProvider(&DatabaseConfig{}))
+	return nil
+}
+
+func (m *DatabaseModule) Init(modular.Application) error { return nil }
+
+func main() {
+	app, err := modular.NewApplication(
+		modular.WithLogger(slog.New(slog.NewTextHandler(os.Stderr, nil))),
+		modular.WithConfigProvider(modular.NewStdConfigProvider(&struct{}{})),
+		modular.WithConfigFeeders(feeders.NewEnvFeeder()),
+		modular.WithModules(&DatabaseModule{}),
+		modular.WithConfigCheckMode(),
+	)
+	if err != nil {
+		os.Exit(1)
+	}
+	if err := app.Run(); err != nil {
+		os.Exit(1)
+	}
+}
+`
+
+// newConfigCheckApp writes mainSource as a module using this checkout of
+// modular, skipping the test if its dependencies are not in the module cache
+func newConfigCheckApp(t *testing.T, mainSource string) string {
+	t.Helper()
+	appDir := t.TempDir()
+	repoRoot, err := filepath.Abs(filepath.Join("..", "..", ".."))
+	require.NoError(t, err)
+
+	goMod := fmt.Sprintf(`module example.com/configcheck
+
+go 1.25
+
+require github.com/GoCodeAlone/modular v1.6.0
+
+replace github.com/GoCodeAlone/modular => %s
+`, repoRoot)
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "go.mod"), []byte(goMod), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "main.go"), []byte(mainSource), 0600))
+
+	t.Setenv("GOPROXY", "off")
+	t.Setenv("GOFLAGS", "-mod=mod")
+	tidy := exec.Command("go", "mod", "tidy")
+	tidy.Dir = appDir
+	if output, err := tidy.CombinedOutput(); err != nil {
+		t.Skipf("Dependencies of the test application are not in the module cache: %v\nOutput: %s", err, output)
+	}
+	return appDir
+}
+
+func TestConfigValidateCommand(t *testing.T) {
+	appDir := newConfigCheckApp(t, configCheckAppMain)
+
+	validate := func(t *testing.T, config string, args ...string) (string, error) {
+		t.Helper()
+		configPath := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(configPath, []byte(config), 0600))
+
+		cmd := NewRootCommand()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		cmd.SetArgs(append([]string{"config", "validate", "--config", configPath, "--app", appDir}, args...))
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	t.Run("valid config", func(t *testing.T) {
+		out, err := validate(t, "database:\n  host: db.internal\n")
+		require.NoError(t, err, out)
+		assert.Contains(t, out, "Sections: database")
+		assert.Contains(t, out, "✅ Configuration is valid")
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		out, err := validate(t, "database:\n  port: -1\n")
+		require.ErrorIs(t, err, ErrConfigInvalid)
+		assert.Contains(t, out, "❌ Configuration is invalid")
+		assert.Contains(t, out, "required field is missing: database.Host")
+		assert.NotContains(t, out, "Usage:", "the report is not buried under the usage")
+	})
+
+	t.Run("JSON report", func(t *testing.T) {
+		out, err := validate(t, "database:\n  host: db.internal\n  port: -1\n", "--json")
+		require.ErrorIs(t, err, ErrConfigInvalid)
+		assert.Contains(t, out, `"valid": false`)
+		assert.Contains(t, out, "database port must be positive")
+	})
+}
+
+func TestConfigValidateCommand_NoReport(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte("database: {}\n"), 0600))
+
+	cmd := NewConfigValidateCommand()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--config", configPath, "--app", "/bin/true"})
+	require.ErrorIs(t, cmd.Execute(), ErrConfigCheckNoReport)
+}
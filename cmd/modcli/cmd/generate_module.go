@@ -29,6 +29,7 @@ var (
 	errNotGitRepoOrNoOrigin = errors.New("not a git repository or no remote 'origin' found")
 	errParentGoModNotFound  = errors.New("parent go.mod file not found")
 	errGitDirectoryNotFound = errors.New(".git directory not found in any parent directory")
+	errModuleNameRequired   = errors.New("--name is required when module features are set with flags")
 )
 
 // ModuleOptions contains the configuration for generating a new module
@@ -239,11 +240,18 @@ func NewGenerateModuleCommand() *cobra.Command {
 	var outputDir string
 	var moduleName string
 	var skipGoMod bool
+	var withConfig bool
+	var withService bool
 
 	cmd := &cobra.Command{
 		Use:   "module",
 		Short: "Generate a new Modular module",
-		Long:  `Generate a new module for the Modular framework with the specified features.`,
+		Long: `Generate a new module for the Modular framework with the specified features.
+
+By default the module features are collected through prompts. Setting --with-config
+or --with-service generates the module without prompting, for example:
+
+  modcli generate module --name foo --with-config --with-service`,
 		Run: func(cmd *cobra.Command, args []string) {
 			options := &ModuleOptions{
 				OutputDir:     outputDir,
@@ -252,8 +260,14 @@ func NewGenerateModuleCommand() *cobra.Command {
 				SkipGoMod:     skipGoMod,
 			}
 
-			// Collect module information through prompts
-			if err := promptForModuleInfo(options); err != nil {
+			if cmd.Flags().Changed("with-config") || cmd.Flags().Changed("with-service") {
+				// Module features were given as flags, so don't prompt for them
+				if err := setModuleOptionsFromFlags(options, withConfig, withService); err != nil {
+					fmt.Fprintf(os.Stderr, "Error gathering module information: %s\n", err)
+					os.Exit(1)
+				}
+			} else if err := promptForModuleInfo(options); err != nil {
+				// Collect module information through prompts
 				fmt.Fprintf(os.Stderr, "Error gathering module information: %s\n", err)
 				os.Exit(1)
 			}
@@ -264,7 +278,8 @@ func NewGenerateModuleCommand() *cobra.Command {
 				os.Exit(1)
 			}
 
-			fmt.Printf("Successfully generated module '%s' in %s\n", options.ModuleName, options.OutputDir)
+			fmt.Fprintf(cmd.OutOrStdout(), "Successfully generated module '%s' in %s\n", options.ModuleName, options.OutputDir)
+			fmt.Fprint(cmd.OutOrStdout(), registrationSnippet(options))
 		},
 	}
 
@@ -272,10 +287,61 @@ func NewGenerateModuleCommand() *cobra.Command {
 	cmd.Flags().StringVarP(&outputDir, "output", "o", ".", "Directory where the module will be generated")
 	cmd.Flags().StringVarP(&moduleName, "name", "n", "", "Name of the module to generate")
 	cmd.Flags().BoolVar(&skipGoMod, "skip-go-mod", false, "Skip generating go.mod file (useful when creating a module in a monorepo)")
+	cmd.Flags().BoolVar(&withConfig, "with-config", false, "Generate a config struct with sample fields and config files (skips prompts)")
+	cmd.Flags().BoolVar(&withService, "with-service", false, "Generate a module that provides services to other modules (skips prompts)")
 
 	return cmd
 }
 
+// setModuleOptionsFromFlags configures the module from command line flags
+// instead of prompts. The module gets lifecycle methods and tests, plus a
+// config struct with sample fields when withConfig is set and service
+// provider methods when withService is set.
+func setModuleOptionsFromFlags(options *ModuleOptions, withConfig, withService bool) error {
+	if options.ModuleName == "" {
+		return errModuleNameRequired
+	}
+
+	// Export the generated type and constructor names, e.g. foo -> FooModule
+	options.ModuleName = strings.ToUpper(options.ModuleName[:1]) + options.ModuleName[1:]
+	options.PackageName = strings.ToLower(strings.ReplaceAll(options.ModuleName, " ", ""))
+	options.HasStartupLogic = true
+	options.HasShutdownLogic = true
+	options.GenerateTests = true
+	options.HasConfig = withConfig
+	options.ProvidesServices = withService
+
+	if withConfig {
+		options.ConfigOptions.TagTypes = []string{"yaml", "json", "toml", "env"}
+		options.ConfigOptions.GenerateSample = true
+		options.ConfigOptions.Fields = []ConfigField{
+			{
+				Name:         "Enabled",
+				Type:         "bool",
+				DefaultValue: "true",
+				Description:  "Whether the module is enabled",
+			},
+			{
+				Name:         "Timeout",
+				Type:         "int",
+				DefaultValue: "30",
+				Description:  "Timeout in seconds",
+			},
+		}
+	}
+	return nil
+}
+
+// registrationSnippet returns the code registering the generated module with an application
+func registrationSnippet(options *ModuleOptions) string {
+	snippet := fmt.Sprintf("\nRegister the module with your application:\n\n\tapp.RegisterModule(%s.New%sModule())\n",
+		options.PackageName, options.ModuleName)
+	if options.HasConfig {
+		snippet += fmt.Sprintf("\nIts configuration is read from the %q config section.\n", options.PackageName)
+	}
+	return snippet
+}
+
 // promptForModuleInfo collects information about the module to generate
 func promptForModuleInfo(options *ModuleOptions) error {
 	// For testing: bypass prompts and directly set options
@@ -662,10 +728,10 @@ func generateModuleFile(outputDir string, options *ModuleOptions) error {
 
 import (
 	{{if or .HasStartupLogic .HasShutdownLogic}}"context"{{end}} {{/* Conditionally import context */}}
-	{{if or .HasConfig .IsTenantAware .ProvidesServices .RequiresServices}}"github.com/GoCodeAlone/modular"{{end}} {{/* Conditionally import modular */}}
+	"github.com/GoCodeAlone/modular"
 	"log/slog"
-	{{if .HasConfig}}"fmt"{{end}} {{/* Conditionally import fmt */}}
-	{{if or .HasConfig .IsTenantAware}}"encoding/json"{{end}} {{/* For config unmarshaling */}}
+	{{if .IsTenantAware}}"fmt"{{end}} {{/* For tenant config errors */}}
+	{{if .IsTenantAware}}"encoding/json"{{end}} {{/* For tenant config unmarshaling */}}
 )
 
 {{if .HasConfig}}
@@ -1144,9 +1210,9 @@ func generateTestFiles(outputDir string, options *ModuleOptions) error {
 import (
 	{{if or .HasStartupLogic .HasShutdownLogic}}"context"{{end}} {{/* Conditionally import context */}}
 	"testing"
-	{{if or .IsTenantAware .ProvidesServices .RequiresServices}}"github.com/GoCodeAlone/modular"{{end}} {{/* Conditionally import modular */}}
+	{{if .IsTenantAware}}"github.com/GoCodeAlone/modular"{{end}} {{/* Conditionally import modular */}}
 	"github.com/stretchr/testify/assert"
-	{{if or .HasConfig .IsTenantAware .ProvidesServices .RequiresServices}}"github.com/stretchr/testify/require"{{end}} {{/* Conditionally import require */}}
+	"github.com/stretchr/testify/require"
 	{{if .IsTenantAware}}"fmt"{{end}} {{/* Import fmt for error formatting in MockTenantService */}}
 )
 
@@ -905,3 +905,67 @@ func main() {
 		t.Logf("Successfully compiled the generated module")
 	}
 }
+
+// TestGenerateModuleFromFlags generates a module without prompts and checks it
+// against the golden files and that it compiles and passes its own tests
+func TestGenerateModuleFromFlags(t *testing.T) {
+	outputDir := t.TempDir()
+
+	moduleCmd := cmd.NewGenerateModuleCommand()
+	buf := new(bytes.Buffer)
+	moduleCmd.SetOut(buf)
+	moduleCmd.SetErr(buf)
+	moduleCmd.SetArgs([]string{
+		"--name", "foo",
+		"--output", outputDir,
+		"--with-config",
+		"--with-service",
+		"--skip-go-mod",
+	})
+	require.NoError(t, moduleCmd.Execute(), "Module generation failed: %s", buf.String())
+	assert.Contains(t, buf.String(), "app.RegisterModule(foo.NewFooModule())", "the registration snippet is printed")
+
+	packageDir := filepath.Join(outputDir, "foo")
+	goldenModuleDir := filepath.Join("testdata", "golden", "foo")
+	if os.Getenv("UPDATE_GOLDEN") != "" {
+		require.NoError(t, os.MkdirAll(goldenModuleDir, 0755))
+		require.NoError(t, copyDirectory(packageDir, goldenModuleDir), "Failed to update golden files")
+		fmtCmd := exec.Command("gofmt", "-w", ".")
+		fmtCmd.Dir = goldenModuleDir
+		fmtOutput, fmtErr := fmtCmd.CombinedOutput()
+		require.NoError(t, fmtErr, "gofmt failed: %s", fmtOutput)
+		t.Logf("Updated golden files in: %s", goldenModuleDir)
+	} else {
+		// A missing golden directory is a failure rather than a reason to create one,
+		// otherwise a deleted or misnamed golden set would silently pass
+		require.True(t, fileExists(goldenModuleDir), "golden files %s are missing; run with UPDATE_GOLDEN=1 to create them", goldenModuleDir)
+		require.NoError(t, compareDirectories(t, packageDir, goldenModuleDir), "Generated files don't match golden files")
+	}
+
+	// Compile the module against this checkout of modular, using only the local module cache
+	repoRoot, err := filepath.Abs(filepath.Join("..", "..", ".."))
+	require.NoError(t, err)
+	goModContent := fmt.Sprintf(`module example.com/foo
+
+go 1.25
+
+require github.com/GoCodeAlone/modular v1.6.0
+
+replace github.com/GoCodeAlone/modular => %s
+`, repoRoot)
+	require.NoError(t, os.WriteFile(filepath.Join(packageDir, "go.mod"), []byte(goModContent), 0600))
+
+	offline := append(os.Environ(), "GOPROXY=off", "GOFLAGS=-mod=mod")
+	tidyCmd := exec.Command("go", "mod", "tidy")
+	tidyCmd.Dir = packageDir
+	tidyCmd.Env = offline
+	if tidyOutput, tidyErr := tidyCmd.CombinedOutput(); tidyErr != nil {
+		t.Skipf("Dependencies of the generated module are not in the module cache: %v\nOutput: %s", tidyErr, tidyOutput)
+	}
+
+	testCmd := exec.Command("go", "test", "./...")
+	testCmd.Dir = packageDir
+	testCmd.Env = offline
+	testOutput, testErr := testCmd.CombinedOutput()
+	require.NoError(t, testErr, "Generated module does not compile or its tests fail:\n%s", testOutput)
+}
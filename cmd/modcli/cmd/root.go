@@ -96,6 +96,8 @@ It helps with generating modules, configurations, and other common tasks.`,
 	cmd.AddCommand(NewGenerateCommand())
 	cmd.AddCommand(NewDebugCommand())
 	cmd.AddCommand(NewContractCommand())
+	cmd.AddCommand(NewServicesCommand())
+	cmd.AddCommand(NewConfigCommand())
 
 	return cmd
 }
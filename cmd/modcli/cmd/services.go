@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// ErrServicesEndpoint is returned when the services debug endpoint does not
+// answer with a service list
+var ErrServicesEndpoint = errors.New("services endpoint request failed")
+
+// RuntimeServiceInfo is a service registered in a running application, as
+// served by the modular.NewServicesHandler debug endpoint
+type RuntimeServiceInfo struct {
+	Name         string   `json:"name"`
+	TypeName     string   `json:"type_name"`
+	RegisteredBy string   `json:"registered_by,omitempty"`
+	Interfaces   []string `json:"interfaces,omitempty"`
+}
+
+// NewServicesCommand creates the command listing the services of a running application
+func NewServicesCommand() *cobra.Command {
+	var (
+		url     string
+		asJSON  bool
+		timeout time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "services",
+		Short: "List the services registered in a running application",
+		Long: `List every service in the service registry of a running modular application,
+with its type, the module that provided it and the interfaces it satisfies for
+interface-based dependency matching.
+
+The application must expose the optional services debug endpoint:
+
+  router.Handle("/debug/services", modular.NewServicesHandler(app))
+
+To inspect the services declared in source code instead, use "modcli debug services".
+
+Examples:
+  modcli services --url http://localhost:8080/debug/services
+  modcli services --url http://localhost:8080/debug/services --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			services, err := fetchServices(url, timeout)
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(services) //nolint:wrapcheck // output errors are reported as is
+			}
+			printServices(cmd, services)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&url, "url", "u", "", "URL of the application's services debug endpoint")
+	cmd.Flags().BoolVar(&asJSON, "json", false, "Print the services as JSON")
+	cmd.Flags().DurationVar(&timeout, "timeout", 10*time.Second, "Timeout for the request to the application")
+	_ = cmd.MarkFlagRequired("url")
+
+	return cmd
+}
+
+// fetchServices reads the service list from a services debug endpoint
+func fetchServices(url string, timeout time.Duration) ([]RuntimeServiceInfo, error) {
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Get(url) //nolint:noctx // one-shot CLI request bounded by the client timeout
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrServicesEndpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s returned %s", ErrServicesEndpoint, url, resp.Status)
+	}
+	var services []RuntimeServiceInfo
+	if err := json.NewDecoder(resp.Body).Decode(&services); err != nil {
+		return nil, fmt.Errorf("%w: invalid response from %s: %w", ErrServicesEndpoint, url, err)
+	}
+	return services, nil
+}
+
+// printServices writes the services as a table
+func printServices(cmd *cobra.Command, services []RuntimeServiceInfo) {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tTYPE\tREGISTERED BY\tINTERFACES")
+	for _, svc := range services {
+		registeredBy := svc.RegisteredBy
+		if registeredBy == "" {
+			registeredBy = "(application)"
+		}
+		interfaces := strings.Join(svc.Interfaces, ", ")
+		if interfaces == "" {
+			interfaces = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", svc.Name, svc.TypeName, registeredBy, interfaces)
+	}
+	_ = w.Flush()
+	fmt.Fprintf(cmd.OutOrStdout(), "\n%d services\n", len(services))
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicesCommand(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"name":"greeter","type_name":"*app.Greeter","registered_by":"provider","interfaces":["app.GreeterService"]},
+			{"name":"logger","type_name":"*slog.Logger"}
+		]`))
+	}))
+	defer server.Close()
+
+	cmd := NewRootCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"services", "--url", server.URL})
+	require.NoError(t, cmd.Execute())
+
+	assert.Regexp(t, `greeter\s+\*app\.Greeter\s+provider\s+app\.GreeterService`, out.String())
+	assert.Regexp(t, `logger\s+\*slog\.Logger\s+\(application\)\s+-`, out.String())
+	assert.Contains(t, out.String(), "2 services")
+}
+
+func TestServicesCommand_EndpointError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	cmd := NewServicesCommand()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--url", server.URL})
+	err := cmd.Execute()
+	require.ErrorIs(t, err, ErrServicesEndpoint)
+	assert.Contains(t, err.Error(), "404")
+}
@@ -0,0 +1,13 @@
+package foo
+
+// FooConfig holds the configuration for the Foo module
+type FooConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled" toml:"enabled" env:"enabled" default:"true" desc:"Whether the module is enabled"` // Whether the module is enabled
+	Timeout int  `yaml:"timeout" json:"timeout" toml:"timeout" env:"timeout" default:"30" desc:"Timeout in seconds"`              // Timeout in seconds
+}
+
+// Validate implements the modular.ConfigValidator interface
+func (c *FooConfig) Validate() error {
+	// Add custom validation logic here
+	return nil
+}
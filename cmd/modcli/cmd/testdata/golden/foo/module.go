@@ -0,0 +1,94 @@
+package foo
+
+import (
+	"context"
+	"github.com/GoCodeAlone/modular"
+	"log/slog"
+)
+
+// Config holds the configuration for the Foo module
+type Config struct {
+	// Add configuration fields here
+	// ExampleField string `mapstructure:"example_field"`
+}
+
+// ProvideDefaults sets default values for the configuration
+func (c *Config) ProvideDefaults() {
+	// Set default values here
+	// c.ExampleField = "default_value"
+}
+
+// Validate checks if the configuration is valid
+func (c *Config) Validate() error {
+	// Add validation logic here
+	// if c.ExampleField == "" {
+	//     return fmt.Errorf("example_field cannot be empty")
+	// }
+	return nil
+}
+
+// GetConfig implements the modular.ConfigProvider interface
+func (c *Config) GetConfig() interface{} {
+	return c
+}
+
+// FooModule represents the Foo module
+type FooModule struct {
+	name   string
+	config *Config
+
+	// Add other dependencies or state fields here
+}
+
+// NewFooModule creates a new instance of the Foo module
+func NewFooModule() modular.Module {
+	return &FooModule{
+		name: "foo",
+	}
+}
+
+// Name returns the name of the module
+func (m *FooModule) Name() string {
+	return m.name
+}
+
+// RegisterConfig registers the module's configuration structure
+func (m *FooModule) RegisterConfig(app modular.Application) error {
+	m.config = &Config{} // Initialize with defaults or empty struct
+	app.RegisterConfigSection(m.Name(), m.config)
+
+	// Load initial config values if needed (e.g., from app's main provider)
+	// Note: Config values will be populated later by feeders during app.Init()
+	slog.Debug("Registered config section", "module", m.Name())
+	return nil
+}
+
+// Init initializes the module
+func (m *FooModule) Init(app modular.Application) error {
+	slog.Info("Initializing Foo module")
+
+	// Add module initialization logic here
+	return nil
+}
+
+// Start performs startup logic for the module
+func (m *FooModule) Start(ctx context.Context) error {
+	slog.Info("Starting Foo module")
+	// Add module startup logic here
+	return nil
+}
+
+// Stop performs shutdown logic for the module
+func (m *FooModule) Stop(ctx context.Context) error {
+	slog.Info("Stopping Foo module")
+	// Add module shutdown logic here
+	return nil
+}
+
+// ProvidesServices declares services provided by this module
+func (m *FooModule) ProvidesServices() []modular.ServiceProvider {
+	// return []modular.ServiceProvider{
+	//     {Name: "myService", Instance: myServiceImpl},
+	// }
+	return nil
+}
@@ -0,0 +1,70 @@
+package foo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFooModule(t *testing.T) {
+	module := NewFooModule()
+	assert.NotNil(t, module)
+	// Test module properties
+	modImpl, ok := module.(*FooModule)
+	require.True(t, ok) // Use require here as the rest of the test depends on this
+	assert.Equal(t, "foo", modImpl.Name())
+
+}
+
+func TestModule_RegisterConfig(t *testing.T) {
+	module := NewFooModule().(*FooModule)
+	// Create a mock application
+	mockApp := NewMockApplication()
+	// Test RegisterConfig
+	err := module.RegisterConfig(mockApp)
+	assert.NoError(t, err)
+	assert.NotNil(t, module.config) // Verify config struct was initialized
+	// Verify the config section was registered in the mock app
+	_, err = mockApp.GetConfigSection(module.Name())
+	assert.NoError(t, err, "Config section should be registered")
+}
+
+func TestModule_Init(t *testing.T) {
+	module := NewFooModule().(*FooModule)
+	// Create a mock application
+	mockApp := NewMockApplication()
+
+	// Test Init
+	err := module.Init(mockApp)
+	assert.NoError(t, err)
+	// Add assertions here to check the state of the module after Init
+}
+
+func TestModule_Start(t *testing.T) {
+	module := NewFooModule().(*FooModule)
+	// Add setup if needed, e.g., call Init
+	// mockApp := NewMockApplication()
+	// module.Init(mockApp)
+
+	// Test Start
+	err := module.Start(context.Background())
+	assert.NoError(t, err)
+	// Add assertions here to check the state of the module after Start
+}
+
+func TestModule_Stop(t *testing.T) {
+	module := NewFooModule().(*FooModule)
+	// Add setup if needed, e.g., call Init and Start
+	// mockApp := NewMockApplication()
+	// module.Init(mockApp)
+	// module.Start(context.Background())
+
+	// Test Stop
+	err := module.Stop(context.Background())
+	assert.NoError(t, err)
+	// Add assertions here to check the state of the module after Stop
+}
+
+// Add more tests for specific module functionality
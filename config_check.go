@@ -0,0 +1,225 @@
+package modular
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/GoCodeAlone/modular/feeders"
+)
+
+// Config check mode lets tools such as "modcli config validate" and
+// "modcli config dump" validate and inspect configuration against an
+// application's real config structs, feeders and validation logic without
+// starting the application. Applications opt in with WithConfigCheckMode;
+// otherwise these variables are ignored.
+const (
+	// ConfigCheckEnv holds the path of a YAML, JSON or TOML config file to
+	// check. When it is set, Init feeds the file after the application's own
+	// feeders, runs the required-field checks, Validate methods, config loaded
+	// hooks and config validators, writes a ConfigCheckReport and returns
+	// ErrConfigCheckOnly without initializing any module. When it is set but
+	// empty, only the application's own feeders are checked.
+	ConfigCheckEnv = "MODULAR_CONFIG_CHECK"
+
+	// ConfigCheckReportEnv holds the path the ConfigCheckReport is written
+	// to as JSON. The report is written to stdout if it is not set.
+	ConfigCheckReportEnv = "MODULAR_CONFIG_CHECK_REPORT"
+
+	// ConfigCheckEnvironmentEnv selects the environment whose overrides are
+	// merged over the base config (see SetBaseConfig) in config check mode,
+	// replacing the environment chosen by the application.
+	ConfigCheckEnvironmentEnv = "MODULAR_CONFIG_CHECK_ENVIRONMENT"
+)
+
+// ConfigCheckReport is the outcome of checking a config file in config check mode
+type ConfigCheckReport struct {
+	ConfigFile string   `json:"config_file"`
+	Valid      bool     `json:"valid"`
+	Sections   []string `json:"sections"`
+	Problems   []string `json:"problems,omitempty"`
+
+	// Environment is the base config environment, if base config is enabled
+	Environment string `json:"environment,omitempty"`
+	// Values holds the effective value of every config field, keyed like
+	// the provenance. Secret fields are redacted.
+	Values map[string]any `json:"values,omitempty"`
+	// Provenance tells which feeder and source set each field
+	Provenance map[string]FieldProvenance `json:"provenance,omitempty"`
+}
+
+// runConfigCheck loads and validates the configuration with configFile fed
+// last, and writes the resulting report
+func (app *StdApplication) runConfigCheck(appToPass Application, configFile string) error {
+	report := &ConfigCheckReport{ConfigFile: configFile}
+
+	if environment := os.Getenv(ConfigCheckEnvironmentEnv); environment != "" {
+		if IsBaseConfigEnabled() || DetectBaseConfigStructure() {
+			BaseConfigSettings.Environment = environment
+		}
+	}
+
+	var (
+		fileFeeder Feeder
+		statErr    error
+	)
+	if configFile != "" {
+		fileFeeder = createTenantFeeder(configFile)
+		_, statErr = os.Stat(configFile)
+	}
+	switch {
+	case configFile != "" && fileFeeder == nil:
+		report.Problems = []string{fmt.Sprintf("%s: %s", ErrConfigCheckFileUnsupported, configFile)}
+	case statErr != nil:
+		report.Problems = []string{statErr.Error()}
+	default:
+		if fileFeeder != nil {
+			appFeeders := app.configFeeders
+			if appFeeders == nil {
+				appFeeders, _ = defaultConfigFeeders()
+			}
+			app.configFeeders = append(slices.Clone(appFeeders), fileFeeder)
+		}
+
+		errs, _ := app.initConfig(appToPass)
+		report.Problems = configCheckProblems(errs)
+		report.Values = app.effectiveConfigValues()
+		report.Provenance = app.ConfigProvenance()
+	}
+	report.Valid = len(report.Problems) == 0
+	report.Sections = slices.Sorted(maps.Keys(app.ConfigSections()))
+	if IsBaseConfigEnabled() {
+		report.Environment = BaseConfigSettings.Environment
+	}
+
+	if err := writeConfigCheckReport(report); err != nil {
+		return fmt.Errorf("writing config check report: %w", err)
+	}
+	if !report.Valid {
+		return fmt.Errorf("%w: %s is invalid", ErrConfigCheckOnly, configFile)
+	}
+	return ErrConfigCheckOnly
+}
+
+// effectiveConfigValues flattens the main config and the config sections into
+// their leaf values, keyed like the config provenance: main config fields by
+// their path, section fields prefixed with the section name
+func (app *StdApplication) effectiveConfigValues() map[string]any {
+	values := make(map[string]any)
+	if app.cfgProvider != nil {
+		flattenConfigValue(values, "", reflect.ValueOf(app.cfgProvider.GetConfig()))
+	}
+	for name, provider := range app.ConfigSections() {
+		if provider != nil {
+			flattenConfigValue(values, name, reflect.ValueOf(provider.GetConfig()))
+		}
+	}
+	return values
+}
+
+// flattenConfigValue adds the leaf values of v to values under prefix. Struct
+// fields are keyed by field name and map entries by key; fields tagged
+// `secret:"true"` are replaced by feeders.RedactedValue.
+func flattenConfigValue(values map[string]any, prefix string, v reflect.Value) {
+	join := func(name string) string {
+		if prefix == "" {
+			return name
+		}
+		return prefix + "." + name
+	}
+
+	switch v.Kind() { //nolint:exhaustive // other kinds are leaf values
+	case reflect.Invalid:
+		return
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			flattenConfigValue(values, prefix, v.Elem())
+		}
+		return
+	case reflect.Struct:
+		if _, ok := v.Interface().(encoding.TextMarshaler); ok {
+			break
+		}
+		t := v.Type()
+		for i := range t.NumField() {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if field.Tag.Get(tagSecret) == "true" {
+				values[join(field.Name)] = feeders.RedactedValue
+				continue
+			}
+			flattenConfigValue(values, join(field.Name), v.Field(i))
+		}
+		return
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			flattenConfigValue(values, join(fmt.Sprint(iter.Key().Interface())), iter.Value())
+		}
+		return
+	case reflect.Slice, reflect.Array:
+		elemType := v.Type().Elem()
+		for elemType.Kind() == reflect.Pointer {
+			elemType = elemType.Elem()
+		}
+		switch elemType.Kind() { //nolint:exhaustive // slices of other kinds are leaf values
+		case reflect.Struct, reflect.Map, reflect.Interface:
+			// Elements may hold secret fields, so they are flattened too
+			for i := range v.Len() {
+				flattenConfigValue(values, fmt.Sprintf("%s[%d]", prefix, i), v.Index(i))
+			}
+			return
+		}
+	}
+
+	if !v.CanInterface() || prefix == "" {
+		return
+	}
+	if stringer, ok := v.Interface().(fmt.Stringer); ok {
+		// Durations and similar named types read better as strings
+		values[prefix] = stringer.String()
+		return
+	}
+	values[prefix] = v.Interface()
+}
+
+// configCheckProblems lists one problem per line of the errors, splitting
+// the errors aggregated with errors.Join
+func configCheckProblems(errs []error) []string {
+	var problems []string
+	for _, err := range errs {
+		for _, line := range strings.Split(err.Error(), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				problems = append(problems, line)
+			}
+		}
+	}
+	return problems
+}
+
+// writeConfigCheckReport writes the report to the ConfigCheckReportEnv file, or stdout
+func writeConfigCheckReport(report *ConfigCheckReport) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding config check report: %w", err)
+	}
+	data = append(data, '\n')
+
+	if path := os.Getenv(ConfigCheckReportEnv); path != "" {
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			return fmt.Errorf("writing %s: %w", path, err)
+		}
+		return nil
+	}
+	if _, err := os.Stdout.Write(data); err != nil {
+		return fmt.Errorf("writing to stdout: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package modular
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoCodeAlone/modular/feeders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errCheckedPortInvalid = errors.New("port must be positive")
+
+type checkedDatabaseConfig struct {
+	Host string `yaml:"host" required:"true"`
+	Port int    `yaml:"port" default:"5432"`
+}
+
+func (c *checkedDatabaseConfig) Validate() error {
+	if c.Port <= 0 {
+		return errCheckedPortInvalid
+	}
+	return nil
+}
+
+// checkedDatabaseModule registers a "database" config section and records
+// whether it was initialized
+type checkedDatabaseModule struct {
+	initialized bool
+}
+
+func (m *checkedDatabaseModule) Name() string { return "database" }
+
+func (m *checkedDatabaseModule) RegisterConfig(app Application) error {
+	app.RegisterConfigSection("database", NewStdConfigProvider(&checkedDatabaseConfig{}))
+	return nil
+}
+
+func (m *checkedDatabaseModule) Init(Application) error {
+	m.initialized = true
+	return nil
+}
+
+// runConfigCheckMode checks a config file with the given content in config
+// check mode and returns the written report
+func runConfigCheckMode(t *testing.T, name, content string) (*ConfigCheckReport, error) {
+	t.Helper()
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(configPath, []byte(content), 0o600))
+	reportPath := filepath.Join(dir, "report.json")
+	t.Setenv(ConfigCheckEnv, configPath)
+	t.Setenv(ConfigCheckReportEnv, reportPath)
+
+	module := &checkedDatabaseModule{}
+	app, err := NewApplication(
+		WithLogger(nopLogger{}),
+		WithConfigProvider(NewStdConfigProvider(&struct{}{})),
+		WithModules(module),
+		WithConfigCheckMode(),
+	)
+	require.NoError(t, err)
+
+	initErr := app.Init()
+	require.ErrorIs(t, initErr, ErrConfigCheckOnly)
+	assert.False(t, module.initialized, "modules are not initialized in config check mode")
+
+	data, err := os.ReadFile(reportPath)
+	require.NoError(t, err)
+	report := &ConfigCheckReport{}
+	require.NoError(t, json.Unmarshal(data, report))
+	assert.Equal(t, configPath, report.ConfigFile)
+	return report, initErr
+}
+
+func TestConfigCheckMode(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		report, err := runConfigCheckMode(t, "config.yaml", "database:\n  host: db.internal\n")
+		assert.Equal(t, ErrConfigCheckOnly, err)
+		assert.True(t, report.Valid)
+		assert.Empty(t, report.Problems)
+		assert.Contains(t, report.Sections, "database")
+	})
+
+	t.Run("missing required field", func(t *testing.T) {
+		report, _ := runConfigCheckMode(t, "config.yaml", "database:\n  port: 5432\n")
+		assert.False(t, report.Valid)
+		require.Len(t, report.Problems, 1)
+		assert.Contains(t, report.Problems[0], "required field is missing: database.Host")
+	})
+
+	t.Run("Validate failure", func(t *testing.T) {
+		report, _ := runConfigCheckMode(t, "config.json", `{"database": {"host": "db.internal", "port": -1}}`)
+		assert.False(t, report.Valid)
+		require.Len(t, report.Problems, 1)
+		assert.Contains(t, report.Problems[0], errCheckedPortInvalid.Error())
+	})
+
+	t.Run("unsupported file format", func(t *testing.T) {
+		report, _ := runConfigCheckMode(t, "config.ini", "host = db.internal\n")
+		assert.False(t, report.Valid)
+		require.Len(t, report.Problems, 1)
+		assert.Contains(t, report.Problems[0], ErrConfigCheckFileUnsupported.Error())
+	})
+}
+
+func TestConfigCheckModeRequiresOptIn(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte("database:\n  host: db.internal\n"), 0o600))
+	reportPath := filepath.Join(dir, "report.json")
+	t.Setenv(ConfigCheckEnv, "")
+	t.Setenv(ConfigCheckReportEnv, reportPath)
+
+	module := &checkedDatabaseModule{}
+	app, err := NewApplication(
+		WithLogger(nopLogger{}),
+		WithConfigProvider(NewStdConfigProvider(&struct{}{})),
+		WithConfigFeeders(feeders.NewYamlFeeder(configPath)),
+		WithModules(module),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, app.Init())
+	assert.True(t, module.initialized, "the environment alone does not switch to config check mode")
+	assert.NoFileExists(t, reportPath)
+}
+
+func TestConfigCheckModeEffectiveConfig(t *testing.T) {
+	configDir := t.TempDir()
+	files := map[string]string{
+		"base/default.yaml":                "app_name: layered\ndatabase:\n  host: db.dev.internal\n  port: 5432\n  password: dev-password\n",
+		"environments/dev/overrides.yaml":  "database:\n  host: db.local\n",
+		"environments/prod/overrides.yaml": "database:\n  host: db.prod.internal\n  password: prod-password\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(configDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	prodPath := filepath.Join(configDir, "environments", "prod", "overrides.yaml")
+	basePath := filepath.Join(configDir, "base", "default.yaml")
+
+	// The application picks dev, the check asks for prod and no extra file
+	SetBaseConfig(configDir, "dev")
+	t.Cleanup(func() { BaseConfigSettings = BaseConfigOptions{} })
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+	t.Setenv(ConfigCheckEnv, "")
+	t.Setenv(ConfigCheckReportEnv, reportPath)
+	t.Setenv(ConfigCheckEnvironmentEnv, "prod")
+
+	app, err := NewApplication(
+		WithLogger(nopLogger{}),
+		WithConfigProvider(NewStdConfigProvider(&provenanceLayeredConfig{})),
+		WithConfigCheckMode(),
+	)
+	require.NoError(t, err)
+	require.ErrorIs(t, app.Init(), ErrConfigCheckOnly)
+
+	data, err := os.ReadFile(reportPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "prod-password", "secret values are never reported")
+	report := &ConfigCheckReport{}
+	require.NoError(t, json.Unmarshal(data, report))
+
+	assert.True(t, report.Valid)
+	assert.Equal(t, "prod", report.Environment)
+	assert.Equal(t, "db.prod.internal", report.Values["Database.Host"])
+	assert.InDelta(t, 5432, report.Values["Database.Port"], 0)
+	assert.Equal(t, feeders.RedactedValue, report.Values["Database.Password"])
+	assert.Equal(t, prodPath, report.Provenance["Database.Host"].Source)
+	assert.Equal(t, basePath, report.Provenance["Database.Port"].Source)
+	assert.Equal(t, "*feeders.BaseConfigFeeder", report.Provenance["AppName"].Feeder)
+}
@@ -10,9 +10,29 @@ type Feeder interface {
 	Feed(structure any) error
 }
 
+// defaultEnvFeeder is the feeder initially installed in the global ConfigFeeders.
+// It is used to detect whether the global slice has been modified.
+var defaultEnvFeeder Feeder = feeders.NewEnvFeeder()
+
 // ConfigFeeders provides a default set of configuration feeders for common use cases
+//
+// Deprecated: configure feeders per application instead, using WithConfigFeeders
+// with NewApplication or StdApplication.SetConfigFeeders. The global slice is only
+// consulted by applications without per-app feeders, and mutating it affects every
+// application in the process; a warning is logged when a modified global is used.
 var ConfigFeeders = []Feeder{
-	feeders.NewEnvFeeder(),
+	defaultEnvFeeder,
+}
+
+// defaultConfigFeeders returns the feeders used by an application that has no
+// per-app feeders configured. While the global ConfigFeeders is untouched a fresh
+// per-app default is returned; otherwise the global slice is returned and the
+// second result reports that the deprecated global fallback is in use.
+func defaultConfigFeeders() ([]Feeder, bool) {
+	if len(ConfigFeeders) == 1 && ConfigFeeders[0] == defaultEnvFeeder {
+		return []Feeder{feeders.NewEnvFeeder()}, false
+	}
+	return ConfigFeeders, true
 }
 
 // ComplexFeeder extends the basic Feeder interface with additional functionality for complex configuration scenarios
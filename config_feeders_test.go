@@ -0,0 +1,155 @@
+package modular
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+type feederIsolationCfg struct {
+	Name string
+}
+
+// namedFeeder sets Name on every config it feeds and counts its invocations.
+type namedFeeder struct {
+	name  string
+	mu    sync.Mutex
+	calls int
+}
+
+func (f *namedFeeder) Feed(structure any) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if cfg, ok := structure.(*feederIsolationCfg); ok {
+		cfg.Name = f.name
+	}
+	return nil
+}
+
+func (f *namedFeeder) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+// warnRecorder is a Logger that records warning messages.
+type warnRecorder struct {
+	mu    sync.Mutex
+	warns []string
+}
+
+func (l *warnRecorder) Info(string, ...any)  {}
+func (l *warnRecorder) Error(string, ...any) {}
+func (l *warnRecorder) Debug(string, ...any) {}
+func (l *warnRecorder) Warn(msg string, _ ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.warns = append(l.warns, msg)
+}
+
+func (l *warnRecorder) hasWarning(substr string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, w := range l.warns {
+		if strings.Contains(w, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestConfigFeeders_PerApplicationIsolation(t *testing.T) {
+	t.Parallel()
+
+	feederA := &namedFeeder{name: "app-a"}
+	feederB := &namedFeeder{name: "app-b"}
+	cfgA := &feederIsolationCfg{}
+	cfgB := &feederIsolationCfg{}
+
+	appA, err := NewApplication(
+		WithLogger(&logger{t}),
+		WithConfigProvider(NewStdConfigProvider(cfgA)),
+		WithConfigFeeders(feederA),
+	)
+	if err != nil {
+		t.Fatalf("failed to build app A: %v", err)
+	}
+	appB := NewStdApplication(NewStdConfigProvider(cfgB), &logger{t}, feederB)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, app := range []Application{appA, appB} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = app.Init()
+		}()
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("app %d init failed: %v", i, err)
+		}
+	}
+
+	if got := appA.ConfigProvider().GetConfig().(*feederIsolationCfg).Name; got != "app-a" {
+		t.Errorf("app A expected config from its own feeder, got %q", got)
+	}
+	if got := appB.ConfigProvider().GetConfig().(*feederIsolationCfg).Name; got != "app-b" {
+		t.Errorf("app B expected config from its own feeder, got %q", got)
+	}
+	if feederA.callCount() != 1 || feederB.callCount() != 1 {
+		t.Errorf("expected each feeder to be used only by its own app, got A=%d B=%d",
+			feederA.callCount(), feederB.callCount())
+	}
+}
+
+func TestConfigFeeders_DefaultDoesNotUseGlobal(t *testing.T) {
+	t.Parallel()
+
+	feeders, usingGlobal := defaultConfigFeeders()
+	if usingGlobal {
+		t.Fatal("expected untouched global to yield per-app default feeders")
+	}
+	if len(feeders) != 1 || feeders[0] == ConfigFeeders[0] {
+		t.Errorf("expected a fresh per-app env feeder, got %v", feeders)
+	}
+}
+
+// Not parallel: temporarily mutates the deprecated global slice.
+func TestConfigFeeders_ModifiedGlobalFallbackWarns(t *testing.T) {
+	original := ConfigFeeders
+	t.Cleanup(func() { ConfigFeeders = original })
+
+	global := &namedFeeder{name: "global"}
+	ConfigFeeders = []Feeder{global}
+
+	cfg := &feederIsolationCfg{}
+	log := &warnRecorder{}
+	app := NewStdApplication(NewStdConfigProvider(cfg), log)
+	if err := app.Init(); err != nil {
+		t.Fatalf("init failed: %v", err)
+	}
+	if cfg.Name != "global" {
+		t.Errorf("expected global feeder fallback, got %q", cfg.Name)
+	}
+	if !log.hasWarning("deprecated global modular.ConfigFeeders") {
+		t.Error("expected deprecation warning when falling back to the global feeders")
+	}
+
+	// An app with its own feeders ignores the modified global without warning.
+	own := &namedFeeder{name: "own"}
+	ownCfg := &feederIsolationCfg{}
+	ownLog := &warnRecorder{}
+	ownApp := NewStdApplication(NewStdConfigProvider(ownCfg), ownLog, own)
+	if err := ownApp.Init(); err != nil {
+		t.Fatalf("init failed: %v", err)
+	}
+	if ownCfg.Name != "own" || global.callCount() != 1 {
+		t.Errorf("expected per-app feeder to take precedence, got %q (global calls %d)", ownCfg.Name, global.callCount())
+	}
+	if ownLog.hasWarning("deprecated") {
+		t.Error("expected no deprecation warning for an app with its own feeders")
+	}
+}
@@ -24,6 +24,7 @@ type FieldPopulation struct {
 	FeederType  string   // Type of feeder that populated it
 	SourceType  string   // Type of source (env, yaml, etc.)
 	SourceKey   string   // Source key that was used (e.g., "DB_PRIMARY_DSN")
+	SourceFile  string   // File the value was read from, when the feeder reads several files
 	Value       any      // Value that was set
 	InstanceKey string   // Instance key for instance-aware fields
 	SearchKeys  []string // All keys that were searched for this field
@@ -64,6 +65,7 @@ func (t *DefaultFieldTracker) RecordFieldPopulation(fp FieldPopulation) {
 			"feederType", fp.FeederType,
 			"sourceType", fp.SourceType,
 			"sourceKey", fp.SourceKey,
+			"sourceFile", fp.SourceFile,
 			"value", fp.Value,
 			"instanceKey", fp.InstanceKey,
 			"searchKeys", strings.Join(fp.SearchKeys, ", "),
@@ -0,0 +1,220 @@
+package modular
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/GoCodeAlone/modular/feeders"
+)
+
+// FieldProvenance records which feeder set the final value of a configuration field.
+type FieldProvenance struct {
+	Section   string `json:"section,omitempty"` // Config section, empty for the main application config
+	FieldPath string `json:"field_path"`        // Path to the field within the section (e.g., "Connections.primary.DSN")
+	Feeder    string `json:"feeder"`            // Type of the feeder that set the final value (e.g., "*feeders.EnvFeeder")
+	Source    string `json:"source,omitempty"`  // File or environment variable the value came from (e.g., "config/base/default.yaml", "env:DB_HOST")
+	RawValue  any    `json:"raw_value"`         // Value the feeder read for the field
+}
+
+// ConfigProvenanceProvider is an optional interface for applications that record
+// which feeder set each configuration field.
+type ConfigProvenanceProvider interface {
+	// ConfigProvenance returns the provenance of every field set during config
+	// loading, keyed by field path prefixed with the section name
+	// (e.g., "database.Connections.primary.DSN"). Main config fields are not prefixed.
+	ConfigProvenance() map[string]FieldProvenance
+}
+
+// ConfigProvenance returns the provenance of every configuration field set during Init.
+// When several feeders set a field, the one applied last wins.
+func (app *StdApplication) ConfigProvenance() map[string]FieldProvenance {
+	app.provenanceMu.RLock()
+	defer app.provenanceMu.RUnlock()
+	return maps.Clone(app.configProvenance)
+}
+
+// setConfigProvenance stores the provenance recorded while loading config
+func (app *StdApplication) setConfigProvenance(provenance map[string]FieldProvenance) {
+	app.provenanceMu.Lock()
+	app.configProvenance = provenance
+	app.provenanceMu.Unlock()
+}
+
+// logConfigProvenance logs which feeder set each field at DEBUG level
+func (app *StdApplication) logConfigProvenance(provenance map[string]FieldProvenance) {
+	app.logger.Debug("Configuration provenance report", "fields", len(provenance))
+	for _, key := range slices.Sorted(maps.Keys(provenance)) {
+		p := provenance[key]
+		app.logger.Debug("Config field provenance",
+			"field", key,
+			"section", p.Section,
+			"feeder", p.Feeder,
+			"source", p.Source,
+			"rawValue", p.RawValue,
+		)
+	}
+}
+
+// redactSecretProvenance replaces the raw values of fields tagged
+// `secret:"true"` with feeders.RedactedValue. The built-in feeders never
+// report secret values, but custom feeders may.
+func redactSecretProvenance(provenance map[string]FieldProvenance, configs map[string]configInfo) map[string]FieldProvenance {
+	for key, p := range provenance {
+		section := p.Section
+		if section == "" {
+			section = mainConfigSection
+		}
+		info, ok := configs[section]
+		if !ok {
+			continue
+		}
+		if isSecretFieldPath(info.tempVal.Type(), strings.Split(p.FieldPath, ".")) {
+			p.RawValue = feeders.RedactedValue
+			provenance[key] = p
+		}
+	}
+	return provenance
+}
+
+// isSecretFieldPath reports whether the field at path within type t is tagged
+// `secret:"true"`. Map keys and slice indexes in the path are skipped.
+func isSecretFieldPath(t reflect.Type, path []string) bool {
+	for len(path) > 0 {
+		switch t.Kind() { //nolint:exhaustive // other kinds have no fields
+		case reflect.Pointer, reflect.Slice, reflect.Array:
+			t = t.Elem()
+		case reflect.Map:
+			t = t.Elem()
+			path = path[1:]
+		case reflect.Struct:
+			name, _, _ := strings.Cut(path[0], "[")
+			field, ok := t.FieldByName(name)
+			if !ok {
+				return false
+			}
+			if len(path) == 1 {
+				return field.Tag.Get(tagSecret) == "true"
+			}
+			t = field.Type
+			path = path[1:]
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// sectionAwareTracker is implemented by field trackers that need to know
+// which config section is being fed
+type sectionAwareTracker interface {
+	setSection(section string)
+}
+
+// feederAwareTracker is implemented by field trackers that need to know
+// which feeder is being applied
+type feederAwareTracker interface {
+	setFeeder(feeder Feeder)
+}
+
+// provenanceTracker wraps a FieldTracker, recording the last feeder to set each field
+type provenanceTracker struct {
+	FieldTracker
+
+	mu         sync.Mutex
+	section    string
+	feeder     Feeder
+	provenance map[string]FieldProvenance
+}
+
+// newProvenanceTracker creates a provenance tracker that forwards populations to inner
+func newProvenanceTracker(inner FieldTracker) *provenanceTracker {
+	return &provenanceTracker{
+		FieldTracker: inner,
+		provenance:   make(map[string]FieldProvenance),
+	}
+}
+
+// RecordFieldPopulation forwards fp to the wrapped tracker and, if the feeder
+// found a value, records it as the field's provenance
+func (t *provenanceTracker) RecordFieldPopulation(fp FieldPopulation) {
+	if t.FieldTracker != nil {
+		t.FieldTracker.RecordFieldPopulation(fp)
+	}
+	if fp.FoundKey == "" {
+		// The feeder searched for the field but left it unchanged
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := fp.FieldPath
+	if t.section != "" {
+		key = t.section + "." + fp.FieldPath
+	}
+	t.provenance[key] = FieldProvenance{
+		Section:   t.section,
+		FieldPath: fp.FieldPath,
+		Feeder:    fp.FeederType,
+		Source:    provenanceSource(t.feeder, fp),
+		RawValue:  fp.Value,
+	}
+}
+
+// provenanceSource describes where feeder read the value of fp: the file of
+// file-based feeders, or the environment variable of environment feeders
+func provenanceSource(feeder Feeder, fp FieldPopulation) string {
+	if fp.SourceFile != "" {
+		return fp.SourceFile
+	}
+	switch f := feeder.(type) {
+	case *feeders.YamlFeeder:
+		return f.Path
+	case *feeders.JSONFeeder:
+		return f.Path
+	case *feeders.TomlFeeder:
+		return f.Path
+	case *feeders.DotEnvFeeder:
+		return f.Path
+	}
+	switch fp.SourceType {
+	case "env", "env_affixed":
+		return "env:" + fp.SourceKey
+	case "default":
+		return "default"
+	}
+	return ""
+}
+
+// SetLogger sets the logger for the wrapped tracker
+func (t *provenanceTracker) SetLogger(logger Logger) {
+	if t.FieldTracker != nil {
+		t.FieldTracker.SetLogger(logger)
+	}
+}
+
+// setSection sets the section subsequent populations belong to
+func (t *provenanceTracker) setSection(section string) {
+	if section == mainConfigSection {
+		section = ""
+	}
+	t.mu.Lock()
+	t.section = section
+	t.mu.Unlock()
+}
+
+// setFeeder sets the feeder subsequent populations come from
+func (t *provenanceTracker) setFeeder(feeder Feeder) {
+	t.mu.Lock()
+	t.feeder = feeder
+	t.mu.Unlock()
+}
+
+// snapshot returns a copy of the recorded provenance
+func (t *provenanceTracker) snapshot() map[string]FieldProvenance {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return maps.Clone(t.provenance)
+}
@@ -0,0 +1,227 @@
+package modular
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/GoCodeAlone/modular/feeders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// provenanceTestLogger captures debug messages with their arguments
+type provenanceTestLogger struct {
+	mu     sync.Mutex
+	debugs []string
+}
+
+func (l *provenanceTestLogger) Info(msg string, args ...any)  {}
+func (l *provenanceTestLogger) Warn(msg string, args ...any)  {}
+func (l *provenanceTestLogger) Error(msg string, args ...any) {}
+func (l *provenanceTestLogger) Debug(msg string, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.debugs = append(l.debugs, fmt.Sprint(append([]any{msg}, args...)...))
+}
+
+func (l *provenanceTestLogger) messages(prefix string) []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var matched []string
+	for _, msg := range l.debugs {
+		if strings.HasPrefix(msg, prefix) {
+			matched = append(matched, msg)
+		}
+	}
+	return matched
+}
+
+type provenanceAppConfig struct {
+	AppName string `yaml:"app_name" env:"PROVENANCE_APP_NAME"`
+	Port    int    `yaml:"port" env:"PROVENANCE_PORT"`
+}
+
+type provenanceDBConfig struct {
+	DSN     string `yaml:"dsn" env:"PROVENANCE_DSN"`
+	MaxConn int    `yaml:"max_conn" env:"PROVENANCE_MAX_CONN"`
+}
+
+func TestStdApplication_ConfigProvenance(t *testing.T) {
+	feeders.ResetGlobalEnvCatalog()
+	t.Cleanup(feeders.ResetGlobalEnvCatalog)
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte(`app_name: yaml-app
+port: 8080
+database:
+  dsn: yaml-dsn
+  max_conn: 5
+`), 0o600))
+	t.Setenv("PROVENANCE_PORT", "9090")
+	t.Setenv("PROVENANCE_DSN", "env-dsn")
+
+	logger := &provenanceTestLogger{}
+	appConfig := &provenanceAppConfig{}
+	app := NewStdApplication(NewStdConfigProvider(appConfig), logger,
+		feeders.NewYamlFeeder(yamlPath),
+		feeders.NewEnvFeeder(),
+	).(*StdApplication)
+	app.SetVerboseConfig(true)
+	dbConfig := &provenanceDBConfig{}
+	app.RegisterConfigSection("database", NewStdConfigProvider(dbConfig))
+
+	require.NoError(t, app.Init())
+	assert.Equal(t, 9090, appConfig.Port)
+	assert.Equal(t, "env-dsn", dbConfig.DSN)
+
+	provenance := app.ConfigProvenance()
+
+	// ENV wins for the fields it overrides
+	assert.Equal(t, FieldProvenance{FieldPath: "Port", Feeder: "*feeders.EnvFeeder", Source: "env:PROVENANCE_PORT", RawValue: 9090},
+		provenance["Port"])
+	assert.Equal(t, FieldProvenance{Section: "database", FieldPath: "DSN", Feeder: "*feeders.EnvFeeder", Source: "env:PROVENANCE_DSN", RawValue: "env-dsn"},
+		provenance["database.DSN"])
+
+	// YAML keeps the fields ENV leaves alone
+	assert.Equal(t, "*feeders.YamlFeeder", provenance["AppName"].Feeder)
+	assert.Equal(t, "yaml-app", provenance["AppName"].RawValue)
+	assert.Equal(t, yamlPath, provenance["AppName"].Source)
+	assert.Equal(t, "*feeders.YamlFeeder", provenance["database.MaxConn"].Feeder)
+
+	// The report is logged at DEBUG in verbose mode
+	report := logger.messages("Config field provenance")
+	assert.Len(t, report, len(provenance))
+	assert.Contains(t, strings.Join(report, "\n"), "database.DSN")
+
+	// Callers get a copy
+	delete(provenance, "Port")
+	assert.Contains(t, app.ConfigProvenance(), "Port")
+}
+
+func TestStdApplication_ConfigProvenanceWithoutVerbose(t *testing.T) {
+	feeders.ResetGlobalEnvCatalog()
+	t.Cleanup(feeders.ResetGlobalEnvCatalog)
+	t.Setenv("PROVENANCE_APP_NAME", "env-app")
+
+	logger := &provenanceTestLogger{}
+	app := NewStdApplication(NewStdConfigProvider(&provenanceAppConfig{}), logger, feeders.NewEnvFeeder()).(*StdApplication)
+	require.NoError(t, app.Init())
+
+	var provider ConfigProvenanceProvider = app
+	assert.Equal(t, "*feeders.EnvFeeder", provider.ConfigProvenance()["AppName"].Feeder)
+	assert.NotContains(t, provider.ConfigProvenance(), "Port", "fields no feeder set have no provenance")
+	assert.Empty(t, logger.messages("Config field provenance"), "the report is only logged in verbose mode")
+}
+
+// rawValueFeeder sets fields and reports their values to the field tracker
+// without redacting secrets, as a custom feeder might
+type rawValueFeeder struct {
+	tracker FieldTracker
+}
+
+func (f *rawValueFeeder) SetFieldTracker(tracker FieldTracker) { f.tracker = tracker }
+
+func (f *rawValueFeeder) Feed(structure any) error {
+	cfg, ok := structure.(*provenanceSecretConfig)
+	if !ok {
+		return nil
+	}
+	cfg.Host = "db.internal"
+	cfg.Auth.Password = "hunter2"
+	for path, value := range map[string]string{"Host": cfg.Host, "Auth.Password": cfg.Auth.Password} {
+		f.tracker.RecordFieldPopulation(FieldPopulation{
+			FieldPath:  path,
+			FeederType: "*modular.rawValueFeeder",
+			Value:      value,
+			FoundKey:   path,
+		})
+	}
+	return nil
+}
+
+type provenanceSecretConfig struct {
+	Host string
+	Auth struct {
+		Password string `secret:"true"`
+	}
+}
+
+func TestStdApplication_ConfigProvenanceRedactsSecrets(t *testing.T) {
+	logger := &provenanceTestLogger{}
+	cfg := &provenanceSecretConfig{}
+	app := NewStdApplication(NewStdConfigProvider(cfg), logger, &rawValueFeeder{}).(*StdApplication)
+	app.SetVerboseConfig(true)
+
+	require.NoError(t, app.Init())
+	assert.Equal(t, "hunter2", cfg.Auth.Password, "the secret field is still populated")
+
+	provenance := app.ConfigProvenance()
+	assert.Equal(t, feeders.RedactedValue, provenance["Auth.Password"].RawValue)
+	assert.Equal(t, "db.internal", provenance["Host"].RawValue, "non-secret fields keep their raw value")
+
+	report := strings.Join(logger.messages("Config field provenance"), "\n")
+	assert.Contains(t, report, feeders.RedactedValue)
+	assert.Contains(t, report, "db.internal")
+	assert.NotContains(t, report, "hunter2")
+}
+
+type provenanceLayeredConfig struct {
+	AppName  string `yaml:"app_name"`
+	Database struct {
+		Host     string `yaml:"host"`
+		Port     int    `yaml:"port"`
+		Password string `yaml:"password" secret:"true"`
+	} `yaml:"database"`
+	Features map[string]bool `yaml:"features"`
+}
+
+func TestStdApplication_ConfigProvenanceBaseConfigOverlay(t *testing.T) {
+	configDir := t.TempDir()
+	basePath := filepath.Join(configDir, "base", "default.yaml")
+	prodPath := filepath.Join(configDir, "environments", "prod", "overrides.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(basePath), 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Dir(prodPath), 0o700))
+	require.NoError(t, os.WriteFile(basePath, []byte(`app_name: layered
+database:
+  host: db.dev.internal
+  port: 5432
+  password: dev-password
+features:
+  search: true
+  beta: false
+`), 0o600))
+	require.NoError(t, os.WriteFile(prodPath, []byte(`database:
+  host: db.prod.internal
+  password: prod-password
+features:
+  beta: true
+`), 0o600))
+	SetBaseConfig(configDir, "prod")
+	t.Cleanup(func() { BaseConfigSettings = BaseConfigOptions{} })
+
+	cfg := &provenanceLayeredConfig{}
+	app := NewStdApplication(NewStdConfigProvider(cfg), &provenanceTestLogger{}).(*StdApplication)
+	require.NoError(t, app.Init())
+
+	// The overlay wins over the base config, which fills in the rest
+	assert.Equal(t, "db.prod.internal", cfg.Database.Host)
+	assert.Equal(t, 5432, cfg.Database.Port)
+	assert.Equal(t, "prod-password", cfg.Database.Password)
+	assert.Equal(t, map[string]bool{"search": true, "beta": true}, cfg.Features)
+
+	provenance := app.ConfigProvenance()
+	assert.Equal(t, FieldProvenance{FieldPath: "Database.Host", Feeder: "*feeders.BaseConfigFeeder", Source: prodPath, RawValue: "db.prod.internal"},
+		provenance["Database.Host"])
+	assert.Equal(t, FieldProvenance{FieldPath: "Database.Port", Feeder: "*feeders.BaseConfigFeeder", Source: basePath, RawValue: 5432},
+		provenance["Database.Port"])
+	assert.Equal(t, basePath, provenance["AppName"].Source)
+	assert.Equal(t, prodPath, provenance["Features.beta"].Source)
+	assert.Equal(t, basePath, provenance["Features.search"].Source)
+
+	// Secret fields are redacted but keep their source
+	assert.Equal(t, feeders.RedactedValue, provenance["Database.Password"].RawValue)
+	assert.Equal(t, prodPath, provenance["Database.Password"].Source)
+}
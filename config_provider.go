@@ -1,9 +1,13 @@
 package modular
 
 import (
+	"context"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 )
@@ -321,6 +325,9 @@ type Config struct {
 	Logger Logger
 	// FieldTracker tracks which fields are populated by which feeders
 	FieldTracker FieldTracker
+	// SecretResolvers resolve references in fields tagged `secret:"true"`,
+	// ahead of the built-in env and file resolvers
+	SecretResolvers []SecretResolver
 }
 
 // NewConfig creates a new configuration builder.
@@ -395,6 +402,18 @@ func (c *Config) AddFeeder(feeder Feeder) *Config {
 	return c
 }
 
+// AddSecretResolver adds resolvers for the references held by fields tagged
+// `secret:"true"`. They are consulted before the built-in env and file resolvers.
+func (c *Config) AddSecretResolver(resolvers ...SecretResolver) *Config {
+	c.SecretResolvers = append(c.SecretResolvers, resolvers...)
+	return c
+}
+
+// secretResolvers returns the configured resolvers followed by the defaults
+func (c *Config) secretResolvers() []SecretResolver {
+	return append(slices.Clone(c.SecretResolvers), DefaultSecretResolvers()...)
+}
+
 // AddStructKey adds a structure with a key to the configuration
 func (c *Config) AddStructKey(key string, target any) *Config {
 	c.StructKeys[key] = target
@@ -494,7 +513,9 @@ func (c *Config) FeedWithModuleContext(target any, moduleName string) error {
 	return nil
 }
 
-// Feed with validation applies defaults and validates configs after feeding
+// Feed with validation applies defaults and validates configs after feeding.
+// Required fields are checked once every struct has been fed by every feeder,
+// and all missing fields across all structs are reported in a single error.
 func (c *Config) Feed() error {
 	if c.VerboseDebug && c.Logger != nil {
 		c.Logger.Debug("Starting config feed process", "structKeysCount", len(c.StructKeys), "feedersCount", len(c.Feeders))
@@ -509,110 +530,170 @@ func (c *Config) Feed() error {
 			c.Logger.Debug("Using enhanced feeding process with field tracking")
 		}
 
+		keys := slices.Sorted(maps.Keys(c.StructKeys))
+
 		// Feed each struct key with each feeder
-		for key, target := range c.StructKeys {
-			if c.VerboseDebug && c.Logger != nil {
-				c.Logger.Debug("Processing struct key", "key", key, "targetType", reflect.TypeOf(target))
+		for _, key := range keys {
+			if err := c.feedStructKey(key, c.StructKeys[key], sortedFeeders); err != nil {
+				return err
 			}
+		}
 
-			for i, f := range sortedFeeders {
-				if c.VerboseDebug && c.Logger != nil {
-					c.Logger.Debug("Applying feeder to struct", "key", key, "feederIndex", i, "feederType", fmt.Sprintf("%T", f))
-				}
-
-				// Try module-aware feeder first if this is a section config (not main config)
-				if key != mainConfigSection {
-					if maf, ok := f.(ModuleAwareFeeder); ok {
-						if c.VerboseDebug && c.Logger != nil {
-							c.Logger.Debug("Using ModuleAwareFeeder for section", "key", key, "feederType", fmt.Sprintf("%T", f))
-						}
-						if err := maf.FeedWithModuleContext(target, key); err != nil {
-							if c.VerboseDebug && c.Logger != nil {
-								c.Logger.Debug("ModuleAwareFeeder Feed method failed", "key", key, "feederType", fmt.Sprintf("%T", f), "error", err)
-							}
-							return fmt.Errorf("config feeder error: %w: %w", ErrConfigFeederError, err)
-						}
-					} else {
-						// Fall back to regular Feed method for non-module-aware feeders
-						if err := f.Feed(target); err != nil {
-							if c.VerboseDebug && c.Logger != nil {
-								c.Logger.Debug("Regular Feed method failed", "key", key, "feederType", fmt.Sprintf("%T", f), "error", err)
-							}
-							return fmt.Errorf("config feeder error: %w: %w", ErrConfigFeederError, err)
-						}
-					}
-				} else {
-					// Use regular Feed method for main config
-					if err := f.Feed(target); err != nil {
-						if c.VerboseDebug && c.Logger != nil {
-							c.Logger.Debug("Feeder Feed method failed", "key", key, "feederType", fmt.Sprintf("%T", f), "error", err)
-						}
-						return fmt.Errorf("config feeder error: %w: %w", ErrConfigFeederError, err)
-					}
-				}
-
-				// Also try ComplexFeeder if available (for instance-aware feeders)
-				if cf, ok := f.(ComplexFeeder); ok {
-					if c.VerboseDebug && c.Logger != nil {
-						c.Logger.Debug("Applying ComplexFeeder FeedKey", "key", key, "feederType", fmt.Sprintf("%T", f))
-					}
-
-					if err := cf.FeedKey(key, target); err != nil {
-						if c.VerboseDebug && c.Logger != nil {
-							c.Logger.Debug("ComplexFeeder FeedKey failed", "key", key, "feederType", fmt.Sprintf("%T", f), "error", err)
-						}
-						return fmt.Errorf("config feeder error: %w: %w", ErrConfigFeederError, err)
-					}
-				}
-
-				if c.VerboseDebug && c.Logger != nil {
-					c.Logger.Debug("Feeder applied successfully", "key", key, "feederType", fmt.Sprintf("%T", f))
-				}
+		// Resolve secret references before defaults and validation see the values
+		for _, key := range keys {
+			if err := ResolveSecretFields(context.Background(), c.StructKeys[key], c.secretResolvers()...); err != nil {
+				return fmt.Errorf("config secret error for %s: %w", key, err)
 			}
+		}
 
-			// Apply defaults and validate config
+		// Apply defaults and check required fields across all structs
+		var missing []string
+		for _, key := range keys {
+			target := c.StructKeys[key]
 			if c.VerboseDebug && c.Logger != nil {
 				c.Logger.Debug("Validating config for struct key", "key", key)
 			}
 
-			if err := ValidateConfig(target); err != nil {
-				if c.VerboseDebug && c.Logger != nil {
-					c.Logger.Debug("Config validation failed", "key", key, "error", err)
-				}
+			if err := ProcessConfigDefaults(target); err != nil {
 				return fmt.Errorf("config validation error for %s: %w", key, err)
 			}
-
+			prefix := key
+			if key == mainConfigSection {
+				prefix = ""
+			}
+			fields, err := missingRequiredFields(target, prefix)
+			if err != nil {
+				return fmt.Errorf("config validation error for %s: %w", key, err)
+			}
+			missing = append(missing, fields...)
+		}
+		if len(missing) > 0 {
 			if c.VerboseDebug && c.Logger != nil {
-				c.Logger.Debug("Config validation succeeded", "key", key)
+				c.Logger.Debug("Required config fields are missing", "fields", missing)
 			}
+			return fmt.Errorf("%w: %w: %s", ErrConfigValidationFailed, ErrConfigRequiredFieldMissing, strings.Join(missing, ", "))
+		}
+
+		// Run custom validation and setup
+		for _, key := range keys {
+			if err := c.validateAndSetup(key, c.StructKeys[key]); err != nil {
+				return err
+			}
+		}
+	} else {
+		// No struct keys configured - this means no explicit structures were added
+		if c.VerboseDebug && c.Logger != nil {
+			c.Logger.Debug("No struct keys configured - skipping feed process")
+		}
+	}
+
+	if c.VerboseDebug && c.Logger != nil {
+		c.Logger.Debug("Config feed process completed successfully")
+	}
 
-			// Call Setup if implemented
-			if setupable, ok := target.(ConfigSetup); ok {
+	return nil
+}
+
+// feedStructKey applies each feeder, in priority order, to the struct registered under key
+func (c *Config) feedStructKey(key string, target any, sortedFeeders []Feeder) error {
+	if c.VerboseDebug && c.Logger != nil {
+		c.Logger.Debug("Processing struct key", "key", key, "targetType", reflect.TypeOf(target))
+	}
+	if st, ok := c.FieldTracker.(sectionAwareTracker); ok {
+		st.setSection(key)
+	}
+
+	for i, f := range sortedFeeders {
+		if c.VerboseDebug && c.Logger != nil {
+			c.Logger.Debug("Applying feeder to struct", "key", key, "feederIndex", i, "feederType", fmt.Sprintf("%T", f))
+		}
+		if ft, ok := c.FieldTracker.(feederAwareTracker); ok {
+			ft.setFeeder(f)
+		}
+
+		// Try module-aware feeder first if this is a section config (not main config)
+		if key != mainConfigSection {
+			if maf, ok := f.(ModuleAwareFeeder); ok {
 				if c.VerboseDebug && c.Logger != nil {
-					c.Logger.Debug("Calling Setup for config", "key", key)
+					c.Logger.Debug("Using ModuleAwareFeeder for section", "key", key, "feederType", fmt.Sprintf("%T", f))
+				}
+				if err := maf.FeedWithModuleContext(target, key); err != nil {
+					if c.VerboseDebug && c.Logger != nil {
+						c.Logger.Debug("ModuleAwareFeeder Feed method failed", "key", key, "feederType", fmt.Sprintf("%T", f), "error", err)
+					}
+					return fmt.Errorf("config feeder error: %w: %w", ErrConfigFeederError, err)
 				}
-				if err := setupable.Setup(); err != nil {
+			} else {
+				// Fall back to regular Feed method for non-module-aware feeders
+				if err := f.Feed(target); err != nil {
 					if c.VerboseDebug && c.Logger != nil {
-						c.Logger.Debug("Config setup failed", "key", key, "error", err)
+						c.Logger.Debug("Regular Feed method failed", "key", key, "feederType", fmt.Sprintf("%T", f), "error", err)
 					}
-					return fmt.Errorf("%w for %s: %w", ErrConfigSetupError, key, err)
+					return fmt.Errorf("config feeder error: %w: %w", ErrConfigFeederError, err)
 				}
+			}
+		} else {
+			// Use regular Feed method for main config
+			if err := f.Feed(target); err != nil {
 				if c.VerboseDebug && c.Logger != nil {
-					c.Logger.Debug("Config setup succeeded", "key", key)
+					c.Logger.Debug("Feeder Feed method failed", "key", key, "feederType", fmt.Sprintf("%T", f), "error", err)
 				}
+				return fmt.Errorf("config feeder error: %w: %w", ErrConfigFeederError, err)
 			}
 		}
-	} else {
-		// No struct keys configured - this means no explicit structures were added
+
+		// Also try ComplexFeeder if available (for instance-aware feeders)
+		if cf, ok := f.(ComplexFeeder); ok {
+			if c.VerboseDebug && c.Logger != nil {
+				c.Logger.Debug("Applying ComplexFeeder FeedKey", "key", key, "feederType", fmt.Sprintf("%T", f))
+			}
+
+			if err := cf.FeedKey(key, target); err != nil {
+				if c.VerboseDebug && c.Logger != nil {
+					c.Logger.Debug("ComplexFeeder FeedKey failed", "key", key, "feederType", fmt.Sprintf("%T", f), "error", err)
+				}
+				return fmt.Errorf("config feeder error: %w: %w", ErrConfigFeederError, err)
+			}
+		}
+
 		if c.VerboseDebug && c.Logger != nil {
-			c.Logger.Debug("No struct keys configured - skipping feed process")
+			c.Logger.Debug("Feeder applied successfully", "key", key, "feederType", fmt.Sprintf("%T", f))
+		}
+	}
+
+	return nil
+}
+
+// validateAndSetup runs the custom validation and Setup of the struct registered under key
+func (c *Config) validateAndSetup(key string, target any) error {
+	if validator, ok := target.(ConfigValidator); ok {
+		if err := validator.Validate(); err != nil {
+			if c.VerboseDebug && c.Logger != nil {
+				c.Logger.Debug("Config validation failed", "key", key, "error", err)
+			}
+			return fmt.Errorf("config validation error for %s: config validation failed: %w", key, err)
 		}
 	}
 
 	if c.VerboseDebug && c.Logger != nil {
-		c.Logger.Debug("Config feed process completed successfully")
+		c.Logger.Debug("Config validation succeeded", "key", key)
 	}
 
+	// Call Setup if implemented
+	if setupable, ok := target.(ConfigSetup); ok {
+		if c.VerboseDebug && c.Logger != nil {
+			c.Logger.Debug("Calling Setup for config", "key", key)
+		}
+		if err := setupable.Setup(); err != nil {
+			if c.VerboseDebug && c.Logger != nil {
+				c.Logger.Debug("Config setup failed", "key", key, "error", err)
+			}
+			return fmt.Errorf("%w for %s: %w", ErrConfigSetupError, key, err)
+		}
+		if c.VerboseDebug && c.Logger != nil {
+			c.Logger.Debug("Config setup succeeded", "key", key)
+		}
+	}
 	return nil
 }
 
@@ -681,41 +762,14 @@ func loadAppConfig(app *StdApplication) error {
 		}
 	}
 
-	// Prepare config feeders - include base config feeder if enabled.
-	// Priority / order:
-	//   1. Base config feeder (if enabled)
-	//   2. Per-app feeders (if explicitly provided via SetConfigFeeders)
-	//   3. Global ConfigFeeders fallback (if no per-app feeders provided)
-	var effectiveFeeders []Feeder
-
-	// Start capacity estimation (base + either per-app or global)
-	baseCount := 0
-	if IsBaseConfigEnabled() && GetBaseConfigFeeder() != nil {
-		baseCount = 1
-	}
-	if app.configFeeders != nil {
-		effectiveFeeders = make([]Feeder, 0, baseCount+len(app.configFeeders))
-	} else {
-		effectiveFeeders = make([]Feeder, 0, baseCount+len(ConfigFeeders))
-	}
-
-	// Add base config feeder first if enabled (so it gets processed first)
-	if IsBaseConfigEnabled() {
-		if baseFeeder := GetBaseConfigFeeder(); baseFeeder != nil {
-			effectiveFeeders = append(effectiveFeeders, baseFeeder)
-			if app.IsVerboseConfig() {
-				app.logger.Debug("Added base config feeder",
-					"configDir", BaseConfigSettings.ConfigDir,
-					"environment", BaseConfigSettings.Environment)
-			}
-		}
+	effectiveFeeders, usingGlobal := effectiveConfigFeeders(app)
+	if usingGlobal {
+		app.logger.Warn("Using deprecated global modular.ConfigFeeders; configure feeders per application with SetConfigFeeders or WithConfigFeeders")
 	}
-
-	// Append per-app feeders if provided; else fall back to global
-	if app.configFeeders != nil {
-		effectiveFeeders = append(effectiveFeeders, app.configFeeders...)
-	} else {
-		effectiveFeeders = append(effectiveFeeders, ConfigFeeders...)
+	if app.IsVerboseConfig() && IsBaseConfigEnabled() && GetBaseConfigFeeder() != nil {
+		app.logger.Debug("Added base config feeder",
+			"configDir", BaseConfigSettings.ConfigDir,
+			"environment", BaseConfigSettings.Environment)
 	}
 
 	// Skip if no feeders are defined
@@ -736,6 +790,9 @@ func loadAppConfig(app *StdApplication) error {
 	if app.IsVerboseConfig() {
 		cfgBuilder.SetVerboseDebug(true, app.logger)
 	}
+	provenance := newProvenanceTracker(cfgBuilder.FieldTracker)
+	cfgBuilder.SetFieldTracker(provenance)
+	cfgBuilder.AddSecretResolver(app.secretResolvers...)
 	for _, feeder := range effectiveFeeders {
 		cfgBuilder.AddFeeder(feeder)
 		if app.IsVerboseConfig() {
@@ -756,6 +813,12 @@ func loadAppConfig(app *StdApplication) error {
 		app.logger.Debug("Configuration structures prepared for feeding", "count", len(tempConfigs))
 	}
 
+	// Keep the registered values so reloads are fed from the same starting point
+	app.initialConfigs = make(map[string]any, len(tempConfigs))
+	for section, info := range tempConfigs {
+		app.initialConfigs[section] = copyConfigValue(info.tempVal).Interface()
+	}
+
 	// Feed all configs at once
 	if err := cfgBuilder.Feed(); err != nil {
 		if app.IsVerboseConfig() {
@@ -765,15 +828,18 @@ func loadAppConfig(app *StdApplication) error {
 	}
 
 	// Apply instance-aware feeding for supported configurations AFTER regular feeding
-	if err := applyInstanceAwareFeeding(app, tempConfigs); err != nil {
+	if err := applyInstanceAwareFeeding(app, tempConfigs, cfgBuilder.secretResolvers()); err != nil {
 		if app.IsVerboseConfig() {
 			app.logger.Debug("Instance-aware feeding failed", "error", err)
 		}
 		return err
 	}
 
+	fieldProvenance := redactSecretProvenance(provenance.snapshot(), tempConfigs)
+	app.setConfigProvenance(fieldProvenance)
 	if app.IsVerboseConfig() {
 		app.logger.Debug("Configuration feeding completed successfully")
+		app.logConfigProvenance(fieldProvenance)
 	}
 
 	// Apply updated configs
@@ -786,6 +852,28 @@ func loadAppConfig(app *StdApplication) error {
 	return nil
 }
 
+// effectiveConfigFeeders returns the feeders used to load the application's config.
+// Priority / order:
+//  1. Base config feeder (if enabled)
+//  2. Per-app feeders (if explicitly provided via SetConfigFeeders / WithConfigFeeders)
+//  3. Default feeders (a per-app env feeder, or the deprecated global ConfigFeeders if modified)
+//
+// usingGlobal reports whether the deprecated global ConfigFeeders were selected.
+func effectiveConfigFeeders(app *StdApplication) (feeders []Feeder, usingGlobal bool) {
+	appFeeders := app.configFeeders
+	if appFeeders == nil {
+		appFeeders, usingGlobal = defaultConfigFeeders()
+	}
+
+	if IsBaseConfigEnabled() {
+		if baseFeeder := GetBaseConfigFeeder(); baseFeeder != nil {
+			// The base config feeder goes first so it gets processed first
+			feeders = append(feeders, baseFeeder)
+		}
+	}
+	return append(feeders, appFeeders...), usingGlobal
+}
+
 // processConfigs handles the collection and preparation of configs
 func processConfigs(app *StdApplication, cfgBuilder *Config) (map[string]configInfo, bool) {
 	tempConfigs := make(map[string]configInfo)
@@ -920,8 +1008,9 @@ func applyConfigUpdates(app *StdApplication, tempConfigs map[string]configInfo)
 	}
 }
 
-// applyInstanceAwareFeeding applies instance-aware feeding to configurations that support it
-func applyInstanceAwareFeeding(app *StdApplication, tempConfigs map[string]configInfo) error {
+// applyInstanceAwareFeeding applies instance-aware feeding to configurations that support it.
+// Secret references fed into instance configs are resolved with resolvers.
+func applyInstanceAwareFeeding(app *StdApplication, tempConfigs map[string]configInfo, resolvers []SecretResolver) error {
 	if app.IsVerboseConfig() {
 		app.logger.Debug("Starting instance-aware feeding process")
 	}
@@ -1011,6 +1100,10 @@ func applyInstanceAwareFeeding(app *StdApplication, tempConfigs map[string]confi
 				continue
 			}
 
+			if err := ResolveSecretFields(context.Background(), instanceConfig, resolvers...); err != nil {
+				return fmt.Errorf("config secret error for %s instance %s: %w", sectionKey, instanceKey, err)
+			}
+
 			if app.IsVerboseConfig() {
 				app.logger.Debug("Successfully fed instance configuration", "section", sectionKey, "instance", instanceKey)
 			}
@@ -0,0 +1,346 @@
+package modular
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DynamicReloadConfig configures the dynamic reload feature enabled by
+// WithDynamicReloadConfig.
+type DynamicReloadConfig struct {
+	// WatchFiles lists config files to watch. When one of them changes, all
+	// config feeders are re-run and the changed fields tagged `dynamic:"true"`
+	// are delivered to Reloadable modules through the ReloadOrchestrator.
+	WatchFiles []string
+
+	// Debounce is how long to wait after the last file change before reloading,
+	// so that editors writing a file in several steps trigger a single reload.
+	// Defaults to 500ms.
+	Debounce time.Duration
+}
+
+// configFileReloader watches config files and requests a reload of the
+// dynamic config fields whenever they change. It also reloads on demand, such
+// as on SIGHUP, with or without watched files.
+type configFileReloader struct {
+	app     *StdApplication
+	watcher *fileWatcher
+
+	// reloadMu serializes reloads and guards current
+	reloadMu sync.Mutex
+	current  configFields // Field values as of the last reload
+}
+
+// newConfigFileReloader creates a reloader for app's watched files, recording
+// the dynamic field values of the current config as the baseline for diffs.
+func newConfigFileReloader(app *StdApplication, cfg DynamicReloadConfig) *configFileReloader {
+	r := &configFileReloader{
+		app:     app,
+		current: collectConfigFields(app.currentConfigs()),
+	}
+	r.watcher = newFileWatcher("config", cfg.WatchFiles, cfg.Debounce, app.logger, func(ctx context.Context) {
+		if err := r.reload(ctx, ReloadFileChange); err != nil {
+			r.app.logger.Error("Failed to reload config after file change", "error", err)
+		}
+	})
+	return r
+}
+
+// start begins watching the config files.
+func (r *configFileReloader) start(ctx context.Context) error {
+	return r.watcher.start(ctx)
+}
+
+// stop stops watching the config files. It is safe to call multiple times.
+func (r *configFileReloader) stop() {
+	r.watcher.stop()
+}
+
+// reload re-runs all config feeders and reloads the dynamic fields that
+// changed since the last successful reload. It waits for the orchestrator, and
+// only once every module has accepted the changes are they copied into the
+// live configs and recorded as the new baseline, so a failed reload is
+// retried on the next trigger.
+func (r *configFileReloader) reload(ctx context.Context, trigger ReloadTrigger) error {
+	r.reloadMu.Lock()
+	defer r.reloadMu.Unlock()
+
+	configs, err := r.app.feedFreshConfigs()
+	if err != nil {
+		return err
+	}
+	next := collectConfigFields(configs)
+
+	// Fields that are not tagged dynamic only take effect after a restart
+	static := diffConfigFields(r.current.static, next.static)
+	for _, fields := range []map[string]FieldChange{static.Added, static.Changed, static.Removed} {
+		for _, path := range slices.Sorted(maps.Keys(fields)) {
+			r.app.logger.Warn("Config field changed but is not tagged dynamic; restart the application to apply it",
+				"field", path)
+		}
+	}
+	r.current.static = next.static
+
+	diff := diffConfigFields(r.current.dynamic, next.dynamic)
+	if !diff.HasChanges() {
+		r.app.logger.Debug("Config reloaded without dynamic field changes", "trigger", trigger.String())
+		return nil
+	}
+
+	if r.app.reloadOrchestrator == nil {
+		return ErrDynamicReloadNotEnabled
+	}
+	r.app.logger.Info("Config changed, requesting reload", "trigger", trigger.String(), "changes", diff.ChangeSummary())
+	if err := r.app.reloadOrchestrator.requestReloadAndWait(ctx, trigger, diff); err != nil {
+		return fmt.Errorf("reloading config: %w", err)
+	}
+	for section, live := range r.app.currentConfigs() {
+		if fresh, ok := configs[section]; ok {
+			copyDynamicFields(reflect.ValueOf(live), reflect.ValueOf(fresh))
+		}
+	}
+	r.current.dynamic = next.dynamic
+	return nil
+}
+
+// currentConfigs returns the main config and every section config keyed by
+// section name, with the main config under mainConfigSection.
+func (app *StdApplication) currentConfigs() map[string]any {
+	configs := make(map[string]any, len(app.cfgSections)+1)
+	if app.cfgProvider != nil {
+		if cfg := app.cfgProvider.GetConfig(); cfg != nil {
+			configs[mainConfigSection] = cfg
+		}
+	}
+	for section, provider := range app.cfgSections {
+		if provider == nil {
+			continue
+		}
+		if cfg := provider.GetConfig(); cfg != nil {
+			configs[section] = cfg
+		}
+	}
+	return configs
+}
+
+// feedFreshConfigs re-runs all config feeders on copies of the configs as they
+// were registered, before Init fed them, so a key that is no longer set falls
+// back to its registered value or `default` tag exactly as it did at Init.
+// Feeding applies the same defaults, validation and setup as Init. The live
+// configs are left untouched. The fed configs are returned keyed like
+// currentConfigs.
+func (app *StdApplication) feedFreshConfigs() (map[string]any, error) {
+	cfgBuilder := NewConfig()
+	cfgBuilder.AddSecretResolver(app.secretResolvers...)
+	feeders, _ := effectiveConfigFeeders(app)
+	for _, feeder := range feeders {
+		cfgBuilder.AddFeeder(feeder)
+	}
+
+	tempConfigs := make(map[string]configInfo)
+	for section, cfg := range app.currentConfigs() {
+		tempCfg, info, err := app.newReloadConfig(section, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("copying config section %s: %w", section, err)
+		}
+		cfgBuilder.AddStructKey(section, tempCfg)
+		tempConfigs[section] = info
+	}
+
+	if err := cfgBuilder.Feed(); err != nil {
+		return nil, fmt.Errorf("feeding config: %w", err)
+	}
+	if err := applyInstanceAwareFeeding(app, tempConfigs, cfgBuilder.secretResolvers()); err != nil {
+		return nil, err
+	}
+
+	configs := make(map[string]any, len(tempConfigs))
+	for section, info := range tempConfigs {
+		configs[section] = info.tempVal.Interface()
+	}
+	return configs, nil
+}
+
+// newReloadConfig returns a copy of the registered value of the config for
+// section to feed, or a new zero value of cfg's type for a section registered
+// after the config was loaded
+func (app *StdApplication) newReloadConfig(section string, cfg any) (any, configInfo, error) {
+	cfgValue := reflect.ValueOf(cfg)
+	isPtr := cfgValue.Kind() == reflect.Pointer
+	targetType := cfgValue.Type()
+	if isPtr {
+		if cfgValue.IsNil() {
+			return nil, configInfo{}, ErrConfigNilPointer
+		}
+		targetType = targetType.Elem()
+	}
+	var tempCfgValue reflect.Value
+	if initial, ok := app.initialConfigs[section]; ok && reflect.TypeOf(initial).Elem() == targetType {
+		tempCfgValue = copyConfigValue(reflect.ValueOf(initial))
+	} else {
+		tempCfgValue = reflect.New(targetType)
+	}
+	return tempCfgValue.Interface(), configInfo{
+		originalVal: cfgValue,
+		tempVal:     tempCfgValue,
+		isPtr:       isPtr,
+	}, nil
+}
+
+// copyConfigValue returns a pointer to a copy of the struct ptr points to.
+// Maps, slices and pointers reachable through exported fields are duplicated so
+// feeding the copy cannot change the original; unexported fields are copied
+// as is.
+func copyConfigValue(ptr reflect.Value) reflect.Value {
+	copied := reflect.New(ptr.Elem().Type())
+	copied.Elem().Set(ptr.Elem())
+	deepCopyValue(copied.Elem(), ptr.Elem())
+	return copied
+}
+
+// copyDynamicFields copies the fields tagged `dynamic:"true"` from src into
+// dst, walking nested structs the same way as walkConfigFields. Both must be
+// pointers to structs of the same type.
+func copyDynamicFields(dst, src reflect.Value) {
+	for dst.Kind() == reflect.Pointer || dst.Kind() == reflect.Interface {
+		if dst.IsNil() || src.IsNil() {
+			return
+		}
+		dst, src = dst.Elem(), src.Elem()
+	}
+	if dst.Kind() != reflect.Struct || dst.Type() != src.Type() {
+		return
+	}
+
+	t := dst.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		switch {
+		case field.Tag.Get("dynamic") == "true":
+			dst.Field(i).Set(src.Field(i))
+		case hasExportedFields(dst.Field(i)):
+			copyDynamicFields(dst.Field(i), src.Field(i))
+		}
+	}
+}
+
+// configFields holds the leaf field values of a set of configs, keyed by field
+// path prefixed with the section name. Main config fields are not prefixed.
+type configFields struct {
+	dynamic map[string]any // Fields tagged `dynamic:"true"`
+	static  map[string]any // All other fields
+}
+
+// collectConfigFields returns the leaf field values of configs, split into
+// fields that can be reloaded at runtime and fields that need a restart.
+func collectConfigFields(configs map[string]any) configFields {
+	fields := configFields{
+		dynamic: make(map[string]any),
+		static:  make(map[string]any),
+	}
+	for section, cfg := range configs {
+		prefix := section + "."
+		if section == mainConfigSection {
+			prefix = ""
+		}
+		walkConfigFields(reflect.ValueOf(cfg), prefix, fields)
+	}
+	return fields
+}
+
+// walkConfigFields records the fields of the struct v points to. Nested structs
+// are walked unless they are tagged dynamic, in which case they are recorded
+// as a whole.
+func walkConfigFields(v reflect.Value, prefix string, fields configFields) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		path := prefix + field.Name
+		value := v.Field(i)
+		switch {
+		case field.Tag.Get("dynamic") == "true":
+			fields.dynamic[path] = value.Interface()
+		case hasExportedFields(value):
+			walkConfigFields(value, path+".", fields)
+		default:
+			fields.static[path] = value.Interface()
+		}
+	}
+}
+
+// hasExportedFields reports whether v is a struct, or a non-nil pointer to one,
+// with exported fields to walk
+func hasExportedFields(v reflect.Value) bool {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+	for i := range v.NumField() {
+		if v.Type().Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+// diffConfigFields compares two sets of field values
+func diffConfigFields(oldFields, newFields map[string]any) ConfigDiff {
+	now := time.Now()
+	diff := ConfigDiff{
+		Changed:   make(map[string]FieldChange),
+		Added:     make(map[string]FieldChange),
+		Removed:   make(map[string]FieldChange),
+		Timestamp: now,
+		DiffID:    fmt.Sprintf("file-change-%d", now.UnixNano()),
+	}
+	for path, newValue := range newFields {
+		oldValue, existed := oldFields[path]
+		switch {
+		case !existed:
+			diff.Added[path] = FieldChange{FieldPath: path, NewValue: newValue, ChangeType: ChangeAdded}
+		case !reflect.DeepEqual(oldValue, newValue):
+			diff.Changed[path] = FieldChange{FieldPath: path, OldValue: oldValue, NewValue: newValue, ChangeType: ChangeModified}
+		}
+	}
+	for path, oldValue := range oldFields {
+		if _, exists := newFields[path]; !exists {
+			diff.Removed[path] = FieldChange{FieldPath: path, OldValue: oldValue, ChangeType: ChangeRemoved}
+		}
+	}
+	return diff
+}
+
+// reloadFromFeeders re-runs all config feeders and requests a reload of the
+// dynamic fields that changed, as is done when a watched file changes
+func (app *StdApplication) reloadFromFeeders(ctx context.Context, trigger ReloadTrigger) error {
+	if app.fileReloader == nil {
+		return ErrDynamicReloadNotEnabled
+	}
+	return app.fileReloader.reload(ctx, trigger)
+}
@@ -0,0 +1,263 @@
+package modular
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular/feeders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type watchedCacheConfig struct {
+	TTL  time.Duration `yaml:"ttl" dynamic:"true" default:"1m"`
+	Size int           `yaml:"size"`
+}
+
+// watchedCacheModule registers a "cache" config section and records the
+// changes it is asked to reload
+type watchedCacheModule struct {
+	mockReloadable
+	config *watchedCacheConfig
+}
+
+func (m *watchedCacheModule) Name() string { return "cache" }
+
+func (m *watchedCacheModule) RegisterConfig(app Application) error {
+	app.RegisterConfigSection("cache", NewStdConfigProvider(m.config))
+	return nil
+}
+
+func (m *watchedCacheModule) Init(Application) error { return nil }
+
+// pollerConfig has dynamic fields defaulted in Go and by Validate rather than
+// by `default` tags
+type pollerConfig struct {
+	Interval time.Duration `yaml:"interval" dynamic:"true"`
+	Retries  int           `yaml:"retries" dynamic:"true"`
+	Workers  int           `yaml:"workers"`
+}
+
+func (c *pollerConfig) Validate() error {
+	if c.Retries == 0 {
+		c.Retries = 3
+	}
+	return nil
+}
+
+// watchedPollerModule registers a "poller" config section with a programmatic
+// default and records the changes it is asked to reload
+type watchedPollerModule struct {
+	mockReloadable
+	config *pollerConfig
+}
+
+func (m *watchedPollerModule) Name() string { return "poller" }
+
+func (m *watchedPollerModule) RegisterConfig(app Application) error {
+	app.RegisterConfigSection("poller", NewStdConfigProvider(m.config))
+	return nil
+}
+
+func (m *watchedPollerModule) Init(Application) error { return nil }
+
+// warnings returns the recorded WARN messages
+func (l *reloadTestLogger) warnings() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var warns []string
+	for _, msg := range l.messages {
+		if strings.HasPrefix(msg, "[WARN]") {
+			warns = append(warns, msg)
+		}
+	}
+	return warns
+}
+
+// startWatchedCacheApp starts an application feeding the cache module's config
+// from yamlPath, watching the file for dynamic reloads
+func startWatchedCacheApp(t *testing.T, yamlPath string, logger Logger) (*StdApplication, *watchedCacheModule) {
+	t.Helper()
+	module := &watchedCacheModule{
+		mockReloadable: mockReloadable{canReload: true, timeout: time.Second},
+		config:         &watchedCacheConfig{},
+	}
+	return startWatchedApp(t, yamlPath, logger, module), module
+}
+
+// startWatchedApp starts an application with module, feeding config from
+// yamlPath and watching the file for dynamic reloads
+func startWatchedApp(t *testing.T, yamlPath string, logger Logger, module Module) *StdApplication {
+	t.Helper()
+	feeders.ResetGlobalEnvCatalog()
+	t.Cleanup(feeders.ResetGlobalEnvCatalog)
+
+	app, err := NewApplication(
+		WithLogger(logger),
+		WithConfigFeeders(feeders.NewYamlFeeder(yamlPath)),
+		WithModules(module),
+		WithDynamicReloadConfig(DynamicReloadConfig{
+			WatchFiles: []string{yamlPath},
+			Debounce:   50 * time.Millisecond,
+		}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, app.Init())
+	require.NoError(t, app.Start())
+	t.Cleanup(func() { _ = app.Stop() })
+	return app.(*StdApplication)
+}
+
+func TestDynamicReload_WatchFilesDeliversDynamicChanges(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 1s\n  size: 10\n"), 0o600))
+	_, module := startWatchedCacheApp(t, yamlPath, &reloadTestLogger{})
+	require.Equal(t, time.Second, module.config.TTL)
+
+	// Several writes within the debounce window trigger a single reload
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 3s\n  size: 20\n"), 0o600))
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 5s\n  size: 20\n"), 0o600))
+
+	require.Eventually(t, func() bool { return module.reloadCalls.Load() > 0 }, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []ConfigChange{{
+		FieldPath: "cache.TTL",
+		OldValue:  "1s",
+		NewValue:  "5s",
+		Source:    "diff",
+	}}, module.getLastChanges(), "only fields tagged dynamic are delivered")
+
+	// Once the module accepts the change the live config is updated, but
+	// fields that are not dynamic keep their value until a restart
+	require.Eventually(t, func() bool { return module.config.TTL == 5*time.Second }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 10, module.config.Size)
+
+	// Changes to non-dynamic fields alone do not trigger a reload
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 5s\n  size: 30\n"), 0o600))
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, int32(1), module.reloadCalls.Load())
+}
+
+func TestDiffConfigFields(t *testing.T) {
+	diff := diffConfigFields(
+		map[string]any{"Port": 80, "cache.TTL": time.Second, "cache.Old": "x"},
+		map[string]any{"Port": 80, "cache.TTL": 2 * time.Second, "cache.New": "y"},
+	)
+	assert.Equal(t, map[string]FieldChange{
+		"cache.TTL": {FieldPath: "cache.TTL", OldValue: time.Second, NewValue: 2 * time.Second, ChangeType: ChangeModified},
+	}, diff.Changed)
+	assert.Contains(t, diff.Added, "cache.New")
+	assert.Contains(t, diff.Removed, "cache.Old")
+}
+
+func TestDynamicReload_UnchangedConfigDeliversNothing(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 1s\n  size: 10\n"), 0o600))
+	logger := &reloadTestLogger{}
+	app, module := startWatchedCacheApp(t, yamlPath, logger)
+
+	require.NoError(t, app.fileReloader.reload(context.Background(), ReloadFileChange))
+	time.Sleep(100 * time.Millisecond)
+	assert.Zero(t, module.reloadCalls.Load(), "no reload is requested when no field changed")
+	assert.Empty(t, logger.warnings())
+}
+
+func TestDynamicReload_NonDynamicChangeWarns(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 1s\n  size: 10\n"), 0o600))
+	logger := &reloadTestLogger{}
+	app, module := startWatchedCacheApp(t, yamlPath, logger)
+	app.fileReloader.stop() // Reload by hand rather than on file events
+
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 1s\n  size: 20\n"), 0o600))
+	require.NoError(t, app.fileReloader.reload(context.Background(), ReloadFileChange))
+	time.Sleep(100 * time.Millisecond)
+	assert.Zero(t, module.reloadCalls.Load(), "non-dynamic changes are not reloaded")
+	require.Len(t, logger.warnings(), 1)
+	assert.Contains(t, logger.warnings()[0], "cache.Size")
+
+	// The warning is not repeated for the same change
+	require.NoError(t, app.fileReloader.reload(context.Background(), ReloadFileChange))
+	assert.Len(t, logger.warnings(), 1)
+}
+
+func TestDynamicReload_SingleChangedField(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 1s\n  size: 10\n"), 0o600))
+	app, module := startWatchedCacheApp(t, yamlPath, &reloadTestLogger{})
+	app.fileReloader.stop()
+
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 2m\n  size: 10\n"), 0o600))
+	require.NoError(t, app.fileReloader.reload(context.Background(), ReloadFileChange))
+	require.Eventually(t, func() bool { return module.reloadCalls.Load() == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []ConfigChange{{FieldPath: "cache.TTL", OldValue: "1s", NewValue: "2m0s", Source: "diff"}},
+		module.getLastChanges())
+}
+
+func TestDynamicReload_RemovedKeyRevertsToDefault(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 1s\n  size: 10\n"), 0o600))
+	app, module := startWatchedCacheApp(t, yamlPath, &reloadTestLogger{})
+	app.fileReloader.stop()
+
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  size: 10\n"), 0o600))
+	require.NoError(t, app.fileReloader.reload(context.Background(), ReloadFileChange))
+	assert.Equal(t, []ConfigChange{{FieldPath: "cache.TTL", OldValue: "1s", NewValue: "1m0s", Source: "diff"}},
+		module.getLastChanges())
+	assert.Equal(t, time.Minute, module.config.TTL, "the live config takes the default once the key is removed")
+}
+
+func TestDynamicReload_FailedReloadIsRetried(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 1s\n  size: 10\n"), 0o600))
+	app, module := startWatchedCacheApp(t, yamlPath, &reloadTestLogger{})
+	app.fileReloader.stop()
+
+	module.reloadErr = errors.New("reload rejected")
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 2s\n  size: 10\n"), 0o600))
+	require.Error(t, app.fileReloader.reload(context.Background(), ReloadFileChange))
+	assert.Equal(t, time.Second, module.config.TTL, "a rejected change is not applied to the live config")
+
+	// The same change is delivered again on the next trigger
+	module.reloadErr = nil
+	require.NoError(t, app.fileReloader.reload(context.Background(), ReloadFileChange))
+	assert.Equal(t, int32(2), module.reloadCalls.Load())
+	assert.Equal(t, []ConfigChange{{FieldPath: "cache.TTL", OldValue: "1s", NewValue: "2s", Source: "diff"}},
+		module.getLastChanges())
+	assert.Equal(t, 2*time.Second, module.config.TTL)
+}
+
+func TestDynamicReload_ProgrammaticDefaultsAreKept(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte("poller:\n  workers: 2\n"), 0o600))
+	logger := &reloadTestLogger{}
+	module := &watchedPollerModule{
+		mockReloadable: mockReloadable{canReload: true, timeout: time.Second},
+		config:         &pollerConfig{Interval: 30 * time.Second},
+	}
+	app := startWatchedApp(t, yamlPath, logger, module)
+	app.fileReloader.stop()
+	require.Equal(t, 30*time.Second, module.config.Interval)
+	require.Equal(t, 3, module.config.Retries)
+
+	// Changing an unrelated field leaves the defaulted dynamic fields alone
+	require.NoError(t, os.WriteFile(yamlPath, []byte("poller:\n  workers: 4\n"), 0o600))
+	require.NoError(t, app.fileReloader.reload(context.Background(), ReloadFileChange))
+	assert.Zero(t, module.reloadCalls.Load(), "defaulted dynamic fields are not reported as changed")
+	require.Len(t, logger.warnings(), 1)
+	assert.Contains(t, logger.warnings()[0], "poller.Workers")
+	assert.Equal(t, 30*time.Second, module.config.Interval)
+	assert.Equal(t, 3, module.config.Retries)
+
+	// Only the field whose value changed is delivered
+	require.NoError(t, os.WriteFile(yamlPath, []byte("poller:\n  workers: 4\n  retries: 5\n"), 0o600))
+	require.NoError(t, app.fileReloader.reload(context.Background(), ReloadFileChange))
+	assert.Equal(t, []ConfigChange{{FieldPath: "poller.Retries", OldValue: "3", NewValue: "5", Source: "diff"}},
+		module.getLastChanges())
+	assert.Equal(t, 30*time.Second, module.config.Interval)
+	assert.Equal(t, 5, module.config.Retries)
+}
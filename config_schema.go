@@ -0,0 +1,278 @@
+package modular
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// jsonSchemaDialect is the JSON Schema draft emitted by GenerateConfigSchema
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// GenerateConfigSchema generates a draft 2020-12 JSON Schema describing a
+// config struct, for validating configuration files in CI or editors. It
+// complements SaveSampleConfig and the resulting document can be compiled
+// by the jsonschema module.
+//
+// Properties are named after the `yaml` tag, falling back to the `json` tag
+// and then to the lower-cased field name, as the YAML feeder does. Other tags
+// are mapped as follows:
+//   - `desc`: the property's description
+//   - `default`: the property's default, typed like the field
+//   - `required:"true"`, or `required` in the `validate` tag: listed in the
+//     enclosing object's required array
+//   - `oneof=a b c` in the `validate` or `desc` tag: the property's enum
+//
+// Example:
+//
+//	type Config struct {
+//	    Host  string `yaml:"host" required:"true" desc:"Server host"`
+//	    Level string `yaml:"level" default:"info" desc:"Log level (oneof=debug info warn error)"`
+//	}
+//
+//	schema, err := modular.GenerateConfigSchema(&Config{})
+func GenerateConfigSchema(cfg any) ([]byte, error) {
+	if cfg == nil {
+		return nil, ErrConfigNil
+	}
+
+	t := reflect.TypeOf(cfg)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, ErrConfigNotStruct
+	}
+
+	schema, err := structSchema(t, map[reflect.Type]bool{})
+	if err != nil {
+		return nil, err
+	}
+	schema["$schema"] = jsonSchemaDialect
+	if t.Name() != "" {
+		schema["title"] = t.Name()
+	}
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config schema: %w", err)
+	}
+	return data, nil
+}
+
+// structSchema builds the object schema of a struct type. Types in visiting
+// are being expanded further up and are emitted as plain objects to stop
+// recursive types from expanding forever.
+func structSchema(t reflect.Type, visiting map[reflect.Type]bool) (map[string]any, error) {
+	if visiting[t] {
+		return map[string]any{"type": "object"}, nil
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	properties := make(map[string]any)
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, inline := schemaFieldName(&field)
+		if name == "-" {
+			continue
+		}
+
+		fieldSchema, err := typeSchema(field.Type, visiting)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+
+		if inline {
+			// Inlined structs contribute their fields to the enclosing object
+			if props, ok := fieldSchema["properties"].(map[string]any); ok {
+				for k, v := range props {
+					properties[k] = v
+				}
+			}
+			if req, ok := fieldSchema["required"].([]string); ok {
+				required = append(required, req...)
+			}
+			continue
+		}
+
+		if err := applySchemaTags(fieldSchema, &field); err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		properties[name] = fieldSchema
+		if isSchemaFieldRequired(&field) {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema, nil
+}
+
+// typeSchema returns the schema of a Go type
+func typeSchema(t reflect.Type, visiting map[reflect.Type]bool) (map[string]any, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case reflect.TypeFor[time.Duration]():
+		return map[string]any{"type": "string"}, nil
+	case reflect.TypeFor[time.Time]():
+		return map[string]any{"type": "string", "format": "date-time"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return map[string]any{"type": "integer", "minimum": 0}, nil
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}, nil
+	case reflect.String:
+		return map[string]any{"type": "string"}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := typeSchema(t.Elem(), visiting)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type": "array", "items": items}, nil
+	case reflect.Map:
+		values, err := typeSchema(t.Elem(), visiting)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type": "object", "additionalProperties": values}, nil
+	case reflect.Struct:
+		return structSchema(t, visiting)
+	case reflect.Interface:
+		// Any value is accepted
+		return map[string]any{}, nil
+	case reflect.Invalid, reflect.Complex64, reflect.Complex128, reflect.Chan,
+		reflect.Func, reflect.Pointer, reflect.UnsafePointer:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedSchemaType, t)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedSchemaType, t)
+	}
+}
+
+// schemaFieldName returns the property name of a struct field and whether
+// the field is an inlined struct
+func schemaFieldName(field *reflect.StructField) (string, bool) {
+	for _, tagName := range []string{"yaml", "json"} {
+		tag, ok := field.Tag.Lookup(tagName)
+		if !ok {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		inline := false
+		for _, opt := range parts[1:] {
+			if opt == "inline" {
+				inline = true
+			}
+		}
+		if parts[0] != "" || inline {
+			return parts[0], inline
+		}
+	}
+	return strings.ToLower(field.Name), false
+}
+
+// applySchemaTags adds the desc, default and oneof tags of a field to its schema
+func applySchemaTags(schema map[string]any, field *reflect.StructField) error {
+	desc := field.Tag.Get(tagDesc)
+	if desc != "" {
+		schema["description"] = desc
+	}
+
+	if defaultVal, ok := field.Tag.Lookup(tagDefault); ok {
+		value, err := schemaDefaultValue(field.Type, defaultVal)
+		if err != nil {
+			return err
+		}
+		schema["default"] = value
+	}
+
+	enum := oneOfValues(field.Tag.Get(tagValidate))
+	if enum == nil {
+		enum = oneOfValues(desc)
+	}
+	if enum != nil {
+		values := make([]any, 0, len(enum))
+		for _, v := range enum {
+			value, err := schemaDefaultValue(field.Type, v)
+			if err != nil {
+				return err
+			}
+			values = append(values, value)
+		}
+		schema["enum"] = values
+	}
+	return nil
+}
+
+// schemaDefaultValue converts a tag value to a value of the field's type, as
+// ProcessConfigDefaults would set it. Durations keep their string form.
+func schemaDefaultValue(t reflect.Type, raw string) (any, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == reflect.TypeFor[time.Duration]() {
+		if _, err := time.ParseDuration(raw); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrDefaultValueParseError, err)
+		}
+		return raw, nil
+	}
+
+	v := reflect.New(t).Elem()
+	if err := setDefaultValue(v, raw); err != nil {
+		return nil, err
+	}
+	return v.Interface(), nil
+}
+
+// oneOfValues extracts the values of a "oneof=a b c" clause, which ends at a
+// comma, semicolon or closing parenthesis. It returns nil if there is none.
+func oneOfValues(s string) []string {
+	_, rest, found := strings.Cut(s, "oneof=")
+	if !found {
+		return nil
+	}
+	if end := strings.IndexAny(rest, ",;)"); end >= 0 {
+		rest = rest[:end]
+	}
+	values := strings.Fields(rest)
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// isSchemaFieldRequired reports whether a field is marked as required by its
+// required or validate tag
+func isSchemaFieldRequired(field *reflect.StructField) bool {
+	if isFieldRequired(field) {
+		return true
+	}
+	for _, rule := range strings.Split(field.Tag.Get(tagValidate), ",") {
+		if strings.TrimSpace(rule) == "required" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package modular
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type schemaServerConfig struct {
+	Host    string        `yaml:"host" required:"true" desc:"Server host"`
+	Port    int           `yaml:"port" default:"8080" desc:"Server port"`
+	Timeout time.Duration `yaml:"timeout" default:"30s"`
+	TLS     bool          `yaml:"tls" default:"true"`
+}
+
+type schemaConfig struct {
+	Name        string                        `yaml:"name" required:"true" desc:"Application name"`
+	Environment string                        `yaml:"environment" default:"dev" desc:"Environment (oneof=dev test prod)"`
+	Engine      string                        `yaml:"engine" validate:"required,oneof=memory redis"`
+	Ratio       float64                       `json:"ratio"`
+	Tags        []string                      `yaml:"tags" default:"[\"a\",\"b\"]"`
+	Limits      map[string]int                `yaml:"limits"`
+	Server      schemaServerConfig            `yaml:"server" desc:"HTTP server settings"`
+	Backup      *schemaServerConfig           `yaml:"backup"`
+	Extra       map[string]any                `yaml:"extra"`
+	Parent      *schemaConfig                 `yaml:"parent"`
+	Ignored     string                        `yaml:"-"`
+	MaxItems    uint                          `default:"10"`
+	Named       map[string]schemaServerConfig `yaml:"named"`
+	internal    string
+}
+
+func generateSchema(t *testing.T, cfg any) map[string]any {
+	t.Helper()
+	data, err := GenerateConfigSchema(cfg)
+	require.NoError(t, err)
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(data, &schema))
+	return schema
+}
+
+func TestGenerateConfigSchema(t *testing.T) {
+	schema := generateSchema(t, &schemaConfig{internal: "x"})
+
+	assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", schema["$schema"])
+	assert.Equal(t, "schemaConfig", schema["title"])
+	assert.Equal(t, "object", schema["type"])
+	assert.ElementsMatch(t, []any{"name", "engine"}, schema["required"])
+
+	props := schema["properties"].(map[string]any)
+	assert.ElementsMatch(t, []string{
+		"name", "environment", "engine", "ratio", "tags", "limits", "server",
+		"backup", "extra", "parent", "maxitems", "named",
+	}, schemaKeys(props))
+
+	assert.Equal(t, map[string]any{"type": "string", "description": "Application name"}, props["name"])
+	assert.Equal(t, map[string]any{
+		"type":        "string",
+		"description": "Environment (oneof=dev test prod)",
+		"default":     "dev",
+		"enum":        []any{"dev", "test", "prod"},
+	}, props["environment"], "oneof in desc becomes an enum")
+	assert.Equal(t, map[string]any{"type": "string", "enum": []any{"memory", "redis"}}, props["engine"],
+		"oneof in the validate tag becomes an enum")
+	assert.Equal(t, map[string]any{"type": "number"}, props["ratio"])
+	assert.Equal(t, map[string]any{
+		"type":    "array",
+		"items":   map[string]any{"type": "string"},
+		"default": []any{"a", "b"},
+	}, props["tags"])
+	assert.Equal(t, map[string]any{
+		"type":                 "object",
+		"additionalProperties": map[string]any{"type": "integer"},
+	}, props["limits"])
+	assert.Equal(t, map[string]any{"type": "integer", "minimum": float64(0), "default": float64(10)}, props["maxitems"])
+	assert.Equal(t, map[string]any{"type": "object", "additionalProperties": map[string]any{}}, props["extra"])
+	assert.Equal(t, map[string]any{"type": "object"}, props["parent"], "recursive types are not expanded")
+
+	server := props["server"].(map[string]any)
+	assert.Equal(t, "HTTP server settings", server["description"])
+	assert.Equal(t, []any{"host"}, server["required"])
+	serverProps := server["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "integer", "description": "Server port", "default": float64(8080)}, serverProps["port"])
+	assert.Equal(t, map[string]any{"type": "string", "default": "30s"}, serverProps["timeout"])
+	assert.Equal(t, map[string]any{"type": "boolean", "default": true}, serverProps["tls"])
+
+	assert.Equal(t, server["properties"], props["backup"].(map[string]any)["properties"])
+	named := props["named"].(map[string]any)["additionalProperties"].(map[string]any)
+	assert.Equal(t, []any{"host"}, named["required"])
+}
+
+func TestGenerateConfigSchema_Inline(t *testing.T) {
+	type inlineConfig struct {
+		Server schemaServerConfig `yaml:",inline"`
+		Debug  bool               `yaml:"debug"`
+	}
+
+	schema := generateSchema(t, &inlineConfig{})
+	assert.Equal(t, []any{"host"}, schema["required"])
+	assert.ElementsMatch(t, []string{"host", "port", "timeout", "tls", "debug"}, schemaKeys(schema["properties"].(map[string]any)))
+}
+
+func TestGenerateConfigSchema_Errors(t *testing.T) {
+	_, err := GenerateConfigSchema(nil)
+	require.ErrorIs(t, err, ErrConfigNil)
+
+	_, err = GenerateConfigSchema(new(string))
+	require.ErrorIs(t, err, ErrConfigNotStruct)
+
+	_, err = GenerateConfigSchema(&struct {
+		Port int `yaml:"port" default:"http"`
+	}{})
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+
+	_, err = GenerateConfigSchema(&struct {
+		Callback func() `yaml:"callback"`
+	}{})
+	require.ErrorIs(t, err, ErrUnsupportedSchemaType)
+}
+
+func schemaKeys(m map[string]any) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
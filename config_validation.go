@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/GoCodeAlone/modular/feeders"
 	"gopkg.in/yaml.v3"
 )
 
@@ -19,6 +20,7 @@ const (
 	tagRequired = "required"
 	tagValidate = "validate"
 	tagDesc     = "desc"
+	tagSecret   = "secret"
 )
 
 // ConfigValidator is an interface for configuration validation.
@@ -54,16 +56,17 @@ type ConfigValidator interface {
 //
 // Supported field types:
 //   - Basic types: string, int, float, bool
-//   - Slices: []string, []int, etc.
-//   - Pointers to basic types
+//   - time.Duration, parsed with time.ParseDuration
+//   - Slices and maps, decoded from JSON
 //
 // Example struct tags:
 //
 //	type Config struct {
-//	    Host     string `default:"localhost"`
-//	    Port     int    `default:"8080"`
-//	    Debug    bool   `default:"false"`
-//	    Features []string `default:"feature1,feature2"`
+//	    Host     string          `default:"localhost"`
+//	    Port     int             `default:"8080"`
+//	    Debug    bool            `default:"false"`
+//	    Features []string        `default:"[\"feature1\",\"feature2\"]"`
+//	    Toggles  map[string]bool `default:"{\"logging\":true}"`
 //	}
 //
 // This function is automatically called by the configuration loading system
@@ -141,24 +144,34 @@ func ValidateConfigRequired(cfg any) error {
 		return ErrConfigNil
 	}
 
+	requiredErrors, err := missingRequiredFields(cfg, "")
+	if err != nil {
+		return err
+	}
+
+	if len(requiredErrors) > 0 {
+		return fmt.Errorf("%w: %s", ErrConfigRequiredFieldMissing, strings.Join(requiredErrors, ", "))
+	}
+
+	return nil
+}
+
+// missingRequiredFields returns the paths of all required fields of cfg that
+// are zero, each prefixed with prefix if it is set
+func missingRequiredFields(cfg any, prefix string) ([]string, error) {
 	v := reflect.ValueOf(cfg)
 	if v.Kind() != reflect.Pointer || v.IsNil() {
-		return ErrConfigNotPointer
+		return nil, ErrConfigNotPointer
 	}
 
 	v = v.Elem() // Dereference pointer
 	if v.Kind() != reflect.Struct {
-		return ErrConfigNotStruct
+		return nil, ErrConfigNotStruct
 	}
 
-	var requiredErrors []string
-	validateRequiredFields(v, "", &requiredErrors)
-
-	if len(requiredErrors) > 0 {
-		return fmt.Errorf("%w: %s", ErrConfigRequiredFieldMissing, strings.Join(requiredErrors, ", "))
-	}
-
-	return nil
+	var missing []string
+	validateRequiredFields(v, prefix, &missing)
+	return missing, nil
 }
 
 // validateRequiredFields recursively validates required fields
@@ -257,10 +270,12 @@ func setDefaultValue(field reflect.Value, defaultVal string) error {
 		return setDefaultUintValue(field, defaultVal)
 	case reflect.Float32, reflect.Float64:
 		return setDefaultFloatValue(field, defaultVal)
-	case reflect.Slice:
-		return setDefaultSlice(field, defaultVal)
-	case reflect.Map:
-		return setDefaultMap(field, defaultVal)
+	case reflect.Slice, reflect.Map:
+		// Collections are decoded from JSON exactly as DefaultsFeeder does
+		if err := feeders.SetDefault(field, defaultVal); err != nil {
+			return fmt.Errorf("failed to decode %s default: %w", kind, err)
+		}
+		return nil
 	case reflect.Invalid, reflect.Complex64, reflect.Complex128, reflect.Array,
 		reflect.Chan, reflect.Func, reflect.Interface, reflect.Pointer, reflect.Struct,
 		reflect.UnsafePointer:
@@ -346,40 +361,6 @@ func setDefaultFloatValue(field reflect.Value, defaultVal string) error {
 	return setDefaultFloat(field, f)
 }
 
-// setDefaultSlice sets a slice default value from JSON
-func setDefaultSlice(field reflect.Value, defaultVal string) error {
-	// Attempt to unmarshal JSON array into slice
-	if field.Type().Elem().Kind() == reflect.String {
-		var strs []string
-		if err := json.Unmarshal([]byte(defaultVal), &strs); err != nil {
-			return fmt.Errorf("failed to unmarshal JSON array: %w", err)
-		}
-		sliceVal := reflect.MakeSlice(field.Type(), len(strs), len(strs))
-		for i, s := range strs {
-			sliceVal.Index(i).SetString(s)
-		}
-		field.Set(sliceVal)
-	}
-	return nil
-}
-
-// setDefaultMap sets a map default value from JSON
-func setDefaultMap(field reflect.Value, defaultVal string) error {
-	// Only handle string->string maps for defaults
-	if field.Type().Key().Kind() == reflect.String && field.Type().Elem().Kind() == reflect.String {
-		var m map[string]string
-		if err := json.Unmarshal([]byte(defaultVal), &m); err != nil {
-			return fmt.Errorf("failed to unmarshal JSON map: %w", err)
-		}
-		mapVal := reflect.MakeMap(field.Type())
-		for k, v := range m {
-			mapVal.SetMapIndex(reflect.ValueOf(k), reflect.ValueOf(v))
-		}
-		field.Set(mapVal)
-	}
-	return nil
-}
-
 func setDefaultInt(field reflect.Value, i int64) error {
 	switch field.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
@@ -438,6 +419,7 @@ func setDefaultFloat(field reflect.Value, f float64) error {
 
 // GenerateSampleConfig generates a sample configuration for a config struct
 // The format parameter can be "yaml", "json", or "toml"
+// Fields tagged `secret:"true"` hold a placeholder instead of their default.
 func GenerateSampleConfig(cfg any, format string) ([]byte, error) {
 	if cfg == nil {
 		return nil, ErrConfigNil
@@ -445,9 +427,10 @@ func GenerateSampleConfig(cfg any, format string) ([]byte, error) {
 
 	// Apply any default values to the sample config
 	sampleConfig := reflect.New(reflect.TypeOf(cfg).Elem()).Interface()
-	if err := ProcessConfigDefaults(sampleConfig); err != nil {
-		return nil, err
+	if err := feeders.NewDefaultsFeeder().Feed(sampleConfig); err != nil {
+		return nil, fmt.Errorf("failed to apply default values: %w", err)
 	}
+	maskSecretFields(reflect.ValueOf(sampleConfig))
 
 	switch strings.ToLower(format) {
 	case "yaml":
@@ -475,6 +458,42 @@ func GenerateSampleConfig(cfg any, format string) ([]byte, error) {
 	}
 }
 
+// maskSecretFields replaces the values of fields tagged `secret:"true"` with
+// feeders.RedactedValue, or the zero value for non-string fields, so that
+// sample configs never contain secret defaults
+func maskSecretFields(v reflect.Value) {
+	switch v.Kind() { //nolint:exhaustive // only structs and pointers to them hold fields
+	case reflect.Pointer:
+		if !v.IsNil() {
+			maskSecretFields(v.Elem())
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := v.Field(i)
+			fieldType := t.Field(i)
+			if !fieldType.IsExported() {
+				continue
+			}
+			if fieldType.Tag.Get(tagSecret) != "true" {
+				maskSecretFields(field)
+				continue
+			}
+
+			switch {
+			case field.Kind() == reflect.String:
+				field.SetString(feeders.RedactedValue)
+			case field.Kind() == reflect.Pointer && field.Type().Elem().Kind() == reflect.String:
+				placeholder := reflect.New(field.Type().Elem())
+				placeholder.Elem().SetString(feeders.RedactedValue)
+				field.Set(placeholder)
+			default:
+				field.Set(reflect.Zero(field.Type()))
+			}
+		}
+	}
+}
+
 // mapStructFieldsForJSON creates a map with proper JSON field names based on struct tags
 func mapStructFieldsForJSON(cfg any) map[string]any {
 	result := make(map[string]any)
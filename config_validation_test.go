@@ -282,6 +282,45 @@ func TestSaveSampleConfig(t *testing.T) {
 	assert.Contains(t, string(fileData), "port: 8080")
 }
 
+type secretSampleConfig struct {
+	Host     string                  `yaml:"host" json:"host" default:"localhost"`
+	Password string                  `yaml:"password" json:"password" default:"changeme" secret:"true"`
+	Token    *string                 `yaml:"token" json:"token" secret:"true"`
+	PIN      int                     `yaml:"pin" json:"pin" default:"1234" secret:"true"`
+	Upstream secretSampleUpstreamCfg `yaml:"upstream" json:"upstream"`
+}
+
+type secretSampleUpstreamCfg struct {
+	URL    string `yaml:"url" json:"url" default:"https://api.example.com"`
+	APIKey string `yaml:"api_key" json:"api_key" default:"dev-key" secret:"true"`
+}
+
+func TestGenerateSampleConfig_SecretFields(t *testing.T) {
+	for _, format := range []string{"yaml", "json", "toml"} {
+		t.Run(format, func(t *testing.T) {
+			data, err := GenerateSampleConfig(&secretSampleConfig{}, format)
+			require.NoError(t, err)
+			sample := string(data)
+
+			assert.Contains(t, sample, "localhost", "non-secret defaults are kept")
+			assert.Contains(t, sample, "https://api.example.com")
+			assert.Contains(t, sample, feeders.RedactedValue)
+			assert.NotContains(t, sample, "changeme")
+			assert.NotContains(t, sample, "dev-key")
+			assert.NotContains(t, sample, "1234")
+		})
+	}
+
+	data, err := GenerateSampleConfig(&secretSampleConfig{}, "json")
+	require.NoError(t, err)
+	var sample map[string]any
+	require.NoError(t, json.Unmarshal(data, &sample))
+	assert.Equal(t, feeders.RedactedValue, sample["password"])
+	assert.Equal(t, feeders.RedactedValue, sample["token"])
+	assert.Equal(t, float64(0), sample["pin"], "non-string secrets are zeroed")
+	assert.Equal(t, feeders.RedactedValue, sample["upstream"].(map[string]any)["api_key"])
+}
+
 func TestProcessConfigDefaults_TimeDuration(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -537,3 +576,66 @@ timeout: 10s
 		assert.Equal(t, 60*time.Second, cfg.RecentRequestThreshold)
 	})
 }
+
+type requiredAppConfig struct {
+	Name string `yaml:"name" required:"true"`
+	Port int    `yaml:"port" env:"REQUIRED_TEST_PORT" required:"true"`
+}
+
+type requiredDatabaseConfig struct {
+	DSN    string `yaml:"dsn" required:"true"`
+	Driver string `yaml:"driver" default:"sqlite" required:"true"`
+}
+
+type requiredCacheConfig struct {
+	URL string `yaml:"url" env:"REQUIRED_TEST_CACHE_URL" required:"true"`
+	TTL int    `yaml:"ttl" required:"true"`
+}
+
+func TestConfigFeed_AggregatesMissingRequiredFields(t *testing.T) {
+	feeders.ResetGlobalEnvCatalog()
+	t.Cleanup(feeders.ResetGlobalEnvCatalog)
+	// Only ENV provides these, so they must not be reported as missing
+	t.Setenv("REQUIRED_TEST_PORT", "8080")
+	t.Setenv("REQUIRED_TEST_CACHE_URL", "redis://cache")
+
+	yamlPath := t.TempDir() + "/config.yaml"
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  url: \"\"\n"), 0o600))
+
+	app := NewStdApplication(NewStdConfigProvider(&requiredAppConfig{}), &initTestLogger{t: t},
+		feeders.NewYamlFeeder(yamlPath),
+		feeders.NewEnvFeeder(),
+	)
+	app.RegisterConfigSection("database", NewStdConfigProvider(&requiredDatabaseConfig{}))
+	app.RegisterConfigSection("cache", NewStdConfigProvider(&requiredCacheConfig{}))
+
+	err := app.Init()
+	require.Error(t, err)
+	require.ErrorIs(t, err, ErrConfigValidationFailed)
+	require.ErrorIs(t, err, ErrConfigRequiredFieldMissing)
+
+	msg := err.Error()
+	for _, field := range []string{"Name", "database.DSN", "cache.TTL"} {
+		assert.Contains(t, msg, field)
+	}
+	assert.NotContains(t, msg, "Port")
+	assert.NotContains(t, msg, "cache.URL")
+	assert.NotContains(t, msg, "database.Driver", "defaults satisfy required fields")
+}
+
+func TestGenerateSampleConfig_JSONCollectionDefaults(t *testing.T) {
+	type collectionConfig struct {
+		Features map[string]bool `json:"features" default:"{\"logging\":true}"`
+		Codes    []int           `json:"codes" default:"[200,204]"`
+	}
+
+	// The loader and the sample generator agree on collection defaults
+	cfg := &collectionConfig{}
+	require.NoError(t, ProcessConfigDefaults(cfg))
+	assert.Equal(t, map[string]bool{"logging": true}, cfg.Features)
+	assert.Equal(t, []int{200, 204}, cfg.Codes)
+
+	data, err := GenerateSampleConfig(&collectionConfig{}, "json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"features":{"logging":true},"codes":[200,204]}`, string(data))
+}
@@ -0,0 +1,113 @@
+package modular
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type crossCacheConfig struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+type crossRedisConfig struct {
+	URL string `yaml:"url"`
+}
+
+var errCrossRedisURLRequired = errors.New("redis.url is required when the cache is enabled")
+
+// crossSectionModule registers a cache and a redis config section and
+// records whether it was initialized
+type crossSectionModule struct {
+	cache       *crossCacheConfig
+	redis       *crossRedisConfig
+	initialized bool
+}
+
+func (m *crossSectionModule) Name() string { return "crossSectionModule" }
+
+func (m *crossSectionModule) RegisterConfig(app Application) error {
+	app.RegisterConfigSection("cache", NewStdConfigProvider(m.cache))
+	app.RegisterConfigSection("redis", NewStdConfigProvider(m.redis))
+	return nil
+}
+
+func (m *crossSectionModule) Init(Application) error {
+	m.initialized = true
+	return nil
+}
+
+// requireRedisForCache is a cross-section rule: an enabled cache needs a redis URL
+func requireRedisForCache(app Application) error {
+	cacheSection, err := app.GetConfigSection("cache")
+	if err != nil {
+		return err
+	}
+	redisSection, err := app.GetConfigSection("redis")
+	if err != nil {
+		return err
+	}
+	if cacheSection.GetConfig().(*crossCacheConfig).Enabled && redisSection.GetConfig().(*crossRedisConfig).URL == "" {
+		return errCrossRedisURLRequired
+	}
+	return nil
+}
+
+func newCrossSectionApp(t *testing.T, module *crossSectionModule, validators ...func(Application) error) Application {
+	t.Helper()
+	app, err := NewApplication(
+		WithLogger(nopLogger{}),
+		WithConfigProvider(NewStdConfigProvider(&struct{}{})),
+		WithModules(module),
+		WithConfigValidator(validators...),
+	)
+	require.NoError(t, err)
+	return app
+}
+
+func TestWithConfigValidator(t *testing.T) {
+	t.Run("cross-section rule passes", func(t *testing.T) {
+		module := &crossSectionModule{
+			cache: &crossCacheConfig{Enabled: true},
+			redis: &crossRedisConfig{URL: "redis://localhost:6379"},
+		}
+		app := newCrossSectionApp(t, module, requireRedisForCache)
+
+		require.NoError(t, app.Init())
+		assert.True(t, module.initialized)
+	})
+
+	t.Run("cross-section rule fails", func(t *testing.T) {
+		module := &crossSectionModule{
+			cache: &crossCacheConfig{Enabled: true},
+			redis: &crossRedisConfig{},
+		}
+		app := newCrossSectionApp(t, module, requireRedisForCache)
+
+		err := app.Init()
+		require.ErrorIs(t, err, ErrConfigValidationFailed)
+		require.ErrorIs(t, err, errCrossRedisURLRequired)
+		assert.False(t, module.initialized, "modules are not initialized with invalid config")
+	})
+
+	t.Run("failures are aggregated", func(t *testing.T) {
+		errOther := errors.New("other rule failed")
+		calls := 0
+		module := &crossSectionModule{
+			cache: &crossCacheConfig{Enabled: true},
+			redis: &crossRedisConfig{},
+		}
+		app := newCrossSectionApp(t, module,
+			requireRedisForCache,
+			func(Application) error { calls++; return errOther },
+		)
+
+		err := app.Init()
+		require.ErrorIs(t, err, ErrConfigValidationFailed)
+		require.ErrorIs(t, err, errCrossRedisURLRequired)
+		require.ErrorIs(t, err, errOther)
+		assert.Equal(t, 1, calls, "all validators run even after a failure")
+	})
+}
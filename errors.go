@@ -40,14 +40,24 @@ var (
 	ErrIncompatibleFieldKind      = errors.New("incompatible field kind")
 	ErrUnexpectedFieldKind        = errors.New("unexpected field kind")
 	ErrUnsupportedFormatType      = errors.New("unsupported format type")
+	ErrUnsupportedSchemaType      = errors.New("unsupported type for config schema")
 	ErrConfigFeederError          = errors.New("config feeder error")
 	ErrConfigSetupError           = errors.New("config setup error")
 	ErrConfigNilPointer           = errors.New("config is nil pointer")
 	ErrFieldCannotBeSet           = errors.New("field cannot be set")
+	ErrConfigCheckOnly            = errors.New("config check mode: modules were not initialized")
+	ErrConfigCheckFileUnsupported = errors.New("unsupported config check file format")
+
+	// Secret errors - problems resolving secret references in config fields
+	ErrSecretNotFound         = errors.New("secret not found")
+	ErrSecretResolverNotFound = errors.New("no secret resolver for reference")
+	ErrSecretFieldNotString   = errors.New("secret field must be a string")
 
 	// Service registry errors
-	ErrServiceAlreadyRegistered = errors.New("service already registered")
-	ErrServiceNotFound          = errors.New("service not found")
+	ErrServiceAlreadyRegistered     = errors.New("service already registered")
+	ErrServiceNotFound              = errors.New("service not found")
+	ErrServiceDecoratorNil          = errors.New("service decorator is nil or returned nil")
+	ErrServiceDecoratorTypeMismatch = errors.New("decorated service doesn't satisfy the interfaces of the original")
 
 	// Service injection errors
 	ErrTargetNotPointer      = errors.New("target must be a non-nil pointer")
@@ -62,6 +72,10 @@ var (
 	ErrModuleDependencyMissing = errors.New("module depends on non-existent module")
 	ErrRequiredServiceNotFound = errors.New("required service not found for module")
 
+	// Lifecycle errors
+	ErrStartTimeout = errors.New("application start timed out")
+	ErrStopTimeout  = errors.New("application stop timed out")
+
 	// Constructor errors
 	ErrConstructorNotFunction              = errors.New("constructor must be a function")
 	ErrConstructorInvalidReturnCount       = errors.New("constructor must return exactly two values (Module, error)")
@@ -79,6 +93,9 @@ var (
 	ErrTenantConfigProviderNil         = errors.New("tenant config provider is nil")
 	ErrTenantConfigValueNil            = errors.New("tenant config value is nil")
 	ErrTenantRegisterNilConfig         = errors.New("cannot register nil config for tenant")
+	ErrTenantIDEmpty                   = errors.New("tenant ID is empty")
+	ErrTenantConfigInvalid             = errors.New("tenant config is invalid")
+	ErrTenantConfigWrongType           = errors.New("tenant config has the wrong type")
 	ErrMockTenantConfigsNotInitialized = errors.New("mock tenant configs not initialized")
 	ErrConfigSectionNotFoundForTenant  = errors.New("config section not found for tenant")
 
@@ -91,14 +108,22 @@ var (
 	ErrReloadChannelFull         = errors.New("reload request channel is full")
 	ErrReloadInProgress          = errors.New("reload already in progress")
 	ErrReloadStopped             = errors.New("reload orchestrator is stopped")
+	ErrReloadNotStarted          = errors.New("reload orchestrator is not started")
 	ErrReloadTimeout             = errors.New("reload timed out waiting for module")
+	ErrReloadValidationFailed    = errors.New("reload rejected by module validation")
 	ErrDynamicReloadNotEnabled   = errors.New("dynamic reload not enabled")
+	ErrApplicationNotReady       = errors.New("application not ready")
 	ErrModuleInitializationPanic = errors.New("panic initializing module")
 	ErrReloadPanic               = errors.New("reload panicked")
 	ErrHealthCheckPanic          = errors.New("health check panicked")
+	ErrHealthCheckerNotFound     = errors.New("no health checker service registered")
+	ErrHealthServiceNil          = errors.New("health service is nil")
 
 	// Observer/Event emission errors
 	ErrNoSubjectForEventEmission = errors.New("no subject available for event emission")
+	ErrEventDataDecode           = errors.New("cannot decode event data")
+	ErrObserverPanicked          = errors.New("observer panicked")
+	ErrInvalidEventTypePattern   = errors.New("invalid event type pattern")
 
 	// Test-specific errors
 	ErrSetupFailed   = errors.New("setup error")
@@ -117,6 +142,10 @@ var (
 	ErrCreatedNilProvider         = errors.New("created nil provider for tenant section")
 	ErrIncompatibleFieldTypes     = errors.New("incompatible types for field assignment")
 	ErrIncompatibleInterfaceValue = errors.New("incompatible interface value for field")
+
+	// Feature flag errors
+	ErrInvalidFeatureFlagFile        = errors.New("invalid feature flag file")
+	ErrFeatureFlagFileAlreadyWatched = errors.New("feature flag file is already being watched")
 )
 
 // Error checking helper functions
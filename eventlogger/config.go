@@ -1,6 +1,10 @@
 package eventlogger
 
 import (
+	"fmt"
+	"math"
+	"net/url"
+	"path"
 	"time"
 )
 
@@ -24,6 +28,9 @@ type EventLoggerConfig struct {
 	// EventTypeBlacklist allows excluding specific event types from logging
 	EventTypeBlacklist []string `yaml:"eventTypeBlacklist" desc:"Event types to exclude from logging (applied after whitelist)"`
 
+	// Filters selects event types by glob pattern and samples high-volume ones
+	Filters EventFilterConfig `yaml:"filters" desc:"Glob pattern filters and sample rates for event types"`
+
 	// ExcludeOwnEvents automatically excludes EventLogger's own operational events
 	ExcludeOwnEvents bool `yaml:"excludeOwnEvents" default:"false" desc:"Automatically exclude EventLogger's own operational events"`
 
@@ -52,9 +59,25 @@ type EventLoggerConfig struct {
 	ShutdownDrainTimeout time.Duration `yaml:"shutdownDrainTimeout" default:"2s" desc:"Maximum time to wait for draining event queue on Stop"`
 }
 
+// EventFilterConfig selects which event types are logged using glob patterns
+// such as "com.modular.chimux.*", and how often matching events are sampled.
+// Patterns use path.Match syntax, where * matches any sequence of characters.
+type EventFilterConfig struct {
+	// Include lists patterns of event types to log (empty = all events)
+	Include []string `yaml:"include" desc:"Glob patterns of event types to log (empty = all events)"`
+
+	// Exclude lists patterns of event types not to log, applied after Include
+	Exclude []string `yaml:"exclude" desc:"Glob patterns of event types to exclude from logging"`
+
+	// SampleRates maps patterns to the fraction (0.0-1.0) of matching events to log.
+	// When several patterns match, the longest applies. Sampling is by a hash
+	// of the event ID, so every replica makes the same decision for an event.
+	SampleRates map[string]float64 `yaml:"sampleRates" desc:"Fraction of events to log per event type pattern"`
+}
+
 // OutputTargetConfig configures a specific output target for event logs.
 type OutputTargetConfig struct {
-	// Type specifies the output type (console, file, syslog)
+	// Type specifies the output type (console, file, syslog, http)
 	Type string `yaml:"type" default:"console" desc:"Output target type"`
 
 	// Level allows different log levels per target
@@ -67,6 +90,7 @@ type OutputTargetConfig struct {
 	Console *ConsoleTargetConfig `yaml:"console,omitempty" desc:"Console output configuration"`
 	File    *FileTargetConfig    `yaml:"file,omitempty" desc:"File output configuration"`
 	Syslog  *SyslogTargetConfig  `yaml:"syslog,omitempty" desc:"Syslog output configuration"`
+	HTTP    *HTTPTargetConfig    `yaml:"http,omitempty" desc:"HTTP output configuration"`
 }
 
 // ConsoleTargetConfig configures console output.
@@ -83,13 +107,13 @@ type FileTargetConfig struct {
 	// Path specifies the log file path
 	Path string `yaml:"path" required:"true" desc:"Path to log file"`
 
-	// MaxSize specifies the maximum file size in MB before rotation
+	// MaxSize specifies the maximum file size in MB before rotation (0 = never rotate)
 	MaxSize int `yaml:"maxSize" default:"100" desc:"Maximum file size in MB before rotation"`
 
-	// MaxBackups specifies the maximum number of backup files to keep
+	// MaxBackups specifies the maximum number of backup files to keep (0 = keep all)
 	MaxBackups int `yaml:"maxBackups" default:"5" desc:"Maximum number of backup files"`
 
-	// MaxAge specifies the maximum age in days to keep log files
+	// MaxAge specifies the maximum age in days to keep backup files (0 = keep all)
 	MaxAge int `yaml:"maxAge" default:"30" desc:"Maximum age in days to keep log files"`
 
 	// Compress determines if rotated logs should be compressed
@@ -111,6 +135,31 @@ type SyslogTargetConfig struct {
 	Facility string `yaml:"facility" default:"user" desc:"Syslog facility"`
 }
 
+// HTTPTargetConfig configures HTTP output. Events are POSTed to Endpoint in
+// batches using the CloudEvents JSON batch format.
+type HTTPTargetConfig struct {
+	// Endpoint specifies the collector URL events are POSTed to
+	Endpoint string `yaml:"endpoint" required:"true" desc:"Collector URL to POST event batches to"`
+
+	// BatchSize specifies how many events are buffered before a batch is sent
+	BatchSize int `yaml:"batchSize" default:"100" desc:"Maximum number of events per batch"`
+
+	// FlushInterval specifies how often buffered events are sent when a batch is not full
+	FlushInterval time.Duration `yaml:"flushInterval" default:"5s" desc:"Interval to send partially filled batches"`
+
+	// Headers specifies additional request headers, e.g. for authentication
+	Headers map[string]string `yaml:"headers" desc:"Additional HTTP headers sent with each batch"`
+
+	// Timeout specifies the timeout for each request
+	Timeout time.Duration `yaml:"timeout" default:"10s" desc:"Timeout for each batch request"`
+
+	// MaxRetries specifies how many times a failed batch is retried before being dropped
+	MaxRetries int `yaml:"maxRetries" default:"3" desc:"Maximum retries for a failed batch"`
+
+	// RetryBackoff specifies the delay before the first retry; it doubles on each retry
+	RetryBackoff time.Duration `yaml:"retryBackoff" default:"500ms" desc:"Initial delay between retries of a failed batch"`
+}
+
 // Validate implements the ConfigValidator interface for EventLoggerConfig.
 func (c *EventLoggerConfig) Validate() error {
 	// Validate log level
@@ -134,6 +183,10 @@ func (c *EventLoggerConfig) Validate() error {
 		return ErrInvalidFlushInterval
 	}
 
+	if err := c.Filters.Validate(); err != nil {
+		return err
+	}
+
 	// Validate output targets
 	for i, target := range c.OutputTargets {
 		if err := target.Validate(); err != nil {
@@ -144,11 +197,31 @@ func (c *EventLoggerConfig) Validate() error {
 	return nil
 }
 
+// Validate validates the filter patterns and sample rates.
+func (f *EventFilterConfig) Validate() error {
+	for _, patterns := range [][]string{f.Include, f.Exclude} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("%w: %q", ErrInvalidEventPattern, pattern)
+			}
+		}
+	}
+	for pattern, rate := range f.SampleRates {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("%w: %q", ErrInvalidEventPattern, pattern)
+		}
+		if rate < 0 || rate > 1 || math.IsNaN(rate) {
+			return fmt.Errorf("%w: %q: %v", ErrInvalidSampleRate, pattern, rate)
+		}
+	}
+	return nil
+}
+
 // Validate validates an OutputTargetConfig.
 func (o *OutputTargetConfig) Validate() error {
 	// Validate type
 	validTypes := map[string]bool{
-		"console": true, "file": true, "syslog": true,
+		"console": true, "file": true, "syslog": true, "http": true,
 	}
 	if !validTypes[o.Type] {
 		return ErrInvalidOutputType
@@ -179,6 +252,9 @@ func (o *OutputTargetConfig) Validate() error {
 		if o.File.Path == "" {
 			return ErrMissingFilePath
 		}
+		if o.File.MaxSize < 0 || o.File.MaxBackups < 0 || o.File.MaxAge < 0 {
+			return ErrInvalidFileRotation
+		}
 	case "syslog":
 		if o.Syslog == nil {
 			return ErrMissingSyslogConfig
@@ -189,6 +265,23 @@ func (o *OutputTargetConfig) Validate() error {
 		if !validNetworks[o.Syslog.Network] {
 			return ErrInvalidSyslogNetwork
 		}
+	case "http":
+		if o.HTTP == nil {
+			return ErrMissingHTTPConfig
+		}
+		if o.HTTP.Endpoint == "" {
+			return ErrMissingHTTPEndpoint
+		}
+		endpoint, err := url.Parse(o.HTTP.Endpoint)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			return fmt.Errorf("%w: %s", ErrInvalidHTTPEndpoint, o.HTTP.Endpoint)
+		}
+		if o.HTTP.BatchSize < 0 || o.HTTP.MaxRetries < 0 {
+			return fmt.Errorf("%w: batchSize and maxRetries must not be negative", ErrInvalidHTTPConfig)
+		}
+		if o.HTTP.FlushInterval < 0 || o.HTTP.Timeout < 0 || o.HTTP.RetryBackoff < 0 {
+			return fmt.Errorf("%w: flushInterval, timeout and retryBackoff must not be negative", ErrInvalidHTTPConfig)
+		}
 	}
 
 	return nil
@@ -14,8 +14,15 @@ var (
 	ErrInvalidOutputType    = errors.New("invalid output target type")
 	ErrMissingFileConfig    = errors.New("missing file configuration for file output target")
 	ErrMissingFilePath      = errors.New("missing file path for file output target")
+	ErrInvalidFileRotation  = errors.New("file rotation settings must not be negative")
 	ErrMissingSyslogConfig  = errors.New("missing syslog configuration for syslog output target")
 	ErrInvalidSyslogNetwork = errors.New("invalid syslog network type")
+	ErrMissingHTTPConfig    = errors.New("missing http configuration for http output target")
+	ErrMissingHTTPEndpoint  = errors.New("missing endpoint for http output target")
+	ErrInvalidHTTPEndpoint  = errors.New("invalid endpoint for http output target")
+	ErrInvalidHTTPConfig    = errors.New("invalid http output target configuration")
+	ErrInvalidEventPattern  = errors.New("invalid event type pattern")
+	ErrInvalidSampleRate    = errors.New("sample rate must be between 0.0 and 1.0")
 
 	// Runtime errors
 	ErrLoggerNotStarted          = errors.New("event logger not started")
@@ -25,6 +32,8 @@ var (
 	ErrUnknownOutputTargetType   = errors.New("unknown output target type")
 	ErrFileNotOpen               = errors.New("file not open")
 	ErrSyslogWriterNotInit       = errors.New("syslog writer not initialized")
+	ErrHTTPTargetStopped         = errors.New("http output target stopped")
+	ErrHTTPBatchRejected         = errors.New("http collector rejected event batch")
 )
 
 // OutputTargetError wraps errors from output target validation
@@ -0,0 +1,207 @@
+package eventlogger
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// megabyte is the unit of FileTargetConfig.MaxSize
+const megabyte = 1024 * 1024
+
+// backupTimeFormat is the timestamp format in rotated file names, e.g.
+// events-2024-01-15T10-30-15.000.log
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// compressSuffix is appended to the names of compressed backups
+const compressSuffix = ".gz"
+
+// logBackup is a rotated log file
+type logBackup struct {
+	path      string
+	timestamp time.Time
+}
+
+// openFile opens the log file for appending and records its current size.
+// Callers must hold f.mu.
+func (f *FileTarget) openFile() error {
+	path := f.config.File.Path
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create log directory %s: %w", filepath.Dir(path), err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file %s: %w", path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to stat log file %s: %w", path, err)
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// rotate renames the current log file to a timestamped backup and starts a
+// new one, then prunes and compresses backups in the background. Callers
+// must hold f.mu.
+func (f *FileTarget) rotate() error {
+	if err := f.file.Close(); err != nil {
+		f.logger.Debug("Error closing file for rotation", "error", err)
+	}
+	f.file = nil
+
+	backup := f.backupName()
+	renameErr := os.Rename(f.config.File.Path, backup)
+
+	// Keep logging even if the rename failed; the file just keeps growing
+	if err := f.openFile(); err != nil {
+		return err
+	}
+	if renameErr != nil {
+		return fmt.Errorf("failed to rotate log file %s: %w", f.config.File.Path, renameErr)
+	}
+	f.logger.Debug("Rotated log file", "path", f.config.File.Path, "backup", backup)
+
+	f.millWG.Add(1)
+	go func() {
+		defer f.millWG.Done()
+		f.mill()
+	}()
+	return nil
+}
+
+// backupName returns an unused timestamped name for the next backup
+func (f *FileTarget) backupName() string {
+	dir := filepath.Dir(f.config.File.Path)
+	prefix, ext := backupNameParts(f.config.File.Path)
+	t := f.now().UTC()
+	for {
+		name := filepath.Join(dir, prefix+t.Format(backupTimeFormat)+ext)
+		_, err := os.Stat(name)
+		_, gzErr := os.Stat(name + compressSuffix)
+		if errors.Is(err, os.ErrNotExist) && errors.Is(gzErr, os.ErrNotExist) {
+			return name
+		}
+		// Rotated twice within a millisecond
+		t = t.Add(time.Millisecond)
+	}
+}
+
+// mill removes backups beyond MaxBackups or older than MaxAge days and
+// compresses the rest if Compress is set
+func (f *FileTarget) mill() {
+	f.millMu.Lock()
+	defer f.millMu.Unlock()
+
+	backups, err := f.listBackups()
+	if err != nil {
+		f.logger.Debug("Failed to list log file backups", "error", err)
+		return
+	}
+
+	cfg := f.config.File
+	var cutoff time.Time
+	if cfg.MaxAge > 0 {
+		cutoff = f.now().Add(-time.Duration(cfg.MaxAge) * 24 * time.Hour)
+	}
+
+	for i, backup := range backups {
+		expired := (cfg.MaxBackups > 0 && i >= cfg.MaxBackups) ||
+			(!cutoff.IsZero() && backup.timestamp.Before(cutoff))
+		if expired {
+			if err := os.Remove(backup.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				f.logger.Debug("Failed to remove old log file", "path", backup.path, "error", err)
+			}
+			continue
+		}
+		if cfg.Compress && !strings.HasSuffix(backup.path, compressSuffix) {
+			if err := compressLogFile(backup.path); err != nil {
+				f.logger.Debug("Failed to compress log file", "path", backup.path, "error", err)
+			}
+		}
+	}
+}
+
+// listBackups returns the backups of the log file, newest first
+func (f *FileTarget) listBackups() ([]logBackup, error) {
+	dir := filepath.Dir(f.config.File.Path)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read log directory %s: %w", dir, err)
+	}
+
+	prefix, ext := backupNameParts(f.config.File.Path)
+	var backups []logBackup
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), compressSuffix)
+		if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) || len(name) < len(prefix)+len(ext) {
+			continue
+		}
+		timestamp, err := time.Parse(backupTimeFormat, name[len(prefix):len(name)-len(ext)])
+		if err != nil {
+			continue
+		}
+		backups = append(backups, logBackup{path: filepath.Join(dir, entry.Name()), timestamp: timestamp})
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].timestamp.After(backups[j].timestamp)
+	})
+	return backups, nil
+}
+
+// backupNameParts splits a log file path into the backup name prefix and
+// extension, e.g. "/var/log/events.log" into "events-" and ".log"
+func backupNameParts(path string) (string, string) {
+	base := filepath.Base(path)
+	ext := filepath.Ext(base)
+	return strings.TrimSuffix(base, ext) + "-", ext
+}
+
+// compressLogFile gzips path to path.gz and removes the original
+func compressLogFile(path string) (err error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer src.Close()
+
+	dstPath := path + compressSuffix
+	dst, err := os.OpenFile(dstPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create compressed log file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = dst.Close()
+			_ = os.Remove(dstPath)
+		}
+	}()
+
+	gz := gzip.NewWriter(dst)
+	if _, err = io.Copy(gz, src); err != nil {
+		return fmt.Errorf("failed to compress log file: %w", err)
+	}
+	if err = gz.Close(); err != nil {
+		return fmt.Errorf("failed to compress log file: %w", err)
+	}
+	if err = dst.Close(); err != nil {
+		return fmt.Errorf("failed to close compressed log file: %w", err)
+	}
+	_ = src.Close()
+	if err = os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove uncompressed log file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package eventlogger
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRotatingFileTarget creates a started file target that rotates after
+// maxBytes, with a clock that advances a second on every reading
+func newRotatingFileTarget(t *testing.T, file FileTargetConfig, maxBytes int64) *FileTarget {
+	t.Helper()
+	target, err := NewFileTarget(OutputTargetConfig{
+		Type:   "file",
+		Level:  "INFO",
+		Format: "json",
+		File:   &file,
+	}, &capturingLogger{})
+	require.NoError(t, err)
+
+	target.maxBytes = maxBytes
+	var clockMu sync.Mutex
+	clock := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	target.now = func() time.Time {
+		clockMu.Lock()
+		defer clockMu.Unlock()
+		clock = clock.Add(time.Second)
+		return clock
+	}
+
+	require.NoError(t, target.Start(context.Background()))
+	return target
+}
+
+func writeEntries(t *testing.T, target *FileTarget, n int) {
+	t.Helper()
+	for i := range n {
+		require.NoError(t, target.WriteEvent(&LogEntry{
+			Timestamp: time.Now(),
+			Level:     "INFO",
+			Type:      "test.event",
+			Source:    "test",
+			Data:      map[string]interface{}{"index": i, "padding": strings.Repeat("x", 40)},
+		}))
+	}
+}
+
+// backupFiles returns the names of the backups of events.log in dir
+func backupFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "events-") {
+			names = append(names, entry.Name())
+		}
+	}
+	return names
+}
+
+// countLines returns the number of lines in path, decompressing .gz files
+func countLines(t *testing.T, path string) int {
+	t.Helper()
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var r io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		require.NoError(t, err)
+		defer gz.Close()
+		r = gz
+	}
+	lines := 0
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines++
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
+
+func TestFileTarget_RotatesOnSize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.log")
+	target := newRotatingFileTarget(t, FileTargetConfig{Path: path}, 1024)
+
+	writeEntries(t, target, 3)
+	assert.Empty(t, backupFiles(t, dir), "no rotation below the size threshold")
+
+	writeEntries(t, target, 47)
+	require.NoError(t, target.Stop(context.Background()))
+
+	backups := backupFiles(t, dir)
+	require.NotEmpty(t, backups, "writing past the size threshold creates a backup")
+	for _, name := range backups {
+		assert.Regexp(t, `^events-2024-01-15T10-00-\d\d\.000\.log$`, name)
+		info, err := os.Stat(filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(1024))
+	}
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, info.Size(), int64(1024))
+
+	// No entries are lost across rotations
+	total := countLines(t, path)
+	for _, name := range backups {
+		total += countLines(t, filepath.Join(dir, name))
+	}
+	assert.Equal(t, 50, total)
+}
+
+func TestFileTarget_PrunesOldBackups(t *testing.T) {
+	t.Run("max backups", func(t *testing.T) {
+		dir := t.TempDir()
+		target := newRotatingFileTarget(t, FileTargetConfig{Path: filepath.Join(dir, "events.log"), MaxBackups: 2}, 512)
+
+		writeEntries(t, target, 60)
+		require.NoError(t, target.Stop(context.Background()))
+
+		backups := backupFiles(t, dir)
+		assert.Len(t, backups, 2)
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		dir := t.TempDir()
+		stale := filepath.Join(dir, "events-2023-12-01T00-00-00.000.log")
+		require.NoError(t, os.WriteFile(stale, []byte("old\n"), 0o600))
+		recent := filepath.Join(dir, "events-2024-01-14T00-00-00.000.log")
+		require.NoError(t, os.WriteFile(recent, []byte("recent\n"), 0o600))
+		unrelated := filepath.Join(dir, "other-2023-12-01T00-00-00.000.log")
+		require.NoError(t, os.WriteFile(unrelated, []byte("other\n"), 0o600))
+
+		target := newRotatingFileTarget(t, FileTargetConfig{Path: filepath.Join(dir, "events.log"), MaxAge: 7}, 512)
+		writeEntries(t, target, 10)
+		require.NoError(t, target.Stop(context.Background()))
+
+		assert.NoFileExists(t, stale)
+		assert.FileExists(t, recent)
+		assert.FileExists(t, unrelated)
+	})
+}
+
+func TestFileTarget_CompressesBackups(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.log")
+	target := newRotatingFileTarget(t, FileTargetConfig{Path: path, Compress: true}, 1024)
+
+	writeEntries(t, target, 50)
+	require.NoError(t, target.Stop(context.Background()))
+
+	backups := backupFiles(t, dir)
+	require.NotEmpty(t, backups)
+	total := countLines(t, path)
+	for _, name := range backups {
+		assert.True(t, strings.HasSuffix(name, ".log.gz"), "backup %s should be compressed", name)
+		total += countLines(t, filepath.Join(dir, name))
+	}
+	assert.Equal(t, 50, total)
+}
+
+func TestFileTarget_ConcurrentWritesWithRotation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.log")
+	target := newRotatingFileTarget(t, FileTargetConfig{Path: path}, 2048)
+
+	const writers, perWriter = 8, 50
+	var wg sync.WaitGroup
+	for range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			writeEntries(t, target, perWriter)
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, target.Stop(context.Background()))
+
+	total := countLines(t, path)
+	for _, name := range backupFiles(t, dir) {
+		total += countLines(t, filepath.Join(dir, name))
+	}
+	assert.Equal(t, writers*perWriter, total)
+}
+
+func TestOutputTargetConfig_FileRotationValidation(t *testing.T) {
+	config := OutputTargetConfig{
+		Type:   "file",
+		Level:  "INFO",
+		Format: "json",
+		File:   &FileTargetConfig{Path: "/tmp/events.log", MaxSize: -1},
+	}
+	assert.ErrorIs(t, config.Validate(), ErrInvalidFileRotation)
+}
@@ -0,0 +1,58 @@
+package eventlogger
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"path"
+)
+
+// matches reports whether eventType passes the Include and Exclude patterns.
+func (f *EventFilterConfig) matches(eventType string) bool {
+	if len(f.Include) > 0 && !matchesAnyPattern(f.Include, eventType) {
+		return false
+	}
+	return !matchesAnyPattern(f.Exclude, eventType)
+}
+
+// sampled reports whether the event is within the sample rate of the longest
+// pattern matching its type. Events matching no pattern are always sampled.
+func (f *EventFilterConfig) sampled(eventType, eventID string) bool {
+	rate, best := 1.0, ""
+	found := false
+	for pattern, r := range f.SampleRates {
+		if ok, _ := path.Match(pattern, eventType); !ok {
+			continue
+		}
+		// Prefer the longest pattern; break ties by name so the choice is stable
+		if !found || len(pattern) > len(best) || (len(pattern) == len(best) && pattern < best) {
+			rate, best, found = r, pattern, true
+		}
+	}
+	switch {
+	case rate >= 1:
+		return true
+	case rate <= 0:
+		return false
+	}
+	return sampleFraction(eventID) < rate
+}
+
+// sampleFraction maps an event ID to a uniformly distributed value in [0, 1).
+// It depends only on the ID, so sampling decisions agree across replicas.
+func sampleFraction(eventID string) float64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(eventID))
+	sum := h.Sum(nil)
+	// Use the top 53 bits so the result is exactly representable
+	return float64(binary.BigEndian.Uint64(sum)>>11) / (1 << 53)
+}
+
+// matchesAnyPattern reports whether eventType matches any of the glob patterns.
+func matchesAnyPattern(patterns []string, eventType string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, eventType); ok {
+			return true
+		}
+	}
+	return false
+}
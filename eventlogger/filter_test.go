@@ -0,0 +1,132 @@
+package eventlogger
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFilterTestModule(filters EventFilterConfig) *EventLoggerModule {
+	return &EventLoggerModule{
+		name: ModuleName,
+		config: &EventLoggerConfig{
+			Enabled:       true,
+			LogLevel:      "INFO",
+			Format:        "structured",
+			FlushInterval: 5 * time.Second,
+			Filters:       filters,
+		},
+		logger: &testLogger{},
+	}
+}
+
+func TestEventLoggerModule_FilterInclude(t *testing.T) {
+	module := newFilterTestModule(EventFilterConfig{
+		Include: []string{"com.modular.chimux.*", "user.created"},
+	})
+
+	tests := map[string]bool{
+		"com.modular.chimux.router.created": true,
+		"com.modular.chimux.route.added":    true,
+		"user.created":                      true,
+		"user.deleted":                      false,
+		"com.modular.httpserver.started":    false,
+	}
+	for eventType, want := range tests {
+		event := modular.NewCloudEvent(eventType, "test-source", nil, nil)
+		assert.Equal(t, want, module.shouldLogEvent(event), "event type %s", eventType)
+	}
+}
+
+func TestEventLoggerModule_FilterExclude(t *testing.T) {
+	t.Run("exclude only", func(t *testing.T) {
+		module := newFilterTestModule(EventFilterConfig{
+			Exclude: []string{"com.modular.eventlogger.*"},
+		})
+		assert.False(t, module.shouldLogEvent(modular.NewCloudEvent("com.modular.eventlogger.event.received", "test", nil, nil)))
+		assert.True(t, module.shouldLogEvent(modular.NewCloudEvent("user.created", "test", nil, nil)))
+	})
+
+	t.Run("exclude overrides include", func(t *testing.T) {
+		module := newFilterTestModule(EventFilterConfig{
+			Include: []string{"com.modular.*"},
+			Exclude: []string{"com.modular.*.health.*"},
+		})
+		assert.True(t, module.shouldLogEvent(modular.NewCloudEvent("com.modular.chimux.router.created", "test", nil, nil)))
+		assert.False(t, module.shouldLogEvent(modular.NewCloudEvent("com.modular.httpserver.health.checked", "test", nil, nil)))
+		assert.False(t, module.shouldLogEvent(modular.NewCloudEvent("user.created", "test", nil, nil)))
+	})
+}
+
+func TestEventLoggerModule_FilterSampling(t *testing.T) {
+	module := newFilterTestModule(EventFilterConfig{
+		SampleRates: map[string]float64{
+			"request.*":        0.25,
+			"request.failed":   1,
+			"cache.hit":        0,
+			"request.received": 0.5,
+		},
+	})
+
+	t.Run("same event ID is sampled consistently", func(t *testing.T) {
+		for i := range 100 {
+			id := fmt.Sprintf("event-%d", i)
+			event := modular.NewCloudEvent("request.completed", "test", nil, nil)
+			event.SetID(id)
+			first := module.shouldLogEvent(event)
+
+			// Another replica seeing the same event, from a different source, agrees
+			other := newFilterTestModule(module.config.Filters)
+			replayed := modular.NewCloudEvent("request.completed", "replica-2", nil, nil)
+			replayed.SetID(id)
+			for range 3 {
+				assert.Equal(t, first, other.shouldLogEvent(replayed), "event %s", id)
+			}
+		}
+	})
+
+	t.Run("rate applies by longest matching pattern", func(t *testing.T) {
+		counts := map[string]int{}
+		const total = 4000
+		for _, eventType := range []string{"request.completed", "request.received", "request.failed", "cache.hit", "user.created"} {
+			for i := range total {
+				event := modular.NewCloudEvent(eventType, "test", nil, nil)
+				event.SetID(fmt.Sprintf("%s-%d", eventType, i))
+				if module.shouldLogEvent(event) {
+					counts[eventType]++
+				}
+			}
+		}
+		assert.InDelta(t, 0.25*total, counts["request.completed"], 0.05*total)
+		assert.InDelta(t, 0.5*total, counts["request.received"], 0.05*total)
+		assert.Equal(t, total, counts["request.failed"])
+		assert.Zero(t, counts["cache.hit"])
+		assert.Equal(t, total, counts["user.created"], "events matching no pattern are not sampled")
+	})
+}
+
+func TestEventFilterConfig_Validate(t *testing.T) {
+	valid := EventFilterConfig{
+		Include:     []string{"com.modular.*"},
+		Exclude:     []string{"com.modular.eventlogger.*"},
+		SampleRates: map[string]float64{"com.modular.chimux.*": 0.1},
+	}
+	require.NoError(t, valid.Validate())
+
+	assert.ErrorIs(t, (&EventFilterConfig{Include: []string{"com.[modular"}}).Validate(), ErrInvalidEventPattern)
+	assert.ErrorIs(t, (&EventFilterConfig{SampleRates: map[string]float64{"[": 0.5}}).Validate(), ErrInvalidEventPattern)
+	assert.ErrorIs(t, (&EventFilterConfig{SampleRates: map[string]float64{"user.*": 1.5}}).Validate(), ErrInvalidSampleRate)
+	assert.ErrorIs(t, (&EventFilterConfig{SampleRates: map[string]float64{"user.*": -0.1}}).Validate(), ErrInvalidSampleRate)
+
+	config := &EventLoggerConfig{
+		LogLevel:      "INFO",
+		Format:        "json",
+		FlushInterval: time.Second,
+		Filters:       EventFilterConfig{SampleRates: map[string]float64{"user.*": 2}},
+	}
+	assert.ErrorIs(t, config.Validate(), ErrInvalidSampleRate)
+}
@@ -0,0 +1,351 @@
+package eventlogger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/google/uuid"
+)
+
+// Defaults applied to zero-valued HTTPTargetConfig fields.
+const (
+	defaultHTTPBatchSize     = 100
+	defaultHTTPFlushInterval = 5 * time.Second
+	defaultHTTPTimeout       = 10 * time.Second
+	defaultHTTPRetryBackoff  = 500 * time.Millisecond
+)
+
+// cloudEventsBatchContentType is the media type of the CloudEvents JSON batch format.
+const cloudEventsBatchContentType = "application/cloudevents-batch+json"
+
+// HTTPTarget POSTs events to an HTTP collector in the CloudEvents JSON batch
+// format. Events are buffered and sent when a batch fills up, when the flush
+// interval elapses, when Flush is called, and on Stop. The target's Format
+// is not used; events keep their CloudEvents attributes and data.
+type HTTPTarget struct {
+	config OutputTargetConfig
+	logger modular.Logger
+	client *http.Client
+
+	batchSize     int
+	flushInterval time.Duration
+	maxRetries    int
+	retryBackoff  time.Duration
+
+	mu      sync.Mutex
+	pending []map[string]interface{}
+	stopped bool
+
+	// sendMu serializes sends so batches reach the collector in order
+	sendMu sync.Mutex
+
+	// full and flushNow wake the background sender to send full batches or
+	// everything buffered
+	full     chan struct{}
+	flushNow chan struct{}
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewHTTPTarget creates a new HTTP output target.
+func NewHTTPTarget(config OutputTargetConfig, logger modular.Logger) (*HTTPTarget, error) {
+	if config.HTTP == nil {
+		return nil, ErrMissingHTTPConfig
+	}
+	if config.HTTP.Endpoint == "" {
+		return nil, ErrMissingHTTPEndpoint
+	}
+
+	target := &HTTPTarget{
+		config:        config,
+		logger:        logger,
+		batchSize:     config.HTTP.BatchSize,
+		flushInterval: config.HTTP.FlushInterval,
+		maxRetries:    config.HTTP.MaxRetries,
+		retryBackoff:  config.HTTP.RetryBackoff,
+	}
+	if target.batchSize <= 0 {
+		target.batchSize = defaultHTTPBatchSize
+	}
+	if target.flushInterval <= 0 {
+		target.flushInterval = defaultHTTPFlushInterval
+	}
+	if target.retryBackoff <= 0 {
+		target.retryBackoff = defaultHTTPRetryBackoff
+	}
+	timeout := config.HTTP.Timeout
+	if timeout <= 0 {
+		timeout = defaultHTTPTimeout
+	}
+	target.client = &http.Client{Timeout: timeout}
+
+	return target, nil
+}
+
+// Start starts sending batches in the background.
+func (h *HTTPTarget) Start(ctx context.Context) error {
+	h.mu.Lock()
+	h.stopped = false
+	h.full = make(chan struct{}, 1)
+	h.flushNow = make(chan struct{}, 1)
+	h.stop = make(chan struct{})
+	h.done = make(chan struct{})
+	go h.run(h.full, h.flushNow, h.stop, h.done)
+	h.mu.Unlock()
+
+	h.logger.Debug("HTTP output target started", "endpoint", h.config.HTTP.Endpoint)
+	return nil
+}
+
+// Stop stops background sending and sends any remaining buffered events.
+// Retries of the final batches are abandoned when ctx is done.
+func (h *HTTPTarget) Stop(ctx context.Context) error {
+	h.mu.Lock()
+	stop, done := h.stop, h.done
+	alreadyStopped := h.stopped
+	h.stopped = true
+	h.mu.Unlock()
+
+	if stop != nil && !alreadyStopped {
+		close(stop)
+		select {
+		case <-done:
+		case <-ctx.Done():
+		}
+	}
+
+	err := h.flush(ctx, true)
+	h.logger.Debug("HTTP output target stopped")
+	return err
+}
+
+// WriteEvent buffers a log entry as a CloudEvent, sending a batch once it is full.
+func (h *HTTPTarget) WriteEvent(entry *LogEntry) error {
+	// Check log level
+	if !shouldLogLevel(entry.Level, h.config.Level) {
+		return nil
+	}
+
+	h.mu.Lock()
+	if h.stopped {
+		h.mu.Unlock()
+		return ErrHTTPTargetStopped
+	}
+	h.pending = append(h.pending, toCloudEventJSON(entry))
+	full := len(h.pending) >= h.batchSize
+	h.mu.Unlock()
+
+	if full {
+		h.wake(false)
+	}
+	return nil
+}
+
+// Flush asks the background sender to send all buffered events without
+// waiting for a slow or unavailable collector. Before Start it sends them
+// directly.
+func (h *HTTPTarget) Flush() error {
+	if h.wake(true) {
+		return nil
+	}
+	return h.flush(context.Background(), true)
+}
+
+// wake asks the background sender to send full batches, or everything
+// buffered if all is set. It reports false if the sender is not running.
+func (h *HTTPTarget) wake(all bool) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ch := h.full
+	if all {
+		ch = h.flushNow
+	}
+	if ch == nil || h.stopped {
+		return false
+	}
+	select {
+	case ch <- struct{}{}:
+	default: // a send is already due
+	}
+	return true
+}
+
+// run sends batches when one fills up, when Flush is called, or when the
+// flush interval elapses
+func (h *HTTPTarget) run(full, flushNow, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(h.flushInterval)
+	defer ticker.Stop()
+
+	// Abandon retries once Stop is called; Stop sends what remains
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		partial := true
+		select {
+		case <-full:
+			// Leave a trailing partial batch to fill up
+			partial = false
+		case <-flushNow:
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+
+		if err := h.flush(ctx, partial); err != nil {
+			h.logger.Warn("Failed to send events to HTTP collector", "endpoint", h.config.HTTP.Endpoint, "error", err)
+		}
+	}
+}
+
+// flush sends buffered events in batches of at most batchSize, including a
+// trailing partial batch only if partial is set. A batch that cannot be
+// delivered after all retries is dropped and reported in the returned error,
+// so one bad batch does not block the ones behind it. If ctx ends first, the
+// unsent batch is kept.
+func (h *HTTPTarget) flush(ctx context.Context, partial bool) error {
+	h.sendMu.Lock()
+	defer h.sendMu.Unlock()
+
+	var errs []error
+	for {
+		h.mu.Lock()
+		n := min(len(h.pending), h.batchSize)
+		if n < h.batchSize && !partial {
+			n = 0
+		}
+		batch := h.pending[:n:n]
+		h.pending = h.pending[n:]
+		h.mu.Unlock()
+
+		if n == 0 {
+			break
+		}
+		if err := h.send(ctx, batch); err != nil {
+			if ctx.Err() != nil {
+				// Interrupted rather than rejected: keep the batch for the next flush
+				h.mu.Lock()
+				h.pending = append(batch, h.pending...)
+				h.mu.Unlock()
+				errs = append(errs, err)
+				break
+			}
+			errs = append(errs, fmt.Errorf("dropped batch of %d events: %w", n, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrOutputTargetFailed, errors.Join(errs...))
+	}
+	return nil
+}
+
+// send POSTs a batch, retrying with exponential backoff on network errors,
+// 429 and 5xx responses
+func (h *HTTPTarget) send(ctx context.Context, batch []map[string]interface{}) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event batch: %w", err)
+	}
+
+	backoff := h.retryBackoff
+	for attempt := 0; ; attempt++ {
+		retryable, err := h.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt >= h.maxRetries {
+			return err
+		}
+
+		h.logger.Debug("Retrying event batch", "endpoint", h.config.HTTP.Endpoint, "attempt", attempt+1, "error", err)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w (retries abandoned: %w)", err, ctx.Err())
+		}
+		backoff *= 2
+	}
+}
+
+// post makes a single request and reports whether a failure may be retried
+func (h *HTTPTarget) post(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.config.HTTP.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", cloudEventsBatchContentType)
+	for name, value := range h.config.HTTP.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, fmt.Errorf("failed to send event batch: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retryable, fmt.Errorf("%w: status %d", ErrHTTPBatchRejected, resp.StatusCode)
+}
+
+// toCloudEventJSON converts a log entry back into the CloudEvents JSON
+// representation of the event it was created from
+func toCloudEventJSON(entry *LogEntry) map[string]interface{} {
+	event := map[string]interface{}{
+		"id":          uuid.NewString(),
+		"specversion": "1.0",
+		"type":        entry.Type,
+		"source":      entry.Source,
+	}
+	if !entry.Timestamp.IsZero() {
+		event["time"] = entry.Timestamp.Format(time.RFC3339Nano)
+	}
+
+	for key, value := range entry.Metadata {
+		switch key {
+		case "cloudevent_id":
+			event["id"] = value
+		case "cloudevent_specversion":
+			event["specversion"] = value
+		case "cloudevent_subject":
+			event["subject"] = value
+		default:
+			// Remaining metadata comes from the event's extension attributes
+			event[strings.ToLower(key)] = value
+		}
+	}
+
+	switch data := entry.Data.(type) {
+	case nil:
+	case []byte:
+		event["data_base64"] = data
+	default:
+		event["datacontenttype"] = "application/json"
+		event["data"] = data
+	}
+	return event
+}
@@ -0,0 +1,332 @@
+package eventlogger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// stubCollector records the event batches POSTed to it
+type stubCollector struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	batches  [][]map[string]interface{}
+	requests []*http.Request
+	// failures is the number of requests to reject with 503 before accepting
+	failures int
+}
+
+func newStubCollector(t *testing.T) *stubCollector {
+	t.Helper()
+	c := &stubCollector{}
+	c.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.requests = append(c.requests, r)
+		if c.failures > 0 {
+			c.failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var batch []map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			t.Errorf("collector received invalid batch: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		c.batches = append(c.batches, batch)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(c.Close)
+	return c
+}
+
+// Batches returns a copy of the batches received so far
+func (c *stubCollector) Batches() [][]map[string]interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([][]map[string]interface{}(nil), c.batches...)
+}
+
+// waitForBatches waits until the collector has received n batches
+func (c *stubCollector) waitForBatches(t *testing.T, n int) [][]map[string]interface{} {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if batches := c.Batches(); len(batches) >= n {
+			return batches
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Expected %d batches, got %d", n, len(c.Batches()))
+	return nil
+}
+
+func newTestHTTPTarget(t *testing.T, endpoint string, batchSize int) *HTTPTarget {
+	t.Helper()
+	target, err := NewHTTPTarget(OutputTargetConfig{
+		Type:   "http",
+		Level:  "INFO",
+		Format: "json",
+		HTTP: &HTTPTargetConfig{
+			Endpoint:      endpoint,
+			BatchSize:     batchSize,
+			FlushInterval: time.Hour, // only full batches and explicit flushes are sent
+			Headers:       map[string]string{"Authorization": "Bearer test-token"},
+			MaxRetries:    3,
+			RetryBackoff:  time.Millisecond,
+		},
+	}, &capturingLogger{})
+	if err != nil {
+		t.Fatalf("Failed to create HTTP target: %v", err)
+	}
+	return target
+}
+
+func testLogEntry(i int) *LogEntry {
+	return &LogEntry{
+		Timestamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Level:     "INFO",
+		Type:      "test.event",
+		Source:    "test-source",
+		Data:      map[string]interface{}{"index": i},
+		Metadata: map[string]interface{}{
+			"cloudevent_id":          "event-" + string(rune('a'+i)),
+			"cloudevent_specversion": "1.0",
+			"tenant":                 "acme",
+		},
+	}
+}
+
+func TestHTTPTarget_Batching(t *testing.T) {
+	collector := newStubCollector(t)
+	target := newTestHTTPTarget(t, collector.URL, 3)
+	ctx := context.Background()
+	if err := target.Start(ctx); err != nil {
+		t.Fatalf("Failed to start target: %v", err)
+	}
+
+	for i := range 7 {
+		if err := target.WriteEvent(testLogEntry(i)); err != nil {
+			t.Fatalf("WriteEvent failed: %v", err)
+		}
+	}
+
+	// Two full batches are sent without waiting for the flush interval
+	batches := collector.waitForBatches(t, 2)
+	time.Sleep(50 * time.Millisecond)
+	if got := len(collector.Batches()); got != 2 {
+		t.Fatalf("Expected only full batches to be sent before Stop, got %d batches", got)
+	}
+	for i, batch := range batches {
+		if len(batch) != 3 {
+			t.Errorf("Expected batch %d to hold 3 events, got %d", i, len(batch))
+		}
+	}
+
+	event := batches[0][0]
+	expected := map[string]interface{}{
+		"id":              "event-a",
+		"specversion":     "1.0",
+		"type":            "test.event",
+		"source":          "test-source",
+		"time":            "2024-01-02T03:04:05Z",
+		"datacontenttype": "application/json",
+		"tenant":          "acme",
+	}
+	for key, want := range expected {
+		if event[key] != want {
+			t.Errorf("Expected %s=%v, got %v", key, want, event[key])
+		}
+	}
+	if data, ok := event["data"].(map[string]interface{}); !ok || data["index"] != float64(0) {
+		t.Errorf("Expected event data to be preserved, got %v", event["data"])
+	}
+
+	collector.mu.Lock()
+	req := collector.requests[0]
+	collector.mu.Unlock()
+	if got := req.Header.Get("Content-Type"); got != "application/cloudevents-batch+json" {
+		t.Errorf("Expected CloudEvents batch content type, got %q", got)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer test-token" {
+		t.Errorf("Expected configured headers to be sent, got Authorization=%q", got)
+	}
+
+	// Stop sends the remaining partial batch
+	if err := target.Stop(ctx); err != nil {
+		t.Fatalf("Failed to stop target: %v", err)
+	}
+	batches = collector.Batches()
+	if len(batches) != 3 || len(batches[2]) != 1 {
+		t.Fatalf("Expected a final batch with the remaining event on Stop, got %d batches", len(batches))
+	}
+	if batches[2][0]["id"] != "event-g" {
+		t.Errorf("Expected the last event in the final batch, got %v", batches[2][0]["id"])
+	}
+
+	if err := target.WriteEvent(testLogEntry(0)); err == nil {
+		t.Error("Expected WriteEvent to fail after Stop")
+	}
+}
+
+func TestHTTPTarget_RetriesFailedBatch(t *testing.T) {
+	collector := newStubCollector(t)
+	collector.failures = 2
+	target := newTestHTTPTarget(t, collector.URL, 10)
+
+	if err := target.WriteEvent(testLogEntry(0)); err != nil {
+		t.Fatalf("WriteEvent failed: %v", err)
+	}
+	if err := target.Flush(); err != nil {
+		t.Fatalf("Expected batch to be delivered after retries, got %v", err)
+	}
+
+	collector.mu.Lock()
+	attempts := len(collector.requests)
+	collector.mu.Unlock()
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+	if batches := collector.Batches(); len(batches) != 1 || len(batches[0]) != 1 {
+		t.Errorf("Expected the batch to be delivered once, got %v", batches)
+	}
+}
+
+func TestHTTPTarget_DropsBatchAfterMaxRetries(t *testing.T) {
+	collector := newStubCollector(t)
+	collector.failures = 10
+	target := newTestHTTPTarget(t, collector.URL, 10)
+
+	if err := target.WriteEvent(testLogEntry(0)); err != nil {
+		t.Fatalf("WriteEvent failed: %v", err)
+	}
+	err := target.Flush()
+	if err == nil {
+		t.Fatal("Expected Flush to report the undeliverable batch")
+	}
+
+	collector.mu.Lock()
+	attempts := len(collector.requests)
+	collector.mu.Unlock()
+	if attempts != 4 {
+		t.Errorf("Expected 1 attempt and 3 retries, got %d attempts", attempts)
+	}
+
+	// The dropped batch is not resent
+	if err := target.Flush(); err != nil {
+		t.Errorf("Expected nothing left to send, got %v", err)
+	}
+}
+
+func TestEventLoggerModule_HTTPOutputFlushesOnStop(t *testing.T) {
+	collector := newStubCollector(t)
+	logger := &capturingLogger{}
+
+	module := NewModule().(*EventLoggerModule)
+	module.config = &EventLoggerConfig{
+		Enabled:       true,
+		LogLevel:      "INFO",
+		Format:        "json",
+		BufferSize:    10,
+		FlushInterval: time.Hour,
+		OutputTargets: []OutputTargetConfig{
+			{
+				Type:   "http",
+				Level:  "INFO",
+				Format: "json",
+				HTTP: &HTTPTargetConfig{
+					Endpoint:      collector.URL,
+					BatchSize:     100,
+					FlushInterval: time.Hour,
+				},
+			},
+		},
+	}
+	if err := module.config.Validate(); err != nil {
+		t.Fatalf("Expected HTTP target config to be valid: %v", err)
+	}
+	module.logger = logger
+	output, err := NewOutputTarget(module.config.OutputTargets[0], logger)
+	if err != nil {
+		t.Fatalf("Failed to create output target: %v", err)
+	}
+	module.outputs = []OutputTarget{output}
+	module.eventChan = make(chan cloudevents.Event, module.config.BufferSize)
+	module.stopChan = make(chan struct{})
+
+	ctx := context.Background()
+	if err := module.Start(ctx); err != nil {
+		t.Fatalf("Failed to start module: %v", err)
+	}
+
+	ids := make(map[string]bool)
+	for range 3 {
+		event := modular.NewCloudEvent("user.created", "test", map[string]interface{}{"user": "alice"}, nil)
+		ids[event.ID()] = true
+		if err := module.OnEvent(ctx, event); err != nil {
+			t.Fatalf("OnEvent failed: %v", err)
+		}
+	}
+
+	if err := module.Stop(ctx); err != nil {
+		t.Fatalf("Failed to stop module: %v", err)
+	}
+
+	var received []map[string]interface{}
+	for _, batch := range collector.Batches() {
+		received = append(received, batch...)
+	}
+	if len(received) != len(ids) {
+		t.Fatalf("Expected %d events to be flushed on Stop, got %d", len(ids), len(received))
+	}
+	for _, event := range received {
+		if id, _ := event["id"].(string); !ids[id] {
+			t.Errorf("Unexpected event id %v", event["id"])
+		}
+		if event["type"] != "user.created" {
+			t.Errorf("Expected type user.created, got %v", event["type"])
+		}
+	}
+}
+
+func TestOutputTargetConfig_HTTPValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		http    *HTTPTargetConfig
+		wantErr error
+	}{
+		{"valid", &HTTPTargetConfig{Endpoint: "https://collector.example.com/events"}, nil},
+		{"missing config", nil, ErrMissingHTTPConfig},
+		{"missing endpoint", &HTTPTargetConfig{}, ErrMissingHTTPEndpoint},
+		{"relative endpoint", &HTTPTargetConfig{Endpoint: "/events"}, ErrInvalidHTTPEndpoint},
+		{"unsupported scheme", &HTTPTargetConfig{Endpoint: "ftp://collector.example.com"}, ErrInvalidHTTPEndpoint},
+		{"negative batch size", &HTTPTargetConfig{Endpoint: "http://localhost", BatchSize: -1}, ErrInvalidHTTPConfig},
+		{"negative timeout", &HTTPTargetConfig{Endpoint: "http://localhost", Timeout: -time.Second}, ErrInvalidHTTPConfig},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := OutputTargetConfig{Type: "http", Level: "INFO", Format: "json", HTTP: tt.http}
+			err := config.Validate()
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("Expected valid config, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
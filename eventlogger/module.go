@@ -1,12 +1,12 @@
 // Package eventlogger provides structured logging capabilities for Observer pattern events.
 //
 // This module acts as an Observer that can be registered with any Subject (like ObservableApplication)
-// to log events to various output targets including console, files, and syslog.
+// to log events to various output targets including console, files, syslog, and HTTP collectors.
 //
 // # Features
 //
 // The eventlogger module offers the following capabilities:
-//   - Multiple output targets (console, file, syslog)
+//   - Multiple output targets (console, file, syslog, HTTP)
 //   - Configurable log levels and formats
 //   - Event type filtering
 //   - Async processing with buffering
@@ -42,6 +42,16 @@
 //	                Compress: true,
 //	            },
 //	        },
+//	        {
+//	            Type: "http",
+//	            Level: "INFO",
+//	            HTTP: &HTTPTargetConfig{
+//	                Endpoint: "https://collector.example.com/events",
+//	                BatchSize: 100,
+//	                FlushInterval: 5 * time.Second,
+//	                Headers: map[string]string{"Authorization": "Bearer " + token},
+//	            },
+//	        },
 //	    },
 //	}
 //
@@ -82,6 +92,16 @@
 //	    },
 //	}
 //
+// Glob pattern filtering with sampling of high-volume events:
+//
+//	config := &EventLoggerConfig{
+//	    Filters: EventFilterConfig{
+//	        Include:     []string{"com.modular.*", "user.*"},
+//	        Exclude:     []string{"com.modular.eventlogger.*"},
+//	        SampleRates: map[string]float64{"com.modular.chimux.*": 0.1},
+//	    },
+//	}
+//
 // # Output Formats
 //
 // The module supports different output formats:
@@ -936,9 +956,19 @@ func (m *EventLoggerModule) shouldLogEvent(event cloudevents.Event) bool {
 		}
 	}
 
-	// Step 4: Check log level
+	// Step 4: Apply glob pattern filters (if configured)
+	if !m.config.Filters.matches(eventType) {
+		return false
+	}
+
+	// Step 5: Check log level
 	eventLevel := m.getEventLevel(event)
-	return m.shouldLogLevel(eventLevel, m.config.LogLevel)
+	if !m.shouldLogLevel(eventLevel, m.config.LogLevel) {
+		return false
+	}
+
+	// Step 6: Sample high-volume event types
+	return m.config.Filters.sampled(eventType, event.ID())
 }
 
 // getEventLevel determines the log level for an event.
@@ -8,6 +8,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/GoCodeAlone/modular"
 )
@@ -36,6 +38,8 @@ func NewOutputTarget(config OutputTargetConfig, logger modular.Logger) (OutputTa
 		return NewFileTarget(config, logger)
 	case "syslog":
 		return NewSyslogTarget(config, logger)
+	case "http":
+		return NewHTTPTarget(config, logger)
 	default:
 		return nil, fmt.Errorf("%w: %s", ErrUnknownOutputTargetType, config.Type)
 	}
@@ -194,10 +198,23 @@ func (c *ConsoleTarget) colorizeLevel(level string) string {
 }
 
 // FileTarget outputs events to a file with rotation support.
+// When the file would grow past MaxSize MB it is renamed to a timestamped
+// backup and a new file is started; old backups are then pruned by
+// MaxBackups and MaxAge and, if Compress is set, gzipped.
 type FileTarget struct {
-	config OutputTargetConfig
-	logger modular.Logger
-	file   *os.File
+	config   OutputTargetConfig
+	logger   modular.Logger
+	maxBytes int64
+	now      func() time.Time
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+
+	// millMu serializes pruning and compression of backups, which run in the
+	// background after each rotation and are awaited by millWG on Stop
+	millMu sync.Mutex
+	millWG sync.WaitGroup
 }
 
 // NewFileTarget creates a new file output target.
@@ -207,8 +224,10 @@ func NewFileTarget(config OutputTargetConfig, logger modular.Logger) (*FileTarge
 	}
 
 	target := &FileTarget{
-		config: config,
-		logger: logger,
+		config:   config,
+		logger:   logger,
+		maxBytes: int64(config.File.MaxSize) * megabyte,
+		now:      time.Now,
 	}
 
 	// Proactively ensure the log file path exists so tests can detect it quickly
@@ -226,15 +245,12 @@ func NewFileTarget(config OutputTargetConfig, logger modular.Logger) (*FileTarge
 
 // Start initializes the file target.
 func (f *FileTarget) Start(ctx context.Context) error {
-	// Ensure parent directory exists
-	if err := os.MkdirAll(filepath.Dir(f.config.File.Path), 0o755); err != nil {
-		return fmt.Errorf("failed to create log directory %s: %w", filepath.Dir(f.config.File.Path), err)
-	}
-	file, err := os.OpenFile(f.config.File.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
-	if err != nil {
-		return fmt.Errorf("failed to open log file %s: %w", f.config.File.Path, err)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.openFile(); err != nil {
+		return err
 	}
-	f.file = file
 	f.logger.Debug("File output target started", "path", f.config.File.Path)
 
 	// Force sync so tests can detect the file immediately
@@ -247,18 +263,27 @@ func (f *FileTarget) Start(ctx context.Context) error {
 
 // Stop shuts down the file target.
 func (f *FileTarget) Stop(ctx context.Context) error {
+	f.mu.Lock()
 	if f.file != nil {
 		if err := f.file.Close(); err != nil {
 			f.logger.Debug("Error closing file", "error", err)
 		}
 		f.file = nil
 	}
+	f.mu.Unlock()
+
+	// Let pruning and compression of the last rotation finish
+	f.millWG.Wait()
 	f.logger.Debug("File output target stopped")
 	return nil
 }
 
-// WriteEvent writes a log entry to file.
+// WriteEvent writes a log entry to file, rotating it first if the entry
+// would take it past the maximum size.
 func (f *FileTarget) WriteEvent(entry *LogEntry) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
 	if f.file == nil {
 		return ErrFileNotOpen
 	}
@@ -286,7 +311,15 @@ func (f *FileTarget) WriteEvent(entry *LogEntry) error {
 		return fmt.Errorf("failed to format log entry: %w", err)
 	}
 
-	_, err = fmt.Fprintln(f.file, output)
+	line := output + "\n"
+	if f.maxBytes > 0 && f.size > 0 && f.size+int64(len(line)) > f.maxBytes {
+		if err := f.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := f.file.WriteString(line)
+	f.size += int64(n)
 	if err != nil {
 		return fmt.Errorf("failed to write to file: %w", err)
 	}
@@ -295,6 +328,9 @@ func (f *FileTarget) WriteEvent(entry *LogEntry) error {
 
 // Flush flushes file output.
 func (f *FileTarget) Flush() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
 	if f.file != nil {
 		if err := f.file.Sync(); err != nil {
 			return fmt.Errorf("failed to sync file: %w", err)
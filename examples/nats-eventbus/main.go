@@ -6,10 +6,8 @@ import (
 	"log"
 	"net"
 	"os"
-	"os/signal"
 	"strings"
 	"sync"
-	"syscall"
 	"time"
 
 	"github.com/GoCodeAlone/modular"
@@ -134,8 +132,6 @@ func (et *EventTracker) Validate() bool {
 }
 
 func main() {
-	ctx := context.Background()
-
 	// Create application configuration
 	appConfig := &AppConfig{
 		Name:        "NATS EventBus Demo",
@@ -179,18 +175,18 @@ func main() {
 	// Register modules
 	app.RegisterModule(eventbus.NewModule())
 
+	// The demo services stop before the eventbus they depend on
+	services := &demoServicesModule{tracker: &EventTracker{}}
+	app.RegisterModule(services)
+
 	// Initialize application
 	err := app.Init()
 	if err != nil {
 		log.Fatal("Failed to initialize application:", err)
 	}
 
-	// Get services
-	var eventBusService *eventbus.EventBusModule
-	err = app.GetService("eventbus.provider", &eventBusService)
-	if err != nil {
-		log.Fatal("Failed to get eventbus service:", err)
-	}
+	// Check if NATS service is available
+	checkNATSAvailability()
 
 	// Start application
 	err = app.Start()
@@ -204,79 +200,95 @@ func main() {
 	fmt.Println("  - All topics routed through NATS")
 	fmt.Println()
 
-	// Check if NATS service is available
-	checkNATSAvailability()
+	// Stop the application on SIGINT or SIGTERM
+	stopped := make(chan error, 1)
+	cancel := modular.InstallSignalHandlers(app, modular.SignalOptions{
+		OnStop: func(err error) { stopped <- err },
+	})
+	defer cancel()
 
-	// Give the eventbus a moment to fully initialize connections
-	time.Sleep(500 * time.Millisecond)
+	fmt.Println("🔄 Services are running. Press Ctrl+C to stop...")
+	fmt.Println()
+
+	if err := <-stopped; err != nil {
+		log.Printf("Warning during shutdown: %v", err)
+	}
 
-	// Create event tracker for validation
-	tracker := &EventTracker{}
+	// Validate event correlation
+	pubOrders, pubAnalytics, pubNotifs, consOrders, consAnalytics, consNotifs := services.tracker.GetStats()
+
+	fmt.Println("\n📊 Event Correlation Report:")
+	fmt.Printf("  Orders:      Published: %d, Consumed: %d ✓\n", pubOrders, consOrders)
+	fmt.Printf("  Analytics:   Published: %d, Consumed: %d ✓\n", pubAnalytics, consAnalytics)
+	fmt.Printf("  Notifications: Published: %d, Consumed: %d ✓\n", pubNotifs, consNotifs)
+
+	if services.tracker.Validate() {
+		fmt.Println("\n✅ Validation PASSED: All published events were consumed")
+	} else {
+		fmt.Println("\n❌ Validation FAILED: Mismatch between published and consumed events")
+		os.Exit(1)
+	}
 
-	// Set up a wait group for services
-	var wg sync.WaitGroup
+	fmt.Println("✅ Application shutdown complete")
+}
+
+// demoServicesModule runs the publisher and subscriber services while the
+// application is running. It depends on the eventbus, so it is stopped, and
+// its services drained, before the eventbus shuts down.
+type demoServicesModule struct {
+	eventBus *eventbus.EventBusModule
+	tracker  *EventTracker
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
 
-	// Set up signal handling for graceful shutdown
-	signalChan := make(chan os.Signal, 1)
-	signal.Notify(signalChan, os.Interrupt, syscall.SIGTERM)
+func (m *demoServicesModule) Name() string { return "demo-services" }
+
+func (m *demoServicesModule) Dependencies() []string { return []string{eventbus.ModuleName} }
+
+func (m *demoServicesModule) Init(app modular.Application) error {
+	if err := app.GetService("eventbus.provider", &m.eventBus); err != nil {
+		return fmt.Errorf("failed to get eventbus service: %w", err)
+	}
+	return nil
+}
+
+func (m *demoServicesModule) Start(ctx context.Context) error {
+	// Give the eventbus a moment to fully initialize connections
+	time.Sleep(500 * time.Millisecond)
 
 	// Create a stop channel that will be closed to signal all goroutines to stop
-	stopChan := make(chan struct{})
+	m.stopChan = make(chan struct{})
+	serviceCtx := context.WithoutCancel(ctx)
 
 	// Start Publisher Service (Service 1)
-	wg.Add(1)
+	m.wg.Add(1)
 	go func() {
-		defer wg.Done()
-		runPublisherService(ctx, eventBusService, stopChan, tracker)
+		defer m.wg.Done()
+		runPublisherService(serviceCtx, m.eventBus, m.stopChan, m.tracker)
 	}()
 
 	// Start Subscriber Services (Service 2)
-	wg.Add(1)
+	m.wg.Add(1)
 	go func() {
-		defer wg.Done()
-		runSubscriberService(ctx, eventBusService, stopChan, tracker)
+		defer m.wg.Done()
+		runSubscriberService(serviceCtx, m.eventBus, m.stopChan, m.tracker)
 	}()
+	return nil
+}
 
-	// Wait for signal or service completion
-	fmt.Println("🔄 Services are running. Press Ctrl+C to stop...")
-	fmt.Println()
-
-	// Wait for shutdown signal
-	<-signalChan
-
+func (m *demoServicesModule) Stop(context.Context) error {
 	// Graceful shutdown - close the stop channel to broadcast to all goroutines
 	fmt.Println("\n🛑 Shutting down services...")
-	close(stopChan)
+	close(m.stopChan)
 
 	// Wait for services to complete (they will stop when they receive the signal)
-	wg.Wait()
+	m.wg.Wait()
 
 	// Wait a moment for async processing to complete
 	fmt.Println("⏳ Waiting for event processing to complete...")
 	time.Sleep(2 * time.Second)
-
-	// Stop application after services have stopped
-	err = app.Stop()
-	if err != nil {
-		log.Printf("Warning during shutdown: %v", err)
-	}
-
-	// Validate event correlation
-	pubOrders, pubAnalytics, pubNotifs, consOrders, consAnalytics, consNotifs := tracker.GetStats()
-
-	fmt.Println("\n📊 Event Correlation Report:")
-	fmt.Printf("  Orders:      Published: %d, Consumed: %d ✓\n", pubOrders, consOrders)
-	fmt.Printf("  Analytics:   Published: %d, Consumed: %d ✓\n", pubAnalytics, consAnalytics)
-	fmt.Printf("  Notifications: Published: %d, Consumed: %d ✓\n", pubNotifs, consNotifs)
-
-	if tracker.Validate() {
-		fmt.Println("\n✅ Validation PASSED: All published events were consumed")
-	} else {
-		fmt.Println("\n❌ Validation FAILED: Mismatch between published and consumed events")
-		os.Exit(1)
-	}
-
-	fmt.Println("✅ Application shutdown complete")
+	return nil
 }
 
 // runPublisherService simulates a service that publishes events
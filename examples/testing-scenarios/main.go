@@ -9,11 +9,9 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
-	"os/signal"
 	"regexp"
 	"strconv"
 	"sync"
-	"syscall"
 	"time"
 
 	"github.com/GoCodeAlone/modular"
@@ -146,24 +144,15 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	// Handle shutdown signals
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-
-	go func() {
-		<-sigChan
-		app.Logger().Info("Shutdown signal received, stopping application...")
-		cancel()
-	}()
-
-	// Run application
+	// Run application, which stops on SIGINT or SIGTERM and reloads its
+	// configuration on SIGHUP
 	testApp.running = true
 	app.Logger().Info("Starting testing scenarios application...")
 
 	go func() {
+		defer cancel()
 		if err := app.Run(); err != nil {
 			app.Logger().Error("Application error", "error", err)
-			cancel()
 		}
 	}()
 
@@ -541,6 +530,15 @@ func (t *TestingApp) registerHealthEndpointAfterStart() {
 	})
 
 	t.app.Logger().Info("Registered application health endpoint at /health")
+
+	// Expose the route table so scenarios can check what is actually routed
+	var mux *chimux.ChiMuxModule
+	if err := t.app.GetService("chimux.router", &mux); err != nil {
+		t.app.Logger().Error("Failed to get chimux module for route table endpoint", "error", err)
+		return
+	}
+	router.HandleFunc("/debug/routes", mux.RouteTableHandler())
+	t.app.Logger().Info("Registered route table endpoint at /debug/routes")
 }
 
 type ScenarioConfig struct {
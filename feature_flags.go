@@ -0,0 +1,282 @@
+package modular
+
+import (
+	"context"
+	"hash/fnv"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// FeatureFlagsSection is the config section read by FileFeatureFlagEvaluator
+const FeatureFlagsSection = "featureFlags"
+
+// Well-known feature flag attributes
+const (
+	// FeatureFlagUserIDAttribute identifies the user a flag is evaluated for,
+	// and is the default bucketing key of percentage rollouts
+	FeatureFlagUserIDAttribute = "userId"
+	// FeatureFlagTenantIDAttribute is set to the tenant a flag is evaluated for
+	FeatureFlagTenantIDAttribute = "tenantId"
+)
+
+// featureFlagBuckets is the number of rollout buckets, giving percentages a
+// resolution of 0.01
+const featureFlagBuckets = 10000
+
+// featureFlagAttributesKey is the context key of FeatureFlagAttributes
+type featureFlagAttributesKey struct{}
+
+// FeatureFlagAttributes describe the subject a feature flag is evaluated for,
+// such as {"userId": "u-123", "country": "CA"}. They are matched by targeting
+// rules and provide the bucketing key of percentage rollouts.
+type FeatureFlagAttributes map[string]string
+
+// WithFeatureFlagAttributes returns a copy of ctx carrying attrs for feature
+// flag evaluation. Attributes already in ctx are kept unless attrs overrides them.
+//
+// Example:
+//
+//	ctx = modular.WithFeatureFlagAttributes(ctx, modular.FeatureFlagAttributes{
+//	    modular.FeatureFlagUserIDAttribute: user.ID,
+//	    "plan":                             user.Plan,
+//	})
+func WithFeatureFlagAttributes(ctx context.Context, attrs FeatureFlagAttributes) context.Context {
+	merged := make(FeatureFlagAttributes, len(attrs))
+	for k, v := range FeatureFlagAttributesFromContext(ctx) {
+		merged[k] = v
+	}
+	for k, v := range attrs {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, featureFlagAttributesKey{}, merged)
+}
+
+// FeatureFlagAttributesFromContext returns the feature flag attributes of ctx
+func FeatureFlagAttributesFromContext(ctx context.Context) FeatureFlagAttributes {
+	attrs, _ := ctx.Value(featureFlagAttributesKey{}).(FeatureFlagAttributes)
+	return attrs
+}
+
+// FeatureFlagEvaluator evaluates boolean feature flags. Any module can gate
+// behavior on flags by discovering the registered evaluator by interface with
+// FindFeatureFlagEvaluator, or more simply through IsFeatureEnabled, so the
+// evaluator can be backed by config files or an external flag service without
+// the modules knowing which.
+type FeatureFlagEvaluator interface {
+	// Evaluate returns the value of flag for the tenant of ctx, if any, or
+	// defaultVal if the flag is not defined.
+	Evaluate(ctx context.Context, flag string, defaultVal bool) bool
+
+	// EvaluateForTenant returns the value of flag for tenantID, or defaultVal
+	// if the flag is defined neither for the tenant nor globally.
+	EvaluateForTenant(ctx context.Context, tenantID TenantID, flag string, defaultVal bool) bool
+}
+
+// FindFeatureFlagEvaluator returns the feature flag evaluator registered with
+// the application under any service name. If several services implement
+// FeatureFlagEvaluator, the one with the lowest service name is returned.
+func FindFeatureFlagEvaluator(app Application) (FeatureFlagEvaluator, bool) {
+	if app == nil {
+		return nil, false
+	}
+	entries := app.GetServicesByInterface(reflect.TypeOf((*FeatureFlagEvaluator)(nil)).Elem())
+	if len(entries) == 0 {
+		return nil, false
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ActualName < entries[j].ActualName
+	})
+	evaluator, ok := entries[0].Service.(FeatureFlagEvaluator)
+	return evaluator, ok
+}
+
+// IsFeatureEnabled evaluates flag with the application's feature flag
+// evaluator, for the tenant of ctx if there is one. It returns defaultVal
+// when no evaluator is registered.
+//
+// Example:
+//
+//	if modular.IsFeatureEnabled(ctx, app, "scheduler.parallel-jobs", false) {
+//	    ...
+//	}
+func IsFeatureEnabled(ctx context.Context, app Application, flag string, defaultVal bool) bool {
+	evaluator, ok := FindFeatureFlagEvaluator(app)
+	if !ok {
+		return defaultVal
+	}
+	return evaluator.Evaluate(ctx, flag, defaultVal)
+}
+
+// FeatureFlagsConfig holds feature flag values, as read from the
+// FeatureFlagsSection config section of the application and of each tenant.
+// A flag is either a plain boolean in Flags or a rule in Rules; Flags wins if
+// both define it.
+type FeatureFlagsConfig struct {
+	Flags map[string]bool            `yaml:"flags" json:"flags" toml:"flags" desc:"Feature flag values by flag name"`
+	Rules map[string]FeatureFlagRule `yaml:"rules" json:"rules" toml:"rules" desc:"Targeted and percentage rollout flags by flag name"`
+}
+
+// FeatureFlagRule enables a flag for targeted subjects and for a stable
+// percentage of the rest. Targets are checked first; a subject matching none
+// is in the rollout if its bucketing key hashes into the first Percentage
+// percent of buckets. The hash covers the flag name and the key only, so the
+// same subject always gets the same result, across restarts too.
+//
+// Example:
+//
+//	featureFlags:
+//	  rules:
+//	    checkout-v2:
+//	      targets:
+//	        - attribute: plan
+//	          values: [enterprise]
+//	      percentage: 25
+//	      bucketBy: userId
+type FeatureFlagRule struct {
+	Targets    []FeatureFlagTarget `yaml:"targets" json:"targets" toml:"targets" desc:"Attribute rules enabling the flag, checked in order"`
+	Percentage float64             `yaml:"percentage" json:"percentage" toml:"percentage" desc:"Percentage (0-100) of subjects the flag is enabled for"`
+	BucketBy   string              `yaml:"bucketBy" json:"bucketBy" toml:"bucketBy" desc:"Attribute used as the rollout bucketing key (default userId)"`
+}
+
+// FeatureFlagTarget matches subjects whose attribute has one of the values
+type FeatureFlagTarget struct {
+	Attribute string   `yaml:"attribute" json:"attribute" toml:"attribute" desc:"Attribute to match"`
+	Values    []string `yaml:"values" json:"values" toml:"values" desc:"Attribute values enabling the flag"`
+	Enabled   *bool    `yaml:"enabled" json:"enabled" toml:"enabled" desc:"Flag value for matching subjects (default true)"`
+}
+
+// evaluate returns whether the rule enables the flag for attrs
+func (r *FeatureFlagRule) evaluate(flag string, attrs FeatureFlagAttributes) bool {
+	for _, target := range r.Targets {
+		if value, ok := attrs[target.Attribute]; ok && slices.Contains(target.Values, value) {
+			return target.Enabled == nil || *target.Enabled
+		}
+	}
+
+	switch {
+	case r.Percentage <= 0:
+		return false
+	case r.Percentage >= 100:
+		return true
+	}
+	bucketBy := r.BucketBy
+	if bucketBy == "" {
+		bucketBy = FeatureFlagUserIDAttribute
+	}
+	key, ok := attrs[bucketBy]
+	if !ok || key == "" {
+		// Subjects without a bucketing key are outside partial rollouts
+		return false
+	}
+	return float64(featureFlagBucket(flag, key)) < r.Percentage*featureFlagBuckets/100
+}
+
+// featureFlagBucket deterministically maps a flag and bucketing key to a
+// bucket in [0, featureFlagBuckets)
+func featureFlagBucket(flag, key string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(flag))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(key))
+	return h.Sum32() % featureFlagBuckets
+}
+
+// lookup returns the value of flag for attrs and whether the config defines it
+func (c *FeatureFlagsConfig) lookup(flag string, attrs FeatureFlagAttributes) (bool, bool) {
+	if value, ok := c.Flags[flag]; ok {
+		return value, true
+	}
+	if rule, ok := c.Rules[flag]; ok {
+		return rule.evaluate(flag, attrs), true
+	}
+	return false, false
+}
+
+// FileFeatureFlagEvaluator evaluates feature flags from the FeatureFlagsSection
+// config section. Tenants override individual flags in the same section of
+// their tenant config; flags a tenant does not set fall back to the
+// application's value. Rules are evaluated against the FeatureFlagAttributes
+// of the context, with FeatureFlagTenantIDAttribute set to the tenant.
+type FileFeatureFlagEvaluator struct {
+	app Application
+
+	// fileFlags holds the flags loaded by WatchFile, nil if there are none
+	fileFlags   atomic.Pointer[FeatureFlagsConfig]
+	watchMu     sync.Mutex
+	fileWatcher *fileWatcher
+	watchedFile string
+}
+
+// NewFileFeatureFlagEvaluator creates a file-based feature flag evaluator.
+// The FeatureFlagsSection config section must be registered with the
+// application so that config feeders populate it.
+//
+// Example:
+//
+//	app.RegisterConfigSection(modular.FeatureFlagsSection, modular.NewStdConfigProvider(&modular.FeatureFlagsConfig{}))
+//	app.RegisterService("featureFlags", modular.NewFileFeatureFlagEvaluator(app))
+//
+// with, in config.yaml:
+//
+//	featureFlags:
+//	  flags:
+//	    new-ui: true
+func NewFileFeatureFlagEvaluator(app Application) *FileFeatureFlagEvaluator {
+	return &FileFeatureFlagEvaluator{app: app}
+}
+
+// Evaluate returns the value of flag for the tenant of ctx, if any
+func (e *FileFeatureFlagEvaluator) Evaluate(ctx context.Context, flag string, defaultVal bool) bool {
+	if tenantID, ok := GetTenantIDFromContext(ctx); ok {
+		return e.EvaluateForTenant(ctx, tenantID, flag, defaultVal)
+	}
+	return e.globalFlag(flag, FeatureFlagAttributesFromContext(ctx), defaultVal)
+}
+
+// EvaluateForTenant returns the tenant's value of flag, falling back to the
+// application's value and then to defaultVal
+func (e *FileFeatureFlagEvaluator) EvaluateForTenant(ctx context.Context, tenantID TenantID, flag string, defaultVal bool) bool {
+	attrs := FeatureFlagAttributes{FeatureFlagTenantIDAttribute: string(tenantID)}
+	for k, v := range FeatureFlagAttributesFromContext(ctx) {
+		attrs[k] = v
+	}
+
+	if cfg, err := GetTenantConfig[FeatureFlagsConfig](e.app, tenantID, FeatureFlagsSection); err == nil {
+		if value, ok := cfg.lookup(flag, attrs); ok {
+			return value
+		}
+	}
+	return e.globalFlag(flag, attrs, defaultVal)
+}
+
+// globalFlag returns the application's value of flag, or defaultVal. Flags
+// from the watched flag file take precedence over the config section.
+func (e *FileFeatureFlagEvaluator) globalFlag(flag string, attrs FeatureFlagAttributes, defaultVal bool) bool {
+	if cfg := e.fileFlags.Load(); cfg != nil {
+		if value, ok := cfg.lookup(flag, attrs); ok {
+			return value
+		}
+	}
+
+	provider, err := e.app.GetConfigSection(FeatureFlagsSection)
+	if err != nil {
+		return defaultVal
+	}
+	var cfg *FeatureFlagsConfig
+	switch c := provider.GetConfig().(type) {
+	case *FeatureFlagsConfig:
+		cfg = c
+	case FeatureFlagsConfig:
+		cfg = &c
+	}
+	if cfg == nil {
+		return defaultVal
+	}
+	if value, ok := cfg.lookup(flag, attrs); ok {
+		return value
+	}
+	return defaultVal
+}
@@ -0,0 +1,143 @@
+package modular
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// WatchFile loads flags from a dedicated flag file and reloads them whenever
+// the file changes, so flag edits take effect without a restart. The file
+// holds a FeatureFlagsConfig in YAML, JSON or TOML, chosen by its extension:
+//
+//	flags:
+//	  new-ui: true
+//	rules:
+//	  checkout-v2:
+//	    percentage: 25
+//
+// Flags defined in the file take precedence over the FeatureFlagsSection
+// config section; tenant overrides still apply on top. Reloads wait until the
+// file has not changed for debounce (500ms if not positive) and swap the flag
+// set atomically, emitting an EventTypeFeatureFlagsReloaded event when the
+// application is a Subject. An edit that leaves the file malformed is logged
+// and ignored, keeping the last good flags. The initial load must succeed.
+// Watching ends when ctx is done or StopWatching is called.
+func (e *FileFeatureFlagEvaluator) WatchFile(ctx context.Context, path string, debounce time.Duration) error {
+	e.watchMu.Lock()
+	defer e.watchMu.Unlock()
+	if e.fileWatcher != nil {
+		return fmt.Errorf("%w: %s", ErrFeatureFlagFileAlreadyWatched, e.watchedFile)
+	}
+
+	path = filepath.Clean(path)
+	if _, err := e.loadFile(path); err != nil {
+		return err
+	}
+
+	w := newFileWatcher("feature flag", []string{path}, debounce, e.app.Logger(), func(ctx context.Context) {
+		e.reloadFile(ctx, path)
+	})
+	if err := w.start(ctx); err != nil {
+		return err
+	}
+	e.fileWatcher = w
+	e.watchedFile = path
+	return nil
+}
+
+// StopWatching stops watching the flag file. The flags loaded last stay in
+// effect. It is safe to call when no file is watched.
+func (e *FileFeatureFlagEvaluator) StopWatching() {
+	e.watchMu.Lock()
+	w := e.fileWatcher
+	e.fileWatcher = nil
+	e.watchMu.Unlock()
+	if w != nil {
+		w.stop()
+	}
+}
+
+// loadFile parses the flag file and swaps it in as the file flag set
+func (e *FileFeatureFlagEvaluator) loadFile(path string) (*FeatureFlagsConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading feature flag file %s: %w", path, err)
+	}
+	cfg, err := parseFeatureFlagFile(path, data)
+	if err != nil {
+		return nil, err
+	}
+	e.fileFlags.Store(cfg)
+	return cfg, nil
+}
+
+// parseFeatureFlagFile decodes a flag file, rejecting unknown keys and
+// out-of-range rules so that typos don't silently disable flags
+func parseFeatureFlagFile(path string, data []byte) (*FeatureFlagsConfig, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, fmt.Errorf("%w %s: file is empty", ErrInvalidFeatureFlagFile, path)
+	}
+
+	cfg := &FeatureFlagsConfig{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(cfg); err != nil {
+			return nil, fmt.Errorf("%w %s: decoding JSON: %w", ErrInvalidFeatureFlagFile, path, err)
+		}
+	case ".toml":
+		md, err := toml.Decode(string(data), cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: decoding TOML: %w", ErrInvalidFeatureFlagFile, path, err)
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			return nil, fmt.Errorf("%w %s: unknown key %s", ErrInvalidFeatureFlagFile, path, undecoded[0])
+		}
+	default:
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(cfg); err != nil {
+			return nil, fmt.Errorf("%w %s: decoding YAML: %w", ErrInvalidFeatureFlagFile, path, err)
+		}
+	}
+
+	for name, rule := range cfg.Rules {
+		if rule.Percentage < 0 || rule.Percentage > 100 {
+			return nil, fmt.Errorf("%w %s: rule %s has percentage %v outside 0-100",
+				ErrInvalidFeatureFlagFile, path, name, rule.Percentage)
+		}
+	}
+	return cfg, nil
+}
+
+// reloadFile loads the changed flag file, keeping the current flags if it is malformed
+func (e *FileFeatureFlagEvaluator) reloadFile(ctx context.Context, path string) {
+	logger := e.app.Logger()
+	cfg, err := e.loadFile(path)
+	if err != nil {
+		logger.Error("Failed to reload feature flags, keeping the last good flags", "file", path, "error", err)
+		return
+	}
+
+	logger.Info("Feature flags reloaded", "file", path, "flags", len(cfg.Flags), "rules", len(cfg.Rules))
+	if subject, ok := e.app.(Subject); ok {
+		event := NewCloudEvent(EventTypeFeatureFlagsReloaded, "modular.featureflags", map[string]any{
+			"file":  path,
+			"flags": len(cfg.Flags),
+			"rules": len(cfg.Rules),
+		}, nil)
+		if err := subject.NotifyObservers(ctx, event); err != nil {
+			logger.Debug("Failed to emit feature flags reloaded event", "error", err)
+		}
+	}
+}
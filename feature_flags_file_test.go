@@ -0,0 +1,127 @@
+package modular
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// errors returns the recorded ERROR messages
+func (l *reloadTestLogger) errors() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var errs []string
+	for _, msg := range l.messages {
+		if strings.HasPrefix(msg, "[ERROR]") {
+			errs = append(errs, msg)
+		}
+	}
+	return errs
+}
+
+// watchFeatureFlagFile writes content to a flag file and watches it with a
+// short debounce, stopping the watch when the test ends
+func watchFeatureFlagFile(t *testing.T, app Application, name, content string) (*FileFeatureFlagEvaluator, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	evaluator := NewFileFeatureFlagEvaluator(app)
+	require.NoError(t, evaluator.WatchFile(context.Background(), path, 20*time.Millisecond))
+	t.Cleanup(evaluator.StopWatching)
+	return evaluator, path
+}
+
+func TestFileFeatureFlagEvaluator_WatchFileReloads(t *testing.T) {
+	app := NewObservableApplication(NewStdConfigProvider(&struct{}{}), nopLogger{})
+	var (
+		mu     sync.Mutex
+		events []cloudevents.Event
+	)
+	require.NoError(t, app.RegisterObserver(NewFunctionalObserver("flag-observer", func(_ context.Context, event cloudevents.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+		return nil
+	}), EventTypeFeatureFlagsReloaded))
+
+	evaluator, path := watchFeatureFlagFile(t, app, "flags.yaml", "flags:\n  new-ui: false\n")
+	ctx := context.Background()
+	assert.False(t, evaluator.Evaluate(ctx, "new-ui", true), "the initial file is loaded")
+
+	require.NoError(t, os.WriteFile(path, []byte("flags:\n  new-ui: true\nrules:\n  checkout-v2:\n    percentage: 100\n"), 0o600))
+	require.Eventually(t, func() bool {
+		return evaluator.Evaluate(ctx, "new-ui", false) && evaluator.Evaluate(ctx, "checkout-v2", false)
+	}, 2*time.Second, 10*time.Millisecond, "edits take effect without a restart")
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(events) > 0
+	}, 2*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	event := events[len(events)-1]
+	mu.Unlock()
+	assert.Equal(t, EventTypeFeatureFlagsReloaded, event.Type())
+	var data map[string]any
+	require.NoError(t, event.DataAs(&data))
+	assert.Equal(t, path, data["file"])
+	assert.EqualValues(t, 1, data["flags"])
+	assert.EqualValues(t, 1, data["rules"])
+}
+
+func TestFileFeatureFlagEvaluator_WatchFileKeepsLastGoodFlags(t *testing.T) {
+	logger := &reloadTestLogger{}
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), logger)
+	evaluator, path := watchFeatureFlagFile(t, app, "flags.yaml", "flags:\n  new-ui: true\n")
+	ctx := context.Background()
+
+	require.NoError(t, os.WriteFile(path, []byte("flags:\n  new-ui: [not a bool\n"), 0o600))
+	require.Eventually(t, func() bool { return len(logger.errors()) > 0 }, 2*time.Second, 10*time.Millisecond)
+	assert.Contains(t, logger.errors()[0], "keeping the last good flags")
+	assert.True(t, evaluator.Evaluate(ctx, "new-ui", false), "a malformed edit is ignored")
+
+	require.NoError(t, os.WriteFile(path, []byte("flags:\n  new-ui: false\n"), 0o600))
+	require.Eventually(t, func() bool { return !evaluator.Evaluate(ctx, "new-ui", true) },
+		2*time.Second, 10*time.Millisecond, "the next valid edit is picked up")
+}
+
+func TestFileFeatureFlagEvaluator_WatchFileErrors(t *testing.T) {
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), nopLogger{})
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{"empty file", "flags.yaml", "  \n"},
+		{"unknown YAML key", "flags.yaml", "flag:\n  new-ui: true\n"},
+		{"unknown JSON key", "flags.json", `{"flags": {"new-ui": true}, "extra": 1}`},
+		{"unknown TOML key", "flags.toml", "[flag]\nnew-ui = true\n"},
+		{"percentage out of range", "flags.yaml", "rules:\n  checkout-v2:\n    percentage: 150\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.file)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+			err := NewFileFeatureFlagEvaluator(app).WatchFile(ctx, path, 0)
+			require.ErrorIs(t, err, ErrInvalidFeatureFlagFile)
+		})
+	}
+
+	t.Run("already watched", func(t *testing.T) {
+		evaluator, path := watchFeatureFlagFile(t, app, "flags.json", `{"flags": {"new-ui": true}}`)
+		assert.True(t, evaluator.Evaluate(ctx, "new-ui", false))
+		require.ErrorIs(t, evaluator.WatchFile(ctx, path, 0), ErrFeatureFlagFileAlreadyWatched)
+	})
+}
@@ -0,0 +1,199 @@
+package modular
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoCodeAlone/modular/feeders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFeatureFlagApp(t *testing.T) Application {
+	t.Helper()
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), nopLogger{})
+	app.RegisterConfigSection(FeatureFlagsSection, NewStdConfigProvider(&FeatureFlagsConfig{
+		Flags: map[string]bool{"new-ui": true, "beta": false},
+	}))
+
+	ts := NewStandardTenantService(slog.Default())
+	require.NoError(t, ts.RegisterTenant("tenant-a", map[string]ConfigProvider{
+		FeatureFlagsSection: NewStdConfigProvider(&FeatureFlagsConfig{
+			Flags: map[string]bool{"beta": true, "new-ui": false},
+		}),
+	}))
+	require.NoError(t, ts.RegisterTenant("tenant-b", nil))
+	require.NoError(t, app.RegisterService("tenantService", ts))
+	return app
+}
+
+func TestFileFeatureFlagEvaluator(t *testing.T) {
+	app := newFeatureFlagApp(t)
+	evaluator := NewFileFeatureFlagEvaluator(app)
+	ctx := context.Background()
+
+	t.Run("global flags", func(t *testing.T) {
+		assert.True(t, evaluator.Evaluate(ctx, "new-ui", false))
+		assert.False(t, evaluator.Evaluate(ctx, "beta", true))
+		assert.True(t, evaluator.Evaluate(ctx, "undefined", true), "undefined flags use the default")
+	})
+
+	t.Run("tenant overrides", func(t *testing.T) {
+		assert.True(t, evaluator.EvaluateForTenant(ctx, "tenant-a", "beta", false))
+		assert.False(t, evaluator.EvaluateForTenant(ctx, "tenant-a", "new-ui", true))
+		assert.True(t, evaluator.Evaluate(NewTenantContext(ctx, "tenant-a"), "beta", false),
+			"Evaluate uses the tenant of the context")
+	})
+
+	t.Run("tenant fallback", func(t *testing.T) {
+		assert.True(t, evaluator.EvaluateForTenant(ctx, "tenant-b", "new-ui", false),
+			"tenants without flags use the global value")
+		assert.False(t, evaluator.EvaluateForTenant(ctx, "unknown", "beta", true))
+		assert.True(t, evaluator.EvaluateForTenant(ctx, "tenant-a", "undefined", true))
+	})
+
+	t.Run("no config section", func(t *testing.T) {
+		evaluator := NewFileFeatureFlagEvaluator(NewStdApplication(NewStdConfigProvider(&struct{}{}), nopLogger{}))
+		assert.True(t, evaluator.Evaluate(ctx, "new-ui", true))
+		assert.False(t, evaluator.EvaluateForTenant(ctx, "tenant-a", "new-ui", false))
+	})
+}
+
+func TestIsFeatureEnabled(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no evaluator registered", func(t *testing.T) {
+		app := newFeatureFlagApp(t)
+		_, found := FindFeatureFlagEvaluator(app)
+		assert.False(t, found)
+		assert.True(t, IsFeatureEnabled(ctx, app, "beta", true))
+		assert.False(t, IsFeatureEnabled(ctx, app, "new-ui", false))
+	})
+
+	t.Run("evaluator discovered by interface", func(t *testing.T) {
+		app := newFeatureFlagApp(t)
+		require.NoError(t, app.RegisterService("flags", NewFileFeatureFlagEvaluator(app)))
+
+		evaluator, found := FindFeatureFlagEvaluator(app)
+		require.True(t, found)
+		assert.IsType(t, &FileFeatureFlagEvaluator{}, evaluator)
+		assert.True(t, IsFeatureEnabled(ctx, app, "new-ui", false))
+		assert.True(t, IsFeatureEnabled(NewTenantContext(ctx, "tenant-a"), app, "beta", false))
+	})
+
+	t.Run("nil application", func(t *testing.T) {
+		assert.True(t, IsFeatureEnabled(ctx, nil, "beta", true))
+	})
+}
+
+func userContext(userID string) context.Context {
+	return WithFeatureFlagAttributes(context.Background(), FeatureFlagAttributes{FeatureFlagUserIDAttribute: userID})
+}
+
+func TestFeatureFlagRule_PercentageRollout(t *testing.T) {
+	rule := &FeatureFlagRule{Percentage: 50}
+
+	const users = 10000
+	enabled := 0
+	for i := range users {
+		attrs := FeatureFlagAttributes{FeatureFlagUserIDAttribute: fmt.Sprintf("user-%d", i)}
+		value := rule.evaluate("checkout-v2", attrs)
+		if value {
+			enabled++
+		}
+		for range 3 {
+			require.Equal(t, value, rule.evaluate("checkout-v2", attrs), "the same ID always lands the same way")
+		}
+	}
+	assert.InDelta(t, users/2, enabled, users*0.03, "a 50%% rollout splits the IDs roughly evenly")
+
+	// Buckets only depend on the flag name and key, so they are stable across restarts
+	assert.Equal(t, uint32(7532), featureFlagBucket("checkout-v2", "user-42"))
+	assert.NotEqual(t, featureFlagBucket("checkout-v2", "user-42"), featureFlagBucket("search-v2", "user-42"),
+		"flags are bucketed independently")
+
+	t.Run("rollouts grow monotonically", func(t *testing.T) {
+		for i := range 1000 {
+			attrs := FeatureFlagAttributes{FeatureFlagUserIDAttribute: fmt.Sprintf("user-%d", i)}
+			if (&FeatureFlagRule{Percentage: 10}).evaluate("checkout-v2", attrs) {
+				assert.True(t, (&FeatureFlagRule{Percentage: 20}).evaluate("checkout-v2", attrs))
+			}
+		}
+	})
+
+	t.Run("bounds and missing key", func(t *testing.T) {
+		attrs := FeatureFlagAttributes{FeatureFlagUserIDAttribute: "user-1"}
+		assert.False(t, (&FeatureFlagRule{Percentage: 0}).evaluate("f", attrs))
+		assert.True(t, (&FeatureFlagRule{Percentage: 100}).evaluate("f", attrs))
+		assert.True(t, (&FeatureFlagRule{Percentage: 100}).evaluate("f", nil))
+		assert.False(t, (&FeatureFlagRule{Percentage: 99.99}).evaluate("f", nil), "subjects without a key are outside partial rollouts")
+		assert.False(t, (&FeatureFlagRule{Percentage: 99.99, BucketBy: "org"}).evaluate("f", attrs))
+	})
+}
+
+func TestFeatureFlagRule_Targeting(t *testing.T) {
+	disabled := false
+	rule := &FeatureFlagRule{
+		Targets: []FeatureFlagTarget{
+			{Attribute: "plan", Values: []string{"free"}, Enabled: &disabled},
+			{Attribute: "country", Values: []string{"CA", "US"}},
+		},
+	}
+
+	assert.True(t, rule.evaluate("f", FeatureFlagAttributes{"country": "CA"}))
+	assert.False(t, rule.evaluate("f", FeatureFlagAttributes{"country": "FR"}))
+	assert.False(t, rule.evaluate("f", FeatureFlagAttributes{"country": "US", "plan": "free"}), "targets are checked in order")
+	assert.False(t, rule.evaluate("f", nil))
+
+	rule.Percentage = 100
+	assert.True(t, rule.evaluate("f", FeatureFlagAttributes{"country": "FR"}), "non-targeted subjects fall through to the rollout")
+}
+
+func TestFileFeatureFlagEvaluator_RulesFromConfigFile(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte(`featureFlags:
+  flags:
+    new-ui: true
+  rules:
+    checkout-v2:
+      targets:
+        - attribute: plan
+          values: [enterprise]
+      percentage: 50
+    tenant-beta:
+      percentage: 50
+      bucketBy: tenantId
+`), 0o600))
+
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), nopLogger{}, feeders.NewYamlFeeder(yamlPath))
+	app.RegisterConfigSection(FeatureFlagsSection, NewStdConfigProvider(&FeatureFlagsConfig{}))
+	require.NoError(t, app.RegisterService("featureFlags", NewFileFeatureFlagEvaluator(app)))
+	require.NoError(t, app.Init())
+
+	assert.True(t, IsFeatureEnabled(context.Background(), app, "new-ui", false), "plain boolean flags keep working")
+
+	enterprise := WithFeatureFlagAttributes(userContext("user-1"), FeatureFlagAttributes{"plan": "enterprise"})
+	assert.True(t, IsFeatureEnabled(enterprise, app, "checkout-v2", false))
+
+	enabled := 0
+	for i := range 1000 {
+		ctx := userContext(fmt.Sprintf("user-%d", i))
+		value := IsFeatureEnabled(ctx, app, "checkout-v2", false)
+		if value {
+			enabled++
+		}
+		assert.Equal(t, value, IsFeatureEnabled(ctx, app, "checkout-v2", !value))
+	}
+	assert.InDelta(t, 500, enabled, 60)
+
+	assert.False(t, IsFeatureEnabled(context.Background(), app, "tenant-beta", true), "no tenant means no bucketing key")
+	for _, tenantID := range []TenantID{"tenant-a", "tenant-b", "tenant-c"} {
+		expected := featureFlagBucket("tenant-beta", string(tenantID)) < featureFlagBuckets/2
+		assert.Equal(t, expected, IsFeatureEnabled(NewTenantContext(context.Background(), tenantID), app, "tenant-beta", !expected),
+			"tenants are bucketed by their ID")
+	}
+}
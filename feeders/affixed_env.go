@@ -192,17 +192,18 @@ func (f *AffixedEnvFeeder) setFieldFromEnv(field reflect.Value, fieldType *refle
 	}
 
 	// Get and apply environment variable if exists
+	secret := IsSecretField(fieldType)
 	catalog := GetGlobalEnvCatalog()
 	envValue, exists := catalog.Get(envName)
 	if exists && envValue != "" {
 		if f.verboseDebug && f.logger != nil {
 			source := catalog.GetSource(envName)
-			f.logger.Debug("AffixedEnvFeeder: Environment variable found", "envName", envName, "envValue", envValue, "source", source)
+			f.logger.Debug("AffixedEnvFeeder: Environment variable found", "envName", envName, "envValue", displayValue(secret, envValue), "source", source)
 		}
 		err := setFieldValue(field, envValue)
 		if err != nil {
 			if f.verboseDebug && f.logger != nil {
-				f.logger.Debug("AffixedEnvFeeder: Failed to set field value", "envName", envName, "envValue", envValue, "error", err)
+				f.logger.Debug("AffixedEnvFeeder: Failed to set field value", "envName", envName, "envValue", displayValue(secret, envValue), "error", err)
 			}
 			return err
 		}
@@ -216,13 +217,13 @@ func (f *AffixedEnvFeeder) setFieldFromEnv(field reflect.Value, fieldType *refle
 			FeederType: "AffixedEnvFeeder",
 			SourceType: "env_affixed",
 			SourceKey:  envName,
-			Value:      convertedValue,
+			Value:      displayValue(secret, convertedValue),
 			SearchKeys: []string{envName},
 			FoundKey:   envName,
 		})
 
 		if f.verboseDebug && f.logger != nil {
-			f.logger.Debug("AffixedEnvFeeder: Successfully set field value", "envName", envName, "envValue", envValue)
+			f.logger.Debug("AffixedEnvFeeder: Successfully set field value", "envName", envName, "envValue", displayValue(secret, envValue))
 		}
 		return nil
 	} else if f.verboseDebug && f.logger != nil {
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"sort"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 	"gopkg.in/yaml.v3"
@@ -57,7 +58,7 @@ func (b *BaseConfigFeeder) Feed(structure interface{}) error {
 	}
 
 	// Load base configuration first
-	baseConfig, err := b.loadBaseConfig()
+	baseConfig, basePath, err := b.loadBaseConfig()
 	if err != nil {
 		if b.verboseDebug && b.logger != nil {
 			b.logger.Debug("BaseConfigFeeder: Failed to load base config", "error", err)
@@ -66,7 +67,7 @@ func (b *BaseConfigFeeder) Feed(structure interface{}) error {
 	}
 
 	// Load environment overrides
-	envConfig, err := b.loadEnvironmentConfig()
+	envConfig, envPath, err := b.loadEnvironmentConfig()
 	if err != nil {
 		if b.verboseDebug && b.logger != nil {
 			b.logger.Debug("BaseConfigFeeder: Failed to load environment config", "error", err)
@@ -85,6 +86,7 @@ func (b *BaseConfigFeeder) Feed(structure interface{}) error {
 		}
 		return fmt.Errorf("failed to apply merged config: %w", err)
 	}
+	b.recordPopulations(structure, mergedConfig, envConfig, basePath, envPath)
 
 	if b.verboseDebug && b.logger != nil {
 		b.logger.Debug("BaseConfigFeeder: Feed completed successfully")
@@ -102,7 +104,7 @@ func (b *BaseConfigFeeder) FeedKey(key string, target interface{}) error {
 	}
 
 	// Load base configuration for the specific key
-	baseConfig, err := b.loadBaseConfigForKey(key)
+	baseConfig, basePath, err := b.loadBaseConfigForKey(key)
 	if err != nil {
 		if b.verboseDebug && b.logger != nil {
 			b.logger.Debug("BaseConfigFeeder: Failed to load base config for key", "key", key, "error", err)
@@ -111,7 +113,7 @@ func (b *BaseConfigFeeder) FeedKey(key string, target interface{}) error {
 	}
 
 	// Load environment overrides for the specific key
-	envConfig, err := b.loadEnvironmentConfigForKey(key)
+	envConfig, envPath, err := b.loadEnvironmentConfigForKey(key)
 	if err != nil {
 		if b.verboseDebug && b.logger != nil {
 			b.logger.Debug("BaseConfigFeeder: Failed to load environment config for key", "key", key, "error", err)
@@ -130,6 +132,7 @@ func (b *BaseConfigFeeder) FeedKey(key string, target interface{}) error {
 		}
 		return fmt.Errorf("failed to apply merged config for key %s: %w", key, err)
 	}
+	b.recordPopulations(target, mergedConfig, envConfig, basePath, envPath)
 
 	if b.verboseDebug && b.logger != nil {
 		b.logger.Debug("BaseConfigFeeder: FeedKey completed successfully", "key", key)
@@ -138,35 +141,37 @@ func (b *BaseConfigFeeder) FeedKey(key string, target interface{}) error {
 	return nil
 }
 
-// loadBaseConfig loads the base configuration file
-func (b *BaseConfigFeeder) loadBaseConfig() (map[string]interface{}, error) {
+// loadBaseConfig loads the base configuration file, returning its path
+func (b *BaseConfigFeeder) loadBaseConfig() (map[string]interface{}, string, error) {
 	baseConfigPath := b.findConfigFile(filepath.Join(b.BaseDir, "base"), "default")
 	if baseConfigPath == "" {
 		if b.verboseDebug && b.logger != nil {
 			b.logger.Debug("BaseConfigFeeder: No base config file found", "baseDir", filepath.Join(b.BaseDir, "base"))
 		}
-		return make(map[string]interface{}), nil // Return empty config if no base file exists
+		return make(map[string]interface{}), "", nil // Return empty config if no base file exists
 	}
 
-	return b.loadConfigFile(baseConfigPath)
+	config, err := b.loadConfigFile(baseConfigPath)
+	return config, baseConfigPath, err
 }
 
-// loadEnvironmentConfig loads the environment-specific overrides
-func (b *BaseConfigFeeder) loadEnvironmentConfig() (map[string]interface{}, error) {
+// loadEnvironmentConfig loads the environment-specific overrides, returning their path
+func (b *BaseConfigFeeder) loadEnvironmentConfig() (map[string]interface{}, string, error) {
 	envConfigPath := b.findConfigFile(filepath.Join(b.BaseDir, "environments", b.Environment), "overrides")
 	if envConfigPath == "" {
 		if b.verboseDebug && b.logger != nil {
 			b.logger.Debug("BaseConfigFeeder: No environment config file found",
 				"envDir", filepath.Join(b.BaseDir, "environments", b.Environment))
 		}
-		return make(map[string]interface{}), nil // Return empty config if no env file exists
+		return make(map[string]interface{}), "", nil // Return empty config if no env file exists
 	}
 
-	return b.loadConfigFile(envConfigPath)
+	config, err := b.loadConfigFile(envConfigPath)
+	return config, envConfigPath, err
 }
 
 // loadBaseConfigForKey loads base config for a specific key (used for tenant configs)
-func (b *BaseConfigFeeder) loadBaseConfigForKey(key string) (map[string]interface{}, error) {
+func (b *BaseConfigFeeder) loadBaseConfigForKey(key string) (map[string]interface{}, string, error) {
 	baseConfigPath := b.findConfigFile(filepath.Join(b.BaseDir, "base", "tenants"), key)
 	if baseConfigPath == "" {
 		if b.verboseDebug && b.logger != nil {
@@ -174,14 +179,15 @@ func (b *BaseConfigFeeder) loadBaseConfigForKey(key string) (map[string]interfac
 				"key", key,
 				"baseDir", filepath.Join(b.BaseDir, "base", "tenants"))
 		}
-		return make(map[string]interface{}), nil
+		return make(map[string]interface{}), "", nil
 	}
 
-	return b.loadConfigFile(baseConfigPath)
+	config, err := b.loadConfigFile(baseConfigPath)
+	return config, baseConfigPath, err
 }
 
 // loadEnvironmentConfigForKey loads environment config for a specific key (used for tenant configs)
-func (b *BaseConfigFeeder) loadEnvironmentConfigForKey(key string) (map[string]interface{}, error) {
+func (b *BaseConfigFeeder) loadEnvironmentConfigForKey(key string) (map[string]interface{}, string, error) {
 	envConfigPath := b.findConfigFile(filepath.Join(b.BaseDir, "environments", b.Environment, "tenants"), key)
 	if envConfigPath == "" {
 		if b.verboseDebug && b.logger != nil {
@@ -189,10 +195,11 @@ func (b *BaseConfigFeeder) loadEnvironmentConfigForKey(key string) (map[string]i
 				"key", key,
 				"envDir", filepath.Join(b.BaseDir, "environments", b.Environment, "tenants"))
 		}
-		return make(map[string]interface{}), nil
+		return make(map[string]interface{}), "", nil
 	}
 
-	return b.loadConfigFile(envConfigPath)
+	config, err := b.loadConfigFile(envConfigPath)
+	return config, envConfigPath, err
 }
 
 // findConfigFile searches for a config file with the given name and supported extensions.
@@ -319,6 +326,117 @@ func (b *BaseConfigFeeder) applyConfigToStruct(config map[string]interface{}, ta
 	return nil
 }
 
+// recordPopulations records the fields of target set from the merged config,
+// attributing each value to the environment file if it overrode the base
+// file. Secret field values are redacted.
+func (b *BaseConfigFeeder) recordPopulations(target interface{}, merged, override map[string]interface{}, basePath, envPath string) {
+	if !b.ft.Has() {
+		return
+	}
+	t := reflect.TypeOf(target)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return
+	}
+	b.recordStructPopulations(t, "", merged, override, basePath, envPath)
+}
+
+// recordStructPopulations records the fields of struct type t found in merged
+func (b *BaseConfigFeeder) recordStructPopulations(t reflect.Type, prefix string, merged, override map[string]interface{}, basePath, envPath string) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			// yaml.v3 matches untagged fields by their lowercased name
+			name = strings.ToLower(field.Name)
+		}
+		value, exists := merged[name]
+		if !exists {
+			continue
+		}
+		overrideValue, overridden := override[name]
+
+		fieldPath := field.Name
+		if prefix != "" {
+			fieldPath = prefix + "." + field.Name
+		}
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		nested, isMap := value.(map[string]interface{})
+		overrideNested, _ := overrideValue.(map[string]interface{})
+		switch {
+		case isMap && fieldType.Kind() == reflect.Struct:
+			b.recordStructPopulations(fieldType, fieldPath, nested, overrideNested, basePath, envPath)
+			continue
+		case isMap && fieldType.Kind() == reflect.Map && !IsSecretField(&field):
+			b.recordMapPopulations(fieldType, fieldPath, nested, overrideNested, basePath, envPath)
+			continue
+		}
+
+		source := basePath
+		if overridden {
+			source = envPath
+		}
+		b.ft.Record(FieldPopulation{
+			FieldPath:  fieldPath,
+			FieldName:  field.Name,
+			FieldType:  field.Type.String(),
+			FeederType: "*feeders.BaseConfigFeeder",
+			SourceType: "base_config",
+			SourceKey:  name,
+			SourceFile: source,
+			Value:      displayValue(IsSecretField(&field), value),
+			SearchKeys: []string{name},
+			FoundKey:   name,
+		})
+	}
+}
+
+// recordMapPopulations records the entries of a map field of type t, each of
+// which may come from a different file
+func (b *BaseConfigFeeder) recordMapPopulations(t reflect.Type, prefix string, merged, override map[string]interface{}, basePath, envPath string) {
+	elemType := t.Elem()
+	for elemType.Kind() == reflect.Pointer {
+		elemType = elemType.Elem()
+	}
+	for key, value := range merged {
+		entryPath := prefix + "." + key
+		overrideValue, overridden := override[key]
+		if nested, isMap := value.(map[string]interface{}); isMap && elemType.Kind() == reflect.Struct {
+			overrideNested, _ := overrideValue.(map[string]interface{})
+			b.recordStructPopulations(elemType, entryPath, nested, overrideNested, basePath, envPath)
+			continue
+		}
+
+		source := basePath
+		if overridden {
+			source = envPath
+		}
+		b.ft.Record(FieldPopulation{
+			FieldPath:  entryPath,
+			FieldName:  key,
+			FieldType:  t.Elem().String(),
+			FeederType: "*feeders.BaseConfigFeeder",
+			SourceType: "base_config",
+			SourceKey:  key,
+			SourceFile: source,
+			Value:      value,
+			SearchKeys: []string{key},
+			FoundKey:   key,
+		})
+	}
+}
+
 // IsBaseConfigStructure checks if the given directory has the expected base config structure
 func IsBaseConfigStructure(configDir string) bool {
 	// Check for base/ directory
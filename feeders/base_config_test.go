@@ -172,6 +172,63 @@ database:
 	assert.Equal(t, 3306, config.Database.Port)
 }
 
+func TestBaseConfigFeeder_FieldTracking(t *testing.T) {
+	tempDir := setupTestConfigStructure(t)
+	defer os.RemoveAll(tempDir)
+
+	basePath := filepath.Join(tempDir, "base", "default.yaml")
+	prodPath := filepath.Join(tempDir, "environments", "prod", "overrides.yaml")
+	require.NoError(t, os.WriteFile(basePath, []byte(`
+app_name: "MyApp"
+database:
+  host: "localhost"
+  port: 5432
+features:
+  logging: true
+  metrics: false
+`), 0644))
+	require.NoError(t, os.WriteFile(prodPath, []byte(`
+database:
+  host: "prod-db.example.com"
+features:
+  metrics: true
+`), 0644))
+
+	type secretDatabase struct {
+		Host string `yaml:"host"`
+		Port int    `yaml:"port" secret:"true"`
+	}
+	var config struct {
+		AppName  string          `yaml:"app_name"`
+		Database secretDatabase  `yaml:"database"`
+		Features map[string]bool `yaml:"features"`
+	}
+
+	feeder := NewBaseConfigFeeder(tempDir, "prod")
+	tracker := NewDefaultFieldTracker()
+	feeder.SetFieldTracker(tracker)
+	require.NoError(t, feeder.Feed(&config))
+
+	populations := make(map[string]FieldPopulation)
+	for _, fp := range tracker.GetFieldPopulations() {
+		assert.Equal(t, "*feeders.BaseConfigFeeder", fp.FeederType)
+		populations[fp.FieldPath] = fp
+	}
+	require.Len(t, populations, 5)
+
+	// Each field is attributed to the file its final value came from
+	assert.Equal(t, basePath, populations["AppName"].SourceFile)
+	assert.Equal(t, "MyApp", populations["AppName"].Value)
+	assert.Equal(t, prodPath, populations["Database.Host"].SourceFile)
+	assert.Equal(t, "prod-db.example.com", populations["Database.Host"].Value)
+	assert.Equal(t, basePath, populations["Features.logging"].SourceFile)
+	assert.Equal(t, prodPath, populations["Features.metrics"].SourceFile)
+
+	// Secret values are redacted
+	assert.Equal(t, basePath, populations["Database.Port"].SourceFile)
+	assert.Equal(t, RedactedValue, populations["Database.Port"].Value)
+}
+
 func TestBaseConfigFeeder_FeedKey_TenantConfigs(t *testing.T) {
 	// Create temporary directory structure
 	tempDir := setupTestConfigStructure(t)
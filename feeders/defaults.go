@@ -0,0 +1,167 @@
+package feeders
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// defaultTag is the struct tag holding a field's default value
+const defaultTag = "default"
+
+// DefaultsFeeder populates a structure from its `default:"..."` struct tags,
+// without reading files or the environment. Only zero-valued fields are set,
+// so it can be used standalone to build a baseline config or as the first
+// feeder in a chain. Scalars and durations are parsed like environment
+// variables; slices and maps take JSON-encoded defaults, e.g.
+// `default:"{\"logging\":true}"`.
+type DefaultsFeeder struct {
+	verboseDebug bool
+	logger       interface {
+		Debug(msg string, args ...any)
+	}
+	ft       FieldTrackerHolder
+	priority int
+}
+
+// NewDefaultsFeeder creates a new DefaultsFeeder
+func NewDefaultsFeeder() *DefaultsFeeder {
+	return &DefaultsFeeder{}
+}
+
+// WithPriority sets the priority for this feeder and returns the feeder for chaining.
+// Higher priority values mean the feeder will be applied later, allowing it to override
+// values from lower priority feeders.
+func (f *DefaultsFeeder) WithPriority(priority int) *DefaultsFeeder {
+	f.priority = priority
+	return f
+}
+
+// Priority returns the priority value for this feeder.
+func (f *DefaultsFeeder) Priority() int {
+	return f.priority
+}
+
+// SetVerboseDebug enables or disables verbose debug logging
+func (f *DefaultsFeeder) SetVerboseDebug(enabled bool, logger interface{ Debug(msg string, args ...any) }) {
+	f.verboseDebug = enabled
+	f.logger = logger
+	if enabled && logger != nil {
+		f.logger.Debug("Verbose defaults feeder debugging enabled")
+	}
+}
+
+// SetFieldTracker sets the field tracker for recording field populations
+func (f *DefaultsFeeder) SetFieldTracker(tracker FieldTracker) {
+	f.ft.Set(tracker)
+}
+
+// Feed applies default values to the zero-valued fields of structure, which
+// must be a pointer to a struct. Nested structs and non-nil struct pointers
+// are processed recursively.
+func (f *DefaultsFeeder) Feed(structure interface{}) error {
+	rv := reflect.ValueOf(structure)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w, got %T", ErrDefaultsInvalidStructure, structure)
+	}
+
+	if f.verboseDebug && f.logger != nil {
+		f.logger.Debug("DefaultsFeeder: Starting feed process", "structureType", reflect.TypeOf(structure))
+	}
+	return f.processStruct(rv.Elem(), "")
+}
+
+// processStruct applies defaults to the fields of a struct value
+func (f *DefaultsFeeder) processStruct(rv reflect.Value, parentPath string) error {
+	structType := rv.Type()
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Field(i)
+		fieldType := structType.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+
+		fieldPath := fieldType.Name
+		if parentPath != "" {
+			fieldPath = parentPath + "." + fieldType.Name
+		}
+
+		switch {
+		case field.Kind() == reflect.Struct:
+			if err := f.processStruct(field, fieldPath); err != nil {
+				return err
+			}
+			continue
+		case field.Kind() == reflect.Pointer && field.Type().Elem().Kind() == reflect.Struct:
+			// Nil struct pointers are left alone rather than allocated
+			if !field.IsNil() {
+				if err := f.processStruct(field.Elem(), fieldPath); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		defaultValue, hasDefault := fieldType.Tag.Lookup(defaultTag)
+		if !hasDefault || !isDefaultable(field) {
+			continue
+		}
+
+		if err := SetDefault(field, defaultValue); err != nil {
+			return fmt.Errorf("%w for field %s: %w", ErrDefaultsInvalidValue, fieldPath, err)
+		}
+
+		if f.verboseDebug && f.logger != nil {
+			f.logger.Debug("DefaultsFeeder: Applied default value", "fieldPath", fieldPath, "default", defaultValue)
+		}
+		f.ft.Record(FieldPopulation{
+			FieldPath:  fieldPath,
+			FieldName:  fieldType.Name,
+			FieldType:  field.Type().String(),
+			FeederType: "*feeders.DefaultsFeeder",
+			SourceType: "default",
+			SourceKey:  defaultTag,
+			Value:      field.Interface(),
+			SearchKeys: []string{defaultTag},
+			FoundKey:   defaultTag,
+		})
+	}
+	return nil
+}
+
+// isDefaultable reports whether a field is still unset and may take its default.
+// Empty slices, maps and strings count as unset.
+func isDefaultable(field reflect.Value) bool {
+	switch field.Kind() { //nolint:exhaustive // other kinds use their zero value
+	case reflect.Slice, reflect.Map, reflect.String:
+		return field.Len() == 0
+	default:
+		return field.IsZero()
+	}
+}
+
+// SetDefault parses a `default` tag value into field. Scalars and durations
+// are parsed like environment variables, slices, maps and arrays are decoded
+// from JSON, and pointers are allocated to hold the parsed value. It is shared
+// with modular.ProcessConfigDefaults so both read defaults the same way.
+func SetDefault(field reflect.Value, value string) error {
+	switch field.Kind() { //nolint:exhaustive // scalars are handled by setFieldValue
+	case reflect.Slice, reflect.Map, reflect.Array:
+		// Collections take JSON-encoded defaults
+		ptr := reflect.New(field.Type())
+		if err := json.Unmarshal([]byte(value), ptr.Interface()); err != nil {
+			return fmt.Errorf("cannot decode JSON default into %v: %w", field.Type(), err)
+		}
+		field.Set(ptr.Elem())
+		return nil
+	case reflect.Pointer:
+		ptr := reflect.New(field.Type().Elem())
+		if err := SetDefault(ptr.Elem(), value); err != nil {
+			return err
+		}
+		field.Set(ptr)
+		return nil
+	default:
+		return setFieldValue(field, value)
+	}
+}
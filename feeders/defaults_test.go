@@ -0,0 +1,114 @@
+package feeders
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type defaultsTestConfig struct {
+	Name     string          `default:"baseline"`
+	Port     int             `default:"8080"`
+	Ratio    float64         `default:"0.5"`
+	Enabled  bool            `default:"true"`
+	Timeout  time.Duration   `default:"30s"`
+	Features map[string]bool `default:"{\"logging\":true,\"metrics\":false}"`
+	Labels   map[string]string
+	Hosts    []string `default:"[\"a.local\",\"b.local\"]"`
+	Codes    []int    `default:"[200, 204]"`
+	Retries  *int     `default:"3"`
+	Database struct {
+		Driver string `default:"sqlite"`
+	}
+	Cache *struct {
+		TTL time.Duration `default:"1m"`
+	}
+}
+
+func TestDefaultsFeeder_AppliesDefaults(t *testing.T) {
+	var config defaultsTestConfig
+	require.NoError(t, NewDefaultsFeeder().Feed(&config))
+
+	t.Run("scalars", func(t *testing.T) {
+		assert.Equal(t, "baseline", config.Name)
+		assert.Equal(t, 8080, config.Port)
+		assert.InDelta(t, 0.5, config.Ratio, 0)
+		assert.True(t, config.Enabled)
+		require.NotNil(t, config.Retries)
+		assert.Equal(t, 3, *config.Retries)
+		assert.Equal(t, "sqlite", config.Database.Driver)
+	})
+	t.Run("duration", func(t *testing.T) {
+		assert.Equal(t, 30*time.Second, config.Timeout)
+	})
+	t.Run("map", func(t *testing.T) {
+		assert.Equal(t, map[string]bool{"logging": true, "metrics": false}, config.Features)
+		assert.Nil(t, config.Labels, "fields without a default are left alone")
+	})
+	t.Run("slice", func(t *testing.T) {
+		assert.Equal(t, []string{"a.local", "b.local"}, config.Hosts)
+		assert.Equal(t, []int{200, 204}, config.Codes)
+	})
+	t.Run("nil struct pointer", func(t *testing.T) {
+		assert.Nil(t, config.Cache)
+	})
+}
+
+func TestDefaultsFeeder_KeepsExistingValues(t *testing.T) {
+	config := defaultsTestConfig{Name: "custom", Hosts: []string{"c.local"}}
+	config.Cache = &struct {
+		TTL time.Duration `default:"1m"`
+	}{}
+	require.NoError(t, NewDefaultsFeeder().Feed(&config))
+
+	assert.Equal(t, "custom", config.Name)
+	assert.Equal(t, []string{"c.local"}, config.Hosts)
+	assert.Equal(t, time.Minute, config.Cache.TTL, "non-nil struct pointers are processed")
+}
+
+func TestDefaultsFeeder_FirstInChain(t *testing.T) {
+	ResetGlobalEnvCatalog()
+	t.Cleanup(ResetGlobalEnvCatalog)
+	t.Setenv("DEFAULTS_TEST_PORT", "9090")
+
+	type chainConfig struct {
+		Host string `env:"DEFAULTS_TEST_HOST" default:"localhost"`
+		Port int    `env:"DEFAULTS_TEST_PORT" default:"8080"`
+	}
+
+	tracker := NewDefaultFieldTracker()
+	defaults := NewDefaultsFeeder()
+	defaults.SetFieldTracker(tracker)
+
+	var config chainConfig
+	require.NoError(t, defaults.Feed(&config))
+	require.NoError(t, NewEnvFeeder().Feed(&config))
+
+	assert.Equal(t, "localhost", config.Host)
+	assert.Equal(t, 9090, config.Port, "later feeders override defaults")
+
+	populations := tracker.GetFieldPopulations()
+	require.Len(t, populations, 2)
+	assert.Equal(t, "*feeders.DefaultsFeeder", populations[0].FeederType)
+	assert.Equal(t, "default", populations[0].SourceType)
+}
+
+func TestDefaultsFeeder_Errors(t *testing.T) {
+	var notStruct string
+	require.ErrorIs(t, NewDefaultsFeeder().Feed(&notStruct), ErrDefaultsInvalidStructure)
+	require.ErrorIs(t, NewDefaultsFeeder().Feed(defaultsTestConfig{}), ErrDefaultsInvalidStructure)
+
+	var badMap struct {
+		Features map[string]bool `default:"logging=true"`
+	}
+	require.ErrorIs(t, NewDefaultsFeeder().Feed(&badMap), ErrDefaultsInvalidValue)
+
+	var badDuration struct {
+		Timeout time.Duration `default:"soon"`
+	}
+	err := NewDefaultsFeeder().Feed(&badDuration)
+	require.ErrorIs(t, err, ErrDefaultsInvalidValue)
+	assert.Contains(t, err.Error(), "Timeout")
+}
@@ -158,8 +158,10 @@ func (f *DotEnvFeeder) parseEnvLine(line string, lineNum int) error {
 		}
 	}
 
+	// Values are only logged once they are assigned to a field, where secret
+	// fields can be redacted
 	if f.verboseDebug && f.logger != nil {
-		f.logger.Debug("DotEnvFeeder: Parsed variable", "key", key, "value", value, "lineNum", lineNum)
+		f.logger.Debug("DotEnvFeeder: Parsed variable", "key", key, "lineNum", lineNum)
 	}
 
 	// Store the variable in memory (do NOT set in environment)
@@ -225,7 +227,7 @@ func (f *DotEnvFeeder) processStructFieldsFromCatalog(rv reflect.Value, prefix s
 
 		if f.verboseDebug && f.logger != nil {
 			source := catalog.GetSource(envKey)
-			f.logger.Debug("DotEnvFeeder: Setting field from catalog", "envKey", envKey, "value", value, "fieldPath", fieldPath, "source", source)
+			f.logger.Debug("DotEnvFeeder: Setting field from catalog", "envKey", envKey, "value", displayValue(IsSecretField(&fieldType), value), "fieldPath", fieldPath, "source", source)
 		}
 
 		// Set the field value
@@ -240,14 +242,15 @@ func (f *DotEnvFeeder) processStructFieldsFromCatalog(rv reflect.Value, prefix s
 
 // setFieldValue sets a field value from .env data with type conversion
 func (f *DotEnvFeeder) setFieldValue(field reflect.Value, fieldType reflect.StructField, value, fieldPath, envKey string) error {
+	secret := IsSecretField(&fieldType)
 	if f.verboseDebug && f.logger != nil {
-		f.logger.Debug("DotEnvFeeder: Setting field", "fieldPath", fieldPath, "envKey", envKey, "value", value, "fieldType", field.Type())
+		f.logger.Debug("DotEnvFeeder: Setting field", "fieldPath", fieldPath, "envKey", envKey, "value", displayValue(secret, value), "fieldType", field.Type())
 	}
 
 	// Convert the string value to the appropriate type
 	convertedValue, err := f.convertStringToType(value, field.Type())
 	if err != nil {
-		return fmt.Errorf("failed to convert value '%s' for field %s: %w", value, fieldPath, err)
+		return fmt.Errorf("failed to convert value '%v' for field %s: %w", displayValue(secret, value), fieldPath, err)
 	}
 
 	// Set the field value
@@ -261,7 +264,7 @@ func (f *DotEnvFeeder) setFieldValue(field reflect.Value, fieldType reflect.Stru
 		FeederType: "DotEnvFeeder",
 		SourceType: "dot_env_file",
 		SourceKey:  envKey,
-		Value:      convertedValue,
+		Value:      displayValue(secret, convertedValue),
 		SearchKeys: []string{envKey},
 		FoundKey:   envKey,
 	})
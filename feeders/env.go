@@ -14,6 +14,8 @@ type EnvFeeder struct {
 	}
 	ft       FieldTrackerHolder
 	priority int
+	// envPrefix scopes the feeder to variables starting with it (e.g., "MYAPP_")
+	envPrefix string
 }
 
 // NewEnvFeeder creates a new EnvFeeder that reads from environment variables
@@ -25,6 +27,21 @@ func NewEnvFeeder() *EnvFeeder {
 	}
 }
 
+// NewEnvFeederWithPrefix creates a new EnvFeeder that only reads environment
+// variables starting with prefix. The prefix is stripped before matching field
+// env tags, so with prefix "MYAPP" the tag `env:"HOST"` reads MYAPP_HOST and
+// never HOST. Like env tags, the prefix is case-insensitive and uppercased; a
+// trailing underscore is added if missing. An empty prefix behaves like NewEnvFeeder.
+func NewEnvFeederWithPrefix(prefix string) *EnvFeeder {
+	f := NewEnvFeeder()
+	prefix = strings.ToUpper(strings.TrimSpace(prefix))
+	if prefix != "" && !strings.HasSuffix(prefix, "_") {
+		prefix += "_"
+	}
+	f.envPrefix = prefix
+	return f
+}
+
 // WithPriority sets the priority for this feeder and returns the feeder for chaining.
 // Higher priority values mean the feeder will be applied later, allowing it to override
 // values from lower priority feeders.
@@ -163,7 +180,7 @@ func (f *EnvFeeder) processFieldWithModule(field reflect.Value, fieldType *refle
 				if f.verboseDebug && f.logger != nil {
 					f.logger.Debug("EnvFeeder: Found env tag for pointer field", "fieldName", fieldType.Name, "envTag", envTag, "fieldPath", fieldPath)
 				}
-				return f.setPointerFieldFromEnvWithModule(field, envTag, prefix, fieldType.Name, fieldPath, moduleName)
+				return f.setPointerFieldFromEnvWithModule(field, envTag, prefix, fieldType.Name, fieldPath, moduleName, IsSecretField(fieldType))
 			} else if f.verboseDebug && f.logger != nil {
 				f.logger.Debug("EnvFeeder: No env tag found for pointer field", "fieldName", fieldType.Name, "fieldPath", fieldPath)
 			}
@@ -177,7 +194,7 @@ func (f *EnvFeeder) processFieldWithModule(field reflect.Value, fieldType *refle
 			if f.verboseDebug && f.logger != nil {
 				f.logger.Debug("EnvFeeder: Found env tag", "fieldName", fieldType.Name, "envTag", envTag, "fieldPath", fieldPath)
 			}
-			return f.setFieldFromEnvWithModule(field, envTag, prefix, fieldType.Name, fieldPath, moduleName)
+			return f.setFieldFromEnvWithModule(field, envTag, prefix, fieldType.Name, fieldPath, moduleName, IsSecretField(fieldType))
 		} else if f.verboseDebug && f.logger != nil {
 			f.logger.Debug("EnvFeeder: No env tag found", "fieldName", fieldType.Name, "fieldPath", fieldPath)
 		}
@@ -187,7 +204,7 @@ func (f *EnvFeeder) processFieldWithModule(field reflect.Value, fieldType *refle
 }
 
 // setFieldFromEnvWithModule sets a field value from an environment variable with module-aware searching
-func (f *EnvFeeder) setFieldFromEnvWithModule(field reflect.Value, envTag, prefix, fieldName, fieldPath, moduleName string) error {
+func (f *EnvFeeder) setFieldFromEnvWithModule(field reflect.Value, envTag, prefix, fieldName, fieldPath, moduleName string, secret bool) error {
 	// Build environment variable name with prefix
 	envName := strings.ToUpper(envTag)
 	if prefix != "" {
@@ -218,13 +235,13 @@ func (f *EnvFeeder) setFieldFromEnvWithModule(field reflect.Value, envTag, prefi
 	if exists && envValue != "" {
 		if f.verboseDebug && f.logger != nil {
 			source := catalog.GetSource(foundKey)
-			f.logger.Debug("EnvFeeder: Environment variable found", "fieldName", fieldName, "foundKey", foundKey, "envValue", envValue, "fieldPath", fieldPath, "source", source)
+			f.logger.Debug("EnvFeeder: Environment variable found", "fieldName", fieldName, "foundKey", foundKey, "envValue", displayValue(secret, envValue), "fieldPath", fieldPath, "source", source)
 		}
 
 		err := setFieldValue(field, envValue)
 		if err != nil {
 			if f.verboseDebug && f.logger != nil {
-				f.logger.Debug("EnvFeeder: Failed to set field value", "fieldName", fieldName, "foundKey", foundKey, "envValue", envValue, "error", err, "fieldPath", fieldPath)
+				f.logger.Debug("EnvFeeder: Failed to set field value", "fieldName", fieldName, "foundKey", foundKey, "envValue", displayValue(secret, envValue), "error", err, "fieldPath", fieldPath)
 			}
 			return err
 		}
@@ -237,14 +254,14 @@ func (f *EnvFeeder) setFieldFromEnvWithModule(field reflect.Value, envTag, prefi
 			FeederType:  "*feeders.EnvFeeder",
 			SourceType:  "env",
 			SourceKey:   foundKey,
-			Value:       field.Interface(),
+			Value:       displayValue(secret, field.Interface()),
 			InstanceKey: "",
 			SearchKeys:  searchKeys,
 			FoundKey:    foundKey,
 		})
 
 		if f.verboseDebug && f.logger != nil {
-			f.logger.Debug("EnvFeeder: Successfully set field value", "fieldName", fieldName, "foundKey", foundKey, "envValue", envValue, "fieldPath", fieldPath)
+			f.logger.Debug("EnvFeeder: Successfully set field value", "fieldName", fieldName, "foundKey", foundKey, "envValue", displayValue(secret, envValue), "fieldPath", fieldPath)
 		}
 	} else {
 		// Record that we searched but didn't find
@@ -270,7 +287,8 @@ func (f *EnvFeeder) setFieldFromEnvWithModule(field reflect.Value, envTag, prefi
 }
 
 // buildSearchKeys creates a list of environment variable names to search in priority order
-// Implements the search pattern: MODULE_ENV_VAR, ENV_VAR_MODULE, ENV_VAR
+// Implements the search pattern: MODULE_ENV_VAR, ENV_VAR_MODULE, ENV_VAR, each
+// preceded by the feeder's prefix if it has one
 func (f *EnvFeeder) buildSearchKeys(envName, moduleName string) []string {
 	var searchKeys []string
 
@@ -288,11 +306,18 @@ func (f *EnvFeeder) buildSearchKeys(envName, moduleName string) []string {
 	// 3. ENV_VAR (original behavior)
 	searchKeys = append(searchKeys, envName)
 
+	// Scope every key to the feeder's prefix
+	if f.envPrefix != "" {
+		for i, key := range searchKeys {
+			searchKeys[i] = f.envPrefix + key
+		}
+	}
+
 	return searchKeys
 }
 
 // setPointerFieldFromEnvWithModule sets a pointer field value from an environment variable with module awareness
-func (f *EnvFeeder) setPointerFieldFromEnvWithModule(field reflect.Value, envTag, prefix, fieldName, fieldPath, moduleName string) error {
+func (f *EnvFeeder) setPointerFieldFromEnvWithModule(field reflect.Value, envTag, prefix, fieldName, fieldPath, moduleName string, secret bool) error {
 	// Build environment variable name with prefix
 	envName := strings.ToUpper(envTag)
 	if prefix != "" {
@@ -323,7 +348,7 @@ func (f *EnvFeeder) setPointerFieldFromEnvWithModule(field reflect.Value, envTag
 	if exists && envValue != "" {
 		if f.verboseDebug && f.logger != nil {
 			source := catalog.GetSource(foundKey)
-			f.logger.Debug("EnvFeeder: Environment variable found for pointer field", "fieldName", fieldName, "foundKey", foundKey, "envValue", envValue, "fieldPath", fieldPath, "source", source)
+			f.logger.Debug("EnvFeeder: Environment variable found for pointer field", "fieldName", fieldName, "foundKey", foundKey, "envValue", displayValue(secret, envValue), "fieldPath", fieldPath, "source", source)
 		}
 
 		// Get the type that the pointer points to
@@ -336,7 +361,7 @@ func (f *EnvFeeder) setPointerFieldFromEnvWithModule(field reflect.Value, envTag
 		err := setFieldValue(newValue.Elem(), envValue)
 		if err != nil {
 			if f.verboseDebug && f.logger != nil {
-				f.logger.Debug("EnvFeeder: Failed to set pointer field value", "fieldName", fieldName, "foundKey", foundKey, "envValue", envValue, "error", err, "fieldPath", fieldPath)
+				f.logger.Debug("EnvFeeder: Failed to set pointer field value", "fieldName", fieldName, "foundKey", foundKey, "envValue", displayValue(secret, envValue), "error", err, "fieldPath", fieldPath)
 			}
 			return err
 		}
@@ -352,7 +377,7 @@ func (f *EnvFeeder) setPointerFieldFromEnvWithModule(field reflect.Value, envTag
 			FeederType:  "*feeders.EnvFeeder",
 			SourceType:  "env",
 			SourceKey:   foundKey,
-			Value:       field.Interface(),
+			Value:       displayValue(secret, field.Interface()),
 			InstanceKey: "",
 			SearchKeys:  searchKeys,
 			FoundKey:    foundKey,
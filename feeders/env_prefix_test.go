@@ -0,0 +1,79 @@
+package feeders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type prefixedEnvConfig struct {
+	Host  string `env:"HOST"`
+	Port  int    `env:"PORT"`
+	Debug bool   `env:"DEBUG"`
+}
+
+func TestEnvFeederWithPrefix_ScopedMatching(t *testing.T) {
+	for _, prefix := range []string{"MYAPP", "MYAPP_", "myapp", " myapp_ "} {
+		t.Run(prefix, func(t *testing.T) {
+			ResetGlobalEnvCatalog()
+			t.Cleanup(ResetGlobalEnvCatalog)
+			t.Setenv("MYAPP_HOST", "scoped-host")
+			t.Setenv("MYAPP_PORT", "8080")
+
+			var config prefixedEnvConfig
+			require.NoError(t, NewEnvFeederWithPrefix(prefix).Feed(&config))
+			assert.Equal(t, "scoped-host", config.Host)
+			assert.Equal(t, 8080, config.Port)
+		})
+	}
+}
+
+func TestEnvFeederWithPrefix_IgnoresUnprefixedVariables(t *testing.T) {
+	ResetGlobalEnvCatalog()
+	t.Cleanup(ResetGlobalEnvCatalog)
+	t.Setenv("HOST", "unrelated-host")
+	t.Setenv("PORT", "1234")
+	t.Setenv("DEBUG", "true")
+	t.Setenv("OTHERAPP_HOST", "other-host")
+	t.Setenv("MYAPP_PORT", "8080")
+
+	tracker := NewDefaultFieldTracker()
+	feeder := NewEnvFeederWithPrefix("MYAPP")
+	feeder.SetFieldTracker(tracker)
+
+	var config prefixedEnvConfig
+	require.NoError(t, feeder.Feed(&config))
+	assert.Empty(t, config.Host, "unprefixed and differently prefixed variables are not consumed")
+	assert.Equal(t, 8080, config.Port)
+	assert.False(t, config.Debug)
+
+	for _, fp := range tracker.GetFieldPopulations() {
+		for _, key := range fp.SearchKeys {
+			assert.Regexp(t, `^MYAPP_`, key)
+		}
+	}
+}
+
+func TestEnvFeederWithPrefix_ModuleContext(t *testing.T) {
+	ResetGlobalEnvCatalog()
+	t.Cleanup(ResetGlobalEnvCatalog)
+	t.Setenv("DATABASE_HOST", "unscoped-db")
+	t.Setenv("MYAPP_DATABASE_HOST", "scoped-db")
+	t.Setenv("MYAPP_PORT_DATABASE", "5432")
+
+	var config prefixedEnvConfig
+	require.NoError(t, NewEnvFeederWithPrefix("MYAPP_").FeedWithModuleContext(&config, "database"))
+	assert.Equal(t, "scoped-db", config.Host)
+	assert.Equal(t, 5432, config.Port)
+}
+
+func TestEnvFeederWithPrefix_EmptyPrefix(t *testing.T) {
+	ResetGlobalEnvCatalog()
+	t.Cleanup(ResetGlobalEnvCatalog)
+	t.Setenv("HOST", "plain-host")
+
+	var config prefixedEnvConfig
+	require.NoError(t, NewEnvFeederWithPrefix("").Feed(&config))
+	assert.Equal(t, "plain-host", config.Host)
+}
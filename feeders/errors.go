@@ -46,6 +46,12 @@ var (
 	ErrYamlExpectedMapForSlice  = errors.New("expected map for slice element")
 )
 
+// Defaults feeder errors
+var (
+	ErrDefaultsInvalidStructure = errors.New("defaults: expected pointer to struct")
+	ErrDefaultsInvalidValue     = errors.New("defaults: invalid default value")
+)
+
 // General feeder errors
 var (
 	ErrJsonFeederUnavailable = errors.New("json feeder unavailable")
@@ -0,0 +1,89 @@
+package feeders
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type feedKeySectionConfig struct {
+	Host    string `yaml:"host" json:"host" toml:"host"`
+	Port    int    `yaml:"port" json:"port" toml:"port"`
+	Timeout string `yaml:"timeout" json:"timeout" toml:"timeout"`
+}
+
+// trackingKeyFeeder is a file feeder that can feed a single key with field tracking
+type trackingKeyFeeder interface {
+	FeedKey(key string, target interface{}) error
+	SetFieldTracker(tracker FieldTracker)
+}
+
+func TestFeedKey_FieldTracking(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name       string
+		file       string
+		content    string
+		feederType string
+		newFeeder  func(path string) trackingKeyFeeder
+	}{
+		{
+			name:       "yaml",
+			file:       "config.yaml",
+			content:    "database:\n  host: db.local\n  port: 5432\n",
+			feederType: "*feeders.YamlFeeder",
+			newFeeder: func(path string) trackingKeyFeeder {
+				return NewYamlFeeder(path)
+			},
+		},
+		{
+			name:       "json",
+			file:       "config.json",
+			content:    `{"database": {"host": "db.local", "port": 5432}}`,
+			feederType: "*feeders.JSONFeeder",
+			newFeeder: func(path string) trackingKeyFeeder {
+				return NewJSONFeeder(path)
+			},
+		},
+		{
+			name:       "toml",
+			file:       "config.toml",
+			content:    "[database]\nhost = \"db.local\"\nport = 5432\n",
+			feederType: "*feeders.TomlFeeder",
+			newFeeder: func(path string) trackingKeyFeeder {
+				return NewTomlFeeder(path)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.file)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			feeder := tt.newFeeder(path)
+			tracker := NewDefaultFieldTracker()
+			feeder.SetFieldTracker(tracker)
+
+			var config feedKeySectionConfig
+			require.NoError(t, feeder.FeedKey("database", &config))
+			assert.Equal(t, "db.local", config.Host)
+			assert.Equal(t, 5432, config.Port)
+
+			populations := tracker.GetFieldPopulations()
+			found := make(map[string]FieldPopulation)
+			for _, fp := range populations {
+				found[fp.FieldPath] = fp
+			}
+			require.Len(t, found, 2, "only fields present under the key are recorded")
+			assert.Equal(t, tt.feederType, found["Host"].FeederType)
+			assert.Equal(t, "db.local", found["Host"].Value)
+			assert.Equal(t, "host", found["Host"].FoundKey)
+			assert.Equal(t, "database.port", found["Port"].SourceKey)
+			assert.NotContains(t, found, "Timeout")
+		})
+	}
+}
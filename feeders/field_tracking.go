@@ -1,6 +1,11 @@
 package feeders
 
-import "sync"
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
 
 // FieldPopulation represents a single field population event
 type FieldPopulation struct {
@@ -10,6 +15,7 @@ type FieldPopulation struct {
 	FeederType  string      // Type of feeder that populated it
 	SourceType  string      // Type of source (env, yaml, etc.)
 	SourceKey   string      // Source key that was used (e.g., "DB_PRIMARY_DSN")
+	SourceFile  string      // File the value was read from, when the feeder reads several files
 	Value       interface{} // Value that was set
 	InstanceKey string      // Instance key for instance-aware fields
 	SearchKeys  []string    // All keys that were searched for this field
@@ -82,3 +88,59 @@ func (h *FieldTrackerHolder) Has() bool {
 	h.mu.RUnlock()
 	return ok
 }
+
+// recordKeyPopulations records the top-level fields of target that a FeedKey
+// call set from data, the value found under key. Fields are matched by their
+// sourceType struct tag, falling back to the field name.
+func recordKeyPopulations(ft *FieldTrackerHolder, feederType, sourceType, key string, target, data interface{}) {
+	if data == nil || !ft.Has() {
+		return
+	}
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return
+	}
+
+	var values map[string]interface{}
+	switch m := data.(type) {
+	case map[string]interface{}:
+		values = m
+	case map[interface{}]interface{}:
+		values = make(map[string]interface{}, len(m))
+		for k, v := range m {
+			values[fmt.Sprint(k)] = v
+		}
+	default:
+		return
+	}
+
+	structType := rv.Elem().Type()
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get(sourceType), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		value, exists := values[name]
+		if !exists {
+			continue
+		}
+		ft.Record(FieldPopulation{
+			FieldPath:  field.Name,
+			FieldName:  field.Name,
+			FieldType:  field.Type.String(),
+			FeederType: feederType,
+			SourceType: sourceType,
+			SourceKey:  key + "." + name,
+			Value:      value,
+			SearchKeys: []string{name},
+			FoundKey:   name,
+		})
+	}
+}
@@ -301,7 +301,7 @@ func (f *InstanceAwareEnvFeeder) processFieldWithPrefix(field reflect.Value, fie
 			if f.verboseDebug && f.logger != nil {
 				f.logger.Debug("InstanceAwareEnvFeeder: Found env tag", "fieldName", fieldType.Name, "envTag", envTag, "prefix", prefix, "fieldPath", fieldPath, "instanceKey", instanceKey)
 			}
-			return f.setFieldFromEnvWithPrefix(field, envTag, prefix, fieldType.Name, fieldPath, instanceKey)
+			return f.setFieldFromEnvWithPrefix(field, envTag, prefix, fieldType.Name, fieldPath, instanceKey, IsSecretField(fieldType))
 		} else if f.verboseDebug && f.logger != nil {
 			f.logger.Debug("InstanceAwareEnvFeeder: No env tag found", "fieldName", fieldType.Name, "prefix", prefix, "fieldPath", fieldPath, "instanceKey", instanceKey)
 		}
@@ -311,7 +311,7 @@ func (f *InstanceAwareEnvFeeder) processFieldWithPrefix(field reflect.Value, fie
 }
 
 // setFieldFromEnvWithPrefix sets a field value from an environment variable with prefix and field tracking
-func (f *InstanceAwareEnvFeeder) setFieldFromEnvWithPrefix(field reflect.Value, envTag, prefix, fieldName, fieldPath, instanceKey string) error {
+func (f *InstanceAwareEnvFeeder) setFieldFromEnvWithPrefix(field reflect.Value, envTag, prefix, fieldName, fieldPath, instanceKey string, secret bool) error {
 	// Build environment variable name with prefix
 	envName := strings.ToUpper(envTag)
 	if prefix != "" {
@@ -331,13 +331,13 @@ func (f *InstanceAwareEnvFeeder) setFieldFromEnvWithPrefix(field reflect.Value,
 	if exists && envValue != "" {
 		if f.verboseDebug && f.logger != nil {
 			source := catalog.GetSource(envName)
-			f.logger.Debug("InstanceAwareEnvFeeder: Environment variable found", "envName", envName, "envValue", envValue, "fieldPath", fieldPath, "instanceKey", instanceKey, "source", source)
+			f.logger.Debug("InstanceAwareEnvFeeder: Environment variable found", "envName", envName, "envValue", displayValue(secret, envValue), "fieldPath", fieldPath, "instanceKey", instanceKey, "source", source)
 		}
 
 		err := setFieldValue(field, envValue)
 		if err != nil {
 			if f.verboseDebug && f.logger != nil {
-				f.logger.Debug("InstanceAwareEnvFeeder: Failed to set field value", "envName", envName, "envValue", envValue, "error", err, "fieldPath", fieldPath, "instanceKey", instanceKey)
+				f.logger.Debug("InstanceAwareEnvFeeder: Failed to set field value", "envName", envName, "envValue", displayValue(secret, envValue), "error", err, "fieldPath", fieldPath, "instanceKey", instanceKey)
 			}
 			return err
 		}
@@ -350,14 +350,14 @@ func (f *InstanceAwareEnvFeeder) setFieldFromEnvWithPrefix(field reflect.Value,
 			FeederType:  "*feeders.InstanceAwareEnvFeeder",
 			SourceType:  "env",
 			SourceKey:   envName,
-			Value:       field.Interface(),
+			Value:       displayValue(secret, field.Interface()),
 			InstanceKey: instanceKey,
 			SearchKeys:  searchKeys,
 			FoundKey:    envName,
 		})
 
 		if f.verboseDebug && f.logger != nil {
-			f.logger.Debug("InstanceAwareEnvFeeder: Successfully set field value", "envName", envName, "envValue", envValue, "fieldPath", fieldPath, "instanceKey", instanceKey)
+			f.logger.Debug("InstanceAwareEnvFeeder: Successfully set field value", "envName", envName, "envValue", displayValue(secret, envValue), "fieldPath", fieldPath, "instanceKey", instanceKey)
 		}
 	} else {
 		// Record that we searched but didn't find
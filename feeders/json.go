@@ -22,32 +22,32 @@ func feedKey(
 	marshalFunc func(interface{}) ([]byte, error),
 	unmarshalFunc func([]byte, interface{}) error,
 	fileType string,
-) error {
+) (interface{}, error) {
 	// Create a temporary map to hold all data
 	var allData map[string]interface{}
 
 	// Use the feeder to read the file
 	if err := feeder.Feed(&allData); err != nil {
-		return fmt.Errorf("failed to read %s: %w", fileType, err)
+		return nil, fmt.Errorf("failed to read %s: %w", fileType, err)
 	}
 
 	// Look for the specific key
 	value, exists := allData[key]
 	if !exists {
-		return nil
+		return nil, nil
 	}
 
 	// Remarshal and unmarshal to handle type conversions
 	valueBytes, err := marshalFunc(value)
 	if err != nil {
-		return fmt.Errorf("failed to marshal %s data: %w", fileType, err)
+		return nil, fmt.Errorf("failed to marshal %s data: %w", fileType, err)
 	}
 
 	if err = unmarshalFunc(valueBytes, target); err != nil {
-		return fmt.Errorf("failed to unmarshal %s data: %w", fileType, err)
+		return nil, fmt.Errorf("failed to unmarshal %s data: %w", fileType, err)
 	}
 
-	return nil
+	return value, nil
 }
 
 // JSONFeeder is a feeder that reads JSON files with optional verbose debug logging
@@ -121,7 +121,10 @@ func (j *JSONFeeder) FeedKey(key string, target interface{}) error {
 		j.logger.Debug("JSONFeeder: Starting FeedKey process", "filePath", j.Path, "key", key, "targetType", reflect.TypeOf(target))
 	}
 
-	err := feedKey(j, key, target, json.Marshal, json.Unmarshal, "JSON file")
+	value, err := feedKey(j, key, target, json.Marshal, json.Unmarshal, "JSON file")
+	if err == nil {
+		recordKeyPopulations(&j.ft, "*feeders.JSONFeeder", "json", key, target, value)
+	}
 
 	if j.verboseDebug && j.logger != nil {
 		if err != nil {
@@ -222,7 +225,7 @@ func (j *JSONFeeder) processField(field reflect.Value, fieldType reflect.StructF
 	switch fieldKind {
 	case reflect.Pointer:
 		// Handle pointer types
-		return j.setPointerFromJSON(field, value, fieldPath)
+		return j.setPointerFromJSON(field, value, fieldPath, IsSecretField(&fieldType))
 
 	case reflect.Struct:
 		// Handle nested structs
@@ -252,22 +255,22 @@ func (j *JSONFeeder) processField(field reflect.Value, fieldType reflect.StructF
 		reflect.Chan, reflect.Func, reflect.Interface, reflect.String,
 		reflect.UnsafePointer:
 		// Handle basic types and unsupported types
-		return j.setFieldFromJSON(field, value, fieldPath)
+		return j.setFieldFromJSON(field, value, fieldPath, IsSecretField(&fieldType))
 
 	default:
 		// Handle any remaining types
-		return j.setFieldFromJSON(field, value, fieldPath)
+		return j.setFieldFromJSON(field, value, fieldPath, IsSecretField(&fieldType))
 	}
 }
 
 // setFieldFromJSON sets a field value from JSON data with type conversion
-func (j *JSONFeeder) setFieldFromJSON(field reflect.Value, value interface{}, fieldPath string) error {
+func (j *JSONFeeder) setFieldFromJSON(field reflect.Value, value interface{}, fieldPath string, secret bool) error {
 	// Special handling for time.Duration
 	if field.Type() == reflect.TypeOf(time.Duration(0)) {
 		if str, ok := value.(string); ok {
 			duration, err := time.ParseDuration(str)
 			if err != nil {
-				return fmt.Errorf("cannot convert string '%s' to time.Duration for field %s: %w", str, fieldPath, err)
+				return fmt.Errorf("cannot convert string '%v' to time.Duration for field %s: %w", displayValue(secret, str), fieldPath, err)
 			}
 			field.Set(reflect.ValueOf(duration))
 
@@ -279,13 +282,13 @@ func (j *JSONFeeder) setFieldFromJSON(field reflect.Value, value interface{}, fi
 				FeederType: "JSONFeeder",
 				SourceType: "json_file",
 				SourceKey:  fieldPath,
-				Value:      duration,
+				Value:      displayValue(secret, duration),
 				SearchKeys: []string{fieldPath},
 				FoundKey:   fieldPath,
 			})
 			return nil
 		}
-		return wrapJSONConvertError(value, field.Type().String(), fieldPath)
+		return wrapJSONConvertError(displayValue(secret, value), field.Type().String(), fieldPath)
 	}
 
 	// Convert and set the value
@@ -301,7 +304,7 @@ func (j *JSONFeeder) setFieldFromJSON(field reflect.Value, value interface{}, fi
 			FeederType: "JSONFeeder",
 			SourceType: "json_file",
 			SourceKey:  fieldPath,
-			Value:      value,
+			Value:      displayValue(secret, value),
 			SearchKeys: []string{fieldPath},
 			FoundKey:   fieldPath,
 		})
@@ -309,11 +312,11 @@ func (j *JSONFeeder) setFieldFromJSON(field reflect.Value, value interface{}, fi
 		return nil
 	}
 
-	return wrapJSONConvertError(value, field.Type().String(), fieldPath)
+	return wrapJSONConvertError(displayValue(secret, value), field.Type().String(), fieldPath)
 }
 
 // setPointerFromJSON handles setting pointer fields from JSON data
-func (j *JSONFeeder) setPointerFromJSON(field reflect.Value, value interface{}, fieldPath string) error {
+func (j *JSONFeeder) setPointerFromJSON(field reflect.Value, value interface{}, fieldPath string, secret bool) error {
 	if value == nil {
 		// Set nil pointer
 		field.Set(reflect.Zero(field.Type()))
@@ -342,12 +345,12 @@ func (j *JSONFeeder) setPointerFromJSON(field reflect.Value, value interface{},
 		if str, ok := value.(string); ok {
 			duration, err := time.ParseDuration(str)
 			if err != nil {
-				return fmt.Errorf("cannot convert string '%s' to time.Duration for field %s: %w", str, fieldPath, err)
+				return fmt.Errorf("cannot convert string '%v' to time.Duration for field %s: %w", displayValue(secret, str), fieldPath, err)
 			}
 			ptrValue.Elem().Set(reflect.ValueOf(duration))
 			field.Set(ptrValue)
 		} else {
-			return wrapJSONConvertError(value, field.Type().String(), fieldPath)
+			return wrapJSONConvertError(displayValue(secret, value), field.Type().String(), fieldPath)
 		}
 	} else {
 		// Handle different element types
@@ -360,7 +363,7 @@ func (j *JSONFeeder) setPointerFromJSON(field reflect.Value, value interface{},
 				}
 				field.Set(ptrValue)
 			} else {
-				return wrapJSONConvertError(value, field.Type().String(), fieldPath)
+				return wrapJSONConvertError(displayValue(secret, value), field.Type().String(), fieldPath)
 			}
 		default:
 			// Handle pointer to basic type
@@ -369,7 +372,7 @@ func (j *JSONFeeder) setPointerFromJSON(field reflect.Value, value interface{},
 				ptrValue.Elem().Set(convertedValue.Convert(elemType))
 				field.Set(ptrValue)
 			} else {
-				return wrapJSONConvertError(value, field.Type().String(), fieldPath)
+				return wrapJSONConvertError(displayValue(secret, value), field.Type().String(), fieldPath)
 			}
 		}
 	}
@@ -382,7 +385,7 @@ func (j *JSONFeeder) setPointerFromJSON(field reflect.Value, value interface{},
 		FeederType: "JSONFeeder",
 		SourceType: "json_file",
 		SourceKey:  fieldPath,
-		Value:      value,
+		Value:      displayValue(secret, value),
 		SearchKeys: []string{fieldPath},
 		FoundKey:   fieldPath,
 	})
@@ -0,0 +1,22 @@
+package feeders
+
+import "reflect"
+
+// RedactedValue is shown in place of the value of a secret field in verbose
+// debug output and recorded field populations.
+const RedactedValue = "[REDACTED]"
+
+// IsSecretField reports whether a struct field is tagged secret:"true".
+// Feeders still populate secret fields, but never write their values to
+// debug output or report them to field trackers.
+func IsSecretField(field *reflect.StructField) bool {
+	return field != nil && field.Tag.Get("secret") == "true"
+}
+
+// displayValue returns value, or RedactedValue if it belongs to a secret field
+func displayValue(secret bool, value any) any {
+	if secret {
+		return RedactedValue
+	}
+	return value
+}
@@ -0,0 +1,107 @@
+package feeders
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// secretCaptureLogger records debug messages with their arguments
+type secretCaptureLogger struct {
+	lines []string
+}
+
+func (l *secretCaptureLogger) Debug(msg string, args ...any) {
+	l.lines = append(l.lines, fmt.Sprint(append([]any{msg}, args...)...))
+}
+
+type secretFeederTarget interface {
+	Feed(structure interface{}) error
+	SetVerboseDebug(enabled bool, logger interface{ Debug(msg string, args ...any) })
+	SetFieldTracker(tracker FieldTracker)
+}
+
+// TestFeeders_RedactSecretFields verifies that no feeder logs or tracks the
+// value of a field tagged secret:"true"
+func TestFeeders_RedactSecretFields(t *testing.T) {
+	type Config struct {
+		Host     string `yaml:"host" json:"host" toml:"host" env:"SECRET_FEEDER_HOST"`
+		Password string `yaml:"password" json:"password" toml:"password" env:"SECRET_FEEDER_PASSWORD" secret:"true"`
+	}
+
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		name   string
+		feeder func(t *testing.T) secretFeederTarget
+	}{
+		{"yaml", func(*testing.T) secretFeederTarget {
+			return NewYamlFeeder(writeFile("config.yaml", "host: db.internal\npassword: hunter2\n"))
+		}},
+		{"json", func(*testing.T) secretFeederTarget {
+			return NewJSONFeeder(writeFile("config.json", `{"host": "db.internal", "password": "hunter2"}`))
+		}},
+		{"toml", func(*testing.T) secretFeederTarget {
+			return NewTomlFeeder(writeFile("config.toml", "host = \"db.internal\"\npassword = \"hunter2\"\n"))
+		}},
+		{"env", func(t *testing.T) secretFeederTarget {
+			t.Setenv("SECRET_FEEDER_HOST", "db.internal")
+			t.Setenv("SECRET_FEEDER_PASSWORD", "hunter2")
+			return NewEnvFeeder()
+		}},
+		{"dotenv", func(*testing.T) secretFeederTarget {
+			return NewDotEnvFeeder(writeFile("config.env", "SECRET_FEEDER_HOST=db.internal\nSECRET_FEEDER_PASSWORD=hunter2\n"))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ResetGlobalEnvCatalog()
+			t.Cleanup(ResetGlobalEnvCatalog)
+
+			logger := &secretCaptureLogger{}
+			tracker := NewDefaultFieldTracker()
+			feeder := tt.feeder(t)
+			feeder.SetVerboseDebug(true, logger)
+			feeder.SetFieldTracker(tracker)
+
+			var config Config
+			if err := feeder.Feed(&config); err != nil {
+				t.Fatalf("Feed failed: %v", err)
+			}
+			if config.Password != "hunter2" || config.Host != "db.internal" {
+				t.Fatalf("unexpected config: %+v", config)
+			}
+
+			output := strings.Join(logger.lines, "\n")
+			if strings.Contains(output, "hunter2") {
+				t.Errorf("secret value was logged:\n%s", output)
+			}
+
+			tracked := false
+			for _, fp := range tracker.GetFieldPopulations() {
+				if fp.FieldName == "Password" {
+					tracked = true
+					if fp.Value != RedactedValue {
+						t.Errorf("expected tracked Password value to be redacted, got %v", fp.Value)
+					}
+				}
+				if fp.FieldName == "Host" && fp.Value != "db.internal" {
+					t.Errorf("expected tracked Host value db.internal, got %v", fp.Value)
+				}
+			}
+			if !tracked {
+				t.Error("expected the Password field population to be tracked")
+			}
+		})
+	}
+}
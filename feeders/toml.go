@@ -86,7 +86,10 @@ func (t *TomlFeeder) FeedKey(key string, target interface{}) error {
 		t.logger.Debug("TomlFeeder: Starting FeedKey process", "filePath", t.Path, "key", key, "targetType", reflect.TypeOf(target))
 	}
 
-	err := feedKey(t, key, target, toml.Marshal, toml.Unmarshal, "TOML file")
+	value, err := feedKey(t, key, target, toml.Marshal, toml.Unmarshal, "TOML file")
+	if err == nil {
+		recordKeyPopulations(&t.ft, "*feeders.TomlFeeder", "toml", key, target, value)
+	}
 
 	if t.verboseDebug && t.logger != nil {
 		if err != nil {
@@ -182,7 +185,7 @@ func (t *TomlFeeder) processField(field reflect.Value, fieldType reflect.StructF
 	switch fieldKind {
 	case reflect.Pointer:
 		// Handle pointer types
-		return t.setPointerFromTOML(field, value, fieldPath)
+		return t.setPointerFromTOML(field, value, fieldPath, IsSecretField(&fieldType))
 
 	case reflect.Struct:
 		// Handle nested structs
@@ -212,16 +215,16 @@ func (t *TomlFeeder) processField(field reflect.Value, fieldType reflect.StructF
 		reflect.Chan, reflect.Func, reflect.Interface, reflect.String,
 		reflect.UnsafePointer:
 		// Handle basic types and unsupported types
-		return t.setFieldFromTOML(field, value, fieldPath)
+		return t.setFieldFromTOML(field, value, fieldPath, IsSecretField(&fieldType))
 
 	default:
 		// Handle any remaining types
-		return t.setFieldFromTOML(field, value, fieldPath)
+		return t.setFieldFromTOML(field, value, fieldPath, IsSecretField(&fieldType))
 	}
 }
 
 // setPointerFromTOML handles setting pointer fields from TOML data
-func (t *TomlFeeder) setPointerFromTOML(field reflect.Value, value interface{}, fieldPath string) error {
+func (t *TomlFeeder) setPointerFromTOML(field reflect.Value, value interface{}, fieldPath string, secret bool) error {
 	if value == nil {
 		// Set nil pointer
 		field.Set(reflect.Zero(field.Type()))
@@ -250,12 +253,12 @@ func (t *TomlFeeder) setPointerFromTOML(field reflect.Value, value interface{},
 		if str, ok := value.(string); ok {
 			duration, err := time.ParseDuration(str)
 			if err != nil {
-				return fmt.Errorf("cannot convert string '%s' to time.Duration for field %s: %w", str, fieldPath, err)
+				return fmt.Errorf("cannot convert string '%v' to time.Duration for field %s: %w", displayValue(secret, str), fieldPath, err)
 			}
 			ptrValue.Elem().Set(reflect.ValueOf(duration))
 			field.Set(ptrValue)
 		} else {
-			return wrapTomlConvertError(value, field.Type().String(), fieldPath)
+			return wrapTomlConvertError(displayValue(secret, value), field.Type().String(), fieldPath)
 		}
 	} else {
 		// Handle different element types
@@ -268,7 +271,7 @@ func (t *TomlFeeder) setPointerFromTOML(field reflect.Value, value interface{},
 				}
 				field.Set(ptrValue)
 			} else {
-				return wrapTomlConvertError(value, field.Type().String(), fieldPath)
+				return wrapTomlConvertError(displayValue(secret, value), field.Type().String(), fieldPath)
 			}
 		default:
 			// Handle pointer to basic type
@@ -277,7 +280,7 @@ func (t *TomlFeeder) setPointerFromTOML(field reflect.Value, value interface{},
 				ptrValue.Elem().Set(convertedValue.Convert(elemType))
 				field.Set(ptrValue)
 			} else {
-				return wrapTomlConvertError(value, field.Type().String(), fieldPath)
+				return wrapTomlConvertError(displayValue(secret, value), field.Type().String(), fieldPath)
 			}
 		}
 	}
@@ -290,7 +293,7 @@ func (t *TomlFeeder) setPointerFromTOML(field reflect.Value, value interface{},
 		FeederType: "TomlFeeder",
 		SourceType: "toml_file",
 		SourceKey:  fieldPath,
-		Value:      value,
+		Value:      displayValue(secret, value),
 		SearchKeys: []string{fieldPath},
 		FoundKey:   fieldPath,
 	})
@@ -340,13 +343,13 @@ func (t *TomlFeeder) setArrayFromTOML(field reflect.Value, value interface{}, fi
 }
 
 // setFieldFromTOML sets a field value from TOML data with type conversion
-func (t *TomlFeeder) setFieldFromTOML(field reflect.Value, value interface{}, fieldPath string) error {
+func (t *TomlFeeder) setFieldFromTOML(field reflect.Value, value interface{}, fieldPath string, secret bool) error {
 	// Special handling for time.Duration
 	if field.Type() == reflect.TypeOf(time.Duration(0)) {
 		if str, ok := value.(string); ok {
 			duration, err := time.ParseDuration(str)
 			if err != nil {
-				return fmt.Errorf("cannot convert string '%s' to time.Duration for field %s: %w", str, fieldPath, err)
+				return fmt.Errorf("cannot convert string '%v' to time.Duration for field %s: %w", displayValue(secret, str), fieldPath, err)
 			}
 			field.Set(reflect.ValueOf(duration))
 
@@ -358,13 +361,13 @@ func (t *TomlFeeder) setFieldFromTOML(field reflect.Value, value interface{}, fi
 				FeederType: "TomlFeeder",
 				SourceType: "toml_file",
 				SourceKey:  fieldPath,
-				Value:      duration,
+				Value:      displayValue(secret, duration),
 				SearchKeys: []string{fieldPath},
 				FoundKey:   fieldPath,
 			})
 			return nil
 		}
-		return wrapTomlConvertError(value, field.Type().String(), fieldPath)
+		return wrapTomlConvertError(displayValue(secret, value), field.Type().String(), fieldPath)
 	}
 
 	// Convert and set the value
@@ -380,7 +383,7 @@ func (t *TomlFeeder) setFieldFromTOML(field reflect.Value, value interface{}, fi
 			FeederType: "TomlFeeder",
 			SourceType: "toml_file",
 			SourceKey:  fieldPath,
-			Value:      value,
+			Value:      displayValue(secret, value),
 			SearchKeys: []string{fieldPath},
 			FoundKey:   fieldPath,
 		})
@@ -388,7 +391,7 @@ func (t *TomlFeeder) setFieldFromTOML(field reflect.Value, value interface{}, fi
 		return nil
 	}
 
-	return wrapTomlConvertError(value, field.Type().String(), fieldPath)
+	return wrapTomlConvertError(displayValue(secret, value), field.Type().String(), fieldPath)
 }
 
 // setSliceFromTOML sets a slice field from TOML array data
@@ -176,6 +176,8 @@ func (y *YamlFeeder) FeedKey(key string, target interface{}) error {
 		return fmt.Errorf("failed to unmarshal value to target: %w", err)
 	}
 
+	recordKeyPopulations(&y.ft, "*feeders.YamlFeeder", "yaml", key, target, value)
+
 	y.debugLog("YamlFeeder: FeedKey completed successfully", "filePath", y.Path, "key", key)
 	return nil
 }
@@ -248,7 +250,7 @@ func (y *YamlFeeder) processField(field reflect.Value, fieldType *reflect.Struct
 	case reflect.Pointer:
 		// Handle pointer types
 		if hasYAMLTag {
-			return y.setPointerFromYAML(field, fieldName, data, fieldType.Name, fieldPath)
+			return y.setPointerFromYAML(field, fieldName, data, fieldType.Name, fieldPath, IsSecretField(fieldType))
 		}
 	case reflect.Slice:
 		// Handle slice types
@@ -300,14 +302,14 @@ func (y *YamlFeeder) processField(field reflect.Value, fieldType *reflect.Struct
 		// Check for yaml tag for primitive types and other non-struct types
 		if hasYAMLTag {
 			y.debugLog("YamlFeeder: Found yaml tag", "fieldName", fieldType.Name, "parsedFieldName", fieldName, "fieldPath", fieldPath)
-			return y.setFieldFromYaml(field, fieldName, data, fieldType.Name, fieldPath)
+			return y.setFieldFromYaml(field, fieldName, data, fieldType.Name, fieldPath, IsSecretField(fieldType))
 		}
 		y.debugLog("YamlFeeder: No yaml tag found", "fieldName", fieldType.Name, "fieldPath", fieldPath)
 	default:
 		// Check for yaml tag for primitive types and other non-struct types
 		if hasYAMLTag {
 			y.debugLog("YamlFeeder: Found yaml tag", "fieldName", fieldType.Name, "parsedFieldName", fieldName, "fieldPath", fieldPath)
-			return y.setFieldFromYaml(field, fieldName, data, fieldType.Name, fieldPath)
+			return y.setFieldFromYaml(field, fieldName, data, fieldType.Name, fieldPath, IsSecretField(fieldType))
 		}
 		y.debugLog("YamlFeeder: No yaml tag found", "fieldName", fieldType.Name, "fieldPath", fieldPath)
 	}
@@ -316,7 +318,7 @@ func (y *YamlFeeder) processField(field reflect.Value, fieldType *reflect.Struct
 }
 
 // setPointerFromYAML handles setting pointer fields from YAML data
-func (y *YamlFeeder) setPointerFromYAML(field reflect.Value, yamlTag string, data map[string]interface{}, fieldName, fieldPath string) error {
+func (y *YamlFeeder) setPointerFromYAML(field reflect.Value, yamlTag string, data map[string]interface{}, fieldName, fieldPath string, secret bool) error {
 	// Find the value in YAML data
 	foundValue, exists := data[yamlTag]
 
@@ -389,7 +391,7 @@ func (y *YamlFeeder) setPointerFromYAML(field reflect.Value, yamlTag string, dat
 		FeederType:  "*feeders.YamlFeeder",
 		SourceType:  "yaml",
 		SourceKey:   yamlTag,
-		Value:       foundValue,
+		Value:       displayValue(secret, foundValue),
 		InstanceKey: "",
 		SearchKeys:  []string{yamlTag},
 		FoundKey:    yamlTag,
@@ -555,7 +557,7 @@ func (y *YamlFeeder) setArrayFromYAML(field reflect.Value, yamlTag string, data
 }
 
 // setFieldFromYaml sets a field value from YAML data with field tracking
-func (y *YamlFeeder) setFieldFromYaml(field reflect.Value, yamlTag string, data map[string]interface{}, fieldName, fieldPath string) error {
+func (y *YamlFeeder) setFieldFromYaml(field reflect.Value, yamlTag string, data map[string]interface{}, fieldName, fieldPath string, secret bool) error {
 	// Find the value in YAML data
 	searchKeys := []string{yamlTag}
 	var foundValue interface{}
@@ -564,14 +566,14 @@ func (y *YamlFeeder) setFieldFromYaml(field reflect.Value, yamlTag string, data
 	if value, exists := data[yamlTag]; exists {
 		foundValue = value
 		foundKey = yamlTag
-		y.debugLog("YamlFeeder: Found YAML value", "fieldName", fieldName, "yamlKey", yamlTag, "value", value, "fieldPath", fieldPath)
+		y.debugLog("YamlFeeder: Found YAML value", "fieldName", fieldName, "yamlKey", yamlTag, "value", displayValue(secret, value), "fieldPath", fieldPath)
 	}
 
 	if foundValue != nil {
 		// Set the field value
 		err := y.setFieldValue(field, foundValue)
 		if err != nil {
-			y.debugLog("YamlFeeder: Failed to set field value", "fieldName", fieldName, "yamlKey", yamlTag, "value", foundValue, "error", err, "fieldPath", fieldPath)
+			y.debugLog("YamlFeeder: Failed to set field value", "fieldName", fieldName, "yamlKey", yamlTag, "value", displayValue(secret, foundValue), "error", err, "fieldPath", fieldPath)
 			return err
 		}
 
@@ -583,13 +585,13 @@ func (y *YamlFeeder) setFieldFromYaml(field reflect.Value, yamlTag string, data
 			FeederType:  "*feeders.YamlFeeder",
 			SourceType:  "yaml",
 			SourceKey:   foundKey,
-			Value:       field.Interface(),
+			Value:       displayValue(secret, field.Interface()),
 			InstanceKey: "",
 			SearchKeys:  searchKeys,
 			FoundKey:    foundKey,
 		})
 
-		y.debugLog("YamlFeeder: Successfully set field value", "fieldName", fieldName, "yamlKey", yamlTag, "value", foundValue, "fieldPath", fieldPath)
+		y.debugLog("YamlFeeder: Successfully set field value", "fieldName", fieldName, "yamlKey", yamlTag, "value", displayValue(secret, foundValue), "fieldPath", fieldPath)
 	} else {
 		// Record that we searched but didn't find
 		y.ft.Record(FieldPopulation{
@@ -28,6 +28,7 @@ func (b *FieldTrackerBridge) RecordFieldPopulation(fp feeders.FieldPopulation) {
 		FeederType:  fp.FeederType,
 		SourceType:  fp.SourceType,
 		SourceKey:   fp.SourceKey,
+		SourceFile:  fp.SourceFile,
 		Value:       fp.Value,
 		InstanceKey: fp.InstanceKey,
 		SearchKeys:  fp.SearchKeys,
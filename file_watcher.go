@@ -0,0 +1,149 @@
+package modular
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// defaultReloadDebounce is the debounce of a fileWatcher when none is configured.
+const defaultReloadDebounce = 500 * time.Millisecond
+
+// fileWatcher calls onChange once a set of files has stopped changing for a
+// debounce interval, so that editors writing a file in several steps trigger
+// a single call. The parent directories are watched rather than the files
+// themselves, so files replaced by a rename (as many editors do) keep being
+// watched. It backs both config file reloading and feature flag files.
+type fileWatcher struct {
+	kind     string // What the files hold, for errors and logs, e.g. "config"
+	files    map[string]struct{}
+	debounce time.Duration
+	logger   Logger
+	onChange func(ctx context.Context)
+	watcher  *fsnotify.Watcher
+
+	timerMu sync.Mutex
+	timer   *time.Timer
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// newFileWatcher creates a watcher for files. A debounce that is not positive
+// defaults to defaultReloadDebounce.
+func newFileWatcher(kind string, files []string, debounce time.Duration, logger Logger, onChange func(ctx context.Context)) *fileWatcher {
+	if debounce <= 0 {
+		debounce = defaultReloadDebounce
+	}
+	watched := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		watched[filepath.Clean(file)] = struct{}{}
+	}
+	return &fileWatcher{
+		kind:     kind,
+		files:    watched,
+		debounce: debounce,
+		logger:   logger,
+		onChange: onChange,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// start begins watching the files until ctx is done or stop is called. It
+// does nothing if there are no files to watch.
+func (w *fileWatcher) start(ctx context.Context) error {
+	if len(w.files) == 0 {
+		return nil
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("creating %s file watcher: %w", w.kind, err)
+	}
+	dirs := make(map[string]struct{})
+	for file := range w.files {
+		dirs[filepath.Dir(file)] = struct{}{}
+	}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+			return fmt.Errorf("watching %s directory %q: %w", w.kind, dir, err)
+		}
+	}
+	w.watcher = watcher
+
+	w.wg.Add(1)
+	go w.eventLoop(ctx)
+	return nil
+}
+
+// stop stops watching and waits for the event loop to exit. A pending
+// onChange call is cancelled. It is safe to call multiple times.
+func (w *fileWatcher) stop() {
+	w.stopOnce.Do(func() {
+		close(w.stopCh)
+		w.timerMu.Lock()
+		if w.timer != nil {
+			w.timer.Stop()
+		}
+		w.timerMu.Unlock()
+		if w.watcher != nil {
+			_ = w.watcher.Close()
+		}
+	})
+	w.wg.Wait()
+}
+
+func (w *fileWatcher) eventLoop(ctx context.Context) {
+	defer w.wg.Done()
+	defer func() {
+		if rec := recover(); rec != nil {
+			w.logger.Error("panic recovered in file watcher", "kind", w.kind, "error", rec)
+		}
+	}()
+
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if _, watched := w.files[filepath.Clean(event.Name)]; !watched {
+				continue
+			}
+			if event.Has(fsnotify.Write) || event.Has(fsnotify.Create) || event.Has(fsnotify.Rename) {
+				w.schedule(ctx)
+			}
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			w.logger.Error("File watcher error", "kind", w.kind, "error", err)
+		case <-ctx.Done():
+			return
+		case <-w.stopCh:
+			return
+		}
+	}
+}
+
+// schedule (re)starts the debounce timer for an onChange call
+func (w *fileWatcher) schedule(ctx context.Context) {
+	w.timerMu.Lock()
+	defer w.timerMu.Unlock()
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+	w.timer = time.AfterFunc(w.debounce, func() {
+		select {
+		case <-w.stopCh:
+			return
+		default:
+		}
+		w.onChange(ctx)
+	})
+}
@@ -0,0 +1,60 @@
+package modular
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFileWatcher_DebouncesChanges verifies that a burst of writes to a watched
+// file results in a single change notification, and that other files in the
+// directory are ignored.
+func TestFileWatcher_DebouncesChanges(t *testing.T) {
+	dir := t.TempDir()
+	watched := filepath.Join(dir, "watched.yaml")
+	other := filepath.Join(dir, "other.yaml")
+	require.NoError(t, os.WriteFile(watched, []byte("a: 1\n"), 0o600))
+
+	var calls atomic.Int32
+	w := newFileWatcher("test", []string{watched}, 100*time.Millisecond, nopLogger{}, func(context.Context) {
+		calls.Add(1)
+	})
+	require.NoError(t, w.start(context.Background()))
+	defer w.stop()
+
+	require.NoError(t, os.WriteFile(other, []byte("b: 1\n"), 0o600))
+	for i := range 3 {
+		require.NoError(t, os.WriteFile(watched, []byte{byte('a' + i), '\n'}, 0o600))
+	}
+
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, 2*time.Second, 20*time.Millisecond)
+	time.Sleep(300 * time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+// TestFileWatcher_StopCancelsPendingChange verifies that stopping the watcher
+// drops a change that is still being debounced.
+func TestFileWatcher_StopCancelsPendingChange(t *testing.T) {
+	watched := filepath.Join(t.TempDir(), "watched.yaml")
+	require.NoError(t, os.WriteFile(watched, []byte("a: 1\n"), 0o600))
+
+	var calls atomic.Int32
+	w := newFileWatcher("test", []string{watched}, 200*time.Millisecond, nopLogger{}, func(context.Context) {
+		calls.Add(1)
+	})
+	require.NoError(t, w.start(context.Background()))
+
+	require.NoError(t, os.WriteFile(watched, []byte("a: 2\n"), 0o600))
+	time.Sleep(50 * time.Millisecond)
+	w.stop()
+	w.stop()
+
+	time.Sleep(300 * time.Millisecond)
+	assert.Zero(t, calls.Load())
+}
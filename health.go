@@ -46,7 +46,36 @@ type HealthProvider interface {
 	HealthCheck(ctx context.Context) ([]HealthReport, error)
 }
 
+// ReadinessProvider is an interface for components that report whether they are
+// ready to serve, independently of their health. A module warming up (e.g. filling
+// a cache) can be healthy while not yet ready.
+type ReadinessProvider interface {
+	// Ready reports whether the component is ready, with a reason when it is not.
+	Ready(ctx context.Context) (bool, string)
+}
+
+// OptionalReadiness can be implemented by a ReadinessProvider whose readiness
+// is not required, such as a module that only warms a secondary cache. An
+// optional provider that is not ready is still listed in
+// AggregatedHealth.NotReady, but it does not affect AggregatedHealth.Readiness
+// and WaitReady does not wait for it.
+type OptionalReadiness interface {
+	// ReadinessOptional reports whether the provider's readiness is optional.
+	ReadinessOptional() bool
+}
+
+// isReadinessRequired reports whether provider must be ready for the
+// application to be ready
+func isReadinessRequired(provider ReadinessProvider) bool {
+	optional, ok := provider.(OptionalReadiness)
+	return !ok || !optional.ReadinessOptional()
+}
+
 // HealthReport represents the health status of a single component.
+//
+// Latency and ObservedSince may be left zero by providers; the
+// AggregateHealthService fills them in with the provider's check duration and
+// the time the component's current status was first observed.
 type HealthReport struct {
 	Module        string
 	Component     string
@@ -54,15 +83,21 @@ type HealthReport struct {
 	Message       string
 	CheckedAt     time.Time
 	ObservedSince time.Time
+	Latency       time.Duration
 	Optional      bool
 	Details       map[string]any
 }
 
 // AggregatedHealth represents the combined health of all providers.
+//
+// Readiness is the worst status of the required (non-optional) reports, and is
+// StatusUnhealthy while any required readiness provider is not ready. NotReady
+// maps the names of readiness providers that are not ready to their reasons.
 type AggregatedHealth struct {
 	Readiness   HealthStatus
 	Health      HealthStatus
 	Reports     []HealthReport
+	NotReady    map[string]string
 	GeneratedAt time.Time
 }
 
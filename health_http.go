@@ -0,0 +1,165 @@
+package modular
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+)
+
+// HealthChecker reports the aggregated health of an application. It is
+// implemented by AggregateHealthService; HealthHandlers uses the one
+// registered as a service with the application.
+type HealthChecker interface {
+	Check(ctx context.Context) (*AggregatedHealth, error)
+}
+
+// StatusCodeMapper maps a health or readiness status to the HTTP status code
+// of the health endpoints
+type StatusCodeMapper func(status HealthStatus) int
+
+// DefaultStatusCodeMapper answers 200 for healthy and degraded statuses, which
+// can still serve, and 503 for unhealthy and unknown ones.
+func DefaultStatusCodeMapper(status HealthStatus) int {
+	if status == StatusHealthy || status == StatusDegraded {
+		return http.StatusOK
+	}
+	return http.StatusServiceUnavailable
+}
+
+// HealthHandlerOption configures the handlers returned by HealthHandlers
+type HealthHandlerOption func(*healthHandlers)
+
+// WithStatusCodeMapper sets how statuses map to HTTP status codes, replacing
+// DefaultStatusCodeMapper.
+func WithStatusCodeMapper(mapper StatusCodeMapper) HealthHandlerOption {
+	return func(h *healthHandlers) {
+		if mapper != nil {
+			h.statusCode = mapper
+		}
+	}
+}
+
+// HealthResponse is the JSON body of the liveness and readiness endpoints
+type HealthResponse struct {
+	Status   string            `json:"status"`
+	NotReady map[string]string `json:"not_ready,omitempty"`
+	Error    string            `json:"error,omitempty"`
+}
+
+// healthHandlers serves the endpoints of HealthHandlers
+type healthHandlers struct {
+	app        Application
+	statusCode StatusCodeMapper
+}
+
+// HealthHandlers returns the standard liveness, readiness and health HTTP
+// handlers of app, typically mounted at /alive, /ready and /health. They
+// report the aggregated health of the HealthChecker service registered with
+// the application, such as an AggregateHealthService:
+//
+//   - live always answers 200 while the process can serve requests.
+//   - ready answers with the code of the aggregated readiness, and 503 until
+//     the application is running. Readiness providers that are not ready are
+//     listed in the response.
+//   - health answers with the code of the aggregated health and the full
+//     HealthTree.
+//
+// Responses are JSON. Adding "?refresh=true" bypasses the health cache. When
+// no HealthChecker is registered, ready and health answer 503.
+//
+// Example:
+//
+//	healthSvc := modular.NewAggregateHealthService()
+//	_ = app.RegisterService("health", healthSvc)
+//	live, ready, health := modular.HealthHandlers(app)
+//	router.Handle("/alive", live)
+//	router.Handle("/ready", ready)
+//	router.Handle("/health", health)
+func HealthHandlers(app Application, opts ...HealthHandlerOption) (live, ready, health http.Handler) {
+	h := &healthHandlers{app: app, statusCode: DefaultStatusCodeMapper}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return http.HandlerFunc(h.serveLive), http.HandlerFunc(h.serveReady), http.HandlerFunc(h.serveHealth)
+}
+
+func (h *healthHandlers) serveLive(w http.ResponseWriter, _ *http.Request) {
+	writeHealthJSON(w, http.StatusOK, HealthResponse{Status: "alive"})
+}
+
+func (h *healthHandlers) serveReady(w http.ResponseWriter, r *http.Request) {
+	if phaseAware, ok := h.app.(PhaseAware); ok {
+		if phase := phaseAware.Phase(); phase != PhaseRunning {
+			writeHealthJSON(w, http.StatusServiceUnavailable, HealthResponse{
+				Status: StatusUnhealthy.String(),
+				Error:  fmt.Sprintf("application is %s", phase),
+			})
+			return
+		}
+	}
+
+	agg, err := h.check(r)
+	if err != nil {
+		writeHealthJSON(w, http.StatusServiceUnavailable, HealthResponse{Status: StatusUnknown.String(), Error: err.Error()})
+		return
+	}
+	writeHealthJSON(w, h.statusCode(agg.Readiness), HealthResponse{
+		Status:   agg.Readiness.String(),
+		NotReady: agg.NotReady,
+	})
+}
+
+func (h *healthHandlers) serveHealth(w http.ResponseWriter, r *http.Request) {
+	agg, err := h.check(r)
+	if err != nil {
+		writeHealthJSON(w, http.StatusServiceUnavailable, HealthResponse{Status: StatusUnknown.String(), Error: err.Error()})
+		return
+	}
+	writeHealthJSON(w, h.statusCode(agg.Health), BuildHealthTree(agg))
+}
+
+// check runs the application's health checker for the request
+func (h *healthHandlers) check(r *http.Request) (*AggregatedHealth, error) {
+	checker, ok := findHealthChecker(h.app)
+	if !ok {
+		return nil, ErrHealthCheckerNotFound
+	}
+	ctx := r.Context()
+	if r.URL.Query().Get("refresh") == "true" {
+		ctx = context.WithValue(ctx, ForceHealthRefreshKey, true)
+	}
+	agg, err := checker.Check(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("health check failed: %w", err)
+	}
+	return agg, nil
+}
+
+// findHealthChecker returns the health checker registered with app under any
+// service name, the one with the lowest name if there are several. Services
+// are looked up on every request so that the checker may be registered late.
+func findHealthChecker(app Application) (HealthChecker, bool) {
+	if app == nil {
+		return nil, false
+	}
+	entries := app.GetServicesByInterface(reflect.TypeOf((*HealthChecker)(nil)).Elem())
+	if len(entries) == 0 {
+		return nil, false
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ActualName < entries[j].ActualName
+	})
+	checker, ok := entries[0].Service.(HealthChecker)
+	return checker, ok
+}
+
+// writeHealthJSON writes body as JSON with the given status code
+func writeHealthJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,149 @@
+package modular
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHealthHandlerApp starts an application with svc registered as its health
+// checker, if not nil
+func newHealthHandlerApp(t *testing.T, svc *AggregateHealthService) Application {
+	t.Helper()
+	app, err := NewApplication(WithLogger(nopLogger{}))
+	require.NoError(t, err)
+	if svc != nil {
+		require.NoError(t, app.RegisterService("health", svc))
+	}
+	require.NoError(t, app.Init())
+	require.NoError(t, app.Start())
+	t.Cleanup(func() { _ = app.Stop() })
+	return app
+}
+
+// serveHealth calls handler and returns the status code and decoded JSON body
+func serveHealth(t *testing.T, handler http.Handler) (int, map[string]any) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	body := map[string]any{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return rec.Code, body
+}
+
+func healthServiceWithStatus(status HealthStatus) *AggregateHealthService {
+	svc := NewAggregateHealthService(WithCacheTTL(0))
+	svc.AddProvider("database", NewStaticHealthProvider(HealthReport{
+		Module:    "database",
+		Component: "connectivity",
+		Status:    status,
+	}))
+	return svc
+}
+
+func TestHealthHandlers(t *testing.T) {
+	t.Run("healthy", func(t *testing.T) {
+		live, ready, health := HealthHandlers(newHealthHandlerApp(t, healthServiceWithStatus(StatusHealthy)))
+
+		code, body := serveHealth(t, live)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "alive", body["status"])
+
+		code, body = serveHealth(t, ready)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "healthy", body["status"])
+
+		code, body = serveHealth(t, health)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "healthy", body["status"])
+		assert.Len(t, body["modules"], 1, "the health endpoint returns the health tree")
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		live, ready, health := HealthHandlers(newHealthHandlerApp(t, healthServiceWithStatus(StatusUnhealthy)))
+
+		code, _ := serveHealth(t, live)
+		assert.Equal(t, http.StatusOK, code, "liveness does not depend on health")
+
+		code, body := serveHealth(t, ready)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "unhealthy", body["status"])
+
+		code, body = serveHealth(t, health)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "unhealthy", body["status"])
+	})
+
+	t.Run("degraded still serves", func(t *testing.T) {
+		_, ready, health := HealthHandlers(newHealthHandlerApp(t, healthServiceWithStatus(StatusDegraded)))
+		code, _ := serveHealth(t, ready)
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = serveHealth(t, health)
+		assert.Equal(t, http.StatusOK, code)
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		svc := healthServiceWithStatus(StatusHealthy)
+		svc.AddReadinessProvider("cache", &warmupReadinessProvider{readyAt: time.Now().Add(time.Hour)})
+		_, ready, health := HealthHandlers(newHealthHandlerApp(t, svc))
+
+		code, body := serveHealth(t, ready)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, map[string]any{"cache": "warming up"}, body["not_ready"])
+
+		code, _ = serveHealth(t, health)
+		assert.Equal(t, http.StatusOK, code, "a component warming up is still healthy")
+	})
+
+	t.Run("custom status code mapper", func(t *testing.T) {
+		strict := func(status HealthStatus) int {
+			if status == StatusHealthy {
+				return http.StatusOK
+			}
+			return http.StatusTeapot
+		}
+		_, ready, health := HealthHandlers(newHealthHandlerApp(t, healthServiceWithStatus(StatusDegraded)),
+			WithStatusCodeMapper(strict))
+		code, _ := serveHealth(t, ready)
+		assert.Equal(t, http.StatusTeapot, code)
+		code, _ = serveHealth(t, health)
+		assert.Equal(t, http.StatusTeapot, code)
+	})
+
+	t.Run("no health checker", func(t *testing.T) {
+		live, ready, health := HealthHandlers(newHealthHandlerApp(t, nil))
+		code, _ := serveHealth(t, live)
+		assert.Equal(t, http.StatusOK, code)
+
+		code, body := serveHealth(t, ready)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, ErrHealthCheckerNotFound.Error(), body["error"])
+		code, _ = serveHealth(t, health)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+	})
+}
+
+func TestHealthHandlers_NotReadyUntilRunning(t *testing.T) {
+	app, err := NewApplication(WithLogger(nopLogger{}))
+	require.NoError(t, err)
+	require.NoError(t, app.RegisterService("health", healthServiceWithStatus(StatusHealthy)))
+	require.NoError(t, app.Init())
+	live, ready, _ := HealthHandlers(app)
+
+	code, body := serveHealth(t, ready)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Contains(t, body["error"], "initialized")
+	code, _ = serveHealth(t, live)
+	assert.Equal(t, http.StatusOK, code)
+
+	require.NoError(t, app.Start())
+	defer func() { _ = app.Stop() }()
+	code, _ = serveHealth(t, ready)
+	assert.Equal(t, http.StatusOK, code)
+}
@@ -0,0 +1,107 @@
+package modular
+
+// AggregationMode selects how an AggregationPolicy combines component statuses.
+type AggregationMode string
+
+const (
+	// AggregateWorstCase reports the worst status of any component. It is the
+	// default mode.
+	AggregateWorstCase AggregationMode = "worst-case"
+	// AggregateQuorum reports unhealthy only when more than half of the
+	// required components are unhealthy, and degraded when any component is
+	// not healthy.
+	AggregateQuorum AggregationMode = "quorum"
+	// AggregateWeighted is like AggregateQuorum, with each required component
+	// counting for its weight and a configurable threshold.
+	AggregateWeighted AggregationMode = "weighted"
+)
+
+// defaultAggregationThreshold is the share of required weight that must be
+// unhealthy, exclusive, for the quorum and weighted modes to report unhealthy
+const defaultAggregationThreshold = 0.5
+
+// AggregationPolicy decides the overall health and readiness of an
+// AggregateHealthService from the statuses of its components.
+//
+// Under the quorum and weighted modes, optional components never make the
+// overall status worse than degraded. Readiness only considers required
+// components in every mode, and readiness providers that are not ready still
+// make the application unready.
+type AggregationPolicy struct {
+	Mode AggregationMode `yaml:"mode" json:"mode" toml:"mode"`
+
+	// Weights of the required components for AggregateWeighted, keyed by
+	// "module/component" or by module. Components without a weight count
+	// for 1.
+	Weights map[string]float64 `yaml:"weights" json:"weights" toml:"weights"`
+
+	// Threshold is the share of the required weight, between 0 and 1, that
+	// must be exceeded by unhealthy components for AggregateWeighted to report
+	// unhealthy. Defaults to 0.5.
+	Threshold float64 `yaml:"threshold" json:"threshold" toml:"threshold"`
+}
+
+// aggregate combines the statuses of the reports, skipping optional reports
+// unless includeOptional is set. Unknown statuses count as unhealthy.
+func (p AggregationPolicy) aggregate(reports []HealthReport, includeOptional bool) HealthStatus {
+	switch p.Mode {
+	case AggregateQuorum:
+		return aggregateShare(reports, includeOptional, nil, defaultAggregationThreshold)
+	case AggregateWeighted:
+		threshold := p.Threshold
+		if threshold <= 0 || threshold > 1 {
+			threshold = defaultAggregationThreshold
+		}
+		return aggregateShare(reports, includeOptional, p.Weights, threshold)
+	default:
+		status := StatusHealthy
+		for _, report := range reports {
+			if includeOptional || !report.Optional {
+				status = worstStatus(status, report.Status)
+			}
+		}
+		return status
+	}
+}
+
+// aggregateShare reports unhealthy when the weighted share of unhealthy
+// required components exceeds threshold, and otherwise degraded when any
+// considered component is not healthy.
+func aggregateShare(reports []HealthReport, includeOptional bool, weights map[string]float64, threshold float64) HealthStatus {
+	status := StatusHealthy
+	var total, down float64
+	for _, report := range reports {
+		componentStatus := worstStatus(StatusHealthy, report.Status)
+		if report.Optional {
+			if includeOptional && componentStatus != StatusHealthy {
+				status = worstStatus(status, StatusDegraded)
+			}
+			continue
+		}
+
+		weight := componentWeight(weights, report)
+		total += weight
+		if componentStatus == StatusUnhealthy {
+			down += weight
+		}
+		if componentStatus != StatusHealthy {
+			status = worstStatus(status, StatusDegraded)
+		}
+	}
+	if total > 0 && down/total > threshold {
+		return StatusUnhealthy
+	}
+	return status
+}
+
+// componentWeight returns the weight of the report's component, 1 if none is
+// set. Negative weights count as 0.
+func componentWeight(weights map[string]float64, report HealthReport) float64 {
+	weight, ok := weights[report.Module+"/"+report.Component]
+	if !ok {
+		if weight, ok = weights[report.Module]; !ok {
+			return 1
+		}
+	}
+	return max(weight, 0)
+}
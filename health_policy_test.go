@@ -0,0 +1,104 @@
+package modular
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func policyReport(module string, status HealthStatus, optional bool) HealthReport {
+	return HealthReport{Module: module, Component: "check", Status: status, Optional: optional}
+}
+
+func TestAggregationPolicy(t *testing.T) {
+	mixed := []HealthReport{
+		policyReport("db", StatusUnhealthy, false),
+		policyReport("cache", StatusHealthy, false),
+		policyReport("queue", StatusHealthy, false),
+		policyReport("metrics", StatusUnhealthy, true),
+	}
+	majorityDown := []HealthReport{
+		policyReport("db", StatusUnhealthy, false),
+		policyReport("cache", StatusUnknown, false),
+		policyReport("queue", StatusHealthy, false),
+	}
+	optionalDown := []HealthReport{
+		policyReport("db", StatusHealthy, false),
+		policyReport("metrics", StatusUnhealthy, true),
+		policyReport("tracing", StatusUnhealthy, true),
+	}
+	halfDegraded := []HealthReport{
+		policyReport("db", StatusUnhealthy, false),
+		policyReport("cache", StatusDegraded, false),
+	}
+
+	tests := []struct {
+		name          string
+		policy        AggregationPolicy
+		reports       []HealthReport
+		wantHealth    HealthStatus
+		wantReadiness HealthStatus
+	}{
+		{"worst-case by default", AggregationPolicy{}, mixed, StatusUnhealthy, StatusUnhealthy},
+		{"worst-case counts optional in health", AggregationPolicy{Mode: AggregateWorstCase}, optionalDown, StatusUnhealthy, StatusHealthy},
+		{"quorum minority down", AggregationPolicy{Mode: AggregateQuorum}, mixed, StatusDegraded, StatusDegraded},
+		{"quorum majority down", AggregationPolicy{Mode: AggregateQuorum}, majorityDown, StatusUnhealthy, StatusUnhealthy},
+		{"quorum half down", AggregationPolicy{Mode: AggregateQuorum}, halfDegraded, StatusDegraded, StatusDegraded},
+		{"quorum optional never unhealthy", AggregationPolicy{Mode: AggregateQuorum}, optionalDown, StatusDegraded, StatusHealthy},
+		{"quorum all healthy", AggregationPolicy{Mode: AggregateQuorum}, []HealthReport{
+			policyReport("db", StatusHealthy, false),
+		}, StatusHealthy, StatusHealthy},
+		{"weighted heavy component down", AggregationPolicy{
+			Mode: AggregateWeighted, Weights: map[string]float64{"db": 3},
+		}, mixed, StatusUnhealthy, StatusUnhealthy},
+		{"weighted light components down", AggregationPolicy{
+			Mode: AggregateWeighted, Weights: map[string]float64{"queue": 3},
+		}, majorityDown, StatusDegraded, StatusDegraded},
+		{"weighted lower threshold", AggregationPolicy{
+			Mode: AggregateWeighted, Threshold: 0.25,
+		}, mixed, StatusUnhealthy, StatusUnhealthy},
+		{"weighted component key takes precedence", AggregationPolicy{
+			Mode: AggregateWeighted, Weights: map[string]float64{"db": 3, "db/check": 0.5},
+		}, mixed, StatusDegraded, StatusDegraded},
+		{"weighted optional never unhealthy", AggregationPolicy{
+			Mode: AggregateWeighted, Weights: map[string]float64{"metrics": 10},
+		}, optionalDown, StatusDegraded, StatusHealthy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.aggregate(tt.reports, true); got != tt.wantHealth {
+				t.Errorf("health = %v, want %v", got, tt.wantHealth)
+			}
+			if got := tt.policy.aggregate(tt.reports, false); got != tt.wantReadiness {
+				t.Errorf("readiness = %v, want %v", got, tt.wantReadiness)
+			}
+		})
+	}
+}
+
+func TestAggregateHealthService_AggregationPolicy(t *testing.T) {
+	svc := NewAggregateHealthService(WithCacheTTL(0), WithAggregationPolicy(AggregationPolicy{Mode: AggregateQuorum}))
+	svc.AddProvider("db", NewStaticHealthProvider(policyReport("db", StatusUnhealthy, false)))
+	svc.AddProvider("cache", NewStaticHealthProvider(policyReport("cache", StatusHealthy, false)))
+	svc.AddProvider("queue", NewStaticHealthProvider(policyReport("queue", StatusHealthy, false)))
+	svc.AddProvider("metrics", NewStaticHealthProvider(policyReport("metrics", StatusUnhealthy, true)))
+
+	result, err := svc.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Health != StatusDegraded || result.Readiness != StatusDegraded {
+		t.Errorf("expected degraded health and readiness with a minority down, got %v and %v",
+			result.Health, result.Readiness)
+	}
+
+	svc.AddReadinessProvider("warmup", &warmupReadinessProvider{readyAt: result.GeneratedAt.Add(time.Hour)})
+	result, err = svc.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Readiness != StatusUnhealthy {
+		t.Errorf("expected a readiness provider that is not ready to make readiness unhealthy, got %v", result.Readiness)
+	}
+}
@@ -2,8 +2,10 @@ package modular
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"sync"
 	"time"
 )
@@ -12,6 +14,7 @@ import (
 // and produces an aggregated health result with caching and event emission.
 type AggregateHealthService struct {
 	providers   map[string]HealthProvider
+	readiness   map[string]ReadinessProvider
 	mu          sync.RWMutex
 	cache       *AggregatedHealth
 	cacheMu     sync.RWMutex
@@ -20,8 +23,35 @@ type AggregateHealthService struct {
 	lastStatus  HealthStatus
 	subject     Subject
 	logger      Logger
+
+	// checkTimeout bounds each provider's HealthCheck, unless overridden for
+	// the provider in providerTimeouts
+	checkTimeout     time.Duration
+	providerTimeouts map[string]time.Duration
+
+	// minInterval is how long a provider's last result is reused before it is
+	// checked again, unless overridden for the provider in providerIntervals
+	minInterval       time.Duration
+	providerIntervals map[string]time.Duration
+	lastResults       map[string]cachedProviderResult
+	lastResultsMu     sync.Mutex
+
+	// policy combines the component statuses into the overall statuses
+	policy AggregationPolicy
+
+	// observed tracks when each module/component was first seen in its current status
+	observed map[string]observedStatus
+}
+
+// observedStatus records a component's status and when it was first observed.
+type observedStatus struct {
+	status HealthStatus
+	since  time.Time
 }
 
+// defaultHealthCheckTimeout bounds a provider's HealthCheck when no timeout is configured
+const defaultHealthCheckTimeout = 5 * time.Second
+
 // HealthServiceOption configures an AggregateHealthService.
 type HealthServiceOption func(*AggregateHealthService)
 
@@ -32,6 +62,52 @@ func WithCacheTTL(d time.Duration) HealthServiceOption {
 	}
 }
 
+// WithCheckTimeout sets how long each provider's HealthCheck may run, 5s by
+// default. Providers are checked concurrently, and one that does not answer in
+// time is reported as degraded without holding back the others. A timeout that
+// is not positive disables the limit.
+func WithCheckTimeout(d time.Duration) HealthServiceOption {
+	return func(s *AggregateHealthService) {
+		s.checkTimeout = d
+	}
+}
+
+// WithProviderCheckTimeout sets the HealthCheck timeout of the provider
+// registered under name, overriding WithCheckTimeout for it.
+func WithProviderCheckTimeout(name string, d time.Duration) HealthServiceOption {
+	return func(s *AggregateHealthService) {
+		s.providerTimeouts[name] = d
+	}
+}
+
+// WithMinCheckInterval throttles providers so that each one is checked at most
+// once per interval; checks within the interval reuse its last result, whose
+// reports keep the CheckedAt of the check that produced them. This protects
+// expensive checks such as database pings from aggressive scraping. Setting
+// ForceHealthRefreshKey in the context checks all providers afresh. An interval
+// that is not positive, the default, checks providers on every cache miss.
+func WithMinCheckInterval(d time.Duration) HealthServiceOption {
+	return func(s *AggregateHealthService) {
+		s.minInterval = d
+	}
+}
+
+// WithProviderMinCheckInterval sets the minimum check interval of the provider
+// registered under name, overriding WithMinCheckInterval for it.
+func WithProviderMinCheckInterval(name string, d time.Duration) HealthServiceOption {
+	return func(s *AggregateHealthService) {
+		s.providerIntervals[name] = d
+	}
+}
+
+// WithAggregationPolicy sets how component statuses combine into the overall
+// health and readiness, AggregationPolicy{Mode: AggregateWorstCase} by default.
+func WithAggregationPolicy(policy AggregationPolicy) HealthServiceOption {
+	return func(s *AggregateHealthService) {
+		s.policy = policy
+	}
+}
+
 // WithSubject sets the event subject for health event emission.
 func WithSubject(sub Subject) HealthServiceOption {
 	return func(s *AggregateHealthService) {
@@ -49,9 +125,16 @@ func WithHealthLogger(l Logger) HealthServiceOption {
 // NewAggregateHealthService creates a new AggregateHealthService with the given options.
 func NewAggregateHealthService(opts ...HealthServiceOption) *AggregateHealthService {
 	svc := &AggregateHealthService{
-		providers:  make(map[string]HealthProvider),
-		cacheTTL:   250 * time.Millisecond,
-		lastStatus: StatusUnknown,
+		providers:        make(map[string]HealthProvider),
+		readiness:        make(map[string]ReadinessProvider),
+		cacheTTL:         250 * time.Millisecond,
+		lastStatus:       StatusUnknown,
+		observed:         make(map[string]observedStatus),
+		checkTimeout:     defaultHealthCheckTimeout,
+		providerTimeouts: make(map[string]time.Duration),
+
+		providerIntervals: make(map[string]time.Duration),
+		lastResults:       make(map[string]cachedProviderResult),
 	}
 	for _, opt := range opts {
 		opt(svc)
@@ -60,18 +143,34 @@ func NewAggregateHealthService(opts ...HealthServiceOption) *AggregateHealthServ
 }
 
 // AddProvider registers a named health provider and invalidates the cache.
+// If the provider also implements ReadinessProvider, it is registered as a
+// readiness provider under the same name.
 func (s *AggregateHealthService) AddProvider(name string, provider HealthProvider) {
 	s.mu.Lock()
 	s.providers[name] = provider
+	if rp, ok := provider.(ReadinessProvider); ok {
+		s.readiness[name] = rp
+	}
+	s.mu.Unlock()
+	s.forgetLastResult(name)
+	s.invalidateCache()
+}
+
+// AddReadinessProvider registers a named readiness provider and invalidates the cache.
+func (s *AggregateHealthService) AddReadinessProvider(name string, provider ReadinessProvider) {
+	s.mu.Lock()
+	s.readiness[name] = provider
 	s.mu.Unlock()
 	s.invalidateCache()
 }
 
-// RemoveProvider removes a named health provider and invalidates the cache.
+// RemoveProvider removes the named health and readiness providers and invalidates the cache.
 func (s *AggregateHealthService) RemoveProvider(name string) {
 	s.mu.Lock()
 	delete(s.providers, name)
+	delete(s.readiness, name)
 	s.mu.Unlock()
+	s.forgetLastResult(name)
 	s.invalidateCache()
 }
 
@@ -82,15 +181,63 @@ func (s *AggregateHealthService) invalidateCache() {
 	s.cacheMu.Unlock()
 }
 
+func (s *AggregateHealthService) forgetLastResult(name string) {
+	s.lastResultsMu.Lock()
+	delete(s.lastResults, name)
+	s.lastResultsMu.Unlock()
+}
+
 // providerResult is used to collect results from concurrent provider checks.
 type providerResult struct {
 	reports []HealthReport
 	err     error
 	name    string
+	latency time.Duration
+	// completed is set when the provider returned, rather than timing out
+	completed bool
+}
+
+// cachedProviderResult is the last result of a throttled provider.
+type cachedProviderResult struct {
+	result  providerResult
+	expires time.Time
+}
+
+// lastResult returns the provider's last result if its minimum check interval
+// has not elapsed since.
+func (s *AggregateHealthService) lastResult(name string, now time.Time) (providerResult, bool) {
+	s.lastResultsMu.Lock()
+	defer s.lastResultsMu.Unlock()
+	cached, ok := s.lastResults[name]
+	if !ok || !now.Before(cached.expires) {
+		return providerResult{}, false
+	}
+	result := cached.result
+	result.reports = slices.Clone(result.reports)
+	return result, true
+}
+
+// storeResult keeps a completed provider result for reuse during the
+// provider's minimum check interval, if it has one.
+func (s *AggregateHealthService) storeResult(result providerResult, checkedAt time.Time) {
+	interval := s.minInterval
+	if d, ok := s.providerIntervals[result.name]; ok {
+		interval = d
+	}
+	if interval <= 0 || !result.completed {
+		return
+	}
+	s.lastResultsMu.Lock()
+	s.lastResults[result.name] = cachedProviderResult{
+		result:  result,
+		expires: checkedAt.Add(interval),
+	}
+	s.lastResultsMu.Unlock()
 }
 
 // Check evaluates all registered providers and returns an aggregated health result.
-// Results are cached for the configured TTL unless ForceHealthRefreshKey is set in the context.
+// Results are cached for the configured TTL unless ForceHealthRefreshKey is set in the context,
+// which also bypasses the minimum check interval of the providers.
 // The returned AggregatedHealth is a deep copy and safe to mutate.
 func (s *AggregateHealthService) Check(ctx context.Context) (*AggregatedHealth, error) {
 	// Check cache validity
@@ -109,37 +256,33 @@ func (s *AggregateHealthService) Check(ctx context.Context) (*AggregatedHealth,
 	s.mu.RLock()
 	providers := make(map[string]HealthProvider, len(s.providers))
 	maps.Copy(providers, s.providers)
+	readinessProviders := maps.Clone(s.readiness)
 	s.mu.RUnlock()
 
-	// Fan-out to all providers
+	// Fan-out to all providers, each bounded by its own timeout, reusing the
+	// last results of throttled providers
 	ch := make(chan providerResult, len(providers))
+	now := time.Now()
 	for name, provider := range providers {
+		if !forceRefresh {
+			if result, ok := s.lastResult(name, now); ok {
+				ch <- result
+				continue
+			}
+		}
+		timeout := s.checkTimeout
+		if d, ok := s.providerTimeouts[name]; ok {
+			timeout = d
+		}
 		go func(name string, provider HealthProvider) {
-			result := providerResult{name: name}
-			defer func() {
-				if r := recover(); r != nil {
-					result.reports = []HealthReport{{
-						Module:    name,
-						Component: "panic-recovery",
-						Status:    StatusUnhealthy,
-						Message:   fmt.Sprintf("provider panicked: %v", r),
-						CheckedAt: time.Now(),
-					}}
-					result.err = nil
-					ch <- result
-				}
-			}()
-			reports, err := provider.HealthCheck(ctx)
-			result.reports = reports
-			result.err = err
+			result := checkProvider(ctx, name, provider, timeout)
+			s.storeResult(result, now)
 			ch <- result
 		}(name, provider)
 	}
 
 	// Collect results
 	var allReports []HealthReport
-	readiness := StatusHealthy
-	health := StatusHealthy
 
 	for range len(providers) {
 		var result providerResult
@@ -162,18 +305,27 @@ func (s *AggregateHealthService) Check(ctx context.Context) (*AggregatedHealth,
 				Status:    status,
 				Message:   result.err.Error(),
 				CheckedAt: time.Now(),
+				Latency:   result.latency,
 			})
-			readiness = worstStatus(readiness, status)
-			health = worstStatus(health, status)
 			continue
 		}
 
 		for _, report := range result.reports {
-			allReports = append(allReports, report)
-			health = worstStatus(health, report.Status)
-			if !report.Optional {
-				readiness = worstStatus(readiness, report.Status)
+			if report.Latency == 0 {
+				report.Latency = result.latency
 			}
+			allReports = append(allReports, report)
+		}
+	}
+
+	health := s.policy.aggregate(allReports, true)
+	readiness := s.policy.aggregate(allReports, false)
+
+	notReady := checkReadiness(ctx, readinessProviders)
+	for name := range notReady {
+		if isReadinessRequired(readinessProviders[name]) {
+			readiness = worstStatus(readiness, StatusUnhealthy)
+			break
 		}
 	}
 
@@ -181,11 +333,13 @@ func (s *AggregateHealthService) Check(ctx context.Context) (*AggregatedHealth,
 		Readiness:   readiness,
 		Health:      health,
 		Reports:     allReports,
+		NotReady:    notReady,
 		GeneratedAt: time.Now(),
 	}
 
 	// Cache result
 	s.cacheMu.Lock()
+	s.trackObservedSince(allReports, aggregated.GeneratedAt)
 	s.cache = aggregated
 	s.cacheExpiry = time.Now().Add(s.cacheTTL)
 	s.cacheMu.Unlock()
@@ -205,6 +359,120 @@ func (s *AggregateHealthService) Check(ctx context.Context) (*AggregatedHealth,
 	return s.deepCopyAggregated(aggregated), nil
 }
 
+// checkProvider runs the provider's HealthCheck, recovering from panics. A
+// check that outlives timeout is abandoned and reported as degraded; its
+// goroutine exits whenever the provider returns.
+func checkProvider(ctx context.Context, name string, provider HealthProvider, timeout time.Duration) providerResult {
+	checkCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		checkCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	done := make(chan providerResult, 1)
+	go func() {
+		result := providerResult{name: name}
+		defer func() {
+			if r := recover(); r != nil {
+				result.reports = []HealthReport{{
+					Module:    name,
+					Component: "panic-recovery",
+					Status:    StatusUnhealthy,
+					Message:   fmt.Sprintf("provider panicked: %v", r),
+					CheckedAt: time.Now(),
+				}}
+				result.err = nil
+			}
+			done <- result
+		}()
+		reports, err := provider.HealthCheck(checkCtx)
+		result.latency = time.Since(start)
+		result.err = err
+		result.completed = true
+		// Reports are stamped so that results reused later tell their age
+		result.reports = make([]HealthReport, len(reports))
+		for i, report := range reports {
+			if report.CheckedAt.IsZero() {
+				report.CheckedAt = start
+			}
+			result.reports[i] = report
+		}
+	}()
+
+	var result providerResult
+	select {
+	case result = <-done:
+		if !errors.Is(result.err, context.DeadlineExceeded) {
+			return result
+		}
+	case <-checkCtx.Done():
+	}
+	if ctx.Err() != nil || checkCtx.Err() == nil {
+		// The caller gave up, or the provider failed on its own
+		if result.name == "" {
+			result = providerResult{name: name, err: ctx.Err(), latency: time.Since(start)}
+		}
+		return result
+	}
+	return providerResult{
+		name: name,
+		reports: []HealthReport{{
+			Module:    name,
+			Component: "timeout",
+			Status:    StatusDegraded,
+			Message:   fmt.Sprintf("health check timed out after %s", timeout),
+			CheckedAt: time.Now(),
+		}},
+		latency: time.Since(start),
+	}
+}
+
+// checkReadiness asks each readiness provider whether it is ready, returning the
+// reasons of those that are not keyed by provider name. A panicking provider is
+// reported as not ready.
+func checkReadiness(ctx context.Context, providers map[string]ReadinessProvider) map[string]string {
+	var notReady map[string]string
+	for name, provider := range providers {
+		ready, reason := func() (ready bool, reason string) {
+			defer func() {
+				if r := recover(); r != nil {
+					ready, reason = false, fmt.Sprintf("readiness provider panicked: %v", r)
+				}
+			}()
+			return provider.Ready(ctx)
+		}()
+		if ready {
+			continue
+		}
+		if notReady == nil {
+			notReady = make(map[string]string)
+		}
+		notReady[name] = reason
+	}
+	return notReady
+}
+
+// trackObservedSince fills in ObservedSince for reports that did not set it,
+// using the time each component was first seen in its current status.
+// Components that no longer report are forgotten. Callers must hold cacheMu.
+func (s *AggregateHealthService) trackObservedSince(reports []HealthReport, now time.Time) {
+	seen := make(map[string]observedStatus, len(reports))
+	for i := range reports {
+		key := reports[i].Module + "/" + reports[i].Component
+		prev, ok := s.observed[key]
+		if !ok || prev.status != reports[i].Status {
+			prev = observedStatus{status: reports[i].Status, since: now}
+		}
+		seen[key] = prev
+		if reports[i].ObservedSince.IsZero() {
+			reports[i].ObservedSince = prev.since
+		}
+	}
+	s.observed = seen
+}
+
 // deepCopyAggregated returns a deep copy of an AggregatedHealth, including
 // reports and their Details maps, so callers cannot mutate cached state.
 func (s *AggregateHealthService) deepCopyAggregated(src *AggregatedHealth) *AggregatedHealth {
@@ -216,6 +484,7 @@ func (s *AggregateHealthService) deepCopyAggregated(src *AggregatedHealth) *Aggr
 		Health:      src.Health,
 		GeneratedAt: src.GeneratedAt,
 		Reports:     make([]HealthReport, len(src.Reports)),
+		NotReady:    maps.Clone(src.NotReady),
 	}
 	for i, r := range src.Reports {
 		dst.Reports[i] = r
@@ -433,3 +433,264 @@ func TestAggregateHealthService_ConcurrentChecks(t *testing.T) {
 		t.Errorf("concurrent check error: %v", err)
 	}
 }
+
+// warmupReadinessProvider reports ready once its ready time has passed.
+type warmupReadinessProvider struct {
+	readyAt time.Time
+}
+
+func (p *warmupReadinessProvider) Ready(_ context.Context) (bool, string) {
+	if time.Now().Before(p.readyAt) {
+		return false, "warming up"
+	}
+	return true, ""
+}
+
+func TestAggregateHealthService_ReadinessProvider(t *testing.T) {
+	svc := NewAggregateHealthService(WithCacheTTL(0))
+	svc.AddProvider("db", NewStaticHealthProvider(HealthReport{
+		Module: "db", Component: "conn", Status: StatusHealthy,
+	}))
+	svc.AddReadinessProvider("cache", &warmupReadinessProvider{readyAt: time.Now().Add(100 * time.Millisecond)})
+
+	result, err := svc.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Health != StatusHealthy {
+		t.Errorf("expected readiness not to affect health, got %v", result.Health)
+	}
+	if result.Readiness != StatusUnhealthy {
+		t.Errorf("expected unhealthy readiness while warming up, got %v", result.Readiness)
+	}
+	if result.NotReady["cache"] != "warming up" {
+		t.Errorf("expected cache to be reported not ready, got %v", result.NotReady)
+	}
+
+	time.Sleep(150 * time.Millisecond)
+	result, err = svc.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Readiness != StatusHealthy {
+		t.Errorf("expected healthy readiness once warmed up, got %v", result.Readiness)
+	}
+	if len(result.NotReady) != 0 {
+		t.Errorf("expected no not-ready providers, got %v", result.NotReady)
+	}
+}
+
+// hangingHealthProvider ignores its context and blocks until release is closed
+type hangingHealthProvider struct {
+	release chan struct{}
+}
+
+func (p *hangingHealthProvider) HealthCheck(_ context.Context) ([]HealthReport, error) {
+	<-p.release
+	return []HealthReport{{Module: "slow", Component: "conn", Status: StatusHealthy}}, nil
+}
+
+func TestAggregateHealthService_ProviderTimeout(t *testing.T) {
+	hanging := &hangingHealthProvider{release: make(chan struct{})}
+	defer close(hanging.release)
+
+	svc := NewAggregateHealthService(WithCacheTTL(0), WithCheckTimeout(50*time.Millisecond))
+	svc.AddProvider("fast", NewStaticHealthProvider(HealthReport{
+		Module: "fast", Component: "conn", Status: StatusHealthy,
+	}))
+	svc.AddProvider("slow", hanging)
+
+	start := time.Now()
+	result, err := svc.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected the hanging provider not to block the check, took %v", elapsed)
+	}
+	if result.Health != StatusDegraded {
+		t.Errorf("expected degraded health with a timed out provider, got %v", result.Health)
+	}
+
+	byModule := make(map[string]HealthReport)
+	for _, r := range result.Reports {
+		byModule[r.Module] = r
+	}
+	if byModule["fast"].Status != StatusHealthy {
+		t.Errorf("expected the fast provider's report, got %+v", byModule["fast"])
+	}
+	slow := byModule["slow"]
+	if slow.Status != StatusDegraded || slow.Component != "timeout" {
+		t.Errorf("expected a degraded timeout report for the slow provider, got %+v", slow)
+	}
+	if slow.Message != "health check timed out after 50ms" {
+		t.Errorf("unexpected timeout message %q", slow.Message)
+	}
+}
+
+func TestAggregateHealthService_ProviderCheckTimeoutOverride(t *testing.T) {
+	svc := NewAggregateHealthService(
+		WithCacheTTL(0),
+		WithCheckTimeout(20*time.Millisecond),
+		WithProviderCheckTimeout("migrations", time.Second),
+	)
+	svc.AddProvider("migrations", NewSimpleHealthProvider("migrations", "schema",
+		func(ctx context.Context) (HealthStatus, string, error) {
+			select {
+			case <-time.After(60 * time.Millisecond):
+				return StatusHealthy, "up to date", nil
+			case <-ctx.Done():
+				return StatusUnknown, "", ctx.Err()
+			}
+		}))
+
+	result, err := svc.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Health != StatusHealthy {
+		t.Errorf("expected the provider's own timeout to apply, got %v: %+v", result.Health, result.Reports)
+	}
+}
+
+func TestAggregateHealthService_ProviderHonoringTimeout(t *testing.T) {
+	svc := NewAggregateHealthService(WithCacheTTL(0), WithCheckTimeout(20*time.Millisecond))
+	svc.AddProvider("db", NewSimpleHealthProvider("db", "conn", func(ctx context.Context) (HealthStatus, string, error) {
+		<-ctx.Done()
+		return StatusUnknown, "", ctx.Err()
+	}))
+
+	result, err := svc.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Reports) != 1 || result.Reports[0].Component != "timeout" || result.Health != StatusDegraded {
+		t.Errorf("expected a provider failing with its deadline to be reported as timed out, got %+v", result.Reports)
+	}
+}
+
+func TestAggregateHealthService_MinCheckInterval(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	provider := NewSimpleHealthProvider("db", "ping", func(_ context.Context) (HealthStatus, string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return StatusHealthy, "pong", nil
+	})
+	callCount := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls
+	}
+
+	svc := NewAggregateHealthService(WithCacheTTL(0), WithMinCheckInterval(time.Hour))
+	svc.AddProvider("db", provider)
+
+	first, err := svc.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := svc.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if callCount() != 1 {
+		t.Fatalf("expected the provider to be checked once within the interval, got %d calls", callCount())
+	}
+	if !second.Reports[0].CheckedAt.Equal(first.Reports[0].CheckedAt) {
+		t.Errorf("expected the reused report to keep its CheckedAt %v, got %v",
+			first.Reports[0].CheckedAt, second.Reports[0].CheckedAt)
+	}
+	if second.Health != StatusHealthy {
+		t.Errorf("expected the reused result to be healthy, got %v", second.Health)
+	}
+
+	ctx := context.WithValue(context.Background(), ForceHealthRefreshKey, true)
+	if _, err := svc.Check(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if callCount() != 2 {
+		t.Errorf("expected a forced refresh to check the provider again, got %d calls", callCount())
+	}
+}
+
+func TestAggregateHealthService_ProviderMinCheckInterval(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	counting := func(name string) HealthProvider {
+		return NewSimpleHealthProvider(name, "check", func(_ context.Context) (HealthStatus, string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls[name]++
+			return StatusHealthy, "", nil
+		})
+	}
+
+	svc := NewAggregateHealthService(
+		WithCacheTTL(0),
+		WithMinCheckInterval(time.Hour),
+		WithProviderMinCheckInterval("cheap", 0),
+	)
+	svc.AddProvider("db", counting("db"))
+	svc.AddProvider("cheap", counting("cheap"))
+
+	for range 3 {
+		if _, err := svc.Check(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if calls["db"] != 1 {
+		t.Errorf("expected the throttled provider to be checked once, got %d", calls["db"])
+	}
+	if calls["cheap"] != 3 {
+		t.Errorf("expected the unthrottled provider to be checked every time, got %d", calls["cheap"])
+	}
+}
+
+func TestAggregateHealthService_MinCheckIntervalExpires(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	svc := NewAggregateHealthService(WithCacheTTL(0), WithMinCheckInterval(20*time.Millisecond))
+	svc.AddProvider("db", NewSimpleHealthProvider("db", "ping", func(_ context.Context) (HealthStatus, string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return StatusHealthy, "", nil
+	}))
+
+	_, _ = svc.Check(context.Background())
+	time.Sleep(40 * time.Millisecond)
+	_, _ = svc.Check(context.Background())
+
+	mu.Lock()
+	defer mu.Unlock()
+	if calls != 2 {
+		t.Errorf("expected the provider to be checked again after the interval, got %d calls", calls)
+	}
+}
+
+// optionalReadinessProvider is a warmup provider whose readiness is optional
+type optionalReadinessProvider struct {
+	warmupReadinessProvider
+}
+
+func (p *optionalReadinessProvider) ReadinessOptional() bool { return true }
+
+func TestAggregateHealthService_OptionalReadinessProvider(t *testing.T) {
+	svc := NewAggregateHealthService(WithCacheTTL(0))
+	svc.AddReadinessProvider("search", &optionalReadinessProvider{warmupReadinessProvider{readyAt: time.Now().Add(time.Hour)}})
+
+	result, err := svc.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Readiness != StatusHealthy {
+		t.Errorf("expected an optional provider not to affect readiness, got %v", result.Readiness)
+	}
+	if result.NotReady["search"] != "warming up" {
+		t.Errorf("expected the optional provider to be listed as not ready, got %v", result.NotReady)
+	}
+}
@@ -0,0 +1,237 @@
+package modular
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// HealthTree is a navigable view of an AggregatedHealth result, grouping
+// component reports by module: overall → per-module → per-component.
+type HealthTree struct {
+	Status      string             `json:"status"`
+	Readiness   string             `json:"readiness"`
+	NotReady    map[string]string  `json:"not_ready,omitempty"`
+	GeneratedAt time.Time          `json:"generated_at"`
+	Modules     []HealthModuleNode `json:"modules"`
+}
+
+// HealthModuleNode groups the component reports of a single module.
+// Status is the worst status among the module's components.
+type HealthModuleNode struct {
+	Name       string                `json:"name"`
+	Status     string                `json:"status"`
+	Components []HealthComponentNode `json:"components"`
+}
+
+// HealthComponentNode is the leaf of a HealthTree and mirrors a HealthReport.
+type HealthComponentNode struct {
+	Name          string         `json:"name"`
+	Status        string         `json:"status"`
+	Message       string         `json:"message,omitempty"`
+	CheckedAt     time.Time      `json:"checked_at"`
+	ObservedSince time.Time      `json:"observed_since"`
+	LatencyMs     float64        `json:"latency_ms"`
+	Optional      bool           `json:"optional"`
+	Details       map[string]any `json:"details,omitempty"`
+}
+
+// BuildHealthTree converts an aggregated health result into a HealthTree.
+// Modules and components are sorted by name so the output is stable.
+func BuildHealthTree(agg *AggregatedHealth) *HealthTree {
+	if agg == nil {
+		return &HealthTree{Status: StatusUnknown.String(), Readiness: StatusUnknown.String()}
+	}
+
+	tree := &HealthTree{
+		Status:      agg.Health.String(),
+		Readiness:   agg.Readiness.String(),
+		NotReady:    agg.NotReady,
+		GeneratedAt: agg.GeneratedAt,
+		Modules:     []HealthModuleNode{},
+	}
+
+	byModule := make(map[string][]HealthReport)
+	for _, report := range agg.Reports {
+		byModule[report.Module] = append(byModule[report.Module], report)
+	}
+
+	names := make([]string, 0, len(byModule))
+	for name := range byModule {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		reports := byModule[name]
+		sort.SliceStable(reports, func(i, j int) bool { return reports[i].Component < reports[j].Component })
+
+		node := HealthModuleNode{Name: name, Components: make([]HealthComponentNode, 0, len(reports))}
+		moduleStatus := StatusHealthy
+		for _, r := range reports {
+			moduleStatus = worstStatus(moduleStatus, r.Status)
+			node.Components = append(node.Components, HealthComponentNode{
+				Name:          r.Component,
+				Status:        r.Status.String(),
+				Message:       r.Message,
+				CheckedAt:     r.CheckedAt,
+				ObservedSince: r.ObservedSince,
+				LatencyMs:     float64(r.Latency) / float64(time.Millisecond),
+				Optional:      r.Optional,
+				Details:       r.Details,
+			})
+		}
+		node.Status = moduleStatus.String()
+		tree.Modules = append(tree.Modules, node)
+	}
+
+	return tree
+}
+
+// healthTreeHTML renders a HealthTree as a simple collapsible HTML page.
+var healthTreeHTML = template.Must(template.New("health").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Health: {{.Status}}</title>
+<style>
+body{font-family:sans-serif;margin:2em}
+.healthy{color:#2e7d32}.degraded{color:#ef6c00}.unhealthy,.unknown{color:#c62828}
+table{border-collapse:collapse;margin:.5em 0 1em 1.5em}
+td,th{border:1px solid #ddd;padding:.25em .5em;text-align:left;vertical-align:top}
+</style></head>
+<body>
+<h1>Health: <span class="{{.Status}}">{{.Status}}</span> (readiness: <span class="{{.Readiness}}">{{.Readiness}}</span>)</h1>
+<p>Generated at {{.GeneratedAt.Format "2006-01-02T15:04:05Z07:00"}}</p>
+{{if .NotReady}}<p>Not ready:</p><ul>{{range $name, $reason := .NotReady}}<li>{{$name}}: {{$reason}}</li>{{end}}</ul>
+{{end}}{{range .Modules}}<details open>
+<summary><strong>{{.Name}}</strong>: <span class="{{.Status}}">{{.Status}}</span></summary>
+<table>
+<tr><th>Component</th><th>Status</th><th>Message</th><th>Latency (ms)</th><th>Observed since</th><th>Details</th></tr>
+{{range .Components}}<tr>
+<td>{{.Name}}{{if .Optional}} (optional){{end}}</td>
+<td class="{{.Status}}">{{.Status}}</td>
+<td>{{.Message}}</td>
+<td>{{printf "%.2f" .LatencyMs}}</td>
+<td>{{.ObservedSince.Format "2006-01-02T15:04:05Z07:00"}}</td>
+<td>{{range $k, $v := .Details}}{{$k}}={{$v}}<br>{{end}}</td>
+</tr>{{end}}
+</table>
+</details>
+{{end}}</body>
+</html>
+`))
+
+// NewHealthTreeHandler returns an http.Handler that renders the full health
+// tree of the given service. JSON is returned by default; HTML is returned when
+// the request has "?format=html" or prefers text/html via the Accept header.
+// The response status is 503 when overall health is unhealthy, 200 otherwise.
+func NewHealthTreeHandler(svc *AggregateHealthService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if svc == nil {
+			http.Error(w, ErrHealthServiceNil.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ctx := r.Context()
+		if r.URL.Query().Get("refresh") == "true" {
+			ctx = context.WithValue(ctx, ForceHealthRefreshKey, true)
+		}
+
+		agg, err := svc.Check(ctx)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("health check failed: %v", err), http.StatusServiceUnavailable)
+			return
+		}
+
+		tree := BuildHealthTree(agg)
+		statusCode := http.StatusOK
+		if agg.Health == StatusUnhealthy {
+			statusCode = http.StatusServiceUnavailable
+		}
+
+		if wantsHTML(r) {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.WriteHeader(statusCode)
+			_ = healthTreeHTML.Execute(w, tree)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_ = json.NewEncoder(w).Encode(tree)
+	})
+}
+
+// wantsHTML reports whether the request asked for an HTML rendering.
+func wantsHTML(r *http.Request) bool {
+	if format := r.URL.Query().Get("format"); format != "" {
+		return format == "html"
+	}
+	accept := r.Header.Get("Accept")
+	return strings.Contains(accept, "text/html") && !strings.Contains(accept, "application/json")
+}
+
+// HealthRouter is the minimal routing interface required by HealthTreeModule.
+// It is satisfied by the "router" service provided by the chimux module.
+type HealthRouter interface {
+	Handle(pattern string, handler http.Handler)
+}
+
+// HealthTreeModule registers the health tree handler on the application's
+// "router" service, exposing the aggregator's component tree over HTTP.
+//
+// Example:
+//
+//	healthSvc := modular.NewAggregateHealthService()
+//	app.RegisterModule(chimux.NewChiMuxModule())
+//	app.RegisterModule(modular.NewHealthTreeModule(healthSvc, "/health/tree"))
+type HealthTreeModule struct {
+	svc  *AggregateHealthService
+	path string
+}
+
+// NewHealthTreeModule creates a HealthTreeModule serving the tree of svc at path.
+// If path is empty, "/health/tree" is used.
+func NewHealthTreeModule(svc *AggregateHealthService, path string) *HealthTreeModule {
+	if path == "" {
+		path = "/health/tree"
+	}
+	return &HealthTreeModule{svc: svc, path: path}
+}
+
+// Name returns the module name.
+func (m *HealthTreeModule) Name() string {
+	return "health-tree"
+}
+
+// Init registers the handler with the router service.
+func (m *HealthTreeModule) Init(app Application) error {
+	if m.svc == nil {
+		return ErrHealthServiceNil
+	}
+	var router HealthRouter
+	if err := app.GetService("router", &router); err != nil {
+		return fmt.Errorf("health tree module: %w", err)
+	}
+	router.Handle(m.path, NewHealthTreeHandler(m.svc))
+	return nil
+}
+
+// ProvidesServices returns no services.
+func (m *HealthTreeModule) ProvidesServices() []ServiceProvider {
+	return nil
+}
+
+// RequiresServices declares the router dependency so the module initializes after it.
+func (m *HealthTreeModule) RequiresServices() []ServiceDependency {
+	return []ServiceDependency{{
+		Name:               "router",
+		Required:           true,
+		SatisfiesInterface: reflect.TypeOf((*HealthRouter)(nil)).Elem(),
+	}}
+}
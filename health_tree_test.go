@@ -0,0 +1,196 @@
+package modular
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newDatabaseHealthService() *AggregateHealthService {
+	svc := NewAggregateHealthService(WithCacheTTL(0))
+	svc.AddProvider("database", NewStaticHealthProvider(
+		HealthReport{
+			Module:    "database",
+			Component: "connectivity",
+			Status:    StatusHealthy,
+			Message:   "ping ok",
+			Details:   map[string]any{"driver": "postgres"},
+		},
+		HealthReport{
+			Module:    "database",
+			Component: "pool",
+			Status:    StatusDegraded,
+			Message:   "pool nearly exhausted",
+			Details:   map[string]any{"open_connections": 19, "max_open": 20},
+		},
+	))
+	svc.AddProvider("cache", NewSimpleHealthProvider("cache", "redis", func(_ context.Context) (HealthStatus, string, error) {
+		time.Sleep(2 * time.Millisecond)
+		return StatusHealthy, "connected", nil
+	}))
+	return svc
+}
+
+func TestBuildHealthTree_DatabaseComponents(t *testing.T) {
+	svc := newDatabaseHealthService()
+	agg, err := svc.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tree := BuildHealthTree(agg)
+	if tree.Status != "degraded" {
+		t.Errorf("expected overall degraded, got %s", tree.Status)
+	}
+	if len(tree.Modules) != 2 || tree.Modules[0].Name != "cache" || tree.Modules[1].Name != "database" {
+		t.Fatalf("expected sorted modules [cache database], got %+v", tree.Modules)
+	}
+
+	db := tree.Modules[1]
+	if db.Status != "degraded" {
+		t.Errorf("expected database module degraded, got %s", db.Status)
+	}
+	if len(db.Components) != 2 {
+		t.Fatalf("expected 2 database components, got %d", len(db.Components))
+	}
+
+	conn, pool := db.Components[0], db.Components[1]
+	if conn.Name != "connectivity" || conn.Status != "healthy" || conn.Message != "ping ok" {
+		t.Errorf("unexpected connectivity component: %+v", conn)
+	}
+	if conn.Details["driver"] != "postgres" {
+		t.Errorf("expected connectivity details to include driver, got %v", conn.Details)
+	}
+	if pool.Name != "pool" || pool.Status != "degraded" {
+		t.Errorf("unexpected pool component: %+v", pool)
+	}
+	if pool.Details["open_connections"] != 19 || pool.Details["max_open"] != 20 {
+		t.Errorf("expected pool details, got %v", pool.Details)
+	}
+	if pool.ObservedSince.IsZero() || pool.CheckedAt.IsZero() {
+		t.Error("expected ObservedSince and CheckedAt to be set")
+	}
+
+	cache := tree.Modules[0].Components[0]
+	if cache.LatencyMs <= 0 {
+		t.Errorf("expected cache latency to be recorded, got %v", cache.LatencyMs)
+	}
+}
+
+func TestAggregateHealthService_ObservedSince(t *testing.T) {
+	status := StatusHealthy
+	svc := NewAggregateHealthService(WithCacheTTL(0))
+	svc.AddProvider("db", NewSimpleHealthProvider("db", "conn", func(_ context.Context) (HealthStatus, string, error) {
+		return status, "", nil
+	}))
+
+	first, _ := svc.Check(context.Background())
+	time.Sleep(5 * time.Millisecond)
+	second, _ := svc.Check(context.Background())
+	if !second.Reports[0].ObservedSince.Equal(first.Reports[0].ObservedSince) {
+		t.Error("ObservedSince should not change while status is unchanged")
+	}
+
+	status = StatusUnhealthy
+	third, _ := svc.Check(context.Background())
+	if !third.Reports[0].ObservedSince.After(first.Reports[0].ObservedSince) {
+		t.Error("ObservedSince should reset when status changes")
+	}
+}
+
+func TestHealthTreeHandler_JSON(t *testing.T) {
+	handler := NewHealthTreeHandler(newDatabaseHealthService())
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/tree", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("expected JSON content type, got %q", ct)
+	}
+
+	var tree HealthTree
+	if err := json.Unmarshal(rec.Body.Bytes(), &tree); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	var found bool
+	for _, m := range tree.Modules {
+		if m.Name != "database" {
+			continue
+		}
+		found = true
+		if len(m.Components) != 2 || m.Components[1].Details["max_open"] != float64(20) {
+			t.Errorf("unexpected database node: %+v", m)
+		}
+	}
+	if !found {
+		t.Error("expected database module in tree")
+	}
+}
+
+func TestHealthTreeHandler_HTMLAndStatus(t *testing.T) {
+	svc := NewAggregateHealthService(WithCacheTTL(0))
+	svc.AddProvider("database", NewStaticHealthProvider(HealthReport{
+		Module: "database", Component: "connectivity", Status: StatusUnhealthy, Message: "connection refused",
+	}))
+	handler := NewHealthTreeHandler(svc)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/tree?format=html", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 for unhealthy tree, got %d", rec.Code)
+	}
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/html") {
+		t.Errorf("expected HTML content type, got %q", rec.Header().Get("Content-Type"))
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "database") || !strings.Contains(body, "connection refused") {
+		t.Errorf("expected HTML to include module and message, got %s", body)
+	}
+}
+
+type healthTestRouter struct {
+	routes map[string]http.Handler
+}
+
+func (r *healthTestRouter) Handle(pattern string, handler http.Handler) {
+	r.routes[pattern] = handler
+}
+
+type healthTestRouterModule struct {
+	router *healthTestRouter
+}
+
+func (m *healthTestRouterModule) Name() string             { return "test-router" }
+func (m *healthTestRouterModule) Init(_ Application) error { return nil }
+func (m *healthTestRouterModule) ProvidesServices() []ServiceProvider {
+	return []ServiceProvider{{Name: "router", Instance: m.router}}
+}
+func (m *healthTestRouterModule) RequiresServices() []ServiceDependency { return nil }
+
+func TestHealthTreeModule_RegistersHandler(t *testing.T) {
+	router := &healthTestRouter{routes: make(map[string]http.Handler)}
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &logger{t})
+	app.RegisterModule(NewHealthTreeModule(newDatabaseHealthService(), ""))
+	app.RegisterModule(&healthTestRouterModule{router: router})
+
+	if err := app.Init(); err != nil {
+		t.Fatalf("init failed: %v", err)
+	}
+	if _, ok := router.routes["/health/tree"]; !ok {
+		t.Fatalf("expected handler registered at /health/tree, got %v", router.routes)
+	}
+}
+
+func TestHealthTreeModule_NilService(t *testing.T) {
+	if err := NewHealthTreeModule(nil, "").Init(nil); err != ErrHealthServiceNil {
+		t.Errorf("expected ErrHealthServiceNil, got %v", err)
+	}
+}
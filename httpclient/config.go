@@ -10,6 +10,12 @@ import (
 var (
 	// ErrLogFilePathRequired is returned when log_to_file is enabled but log_file_path is not specified
 	ErrLogFilePathRequired = errors.New("log_file_path must be specified when log_to_file is enabled")
+	// ErrInvalidHostPattern is returned when a host_overrides key is not a valid host pattern
+	ErrInvalidHostPattern = errors.New("invalid host pattern")
+	// ErrInvalidRecordingMode is returned when recording.mode is neither "record" nor "replay"
+	ErrInvalidRecordingMode = errors.New("recording mode must be \"record\" or \"replay\"")
+	// ErrRecordingFixtureRequired is returned when a recording mode is set without recording.fixture_path
+	ErrRecordingFixtureRequired = errors.New("recording.fixture_path must be specified when recording.mode is set")
 )
 
 // Config defines the configuration for the HTTP client module.
@@ -26,6 +32,7 @@ var (
 //	idle_conn_timeout: 120
 //	request_timeout: 60
 //	tls_timeout: 15
+//	dial_timeout: 5
 //	disable_compression: false
 //	disable_keep_alives: false
 //	verbose: true
@@ -35,6 +42,18 @@ var (
 //	  max_body_log_size: 1024
 //	  log_to_file: true
 //	  log_file_path: "/var/log/httpclient"
+//	  max_logged_body_bytes: 4096
+//	  redact_headers: ["X-Session-Id"]
+//	  redact_body_fields: ["ssn", "card_number"]
+//	host_overrides:
+//	  "reports.internal:8443":
+//	    response_header_timeout: 120
+//	  "*.cdn.example.com":
+//	    max_idle_conns_per_host: 50
+//	    dial_timeout: 2
+//	recording:
+//	  mode: replay
+//	  fixture_path: "testdata/api.json"
 //
 // Example environment variables:
 //
@@ -72,6 +91,15 @@ type Config struct {
 	// Default: 10 seconds
 	TLSTimeout time.Duration `yaml:"tls_timeout" json:"tls_timeout" env:"TLS_TIMEOUT"`
 
+	// DialTimeout is the maximum time waiting for a TCP connection to be established.
+	// Default: 0 (no timeout beyond RequestTimeout)
+	DialTimeout time.Duration `yaml:"dial_timeout" json:"dial_timeout" env:"DIAL_TIMEOUT"`
+
+	// ResponseHeaderTimeout is the maximum time waiting for the response headers
+	// after the request has been written. It does not include reading the body.
+	// Default: 0 (no timeout beyond RequestTimeout)
+	ResponseHeaderTimeout time.Duration `yaml:"response_header_timeout" json:"response_header_timeout" env:"RESPONSE_HEADER_TIMEOUT"`
+
 	// DisableCompression disables decompressing response bodies.
 	// When false (default), the client automatically handles gzip compression.
 	// Set to true if you need to handle compression manually or want raw responses.
@@ -93,6 +121,63 @@ type Config struct {
 	// VerboseOptions configures the behavior when Verbose is enabled.
 	// This allows fine-grained control over what gets logged and where.
 	VerboseOptions *VerboseOptions `yaml:"verbose_options" json:"verbose_options" env:"VERBOSE_OPTIONS"`
+
+	// HostOverrides tunes the connection pool and timeouts for specific hosts,
+	// keyed by host pattern. A pattern is a host name such as "api.example.com",
+	// optionally with a port ("localhost:8080"), and may use path.Match
+	// wildcards ("*.example.com"). Patterns with a port only match requests to
+	// that port. When several patterns match, an exact host wins over a wildcard
+	// and a longer pattern over a shorter one. Unset fields fall back to the
+	// module-wide settings.
+	HostOverrides map[string]HostOverride `yaml:"host_overrides" json:"host_overrides"`
+
+	// Recording switches the client to recording real responses to a fixture
+	// file, or to replaying them without network access, for tests.
+	// Default: nil (requests go to the network)
+	Recording *RecordingConfig `yaml:"recording" json:"recording"`
+}
+
+// RecordingConfig selects the record/replay transport used in tests.
+type RecordingConfig struct {
+	// Mode is RecordingModeRecord ("record") to record responses to
+	// FixturePath, RecordingModeReplay ("replay") to serve them from it, or
+	// empty to disable recording.
+	Mode string `yaml:"mode" json:"mode" env:"RECORDING_MODE"`
+
+	// FixturePath is the JSON fixture file holding the recorded responses.
+	FixturePath string `yaml:"fixture_path" json:"fixture_path" env:"RECORDING_FIXTURE_PATH"`
+
+	// MatchBody also matches requests by a hash of their body, not only by
+	// method and URL. It must be the same when recording and replaying.
+	// Default: false
+	MatchBody bool `yaml:"match_body" json:"match_body" env:"RECORDING_MATCH_BODY"`
+
+	// RedactHeaders lists additional response headers whose values are
+	// recorded as "***". Set-Cookie, Authorization and the other headers
+	// redacted in logs (see VerboseOptions.RedactHeaders) always are.
+	RedactHeaders []string `yaml:"redact_headers" json:"redact_headers"`
+
+	// RedactQueryParams lists additional query parameters whose values are
+	// recorded as "***". Parameters whose names contain password, secret,
+	// token, api key, authorization or credential always are. It must be the
+	// same when recording and replaying.
+	RedactQueryParams []string `yaml:"redact_query_params" json:"redact_query_params"`
+}
+
+// HostOverride holds the per-host transport settings of a HostOverrides entry.
+// Zero values fall back to the corresponding module-wide setting.
+type HostOverride struct {
+	// MaxIdleConnsPerHost overrides Config.MaxIdleConnsPerHost.
+	MaxIdleConnsPerHost int `yaml:"max_idle_conns_per_host" json:"max_idle_conns_per_host"`
+
+	// DialTimeout overrides Config.DialTimeout.
+	DialTimeout time.Duration `yaml:"dial_timeout" json:"dial_timeout"`
+
+	// ResponseHeaderTimeout overrides Config.ResponseHeaderTimeout.
+	ResponseHeaderTimeout time.Duration `yaml:"response_header_timeout" json:"response_header_timeout"`
+
+	// TLSHandshakeTimeout overrides Config.TLSTimeout.
+	TLSHandshakeTimeout time.Duration `yaml:"tls_handshake_timeout" json:"tls_handshake_timeout"`
 }
 
 // VerboseOptions configures the behavior of verbose logging.
@@ -130,6 +215,25 @@ type VerboseOptions struct {
 	// The directory must be writable by the application.
 	// Default: "" (current directory)
 	LogFilePath string `yaml:"log_file_path" json:"log_file_path" env:"LOG_FILE_PATH"`
+
+	// MaxLoggedBodyBytes limits how many bytes of each request and response
+	// body are captured for logging, in both the application log and log files.
+	// Longer bodies are logged up to the limit with a truncation notice, and
+	// the remainder is streamed to the server or caller without being buffered.
+	// A negative value captures whole bodies.
+	// Default: 65536 (64KB) when LogBody is enabled
+	MaxLoggedBodyBytes int `yaml:"max_logged_body_bytes" json:"max_logged_body_bytes" env:"MAX_LOGGED_BODY_BYTES"`
+
+	// RedactHeaders lists additional header names whose values are replaced
+	// with "***" in logs. Authorization, Cookie, Set-Cookie and headers whose
+	// names contain token, secret, password or api key are always redacted.
+	RedactHeaders []string `yaml:"redact_headers" json:"redact_headers"`
+
+	// RedactBodyFields lists additional JSON keys and form fields whose values
+	// are replaced with "***" in logged bodies. Fields whose names contain
+	// password, secret, token, api key, authorization or credential are always
+	// redacted.
+	RedactBodyFields []string `yaml:"redact_body_fields" json:"redact_body_fields"`
 }
 
 // Validate checks the configuration values and sets sensible defaults.
@@ -156,6 +260,21 @@ func (c *Config) Validate() error {
 		c.TLSTimeout = 10 * time.Second
 	}
 
+	for pattern := range c.HostOverrides {
+		if err := validateHostPattern(pattern); err != nil {
+			return fmt.Errorf("config validation error: host_overrides: %w", err)
+		}
+	}
+
+	if c.Recording != nil && c.Recording.Mode != "" {
+		if c.Recording.Mode != RecordingModeRecord && c.Recording.Mode != RecordingModeReplay {
+			return fmt.Errorf("config validation error: %w: %q", ErrInvalidRecordingMode, c.Recording.Mode)
+		}
+		if c.Recording.FixturePath == "" {
+			return fmt.Errorf("config validation error: %w", ErrRecordingFixtureRequired)
+		}
+	}
+
 	// Initialize verbose options if needed
 	if c.Verbose && c.VerboseOptions == nil {
 		c.VerboseOptions = &VerboseOptions{
@@ -173,6 +292,12 @@ func (c *Config) Validate() error {
 		c.VerboseOptions.MaxBodyLogSize = 1024 // 1KB default cap
 	}
 
+	// Cap the captured body size by default so that large payloads do not
+	// blow up log files
+	if c.Verbose && c.VerboseOptions != nil && c.VerboseOptions.LogBody && c.VerboseOptions.MaxLoggedBodyBytes == 0 {
+		c.VerboseOptions.MaxLoggedBodyBytes = defaultMaxLoggedBodyBytes
+	}
+
 	// Validate verbose log file path if logging to file is enabled
 	if c.Verbose && c.VerboseOptions != nil && c.VerboseOptions.LogToFile && c.VerboseOptions.LogFilePath == "" {
 		return fmt.Errorf("config validation error: %w", ErrLogFilePathRequired)
@@ -10,4 +10,6 @@ var (
 	ErrNoSubjectForEventEmission = errors.New("no subject available for event emission")
 	// ErrUnsafeFilename is returned when a URL cannot be safely converted to a filename
 	ErrUnsafeFilename = errors.New("URL contains no valid characters for filename after sanitization")
+	// ErrNoRecordedResponse is returned by a ReplayTransport for a request that was not recorded
+	ErrNoRecordedResponse = errors.New("no recorded response for request")
 )
@@ -0,0 +1,128 @@
+package httpclient
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+)
+
+// hostRoute is the transport used for the hosts matching pattern.
+type hostRoute struct {
+	pattern   string
+	transport *http.Transport
+}
+
+// hostTransport sends each request through the transport of the first route
+// whose pattern matches the request host, or the default transport. Routes are
+// ordered from the most to the least specific pattern.
+type hostTransport struct {
+	defaultTransport *http.Transport
+	routes           []hostRoute
+}
+
+// newHostTransport builds the default transport from config and one transport
+// per HostOverrides entry.
+func newHostTransport(config *Config) *hostTransport {
+	t := &hostTransport{defaultTransport: newTransport(config, HostOverride{})}
+	for pattern, override := range config.HostOverrides {
+		t.routes = append(t.routes, hostRoute{pattern: pattern, transport: newTransport(config, override)})
+	}
+	sort.Slice(t.routes, func(i, j int) bool {
+		return moreSpecificHostPattern(t.routes[i].pattern, t.routes[j].pattern)
+	})
+	return t
+}
+
+// newTransport creates a transport from the module-wide settings with the
+// non-zero fields of override applied.
+func newTransport(config *Config, override HostOverride) *http.Transport {
+	maxIdleConnsPerHost := config.MaxIdleConnsPerHost
+	if override.MaxIdleConnsPerHost > 0 {
+		maxIdleConnsPerHost = override.MaxIdleConnsPerHost
+	}
+	dialTimeout := config.DialTimeout
+	if override.DialTimeout > 0 {
+		dialTimeout = override.DialTimeout
+	}
+	responseHeaderTimeout := config.ResponseHeaderTimeout
+	if override.ResponseHeaderTimeout > 0 {
+		responseHeaderTimeout = override.ResponseHeaderTimeout
+	}
+	tlsHandshakeTimeout := config.TLSTimeout
+	if override.TLSHandshakeTimeout > 0 {
+		tlsHandshakeTimeout = override.TLSHandshakeTimeout
+	}
+
+	return &http.Transport{
+		DialContext:           (&net.Dialer{Timeout: dialTimeout}).DialContext,
+		MaxIdleConns:          config.MaxIdleConns,
+		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+		IdleConnTimeout:       config.IdleConnTimeout,
+		TLSHandshakeTimeout:   tlsHandshakeTimeout,
+		ResponseHeaderTimeout: responseHeaderTimeout,
+		DisableCompression:    config.DisableCompression,
+		DisableKeepAlives:     config.DisableKeepAlives,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *hostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.transportFor(req.URL.Host).RoundTrip(req) //nolint:wrapcheck // transport errors are returned as is, like http.Transport's
+}
+
+// transportFor returns the transport for a request to host, which may include a port.
+func (t *hostTransport) transportFor(host string) *http.Transport {
+	for _, route := range t.routes {
+		if matchesHostPattern(route.pattern, host) {
+			return route.transport
+		}
+	}
+	return t.defaultTransport
+}
+
+// CloseIdleConnections closes the idle connections of every transport.
+func (t *hostTransport) CloseIdleConnections() {
+	t.defaultTransport.CloseIdleConnections()
+	for _, route := range t.routes {
+		route.transport.CloseIdleConnections()
+	}
+}
+
+// validateHostPattern reports whether pattern is usable as a HostOverrides key.
+func validateHostPattern(pattern string) error {
+	if pattern == "" || strings.Contains(pattern, "/") {
+		return fmt.Errorf("%w: %q", ErrInvalidHostPattern, pattern)
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("%w: %q: %w", ErrInvalidHostPattern, pattern, err)
+	}
+	return nil
+}
+
+// matchesHostPattern reports whether host, which may include a port, matches
+// pattern. Patterns without a port match the host name on any port.
+func matchesHostPattern(pattern, host string) bool {
+	if !strings.Contains(pattern, ":") {
+		if name, _, err := net.SplitHostPort(host); err == nil {
+			host = name
+		}
+	}
+	matched, _ := path.Match(strings.ToLower(pattern), strings.ToLower(host))
+	return matched
+}
+
+// moreSpecificHostPattern orders patterns so that exact hosts come before
+// wildcards and longer patterns before shorter ones.
+func moreSpecificHostPattern(a, b string) bool {
+	aWildcard, bWildcard := strings.ContainsAny(a, "*?["), strings.ContainsAny(b, "*?[")
+	if aWildcard != bWildcard {
+		return !aWildcard
+	}
+	if len(a) != len(b) {
+		return len(a) > len(b)
+	}
+	return a < b
+}
@@ -0,0 +1,99 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_ValidateHostOverrides(t *testing.T) {
+	valid := &Config{HostOverrides: map[string]HostOverride{
+		"api.example.com": {}, "*.example.com": {}, "localhost:8080": {},
+	}}
+	require.NoError(t, valid.Validate())
+
+	for _, pattern := range []string{"", "example.com/path", "[example.com"} {
+		invalid := &Config{HostOverrides: map[string]HostOverride{pattern: {}}}
+		require.ErrorIs(t, invalid.Validate(), ErrInvalidHostPattern, pattern)
+	}
+}
+
+func TestHostTransport_TransportFor(t *testing.T) {
+	config := &Config{
+		MaxIdleConnsPerHost:   10,
+		TLSTimeout:            10 * time.Second,
+		ResponseHeaderTimeout: time.Second,
+		HostOverrides: map[string]HostOverride{
+			"*.example.com":      {MaxIdleConnsPerHost: 50},
+			"api.example.com":    {ResponseHeaderTimeout: time.Minute},
+			"api.example.com:81": {TLSHandshakeTimeout: 30 * time.Second},
+		},
+	}
+	transport := newHostTransport(config)
+
+	defaults := transport.transportFor("other.org")
+	assert.Same(t, transport.defaultTransport, defaults)
+	assert.Equal(t, 10, defaults.MaxIdleConnsPerHost)
+	assert.Equal(t, time.Second, defaults.ResponseHeaderTimeout)
+
+	wildcard := transport.transportFor("cdn.example.com:443")
+	assert.Equal(t, 50, wildcard.MaxIdleConnsPerHost)
+	assert.Equal(t, time.Second, wildcard.ResponseHeaderTimeout, "unset fields fall back to the defaults")
+
+	// An exact host wins over the wildcard, on any port
+	exact := transport.transportFor("API.example.com:8443")
+	assert.Equal(t, time.Minute, exact.ResponseHeaderTimeout)
+	assert.Equal(t, 10, exact.MaxIdleConnsPerHost)
+
+	// A pattern with a port only matches that port
+	withPort := transport.transportFor("api.example.com:81")
+	assert.Equal(t, 30*time.Second, withPort.TLSHandshakeTimeout)
+	assert.Equal(t, time.Second, withPort.ResponseHeaderTimeout)
+}
+
+func TestHTTPClientModule_SlowHostOverride(t *testing.T) {
+	slowHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})
+	slow := httptest.NewServer(slowHandler)
+	defer slow.Close()
+	other := httptest.NewServer(slowHandler)
+	defer other.Close()
+
+	slowURL, err := url.Parse(slow.URL)
+	require.NoError(t, err)
+	module := newMiddlewareTestModuleWithConfig(t, &Config{
+		RequestTimeout:        5 * time.Second,
+		ResponseHeaderTimeout: 50 * time.Millisecond,
+		HostOverrides: map[string]HostOverride{
+			slowURL.Host: {ResponseHeaderTimeout: 2 * time.Second},
+		},
+	})
+
+	get := func(target string) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, target, nil)
+		require.NoError(t, err)
+		return module.Client().Do(req)
+	}
+
+	// The slow host gets the longer override
+	resp, err := get(slow.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Other hosts keep the default and time out waiting for the headers
+	resp, err = get(other.URL)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timeout awaiting response headers")
+}
@@ -0,0 +1,240 @@
+package httpclient
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// redactedValue replaces sensitive header values and body fields in logs.
+const redactedValue = "***"
+
+// defaultMaxLoggedBodyBytes is the body capture limit applied by Validate when
+// body logging is enabled and MaxLoggedBodyBytes is not set.
+const defaultMaxLoggedBodyBytes = 64 * 1024
+
+// sensitiveBodyFieldPatterns lists lowercase substrings that identify JSON keys
+// and form fields whose values must be redacted before logging.
+var sensitiveBodyFieldPatterns = []string{
+	"password", "passwd", "secret", "token", "apikey", "api_key", "authorization", "credential",
+}
+
+// jsonFieldPattern matches a JSON "key": value pair with a scalar value. It is
+// applied to the raw text so that truncated bodies are redacted too.
+var jsonFieldPattern = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"(\s*:\s*)("(?:[^"\\]|\\.)*"|[-0-9.eE+]+|true|false|null)`)
+
+// readCloser pairs a reader with the closer of the body it replaces.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// captureBody reads at most limit bytes of body for logging; limit <= 0 reads
+// it all. It returns the captured bytes, whether the body was longer, and a
+// replacement body that still yields the full content, so that only the
+// logged prefix is ever buffered.
+func captureBody(body io.ReadCloser, limit int) ([]byte, bool, io.ReadCloser, error) {
+	reader := io.Reader(body)
+	if limit > 0 {
+		reader = io.LimitReader(body, int64(limit)+1)
+	}
+	captured, err := io.ReadAll(reader)
+	restored := &readCloser{Reader: io.MultiReader(bytes.NewReader(captured), body), Closer: body}
+	if err != nil {
+		return nil, false, restored, fmt.Errorf("reading body for logging: %w", err)
+	}
+	if limit > 0 && len(captured) > limit {
+		return captured[:limit], true, restored, nil
+	}
+	return captured, false, restored, nil
+}
+
+// appendCapturedBody appends a captured body to a dump, noting a truncation.
+func appendCapturedBody(dump, body []byte, truncated bool, limit int) []byte {
+	dump = append(dump, body...)
+	if truncated {
+		dump = append(dump, fmt.Sprintf("\r\n[body truncated: logged first %d bytes]", limit)...)
+	}
+	return dump
+}
+
+// dumpRequest dumps req for logging, capturing at most MaxLoggedBodyBytes of
+// its body when LogBody is enabled.
+func (t *loggingTransport) dumpRequest(req *http.Request) ([]byte, error) {
+	if !t.LogBody || req.Body == nil || req.Body == http.NoBody {
+		dump, err := httputil.DumpRequestOut(req, false)
+		if err != nil {
+			return nil, fmt.Errorf("dumping request: %w", err)
+		}
+		return dump, nil
+	}
+
+	body, truncated, restored, err := captureBody(req.Body, t.MaxLoggedBodyBytes)
+	req.Body = restored
+	if err != nil {
+		return nil, err
+	}
+	dump, err := httputil.DumpRequestOut(req, false)
+	if err != nil {
+		return nil, fmt.Errorf("dumping request: %w", err)
+	}
+	return appendCapturedBody(dump, body, truncated, t.MaxLoggedBodyBytes), nil
+}
+
+// isRedactedHeader reports whether a header's value must be redacted, either
+// by the built-in patterns or because it is listed in extra.
+func isRedactedHeader(name string, extra []string) bool {
+	if isSensitiveHeader(name) {
+		return true
+	}
+	for _, header := range extra {
+		if strings.EqualFold(header, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRedactedField reports whether a JSON key, form field or query parameter
+// must be redacted, either by the built-in patterns or because it is listed
+// in extra.
+func isRedactedField(name string, extra []string) bool {
+	lower := strings.ToLower(name)
+	for _, pat := range sensitiveBodyFieldPatterns {
+		if strings.Contains(lower, pat) {
+			return true
+		}
+	}
+	for _, field := range extra {
+		if strings.EqualFold(field, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSensitiveHeader reports whether a header's value must be redacted in logs.
+func (t *loggingTransport) isSensitiveHeader(name string) bool {
+	return isRedactedHeader(name, t.RedactHeaders)
+}
+
+// isSensitiveBodyField reports whether a JSON key or form field must be
+// redacted in logged bodies.
+func (t *loggingTransport) isSensitiveBodyField(name string) bool {
+	return isRedactedField(name, t.RedactBodyFields)
+}
+
+// redactHeaders returns a new map with sensitive header values replaced by "***".
+func (t *loggingTransport) redactHeaders(headers map[string]string) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for k, v := range headers {
+		if t.isSensitiveHeader(k) {
+			redacted[k] = redactedValue
+		} else {
+			redacted[k] = v
+		}
+	}
+	return redacted
+}
+
+// redactDump scrubs a raw HTTP request/response dump (as produced by
+// httputil.DumpRequestOut / DumpResponse) before it is logged or written to a
+// file. In the header section, everything up to the first blank line, the
+// values of sensitive headers are replaced with "***" while the header names
+// are kept. In the body, the values of sensitive JSON keys, and of sensitive
+// form fields for form-encoded bodies, are replaced with "***".
+func (t *loggingTransport) redactDump(dump string) string {
+	if dump == "" {
+		return dump
+	}
+
+	// Preserve the original line endings (dumps use CRLF). Split on "\n" and
+	// strip a trailing "\r" per line so we can match, then re-attach it.
+	lines := strings.Split(dump, "\n")
+	contentType := ""
+	bodyStart := len(lines)
+	for i, line := range lines {
+		// Stop at the blank line separating headers from the body. A blank line
+		// is "" or just "\r".
+		trimmed := strings.TrimRight(line, "\r")
+		if trimmed == "" {
+			bodyStart = i + 1
+			break
+		}
+
+		colon := strings.Index(trimmed, ":")
+		if colon <= 0 {
+			// Request/status line (e.g. "GET / HTTP/1.1") has no leading "Name:".
+			continue
+		}
+
+		name := trimmed[:colon]
+		if strings.EqualFold(name, "Content-Type") {
+			contentType = strings.TrimSpace(trimmed[colon+1:])
+		}
+		if !t.isSensitiveHeader(name) {
+			continue
+		}
+
+		// Rebuild as "Name: ***", preserving the original CRLF if present.
+		suffix := ""
+		if strings.HasSuffix(line, "\r") {
+			suffix = "\r"
+		}
+		lines[i] = name + ": " + redactedValue + suffix
+	}
+
+	if bodyStart < len(lines) {
+		body := t.redactBody(strings.Join(lines[bodyStart:], "\n"), contentType)
+		lines = append(lines[:bodyStart], body)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// redactBody replaces the values of sensitive JSON keys, and of sensitive form
+// fields when contentType is form-encoded.
+func (t *loggingTransport) redactBody(body, contentType string) string {
+	if body == "" {
+		return body
+	}
+	if strings.HasPrefix(strings.ToLower(contentType), "application/x-www-form-urlencoded") {
+		return t.redactFormBody(body)
+	}
+	return jsonFieldPattern.ReplaceAllStringFunc(body, func(pair string) string {
+		match := jsonFieldPattern.FindStringSubmatch(pair)
+		if !t.isSensitiveBodyField(match[1]) {
+			return pair
+		}
+		return `"` + match[1] + `"` + match[2] + `"` + redactedValue + `"`
+	})
+}
+
+// redactFormBody replaces the values of sensitive fields of a form-encoded body.
+func (t *loggingTransport) redactFormBody(body string) string {
+	return redactFormFields(body, t.isSensitiveBodyField)
+}
+
+// redactFormFields replaces the values of the fields of a form-encoded body or
+// URL query for which isSensitive returns true, keeping the field order.
+func redactFormFields(body string, isSensitive func(name string) bool) string {
+	pairs := strings.Split(body, "&")
+	for i, pair := range pairs {
+		key, _, found := strings.Cut(pair, "=")
+		if !found {
+			continue
+		}
+		name, err := url.QueryUnescape(key)
+		if err != nil {
+			name = key
+		}
+		if isSensitive(name) {
+			pairs[i] = key + "=" + redactedValue
+		}
+	}
+	return strings.Join(pairs, "&")
+}
@@ -0,0 +1,177 @@
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// logDetails returns the "details" of the first log entry whose message contains message.
+func logDetails(t *testing.T, logger *TestLogger, message string) string {
+	t.Helper()
+	for _, entry := range logger.GetEntries() {
+		if strings.Contains(entry.Message, message) {
+			return fmt.Sprintf("%v", entry.KeyVals["details"])
+		}
+	}
+	t.Fatalf("no %q log entry", message)
+	return ""
+}
+
+func TestLoggingTransport_MaxLoggedBodyBytes(t *testing.T) {
+	requestBody := strings.Repeat("q", 1000)
+	responseBody := strings.Repeat("r", 1000)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ := io.ReadAll(r.Body)
+		assert.Equal(t, requestBody, string(received), "the server must receive the full request body")
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(responseBody))
+	}))
+	defer server.Close()
+
+	testLogger := &TestLogger{}
+	client := &http.Client{Transport: &loggingTransport{
+		Transport:          http.DefaultTransport,
+		Logger:             testLogger,
+		LogHeaders:         true,
+		LogBody:            true,
+		MaxLoggedBodyBytes: 16,
+	}}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, strings.NewReader(requestBody))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	received, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, responseBody, string(received), "the caller must receive the full response body")
+
+	reqDetails := logDetails(t, testLogger, "Outgoing request")
+	assert.Contains(t, reqDetails, strings.Repeat("q", 16)+"\r\n[body truncated: logged first 16 bytes]")
+	assert.NotContains(t, reqDetails, strings.Repeat("q", 17))
+
+	respDetails := logDetails(t, testLogger, "Received response")
+	assert.Contains(t, respDetails, strings.Repeat("r", 16)+"\r\n[body truncated: logged first 16 bytes]")
+	assert.NotContains(t, respDetails, strings.Repeat("r", 17))
+}
+
+func TestLoggingTransport_RedactsTransactionLogFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=resp-cookie-value")
+		_, _ = w.Write([]byte(`{"access_token": "resp-token-value", "user": "alice"}`))
+	}))
+	defer server.Close()
+
+	tmpDir := t.TempDir()
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	fileLogger, err := NewFileLogger(tmpDir, mockLogger)
+	require.NoError(t, err)
+
+	client := &http.Client{Transport: &loggingTransport{
+		Transport:        http.DefaultTransport,
+		Logger:           mockLogger,
+		FileLogger:       fileLogger,
+		LogHeaders:       true,
+		LogBody:          true,
+		LogToFile:        true,
+		RedactHeaders:    []string{"X-Session-Id"},
+		RedactBodyFields: []string{"ssn"},
+	}}
+
+	body := `{"name": "bob", "password": "req-password-value", "ssn": "123-45-6789", "age": 42}`
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer req-bearer-value")
+	req.Header.Set("Cookie", "session=req-cookie-value")
+	req.Header.Set("X-Session-Id", "req-session-value")
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	var logged strings.Builder
+	for _, dir := range []string{"requests", "responses", "transactions"} {
+		files, err := filepath.Glob(filepath.Join(tmpDir, dir, "*.log"))
+		require.NoError(t, err)
+		require.NotEmpty(t, files, dir)
+		for _, file := range files {
+			contents, err := os.ReadFile(file)
+			require.NoError(t, err)
+			logged.Write(contents)
+		}
+	}
+	output := logged.String()
+
+	for _, secret := range []string{
+		"req-bearer-value", "req-cookie-value", "req-session-value",
+		"req-password-value", "123-45-6789", "resp-cookie-value", "resp-token-value",
+	} {
+		assert.NotContains(t, output, secret)
+	}
+	assert.Contains(t, output, "Authorization: ***")
+	assert.Contains(t, output, "X-Session-Id: ***")
+	assert.Contains(t, output, `"password": "***"`)
+	assert.Contains(t, output, `"ssn": "***"`)
+	assert.Contains(t, output, `"access_token": "***"`)
+	// Other fields stay readable
+	assert.Contains(t, output, `"name": "bob"`)
+	assert.Contains(t, output, `"age": 42`)
+	assert.Contains(t, output, `"user": "alice"`)
+}
+
+func TestLoggingTransport_RedactBody(t *testing.T) {
+	transport := &loggingTransport{RedactBodyFields: []string{"Email"}}
+
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		want        string
+	}{
+		{
+			name: "json",
+			body: `{"user":{"email":"a@b.c","apiKey":"k1"},"count":1,"refresh_token":null}`,
+			want: `{"user":{"email":"***","apiKey":"***"},"count":1,"refresh_token":"***"}`,
+		},
+		{
+			name: "truncated json",
+			body: `{"password": "hunter2", "note": "unterminat`,
+			want: `{"password": "***", "note": "unterminat`,
+		},
+		{
+			name:        "form",
+			body:        "user=bob&password=hunter2&email=a%40b.c&client_secret=s",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			want:        "user=bob&password=***&email=***&client_secret=***",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, transport.redactBody(tt.body, tt.contentType))
+		})
+	}
+}
+
+func TestConfig_MaxLoggedBodyBytesDefault(t *testing.T) {
+	cfg := &Config{Verbose: true, VerboseOptions: &VerboseOptions{LogBody: true}}
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, defaultMaxLoggedBodyBytes, cfg.VerboseOptions.MaxLoggedBodyBytes)
+
+	cfg = &Config{Verbose: true, VerboseOptions: &VerboseOptions{LogBody: true, MaxLoggedBodyBytes: -1}}
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, -1, cfg.VerboseOptions.MaxLoggedBodyBytes, "a negative limit captures whole bodies")
+}
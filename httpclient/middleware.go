@@ -0,0 +1,128 @@
+package httpclient
+
+import (
+	"net/http"
+	"sync"
+)
+
+// Middleware wraps the round-tripper of the module's HTTP client. Middlewares
+// are the extension point for cross-cutting request handling such as
+// authentication, retries and tracing: each one receives the next round-tripper
+// in the pipeline and returns a round-tripper that calls it.
+//
+// Example retry middleware:
+//
+//	retry := func(next http.RoundTripper) http.RoundTripper {
+//	    return httpclient.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+//	        resp, err := next.RoundTrip(req)
+//	        if err == nil && resp.StatusCode == http.StatusServiceUnavailable {
+//	            resp.Body.Close()
+//	            return next.RoundTrip(req)
+//	        }
+//	        return resp, err
+//	    })
+//	}
+//	client.Use(retry)
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc adapts a function to the http.RoundTripper interface, which
+// is convenient when writing a Middleware.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Use appends middlewares to the client's round-tripper pipeline. Middlewares
+// run in the order they were added: the first one sees a request first and its
+// response last. The module's verbose logging is the innermost middleware, so
+// it logs every attempt as sent, including headers added and retries made by
+// the middlewares.
+//
+// Use may be called before or after Init. The middlewares apply to every
+// subsequent request of Client(), the registered *http.Client service and the
+// clients returned by WithTimeout.
+func (m *HTTPClientModule) Use(middlewares ...Middleware) {
+	if m.pipeline == nil {
+		m.pipeline = &middlewarePipeline{}
+	}
+	m.pipeline.use(middlewares...)
+}
+
+// loggingMiddleware returns the verbose request/response logging as a middleware.
+func (m *HTTPClientModule) loggingMiddleware() Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &loggingTransport{
+			Transport:      next,
+			Logger:         m.logger,
+			FileLogger:     m.fileLogger,
+			LogHeaders:     m.config.VerboseOptions.LogHeaders,
+			LogBody:        m.config.VerboseOptions.LogBody,
+			MaxBodyLogSize: m.config.VerboseOptions.MaxBodyLogSize,
+			LogToFile:      m.config.VerboseOptions.LogToFile && m.fileLogger != nil,
+
+			MaxLoggedBodyBytes: m.config.VerboseOptions.MaxLoggedBodyBytes,
+			RedactHeaders:      m.config.VerboseOptions.RedactHeaders,
+			RedactBodyFields:   m.config.VerboseOptions.RedactBodyFields,
+		}
+	}
+}
+
+// middlewarePipeline is the http.RoundTripper of the module's clients. It
+// composes the user middlewares and the module's built-in ones around the base
+// transport, rebuilding the chain whenever a middleware is added.
+type middlewarePipeline struct {
+	mu          sync.RWMutex
+	base        http.RoundTripper
+	builtin     []Middleware // innermost, set up by Init
+	middlewares []Middleware // added with Use, outermost first
+	chain       http.RoundTripper
+}
+
+// RoundTrip sends req through the current middleware chain.
+func (p *middlewarePipeline) RoundTrip(req *http.Request) (*http.Response, error) {
+	p.mu.RLock()
+	chain := p.chain
+	p.mu.RUnlock()
+	if chain == nil {
+		chain = http.DefaultTransport
+	}
+	return chain.RoundTrip(req) //nolint:wrapcheck // middlewares and the transport return their own errors
+}
+
+// init sets the base transport and the built-in middlewares.
+func (p *middlewarePipeline) init(base http.RoundTripper, builtin ...Middleware) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.base = base
+	p.builtin = builtin
+	p.build()
+}
+
+// use appends middlewares, skipping nil ones.
+func (p *middlewarePipeline) use(middlewares ...Middleware) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, middleware := range middlewares {
+		if middleware != nil {
+			p.middlewares = append(p.middlewares, middleware)
+		}
+	}
+	p.build()
+}
+
+// build composes the chain; the caller holds p.mu.
+func (p *middlewarePipeline) build() {
+	if p.base == nil {
+		return
+	}
+	chain := p.base
+	for i := len(p.builtin) - 1; i >= 0; i-- {
+		chain = p.builtin[i](chain)
+	}
+	for i := len(p.middlewares) - 1; i >= 0; i-- {
+		chain = p.middlewares[i](chain)
+	}
+	p.chain = chain
+}
@@ -0,0 +1,182 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newMiddlewareTestModule creates an initialized module, adding the
+// middlewares before Init.
+func newMiddlewareTestModule(t *testing.T, verbose bool, middlewares ...Middleware) *HTTPClientModule {
+	t.Helper()
+	return newMiddlewareTestModuleWithConfig(t, &Config{
+		RequestTimeout: 5 * time.Second,
+		Verbose:        verbose,
+		VerboseOptions: &VerboseOptions{LogHeaders: true},
+	}, middlewares...)
+}
+
+// newMiddlewareTestModuleWithConfig creates a module initialized with config,
+// adding the middlewares before Init.
+func newMiddlewareTestModuleWithConfig(t *testing.T, config *Config, middlewares ...Middleware) *HTTPClientModule {
+	t.Helper()
+	mockApp := new(MockApplication)
+	mockLogger := new(MockLogger)
+	mockConfigProvider := new(MockConfigProvider)
+	mockApp.On("Logger").Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Debug", mock.Anything, mock.Anything).Return()
+	mockApp.On("GetConfigSection", "httpclient").Return(mockConfigProvider, nil)
+	mockConfigProvider.On("GetConfig").Return(config)
+
+	module := NewHTTPClientModule().(*HTTPClientModule)
+	module.Use(middlewares...)
+	require.NoError(t, module.Init(mockApp))
+	return module
+}
+
+// recordingMiddleware appends "<name>:before" and "<name>:after" around the
+// next round-tripper.
+func recordingMiddleware(name string, mu *sync.Mutex, calls *[]string) Middleware {
+	record := func(call string) {
+		mu.Lock()
+		*calls = append(*calls, call)
+		mu.Unlock()
+	}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			record(name + ":before")
+			resp, err := next.RoundTrip(req)
+			record(name + ":after")
+			return resp, err
+		})
+	}
+}
+
+// retryOn503 re-sends a request once the server answers 503, up to attempts times.
+func retryOn503(attempts int) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.RoundTrip(req)
+			for i := 1; i < attempts && err == nil && resp.StatusCode == http.StatusServiceUnavailable; i++ {
+				_ = resp.Body.Close()
+				resp, err = next.RoundTrip(req)
+			}
+			return resp, err
+		})
+	}
+}
+
+func TestHTTPClientModule_UseMiddlewareOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	// Middlewares added before and after Init form one pipeline
+	module := newMiddlewareTestModule(t, false, recordingMiddleware("first", &mu, &calls))
+	module.Use(recordingMiddleware("second", &mu, &calls), nil, recordingMiddleware("third", &mu, &calls))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := module.Client().Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, []string{
+		"first:before", "second:before", "third:before",
+		"third:after", "second:after", "first:after",
+	}, calls)
+}
+
+func TestHTTPClientModule_UseRetryMiddleware(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	module := newMiddlewareTestModule(t, false)
+	module.Use(retryOn503(3))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := module.Client().Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), requests.Load(), "the 503 response should be retried once")
+}
+
+func TestClientService_Use(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// Dependent modules only see the ClientService interface
+	var service ClientService = newMiddlewareTestModule(t, false)
+	service.Use(retryOn503(3))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := service.Client().Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), requests.Load(), "middleware added through the service applies to its client")
+}
+
+func TestHTTPClientModule_UseAppliesToAllClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	module := newMiddlewareTestModule(t, true)
+	timeoutClient := module.WithTimeout(10)
+	module.Use(func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.Header.Set("Authorization", "Bearer token")
+			return next.RoundTrip(req)
+		})
+	})
+
+	for _, client := range []*http.Client{module.Client(), timeoutClient} {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+}
@@ -11,8 +11,11 @@
 //   - Configurable connection pooling and keep-alive settings
 //   - Request and response timeout management
 //   - TLS handshake timeout configuration
+//   - Per-host connection pool and timeout overrides
+//   - Record/replay transports for offline, deterministic tests
 //   - Comprehensive request/response logging with file output
 //   - Request modification pipeline for adding headers, authentication, etc.
+//   - Composable round-tripper middlewares for auth, retries, tracing, etc.
 //   - Performance-optimized transport settings
 //   - Support for compression and keep-alive control
 //   - Service interface for dependency injection
@@ -82,6 +85,19 @@
 //	// All subsequent requests will include the headers
 //	resp, err := client.Client().Get("https://api.example.com/protected")
 //
+// Round-tripper middlewares, run in the order they are added:
+//
+//	module.Use(
+//	    func(next http.RoundTripper) http.RoundTripper {
+//	        return httpclient.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+//	            req = req.Clone(req.Context())
+//	            req.Header.Set("Authorization", "Bearer "+apiToken)
+//	            return next.RoundTrip(req)
+//	        })
+//	    },
+//	    retryMiddleware,
+//	)
+//
 // Custom timeout scenarios:
 //
 //	// Short timeout for health checks
@@ -116,10 +132,8 @@
 package httpclient
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"net/http/httputil"
 	"strings"
@@ -155,9 +169,10 @@ type HTTPClientModule struct {
 	logger         modular.Logger
 	fileLogger     *FileLogger
 	httpClient     *http.Client
-	transport      *http.Transport
+	transport      *hostTransport
 	modifier       RequestModifierFunc
 	namedModifiers map[string]func(*http.Request) error // For named modifier management
+	pipeline       *middlewarePipeline                  // Transport of the client, see Use
 	// subject can be set during observer registration while background event goroutines read it.
 	// Use RWMutex to avoid data race (pattern aligned with cache module fix).
 	subject   modular.Subject
@@ -181,6 +196,7 @@ func NewHTTPClientModule() modular.Module {
 	return &HTTPClientModule{
 		modifier:       func(r *http.Request) *http.Request { return r }, // Default no-op modifier
 		namedModifiers: make(map[string]func(*http.Request) error),       // Initialize named modifiers map
+		pipeline:       &middlewarePipeline{},
 	}
 }
 
@@ -226,7 +242,8 @@ func (m *HTTPClientModule) RegisterConfig(app modular.Application) error {
 // The initialization process:
 //  1. Retrieves the module's configuration
 //  2. Sets up logging
-//  3. Creates and configures the HTTP transport with connection pooling
+//  3. Creates and configures the HTTP transports with connection pooling,
+//     applying the per-host overrides
 //  4. Sets up request/response logging if verbose mode is enabled
 //  5. Creates the HTTP client with configured transport and middleware
 //  6. Initializes request modification pipeline
@@ -236,6 +253,7 @@ func (m *HTTPClientModule) RegisterConfig(app modular.Application) error {
 //   - Timeout configurations for reliability
 //   - Compression and keep-alive settings
 //   - TLS handshake timeout for secure connections
+//   - Per-host pool size and timeout overrides (HostOverrides)
 func (m *HTTPClientModule) Init(app modular.Application) error {
 	m.app = app
 	m.logger = app.Logger()
@@ -248,20 +266,13 @@ func (m *HTTPClientModule) Init(app modular.Application) error {
 	}
 	m.config = cfg.GetConfig().(*Config)
 
-	// Create the transport with the configured settings
-	m.transport = &http.Transport{
-		MaxIdleConns:        m.config.MaxIdleConns,
-		MaxIdleConnsPerHost: m.config.MaxIdleConnsPerHost,
-		IdleConnTimeout:     m.config.IdleConnTimeout,
-		TLSHandshakeTimeout: m.config.TLSTimeout,
-		DisableCompression:  m.config.DisableCompression,
-		DisableKeepAlives:   m.config.DisableKeepAlives,
-	}
+	// Create the transports with the configured settings, one per host override
+	m.transport = newHostTransport(m.config)
 
 	// Create the HTTP client with the transport
-	baseTransport := http.RoundTripper(m.transport)
+	var builtin []Middleware
 
-	// If verbose logging is enabled, wrap the transport with logging
+	// If verbose logging is enabled, add logging as the innermost middleware
 	if m.config.Verbose {
 		// If we should log to file, initialize the file logger
 		if m.config.VerboseOptions.LogToFile {
@@ -290,19 +301,20 @@ func (m *HTTPClientModule) Init(app modular.Application) error {
 			}
 		}
 
-		baseTransport = &loggingTransport{
-			Transport:      baseTransport,
-			Logger:         m.logger,
-			FileLogger:     m.fileLogger,
-			LogHeaders:     m.config.VerboseOptions.LogHeaders,
-			LogBody:        m.config.VerboseOptions.LogBody,
-			MaxBodyLogSize: m.config.VerboseOptions.MaxBodyLogSize,
-			LogToFile:      m.config.VerboseOptions.LogToFile && m.fileLogger != nil,
-		}
+		builtin = append(builtin, m.loggingMiddleware())
 	}
 
+	base, err := m.baseTransport()
+	if err != nil {
+		return err
+	}
+	if m.pipeline == nil {
+		m.pipeline = &middlewarePipeline{}
+	}
+	m.pipeline.init(base, builtin...)
+
 	m.httpClient = &http.Client{
-		Transport: baseTransport,
+		Transport: m.pipeline,
 		Timeout:   m.config.RequestTimeout,
 	}
 
@@ -315,6 +327,33 @@ func (m *HTTPClientModule) Init(app modular.Application) error {
 	return nil
 }
 
+// baseTransport returns the transport at the bottom of the middleware
+// pipeline: the network transport, wrapped for recording or replaced for
+// replay when Recording is configured.
+func (m *HTTPClientModule) baseTransport() (http.RoundTripper, error) {
+	if m.config.Recording == nil {
+		return m.transport, nil
+	}
+	switch m.config.Recording.Mode {
+	case RecordingModeRecord:
+		m.logger.Info("HTTP client recording responses", "fixture", m.config.Recording.FixturePath)
+		recorder, err := NewRecordingTransport(m.transport, *m.config.Recording)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load existing recordings: %w", err)
+		}
+		return recorder, nil
+	case RecordingModeReplay:
+		m.logger.Info("HTTP client replaying recorded responses", "fixture", m.config.Recording.FixturePath)
+		replay, err := NewReplayTransport(*m.config.Recording)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load recorded responses: %w", err)
+		}
+		return replay, nil
+	default:
+		return m.transport, nil
+	}
+}
+
 // Start performs startup logic for the module.
 func (m *HTTPClientModule) Start(ctx context.Context) error {
 	m.logger.Info("Starting HTTP client module")
@@ -594,6 +633,14 @@ type loggingTransport struct {
 	LogBody        bool
 	MaxBodyLogSize int
 	LogToFile      bool
+
+	// MaxLoggedBodyBytes limits the body bytes captured per request or
+	// response; 0 or less captures whole bodies.
+	MaxLoggedBodyBytes int
+	// RedactHeaders and RedactBodyFields name headers and body fields to
+	// redact in addition to the built-in sensitive names.
+	RedactHeaders    []string
+	RedactBodyFields []string
 }
 
 // RoundTrip implements the http.RoundTripper interface and adds logging.
@@ -603,7 +650,7 @@ func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	startTime := time.Now()
 
 	// Log the request
-	t.logRequest(requestID, req)
+	reqDump := t.logRequest(requestID, req)
 
 	// Execute the actual request
 	resp, err := t.Transport.RoundTrip(req)
@@ -628,21 +675,22 @@ func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error)
 
 	// Handle file logging if enabled
 	if t.LogToFile && t.FileLogger != nil {
-		t.handleFileLogging(requestID, req, resp, duration)
+		t.handleFileLogging(requestID, reqDump, req, resp, duration)
 	}
 
 	return resp, nil
 }
 
-// logRequest logs detailed information about the request.
-func (t *loggingTransport) logRequest(id string, req *http.Request) {
+// logRequest logs detailed information about the request. It returns the
+// redacted request dump when detailed logging is enabled, for the transaction
+// log file.
+func (t *loggingTransport) logRequest(id string, req *http.Request) []byte {
 	// Basic request information that's always useful
 	basicInfo := fmt.Sprintf("%s %s", req.Method, req.URL.String())
 
 	// If detailed logging is enabled, try to get more information
 	if t.LogHeaders || t.LogBody {
-		dumpBody := t.LogBody
-		reqDump, err := httputil.DumpRequestOut(req, dumpBody)
+		rawDump, err := t.dumpRequest(req)
 		if err != nil {
 			// If dump fails, log basic info with error
 			t.Logger.Info("Outgoing request (dump failed)",
@@ -651,6 +699,10 @@ func (t *loggingTransport) logRequest(id string, req *http.Request) {
 				"error", err,
 			)
 		} else {
+			// Redact sensitive header values and body fields before the dump
+			// is logged or written to a file, so Authorization/Cookie/etc. are
+			// not emitted in clear text.
+			reqDump := []byte(t.redactDump(string(rawDump)))
 			if t.LogToFile && t.FileLogger != nil {
 				// Log to file using our FileLogger
 				t.Logger.Info("Outgoing request (logged to file)",
@@ -664,10 +716,8 @@ func (t *loggingTransport) logRequest(id string, req *http.Request) {
 					)
 				}
 			} else {
-				// Log to application logger with smart truncation.
-				// Redact sensitive header values in the raw dump before logging so
-				// Authorization/Cookie/etc. are not emitted in clear text.
-				dumpStr := redactDump(string(reqDump))
+				// Log to application logger with smart truncation
+				dumpStr := string(reqDump)
 				if t.MaxBodyLogSize > 0 && len(dumpStr) > t.MaxBodyLogSize {
 					// Smart truncation: try to include the request line and headers
 					truncated := t.smartTruncateRequest(dumpStr, t.MaxBodyLogSize)
@@ -684,6 +734,7 @@ func (t *loggingTransport) logRequest(id string, req *http.Request) {
 					)
 				}
 			}
+			return reqDump
 		}
 	} else {
 		// Even when detailed logging is disabled, show useful basic information
@@ -698,9 +749,10 @@ func (t *loggingTransport) logRequest(id string, req *http.Request) {
 			"id", id,
 			"request", basicInfo,
 			"content_length", req.ContentLength,
-			"important_headers", redactHeaders(headers),
+			"important_headers", t.redactHeaders(headers),
 		)
 	}
+	return nil
 }
 
 // logResponse logs detailed information about the response.
@@ -736,8 +788,9 @@ func (t *loggingTransport) logResponse(id, url string, resp *http.Response, dura
 					respDump = append(respDump, []byte(fmt.Sprintf("\r\n[body omitted: %s]", reason))...)
 				}
 			} else {
-				// Read body for logging
-				bodyBytes, err = io.ReadAll(resp.Body)
+				// Read body for logging, up to MaxLoggedBodyBytes
+				var truncated bool
+				bodyBytes, truncated, resp.Body, err = captureBody(resp.Body, t.MaxLoggedBodyBytes)
 				if err != nil {
 					t.Logger.Info("Received response (body read failed)",
 						"id", id,
@@ -749,16 +802,14 @@ func (t *loggingTransport) logResponse(id, url string, resp *http.Response, dura
 					return
 				}
 
-				// Restore the body for the caller
-				resp.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
-
-				// Create the response dump manually
+				// Create the response dump manually; captureBody restored the
+				// full body for the caller
 				respDump = append([]byte(fmt.Sprintf("HTTP %s\r\n", resp.Status)), []byte{}...)
 				for k, v := range resp.Header {
 					respDump = append(respDump, []byte(fmt.Sprintf("%s: %s\r\n", k, v[0]))...)
 				}
 				respDump = append(respDump, []byte("\r\n")...)
-				respDump = append(respDump, bodyBytes...)
+				respDump = appendCapturedBody(respDump, bodyBytes, truncated, t.MaxLoggedBodyBytes)
 			}
 
 		} else {
@@ -778,6 +829,10 @@ func (t *loggingTransport) logResponse(id, url string, resp *http.Response, dura
 				"error", err,
 			)
 		} else {
+			// Redact sensitive header values and body fields before the dump
+			// is logged or written to a file, so Set-Cookie/Authorization/etc.
+			// are not emitted in clear text.
+			respDump = []byte(t.redactDump(string(respDump)))
 			if t.LogToFile && t.FileLogger != nil {
 				// Log the response to file using our FileLogger
 				t.Logger.Info("Received response (logged to file)",
@@ -793,10 +848,8 @@ func (t *loggingTransport) logResponse(id, url string, resp *http.Response, dura
 					)
 				}
 			} else {
-				// Log to application logger with smart truncation.
-				// Redact sensitive header values in the raw dump before logging so
-				// Set-Cookie/Authorization/etc. are not emitted in clear text.
-				dumpStr := redactDump(string(respDump))
+				// Log to application logger with smart truncation
+				dumpStr := string(respDump)
 				if t.MaxBodyLogSize > 0 && len(dumpStr) > t.MaxBodyLogSize {
 					// Smart truncation: try to include the status line and headers
 					truncated := t.smartTruncateResponse(dumpStr, t.MaxBodyLogSize)
@@ -833,7 +886,7 @@ func (t *loggingTransport) logResponse(id, url string, resp *http.Response, dura
 			"url", url,
 			"duration_ms", duration.Milliseconds(),
 			"content_length", resp.ContentLength,
-			"important_headers", redactHeaders(headers),
+			"important_headers", t.redactHeaders(headers),
 		)
 	}
 }
@@ -966,63 +1019,6 @@ func isSensitiveHeader(name string) bool {
 	return false
 }
 
-// redactHeaders returns a new map with sensitive header values replaced by "***".
-func redactHeaders(headers map[string]string) map[string]string {
-	redacted := make(map[string]string, len(headers))
-	for k, v := range headers {
-		if isSensitiveHeader(k) {
-			redacted[k] = "***"
-		} else {
-			redacted[k] = v
-		}
-	}
-	return redacted
-}
-
-// redactDump scrubs sensitive header values from a raw HTTP request/response dump
-// (as produced by httputil.DumpRequestOut / DumpResponse) before it is logged.
-// It scans the header section (everything up to the first blank line that separates
-// headers from the body) line by line; for any line of the form "Name: value" whose
-// header name matches isSensitiveHeader, the value is replaced with "***" while the
-// header name and the rest of the dump (including the body) are left intact.
-func redactDump(dump string) string {
-	if dump == "" {
-		return dump
-	}
-
-	// Preserve the original line endings (dumps use CRLF). Split on "\n" and
-	// strip a trailing "\r" per line so we can match, then re-attach it.
-	lines := strings.Split(dump, "\n")
-	for i, line := range lines {
-		// Stop at the blank line separating headers from the body. A blank line
-		// is "" or just "\r".
-		trimmed := strings.TrimRight(line, "\r")
-		if trimmed == "" {
-			break
-		}
-
-		colon := strings.Index(trimmed, ":")
-		if colon <= 0 {
-			// Request/status line (e.g. "GET / HTTP/1.1") has no leading "Name:".
-			continue
-		}
-
-		name := trimmed[:colon]
-		if !isSensitiveHeader(name) {
-			continue
-		}
-
-		// Rebuild as "Name: ***", preserving the original CRLF if present.
-		suffix := ""
-		if strings.HasSuffix(line, "\r") {
-			suffix = "\r"
-		}
-		lines[i] = name + ": ***" + suffix
-	}
-
-	return strings.Join(lines, "\n")
-}
-
 // isImportantHeader determines if a header is important enough to show
 // even when detailed logging is disabled.
 func (t *loggingTransport) isImportantHeader(headerName string) bool {
@@ -1051,19 +1047,17 @@ func (t *loggingTransport) isImportantHeaderLine(line string) bool {
 	return t.isImportantHeader(headerName)
 }
 
-// handleFileLogging handles file-based logging for transactions.
-func (t *loggingTransport) handleFileLogging(requestID string, req *http.Request, resp *http.Response, duration time.Duration) {
+// handleFileLogging handles file-based logging for transactions. reqDump is
+// the redacted request dump made by logRequest before the request was sent,
+// as the request body has been consumed since.
+func (t *loggingTransport) handleFileLogging(requestID string, reqDump []byte, req *http.Request, resp *http.Response, duration time.Duration) {
 	if !t.LogHeaders && !t.LogBody {
 		return // No detailed logging requested
 	}
 
-	// Get request dump
-	dumpBody := t.LogBody
-	reqDump, err := httputil.DumpRequestOut(req, dumpBody)
-	if err != nil {
+	if reqDump == nil {
 		t.Logger.Error("Failed to dump request for transaction logging",
 			"id", requestID,
-			"error", err,
 		)
 		return
 	}
@@ -1084,8 +1078,10 @@ func (t *loggingTransport) handleFileLogging(requestID string, req *http.Request
 			}
 			respDump = append(respDump, []byte(fmt.Sprintf("\r\n[body omitted: %s]", reason))...)
 		} else {
-			// We need to read the body for logging and then restore it
-			bodyBytes, readErr := io.ReadAll(resp.Body)
+			// We need to read the body for logging, up to MaxLoggedBodyBytes,
+			// and then restore it
+			bodyBytes, truncated, restored, readErr := captureBody(resp.Body, t.MaxLoggedBodyBytes)
+			resp.Body = restored
 			if readErr != nil {
 				t.Logger.Error("Failed to read response body for transaction logging",
 					"id", requestID,
@@ -1094,16 +1090,13 @@ func (t *loggingTransport) handleFileLogging(requestID string, req *http.Request
 				return
 			}
 
-			// Restore the body for the caller
-			resp.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
-
 			// Create the response dump manually
 			respDump = append([]byte(fmt.Sprintf("HTTP %s\r\n", resp.Status)), []byte{}...)
 			for k, v := range resp.Header {
 				respDump = append(respDump, []byte(fmt.Sprintf("%s: %s\r\n", k, v[0]))...)
 			}
 			respDump = append(respDump, []byte("\r\n")...)
-			respDump = append(respDump, bodyBytes...)
+			respDump = appendCapturedBody(respDump, bodyBytes, truncated, t.MaxLoggedBodyBytes)
 		}
 	} else {
 		// If we don't need the body or there is no body
@@ -1119,6 +1112,7 @@ func (t *loggingTransport) handleFileLogging(requestID string, req *http.Request
 	}
 
 	// Write transaction log
+	respDump = []byte(t.redactDump(string(respDump)))
 	if err := t.FileLogger.LogTransactionToFile(requestID, reqDump, respDump, duration, req.URL.String()); err != nil {
 		t.Logger.Error("Failed to write transaction to log file",
 			"id", requestID,
@@ -0,0 +1,262 @@
+package httpclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+)
+
+// Recording modes of RecordingConfig.
+const (
+	// RecordingModeRecord sends requests to the real servers and records the
+	// responses to the fixture file.
+	RecordingModeRecord = "record"
+
+	// RecordingModeReplay serves the responses recorded in the fixture file
+	// without any network access.
+	RecordingModeReplay = "replay"
+)
+
+// recordedRequest identifies a recorded request.
+type recordedRequest struct {
+	Method   string `json:"method"`
+	URL      string `json:"url"`
+	BodyHash string `json:"body_hash,omitempty"`
+}
+
+// recordedResponse is a recorded response.
+type recordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       []byte      `json:"body,omitempty"`
+}
+
+// recordedInteraction is one entry of a fixture file.
+type recordedInteraction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+// RecordingTransport is an http.RoundTripper that sends requests through the
+// next round-tripper and records every response to a JSON fixture file, which
+// a ReplayTransport serves later. The fixture file is rewritten after each
+// request, so no explicit save is needed.
+//
+// Fixtures are meant to be committed, so secrets are redacted with the rules
+// of the verbose logging: the values of sensitive response headers such as
+// Set-Cookie, and of sensitive query parameters and URL passwords, are
+// recorded as "***". Response bodies are recorded as is.
+type RecordingTransport struct {
+	next   http.RoundTripper
+	config RecordingConfig
+
+	mu           sync.Mutex
+	interactions []recordedInteraction
+	// previous holds the requests recorded by an earlier run; their old
+	// responses are dropped once the request is recorded again
+	previous map[recordedRequest]bool
+}
+
+// NewRecordingTransport creates a transport recording the responses of next,
+// or of http.DefaultTransport when next is nil, to config.FixturePath. An
+// existing fixture file is merged: its recordings are kept, except for the
+// requests recorded again, whose old responses are replaced.
+func NewRecordingTransport(next http.RoundTripper, config RecordingConfig) (*RecordingTransport, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	interactions, err := loadInteractions(config.FixturePath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	previous := make(map[recordedRequest]bool, len(interactions))
+	for _, interaction := range interactions {
+		previous[interaction.Request] = true
+	}
+	return &RecordingTransport{next: next, config: config, interactions: interactions, previous: previous}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key, err := recordingKey(req, t.config)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // errors of the next round-tripper are returned as is
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading response to record: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := resp.Header.Clone()
+	for name := range header {
+		if isRedactedHeader(name, t.config.RedactHeaders) {
+			header[name] = []string{redactedValue}
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.previous[key] {
+		t.interactions = slices.DeleteFunc(t.interactions, func(interaction recordedInteraction) bool {
+			return interaction.Request == key
+		})
+		delete(t.previous, key)
+	}
+	t.interactions = append(t.interactions, recordedInteraction{
+		Request:  key,
+		Response: recordedResponse{StatusCode: resp.StatusCode, Header: header, Body: body},
+	})
+	if err := t.save(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// save writes the recorded interactions to the fixture file; the caller holds t.mu.
+func (t *RecordingTransport) save() error {
+	// Keep URLs readable in the fixture rather than escaping & as \u0026
+	var data bytes.Buffer
+	encoder := json.NewEncoder(&data)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(t.interactions); err != nil {
+		return fmt.Errorf("encoding recorded responses: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(t.config.FixturePath), 0750); err != nil {
+		return fmt.Errorf("creating fixture directory: %w", err)
+	}
+	if err := os.WriteFile(t.config.FixturePath, data.Bytes(), 0600); err != nil {
+		return fmt.Errorf("writing fixture file %s: %w", t.config.FixturePath, err)
+	}
+	return nil
+}
+
+// loadInteractions reads the interactions recorded in a fixture file.
+func loadInteractions(fixturePath string) ([]recordedInteraction, error) {
+	data, err := os.ReadFile(fixturePath) //nolint:gosec // the fixture path comes from the module configuration
+	if err != nil {
+		return nil, fmt.Errorf("reading fixture file: %w", err)
+	}
+	var interactions []recordedInteraction
+	if err := json.Unmarshal(data, &interactions); err != nil {
+		return nil, fmt.Errorf("decoding fixture file %s: %w", fixturePath, err)
+	}
+	return interactions, nil
+}
+
+// ReplayTransport is an http.RoundTripper that serves the responses recorded
+// by a RecordingTransport, so that tests run offline and deterministically.
+// Requests are matched by method and URL, and by body hash when the fixture
+// was recorded with body matching. Requests matching several recordings get
+// them in recorded order, the last one being repeated once all were served.
+type ReplayTransport struct {
+	config RecordingConfig
+
+	mu        sync.Mutex
+	responses map[recordedRequest][]recordedResponse
+	served    map[recordedRequest]int
+}
+
+// NewReplayTransport loads the responses recorded in config.FixturePath.
+// MatchBody and RedactQueryParams must match the settings used when recording.
+func NewReplayTransport(config RecordingConfig) (*ReplayTransport, error) {
+	interactions, err := loadInteractions(config.FixturePath)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &ReplayTransport{
+		config:    config,
+		responses: make(map[recordedRequest][]recordedResponse),
+		served:    make(map[recordedRequest]int),
+	}
+	for _, interaction := range interactions {
+		t.responses[interaction.Request] = append(t.responses[interaction.Request], interaction.Response)
+	}
+	return t, nil
+}
+
+// RoundTrip implements http.RoundTripper. It returns ErrNoRecordedResponse for
+// requests that were not recorded.
+func (t *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key, err := recordingKey(req, t.config)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	t.mu.Lock()
+	responses := t.responses[key]
+	if len(responses) == 0 {
+		t.mu.Unlock()
+		return nil, fmt.Errorf("%w: %s %s", ErrNoRecordedResponse, req.Method, req.URL)
+	}
+	index := min(t.served[key], len(responses)-1)
+	t.served[key]++
+	recorded := responses[index]
+	t.mu.Unlock()
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recorded.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// recordingKey identifies req by method, redacted URL and, with MatchBody, the
+// SHA-256 of its body. The body is read and restored for the next round-tripper.
+func recordingKey(req *http.Request, config RecordingConfig) (recordedRequest, error) {
+	key := recordedRequest{Method: req.Method, URL: redactURL(req.URL, config.RedactQueryParams)}
+	if !config.MatchBody || req.Body == nil || req.Body == http.NoBody {
+		return key, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return key, fmt.Errorf("reading request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	sum := sha256.Sum256(body)
+	key.BodyHash = hex.EncodeToString(sum[:])
+	return key, nil
+}
+
+// redactURL returns u with the password and the values of sensitive query
+// parameters replaced by "***". Replayed requests are redacted the same way,
+// so they still match their recordings.
+func redactURL(u *url.URL, extraParams []string) string {
+	redacted := *u
+	if _, ok := redacted.User.Password(); ok {
+		redacted.User = url.UserPassword(redacted.User.Username(), redactedValue)
+	}
+	redacted.RawQuery = redactFormFields(redacted.RawQuery, func(name string) bool {
+		return isRedactedField(name, extraParams)
+	})
+	return redacted.String()
+}
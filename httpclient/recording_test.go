@@ -0,0 +1,190 @@
+package httpclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// doRequest sends a request with client and returns the status and body.
+func doRequest(t *testing.T, client *http.Client, method, target, body string) (int, string, error) {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), method, target, reader)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(data), nil
+}
+
+func TestRecordingTransport_RecordThenReplay(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(r.Method + " " + r.URL.Path + " " + string(body)))
+	}))
+	fixture := filepath.Join(t.TempDir(), "fixtures", "api.json")
+
+	// Record against the real server through the module
+	recorder := newMiddlewareTestModuleWithConfig(t, &Config{
+		RequestTimeout: 5 * time.Second,
+		Recording:      &RecordingConfig{Mode: RecordingModeRecord, FixturePath: fixture, MatchBody: true},
+	})
+	status, body, err := doRequest(t, recorder.Client(), http.MethodPost, server.URL+"/orders", `{"id":1}`)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, `POST /orders {"id":1}`, body)
+	_, _, err = doRequest(t, recorder.Client(), http.MethodPost, server.URL+"/orders", `{"id":2}`)
+	require.NoError(t, err)
+	server.Close()
+
+	// Replay offline: the server is gone
+	replayer := newMiddlewareTestModuleWithConfig(t, &Config{
+		RequestTimeout: 5 * time.Second,
+		Recording:      &RecordingConfig{Mode: RecordingModeReplay, FixturePath: fixture, MatchBody: true},
+	})
+	for _, id := range []string{"2", "1", "1"} {
+		status, body, err = doRequest(t, replayer.Client(), http.MethodPost, server.URL+"/orders", `{"id":`+id+`}`)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, status)
+		assert.Equal(t, `POST /orders {"id":`+id+`}`, body, "the body hash selects the recording")
+	}
+	assert.Equal(t, int32(2), calls.Load())
+
+	_, _, err = doRequest(t, replayer.Client(), http.MethodPost, server.URL+"/orders", `{"id":3}`)
+	require.ErrorIs(t, err, ErrNoRecordedResponse)
+	_, _, err = doRequest(t, replayer.Client(), http.MethodGet, server.URL+"/orders", "")
+	require.ErrorIs(t, err, ErrNoRecordedResponse)
+}
+
+func TestReplayTransport_RecordedOrder(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Call", string(rune('0'+calls.Add(1))))
+	}))
+	defer server.Close()
+	fixture := filepath.Join(t.TempDir(), "order.json")
+
+	recording, err := NewRecordingTransport(nil, RecordingConfig{FixturePath: fixture})
+	require.NoError(t, err)
+	recorder := &http.Client{Transport: recording}
+	for i := 0; i < 2; i++ {
+		_, _, err := doRequest(t, recorder, http.MethodGet, server.URL+"/status?verbose=1", "")
+		require.NoError(t, err)
+	}
+
+	replay, err := NewReplayTransport(RecordingConfig{FixturePath: fixture})
+	require.NoError(t, err)
+	var headers []string
+	for i := 0; i < 3; i++ {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/status?verbose=1", nil)
+		require.NoError(t, err)
+		resp, err := replay.RoundTrip(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		headers = append(headers, resp.Header.Get("X-Call"))
+	}
+	assert.Equal(t, []string{"1", "2", "2"}, headers, "recordings are served in order, repeating the last one")
+}
+
+func TestRecordingTransport_RedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "session-secret"})
+		w.Header().Set("X-Tenant-Key", "tenant-secret")
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+	fixture := filepath.Join(t.TempDir(), "secrets.json")
+	config := RecordingConfig{FixturePath: fixture, RedactHeaders: []string{"X-Tenant-Key"}, RedactQueryParams: []string{"sig"}}
+
+	recording, err := NewRecordingTransport(nil, config)
+	require.NoError(t, err)
+	target := server.URL + "/data?page=2&access_token=token-secret&sig=sig-secret"
+	status, body, err := doRequest(t, &http.Client{Transport: recording}, http.MethodGet, target, "")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "ok", body)
+
+	data, err := os.ReadFile(fixture)
+	require.NoError(t, err)
+	for _, secret := range []string{"session-secret", "tenant-secret", "token-secret", "sig-secret"} {
+		assert.NotContains(t, string(data), secret)
+	}
+	assert.Contains(t, string(data), "page=2&access_token=***&sig=***", "other parameters and their order are kept")
+	assert.Contains(t, string(data), "text/plain")
+
+	// Replayed requests carry the real secrets and still match
+	replay, err := NewReplayTransport(config)
+	require.NoError(t, err)
+	status, body, err = doRequest(t, &http.Client{Transport: replay}, http.MethodGet, target, "")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "ok", body)
+}
+
+func TestRecordingTransport_MergesExistingFixture(t *testing.T) {
+	var version atomic.Int32
+	version.Store(1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Path + " v" + string(rune('0'+version.Load()))))
+	}))
+	defer server.Close()
+	config := RecordingConfig{FixturePath: filepath.Join(t.TempDir(), "merge.json")}
+
+	record := func(paths ...string) {
+		t.Helper()
+		recording, err := NewRecordingTransport(nil, config)
+		require.NoError(t, err)
+		for _, path := range paths {
+			_, _, err := doRequest(t, &http.Client{Transport: recording}, http.MethodGet, server.URL+path, "")
+			require.NoError(t, err)
+		}
+	}
+	record("/users", "/orders")
+	version.Store(2)
+	record("/orders", "/orders")
+
+	replay, err := NewReplayTransport(config)
+	require.NoError(t, err)
+	client := &http.Client{Transport: replay}
+	_, body, err := doRequest(t, client, http.MethodGet, server.URL+"/users", "")
+	require.NoError(t, err)
+	assert.Equal(t, "/users v1", body, "recordings of other requests are kept")
+	for i := 0; i < 2; i++ {
+		_, body, err = doRequest(t, client, http.MethodGet, server.URL+"/orders", "")
+		require.NoError(t, err)
+		assert.Equal(t, "/orders v2", body, "a request recorded again replaces its old recordings")
+	}
+
+	require.NoError(t, os.WriteFile(config.FixturePath, []byte("not json"), 0600))
+	_, err = NewRecordingTransport(nil, config)
+	require.Error(t, err, "a malformed fixture is not overwritten")
+}
+
+func TestConfig_ValidateRecording(t *testing.T) {
+	require.NoError(t, (&Config{Recording: &RecordingConfig{}}).Validate())
+	require.NoError(t, (&Config{Recording: &RecordingConfig{Mode: RecordingModeReplay, FixturePath: "f.json"}}).Validate())
+	require.ErrorIs(t, (&Config{Recording: &RecordingConfig{Mode: "mock", FixturePath: "f.json"}}).Validate(), ErrInvalidRecordingMode)
+	require.ErrorIs(t, (&Config{Recording: &RecordingConfig{Mode: RecordingModeRecord}}).Validate(), ErrRecordingFixtureRequired)
+}
@@ -53,6 +53,9 @@ type HTTPDoer interface {
 //	modifier := httpClientService.RequestModifier()
 //	req, _ := http.NewRequest("GET", "https://api.example.com/data", nil)
 //	modifiedReq := modifier(req)
+//
+//	// Middleware
+//	httpClientService.Use(retryMiddleware)
 type ClientService interface {
 	// Client returns the configured http.Client instance.
 	// This client uses the module's configuration for timeouts, connection
@@ -85,6 +88,13 @@ type ClientService interface {
 	//   - API calls: 30-60 seconds
 	//   - File uploads: 300+ seconds
 	WithTimeout(timeoutSeconds int) *http.Client
+
+	// Use appends middlewares to the round-tripper pipeline shared by Client(),
+	// the clients returned by WithTimeout and the registered *http.Client
+	// service. Middlewares run in the order they were added, so modules that
+	// depend on this service can add authentication, retries or tracing:
+	//	httpClientService.Use(tracingMiddleware, retryMiddleware)
+	Use(middlewares ...Middleware)
 }
 
 // RequestModifierFunc is a function type that can be used to modify an HTTP request
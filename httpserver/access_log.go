@@ -0,0 +1,104 @@
+package httpserver
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+)
+
+// DefaultRequestIDHeader is the header access logs read the request ID from
+// when AccessLogConfig.RequestIDHeader is not set.
+const DefaultRequestIDHeader = "X-Request-ID"
+
+// DefaultTenantHeader is the header access logs read the tenant from when
+// AccessLogConfig.TenantHeader is not set.
+const DefaultTenantHeader = "X-Tenant-ID"
+
+// defaultLatencyBuckets are the latency bucket bounds used when
+// AccessLogConfig.LatencyBuckets is not set.
+var defaultLatencyBuckets = []time.Duration{
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+}
+
+// wrapHandlerWithAccessLog wraps the HTTP handler to log an access log entry
+// for each request selected by the access log configuration
+func (m *HTTPServerModule) wrapHandlerWithAccessLog(handler http.Handler) http.Handler {
+	cfg := m.config.AccessLog
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		wrappedWriter := &responseWriter{ResponseWriter: w, statusCode: 0}
+
+		handler.ServeHTTP(wrappedWriter, r)
+
+		duration := time.Since(start)
+		if !shouldLogAccess(cfg, duration, rand.Float64()) { //nolint:gosec // sampling does not need a secure source
+			return
+		}
+
+		statusCode := wrappedWriter.statusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK // Nothing written: net/http replies 200
+		}
+		requestID := r.Header.Get(cfg.RequestIDHeader)
+		if requestID == "" {
+			requestID = w.Header().Get(cfg.RequestIDHeader)
+		}
+		tenant := r.Header.Get(cfg.TenantHeader)
+		if tenantID, ok := modular.GetTenantIDFromContext(r.Context()); ok {
+			tenant = string(tenantID)
+		}
+
+		m.logger.Info("HTTP request",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", statusCode,
+			"bytes", wrappedWriter.bytesWritten,
+			"duration_ms", float64(duration.Microseconds())/1000,
+			"latency_bucket", latencyBucket(duration, cfg.LatencyBuckets),
+			"request_id", requestID,
+			"tenant", tenant,
+		)
+	})
+}
+
+// shouldLogAccess reports whether a request that took duration is logged:
+// with a slow threshold only slow requests are, otherwise requests are sampled
+// by comparing sample, drawn from [0, 1), to the sample rate
+func shouldLogAccess(cfg *AccessLogConfig, duration time.Duration, sample float64) bool {
+	if cfg.SlowThreshold > 0 {
+		return duration >= cfg.SlowThreshold
+	}
+	return cfg.SampleRate == nil || sample < *cfg.SampleRate
+}
+
+// latencyBucket returns the label of the first bucket bounding duration, such
+// as "le_100ms", or "gt_5s" when duration exceeds every bound
+func latencyBucket(duration time.Duration, bounds []time.Duration) string {
+	for _, bound := range bounds {
+		if duration <= bound {
+			return "le_" + formatBound(bound)
+		}
+	}
+	if len(bounds) == 0 {
+		return ""
+	}
+	return "gt_" + formatBound(bounds[len(bounds)-1])
+}
+
+// formatBound formats a bucket bound compactly, e.g. "250ms" or "2.5s"
+func formatBound(bound time.Duration) string {
+	if bound < time.Second {
+		return strconv.FormatFloat(float64(bound)/float64(time.Millisecond), 'f', -1, 64) + "ms"
+	}
+	return strconv.FormatFloat(bound.Seconds(), 'f', -1, 64) + "s"
+}
@@ -0,0 +1,304 @@
+package httpserver
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accessLogRecorder is a modular.Logger recording the key/values of its Info entries
+type accessLogRecorder struct {
+	mu      sync.Mutex
+	entries []map[string]interface{}
+}
+
+func (l *accessLogRecorder) Info(msg string, keyvals ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entry := map[string]interface{}{"msg": msg}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		entry[fmt.Sprint(keyvals[i])] = keyvals[i+1]
+	}
+	l.entries = append(l.entries, entry)
+}
+
+func (l *accessLogRecorder) Debug(string, ...interface{}) {}
+func (l *accessLogRecorder) Warn(string, ...interface{})  {}
+func (l *accessLogRecorder) Error(string, ...interface{}) {}
+
+func (l *accessLogRecorder) logged() []map[string]interface{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]map[string]interface{}(nil), l.entries...)
+}
+
+// sampleRate returns a pointer to rate for AccessLogConfig.SampleRate
+func sampleRate(rate float64) *float64 {
+	return &rate
+}
+
+// newAccessLogTestModule returns a module with the given, validated access log config
+func newAccessLogTestModule(t *testing.T, cfg *AccessLogConfig) (*HTTPServerModule, *accessLogRecorder) {
+	t.Helper()
+	cfg.Enabled = true
+	config := &HTTPServerConfig{AccessLog: cfg}
+	require.NoError(t, config.Validate())
+	logger := &accessLogRecorder{}
+	return &HTTPServerModule{config: config, logger: logger}, logger
+}
+
+func TestAccessLog_LogsStatusWrittenByHandler(t *testing.T) {
+	module, logger := newAccessLogTestModule(t, &AccessLogConfig{})
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		status  int
+		bytes   int64
+	}{
+		{
+			name: "explicit status",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusTeapot)
+				_, _ = w.Write([]byte("short and stout"))
+			},
+			status: http.StatusTeapot,
+			bytes:  15,
+		},
+		{
+			name: "first status wins",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name: "implicit status",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("ok"))
+			},
+			status: http.StatusOK,
+			bytes:  2,
+		},
+		{
+			name:    "nothing written",
+			handler: func(http.ResponseWriter, *http.Request) {},
+			status:  http.StatusOK,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/orders/42?debug=1", nil)
+			req.Header.Set("X-Request-ID", "req-"+tt.name)
+			req.Header.Set("X-Tenant-ID", "acme")
+
+			module.wrapHandlerWithAccessLog(tt.handler).ServeHTTP(recorder, req)
+
+			entries := logger.logged()
+			require.Len(t, entries, i+1)
+			entry := entries[i]
+			assert.Equal(t, "HTTP request", entry["msg"])
+			assert.Equal(t, recorder.Code, entry["status"], "the logged status must match the response")
+			assert.Equal(t, tt.status, entry["status"])
+			assert.Equal(t, tt.bytes, entry["bytes"])
+			assert.Equal(t, http.MethodPost, entry["method"])
+			assert.Equal(t, "/orders/42", entry["path"])
+			assert.Equal(t, "req-"+tt.name, entry["request_id"])
+			assert.Equal(t, "acme", entry["tenant"])
+			assert.Equal(t, "le_10ms", entry["latency_bucket"])
+			assert.IsType(t, float64(0), entry["duration_ms"])
+		})
+	}
+}
+
+func TestAccessLog_TenantAndRequestIDSources(t *testing.T) {
+	module, logger := newAccessLogTestModule(t, &AccessLogConfig{RequestIDHeader: "X-Trace"})
+	handler := module.wrapHandlerWithAccessLog(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-Trace", "generated-id")
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Tenant-ID", "from-header")
+	req = req.WithContext(modular.NewTenantContext(context.Background(), "from-context"))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	entries := logger.logged()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "generated-id", entries[0]["request_id"], "the request ID falls back to the response header")
+	assert.Equal(t, "from-context", entries[0]["tenant"], "the context tenant takes precedence")
+}
+
+func TestAccessLog_SlowThreshold(t *testing.T) {
+	module, logger := newAccessLogTestModule(t, &AccessLogConfig{SlowThreshold: 50 * time.Millisecond})
+	handler := module.wrapHandlerWithAccessLog(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(60 * time.Millisecond)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+
+	for _, path := range []string{"/fast", "/slow", "/fast"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	entries := logger.logged()
+	require.Len(t, entries, 1, "only the slow request is logged")
+	assert.Equal(t, "/slow", entries[0]["path"])
+	assert.Equal(t, http.StatusAccepted, entries[0]["status"])
+	assert.GreaterOrEqual(t, entries[0]["duration_ms"], float64(50))
+	assert.Equal(t, "le_100ms", entries[0]["latency_bucket"])
+}
+
+func TestShouldLogAccess(t *testing.T) {
+	sampled := &AccessLogConfig{SampleRate: sampleRate(0.25)}
+	assert.True(t, shouldLogAccess(sampled, time.Millisecond, 0.1))
+	assert.False(t, shouldLogAccess(sampled, time.Millisecond, 0.25))
+	assert.False(t, shouldLogAccess(sampled, time.Hour, 0.9), "sampling applies regardless of latency without a threshold")
+
+	slow := &AccessLogConfig{SampleRate: sampleRate(0.25), SlowThreshold: time.Second}
+	assert.True(t, shouldLogAccess(slow, time.Second, 0.9), "slow requests are logged regardless of sampling")
+	assert.False(t, shouldLogAccess(slow, 999*time.Millisecond, 0.1))
+
+	none := &AccessLogConfig{SampleRate: sampleRate(0)}
+	assert.False(t, shouldLogAccess(none, time.Millisecond, 0), "an explicit zero rate logs nothing")
+}
+
+func TestLatencyBucket(t *testing.T) {
+	assert.Equal(t, "le_10ms", latencyBucket(0, defaultLatencyBuckets))
+	assert.Equal(t, "le_250ms", latencyBucket(101*time.Millisecond, defaultLatencyBuckets))
+	assert.Equal(t, "le_1s", latencyBucket(time.Second, defaultLatencyBuckets))
+	assert.Equal(t, "le_2.5s", latencyBucket(2*time.Second, defaultLatencyBuckets))
+	assert.Equal(t, "gt_5s", latencyBucket(6*time.Second, defaultLatencyBuckets))
+	assert.Equal(t, "le_0.5ms", latencyBucket(100*time.Microsecond, []time.Duration{500 * time.Microsecond}))
+}
+
+func TestAccessLogConfig_Validate(t *testing.T) {
+	cfg := &HTTPServerConfig{AccessLog: &AccessLogConfig{Enabled: true}}
+	require.NoError(t, cfg.Validate())
+	require.NotNil(t, cfg.AccessLog.SampleRate)
+	assert.InDelta(t, 1.0, *cfg.AccessLog.SampleRate, 0)
+	assert.Equal(t, defaultLatencyBuckets, cfg.AccessLog.LatencyBuckets)
+	assert.Equal(t, DefaultRequestIDHeader, cfg.AccessLog.RequestIDHeader)
+	assert.Equal(t, DefaultTenantHeader, cfg.AccessLog.TenantHeader)
+
+	cfg = &HTTPServerConfig{AccessLog: &AccessLogConfig{Enabled: true, SampleRate: sampleRate(0)}}
+	require.NoError(t, cfg.Validate())
+	assert.InDelta(t, 0.0, *cfg.AccessLog.SampleRate, 0, "an explicit zero rate is kept")
+
+	cfg = &HTTPServerConfig{AccessLog: &AccessLogConfig{Enabled: true, SampleRate: sampleRate(1.5)}}
+	require.ErrorIs(t, cfg.Validate(), ErrInvalidAccessLogSampleRate)
+
+	cfg = &HTTPServerConfig{AccessLog: &AccessLogConfig{Enabled: true, LatencyBuckets: []time.Duration{time.Second, time.Millisecond}}}
+	require.ErrorIs(t, cfg.Validate(), ErrInvalidLatencyBuckets)
+
+	cfg = &HTTPServerConfig{AccessLog: &AccessLogConfig{SampleRate: sampleRate(1.5)}}
+	require.NoError(t, cfg.Validate(), "a disabled access log is not validated")
+}
+
+func TestAccessLog_EnabledOnStart(t *testing.T) {
+	port, err := findFreePort()
+	require.NoError(t, err)
+	module := newTestModule(t)
+	module.config.Port = port
+	module.config.AccessLog = &AccessLogConfig{Enabled: true}
+	require.NoError(t, module.config.AccessLog.validate())
+	logger := &accessLogRecorder{}
+	module.logger = logger
+	module.handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	require.NoError(t, module.Start(context.Background()))
+	defer func() { _ = module.Stop(context.Background()) }()
+
+	resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d/items", port))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	var requests []map[string]interface{}
+	for _, entry := range logger.logged() {
+		if entry["msg"] == "HTTP request" {
+			requests = append(requests, entry)
+		}
+	}
+	require.Len(t, requests, 1)
+	assert.Equal(t, http.StatusCreated, requests[0]["status"])
+	assert.Equal(t, "/items", requests[0]["path"])
+}
+
+func TestAccessLog_StreamingHandler(t *testing.T) {
+	module, logger := newAccessLogTestModule(t, &AccessLogConfig{})
+	release := make(chan struct{})
+	server := httptest.NewServer(module.wrapHandlerWithAccessLog(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte("first\n"))
+		flusher.Flush()
+		<-release
+		_, _ = w.Write([]byte("second\n"))
+	})))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL) //nolint:noctx // test request
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The first chunk arrives while the handler is still blocked
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "first\n", line)
+	close(release)
+	line, err = reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "second\n", line)
+	server.Close()
+
+	entries := logger.logged()
+	require.Len(t, entries, 1)
+	assert.Equal(t, http.StatusOK, entries[0]["status"])
+	assert.Equal(t, int64(13), entries[0]["bytes"])
+}
+
+func TestResponseWriter_Hijack(t *testing.T) {
+	module, logger := newAccessLogTestModule(t, &AccessLogConfig{})
+	server := httptest.NewServer(module.wrapHandlerWithAccessLog(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		assert.NotNil(t, w.(interface{ Unwrap() http.ResponseWriter }).Unwrap())
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+		_, _ = buf.WriteString("HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: test\r\n\r\n")
+		_ = buf.Flush()
+	})))
+	defer server.Close()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "test")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+
+	// Closing the server does not wait for hijacked connections
+	require.Eventually(t, func() bool { return len(logger.logged()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, http.StatusSwitchingProtocols, logger.logged()[0]["status"])
+}
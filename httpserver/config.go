@@ -13,6 +13,11 @@ var (
 	ErrTLSNoDomainsSpecified = errors.New("TLS auto-generation is enabled but no domains specified")
 	ErrTLSNoCertificateFile  = errors.New("TLS is enabled but no certificate file specified")
 	ErrTLSNoKeyFile          = errors.New("TLS is enabled but no key file specified")
+	ErrTLSNoClientCAFile     = errors.New("client certificates are required but no client CA file specified")
+	ErrH2CWithoutHTTP2       = errors.New("h2c cannot be enabled when http2 is disabled")
+
+	ErrInvalidAccessLogSampleRate = errors.New("access log sample rate must be between 0 and 1")
+	ErrInvalidLatencyBuckets      = errors.New("access log latency buckets must be positive and increasing")
 )
 
 // DefaultTimeout is the default timeout value
@@ -30,6 +35,10 @@ type HTTPServerConfig struct {
 	// including the body.
 	ReadTimeout time.Duration `yaml:"read_timeout" json:"read_timeout" env:"READ_TIMEOUT"`
 
+	// ReadHeaderTimeout is the maximum duration for reading request headers.
+	// If zero, ReadTimeout is used.
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" json:"read_header_timeout" env:"READ_HEADER_TIMEOUT"`
+
 	// WriteTimeout is the maximum duration before timing out writes of the response.
 	WriteTimeout time.Duration `yaml:"write_timeout" json:"write_timeout" env:"WRITE_TIMEOUT"`
 
@@ -46,8 +55,51 @@ type HTTPServerConfig struct {
 	// Default: 32768 (32KB)
 	MaxHeaderBytes int `yaml:"max_header_bytes" json:"max_header_bytes" env:"MAX_HEADER_BYTES"`
 
+	// HTTP2 controls HTTP/2 alongside HTTP/1.1 on TLS connections, negotiated
+	// via ALPN. When unset the net/http default applies, which enables HTTP/2
+	// on TLS; false restricts TLS connections to HTTP/1.1.
+	HTTP2 *bool `yaml:"http2" json:"http2" env:"HTTP2"`
+
+	// H2C enables HTTP/2 over cleartext connections using prior knowledge, as
+	// used by gRPC clients and L7 load balancers. It cannot be combined with
+	// HTTP2 set to false.
+	H2C bool `yaml:"h2c" json:"h2c" env:"H2C"`
+
 	// TLS configuration if HTTPS is enabled
 	TLS *TLSConfig `yaml:"tls" json:"tls"`
+
+	// AccessLog configures structured access logging of served requests.
+	AccessLog *AccessLogConfig `yaml:"access_log" json:"access_log"`
+}
+
+// AccessLogConfig holds the access logging configuration. Each logged request
+// produces one "HTTP request" entry on the application logger with the method,
+// path, status, bytes written, duration, latency bucket, request ID and tenant.
+type AccessLogConfig struct {
+	// Enabled turns access logging on
+	Enabled bool `yaml:"enabled" json:"enabled" env:"ACCESS_LOG_ENABLED"`
+
+	// SampleRate is the fraction of requests logged, between 0 and 1, when
+	// SlowThreshold is not set. An explicit 0 logs no request.
+	// Default: 1 (every request) when not set
+	SampleRate *float64 `yaml:"sample_rate" json:"sample_rate" env:"ACCESS_LOG_SAMPLE_RATE"`
+
+	// SlowThreshold, when set, restricts logging to requests taking at least
+	// this long, all of which are logged regardless of SampleRate
+	SlowThreshold time.Duration `yaml:"slow_threshold" json:"slow_threshold" env:"ACCESS_LOG_SLOW_THRESHOLD"`
+
+	// LatencyBuckets are the increasing upper bounds used to label each entry
+	// with a latency bucket such as "le_100ms", or "gt_5s" past the last one.
+	// Default: 10ms, 50ms, 100ms, 250ms, 500ms, 1s, 2.5s, 5s
+	LatencyBuckets []time.Duration `yaml:"latency_buckets" json:"latency_buckets"`
+
+	// RequestIDHeader is the header the request ID is read from, on the
+	// request or else on the response. Default: X-Request-ID
+	RequestIDHeader string `yaml:"request_id_header" json:"request_id_header" env:"ACCESS_LOG_REQUEST_ID_HEADER"`
+
+	// TenantHeader is the header the tenant is read from when the request
+	// context carries no tenant ID. Default: X-Tenant-ID
+	TenantHeader string `yaml:"tenant_header" json:"tenant_header" env:"ACCESS_LOG_TENANT_HEADER"`
 }
 
 // TLSConfig holds the TLS configuration for HTTPS support
@@ -71,6 +123,16 @@ type TLSConfig struct {
 
 	// Domains is a list of domain names to generate certificates for (when AutoGenerate is true)
 	Domains []string `yaml:"domains" json:"domains" env:"TLS_DOMAINS"`
+
+	// ClientCAFile is the path to a PEM bundle of the CAs trusted to sign
+	// client certificates. When set, client certificates are verified and the
+	// verified one is available to handlers through ClientCertFromContext
+	ClientCAFile string `yaml:"client_ca_file" json:"client_ca_file" env:"TLS_CLIENT_CA_FILE"`
+
+	// RequireClientCert rejects TLS handshakes without a valid client
+	// certificate (mutual TLS). Otherwise client certificates are optional
+	// and only verified when presented. Requires ClientCAFile
+	RequireClientCert bool `yaml:"require_client_cert" json:"require_client_cert" env:"TLS_REQUIRE_CLIENT_CERT"`
 }
 
 // Validate checks if the configuration is valid and sets default values
@@ -91,6 +153,10 @@ func (c *HTTPServerConfig) Validate() error {
 		return fmt.Errorf("%w: %d", ErrInvalidPort, c.Port)
 	}
 
+	if c.H2C && c.HTTP2 != nil && !*c.HTTP2 {
+		return ErrH2CWithoutHTTP2
+	}
+
 	// Set timeout defaults if zero values (programmatic defaults work reliably)
 	if c.ReadTimeout == 0 {
 		c.ReadTimeout = 15 * time.Second
@@ -112,8 +178,18 @@ func (c *HTTPServerConfig) Validate() error {
 		c.MaxHeaderBytes = 32 * 1024 // 32KB
 	}
 
+	if c.AccessLog != nil && c.AccessLog.Enabled {
+		if err := c.AccessLog.validate(); err != nil {
+			return err
+		}
+	}
+
 	// Validate TLS configuration if enabled
 	if c.TLS != nil && c.TLS.Enabled {
+		if c.TLS.RequireClientCert && c.TLS.ClientCAFile == "" {
+			return ErrTLSNoClientCAFile
+		}
+
 		// If using service, we don't need cert/key files
 		if c.TLS.UseService {
 			// UseService takes precedence over file-based configuration
@@ -140,3 +216,31 @@ func (c *HTTPServerConfig) Validate() error {
 
 	return nil
 }
+
+// validate checks the access log settings and sets their defaults.
+func (c *AccessLogConfig) validate() error {
+	if c.SampleRate == nil {
+		everyRequest := 1.0
+		c.SampleRate = &everyRequest
+	}
+	if *c.SampleRate < 0 || *c.SampleRate > 1 {
+		return fmt.Errorf("%w: %v", ErrInvalidAccessLogSampleRate, *c.SampleRate)
+	}
+
+	if len(c.LatencyBuckets) == 0 {
+		c.LatencyBuckets = append([]time.Duration(nil), defaultLatencyBuckets...)
+	}
+	for i, bound := range c.LatencyBuckets {
+		if bound <= 0 || (i > 0 && bound <= c.LatencyBuckets[i-1]) {
+			return fmt.Errorf("%w: %v", ErrInvalidLatencyBuckets, c.LatencyBuckets)
+		}
+	}
+
+	if c.RequestIDHeader == "" {
+		c.RequestIDHeader = DefaultRequestIDHeader
+	}
+	if c.TenantHeader == "" {
+		c.TenantHeader = DefaultTenantHeader
+	}
+	return nil
+}
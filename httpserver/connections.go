@@ -0,0 +1,47 @@
+package httpserver
+
+import (
+	"net"
+	"net/http"
+)
+
+// trackConnState records connection state transitions so the module can
+// report how many connections are open and how many were cut off when a
+// drain times out. It is installed as http.Server.ConnState.
+func (m *HTTPServerModule) trackConnState(conn net.Conn, state http.ConnState) {
+	m.connMu.Lock()
+	defer m.connMu.Unlock()
+
+	switch state {
+	case http.StateNew, http.StateActive, http.StateIdle:
+		if m.conns == nil {
+			m.conns = make(map[net.Conn]http.ConnState)
+		}
+		m.conns[conn] = state
+	case http.StateHijacked, http.StateClosed:
+		delete(m.conns, conn)
+	}
+}
+
+// ActiveConnections returns the number of connections currently serving a
+// request, or waiting for their first one. Idle keep-alive connections are
+// not counted.
+func (m *HTTPServerModule) ActiveConnections() int {
+	m.connMu.Lock()
+	defer m.connMu.Unlock()
+
+	active := 0
+	for _, state := range m.conns {
+		if state != http.StateIdle {
+			active++
+		}
+	}
+	return active
+}
+
+// openConnections returns the number of open connections, including idle ones
+func (m *HTTPServerModule) openConnections() int {
+	m.connMu.Lock()
+	defer m.connMu.Unlock()
+	return len(m.conns)
+}
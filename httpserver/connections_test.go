@@ -0,0 +1,213 @@
+package httpserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSubject is a modular.Subject that records every event it is asked to deliver
+type recordingSubject struct {
+	mu     sync.Mutex
+	events []cloudevents.Event
+}
+
+func (s *recordingSubject) RegisterObserver(modular.Observer, ...string) error { return nil }
+func (s *recordingSubject) UnregisterObserver(modular.Observer) error          { return nil }
+func (s *recordingSubject) GetObservers() []modular.ObserverInfo               { return nil }
+
+func (s *recordingSubject) NotifyObservers(_ context.Context, event cloudevents.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event.Clone())
+	return nil
+}
+
+// eventData returns the payload of the first recorded event of eventType
+func (s *recordingSubject) eventData(eventType string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, event := range s.events {
+		if event.Type() == eventType {
+			var data map[string]interface{}
+			if err := event.DataAs(&data); err != nil {
+				return nil, false
+			}
+			return data, true
+		}
+	}
+	return nil, false
+}
+
+// startDrainTestServer starts a module serving handler on a free port
+func startDrainTestServer(t *testing.T, handler http.Handler, shutdownTimeout time.Duration) (*HTTPServerModule, *recordingSubject, string) {
+	t.Helper()
+
+	port, err := findFreePort()
+	require.NoError(t, err)
+
+	module := newTestModule(t)
+	module.config.Port = port
+	module.config.ShutdownTimeout = shutdownTimeout
+	module.handler = handler
+	subject := &recordingSubject{}
+	require.NoError(t, module.RegisterObservers(subject))
+
+	require.NoError(t, module.Start(context.Background()))
+	t.Cleanup(func() {
+		if module.started {
+			_ = module.Stop(context.Background())
+		}
+	})
+	return module, subject, fmt.Sprintf("127.0.0.1:%d", port)
+}
+
+// getAsync issues a GET request in the background and reports its outcome
+func getAsync(url string) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		client := &http.Client{Timeout: 10 * time.Second}
+		resp, err := client.Get(url)
+		if err != nil {
+			result <- err
+			return
+		}
+		defer resp.Body.Close()
+		if _, err := io.ReadAll(resp.Body); err != nil {
+			result <- err
+			return
+		}
+		if resp.StatusCode != http.StatusOK {
+			result <- fmt.Errorf("unexpected status %d", resp.StatusCode)
+			return
+		}
+		result <- nil
+	}()
+	return result
+}
+
+func TestStopWaitsForInFlightRequests(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+	module, subject, addr := startDrainTestServer(t, handler, 5*time.Second)
+
+	result := getAsync("http://" + addr)
+	<-started
+	assert.Equal(t, 1, module.ActiveConnections())
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- module.Stop(context.Background()) }()
+
+	// The listener is closed as soon as draining begins
+	assert.Eventually(t, func() bool {
+		conn, err := net.DialTimeout("tcp", addr, 100*time.Millisecond)
+		if err == nil {
+			conn.Close()
+		}
+		return err != nil
+	}, 2*time.Second, 10*time.Millisecond, "new connections should be refused while draining")
+
+	select {
+	case <-stopped:
+		t.Fatal("Stop returned before the in-flight request completed")
+	default:
+	}
+
+	close(release)
+	require.NoError(t, <-result, "in-flight request should complete during the drain")
+	require.NoError(t, <-stopped)
+
+	require.Eventually(t, func() bool {
+		_, ok := subject.eventData(EventTypeServerDrained)
+		return ok
+	}, time.Second, 10*time.Millisecond)
+	data, _ := subject.eventData(EventTypeServerDrained)
+	assert.EqualValues(t, 1, data["active_connections"])
+	assert.EqualValues(t, 0, data["force_closed_connections"])
+}
+
+func TestStopForceClosesAfterShutdownTimeout(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	shutdownTimeout := 200 * time.Millisecond
+	module, subject, addr := startDrainTestServer(t, handler, shutdownTimeout)
+
+	result := getAsync("http://" + addr)
+	<-started
+
+	stopped := make(chan error, 1)
+	stopStart := time.Now()
+	go func() { stopped <- module.Stop(context.Background()) }()
+
+	assert.Eventually(t, func() bool {
+		reports, err := module.HealthCheck(context.Background())
+		return err == nil && reports[0].Status == modular.StatusDegraded
+	}, time.Second, 5*time.Millisecond, "server should report degraded while draining")
+
+	require.NoError(t, <-stopped)
+	assert.GreaterOrEqual(t, time.Since(stopStart), shutdownTimeout, "Stop should wait for the shutdown timeout")
+	assert.Error(t, <-result, "slow request should be cut off once the drain times out")
+
+	reports, err := module.HealthCheck(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, modular.StatusUnhealthy, reports[0].Status)
+
+	require.Eventually(t, func() bool {
+		_, ok := subject.eventData(EventTypeServerDrained)
+		return ok
+	}, time.Second, 10*time.Millisecond)
+	data, _ := subject.eventData(EventTypeServerDrained)
+	assert.EqualValues(t, 1, data["force_closed_connections"])
+}
+
+func TestActiveConnectionsIgnoresIdle(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	module, _, addr := startDrainTestServer(t, handler, 5*time.Second)
+
+	// The keep-alive connection stays open but idle after the response
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Get("http://" + addr)
+	require.NoError(t, err)
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	assert.Eventually(t, func() bool {
+		return module.openConnections() == 1 && module.ActiveConnections() == 0
+	}, time.Second, 10*time.Millisecond)
+
+	reports, err := module.HealthCheck(context.Background())
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, modular.StatusHealthy, reports[0].Status)
+	assert.Equal(t, 0, reports[0].Details["active_connections"])
+	assert.Equal(t, 1, reports[0].Details["open_connections"])
+
+	client.CloseIdleConnections()
+}
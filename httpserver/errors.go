@@ -8,4 +8,7 @@ import (
 var (
 	// ErrNoSubjectForEventEmission is returned when trying to emit events without a subject
 	ErrNoSubjectForEventEmission = errors.New("no subject available for event emission")
+
+	// ErrTLSNoClientCACertificates is returned when the client CA file contains no PEM certificates
+	ErrTLSNoClientCACertificates = errors.New("no certificates found in client CA file")
 )
@@ -6,6 +6,7 @@ const (
 	// Server lifecycle events
 	EventTypeServerStarted = "com.modular.httpserver.server.started"
 	EventTypeServerStopped = "com.modular.httpserver.server.stopped"
+	EventTypeServerDrained = "com.modular.httpserver.server.drained"
 
 	// Request handling events
 	EventTypeRequestReceived = "com.modular.httpserver.request.received"
@@ -0,0 +1,42 @@
+package httpserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+)
+
+// Compile-time check that the module reports its health
+var _ modular.HealthProvider = (*HTTPServerModule)(nil)
+
+// HealthCheck implements modular.HealthProvider.
+// The server is healthy while serving, degraded while draining for shutdown
+// and unhealthy when not running. Details include active_connections (those
+// serving a request) and open_connections (including idle keep-alives).
+func (m *HTTPServerModule) HealthCheck(_ context.Context) ([]modular.HealthReport, error) {
+	m.mu.RLock()
+	started, draining := m.started, m.draining
+	m.mu.RUnlock()
+
+	report := modular.HealthReport{
+		Module:    ModuleName,
+		Component: "server",
+		Status:    modular.StatusHealthy,
+		Message:   "serving requests",
+		CheckedAt: time.Now(),
+		Details: map[string]any{
+			"active_connections": m.ActiveConnections(),
+			"open_connections":   m.openConnections(),
+		},
+	}
+	switch {
+	case !started:
+		report.Status = modular.StatusUnhealthy
+		report.Message = "server not started"
+	case draining:
+		report.Status = modular.StatusDegraded
+		report.Message = "draining connections for shutdown"
+	}
+	return []modular.HealthReport{report}, nil
+}
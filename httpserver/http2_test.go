@@ -0,0 +1,129 @@
+package httpserver
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// protoHandler responds with the protocol the request arrived over
+var protoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-Request-Proto", r.Proto)
+	w.WriteHeader(http.StatusOK)
+})
+
+// startProtocolTestServer starts a module with the given protocol settings on a free port
+func startProtocolTestServer(t *testing.T, configure func(*HTTPServerConfig)) *HTTPServerModule {
+	t.Helper()
+
+	port, err := findFreePort()
+	require.NoError(t, err)
+
+	module := newTestModule(t)
+	module.config.Port = port
+	module.config.ReadHeaderTimeout = 2 * time.Second
+	configure(module.config)
+	module.handler = protoHandler
+
+	require.NoError(t, module.Start(context.Background()))
+	t.Cleanup(func() { _ = module.Stop(context.Background()) })
+	return module
+}
+
+// h2cClient speaks HTTP/2 with prior knowledge over cleartext connections only
+func h2cClient() *http.Client {
+	transport := &http.Transport{Protocols: new(http.Protocols)}
+	transport.Protocols.SetUnencryptedHTTP2(true)
+	return &http.Client{Transport: transport, Timeout: 5 * time.Second}
+}
+
+func TestH2C(t *testing.T) {
+	module := startProtocolTestServer(t, func(c *HTTPServerConfig) { c.H2C = true })
+
+	client := h2cClient()
+	defer client.CloseIdleConnections()
+	resp, err := client.Get("http://" + module.server.Addr)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 2, resp.ProtoMajor, "expected HTTP/2 to be negotiated")
+	assert.Equal(t, "HTTP/2.0", resp.Header.Get("X-Request-Proto"))
+	assert.Equal(t, 2*time.Second, module.server.ReadHeaderTimeout, "ReadHeaderTimeout should still be applied")
+
+	// HTTP/1.1 clients are still served
+	plain := &http.Client{Timeout: 5 * time.Second}
+	resp1, err := plain.Get("http://" + module.server.Addr)
+	require.NoError(t, err)
+	defer resp1.Body.Close()
+	assert.Equal(t, 1, resp1.ProtoMajor)
+}
+
+func TestH2CDisabledByDefault(t *testing.T) {
+	module := startProtocolTestServer(t, func(*HTTPServerConfig) {})
+
+	client := h2cClient()
+	defer client.CloseIdleConnections()
+	resp, err := client.Get("http://" + module.server.Addr)
+	if err == nil {
+		resp.Body.Close()
+	}
+	assert.Error(t, err, "cleartext HTTP/2 should be rejected unless H2C is enabled")
+}
+
+// getOverTLS requests module over TLS with a client offering HTTP/2
+func getOverTLS(t *testing.T, module *HTTPServerModule) *http.Response {
+	t.Helper()
+	transport := &http.Transport{
+		TLSClientConfig:   &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // self-signed test certificate
+		ForceAttemptHTTP2: true,
+	}
+	client := &http.Client{Transport: transport, Timeout: 5 * time.Second}
+	t.Cleanup(client.CloseIdleConnections)
+
+	resp, err := client.Get("https://" + module.server.Addr)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestHTTP2OverTLS(t *testing.T) {
+	for name, http2 := range map[string]*bool{"default": nil, "enabled": boolPtr(true)} {
+		t.Run(name, func(t *testing.T) {
+			module := startProtocolTestServer(t, func(c *HTTPServerConfig) {
+				c.HTTP2 = http2
+				c.TLS = &TLSConfig{Enabled: true, AutoGenerate: true, Domains: []string{"localhost"}}
+			})
+
+			resp := getOverTLS(t, module)
+			assert.Equal(t, 2, resp.ProtoMajor, "expected HTTP/2 to be negotiated over TLS")
+			assert.Equal(t, "HTTP/2.0", resp.Header.Get("X-Request-Proto"))
+			assert.Equal(t, 2*time.Second, module.server.ReadHeaderTimeout, "ReadHeaderTimeout should still be applied")
+		})
+	}
+}
+
+func TestHTTP2DisabledOverTLS(t *testing.T) {
+	module := startProtocolTestServer(t, func(c *HTTPServerConfig) {
+		c.HTTP2 = boolPtr(false)
+		c.TLS = &TLSConfig{Enabled: true, AutoGenerate: true, Domains: []string{"localhost"}}
+	})
+
+	resp := getOverTLS(t, module)
+	assert.Equal(t, 1, resp.ProtoMajor, "HTTP/2 must not be negotiated when HTTP2 is false")
+	assert.Equal(t, "HTTP/1.1", resp.Header.Get("X-Request-Proto"))
+}
+
+func TestH2CRequiresHTTP2(t *testing.T) {
+	cfg := &HTTPServerConfig{H2C: true, HTTP2: boolPtr(false)}
+	require.ErrorIs(t, cfg.Validate(), ErrH2CWithoutHTTP2)
+}
+
+// boolPtr returns a pointer to v for the optional HTTP2 setting
+func boolPtr(v bool) *bool {
+	return &v
+}
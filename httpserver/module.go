@@ -25,6 +25,7 @@
 package httpserver
 
 import (
+	"bufio"
 	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
@@ -40,6 +41,7 @@ import (
 	"net/http"
 	"os"
 	"reflect"
+	"slices"
 	"sync"
 	"time"
 
@@ -92,9 +94,13 @@ type HTTPServerModule struct {
 	handler            http.Handler
 	started            bool
 	certificateService CertificateService
+	clientCAs          *x509.CertPool  // CAs verifying client certificates, nil without mTLS
 	subject            modular.Subject // For event observation (guarded by mu)
 	draining           bool            // Set by PreStop to signal drain phase
 	mu                 sync.RWMutex
+
+	conns  map[net.Conn]http.ConnState // Open connections, maintained by trackConnState
+	connMu sync.Mutex
 }
 
 // Make sure the HTTPServerModule implements the Module interface
@@ -237,23 +243,46 @@ func (m *HTTPServerModule) Start(ctx context.Context) error {
 	// Create address string from host and port
 	addr := fmt.Sprintf("%s:%d", m.config.Host, m.config.Port)
 
+	clientCAs, err := m.loadClientCAs()
+	if err != nil {
+		return err
+	}
+	m.clientCAs = clientCAs
+
 	// Always ensure the handler is wrapped to emit request events, even if a plain
 	// handler was set after construction (e.g., in tests). Wrapping multiple times is
 	// safe functionally, but to avoid duplicate emissions, only wrap if it's not our
 	// wrapper already. Since we can't reliably detect prior wrapping without adding
 	// types, we conservatively wrap here to guarantee event emission.
 	effectiveHandler := m.wrapHandlerWithRequestEvents(m.handler)
+	if m.clientCAs != nil {
+		effectiveHandler = m.wrapHandlerWithClientCert(effectiveHandler)
+	}
+	if m.config.AccessLog != nil && m.config.AccessLog.Enabled {
+		effectiveHandler = m.wrapHandlerWithAccessLog(effectiveHandler)
+	}
 
 	// Create server with configured timeouts
 	m.server = &http.Server{
-		Addr:           addr,
-		Handler:        effectiveHandler,
-		ReadTimeout:    m.config.ReadTimeout,
-		WriteTimeout:   m.config.WriteTimeout,
-		IdleTimeout:    m.config.IdleTimeout,
-		MaxHeaderBytes: m.config.MaxHeaderBytes,
+		Addr:              addr,
+		Handler:           effectiveHandler,
+		ReadTimeout:       m.config.ReadTimeout,
+		ReadHeaderTimeout: m.config.ReadHeaderTimeout,
+		WriteTimeout:      m.config.WriteTimeout,
+		IdleTimeout:       m.config.IdleTimeout,
+		MaxHeaderBytes:    m.config.MaxHeaderBytes,
+		ConnState:         m.trackConnState,
+		Protocols:         m.protocols(),
+	}
+	if m.http2Disabled() {
+		// A non-nil, empty TLSNextProto stops net/http from enabling HTTP/2
+		m.server.TLSNextProto = map[string]func(*http.Server, *tls.Conn, http.Handler){}
 	}
 
+	m.mu.Lock()
+	m.draining = false
+	m.mu.Unlock()
+
 	// Start the server in a goroutine
 	go m.runServer(ctx, addr)
 
@@ -283,6 +312,26 @@ func (m *HTTPServerModule) Start(ctx context.Context) error {
 	return nil
 }
 
+// http2Disabled reports whether HTTP2 is explicitly set to false, opting
+// out of the net/http default of HTTP/2 on TLS connections
+func (m *HTTPServerModule) http2Disabled() bool {
+	return m.config.HTTP2 != nil && !*m.config.HTTP2
+}
+
+// protocols returns the protocols the server accepts, or nil to keep the
+// net/http defaults: HTTP/1.1, plus HTTP/2 on TLS unless TLSNextProto
+// disables it
+func (m *HTTPServerModule) protocols() *http.Protocols {
+	if !m.config.H2C {
+		return nil
+	}
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(m.config.H2C)
+	return protocols
+}
+
 // runServer starts the HTTP server with appropriate TLS configuration
 func (m *HTTPServerModule) runServer(ctx context.Context, addr string) {
 	defer func() {
@@ -312,6 +361,10 @@ func (m *HTTPServerModule) startTLSServer(ctx context.Context) error {
 	tlsConfig := &tls.Config{
 		MinVersion: tls.VersionTLS12,
 	}
+	m.configureClientAuth(tlsConfig)
+	if m.http2Disabled() {
+		tlsConfig.NextProtos = slices.DeleteFunc(tlsConfig.NextProtos, func(proto string) bool { return proto == "h2" })
+	}
 
 	// UseService flag takes precedence
 	if m.config.TLS.UseService {
@@ -319,7 +372,7 @@ func (m *HTTPServerModule) startTLSServer(ctx context.Context) error {
 	} else if m.config.TLS.AutoGenerate {
 		return m.startWithAutoGeneratedCerts(ctx, tlsConfig)
 	} else {
-		return m.startWithCertificateFiles(ctx)
+		return m.startWithCertificateFiles(ctx, tlsConfig)
 	}
 }
 
@@ -382,7 +435,7 @@ func (m *HTTPServerModule) startWithAutoGeneratedCerts(ctx context.Context, tlsC
 }
 
 // startWithCertificateFiles starts server using provided certificate files
-func (m *HTTPServerModule) startWithCertificateFiles(ctx context.Context) error {
+func (m *HTTPServerModule) startWithCertificateFiles(ctx context.Context, tlsConfig *tls.Config) error {
 	m.logger.Info("Using TLS configuration", "cert", m.config.TLS.CertFile, "key", m.config.TLS.KeyFile)
 
 	// Emit TLS enabled event SYNCHRONOUSLY
@@ -395,6 +448,7 @@ func (m *HTTPServerModule) startWithCertificateFiles(ctx context.Context) error
 		m.logger.Debug("Failed to emit TLS configured event", "error", emitErr)
 	}
 
+	m.server.TLSConfig = tlsConfig
 	if err := m.server.ListenAndServeTLS(m.config.TLS.CertFile, m.config.TLS.KeyFile); err != nil {
 		return fmt.Errorf("failed to start HTTPS server with certificate files: %w", err)
 	}
@@ -477,17 +531,16 @@ func (m *HTTPServerModule) emitTLSConfiguredEvent(ctx context.Context) {
 }
 
 // Stop stops the HTTP server gracefully.
-// This method initiates a graceful shutdown of the HTTP server,
-// allowing existing connections to finish processing before closing.
+// This method drains the HTTP server, allowing in-flight requests to finish
+// before closing.
 //
 // The shutdown process:
 //  1. Check if server is running
-//  2. Create shutdown context with configured timeout
-//  3. Call server.Shutdown() to stop accepting new connections
-//  4. Wait for existing connections to complete or timeout
-//  5. Mark server as stopped
-//
-// If the shutdown timeout is exceeded, the server will be forcefully closed.
+//  2. Stop accepting new connections and close idle ones
+//  3. Wait up to ShutdownTimeout for in-flight requests to complete
+//  4. Force-close any connections still open after the timeout
+//  5. Emit a server drained event reporting how many connections were
+//     forcibly closed, then mark the server as stopped
 func (m *HTTPServerModule) Stop(ctx context.Context) error {
 	if m.server == nil || !m.started {
 		return ErrServerNotStarted
@@ -495,6 +548,10 @@ func (m *HTTPServerModule) Stop(ctx context.Context) error {
 
 	m.logger.Info("Stopping HTTP server", "timeout", m.config.ShutdownTimeout)
 
+	m.mu.Lock()
+	m.draining = true
+	m.mu.Unlock()
+
 	// Create a context with timeout for shutdown
 	shutdownCtx, cancel := context.WithTimeout(
 		ctx,
@@ -502,19 +559,38 @@ func (m *HTTPServerModule) Stop(ctx context.Context) error {
 	)
 	defer cancel()
 
-	// Shutdown the server gracefully
-	err := m.server.Shutdown(shutdownCtx)
-	if err != nil {
-		return fmt.Errorf("error shutting down HTTP server: %w", err)
+	// Shutdown closes the listeners immediately, so no new connections are
+	// accepted while in-flight requests drain
+	drainStart := time.Now()
+	activeAtShutdown := m.ActiveConnections()
+	forceClosed := 0
+	if err := m.server.Shutdown(shutdownCtx); err != nil {
+		forceClosed = m.openConnections()
+		m.logger.Warn("HTTP server drain did not complete, closing remaining connections",
+			"error", err, "connections", forceClosed)
+		if closeErr := m.server.Close(); closeErr != nil {
+			return fmt.Errorf("error closing HTTP server: %w", closeErr)
+		}
 	}
 
+	m.mu.Lock()
 	m.started = false
+	m.mu.Unlock()
 	m.logger.Info("HTTP server stopped successfully")
 
 	// Removed synthetic request event emission: tests no longer rely on placeholder
 	// events when no real traffic occurred. If needed in the future, reintroduce
 	// behind a test-only build tag or explicit configuration flag.
 
+	drainedEvent := modular.NewCloudEvent(EventTypeServerDrained, "httpserver-service", map[string]interface{}{
+		"active_connections":       activeAtShutdown,
+		"force_closed_connections": forceClosed,
+		"drain_duration_ms":        time.Since(drainStart).Milliseconds(),
+	}, nil)
+	if emitErr := m.EmitEvent(ctx, drainedEvent); emitErr != nil {
+		m.logger.Debug("Failed to emit server drained event", "error", emitErr)
+	}
+
 	// Emit server stopped event synchronously
 	event := modular.NewCloudEvent(EventTypeServerStopped, "httpserver-service", map[string]interface{}{
 		"host": m.config.Host,
@@ -747,11 +823,14 @@ func (m *HTTPServerModule) wrapHandlerWithRequestEvents(handler http.Handler) ht
 	})
 }
 
-// responseWriter wraps http.ResponseWriter to capture status code
+// responseWriter wraps http.ResponseWriter to capture status code and body size.
+// It passes Flush and Hijack through to the wrapped writer, and Unwrap lets
+// http.ResponseController reach its other optional methods.
 type responseWriter struct {
 	http.ResponseWriter
 	statusCode    int
-	headerWritten bool // Track if WriteHeader has been called
+	headerWritten bool  // Track if WriteHeader has been called
+	bytesWritten  int64 // Body bytes written by the handler
 }
 
 func (rw *responseWriter) WriteHeader(code int) {
@@ -771,18 +850,51 @@ func (rw *responseWriter) Write(data []byte) (int, error) {
 	}
 
 	n, err := rw.ResponseWriter.Write(data)
+	rw.bytesWritten += int64(n)
 	if err != nil {
 		return n, fmt.Errorf("failed to write HTTP response: %w", err)
 	}
 	return n, nil
 }
 
+// Flush implements http.Flusher, so that streaming handlers such as
+// server-sent events work behind the wrapper. It does nothing if the wrapped
+// writer cannot flush.
+func (rw *responseWriter) Flush() {
+	// Flushing sends the headers, with an implicit 200
+	if !rw.headerWritten {
+		rw.WriteHeader(http.StatusOK)
+	}
+	_ = http.NewResponseController(rw.ResponseWriter).Flush()
+}
+
+// Hijack implements http.Hijacker, so that handlers can take over the
+// connection, e.g. for WebSocket upgrades.
+func (rw *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, buf, err := http.NewResponseController(rw.ResponseWriter).Hijack()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to hijack HTTP connection: %w", err)
+	}
+	// The handler now answers on the raw connection, typically switching protocols
+	if !rw.headerWritten {
+		rw.statusCode = http.StatusSwitchingProtocols
+		rw.headerWritten = true
+	}
+	return conn, buf, nil
+}
+
+// Unwrap returns the wrapped http.ResponseWriter for http.ResponseController.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // GetRegisteredEventTypes implements the ObservableModule interface.
 // Returns all event types that this httpserver module can emit.
 func (m *HTTPServerModule) GetRegisteredEventTypes() []string {
 	return []string{
 		EventTypeServerStarted,
 		EventTypeServerStopped,
+		EventTypeServerDrained,
 		EventTypeRequestReceived,
 		EventTypeRequestHandled,
 		EventTypeTLSEnabled,
@@ -308,6 +308,7 @@ func TestStartStop(t *testing.T) {
 	mockLogger.On("Info", "HTTP server stopped successfully").Return()
 	// Expect Debug calls for failed event emissions (when no observer is configured)
 	mockLogger.On("Debug", "Failed to emit server started event", "error", mock.AnythingOfType("*errors.errorString")).Return()
+	mockLogger.On("Debug", "Failed to emit server drained event", "error", mock.AnythingOfType("*errors.errorString")).Return()
 	mockLogger.On("Debug", "Failed to emit server stopped event", "error", mock.AnythingOfType("*errors.errorString")).Return()
 	// Allow for request event debug calls as well
 	mockLogger.On("Debug", "Failed to emit request received event", "error", mock.AnythingOfType("*errors.errorString")).Return().Maybe()
@@ -458,6 +459,7 @@ func TestTLSSupport(t *testing.T) {
 	mockLogger.On("Info", "HTTP server stopped successfully").Return()
 	// Expect Debug calls for failed event emissions (when no observer is configured)
 	mockLogger.On("Debug", "Failed to emit server started event", "error", mock.AnythingOfType("*errors.errorString")).Return()
+	mockLogger.On("Debug", "Failed to emit server drained event", "error", mock.AnythingOfType("*errors.errorString")).Return()
 	mockLogger.On("Debug", "Failed to emit server stopped event", "error", mock.AnythingOfType("*errors.errorString")).Return()
 	mockLogger.On("Debug", "Failed to emit TLS configured event", "error", mock.AnythingOfType("*errors.errorString")).Return()
 	// Allow for request event debug calls as well
@@ -0,0 +1,239 @@
+package httpserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCA is a certificate authority issuing certificates for mTLS tests
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+// issue returns a certificate for subject signed by the CA
+func (ca *testCA) issue(t *testing.T, subject pkix.Name, usage x509.ExtKeyUsage) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      subject,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+// writePEM writes a certificate, and its key when it has one, to PEM files in dir
+func writePEM(t *testing.T, dir, name string, cert tls.Certificate) (string, string) {
+	t.Helper()
+	certFile := filepath.Join(dir, name+".pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0600))
+	if cert.PrivateKey == nil {
+		return certFile, ""
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	require.NoError(t, err)
+	keyFile := filepath.Join(dir, name+"-key.pem")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0600))
+	return certFile, keyFile
+}
+
+// mtlsFixture holds a started mTLS server and the client certificates of the tests
+type mtlsFixture struct {
+	url       string
+	serverCAs *x509.CertPool
+	trusted   tls.Certificate
+	untrusted tls.Certificate
+}
+
+// startMTLSServer starts a module echoing the client certificate subject from the request context
+func startMTLSServer(t *testing.T, requireClientCert bool) *mtlsFixture {
+	t.Helper()
+	dir := t.TempDir()
+	ca := newTestCA(t, "Test Client CA")
+	rogueCA := newTestCA(t, "Rogue CA")
+
+	serverCert := ca.issue(t, pkix.Name{CommonName: "127.0.0.1"}, x509.ExtKeyUsageServerAuth)
+	certFile, keyFile := writePEM(t, dir, "server", serverCert)
+	caFile, _ := writePEM(t, dir, "ca", tls.Certificate{Certificate: [][]byte{ca.cert.Raw}})
+
+	port, err := findFreePort()
+	require.NoError(t, err)
+	module := newTestModule(t)
+	module.config.Port = port
+	module.config.TLS = &TLSConfig{
+		Enabled:           true,
+		CertFile:          certFile,
+		KeyFile:           keyFile,
+		ClientCAFile:      caFile,
+		RequireClientCert: requireClientCert,
+	}
+	module.handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cert, ok := ClientCertFromContext(r.Context())
+		if !ok {
+			_, _ = w.Write([]byte("anonymous"))
+			return
+		}
+		_, _ = w.Write([]byte(cert.Subject.CommonName))
+	})
+	require.NoError(t, module.Start(context.Background()))
+	t.Cleanup(func() { _ = module.Stop(context.Background()) })
+
+	serverCAs := x509.NewCertPool()
+	serverCAs.AddCert(ca.cert)
+	return &mtlsFixture{
+		url:       fmt.Sprintf("https://127.0.0.1:%d/", port),
+		serverCAs: serverCAs,
+		trusted:   ca.issue(t, pkix.Name{CommonName: "billing", Organization: []string{"Acme"}}, x509.ExtKeyUsageClientAuth),
+		untrusted: rogueCA.issue(t, pkix.Name{CommonName: "billing", Organization: []string{"Acme"}}, x509.ExtKeyUsageClientAuth),
+	}
+}
+
+// get requests the fixture server presenting clientCert, if any, and returns the response body
+func (f *mtlsFixture) get(clientCert ...tls.Certificate) (string, error) {
+	client := &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{
+			RootCAs:    f.serverCAs,
+			MinVersion: tls.VersionTLS12,
+			// Present the certificate even when its issuer is not among the
+			// server's acceptable CAs, which tls.Config.Certificates would not
+			GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				if len(clientCert) == 0 {
+					return &tls.Certificate{}, nil
+				}
+				return &clientCert[0], nil
+			},
+		}},
+	}
+	resp, err := client.Get(f.url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	return string(body), err
+}
+
+func TestMTLS_RequiredClientCert(t *testing.T) {
+	fixture := startMTLSServer(t, true)
+
+	body, err := fixture.get(fixture.trusted)
+	require.NoError(t, err)
+	assert.Equal(t, "billing", body, "the verified subject is available to handlers")
+
+	_, err = fixture.get()
+	require.Error(t, err, "a client without a certificate must be rejected")
+
+	_, err = fixture.get(fixture.untrusted)
+	require.Error(t, err, "a certificate from an untrusted CA must be rejected")
+}
+
+func TestMTLS_OptionalClientCert(t *testing.T) {
+	fixture := startMTLSServer(t, false)
+
+	body, err := fixture.get(fixture.trusted)
+	require.NoError(t, err)
+	assert.Equal(t, "billing", body)
+
+	body, err = fixture.get()
+	require.NoError(t, err)
+	assert.Equal(t, "anonymous", body, "clients without a certificate are accepted")
+
+	_, err = fixture.get(fixture.untrusted)
+	require.Error(t, err, "presented certificates are still verified")
+}
+
+func TestRequireClientCertSubjects(t *testing.T) {
+	ca := newTestCA(t, "Test Client CA")
+	handler := RequireClientCertSubjects("billing", "CN=orders,O=Acme")(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	tests := []struct {
+		name    string
+		subject *pkix.Name
+		status  int
+	}{
+		{name: "common name allowed", subject: &pkix.Name{CommonName: "billing", Organization: []string{"Other"}}, status: http.StatusNoContent},
+		{name: "distinguished name allowed", subject: &pkix.Name{CommonName: "orders", Organization: []string{"Acme"}}, status: http.StatusNoContent},
+		{name: "distinguished name mismatch", subject: &pkix.Name{CommonName: "orders", Organization: []string{"Evil"}}, status: http.StatusForbidden},
+		{name: "subject not allowed", subject: &pkix.Name{CommonName: "reports"}, status: http.StatusForbidden},
+		{name: "no client certificate", status: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.subject != nil {
+				cert := ca.issue(t, *tt.subject, x509.ExtKeyUsageClientAuth)
+				req = req.WithContext(context.WithValue(req.Context(), clientCertContextKey{}, cert.Leaf))
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.status, recorder.Code)
+		})
+	}
+}
+
+func TestMTLS_Configuration(t *testing.T) {
+	cfg := &HTTPServerConfig{TLS: &TLSConfig{Enabled: true, UseService: true, RequireClientCert: true}}
+	require.ErrorIs(t, cfg.Validate(), ErrTLSNoClientCAFile)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0600))
+	module := newTestModule(t)
+	module.config.TLS = &TLSConfig{Enabled: true, CertFile: "cert.pem", KeyFile: "key.pem", ClientCAFile: caFile}
+	module.handler = http.NotFoundHandler()
+	require.ErrorIs(t, module.Start(context.Background()), ErrTLSNoClientCACertificates)
+	assert.False(t, module.started)
+}
@@ -114,8 +114,9 @@ func (m *HTTPServerModule) CollectMetrics(_ context.Context) modular.ModuleMetri
 	return modular.ModuleMetrics{
 		Name: ModuleName,
 		Values: map[string]float64{
-			"started": started,
-			"port":    port,
+			"started":            started,
+			"port":               port,
+			"active_connections": float64(m.ActiveConnections()),
 		},
 	}
 }
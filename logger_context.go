@@ -0,0 +1,99 @@
+package modular
+
+import "context"
+
+// ContextualLogger is an optional interface for loggers that can attach values
+// carried by a context, such as request, trace, and tenant IDs, to log calls.
+type ContextualLogger interface {
+	Logger
+
+	// WithContext returns a logger that adds the values found in ctx to every
+	// log call.
+	WithContext(ctx context.Context) Logger
+}
+
+// LoggerWithContext returns logger bound to ctx if it implements
+// ContextualLogger, or logger unchanged otherwise. Handlers can use it
+// regardless of the logger the application was configured with:
+//
+//	func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	    logger := modular.LoggerWithContext(r.Context(), h.logger)
+//	    logger.Info("Handling request") // includes request_id, trace_id, tenant_id
+//	}
+func LoggerWithContext(ctx context.Context, logger Logger) Logger {
+	if ctx == nil || logger == nil {
+		return logger
+	}
+	if contextual, ok := logger.(ContextualLogger); ok {
+		return contextual.WithContext(ctx)
+	}
+	return logger
+}
+
+// ContextLogFields extracts key-value pairs to add to log calls from a context.
+// It returns nil when the context carries no value of interest.
+type ContextLogFields func(ctx context.Context) []any
+
+// traceIDContextKey is the context key under which WithTraceID stores the ID
+type traceIDContextKey struct{}
+
+// WithTraceID returns a copy of ctx carrying the given trace ID, for use by
+// tracing middleware that does not already store one the logger can read.
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDContextKey{}, traceID)
+}
+
+// TraceIDFromContext returns the trace ID stored by WithTraceID
+func TraceIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(traceIDContextKey{}).(string)
+	return id, ok && id != ""
+}
+
+// TraceIDLogFields logs the trace ID stored by WithTraceID as "trace_id"
+func TraceIDLogFields(ctx context.Context) []any {
+	if id, ok := TraceIDFromContext(ctx); ok {
+		return []any{"trace_id", id}
+	}
+	return nil
+}
+
+// TenantIDLogFields logs the tenant ID of a TenantContext as "tenant_id"
+func TenantIDLogFields(ctx context.Context) []any {
+	if id, ok := GetTenantIDFromContext(ctx); ok && id != "" {
+		return []any{"tenant_id", string(id)}
+	}
+	return nil
+}
+
+// ContextLoggerDecorator is a ContextualLogger that adds the fields its
+// extractors find in a context to every log call of the logger returned by
+// WithContext. Log calls made on the decorator itself are passed through.
+type ContextLoggerDecorator struct {
+	*BaseLoggerDecorator
+	extractors []ContextLogFields
+}
+
+// NewContextLoggerDecorator creates a contextual logger wrapping inner. Without
+// extractors, the trace ID and tenant ID are extracted.
+func NewContextLoggerDecorator(inner Logger, extractors ...ContextLogFields) *ContextLoggerDecorator {
+	if len(extractors) == 0 {
+		extractors = []ContextLogFields{TraceIDLogFields, TenantIDLogFields}
+	}
+	return &ContextLoggerDecorator{
+		BaseLoggerDecorator: NewBaseLoggerDecorator(inner),
+		extractors:          extractors,
+	}
+}
+
+// WithContext returns a logger that adds the fields extracted from ctx to
+// every log call, or the decorator itself if ctx carries none.
+func (d *ContextLoggerDecorator) WithContext(ctx context.Context) Logger {
+	var fields []any
+	for _, extract := range d.extractors {
+		fields = append(fields, extract(ctx)...)
+	}
+	if len(fields) == 0 {
+		return d
+	}
+	return NewValueInjectionLoggerDecorator(d.inner, fields...)
+}
@@ -0,0 +1,52 @@
+package modular
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggerWithContext_InjectsContextFields(t *testing.T) {
+	inner := NewTestLogger()
+	logger := NewContextLoggerDecorator(inner)
+
+	ctx := WithTraceID(context.Background(), "trace-123")
+	LoggerWithContext(ctx, logger).Info("Handling request", "path", "/orders")
+	LoggerWithContext(ctx, logger).Error("Request failed")
+
+	entries := inner.GetEntries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, []any{"trace_id", "trace-123", "path", "/orders"}, entries[0].Args)
+	assert.Equal(t, []any{"trace_id", "trace-123"}, entries[1].Args)
+
+	inner.Clear()
+	tenantCtx := NewTenantContext(WithTraceID(context.Background(), "trace-456"), "tenant-b")
+	LoggerWithContext(tenantCtx, logger).Warn("Quota exceeded")
+	require.Len(t, inner.GetEntries(), 1)
+	assert.Equal(t, []any{"trace_id", "trace-456", "tenant_id", "tenant-b"}, inner.GetEntries()[0].Args)
+}
+
+func TestLoggerWithContext_CustomExtractors(t *testing.T) {
+	type userKey struct{}
+	inner := NewTestLogger()
+	logger := NewContextLoggerDecorator(inner, func(ctx context.Context) []any {
+		if user, ok := ctx.Value(userKey{}).(string); ok {
+			return []any{"user", user}
+		}
+		return nil
+	})
+
+	LoggerWithContext(context.WithValue(context.Background(), userKey{}, "alice"), logger).Debug("Loaded profile")
+	require.Len(t, inner.GetEntries(), 1)
+	assert.Equal(t, []any{"user", "alice"}, inner.GetEntries()[0].Args)
+
+	// A context without the values returns the decorator itself
+	assert.Same(t, logger, LoggerWithContext(context.Background(), logger))
+}
+
+func TestLoggerWithContext_NonContextualLogger(t *testing.T) {
+	inner := NewTestLogger()
+	assert.Same(t, inner, LoggerWithContext(WithTraceID(context.Background(), "trace-1"), inner))
+}
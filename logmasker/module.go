@@ -9,9 +9,10 @@
 // The logmasker module offers the following capabilities:
 //   - Logger decorator that wraps any modular.Logger implementation
 //   - Configurable field-based masking rules
-//   - Regex pattern matching for sensitive data
+//   - Regex pattern matching for sensitive data, with a configurable library of named patterns
 //   - MaskableValue interface for self-determining value masking
 //   - Multiple masking strategies (redact, partial mask, hash)
+//   - Optional type preservation so masked numbers and booleans stay JSON-compatible
 //   - Performance optimized for production use
 //
 // # Configuration
@@ -30,9 +31,9 @@
 //	            FieldName: "email",
 //	            Strategy:  "partial",
 //	            PartialConfig: &PartialMaskConfig{
-//	                ShowFirst: 2,
-//	                ShowLast:  2,
-//	                MaskChar:  "*",
+//	                KeepPrefix: 2,
+//	                KeepSuffix: 2,
+//	                MaskChar:   "*",
 //	            },
 //	        },
 //	    },
@@ -42,6 +43,13 @@
 //	            Strategy: "redact",
 //	        },
 //	    },
+//	    Patterns: []MaskingPattern{
+//	        {
+//	            Name:     "iban",
+//	            Regex:    `\b[A-Z]{2}\d{2}[A-Z0-9]{11,30}\b`,
+//	            Strategy: "partial",
+//	        },
+//	    },
 //	}
 //
 // # Usage Examples
@@ -65,14 +73,20 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 
 	"github.com/GoCodeAlone/modular"
 )
 
-// ErrInvalidConfigType indicates the configuration type is incorrect for this module.
-var ErrInvalidConfigType = errors.New("invalid config type for log masker")
+var (
+	// ErrInvalidConfigType indicates the configuration type is incorrect for this module.
+	ErrInvalidConfigType = errors.New("invalid config type for log masker")
+
+	// ErrInvalidPattern indicates a named masking pattern is missing a name or has an invalid regex.
+	ErrInvalidPattern = errors.New("invalid masking pattern")
+)
 
 const (
 	// ServiceName is the name of the masking logger service.
@@ -80,16 +94,22 @@ const (
 
 	// ModuleName is the name of the log masker module.
 	ModuleName = "logmasker"
+
+	// defaultRedactionToken replaces redacted values when no RedactionToken is configured.
+	defaultRedactionToken = "[REDACTED]"
 )
 
 // MaskStrategy defines the type of masking to apply.
 type MaskStrategy string
 
 const (
-	// MaskStrategyRedact replaces the entire value with "[REDACTED]".
+	// MaskStrategyRedact replaces the entire value with "[REDACTED]", or the
+	// configured RedactionToken.
 	MaskStrategyRedact MaskStrategy = "redact"
 
-	// MaskStrategyPartial shows only part of the value, masking the rest.
+	// MaskStrategyPartial shows only the first and last characters of the
+	// value, e.g. "4111********1111", masking the rest. Values too short to
+	// keep any characters hidden are redacted entirely.
 	MaskStrategyPartial MaskStrategy = "partial"
 
 	// MaskStrategyHash replaces the value with a hash.
@@ -107,6 +127,9 @@ type MaskableValue interface {
 
 	// GetMaskedValue returns the masked representation of this value.
 	// If ShouldMask() returns false, this method may not be called.
+	// When GetMaskStrategy returns MaskStrategyPartial, it should return the
+	// unmasked value instead, which is partially masked using the module's
+	// DefaultPartialConfig.
 	GetMaskedValue() any
 
 	// GetMaskStrategy returns the preferred masking strategy for this value.
@@ -114,6 +137,17 @@ type MaskableValue interface {
 	GetMaskStrategy() MaskStrategy
 }
 
+// TypedMaskableValue is an optional extension of MaskableValue for values that
+// provide a replacement of their own type, e.g. 0 for an account number held
+// as an int. It is used instead of GetMaskedValue when PreserveTypes is enabled.
+type TypedMaskableValue interface {
+	MaskableValue
+
+	// GetTypedMaskedValue returns the masked replacement, of the same type
+	// as the unmasked value.
+	GetTypedMaskedValue() any
+}
+
 // FieldMaskingRule defines masking rules for specific field names.
 type FieldMaskingRule struct {
 	// FieldName is the exact field name to match (case-sensitive).
@@ -141,19 +175,65 @@ type PatternMaskingRule struct {
 	compiled *regexp.Regexp
 }
 
+// MaskingPattern is a named regex masking rule in the pattern library.
+// Named patterns let teams add detection for values such as IBANs, phone
+// numbers or custom tokens through configuration alone.
+type MaskingPattern struct {
+	// Name identifies the pattern in errors, e.g. "iban".
+	Name string `yaml:"name" json:"name" desc:"Name identifying the pattern"`
+
+	// Regex is the regular expression to match against string values.
+	Regex string `yaml:"regex" json:"regex" desc:"Regular expression to match"`
+
+	// Strategy defines how to mask values matching this pattern.
+	Strategy MaskStrategy `yaml:"strategy" json:"strategy" desc:"Masking strategy to use"`
+
+	// PartialConfig provides configuration for partial masking.
+	PartialConfig *PartialMaskConfig `yaml:"partialConfig,omitempty" json:"partialConfig,omitempty" desc:"Configuration for partial masking"`
+}
+
 // PartialMaskConfig defines how to partially mask a value.
 type PartialMaskConfig struct {
-	// ShowFirst is the number of characters to show at the beginning.
-	ShowFirst int `yaml:"showFirst" json:"showFirst" default:"0" desc:"Number of characters to show at start"`
+	// KeepPrefix is the number of characters to show at the beginning.
+	KeepPrefix int `yaml:"keepPrefix" json:"keepPrefix" default:"0" desc:"Number of characters to show at start"`
+
+	// KeepSuffix is the number of characters to show at the end.
+	KeepSuffix int `yaml:"keepSuffix" json:"keepSuffix" default:"0" desc:"Number of characters to show at end"`
+
+	// ShowFirst is the former name of KeepPrefix. A non-zero value overrides
+	// KeepPrefix.
+	//
+	// Deprecated: use KeepPrefix.
+	ShowFirst int `yaml:"showFirst,omitempty" json:"showFirst,omitempty" desc:"Deprecated: use keepPrefix"`
 
-	// ShowLast is the number of characters to show at the end.
-	ShowLast int `yaml:"showLast" json:"showLast" default:"0" desc:"Number of characters to show at end"`
+	// ShowLast is the former name of KeepSuffix. A non-zero value overrides
+	// KeepSuffix.
+	//
+	// Deprecated: use KeepSuffix.
+	ShowLast int `yaml:"showLast,omitempty" json:"showLast,omitempty" desc:"Deprecated: use keepSuffix"`
 
 	// MaskChar is the character to use for masking.
 	MaskChar string `yaml:"maskChar" json:"maskChar" default:"*" desc:"Character to use for masking"`
 
 	// MinLength is the minimum length before applying partial masking.
-	MinLength int `yaml:"minLength" json:"minLength" default:"4" desc:"Minimum length before applying partial masking"`
+	// Shorter values are redacted entirely.
+	MinLength int `yaml:"minLength" json:"minLength" default:"4" desc:"Minimum length before applying partial masking; shorter values are redacted"`
+}
+
+// keepPrefix returns the prefix length, honouring the deprecated ShowFirst.
+func (c *PartialMaskConfig) keepPrefix() int {
+	if c.ShowFirst != 0 {
+		return c.ShowFirst
+	}
+	return c.KeepPrefix
+}
+
+// keepSuffix returns the suffix length, honouring the deprecated ShowLast.
+func (c *PartialMaskConfig) keepSuffix() int {
+	if c.ShowLast != 0 {
+		return c.ShowLast
+	}
+	return c.KeepSuffix
 }
 
 // LogMaskerConfig defines the configuration for the log masking module.
@@ -170,8 +250,22 @@ type LogMaskerConfig struct {
 	// PatternRules defines masking rules based on regex patterns.
 	PatternRules []PatternMaskingRule `yaml:"patternRules" json:"patternRules" desc:"Pattern-based masking rules"`
 
+	// Patterns is a library of named regex masking rules, checked after PatternRules.
+	// Unlike PatternRules, which replace the built-in credit card and SSN rules when
+	// configured, Patterns add to them.
+	Patterns []MaskingPattern `yaml:"patterns" json:"patterns" desc:"Named regex masking patterns"`
+
 	// DefaultPartialConfig provides default settings for partial masking.
 	DefaultPartialConfig PartialMaskConfig `yaml:"defaultPartialConfig" json:"defaultPartialConfig" desc:"Default partial masking configuration"`
+
+	// RedactionToken replaces redacted string values (default "[REDACTED]").
+	RedactionToken string `yaml:"redactionToken" json:"redactionToken" default:"[REDACTED]" desc:"Replacement for redacted values"`
+
+	// PreserveTypes keeps the types of masked values so downstream JSON consumers
+	// still see numbers and booleans: masked numbers become 0, masked booleans
+	// false, and masked strings RedactionToken. Hashing cannot preserve the type
+	// of non-string values, so they are zeroed as well.
+	PreserveTypes bool `yaml:"preserveTypes" json:"preserveTypes" default:"false" desc:"Replace masked numbers and booleans with zero values instead of strings"`
 }
 
 // LogMaskerModule implements the modular.Module interface to provide log masking functionality.
@@ -196,6 +290,7 @@ func (m *LogMaskerModule) RegisterConfig(app modular.Application) error {
 	defaultConfig := &LogMaskerConfig{
 		Enabled:             true,
 		DefaultMaskStrategy: MaskStrategyRedact,
+		RedactionToken:      defaultRedactionToken,
 		FieldRules: []FieldMaskingRule{
 			{
 				FieldName: "password",
@@ -217,10 +312,10 @@ func (m *LogMaskerModule) RegisterConfig(app modular.Application) error {
 				FieldName: "email",
 				Strategy:  MaskStrategyPartial,
 				PartialConfig: &PartialMaskConfig{
-					ShowFirst: 2,
-					ShowLast:  2,
-					MaskChar:  "*",
-					MinLength: 4,
+					KeepPrefix: 2,
+					KeepSuffix: 2,
+					MaskChar:   "*",
+					MinLength:  4,
 				},
 			},
 		},
@@ -235,10 +330,10 @@ func (m *LogMaskerModule) RegisterConfig(app modular.Application) error {
 			},
 		},
 		DefaultPartialConfig: PartialMaskConfig{
-			ShowFirst: 2,
-			ShowLast:  2,
-			MaskChar:  "*",
-			MinLength: 4,
+			KeepPrefix: 2,
+			KeepSuffix: 2,
+			MaskChar:   "*",
+			MinLength:  4,
 		},
 	}
 
@@ -267,8 +362,8 @@ func (m *LogMaskerModule) Init(app modular.Application) error {
 	}
 
 	// Compile regex patterns
-	m.compiledPatterns = make([]*PatternMaskingRule, len(config.PatternRules))
-	for i, rule := range config.PatternRules {
+	m.compiledPatterns = make([]*PatternMaskingRule, 0, len(config.PatternRules)+len(config.Patterns))
+	for _, rule := range config.PatternRules {
 		compiled, err := regexp.Compile(rule.Pattern)
 		if err != nil {
 			return fmt.Errorf("failed to compile pattern '%s': %w", rule.Pattern, err)
@@ -277,7 +372,30 @@ func (m *LogMaskerModule) Init(app modular.Application) error {
 		// Create a copy of the rule with compiled regex
 		compiledRule := rule
 		compiledRule.compiled = compiled
-		m.compiledPatterns[i] = &compiledRule
+		m.compiledPatterns = append(m.compiledPatterns, &compiledRule)
+	}
+
+	// Compile the named pattern library
+	names := make(map[string]bool, len(config.Patterns))
+	for i, pattern := range config.Patterns {
+		if pattern.Name == "" {
+			return fmt.Errorf("%w: pattern %d has no name", ErrInvalidPattern, i)
+		}
+		if names[pattern.Name] {
+			return fmt.Errorf("%w: duplicate pattern name '%s'", ErrInvalidPattern, pattern.Name)
+		}
+		names[pattern.Name] = true
+
+		compiled, err := regexp.Compile(pattern.Regex)
+		if err != nil {
+			return fmt.Errorf("%w '%s': %w", ErrInvalidPattern, pattern.Name, err)
+		}
+		m.compiledPatterns = append(m.compiledPatterns, &PatternMaskingRule{
+			Pattern:       pattern.Regex,
+			Strategy:      pattern.Strategy,
+			PartialConfig: pattern.PartialConfig,
+			compiled:      compiled,
+		})
 	}
 
 	// Register the masking logger service using the decorator pattern
@@ -395,10 +513,15 @@ func (l *MaskingLogger) maskArgs(args ...any) []any {
 
 			// Check if value implements MaskableValue
 			if maskable, ok := value.(MaskableValue); ok {
-				if maskable.ShouldMask() {
-					result[i+1] = maskable.GetMaskedValue()
-				} else {
+				switch {
+				case !maskable.ShouldMask():
 					result[i+1] = value
+				case l.module.config.PreserveTypes && isTypedMaskable(maskable):
+					result[i+1] = maskable.(TypedMaskableValue).GetTypedMaskedValue()
+				case maskable.GetMaskStrategy() == MaskStrategyPartial:
+					result[i+1] = l.applyMaskStrategy(maskable.GetMaskedValue(), MaskStrategyPartial, nil)
+				default:
+					result[i+1] = maskable.GetMaskedValue()
 				}
 				continue
 			}
@@ -440,7 +563,7 @@ func (l *MaskingLogger) applyMaskingRules(fieldName string, value any) any {
 func (l *MaskingLogger) applyMaskStrategy(value any, strategy MaskStrategy, partialConfig *PartialMaskConfig) any {
 	switch strategy {
 	case MaskStrategyRedact:
-		return "[REDACTED]"
+		return l.redact(value)
 
 	case MaskStrategyPartial:
 		if strValue, ok := value.(string); ok {
@@ -450,7 +573,7 @@ func (l *MaskingLogger) applyMaskStrategy(value any, strategy MaskStrategy, part
 			}
 			return l.partialMask(strValue, config)
 		}
-		return "[REDACTED]" // Fallback for non-string values
+		return l.redact(value) // Fallback for non-string values
 
 	case MaskStrategyHash:
 		// Use type switch to handle common types efficiently
@@ -461,6 +584,9 @@ func (l *MaskingLogger) applyMaskStrategy(value any, strategy MaskStrategy, part
 		case []byte:
 			valueBytes = v
 		default:
+			if l.module.config.PreserveTypes {
+				return l.redact(value)
+			}
 			// Fallback to fmt.Sprintf for other types
 			valueBytes = []byte(fmt.Sprintf("%v", v))
 		}
@@ -477,18 +603,47 @@ func (l *MaskingLogger) applyMaskStrategy(value any, strategy MaskStrategy, part
 	}
 }
 
-// partialMask applies partial masking to a string value.
-func (l *MaskingLogger) partialMask(value string, config *PartialMaskConfig) string {
-	if len(value) < config.MinLength {
-		return value
+// redact returns the replacement for a fully masked value. With PreserveTypes
+// enabled, numbers and booleans are replaced with the zero value of their type.
+func (l *MaskingLogger) redact(value any) any {
+	if l.module.config.PreserveTypes && value != nil {
+		rv := reflect.ValueOf(value)
+		switch rv.Kind() {
+		case reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+			reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+			return reflect.Zero(rv.Type()).Interface()
+		}
 	}
+	return l.redactionToken()
+}
 
-	showFirst := config.ShowFirst
-	showLast := config.ShowLast
+// redactionToken returns the configured replacement for redacted strings.
+func (l *MaskingLogger) redactionToken() string {
+	if l.module.config == nil || l.module.config.RedactionToken == "" {
+		return defaultRedactionToken
+	}
+	return l.module.config.RedactionToken
+}
 
-	// Ensure we don't show more characters than the string length
-	if showFirst+showLast >= len(value) {
-		return value
+// isTypedMaskable reports whether value provides a typed replacement.
+func isTypedMaskable(value MaskableValue) bool {
+	_, ok := value.(TypedMaskableValue)
+	return ok
+}
+
+// partialMask applies partial masking to a string value. Values shorter than
+// MinLength, or too short to hide at least one character between the shown
+// prefix and suffix, are redacted entirely so partial masking never reveals
+// the whole value.
+func (l *MaskingLogger) partialMask(value string, config *PartialMaskConfig) string {
+	runes := []rune(value)
+	keepPrefix := max(config.keepPrefix(), 0)
+	keepSuffix := max(config.keepSuffix(), 0)
+
+	if len(runes) < config.MinLength || keepPrefix+keepSuffix >= len(runes) {
+		return l.redactionToken()
 	}
 
 	maskChar := config.MaskChar
@@ -496,14 +651,9 @@ func (l *MaskingLogger) partialMask(value string, config *PartialMaskConfig) str
 		maskChar = "*"
 	}
 
-	first := value[:showFirst]
-	last := ""
-	if showLast > 0 {
-		last = value[len(value)-showLast:]
-	}
-
-	maskLength := len(value) - showFirst - showLast
-	mask := strings.Repeat(maskChar, maskLength)
+	first := string(runes[:keepPrefix])
+	last := string(runes[len(runes)-keepSuffix:])
+	mask := strings.Repeat(maskChar, len(runes)-keepPrefix-keepSuffix)
 
 	return first + mask + last
 }
@@ -2,6 +2,8 @@ package logmasker
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -9,6 +11,7 @@ import (
 	"time"
 
 	"github.com/GoCodeAlone/modular"
+	"gopkg.in/yaml.v3"
 )
 
 // MockLogger implements modular.Logger for testing.
@@ -452,10 +455,10 @@ func TestPartialMasking(t *testing.T) {
 	}
 
 	config := &PartialMaskConfig{
-		ShowFirst: 2,
-		ShowLast:  2,
-		MaskChar:  "*",
-		MinLength: 4,
+		KeepPrefix: 2,
+		KeepSuffix: 2,
+		MaskChar:   "*",
+		MinLength:  4,
 	}
 
 	tests := []struct {
@@ -470,12 +473,12 @@ func TestPartialMasking(t *testing.T) {
 		},
 		{
 			input:    "ab",
-			expected: "ab", // Too short, not masked
+			expected: "[REDACTED]", // Too short, redacted rather than shown
 			name:     "too short",
 		},
 		{
 			input:    "abcd",
-			expected: "abcd", // Exactly min length, but showFirst+showLast >= length
+			expected: "[REDACTED]", // Exactly min length, but keepPrefix+keepSuffix >= length
 			name:     "exactly min length",
 		},
 		{
@@ -495,6 +498,113 @@ func TestPartialMasking(t *testing.T) {
 	}
 }
 
+func TestPartialMasking_KeepPrefixAndSuffix(t *testing.T) {
+	masker := &MaskingLogger{
+		BaseLoggerDecorator: modular.NewBaseLoggerDecorator(&MockLogger{}),
+		module:              NewModule(),
+	}
+	config := &PartialMaskConfig{KeepPrefix: 4, KeepSuffix: 4, MaskChar: "*"}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"long value", "4111111111111111", "4111********1111"},
+		{"one character hidden", "411101111", "4111*1111"},
+		{"exactly prefix plus suffix", "41111111", "[REDACTED]"},
+		{"shorter than prefix plus suffix", "41111", "[REDACTED]"},
+		{"empty", "", "[REDACTED]"},
+		{"multi-byte characters", "ünïcödé-välüé", "ünïc*****älüé"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if result := masker.partialMask(test.input, config); result != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, result)
+			}
+		})
+	}
+
+	t.Run("custom mask character", func(t *testing.T) {
+		result := masker.partialMask("4111111111111111", &PartialMaskConfig{KeepSuffix: 4, MaskChar: "#"})
+		if result != "############1111" {
+			t.Errorf("Expected ############1111, got %s", result)
+		}
+	})
+}
+
+func TestPartialMasking_DeprecatedShowFirstAndShowLast(t *testing.T) {
+	masker := &MaskingLogger{
+		BaseLoggerDecorator: modular.NewBaseLoggerDecorator(&MockLogger{}),
+		module:              NewModule(),
+	}
+
+	t.Run("struct fields", func(t *testing.T) {
+		result := masker.partialMask("4111111111111111", &PartialMaskConfig{ShowFirst: 4, ShowLast: 4, MaskChar: "*"})
+		if result != "4111********1111" {
+			t.Errorf("Expected 4111********1111, got %s", result)
+		}
+	})
+
+	t.Run("legacy yaml keys override defaults", func(t *testing.T) {
+		config := PartialMaskConfig{KeepPrefix: 2, KeepSuffix: 2, MaskChar: "*"}
+		if err := yaml.Unmarshal([]byte("showFirst: 4\nshowLast: 1\n"), &config); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if result := masker.partialMask("4111111111111111", &config); result != "4111***********1" {
+			t.Errorf("Expected 4111***********1, got %s", result)
+		}
+	})
+
+	t.Run("legacy json keys", func(t *testing.T) {
+		var config PartialMaskConfig
+		if err := json.Unmarshal([]byte(`{"showFirst": 1, "showLast": 4}`), &config); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if result := masker.partialMask("4111111111111111", &config); result != "4***********1111" {
+			t.Errorf("Expected 4***********1111, got %s", result)
+		}
+	})
+}
+
+func TestMaskingLogger_PartialMaskingOfFieldsAndMaskableValues(t *testing.T) {
+	module := NewModule()
+	mockLogger := &MockLogger{}
+	app := NewMockApplication(mockLogger)
+	module.RegisterConfig(app)
+	app.RegisterService("logger", mockLogger)
+
+	cp, _ := app.GetConfigSection(ModuleName)
+	config := cp.GetConfig().(*LogMaskerConfig)
+	config.FieldRules = append(config.FieldRules, FieldMaskingRule{
+		FieldName:     "pan",
+		Strategy:      MaskStrategyPartial,
+		PartialConfig: &PartialMaskConfig{KeepPrefix: 4, KeepSuffix: 4, MaskChar: "*"},
+	})
+	config.DefaultPartialConfig = PartialMaskConfig{KeepPrefix: 3, KeepSuffix: 3, MaskChar: "x"}
+	if err := module.Init(app); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	masker := &MaskingLogger{
+		BaseLoggerDecorator: modular.NewBaseLoggerDecorator(mockLogger),
+		module:              module,
+	}
+	masker.Info("Payment",
+		"pan", "4111111111111111",
+		"account", &TestMaskableValue{ShouldMaskValue: true, MaskedValue: "acct-12345678", Strategy: MaskStrategyPartial},
+		"pin", &TestMaskableValue{ShouldMaskValue: true, MaskedValue: "1234", Strategy: MaskStrategyPartial},
+		"session", &TestMaskableValue{ShouldMaskValue: true, MaskedValue: "[SESSION]", Strategy: MaskStrategyRedact})
+
+	args := mockLogger.InfoCalls[0].Args
+	expected := []any{"4111********1111", "accxxxxxxx678", "[REDACTED]", "[SESSION]"}
+	for i, want := range expected {
+		if args[2*i+1] != want {
+			t.Errorf("Expected %s to be %v, got %v", args[2*i], want, args[2*i+1])
+		}
+	}
+}
+
 func TestAllLogLevels(t *testing.T) {
 	module := NewModule()
 	mockLogger := &MockLogger{}
@@ -533,3 +643,186 @@ func TestAllLogLevels(t *testing.T) {
 		t.Error("Debug call was not properly masked")
 	}
 }
+
+// initWithPatterns registers the default config, adds patterns and initializes the module.
+func initWithPatterns(t *testing.T, patterns []MaskingPattern) (*LogMaskerModule, *MockLogger, error) {
+	t.Helper()
+	module := NewModule()
+	mockLogger := &MockLogger{}
+	app := NewMockApplication(mockLogger)
+
+	if err := module.RegisterConfig(app); err != nil {
+		t.Fatalf("RegisterConfig failed: %v", err)
+	}
+	app.RegisterService("logger", mockLogger)
+
+	cp, _ := app.GetConfigSection(ModuleName)
+	cp.GetConfig().(*LogMaskerConfig).Patterns = patterns
+
+	return module, mockLogger, module.Init(app)
+}
+
+func TestMaskingLogger_NamedPatterns(t *testing.T) {
+	module, mockLogger, err := initWithPatterns(t, []MaskingPattern{
+		{
+			Name:     "iban",
+			Regex:    `\b[A-Z]{2}\d{2}[A-Z0-9]{11,30}\b`,
+			Strategy: MaskStrategyPartial,
+			PartialConfig: &PartialMaskConfig{
+				KeepPrefix: 4,
+				KeepSuffix: 2,
+				MaskChar:   "#",
+			},
+		},
+		{
+			Name:     "internal-token",
+			Regex:    `^tok_[a-z0-9]{16}$`,
+			Strategy: MaskStrategyRedact,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	masker := &MaskingLogger{
+		BaseLoggerDecorator: modular.NewBaseLoggerDecorator(mockLogger),
+		module:              module,
+	}
+
+	masker.Info("Transfer",
+		"account", "DE89370400440532013000",
+		"ref", "tok_0123456789abcdef",
+		"password", "hunter2",
+		"card", "4111-1111-1111-1111",
+		"amount", "100")
+
+	args := mockLogger.InfoCalls[0].Args
+	if args[1] != "DE89################00" {
+		t.Errorf("Expected IBAN to be partially masked by custom pattern, got %v", args[1])
+	}
+	if args[3] != "[REDACTED]" {
+		t.Errorf("Expected token to be redacted by custom pattern, got %v", args[3])
+	}
+	if args[5] != "[REDACTED]" {
+		t.Errorf("Expected field rule to still redact password, got %v", args[5])
+	}
+	if args[7] != "[REDACTED]" {
+		t.Errorf("Expected built-in credit card pattern to still apply, got %v", args[7])
+	}
+	if args[9] != "100" {
+		t.Errorf("Expected amount to not be masked, got %v", args[9])
+	}
+}
+
+func TestLogMaskerModule_InvalidNamedPattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []MaskingPattern
+		contains string
+	}{
+		{
+			name:     "invalid regex",
+			patterns: []MaskingPattern{{Name: "phone", Regex: `\+?\d{10,15}`, Strategy: MaskStrategyRedact}, {Name: "broken-iban", Regex: `[A-Z`, Strategy: MaskStrategyRedact}},
+			contains: "'broken-iban'",
+		},
+		{
+			name:     "missing name",
+			patterns: []MaskingPattern{{Regex: `\d+`}},
+			contains: "no name",
+		},
+		{
+			name:     "duplicate name",
+			patterns: []MaskingPattern{{Name: "phone", Regex: `\d+`}, {Name: "phone", Regex: `\d+`}},
+			contains: "duplicate pattern name 'phone'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := initWithPatterns(t, tt.patterns)
+			if !errors.Is(err, ErrInvalidPattern) {
+				t.Fatalf("Expected ErrInvalidPattern, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.contains) {
+				t.Errorf("Expected error to contain %q, got %q", tt.contains, err.Error())
+			}
+		})
+	}
+}
+
+// typedMaskableAccount is a MaskableValue with a typed replacement.
+type typedMaskableAccount struct {
+	Number int64
+}
+
+func (a typedMaskableAccount) ShouldMask() bool              { return true }
+func (a typedMaskableAccount) GetMaskedValue() any           { return "[ACCOUNT]" }
+func (a typedMaskableAccount) GetMaskStrategy() MaskStrategy { return MaskStrategyRedact }
+func (a typedMaskableAccount) GetTypedMaskedValue() any      { return int64(-1) }
+
+func TestMaskingLogger_PreserveTypes(t *testing.T) {
+	newMasker := func(t *testing.T, preserveTypes bool) (*MaskingLogger, *MockLogger) {
+		t.Helper()
+		module := NewModule()
+		mockLogger := &MockLogger{}
+		app := NewMockApplication(mockLogger)
+		module.RegisterConfig(app)
+		app.RegisterService("logger", mockLogger)
+
+		cp, _ := app.GetConfigSection(ModuleName)
+		config := cp.GetConfig().(*LogMaskerConfig)
+		config.PreserveTypes = preserveTypes
+		config.RedactionToken = "***"
+		config.FieldRules = []FieldMaskingRule{
+			{FieldName: "balance", Strategy: MaskStrategyRedact},
+			{FieldName: "ratio", Strategy: MaskStrategyRedact},
+			{FieldName: "vip", Strategy: MaskStrategyRedact},
+			{FieldName: "password", Strategy: MaskStrategyRedact},
+			{FieldName: "count", Strategy: MaskStrategyHash},
+			{FieldName: "limit", Strategy: MaskStrategyPartial},
+		}
+		if err := module.Init(app); err != nil {
+			t.Fatalf("Init failed: %v", err)
+		}
+		return &MaskingLogger{
+			BaseLoggerDecorator: modular.NewBaseLoggerDecorator(mockLogger),
+			module:              module,
+		}, mockLogger
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		masker, mockLogger := newMasker(t, true)
+		masker.Info("Account",
+			"balance", 1500,
+			"ratio", 0.75,
+			"vip", true,
+			"password", "secret",
+			"count", uint8(7),
+			"limit", float32(99.5),
+			"account", typedMaskableAccount{Number: 12345678})
+
+		args := mockLogger.InfoCalls[0].Args
+		expected := []any{0, 0.0, false, "***", uint8(0), float32(0), int64(-1)}
+		for i, want := range expected {
+			if got := args[2*i+1]; got != want {
+				t.Errorf("Expected %s to be %v (%T), got %v (%T)", args[2*i], want, want, got, got)
+			}
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		masker, mockLogger := newMasker(t, false)
+		masker.Info("Account",
+			"balance", 1500,
+			"vip", true,
+			"account", typedMaskableAccount{Number: 12345678})
+
+		args := mockLogger.InfoCalls[0].Args
+		expected := []any{"***", "***", "[ACCOUNT]"}
+		for i, want := range expected {
+			if got := args[2*i+1]; got != want {
+				t.Errorf("Expected %s to be %v, got %v", args[2*i], want, got)
+			}
+		}
+	})
+}
@@ -273,6 +273,18 @@ type Reloadable interface {
 	ReloadTimeout() time.Duration
 }
 
+// ReloadValidator is an optional interface for Reloadable modules that can check
+// a set of changes before any module applies them.
+//
+// The ReloadOrchestrator validates the changes with every reloadable module
+// implementing this interface first, and only calls Reload if all of them accept
+// the changes. A module rejecting the changes aborts the whole reload, so no
+// module is left with partially applied configuration.
+type ReloadValidator interface {
+	// Validate reports whether the module can apply the changes, without applying them.
+	Validate(ctx context.Context, changes []ConfigChange) error
+}
+
 // ModuleRegistry represents a registry of modules keyed by their names.
 // This is used internally by the application to manage registered modules
 // and resolve dependencies between them.
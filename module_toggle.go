@@ -0,0 +1,111 @@
+package modular
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ModuleTogglesSection is the config key under which modules can be enabled or
+// disabled by name, e.g. in YAML:
+//
+//	modules:
+//	  letsencrypt:
+//	    enabled: false
+//
+// or with the MODULES_LETSENCRYPT_ENABLED=false environment variable. Module
+// names are upper-cased and non-alphanumeric characters replaced by underscores
+// to form the variable name. Modules without a setting are enabled.
+const ModuleTogglesSection = "modules"
+
+// moduleToggle is the per-module settings read from ModuleTogglesSection
+type moduleToggle struct {
+	Enabled *bool
+}
+
+// moduleToggleEnvVar returns the environment variable that enables or disables the named module
+func moduleToggleEnvVar(name string) string {
+	var b strings.Builder
+	b.WriteString("MODULES_")
+	for _, r := range strings.ToUpper(name) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	b.WriteString("_ENABLED")
+	return b.String()
+}
+
+// newModuleTogglesConfig builds a config struct with a toggle per module name,
+// so that every config feeder can populate it
+func newModuleTogglesConfig(names []string) reflect.Value {
+	fields := make([]reflect.StructField, 0, len(names))
+	for i, name := range names {
+		enabledTag := fmt.Sprintf(`yaml:"enabled" json:"enabled" toml:"enabled" env:"%s"`, moduleToggleEnvVar(name))
+		toggleType := reflect.StructOf([]reflect.StructField{{
+			Name: "Enabled",
+			Type: reflect.TypeFor[*bool](),
+			Tag:  reflect.StructTag(enabledTag),
+		}})
+		fields = append(fields, reflect.StructField{
+			// Module names are not necessarily valid identifiers
+			Name: fmt.Sprintf("Module%d", i),
+			Type: toggleType,
+			Tag:  reflect.StructTag(fmt.Sprintf(`yaml:"%[1]s" json:"%[1]s" toml:"%[1]s"`, name)),
+		})
+	}
+	return reflect.New(reflect.StructOf(fields))
+}
+
+// disabledModules feeds the module toggles with the application's config
+// feeders and returns the names of the modules disabled by config, sorted.
+// Feeders that can read a single key (such as the file feeders) only read
+// ModuleTogglesSection, so a module's own config section, e.g. a top-level
+// "cache: {enabled: false}", never disables it.
+func (app *StdApplication) disabledModules() ([]string, error) {
+	feeders, _ := effectiveConfigFeeders(app)
+	if len(feeders) == 0 || len(app.moduleRegistry) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(app.moduleRegistry))
+	for name := range app.moduleRegistry {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	toggles := newModuleTogglesConfig(names)
+	cfgBuilder := NewConfig()
+	for _, feeder := range feeders {
+		cfgBuilder.AddFeeder(feeder)
+	}
+	for _, feeder := range cfgBuilder.sortFeedersByPriority() {
+		if err := feedModuleToggles(feeder, toggles.Interface()); err != nil {
+			return nil, fmt.Errorf("reading module toggles: %w", err)
+		}
+	}
+
+	var disabled []string
+	for i, name := range names {
+		enabled := toggles.Elem().Field(i).Field(0).Interface().(*bool)
+		if enabled != nil && !*enabled {
+			disabled = append(disabled, name)
+		}
+	}
+	return disabled, nil
+}
+
+// feedModuleToggles populates toggles from ModuleTogglesSection using feeder
+func feedModuleToggles(feeder Feeder, toggles any) error {
+	switch f := feeder.(type) {
+	case ComplexFeeder:
+		return f.FeedKey(ModuleTogglesSection, toggles)
+	case ModuleAwareFeeder:
+		return f.FeedWithModuleContext(toggles, ModuleTogglesSection)
+	default:
+		return f.Feed(toggles)
+	}
+}
@@ -0,0 +1,131 @@
+package modular
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoCodeAlone/modular/feeders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type toggleCacheConfig struct {
+	Addr string `yaml:"addr" required:"true"`
+}
+
+// toggleCacheModule provides the "cache" service and requires config that a
+// disabled module would not have
+type toggleCacheModule struct {
+	initialized bool
+	started     bool
+}
+
+func (m *toggleCacheModule) Name() string { return "cache" }
+func (m *toggleCacheModule) RegisterConfig(app Application) error {
+	app.RegisterConfigSection("cache", NewStdConfigProvider(&toggleCacheConfig{}))
+	return nil
+}
+func (m *toggleCacheModule) Init(Application) error { m.initialized = true; return nil }
+func (m *toggleCacheModule) Start(context.Context) error {
+	m.started = true
+	return nil
+}
+func (m *toggleCacheModule) Stop(context.Context) error { return nil }
+func (m *toggleCacheModule) ProvidesServices() []ServiceProvider {
+	return []ServiceProvider{{Name: "cache", Instance: m}}
+}
+func (m *toggleCacheModule) RequiresServices() []ServiceDependency { return nil }
+
+// toggleAPIModule optionally uses the "cache" service once started
+type toggleAPIModule struct {
+	app   Application
+	cache *toggleCacheModule
+}
+
+func (m *toggleAPIModule) Name() string { return "api" }
+func (m *toggleAPIModule) Init(app Application) error {
+	m.app = app
+	return nil
+}
+func (m *toggleAPIModule) Start(context.Context) error {
+	var cache *toggleCacheModule
+	if err := m.app.GetService("cache", &cache); err == nil {
+		m.cache = cache
+	}
+	return nil
+}
+func (m *toggleAPIModule) Stop(context.Context) error          { return nil }
+func (m *toggleAPIModule) ProvidesServices() []ServiceProvider { return nil }
+func (m *toggleAPIModule) RequiresServices() []ServiceDependency {
+	return []ServiceDependency{{Name: "cache", Required: false}}
+}
+
+func newToggleTestApp(t *testing.T, yaml string) (Application, *toggleCacheModule, *toggleAPIModule) {
+	t.Helper()
+	feeders.ResetGlobalEnvCatalog()
+	t.Cleanup(feeders.ResetGlobalEnvCatalog)
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte(yaml), 0o600))
+
+	cache := &toggleCacheModule{}
+	api := &toggleAPIModule{}
+	app, err := NewApplication(
+		WithLogger(&reloadTestLogger{}),
+		WithConfigFeeders(feeders.NewYamlFeeder(yamlPath), feeders.NewEnvFeeder()),
+		WithModules(cache, api),
+	)
+	require.NoError(t, err)
+	return app, cache, api
+}
+
+func TestModuleToggles_DisabledModuleIsSkipped(t *testing.T) {
+	app, cache, api := newToggleTestApp(t, "modules:\n  cache:\n    enabled: false\n")
+
+	require.NoError(t, app.Init(), "a disabled module's config is not loaded or validated")
+	require.NoError(t, app.Start())
+	t.Cleanup(func() { _ = app.Stop() })
+
+	assert.False(t, cache.initialized)
+	assert.False(t, cache.started)
+	assert.Nil(t, api.cache, "dependents see the disabled module's services as absent")
+	assert.Nil(t, app.GetModule("cache"))
+}
+
+func TestModuleToggles_EnabledModule(t *testing.T) {
+	app, cache, api := newToggleTestApp(t, "cache:\n  addr: localhost:6379\nmodules:\n  cache:\n    enabled: true\n")
+	require.NoError(t, app.Init())
+	require.NoError(t, app.Start())
+	t.Cleanup(func() { _ = app.Stop() })
+
+	assert.True(t, cache.started)
+	assert.Same(t, cache, api.cache)
+}
+
+func TestModuleToggles_ModuleSectionEnabledKeyIgnored(t *testing.T) {
+	// An "enabled" field in the module's own section is module config, not a toggle
+	app, cache, api := newToggleTestApp(t, "cache:\n  addr: localhost:6379\n  enabled: false\n")
+	require.NoError(t, app.Init())
+	require.NoError(t, app.Start())
+	t.Cleanup(func() { _ = app.Stop() })
+
+	assert.True(t, cache.started)
+	assert.Same(t, cache, api.cache)
+}
+
+func TestModuleToggles_EnvVar(t *testing.T) {
+	t.Setenv("MODULES_CACHE_ENABLED", "false")
+	app, cache, api := newToggleTestApp(t, "{}\n")
+	require.NoError(t, app.Init())
+	require.NoError(t, app.Start())
+	t.Cleanup(func() { _ = app.Stop() })
+
+	assert.False(t, cache.initialized)
+	assert.Nil(t, api.cache)
+}
+
+func TestModuleToggleEnvVar(t *testing.T) {
+	assert.Equal(t, "MODULES_HTTP_CLIENT_ENABLED", moduleToggleEnvVar("http-client"))
+	assert.Equal(t, "MODULES_LETSENCRYPT_ENABLED", moduleToggleEnvVar("letsencrypt"))
+}
@@ -9,6 +9,7 @@ type Config struct {
 	JWT      JWTConfig      `yaml:"jwt" env:"JWT"`
 	Session  SessionConfig  `yaml:"session" env:"SESSION"`
 	OAuth2   OAuth2Config   `yaml:"oauth2" env:"OAUTH2"`
+	OIDC     OIDCConfig     `yaml:"oidc" env:"OIDC"`
 	Password PasswordConfig `yaml:"password" env:"PASSWORD"`
 }
 
@@ -19,6 +20,9 @@ type JWTConfig struct {
 	RefreshExpiration time.Duration `yaml:"refresh_expiration" default:"168h" env:"REFRESH_EXPIRATION"` // 7 days
 	Issuer            string        `yaml:"issuer" default:"modular-auth" env:"ISSUER"`
 	Algorithm         string        `yaml:"algorithm" default:"HS256" env:"ALGORITHM"`
+	// RevocationCleanupInterval is how often expired entries are removed from
+	// the revocation store while the module is running
+	RevocationCleanupInterval time.Duration `yaml:"revocation_cleanup_interval" default:"1h" env:"REVOCATION_CLEANUP_INTERVAL"`
 }
 
 // SessionConfig contains session-related configuration
@@ -49,15 +53,36 @@ type OAuth2Provider struct {
 	UserInfoURL  string   `yaml:"user_info_url" env:"USER_INFO_URL"`
 }
 
+// OIDCConfig contains configuration for validating tokens from external OIDC issuers
+type OIDCConfig struct {
+	Issuers []OIDCIssuer `yaml:"issuers" env:"ISSUERS"`
+}
+
+// OIDCIssuer represents an external OpenID Connect token issuer
+type OIDCIssuer struct {
+	// Name identifies the provider. Issuers sharing an issuer URL, such as
+	// several client applications of one IdP with different audiences, must
+	// have distinct names.
+	Name                string        `yaml:"name" env:"NAME"`
+	IssuerURL           string        `yaml:"issuer_url" required:"true" env:"ISSUER_URL"`
+	Audience            string        `yaml:"audience" env:"AUDIENCE"`
+	JWKSRefreshInterval time.Duration `yaml:"jwks_refresh_interval" default:"1h" env:"JWKS_REFRESH_INTERVAL"`
+}
+
 // PasswordConfig contains password-related configuration
 type PasswordConfig struct {
-	Algorithm      string `yaml:"algorithm" default:"bcrypt" env:"ALGORITHM"` // bcrypt, argon2
+	Algorithm      string `yaml:"algorithm" default:"bcrypt" env:"ALGORITHM"` // bcrypt, argon2id (alias: argon2)
 	MinLength      int    `yaml:"min_length" default:"8" env:"MIN_LENGTH"`
 	RequireUpper   bool   `yaml:"require_upper" default:"true" env:"REQUIRE_UPPER"`
 	RequireLower   bool   `yaml:"require_lower" default:"true" env:"REQUIRE_LOWER"`
 	RequireDigit   bool   `yaml:"require_digit" default:"true" env:"REQUIRE_DIGIT"`
 	RequireSpecial bool   `yaml:"require_special" default:"false" env:"REQUIRE_SPECIAL"`
 	BcryptCost     int    `yaml:"bcrypt_cost" default:"12" env:"BCRYPT_COST"`
+
+	// Argon2id cost parameters; zero values select the package defaults
+	Argon2Time        uint32 `yaml:"argon2_time" default:"3" env:"ARGON2_TIME"`
+	Argon2Memory      uint32 `yaml:"argon2_memory" default:"65536" env:"ARGON2_MEMORY"` // KiB
+	Argon2Parallelism uint8  `yaml:"argon2_parallelism" default:"4" env:"ARGON2_PARALLELISM"`
 }
 
 // Validate validates the authentication configuration
@@ -82,6 +107,25 @@ func (c *Config) Validate() error {
 		return ErrInvalidConfig
 	}
 
+	for _, issuer := range c.OIDC.Issuers {
+		if issuer.IssuerURL == "" {
+			return ErrInvalidConfig
+		}
+	}
+
+	switch c.Password.Algorithm {
+	case "", PasswordAlgorithmBcrypt, PasswordAlgorithmArgon2id, PasswordAlgorithmArgon2:
+	default:
+		return ErrInvalidConfig
+	}
+
+	// Hashes with parameters beyond the bounds could never be verified
+	if c.Password.Argon2Time > MaxArgon2Time ||
+		c.Password.Argon2Memory > MaxArgon2Memory ||
+		c.Password.Argon2Parallelism > MaxArgon2Parallelism {
+		return ErrInvalidConfig
+	}
+
 	return nil
 }
 
@@ -95,6 +139,18 @@ func (c *JWTConfig) GetJWTRefreshExpiration() time.Duration {
 	return c.RefreshExpiration
 }
 
+// DefaultRevocationCleanupInterval is used when RevocationCleanupInterval is not set
+const DefaultRevocationCleanupInterval = time.Hour
+
+// GetRevocationCleanupInterval returns how often expired revocations are
+// removed, defaulting to DefaultRevocationCleanupInterval
+func (c *JWTConfig) GetRevocationCleanupInterval() time.Duration {
+	if c.RevocationCleanupInterval <= 0 {
+		return DefaultRevocationCleanupInterval
+	}
+	return c.RevocationCleanupInterval
+}
+
 // GetSessionMaxAge returns the session max age as time.Duration
 func (c *SessionConfig) GetSessionMaxAge() time.Duration {
 	return c.MaxAge
@@ -12,10 +12,12 @@ type AuthService interface {
 	GenerateToken(userID string, claims map[string]interface{}) (*TokenPair, error)
 	ValidateToken(token string) (*Claims, error)
 	RefreshToken(refreshToken string) (*TokenPair, error)
+	RevokeToken(tokenID string) error
 
 	// Password operations
 	HashPassword(password string) (string, error)
 	VerifyPassword(hashedPassword, password string) error
+	VerifyPasswordAndRehash(hashedPassword, password string) (string, error)
 	ValidatePasswordStrength(password string) error
 
 	// Session operations
@@ -46,6 +48,17 @@ type SessionStore interface {
 	Cleanup(ctx context.Context) error // Remove expired sessions
 }
 
+// RevocationStore defines the interface for tracking revoked token IDs (jti).
+// Implementations backed by shared storage allow revocations to take effect
+// across all instances of an application.
+type RevocationStore interface {
+	// Revoke marks tokenID as revoked until expiresAt, after which the entry
+	// may be discarded because the token can no longer validate anyway.
+	Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+	Cleanup(ctx context.Context) error // Remove entries past their expiry
+}
+
 // Middleware defines authentication middleware interface
 type Middleware interface {
 	RequireAuth(next http.Handler) http.Handler
@@ -69,10 +82,12 @@ type Claims struct {
 	Email       string                 `json:"email"`
 	Roles       []string               `json:"roles"`
 	Permissions []string               `json:"permissions"`
+	Scopes      []string               `json:"scope"`
 	IssuedAt    time.Time              `json:"iat"`
 	ExpiresAt   time.Time              `json:"exp"`
 	Issuer      string                 `json:"iss"`
 	Subject     string                 `json:"sub"`
+	TokenID     string                 `json:"jti"`
 	Custom      map[string]interface{} `json:"custom,omitempty"`
 }
 
@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Compile-time check that Service provides the authentication middleware.
+var _ Middleware = (*Service)(nil)
+
+// claimsContextKey is the context key under which validated claims are stored.
+type claimsContextKey struct{}
+
+// ContextWithClaims returns a copy of ctx carrying claims.
+func ContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims stored by the authentication middleware.
+//
+// Example:
+//
+//	func handler(w http.ResponseWriter, r *http.Request) {
+//	    claims, ok := auth.ClaimsFromContext(r.Context())
+//	    if !ok {
+//	        // unauthenticated request (only possible behind OptionalAuth)
+//	    }
+//	    fmt.Fprintf(w, "hello %s", claims.UserID)
+//	}
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*Claims)
+	return claims, ok && claims != nil
+}
+
+// RequireAuth rejects requests without a valid bearer token with 401 and
+// stores the token's claims in the request context.
+func (s *Service) RequireAuth(next http.Handler) http.Handler {
+	return s.authorize(nil, "", next)
+}
+
+// OptionalAuth stores the claims of a valid bearer token in the request
+// context but lets requests without an Authorization header through.
+// Requests presenting an invalid token are rejected with 401.
+func (s *Service) OptionalAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		s.authorize(nil, "", next).ServeHTTP(w, r)
+	})
+}
+
+// RequireRole requires a valid bearer token whose roles claim contains role.
+func (s *Service) RequireRole(role string) func(http.Handler) http.Handler {
+	return s.RequireRoles(role)
+}
+
+// RequireRoles requires a valid bearer token whose roles claim contains at
+// least one of roles. Missing or invalid tokens get 401, tokens without a
+// matching role get 403.
+func (s *Service) RequireRoles(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return s.authorize(func(claims *Claims) bool {
+			for _, role := range roles {
+				if slices.Contains(claims.Roles, role) {
+					return true
+				}
+			}
+			return false
+		}, "", next)
+	}
+}
+
+// RequirePermission requires a valid bearer token whose permissions claim
+// contains permission.
+func (s *Service) RequirePermission(permission string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return s.authorize(func(claims *Claims) bool {
+			return slices.Contains(claims.Permissions, permission)
+		}, "", next)
+	}
+}
+
+// RequireScopes requires a valid bearer token whose scope claim contains all
+// of scopes. Missing or invalid tokens get 401, tokens lacking a scope get
+// 403 with an RFC 6750 insufficient_scope challenge.
+//
+// Example:
+//
+//	router.With(authService.RequireScopes("orders:read")).Get("/orders", listOrders)
+func (s *Service) RequireScopes(scopes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		challenge := fmt.Sprintf(`Bearer error="insufficient_scope", scope=%q`, strings.Join(scopes, " "))
+		return s.authorize(func(claims *Claims) bool {
+			for _, scope := range scopes {
+				if !slices.Contains(claims.Scopes, scope) {
+					return false
+				}
+			}
+			return true
+		}, challenge, next)
+	}
+}
+
+// authorize validates the request's bearer token, applies allowed to its
+// claims and calls next with the claims stored in the request context.
+// forbiddenChallenge, if set, is sent as the WWW-Authenticate header of 403 responses.
+func (s *Service) authorize(allowed func(*Claims) bool, forbiddenChallenge string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := bearerToken(r)
+		if !ok {
+			w.Header().Set("WWW-Authenticate", `Bearer`)
+			http.Error(w, "missing bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		claims, err := s.ValidateToken(token)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+
+		if allowed != nil && !allowed(claims) {
+			if forbiddenChallenge != "" {
+				w.Header().Set("WWW-Authenticate", forbiddenChallenge)
+			}
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(ContextWithClaims(r.Context(), claims)))
+	})
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer <token>" header
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
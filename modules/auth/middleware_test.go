@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMiddlewareTestService() *Service {
+	config := &Config{
+		JWT: JWTConfig{
+			Secret:            "test-secret",
+			Expiration:        1 * time.Hour,
+			RefreshExpiration: 24 * time.Hour,
+		},
+	}
+	return NewService(config, NewMemoryUserStore(), NewMemorySessionStore())
+}
+
+// claimsEchoHandler responds with the user ID found in the request context
+func claimsEchoHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := ClaimsFromContext(r.Context())
+		if !ok {
+			_, _ = w.Write([]byte("anonymous"))
+			return
+		}
+		_, _ = w.Write([]byte(claims.UserID))
+	})
+}
+
+func serve(handler http.Handler, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware_RequireScopes(t *testing.T) {
+	service := newMiddlewareTestService()
+	handler := service.RequireScopes("orders:read", "orders:write")(claimsEchoHandler())
+
+	t.Run("missing token", func(t *testing.T) {
+		rec := serve(handler, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, "Bearer", rec.Header().Get("WWW-Authenticate"))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		rec := serve(handler, "not-a-jwt")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Header().Get("WWW-Authenticate"), "invalid_token")
+	})
+
+	t.Run("insufficient scope", func(t *testing.T) {
+		pair, err := service.GenerateToken("user-1", map[string]interface{}{"scope": "orders:read"})
+		require.NoError(t, err)
+
+		rec := serve(handler, pair.AccessToken)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Contains(t, rec.Header().Get("WWW-Authenticate"), `error="insufficient_scope"`)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		pair, err := service.GenerateToken("user-1", map[string]interface{}{"scope": "profile orders:read orders:write"})
+		require.NoError(t, err)
+
+		rec := serve(handler, pair.AccessToken)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "user-1", rec.Body.String())
+	})
+}
+
+func TestMiddleware_RequireRoles(t *testing.T) {
+	service := newMiddlewareTestService()
+	handler := service.RequireRoles("admin", "support")(claimsEchoHandler())
+
+	rec := serve(handler, "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	userPair, err := service.GenerateToken("user-1", map[string]interface{}{"roles": []string{"user"}})
+	require.NoError(t, err)
+	rec = serve(handler, userPair.AccessToken)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	supportPair, err := service.GenerateToken("user-2", map[string]interface{}{"roles": []string{"user", "support"}})
+	require.NoError(t, err)
+	rec = serve(handler, supportPair.AccessToken)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "user-2", rec.Body.String())
+}
+
+func TestMiddleware_RequirePermissionAndOptionalAuth(t *testing.T) {
+	service := newMiddlewareTestService()
+
+	pair, err := service.GenerateToken("user-1", map[string]interface{}{"permissions": []string{"read"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, serve(service.RequirePermission("read")(claimsEchoHandler()), pair.AccessToken).Code)
+	assert.Equal(t, http.StatusForbidden, serve(service.RequirePermission("write")(claimsEchoHandler()), pair.AccessToken).Code)
+
+	optional := service.OptionalAuth(claimsEchoHandler())
+	rec := serve(optional, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "anonymous", rec.Body.String())
+
+	rec = serve(optional, pair.AccessToken)
+	assert.Equal(t, "user-1", rec.Body.String())
+
+	assert.Equal(t, http.StatusUnauthorized, serve(optional, "garbage").Code)
+
+	// Revoked tokens are rejected by the middleware too
+	claims, err := service.ValidateToken(pair.AccessToken)
+	require.NoError(t, err)
+	require.NoError(t, service.RevokeToken(claims.TokenID))
+	assert.Equal(t, http.StatusUnauthorized, serve(service.RequireAuth(claimsEchoHandler()), pair.AccessToken).Code)
+}
+
+func TestClaimsFromContext_Empty(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	_, ok := ClaimsFromContext(req.Context())
+	assert.False(t, ok)
+}
@@ -24,6 +24,7 @@ package auth
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/GoCodeAlone/modular"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -46,6 +47,10 @@ type Module struct {
 	service *Service
 	logger  modular.Logger
 	subject modular.Subject // For event emission
+
+	// Background revocation cleanup, running between Start and Stop
+	cleanupCancel context.CancelFunc
+	cleanupDone   chan struct{}
 }
 
 // NewModule creates a new authentication module.
@@ -121,22 +126,53 @@ func (m *Module) Init(app modular.Application) error {
 }
 
 // Start starts the authentication module.
-// Currently the auth module doesn't require any startup operations,
-// but this method is available for future enhancements like background
-// token cleanup or session maintenance tasks.
+// It schedules the periodic removal of expired entries from the revocation
+// store, every JWT.RevocationCleanupInterval.
 func (m *Module) Start(ctx context.Context) error {
+	if m.cleanupCancel == nil && m.service != nil {
+		cleanupCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		m.cleanupCancel = cancel
+		m.cleanupDone = make(chan struct{})
+		go m.runRevocationCleanup(cleanupCtx, m.config.JWT.GetRevocationCleanupInterval())
+	}
+
 	m.logger.Info("Authentication module started", "module", m.Name())
 	return nil
 }
 
-// Stop stops the authentication module.
-// This method can be used for cleanup operations like closing database
-// connections or stopping background tasks when they are added in the future.
+// Stop stops the authentication module and its background revocation cleanup.
 func (m *Module) Stop(ctx context.Context) error {
+	if m.cleanupCancel != nil {
+		m.cleanupCancel()
+		select {
+		case <-m.cleanupDone:
+		case <-ctx.Done():
+		}
+		m.cleanupCancel = nil
+	}
+
 	m.logger.Info("Authentication module stopped", "module", m.Name())
 	return nil
 }
 
+// runRevocationCleanup removes expired revocations every interval until ctx is done
+func (m *Module) runRevocationCleanup(ctx context.Context, interval time.Duration) {
+	defer close(m.cleanupDone)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.service.CleanupRevocations(ctx); err != nil {
+				m.logger.Warn("Failed to clean up token revocations", "module", m.Name(), "error", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // Dependencies returns the module dependencies.
 // The auth module has no required module dependencies, making it suitable
 // for use as a foundation module that other modules can depend on.
@@ -166,6 +202,7 @@ func (m *Module) ProvidesServices() []modular.ServiceProvider {
 // Optional services:
 //   - user_store: Implementation of UserStore interface for persistent user data
 //   - session_store: Implementation of SessionStore interface for session persistence
+//   - revocation_store: Implementation of RevocationStore interface for shared token revocation
 func (m *Module) RequiresServices() []modular.ServiceDependency {
 	return []modular.ServiceDependency{
 		{
@@ -176,6 +213,10 @@ func (m *Module) RequiresServices() []modular.ServiceDependency {
 			Name:     "session_store",
 			Required: false, // Optional - will use in-memory store if not provided
 		},
+		{
+			Name:     "revocation_store",
+			Required: false, // Optional - will use in-memory store if not provided
+		},
 	}
 }
 
@@ -186,6 +227,7 @@ func (m *Module) RequiresServices() []modular.ServiceDependency {
 // Dependencies resolved:
 //   - user_store: External user storage (falls back to memory store)
 //   - session_store: External session storage (falls back to memory store)
+//   - revocation_store: External token revocation storage (falls back to memory store)
 func (m *Module) Constructor() modular.ModuleConstructor {
 	return func(app modular.Application, services map[string]any) (modular.Module, error) {
 		// Get user store (use injected if provided)
@@ -208,6 +250,16 @@ func (m *Module) Constructor() modular.ModuleConstructor {
 			}
 		}
 
+		// Get revocation store (use injected if provided)
+		var revocationStore RevocationStore
+		if rs, ok := services["revocation_store"]; ok {
+			if revocationStoreImpl, ok := rs.(RevocationStore); ok {
+				revocationStore = revocationStoreImpl
+			} else {
+				return nil, ErrRevocationStoreNotInterface
+			}
+		}
+
 		// Create or recreate the auth service with the appropriate stores
 		// This handles both the case where Init() already created a service (normal flow)
 		// and the case where the constructor is called directly (unit tests)
@@ -225,6 +277,10 @@ func (m *Module) Constructor() modular.ModuleConstructor {
 			}, userStore, sessionStore)
 		}
 
+		if revocationStore != nil {
+			m.service.SetRevocationStore(revocationStore)
+		}
+
 		// Set the event emitter in the service
 		m.service.SetEventEmitter(m)
 
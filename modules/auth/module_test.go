@@ -192,7 +192,7 @@ func TestModule_RequiresServices(t *testing.T) {
 	module := &Module{}
 	deps := module.RequiresServices()
 
-	require.Len(t, deps, 2)
+	require.Len(t, deps, 3)
 
 	// Check user_store dependency
 	userStoreDep := deps[0]
@@ -203,6 +203,11 @@ func TestModule_RequiresServices(t *testing.T) {
 	sessionStoreDep := deps[1]
 	assert.Equal(t, "session_store", sessionStoreDep.Name)
 	assert.False(t, sessionStoreDep.Required, "session_store should be optional")
+
+	// Check revocation_store dependency
+	revocationStoreDep := deps[2]
+	assert.Equal(t, "revocation_store", revocationStoreDep.Name)
+	assert.False(t, revocationStoreDep.Required, "revocation_store should be optional")
 }
 
 func TestModule_RegisterConfig(t *testing.T) {
@@ -280,6 +285,30 @@ func TestModule_StartStop(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestModule_RevocationCleanupScheduled(t *testing.T) {
+	config := &Config{JWT: JWTConfig{Secret: "test-secret", RevocationCleanupInterval: 10 * time.Millisecond}}
+	store := NewMemoryRevocationStore()
+	service := NewService(config, NewMemoryUserStore(), NewMemorySessionStore())
+	service.SetRevocationStore(store)
+	module := &Module{config: config, service: service, logger: MockLogger{}}
+
+	ctx := context.Background()
+	require.NoError(t, store.Revoke(ctx, "expired", time.Now().Add(-time.Minute)))
+	require.NoError(t, store.Revoke(ctx, "live", time.Now().Add(time.Hour)))
+
+	require.NoError(t, module.Start(ctx))
+	assert.Eventually(t, func() bool {
+		revoked, _ := store.IsRevoked(ctx, "expired")
+		return !revoked
+	}, time.Second, 10*time.Millisecond)
+	revoked, err := store.IsRevoked(ctx, "live")
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	require.NoError(t, module.Stop(ctx))
+	assert.Nil(t, module.cleanupCancel)
+}
+
 func TestModule_Constructor(t *testing.T) {
 	module := &Module{
 		config: &Config{
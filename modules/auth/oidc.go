@@ -0,0 +1,328 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Default OIDC key cache settings
+const (
+	DefaultJWKSRefreshInterval = time.Hour
+	// DefaultJWKSMinRefreshInterval rate-limits JWKS fetches triggered by
+	// tokens signed with unknown key IDs.
+	DefaultJWKSMinRefreshInterval = 10 * time.Second
+)
+
+// oidcSigningMethods are the asymmetric algorithms accepted from external issuers.
+// HMAC is deliberately excluded since an IdP never shares its signing secret.
+var oidcSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// OIDCValidator validates tokens issued by an external OpenID Connect provider.
+// The provider's signing keys are discovered through
+// <issuer>/.well-known/openid-configuration, cached, and refetched when they
+// age past the refresh interval or a token references an unknown key ID,
+// which handles key rotation at the IdP.
+type OIDCValidator struct {
+	name               string
+	issuer             string
+	audience           string
+	httpClient         *http.Client
+	refreshInterval    time.Duration
+	minRefreshInterval time.Duration
+
+	mutex     sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// OIDCValidatorOption configures an OIDCValidator
+type OIDCValidatorOption func(*OIDCValidator)
+
+// WithOIDCHTTPClient sets the HTTP client used for discovery and JWKS requests
+func WithOIDCHTTPClient(client *http.Client) OIDCValidatorOption {
+	return func(v *OIDCValidator) {
+		v.httpClient = client
+	}
+}
+
+// WithOIDCProviderName names the provider, distinguishing validators that
+// share an issuer URL
+func WithOIDCProviderName(name string) OIDCValidatorOption {
+	return func(v *OIDCValidator) {
+		v.name = name
+	}
+}
+
+// WithJWKSRefreshInterval sets how long fetched signing keys are cached
+func WithJWKSRefreshInterval(interval time.Duration) OIDCValidatorOption {
+	return func(v *OIDCValidator) {
+		v.refreshInterval = interval
+	}
+}
+
+// WithJWKSMinRefreshInterval sets the minimum time between JWKS fetches
+// triggered by unknown key IDs
+func WithJWKSMinRefreshInterval(interval time.Duration) OIDCValidatorOption {
+	return func(v *OIDCValidator) {
+		v.minRefreshInterval = interval
+	}
+}
+
+// NewOIDCValidator creates a validator for tokens issued by issuerURL.
+// If audience is non-empty, tokens must list it in their "aud" claim.
+func NewOIDCValidator(issuerURL, audience string, opts ...OIDCValidatorOption) *OIDCValidator {
+	v := &OIDCValidator{
+		issuer:             strings.TrimSuffix(issuerURL, "/"),
+		audience:           audience,
+		httpClient:         &http.Client{Timeout: 10 * time.Second},
+		refreshInterval:    DefaultJWKSRefreshInterval,
+		minRefreshInterval: DefaultJWKSMinRefreshInterval,
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Issuer returns the issuer URL whose tokens this validator accepts
+func (v *OIDCValidator) Issuer() string {
+	return v.issuer
+}
+
+// Name returns the provider name set with WithOIDCProviderName
+func (v *OIDCValidator) Name() string {
+	return v.name
+}
+
+// ValidateToken verifies the token's signature against the issuer's JWKS and
+// validates its iss, aud and exp claims.
+func (v *OIDCValidator) ValidateToken(ctx context.Context, tokenString string) (*Claims, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(oidcSigningMethods),
+		jwt.WithIssuer(v.issuer),
+		jwt.WithExpirationRequired(),
+	}
+	if v.audience != "" {
+		options = append(options, jwt.WithAudience(v.audience))
+	}
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return v.key(ctx, kid)
+	}, options...)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrTokenExpired
+		}
+		return nil, fmt.Errorf("%w: %w", ErrTokenInvalid, err)
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, ErrTokenMalformed
+	}
+
+	result := claimsFromMap(claims)
+	if result.UserID == "" {
+		// External issuers identify the user by subject
+		result.UserID = result.Subject
+	}
+	return result, nil
+}
+
+// key returns the signing key with the given key ID, refreshing the cached
+// JWKS when it is stale or does not contain kid.
+func (v *OIDCValidator) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	v.mutex.RLock()
+	key, found := v.lookup(kid)
+	stale := time.Since(v.fetchedAt) > v.refreshInterval
+	recentlyFetched := time.Since(v.fetchedAt) < v.minRefreshInterval
+	v.mutex.RUnlock()
+
+	if found && !stale {
+		return key, nil
+	}
+	if !found && !stale && recentlyFetched {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSigningKey, kid)
+	}
+
+	if err := v.refresh(ctx); err != nil {
+		if found {
+			// Keep serving the cached key if the IdP is temporarily unreachable
+			return key, nil
+		}
+		return nil, err
+	}
+
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+	if key, found := v.lookup(kid); found {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownSigningKey, kid)
+}
+
+// lookup finds a cached key; a token without kid matches a single cached key.
+// Callers must hold the mutex.
+func (v *OIDCValidator) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, true
+		}
+	}
+	key, found := v.keys[kid]
+	return key, found
+}
+
+// refresh fetches the discovery document and the JWKS it references
+func (v *OIDCValidator) refresh(ctx context.Context) error {
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := v.getJSON(ctx, v.issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return fmt.Errorf("%w: %w", ErrOIDCDiscoveryFailed, err)
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != v.issuer {
+		return fmt.Errorf("%w: issuer mismatch %q", ErrOIDCDiscoveryFailed, discovery.Issuer)
+	}
+	if discovery.JWKSURI == "" {
+		return fmt.Errorf("%w: jwks_uri missing", ErrOIDCDiscoveryFailed)
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := v.getJSON(ctx, discovery.JWKSURI, &jwks); err != nil {
+		return fmt.Errorf("%w: %w", ErrJWKSFetchFailed, err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Skip keys of unsupported types rather than failing the whole set
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	v.mutex.Lock()
+	v.keys = keys
+	v.fetchedAt = time.Now()
+	v.mutex.Unlock()
+	return nil
+}
+
+// getJSON fetches url and decodes its JSON body into target
+func (v *OIDCValidator) getJSON(ctx context.Context, url string, target interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("requesting %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("requesting %s: %w %d", url, ErrOIDCUnexpectedStatus, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("decoding %s: %w", url, err)
+	}
+	return nil
+}
+
+// jsonWebKey is a public key from a JWKS document (RFC 7517)
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// publicKey decodes the RSA or EC public key described by the JWK
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeJWKInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeJWKInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("%w: RSA exponent too large", ErrUnsupportedJWK)
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("%w: curve %q", ErrUnsupportedJWK, k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnsupportedJWK, err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnsupportedJWK, err)
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) > size || len(y) > size {
+			return nil, fmt.Errorf("%w: invalid EC coordinates", ErrUnsupportedJWK)
+		}
+		point := make([]byte, 1+2*size)
+		point[0] = 4 // uncompressed point
+		copy(point[1+size-len(x):1+size], x)
+		copy(point[1+2*size-len(y):], y)
+		key, err := ecdsa.ParseUncompressedPublicKey(curve, point)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnsupportedJWK, err)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("%w: key type %q", ErrUnsupportedJWK, k.Kty)
+	}
+}
+
+// decodeJWKInt decodes a base64url-encoded big-endian integer
+func decodeJWKInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(data) == 0 {
+		return nil, fmt.Errorf("%w: invalid integer", ErrUnsupportedJWK)
+	}
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,269 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockIdP serves an OIDC discovery document and a JWKS whose keys can be rotated
+type mockIdP struct {
+	server   *httptest.Server
+	mutex    sync.Mutex
+	keys     []map[string]string
+	jwksHits atomic.Int32
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	t.Helper()
+	idp := &mockIdP{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   idp.server.URL,
+			"jwks_uri": idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		idp.jwksHits.Add(1)
+		idp.mutex.Lock()
+		defer idp.mutex.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": idp.keys})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (idp *mockIdP) publishRSA(kid string, key *rsa.PrivateKey) {
+	idp.mutex.Lock()
+	defer idp.mutex.Unlock()
+	idp.keys = []map[string]string{{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}
+}
+
+func (idp *mockIdP) publishEC(kid string, key *ecdsa.PrivateKey) {
+	idp.mutex.Lock()
+	defer idp.mutex.Unlock()
+	point, err := key.PublicKey.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	size := (len(point) - 1) / 2
+	idp.keys = []map[string]string{{
+		"kty": "EC",
+		"kid": kid,
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(point[1 : 1+size]),
+		"y":   base64.RawURLEncoding.EncodeToString(point[1+size:]),
+	}}
+}
+
+func signExternalToken(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func externalClaims(issuer string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":   issuer,
+		"sub":   "external-user",
+		"aud":   "my-api",
+		"email": "ext@example.com",
+		"scope": "orders:read",
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestOIDCValidator(t *testing.T) {
+	idp := newMockIdP(t)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idp.publishRSA("key-1", rsaKey)
+
+	validator := NewOIDCValidator(idp.server.URL, "my-api", WithJWKSMinRefreshInterval(0))
+	ctx := context.Background()
+
+	t.Run("valid token", func(t *testing.T) {
+		token := signExternalToken(t, jwt.SigningMethodRS256, rsaKey, "key-1", externalClaims(idp.server.URL))
+		claims, err := validator.ValidateToken(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, "external-user", claims.UserID)
+		assert.Equal(t, "ext@example.com", claims.Email)
+		assert.Equal(t, []string{"orders:read"}, claims.Scopes)
+		assert.Equal(t, idp.server.URL, claims.Issuer)
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		claims := externalClaims(idp.server.URL)
+		claims["aud"] = "another-api"
+		_, err := validator.ValidateToken(ctx, signExternalToken(t, jwt.SigningMethodRS256, rsaKey, "key-1", claims))
+		require.ErrorIs(t, err, ErrTokenInvalid)
+	})
+
+	t.Run("wrong issuer", func(t *testing.T) {
+		claims := externalClaims("https://evil.example.com")
+		_, err := validator.ValidateToken(ctx, signExternalToken(t, jwt.SigningMethodRS256, rsaKey, "key-1", claims))
+		require.ErrorIs(t, err, ErrTokenInvalid)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		claims := externalClaims(idp.server.URL)
+		claims["exp"] = time.Now().Add(-time.Minute).Unix()
+		_, err := validator.ValidateToken(ctx, signExternalToken(t, jwt.SigningMethodRS256, rsaKey, "key-1", claims))
+		require.ErrorIs(t, err, ErrTokenExpired)
+	})
+
+	t.Run("forged signature", func(t *testing.T) {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		_, err = validator.ValidateToken(ctx, signExternalToken(t, jwt.SigningMethodRS256, otherKey, "key-1", externalClaims(idp.server.URL)))
+		require.ErrorIs(t, err, ErrTokenInvalid)
+	})
+
+	t.Run("key rotation", func(t *testing.T) {
+		rotatedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		idp.publishEC("key-2", rotatedKey)
+
+		before := idp.jwksHits.Load()
+		token := signExternalToken(t, jwt.SigningMethodES256, rotatedKey, "key-2", externalClaims(idp.server.URL))
+		claims, err := validator.ValidateToken(ctx, token)
+		require.NoError(t, err, "unknown kid should trigger a JWKS refetch")
+		assert.Equal(t, "external-user", claims.UserID)
+		assert.Equal(t, before+1, idp.jwksHits.Load())
+
+		// The retired key is no longer trusted
+		_, err = validator.ValidateToken(ctx, signExternalToken(t, jwt.SigningMethodRS256, rsaKey, "key-1", externalClaims(idp.server.URL)))
+		require.ErrorIs(t, err, ErrTokenInvalid)
+	})
+}
+
+func TestOIDCValidator_DiscoveryErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	validator := NewOIDCValidator(server.URL, "my-api", WithJWKSMinRefreshInterval(0))
+	_, err = validator.ValidateToken(context.Background(), signExternalToken(t, jwt.SigningMethodRS256, rsaKey, "key-1", externalClaims(server.URL)))
+	require.ErrorIs(t, err, ErrOIDCDiscoveryFailed)
+	require.ErrorIs(t, err, ErrOIDCUnexpectedStatus)
+	assert.Contains(t, err.Error(), "503")
+}
+
+func TestOIDCValidator_UnknownKidIsRateLimited(t *testing.T) {
+	idp := newMockIdP(t)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idp.publishRSA("key-1", rsaKey)
+
+	validator := NewOIDCValidator(idp.server.URL, "", WithJWKSMinRefreshInterval(time.Hour))
+	ctx := context.Background()
+
+	_, err = validator.ValidateToken(ctx, signExternalToken(t, jwt.SigningMethodRS256, rsaKey, "key-1", externalClaims(idp.server.URL)))
+	require.NoError(t, err)
+
+	for range 5 {
+		_, err = validator.ValidateToken(ctx, signExternalToken(t, jwt.SigningMethodRS256, rsaKey, "unknown", externalClaims(idp.server.URL)))
+		require.ErrorIs(t, err, ErrUnknownSigningKey)
+	}
+	assert.Equal(t, int32(1), idp.jwksHits.Load(), "unknown kids must not hammer the JWKS endpoint")
+}
+
+func TestService_ValidateTokenDelegatesToOIDC(t *testing.T) {
+	idp := newMockIdP(t)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idp.publishRSA("key-1", rsaKey)
+
+	config := &Config{
+		JWT: JWTConfig{
+			Secret:            "test-secret",
+			Expiration:        time.Hour,
+			RefreshExpiration: 24 * time.Hour,
+			Issuer:            "local",
+		},
+		OIDC: OIDCConfig{Issuers: []OIDCIssuer{{IssuerURL: idp.server.URL + "/", Audience: "my-api"}}},
+	}
+	service := NewService(config, NewMemoryUserStore(), NewMemorySessionStore())
+
+	// External tokens are validated against the IdP's keys
+	external := signExternalToken(t, jwt.SigningMethodRS256, rsaKey, "key-1", externalClaims(idp.server.URL))
+	claims, err := service.ValidateToken(external)
+	require.NoError(t, err)
+	assert.Equal(t, "external-user", claims.UserID)
+
+	// Locally issued tokens still validate with the shared secret
+	pair, err := service.GenerateToken("local-user", nil)
+	require.NoError(t, err)
+	claims, err = service.ValidateToken(pair.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "local-user", claims.UserID)
+
+	// A token claiming the external issuer but signed with the local secret is rejected
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, externalClaims(idp.server.URL))
+	forgedString, err := forged.SignedString([]byte("test-secret"))
+	require.NoError(t, err)
+	_, err = service.ValidateToken(forgedString)
+	require.ErrorIs(t, err, ErrTokenInvalid)
+}
+
+func TestService_OIDCProvidersSharingIssuer(t *testing.T) {
+	idp := newMockIdP(t)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idp.publishRSA("key-1", rsaKey)
+
+	config := &Config{
+		JWT: JWTConfig{Secret: "test-secret", Expiration: time.Hour, RefreshExpiration: 24 * time.Hour},
+		OIDC: OIDCConfig{Issuers: []OIDCIssuer{
+			{Name: "web", IssuerURL: idp.server.URL, Audience: "web-app"},
+			{Name: "mobile", IssuerURL: idp.server.URL, Audience: "mobile-app"},
+		}},
+	}
+	service := NewService(config, NewMemoryUserStore(), NewMemorySessionStore())
+	require.Len(t, service.oidcValidators, 2, "providers sharing an issuer must not replace each other")
+
+	// Tokens for either provider's audience validate
+	for _, audience := range []string{"web-app", "mobile-app"} {
+		claims := externalClaims(idp.server.URL)
+		claims["aud"] = audience
+		validated, err := service.ValidateToken(signExternalToken(t, jwt.SigningMethodRS256, rsaKey, "key-1", claims))
+		require.NoError(t, err, audience)
+		assert.Equal(t, "external-user", validated.UserID)
+	}
+
+	// A token for neither audience is rejected
+	claims := externalClaims(idp.server.URL)
+	claims["aud"] = "other-app"
+	_, err = service.ValidateToken(signExternalToken(t, jwt.SigningMethodRS256, rsaKey, "key-1", claims))
+	require.ErrorIs(t, err, ErrTokenInvalid)
+}
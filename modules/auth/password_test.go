@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPasswordTestService creates a service hashing with the given algorithm
+// using cheap parameters suitable for tests
+func newPasswordTestService(algorithm string) *Service {
+	config := &Config{
+		Password: PasswordConfig{
+			Algorithm:         algorithm,
+			BcryptCost:        4,
+			Argon2Time:        1,
+			Argon2Memory:      8 * 1024,
+			Argon2Parallelism: 1,
+		},
+	}
+	return NewService(config, NewMemoryUserStore(), NewMemorySessionStore())
+}
+
+func TestPasswordHashers(t *testing.T) {
+	hashers := []PasswordHasher{
+		NewBcryptHasher(4),
+		NewArgon2idHasher(1, 8*1024, 1),
+	}
+
+	for _, hasher := range hashers {
+		t.Run(hasher.Name(), func(t *testing.T) {
+			hash, err := hasher.Hash("Secret123!")
+			require.NoError(t, err)
+			assert.True(t, hasher.Recognizes(hash))
+			assert.False(t, hasher.NeedsRehash(hash))
+
+			require.NoError(t, hasher.Verify(hash, "Secret123!"))
+			require.ErrorIs(t, hasher.Verify(hash, "wrong"), ErrInvalidCredentials)
+
+			other, err := hasher.Hash("Secret123!")
+			require.NoError(t, err)
+			assert.NotEqual(t, hash, other, "hashes must be salted")
+		})
+	}
+
+	t.Run("argon2id format", func(t *testing.T) {
+		hash, err := NewArgon2idHasher(1, 8*1024, 1).Hash("Secret123!")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=8192,t=1,p=1$"))
+		assert.True(t, NewArgon2idHasher(2, 8*1024, 1).NeedsRehash(hash))
+		require.ErrorIs(t, NewArgon2idHasher(0, 0, 0).Verify("$argon2id$garbage", "x"), ErrInvalidCredentials)
+	})
+}
+
+func TestParseArgon2idHashRejectsUnsafeParameters(t *testing.T) {
+	const salt, key = "c2FsdHNhbHRzYWx0c2FsdA", "a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"
+	tests := map[string]string{
+		"zero parallelism": "m=8192,t=1,p=0",
+		"zero time":        "m=8192,t=0,p=1",
+		"zero memory":      "m=0,t=1,p=1",
+		"huge memory":      "m=4294967295,t=1,p=1",
+		"huge time":        "m=8192,t=4294967295,p=1",
+		"huge parallelism": "m=8192,t=1,p=255",
+	}
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			hash := "$argon2id$v=19$" + params + "$" + salt + "$" + key
+			_, _, _, err := parseArgon2idHash(hash)
+			require.ErrorIs(t, err, errMalformedArgon2Hash)
+			require.ErrorIs(t, NewArgon2idHasher(1, 8*1024, 1).Verify(hash, "x"), ErrInvalidCredentials)
+		})
+	}
+
+	t.Run("oversized key", func(t *testing.T) {
+		hash := "$argon2id$v=19$m=8192,t=1,p=1$" + salt + "$" + strings.Repeat("A", 4*maxArgon2KeyLength)
+		_, _, _, err := parseArgon2idHash(hash)
+		require.ErrorIs(t, err, errMalformedArgon2Hash)
+	})
+}
+
+func TestService_PasswordCrossAlgorithmVerification(t *testing.T) {
+	bcryptService := newPasswordTestService(PasswordAlgorithmBcrypt)
+	argonService := newPasswordTestService(PasswordAlgorithmArgon2id)
+
+	bcryptHash, err := bcryptService.HashPassword("Secret123!")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(bcryptHash, "$2a$"))
+
+	argonHash, err := argonService.HashPassword("Secret123!")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(argonHash, "$argon2id$"))
+
+	// Existing bcrypt hashes keep verifying after switching to argon2id, and vice versa
+	require.NoError(t, argonService.VerifyPassword(bcryptHash, "Secret123!"))
+	require.ErrorIs(t, argonService.VerifyPassword(bcryptHash, "wrong"), ErrInvalidCredentials)
+	require.NoError(t, bcryptService.VerifyPassword(argonHash, "Secret123!"))
+	require.ErrorIs(t, bcryptService.VerifyPassword(argonHash, "wrong"), ErrInvalidCredentials)
+
+	require.ErrorIs(t, argonService.VerifyPassword("plaintext", "plaintext"), ErrInvalidCredentials)
+}
+
+func TestService_VerifyPasswordAndRehash(t *testing.T) {
+	bcryptService := newPasswordTestService(PasswordAlgorithmBcrypt)
+	argonService := newPasswordTestService(PasswordAlgorithmArgon2id)
+
+	bcryptHash, err := bcryptService.HashPassword("Secret123!")
+	require.NoError(t, err)
+
+	// A bcrypt hash is upgraded on login once argon2id is configured
+	newHash, err := argonService.VerifyPasswordAndRehash(bcryptHash, "Secret123!")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(newHash, "$argon2id$"))
+	require.NoError(t, argonService.VerifyPassword(newHash, "Secret123!"))
+
+	// A current hash needs no rehash
+	again, err := argonService.VerifyPasswordAndRehash(newHash, "Secret123!")
+	require.NoError(t, err)
+	assert.Empty(t, again)
+
+	// Wrong passwords are never rehashed
+	_, err = argonService.VerifyPasswordAndRehash(bcryptHash, "wrong")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestConfig_ValidatePasswordAlgorithm(t *testing.T) {
+	config := &Config{
+		JWT:      JWTConfig{Secret: "secret", Expiration: 1, RefreshExpiration: 1},
+		Password: PasswordConfig{MinLength: 8, BcryptCost: 12, Algorithm: PasswordAlgorithmArgon2id},
+	}
+	require.NoError(t, config.Validate())
+
+	config.Password.Algorithm = "md5"
+	require.ErrorIs(t, config.Validate(), ErrInvalidConfig)
+
+	config.Password.Algorithm = PasswordAlgorithmArgon2id
+	config.Password.Argon2Memory = MaxArgon2Memory + 1
+	require.ErrorIs(t, config.Validate(), ErrInvalidConfig)
+}
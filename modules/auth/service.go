@@ -5,10 +5,13 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 	"unicode"
@@ -16,7 +19,6 @@ import (
 	"github.com/GoCodeAlone/modular"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/golang-jwt/jwt/v5"
-	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/oauth2"
 )
 
@@ -27,21 +29,37 @@ type EventEmitter interface {
 
 // Service implements the AuthService interface
 type Service struct {
-	config        *Config
-	userStore     UserStore
-	sessionStore  SessionStore
-	oauth2Configs map[string]*oauth2.Config
-	tokenCounter  int64        // Add counter to ensure unique tokens
-	eventEmitter  EventEmitter // For emitting events
+	config          *Config
+	userStore       UserStore
+	sessionStore    SessionStore
+	revocationStore RevocationStore
+	passwordHasher  PasswordHasher                     // Hashes new passwords
+	passwordHashers []PasswordHasher                   // Verify existing hashes, by algorithm
+	oidcValidators  map[oidcProviderKey]*OIDCValidator // External token issuers
+	oauth2Configs   map[string]*oauth2.Config
+	tokenCounter    int64        // Add counter to ensure unique tokens
+	eventEmitter    EventEmitter // For emitting events
+	refreshMutex    sync.Mutex   // Serializes refresh token rotation
 }
 
 // NewService creates a new authentication service
 func NewService(config *Config, userStore UserStore, sessionStore SessionStore) *Service {
 	s := &Service{
-		config:        config,
-		userStore:     userStore,
-		sessionStore:  sessionStore,
-		oauth2Configs: make(map[string]*oauth2.Config),
+		config:          config,
+		userStore:       userStore,
+		sessionStore:    sessionStore,
+		revocationStore: NewMemoryRevocationStore(),
+		oauth2Configs:   make(map[string]*oauth2.Config),
+		oidcValidators:  make(map[oidcProviderKey]*OIDCValidator),
+	}
+	s.SetPasswordHasher(newPasswordHasher(config.Password))
+
+	for _, issuer := range config.OIDC.Issuers {
+		opts := []OIDCValidatorOption{WithOIDCProviderName(issuer.Name)}
+		if issuer.JWKSRefreshInterval > 0 {
+			opts = append(opts, WithJWKSRefreshInterval(issuer.JWKSRefreshInterval))
+		}
+		s.AddOIDCValidator(NewOIDCValidator(issuer.IssuerURL, issuer.Audience, opts...))
 	}
 
 	// Initialize OAuth2 configurations if providers exist
@@ -68,6 +86,68 @@ func (s *Service) SetEventEmitter(emitter EventEmitter) {
 	s.eventEmitter = emitter
 }
 
+// SetRevocationStore replaces the store used to track revoked tokens.
+// The default is an in-memory store local to this service instance.
+func (s *Service) SetRevocationStore(store RevocationStore) {
+	s.revocationStore = store
+}
+
+// oidcProviderKey identifies an external token issuer. Providers may share an
+// issuer URL, so the provider name is part of the key.
+type oidcProviderKey struct {
+	issuer string
+	name   string
+}
+
+// AddOIDCValidator registers an external token issuer. ValidateToken delegates
+// tokens whose "iss" claim matches the validator's issuer to it; when several
+// validators share the issuer, the token is accepted by the first of them, in
+// order of name, that validates it. A validator with the same issuer and name
+// as a registered one replaces it.
+func (s *Service) AddOIDCValidator(validator *OIDCValidator) {
+	s.oidcValidators[oidcProviderKey{issuer: validator.Issuer(), name: validator.Name()}] = validator
+}
+
+// SetPasswordHasher sets the algorithm used to hash new passwords.
+// Hashes produced by the built-in bcrypt and argon2id hashers, as well as by
+// any previously set hasher, continue to verify.
+func (s *Service) SetPasswordHasher(hasher PasswordHasher) {
+	s.passwordHasher = hasher
+
+	hashers := []PasswordHasher{hasher}
+	for _, h := range s.passwordHashers {
+		if !containsHasher(hashers, h.Name()) {
+			hashers = append(hashers, h)
+		}
+	}
+	for _, h := range []PasswordHasher{NewBcryptHasher(s.config.Password.BcryptCost), NewArgon2idHasher(0, 0, 0)} {
+		if !containsHasher(hashers, h.Name()) {
+			hashers = append(hashers, h)
+		}
+	}
+	s.passwordHashers = hashers
+}
+
+// containsHasher reports whether hashers includes one with the given name
+func containsHasher(hashers []PasswordHasher, name string) bool {
+	for _, h := range hashers {
+		if h.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hasherFor returns the hasher that produced hashedPassword, if any
+func (s *Service) hasherFor(hashedPassword string) PasswordHasher {
+	for _, h := range s.passwordHashers {
+		if h.Recognizes(hashedPassword) {
+			return h
+		}
+	}
+	return nil
+}
+
 // emitEvent is a helper method to emit events if an emitter is available
 func (s *Service) emitEvent(ctx context.Context, eventType string, data interface{}, metadata map[string]interface{}) {
 	if s.eventEmitter != nil {
@@ -77,8 +157,28 @@ func (s *Service) emitEvent(ctx context.Context, eventType string, data interfac
 	}
 }
 
-// GenerateToken creates a new JWT token pair
+// GenerateToken creates a new JWT token pair.
+// Each token carries a unique "jti" claim that can be passed to RevokeToken.
+// The pair starts a new refresh token family used for reuse detection.
 func (s *Service) GenerateToken(userID string, customClaims map[string]interface{}) (*TokenPair, error) {
+	familyID, err := generateRandomID(16)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token family ID: %w", err)
+	}
+	return s.generateTokenPair(userID, customClaims, familyID)
+}
+
+// generateTokenPair creates a token pair belonging to the given refresh token family
+func (s *Service) generateTokenPair(userID string, customClaims map[string]interface{}, familyID string) (*TokenPair, error) {
+	accessTokenID, err := generateRandomID(16)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token ID: %w", err)
+	}
+	refreshTokenID, err := generateRandomID(16)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token ID: %w", err)
+	}
+
 	now := time.Now()
 	// Add atomic counter to ensure uniqueness
 	counter := atomic.AddInt64(&s.tokenCounter, 1)
@@ -101,6 +201,8 @@ func (s *Service) GenerateToken(userID string, customClaims map[string]interface
 	for key, value := range customClaims {
 		accessClaims[key] = value
 	}
+	accessClaims["jti"] = accessTokenID
+	accessClaims["fid"] = familyID
 
 	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
 	accessTokenString, err := accessToken.SignedString([]byte(s.config.JWT.Secret))
@@ -122,6 +224,8 @@ func (s *Service) GenerateToken(userID string, customClaims map[string]interface
 		refreshClaims["iss"] = s.config.JWT.Issuer
 	}
 	refreshClaims["sub"] = userID
+	refreshClaims["jti"] = refreshTokenID
+	refreshClaims["fid"] = familyID
 
 	refreshToken := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims)
 	refreshTokenString, err := refreshToken.SignedString([]byte(s.config.JWT.Secret))
@@ -150,8 +254,14 @@ func (s *Service) GenerateToken(userID string, customClaims map[string]interface
 	return tokenPair, nil
 }
 
-// ValidateToken validates a JWT token and returns the claims
+// ValidateToken validates a JWT token and returns the claims.
+// Tokens issued by a configured external OIDC issuer are validated against
+// that issuer's published keys; all others must be signed by this service.
 func (s *Service) ValidateToken(tokenString string) (*Claims, error) {
+	if validators := s.externalValidators(tokenString); len(validators) > 0 {
+		return s.validateExternalToken(validators, tokenString)
+	}
+
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("%w: %v", ErrUnexpectedSigningMethod, token.Header["alg"])
@@ -189,11 +299,99 @@ func (s *Service) ValidateToken(tokenString string) (*Claims, error) {
 		return nil, ErrTokenInvalid
 	}
 
-	// Extract claims
+	tokenID, _ := claims["jti"].(string)
+	familyID, _ := claims["fid"].(string)
+	if err := s.checkRevoked(tokenID, familyID); err != nil {
+		return nil, err
+	}
+
+	claimsResult := claimsFromMap(claims)
+	userID := claimsResult.UserID
+
+	// Emit token validated event
+	s.emitEvent(context.Background(), EventTypeTokenValidated, map[string]interface{}{
+		"userID":    userID,
+		"tokenType": claims["type"],
+	}, nil)
+
+	return claimsResult, nil
+}
+
+// externalValidators returns the OIDC validators for the token's issuer,
+// ordered by provider name. The issuer is read without verification only to
+// select the validators.
+func (s *Service) externalValidators(tokenString string) []*OIDCValidator {
+	if len(s.oidcValidators) == 0 {
+		return nil
+	}
+	token, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		return nil
+	}
+	issuer, err := token.Claims.GetIssuer()
+	if err != nil {
+		return nil
+	}
+	issuer = strings.TrimSuffix(issuer, "/")
+
+	var validators []*OIDCValidator
+	for key, validator := range s.oidcValidators {
+		if key.issuer == issuer {
+			validators = append(validators, validator)
+		}
+	}
+	sort.Slice(validators, func(i, j int) bool { return validators[i].Name() < validators[j].Name() })
+	return validators
+}
+
+// validateExternalToken validates a token from an external OIDC issuer with
+// the first of validators that accepts it
+func (s *Service) validateExternalToken(validators []*OIDCValidator, tokenString string) (*Claims, error) {
+	var (
+		claims    *Claims
+		validator *OIDCValidator
+		firstErr  error
+	)
+	for _, candidate := range validators {
+		var err error
+		if claims, err = candidate.ValidateToken(context.Background(), tokenString); err == nil {
+			validator = candidate
+			break
+		}
+		// Expiry is reported over audience mismatches of other providers
+		if firstErr == nil || errors.Is(err, ErrTokenExpired) {
+			firstErr = err
+		}
+	}
+	if validator == nil {
+		if errors.Is(firstErr, ErrTokenExpired) {
+			s.emitEvent(context.Background(), EventTypeTokenExpired, map[string]interface{}{
+				"issuer": validators[0].Issuer(),
+			}, nil)
+		}
+		return nil, firstErr
+	}
+
+	if err := s.checkRevoked(claims.TokenID, ""); err != nil {
+		return nil, err
+	}
+
+	s.emitEvent(context.Background(), EventTypeTokenValidated, map[string]interface{}{
+		"userID":   claims.UserID,
+		"issuer":   validator.Issuer(),
+		"provider": validator.Name(),
+	}, nil)
+	return claims, nil
+}
+
+// claimsFromMap converts parsed JWT claims into Claims. Claims other than the
+// standard ones are returned in Claims.Custom.
+func claimsFromMap(claims jwt.MapClaims) *Claims {
 	userID, _ := claims["user_id"].(string)
 	email, _ := claims["email"].(string)
 	issuer, _ := claims["iss"].(string)
 	subject, _ := claims["sub"].(string)
+	tokenID, _ := claims["jti"].(string)
 
 	var roles []string
 	if rolesInterface, exists := claims["roles"]; exists {
@@ -217,14 +415,33 @@ func (s *Service) ValidateToken(tokenString string) (*Claims, error) {
 		}
 	}
 
-	issuedAt := time.Unix(int64(claims["iat"].(float64)), 0)
-	expiresAt := time.Unix(int64(claims["exp"].(float64)), 0)
+	// The OAuth2 "scope" claim is a space-delimited string; accept a list too
+	var scopes []string
+	switch scopeClaim := claims["scope"].(type) {
+	case string:
+		scopes = strings.Fields(scopeClaim)
+	case []interface{}:
+		for _, scope := range scopeClaim {
+			if scopeStr, ok := scope.(string); ok {
+				scopes = append(scopes, scopeStr)
+			}
+		}
+	}
+
+	var issuedAt, expiresAt time.Time
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil {
+		issuedAt = iat.Time
+	}
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		expiresAt = exp.Time
+	}
 
 	// Extract custom claims
 	custom := make(map[string]interface{})
 	standardClaims := map[string]bool{
 		"user_id": true, "email": true, "roles": true, "permissions": true,
 		"iat": true, "exp": true, "iss": true, "sub": true, "type": true,
+		"jti": true, "fid": true, "scope": true,
 	}
 	for k, v := range claims {
 		if !standardClaims[k] {
@@ -232,25 +449,19 @@ func (s *Service) ValidateToken(tokenString string) (*Claims, error) {
 		}
 	}
 
-	claimsResult := &Claims{
+	return &Claims{
 		UserID:      userID,
 		Email:       email,
 		Roles:       roles,
 		Permissions: permissions,
+		Scopes:      scopes,
 		IssuedAt:    issuedAt,
 		ExpiresAt:   expiresAt,
 		Issuer:      issuer,
 		Subject:     subject,
+		TokenID:     tokenID,
 		Custom:      custom,
 	}
-
-	// Emit token validated event
-	s.emitEvent(context.Background(), EventTypeTokenValidated, map[string]interface{}{
-		"userID":    userID,
-		"tokenType": claims["type"],
-	}, nil)
-
-	return claimsResult, nil
 }
 
 // RefreshToken creates a new token pair using a refresh token
@@ -298,6 +509,23 @@ func (s *Service) RefreshToken(refreshTokenString string) (*TokenPair, error) {
 		return nil, ErrTokenMalformed
 	}
 
+	// Rotation: a refresh token may be used once. Serialize so that two
+	// concurrent uses of the same token cannot both succeed.
+	s.refreshMutex.Lock()
+	defer s.refreshMutex.Unlock()
+
+	tokenID, _ := claims["jti"].(string)
+	familyID, _ := claims["fid"].(string)
+	if err := s.checkRefreshTokenReuse(tokenID, familyID); err != nil {
+		return nil, err
+	}
+	if familyID == "" {
+		// Tokens issued before rotation was introduced start a new family
+		if familyID, err = generateRandomID(16); err != nil {
+			return nil, fmt.Errorf("failed to generate token family ID: %w", err)
+		}
+	}
+
 	// Get user to include current roles and permissions
 	user, err := s.userStore.GetUser(context.Background(), userID)
 	if err != nil {
@@ -318,11 +546,22 @@ func (s *Service) RefreshToken(refreshTokenString string) (*TokenPair, error) {
 		"permissions": user.Permissions,
 	}
 
-	newTokenPair, err := s.GenerateToken(userID, customClaims)
+	newTokenPair, err := s.generateTokenPair(userID, customClaims, familyID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Invalidate the refresh token that was just exchanged
+	if tokenID != "" {
+		expiresAt := time.Now().Add(s.config.JWT.GetJWTRefreshExpiration())
+		if exp, ok := claims["exp"].(float64); ok {
+			expiresAt = time.Unix(int64(exp), 0)
+		}
+		if err := s.revocationStore.Revoke(context.Background(), usedRefreshTokenPrefix+tokenID, expiresAt); err != nil {
+			return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+		}
+	}
+
 	// Emit token refreshed event
 	s.emitEvent(context.Background(), EventTypeTokenRefreshed, map[string]interface{}{
 		"userID":    userID,
@@ -332,25 +571,118 @@ func (s *Service) RefreshToken(refreshTokenString string) (*TokenPair, error) {
 	return newTokenPair, nil
 }
 
-// HashPassword hashes a password using bcrypt
+// RevokeToken revokes the access or refresh token with the given ID (its "jti"
+// claim). Revoked tokens fail ValidateToken and RefreshToken until they expire.
+func (s *Service) RevokeToken(tokenID string) error {
+	if tokenID == "" {
+		return ErrTokenIDRequired
+	}
+
+	// The revocation only needs to outlive the longest-lived token
+	lifetime := max(s.config.JWT.GetJWTExpiration(), s.config.JWT.GetJWTRefreshExpiration())
+	if err := s.revocationStore.Revoke(context.Background(), tokenID, time.Now().Add(lifetime)); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+// usedRefreshTokenPrefix namespaces the revocation store entries that mark
+// refresh tokens as exchanged, keeping them apart from explicit revocations
+// so that only replaying an exchanged token is treated as reuse.
+const usedRefreshTokenPrefix = "used:"
+
+// CleanupRevocations removes revocation entries whose tokens have expired.
+// The auth module calls it periodically while running.
+func (s *Service) CleanupRevocations(ctx context.Context) error {
+	if err := s.revocationStore.Cleanup(ctx); err != nil {
+		return fmt.Errorf("failed to clean up token revocations: %w", err)
+	}
+	return nil
+}
+
+// checkRevoked returns ErrTokenRevoked if the token or its family was revoked
+func (s *Service) checkRevoked(tokenID, familyID string) error {
+	for _, id := range []string{tokenID, familyID} {
+		if id == "" {
+			continue
+		}
+		revoked, err := s.revocationStore.IsRevoked(context.Background(), id)
+		if err != nil {
+			return fmt.Errorf("failed to check token revocation: %w", err)
+		}
+		if revoked {
+			return ErrTokenRevoked
+		}
+	}
+	return nil
+}
+
+// checkRefreshTokenReuse rejects revoked refresh tokens and refresh tokens
+// that were already exchanged. Presenting an exchanged refresh token indicates
+// it may have been stolen, so the whole token family, including access tokens
+// issued from it, is revoked. A token revoked with RevokeToken is only
+// rejected; it does not revoke its family.
+func (s *Service) checkRefreshTokenReuse(tokenID, familyID string) error {
+	if err := s.checkRevoked(tokenID, familyID); err != nil {
+		return err
+	}
+	if tokenID == "" {
+		return nil
+	}
+
+	used, err := s.revocationStore.IsRevoked(context.Background(), usedRefreshTokenPrefix+tokenID)
+	if err != nil {
+		return fmt.Errorf("failed to check token revocation: %w", err)
+	}
+	if !used {
+		return nil
+	}
+
+	if familyID != "" {
+		if err := s.RevokeToken(familyID); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("%w: %w", ErrRefreshTokenReused, ErrTokenRevoked)
+}
+
+// HashPassword hashes a password using the configured password hasher
 func (s *Service) HashPassword(password string) (string, error) {
-	cost := s.config.Password.BcryptCost
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	hash, err := s.passwordHasher.Hash(password)
 	if err != nil {
-		return "", fmt.Errorf("failed to hash password: %w", err)
+		return "", fmt.Errorf("%s hasher: %w", s.passwordHasher.Name(), err)
 	}
-	return string(hash), nil
+	return hash, nil
 }
 
-// VerifyPassword verifies a password against its hash
+// VerifyPassword verifies a password against its hash.
+// The algorithm is detected from the hash prefix, so hashes created before
+// switching algorithms keep verifying.
 func (s *Service) VerifyPassword(hashedPassword, password string) error {
-	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
-	if err != nil {
+	hasher := s.hasherFor(hashedPassword)
+	if hasher == nil {
 		return ErrInvalidCredentials
 	}
+	if err := hasher.Verify(hashedPassword, password); err != nil {
+		return fmt.Errorf("%s hasher: %w", hasher.Name(), err)
+	}
 	return nil
 }
 
+// VerifyPasswordAndRehash verifies a password and, when the stored hash uses a
+// different algorithm or weaker parameters than currently configured, returns
+// a new hash to persist in its place. An empty string means the stored hash is
+// current. Call it on login to migrate users to the configured algorithm.
+func (s *Service) VerifyPasswordAndRehash(hashedPassword, password string) (string, error) {
+	if err := s.VerifyPassword(hashedPassword, password); err != nil {
+		return "", err
+	}
+	if s.passwordHasher.Recognizes(hashedPassword) && !s.passwordHasher.NeedsRehash(hashedPassword) {
+		return "", nil
+	}
+	return s.HashPassword(password)
+}
+
 // ValidatePasswordStrength validates password against configured requirements
 func (s *Service) ValidatePasswordStrength(password string) error {
 	if len(password) < s.config.Password.MinLength {
@@ -269,6 +269,124 @@ func TestService_RefreshToken(t *testing.T) {
 	assert.Equal(t, user.Permissions, claims.Permissions)
 }
 
+func newRevocationTestService(t *testing.T) (*Service, *User) {
+	t.Helper()
+	config := &Config{
+		JWT: JWTConfig{
+			Secret:            "test-secret",
+			Expiration:        1 * time.Hour,
+			RefreshExpiration: 24 * time.Hour,
+		},
+	}
+
+	userStore := NewMemoryUserStore()
+	service := NewService(config, userStore, NewMemorySessionStore())
+
+	user := &User{
+		ID:     "test-user-123",
+		Email:  "test@example.com",
+		Active: true,
+	}
+	require.NoError(t, userStore.CreateUser(context.Background(), user))
+	return service, user
+}
+
+func TestService_RevokeToken(t *testing.T) {
+	service, user := newRevocationTestService(t)
+
+	tokenPair, err := service.GenerateToken(user.ID, nil)
+	require.NoError(t, err)
+
+	claims, err := service.ValidateToken(tokenPair.AccessToken)
+	require.NoError(t, err)
+	require.NotEmpty(t, claims.TokenID)
+	assert.NotContains(t, claims.Custom, "jti")
+
+	require.NoError(t, service.RevokeToken(claims.TokenID))
+
+	_, err = service.ValidateToken(tokenPair.AccessToken)
+	require.ErrorIs(t, err, ErrTokenRevoked)
+
+	// Other tokens are unaffected
+	otherPair, err := service.GenerateToken(user.ID, nil)
+	require.NoError(t, err)
+	_, err = service.ValidateToken(otherPair.AccessToken)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, service.RevokeToken(""), ErrTokenIDRequired)
+}
+
+func TestService_RefreshTokenRotation(t *testing.T) {
+	service, user := newRevocationTestService(t)
+
+	tokenPair, err := service.GenerateToken(user.ID, nil)
+	require.NoError(t, err)
+
+	rotated, err := service.RefreshToken(tokenPair.RefreshToken)
+	require.NoError(t, err)
+
+	// The rotated refresh token works once more
+	rotatedAgain, err := service.RefreshToken(rotated.RefreshToken)
+	require.NoError(t, err)
+
+	_, err = service.ValidateToken(rotatedAgain.AccessToken)
+	require.NoError(t, err)
+}
+
+func TestService_RefreshTokenReuseDetection(t *testing.T) {
+	service, user := newRevocationTestService(t)
+
+	tokenPair, err := service.GenerateToken(user.ID, nil)
+	require.NoError(t, err)
+
+	rotated, err := service.RefreshToken(tokenPair.RefreshToken)
+	require.NoError(t, err)
+
+	// Replaying the already-exchanged refresh token is treated as theft
+	_, err = service.RefreshToken(tokenPair.RefreshToken)
+	require.ErrorIs(t, err, ErrRefreshTokenReused)
+	require.ErrorIs(t, err, ErrTokenRevoked)
+
+	// The whole family is revoked, including tokens issued after the reuse
+	_, err = service.RefreshToken(rotated.RefreshToken)
+	require.ErrorIs(t, err, ErrTokenRevoked)
+	_, err = service.ValidateToken(rotated.AccessToken)
+	require.ErrorIs(t, err, ErrTokenRevoked)
+	_, err = service.ValidateToken(tokenPair.AccessToken)
+	require.ErrorIs(t, err, ErrTokenRevoked)
+
+	// Tokens from an unrelated login keep working
+	otherPair, err := service.GenerateToken(user.ID, nil)
+	require.NoError(t, err)
+	_, err = service.RefreshToken(otherPair.RefreshToken)
+	require.NoError(t, err)
+}
+
+func TestService_RevokeRefreshTokenIsNotReuse(t *testing.T) {
+	service, user := newRevocationTestService(t)
+
+	tokenPair, err := service.GenerateToken(user.ID, nil)
+	require.NoError(t, err)
+	rotated, err := service.RefreshToken(tokenPair.RefreshToken)
+	require.NoError(t, err)
+
+	refreshClaims := jwt.MapClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(rotated.RefreshToken, refreshClaims)
+	require.NoError(t, err)
+	refreshID, _ := refreshClaims["jti"].(string)
+	require.NotEmpty(t, refreshID)
+
+	// An explicitly revoked refresh token is rejected without being treated
+	// as reuse, so the rest of its family keeps working
+	require.NoError(t, service.RevokeToken(refreshID))
+	_, err = service.RefreshToken(rotated.RefreshToken)
+	require.ErrorIs(t, err, ErrTokenRevoked)
+	require.NotErrorIs(t, err, ErrRefreshTokenReused)
+
+	_, err = service.ValidateToken(rotated.AccessToken)
+	require.NoError(t, err)
+}
+
 func TestService_HashPassword(t *testing.T) {
 	config := &Config{
 		Password: PasswordConfig{
@@ -156,3 +156,51 @@ func (s *MemorySessionStore) Cleanup(ctx context.Context) error {
 
 	return nil
 }
+
+// MemoryRevocationStore implements RevocationStore interface using in-memory storage
+type MemoryRevocationStore struct {
+	revoked map[string]time.Time
+	mutex   sync.RWMutex
+}
+
+// NewMemoryRevocationStore creates a new in-memory revocation store
+func NewMemoryRevocationStore() *MemoryRevocationStore {
+	return &MemoryRevocationStore{
+		revoked: make(map[string]time.Time),
+	}
+}
+
+// Revoke marks a token ID as revoked until expiresAt
+func (s *MemoryRevocationStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if existing, exists := s.revoked[tokenID]; !exists || expiresAt.After(existing) {
+		s.revoked[tokenID] = expiresAt
+	}
+	return nil
+}
+
+// IsRevoked reports whether a token ID has been revoked
+func (s *MemoryRevocationStore) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	_, exists := s.revoked[tokenID]
+	return exists, nil
+}
+
+// Cleanup removes revocations whose tokens have expired
+func (s *MemoryRevocationStore) Cleanup(ctx context.Context) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	for id, expiresAt := range s.revoked {
+		if now.After(expiresAt) {
+			delete(s.revoked, id)
+		}
+	}
+
+	return nil
+}
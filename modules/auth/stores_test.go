@@ -189,3 +189,30 @@ func TestMemorySessionStore(t *testing.T) {
 	_, err = store.Get(ctx, validSession.ID)
 	assert.NoError(t, err, "Valid session should remain")
 }
+
+func TestMemoryRevocationStore(t *testing.T) {
+	store := NewMemoryRevocationStore()
+	ctx := context.Background()
+
+	revoked, err := store.IsRevoked(ctx, "token-1")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	require.NoError(t, store.Revoke(ctx, "token-1", time.Now().Add(time.Hour)))
+	require.NoError(t, store.Revoke(ctx, "expired-token", time.Now().Add(-time.Hour)))
+
+	revoked, err = store.IsRevoked(ctx, "token-1")
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	// Cleanup only drops revocations whose tokens have expired
+	require.NoError(t, store.Cleanup(ctx))
+
+	revoked, err = store.IsRevoked(ctx, "token-1")
+	require.NoError(t, err)
+	assert.True(t, revoked, "Unexpired revocation should remain")
+
+	revoked, err = store.IsRevoked(ctx, "expired-token")
+	require.NoError(t, err)
+	assert.False(t, revoked, "Expired revocation should be removed")
+}
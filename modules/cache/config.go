@@ -29,6 +29,7 @@ import (
 //	CACHE_ENGINE=memory
 //	CACHE_DEFAULT_TTL=300
 //	CACHE_MAX_ITEMS=10000
+//	CACHE_SESSIONS_REDIS_URL=redis://sessions:6379
 type CacheConfig struct {
 	// Engine specifies the cache engine to use.
 	// Supported values: "memory", "redis"
@@ -64,8 +65,70 @@ type CacheConfig struct {
 	// Must be non-negative.
 	RedisDB int `json:"redisDB" yaml:"redisDB" env:"REDIS_DB" validate:"min=0"`
 
+	// KeyPrefix namespaces the keys stored in Redis, e.g. "myapp:".
+	// It is prepended transparently to every key, so callers keep using bare
+	// keys and GetMulti returns bare keys. Clear only removes keys under this
+	// prefix, so applications sharing a Redis database neither collide nor
+	// wipe each other's entries; without a prefix the Redis engine's Clear
+	// returns ErrClearRequiresKeyPrefix. The memory engine is private to each module
+	// instance and therefore needs no prefix.
+	KeyPrefix string `json:"keyPrefix" yaml:"keyPrefix" env:"KEY_PREFIX"`
+
+	// TenantIsolation namespaces keys by tenant when the context of a cache
+	// operation carries a tenant ID (see modular.NewTenantContext), so that
+	// tenants setting the same key never see each other's entries and Clear
+	// only removes the calling tenant's entries. Operations without a tenant
+	// in context use a separate global namespace, and Clear without a tenant
+	// only removes the global entries.
+	// Default: false
+	TenantIsolation bool `json:"tenantIsolation" yaml:"tenantIsolation" env:"TENANT_ISOLATION"`
+
 	// ConnectionMaxAge is the maximum age of a connection.
 	// Connections older than this will be closed and recreated.
 	// Helps prevent connection staleness in long-running applications.
 	ConnectionMaxAge time.Duration `json:"connectionMaxAge" yaml:"connectionMaxAge" env:"CONNECTION_MAX_AGE" default:"3600s"`
+
+	// Instances declares additional named caches, each with its own engine
+	// and connection settings, e.g. separate Redis caches for sessions and
+	// rate limits. Every instance setting can be supplied through environment
+	// variables prefixed with CACHE_<INSTANCE>_, e.g. CACHE_SESSIONS_REDIS_URL.
+	// Engine, DefaultTTL, CleanupInterval, MaxItems and ConnectionMaxAge fall
+	// back to the top-level values when left unset.
+	Instances map[string]*CacheConfig `json:"instances,omitempty" yaml:"instances,omitempty"`
+}
+
+// GetInstanceConfigs returns the named cache instances for instance-aware
+// configuration. The returned values point at the configured instances so
+// that environment variables can be fed into them.
+func (c *CacheConfig) GetInstanceConfigs() map[string]interface{} {
+	instances := make(map[string]interface{}, len(c.Instances))
+	for name, instance := range c.Instances {
+		if instance != nil {
+			instances[name] = instance
+		}
+	}
+	return instances
+}
+
+// instanceConfig returns a copy of an instance's configuration with unset
+// engine settings inherited from c.
+func (c *CacheConfig) instanceConfig(instance *CacheConfig) *CacheConfig {
+	cfg := *instance
+	cfg.Instances = nil
+	if cfg.Engine == "" {
+		cfg.Engine = c.Engine
+	}
+	if cfg.DefaultTTL == 0 {
+		cfg.DefaultTTL = c.DefaultTTL
+	}
+	if cfg.CleanupInterval == 0 {
+		cfg.CleanupInterval = c.CleanupInterval
+	}
+	if cfg.MaxItems == 0 {
+		cfg.MaxItems = c.MaxItems
+	}
+	if cfg.ConnectionMaxAge == 0 {
+		cfg.ConnectionMaxAge = c.ConnectionMaxAge
+	}
+	return &cfg
 }
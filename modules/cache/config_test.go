@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCacheConfig_GetInstanceConfigs_ReturnsOriginalPointers verifies that instance-aware
+// feeding modifies the configured instances rather than copies of them.
+func TestCacheConfig_GetInstanceConfigs_ReturnsOriginalPointers(t *testing.T) {
+	t.Parallel()
+	config := &CacheConfig{
+		Instances: map[string]*CacheConfig{
+			"sessions":  {Engine: "redis", RedisURL: "redis://localhost:6379"},
+			"ratelimit": {Engine: "memory"},
+			"disabled":  nil,
+		},
+	}
+
+	instances := config.GetInstanceConfigs()
+	require.Len(t, instances, 2, "nil instances are skipped")
+
+	sessions, ok := instances["sessions"].(*CacheConfig)
+	require.True(t, ok, "expected *CacheConfig, got %T", instances["sessions"])
+	assert.Same(t, config.Instances["sessions"], sessions)
+
+	sessions.RedisURL = "redis://sessions:6379"
+	assert.Equal(t, "redis://sessions:6379", config.Instances["sessions"].RedisURL)
+}
+
+// TestCacheConfig_InstanceAwareFeeding verifies that CACHE_<INSTANCE>_* environment
+// variables are fed into the matching named cache instance.
+func TestCacheConfig_InstanceAwareFeeding(t *testing.T) {
+	envVars := map[string]string{
+		"CACHE_SESSIONS_ENGINE":      "redis",
+		"CACHE_SESSIONS_REDIS_URL":   "redis://sessions:6379",
+		"CACHE_SESSIONS_REDIS_DB":    "2",
+		"CACHE_RATELIMIT_MAX_ITEMS":  "500",
+		"CACHE_RATELIMIT_KEY_PREFIX": "rl:",
+	}
+	for key, value := range envVars {
+		t.Setenv(key, value)
+	}
+
+	config := &CacheConfig{
+		Instances: map[string]*CacheConfig{
+			"sessions":  {Engine: "memory", RedisURL: "redis://localhost:6379"},
+			"ratelimit": {Engine: "memory", MaxItems: 100},
+		},
+	}
+
+	feeder := modular.NewInstanceAwareEnvFeeder(func(instanceKey string) string {
+		return "CACHE_" + instanceKey + "_"
+	})
+	for instanceKey, instanceConfig := range config.GetInstanceConfigs() {
+		require.NoError(t, feeder.FeedKey(instanceKey, instanceConfig), "feeding instance %s", instanceKey)
+	}
+
+	sessions := config.Instances["sessions"]
+	assert.Equal(t, "redis", sessions.Engine)
+	assert.Equal(t, "redis://sessions:6379", sessions.RedisURL)
+	assert.Equal(t, 2, sessions.RedisDB)
+
+	ratelimit := config.Instances["ratelimit"]
+	assert.Equal(t, "memory", ratelimit.Engine)
+	assert.Equal(t, 500, ratelimit.MaxItems)
+	assert.Equal(t, "rl:", ratelimit.KeyPrefix)
+	assert.Empty(t, ratelimit.RedisURL, "settings of one instance do not leak into another")
+}
+
+func TestCacheModule_Instances(t *testing.T) {
+	t.Parallel()
+	s := miniredis.RunT(t)
+
+	module := NewModule().(*CacheModule)
+	app := newMockApp()
+	app.RegisterConfigSection(module.Name(), modular.NewStdConfigProvider(&CacheConfig{
+		Engine:          "memory",
+		DefaultTTL:      time.Minute,
+		CleanupInterval: time.Minute,
+		MaxItems:        1000,
+		Instances: map[string]*CacheConfig{
+			"sessions":  {Engine: "redis", RedisURL: "redis://" + s.Addr(), KeyPrefix: "sessions:"},
+			"ratelimit": {MaxItems: 10},
+		},
+	}))
+	require.NoError(t, module.Init(app))
+
+	ctx := context.Background()
+	require.NoError(t, module.Start(ctx))
+	t.Cleanup(func() { _ = module.Stop(ctx) })
+
+	sessions, err := module.Instance("sessions")
+	require.NoError(t, err)
+	ratelimit, err := module.Instance("ratelimit")
+	require.NoError(t, err)
+
+	require.NoError(t, sessions.Set(ctx, "k", "session", time.Minute))
+	require.NoError(t, ratelimit.Set(ctx, "k", "ratelimit", time.Minute))
+
+	value, found := sessions.Get(ctx, "k")
+	require.True(t, found)
+	assert.Equal(t, "session", value)
+	assert.True(t, s.Exists("sessions:k"), "the sessions instance uses its own Redis engine")
+
+	value, found = ratelimit.Get(ctx, "k")
+	require.True(t, found)
+	assert.Equal(t, "ratelimit", value)
+
+	_, found = module.Get(ctx, "k")
+	assert.False(t, found, "instances do not share entries with the default cache")
+
+	assert.Equal(t, "memory", ratelimit.config.Engine, "unset settings are inherited")
+	assert.Equal(t, time.Minute, ratelimit.config.DefaultTTL)
+	assert.Equal(t, 10, ratelimit.config.MaxItems)
+
+	_, err = module.Instance("missing")
+	require.ErrorIs(t, err, ErrInstanceNotFound)
+
+	var names []string
+	for _, svc := range module.ProvidesServices() {
+		names = append(names, svc.Name)
+	}
+	assert.Equal(t, []string{ServiceName, ServiceName + ".ratelimit", ServiceName + ".sessions"}, names)
+}
@@ -68,6 +68,14 @@ type CacheEngine interface {
 	// The context can be used for operation timeouts.
 	Flush(ctx context.Context) error
 
+	// Clear removes all items belonging to this cache instance.
+	// Unlike a backend-wide flush, Clear is scoped to the cache's own key
+	// namespace: shared backends such as Redis must only remove keys under
+	// the configured KeyPrefix and leave other applications' keys intact.
+	//
+	// The context can be used for operation timeouts.
+	Clear(ctx context.Context) error
+
 	// GetMulti retrieves multiple items from the cache in a single operation.
 	// Returns a map containing only the keys that were found.
 	// Missing or expired keys are not included in the result.
@@ -102,3 +110,19 @@ type CacheEngine interface {
 	// Used by the MetricsProvider interface to collect operational metrics.
 	Stats(ctx context.Context) map[string]float64
 }
+
+// PrefixClearer is an optional interface for cache engines that can remove
+// only the items whose key starts with a prefix. The cache module requires it
+// to clear a single tenant's namespace when TenantIsolation is enabled.
+type PrefixClearer interface {
+	// ClearPrefix removes all items of this cache instance whose key starts
+	// with prefix.
+	//
+	// The context can be used for operation timeouts.
+	ClearPrefix(ctx context.Context, prefix string) error
+}
+
+var (
+	_ PrefixClearer = (*MemoryCache)(nil)
+	_ PrefixClearer = (*RedisCache)(nil)
+)
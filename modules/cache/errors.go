@@ -18,6 +18,23 @@ var (
 	// ErrNotConnected is returned when an operation is attempted on a cache that is not connected
 	ErrNotConnected = errors.New("cache not connected")
 
+	// ErrNilLoader is returned by GetOrLoad when no loader function is provided
+	ErrNilLoader = errors.New("cache loader function is nil")
+
+	// ErrLoaderPanicked is returned by GetOrLoad when the loader function panics
+	ErrLoaderPanicked = errors.New("cache loader panicked")
+
+	// ErrInstanceNotFound is returned when a named cache instance is not configured
+	ErrInstanceNotFound = errors.New("cache instance not found")
+
+	// ErrPrefixClearNotSupported is returned by Clear when tenant isolation is enabled and the
+	// cache engine cannot clear a single namespace
+	ErrPrefixClearNotSupported = errors.New("cache engine does not support clearing by prefix")
+
+	// ErrClearRequiresKeyPrefix is returned by the Redis engine's Clear when no KeyPrefix
+	// is configured, since every key in the database would match
+	ErrClearRequiresKeyPrefix = errors.New("clearing a Redis cache requires a keyPrefix")
+
 	// ErrNoSubjectForEventEmission is returned when trying to emit events without a subject
 	ErrNoSubjectForEventEmission = errors.New("no subject available for event emission")
 )
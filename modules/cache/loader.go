@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// LoaderFunc loads the value for a key that is missing from the cache.
+type LoaderFunc func(ctx context.Context) (interface{}, error)
+
+// loadCall is an in-flight loader invocation shared by concurrent callers.
+type loadCall struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// loadGroup deduplicates concurrent loads of the same key.
+type loadGroup struct {
+	mu    sync.Mutex
+	calls map[string]*loadCall
+}
+
+// join returns the in-flight call for key and whether the caller is the leader
+// responsible for running the loader.
+func (g *loadGroup) join(key string) (*loadCall, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if call, ok := g.calls[key]; ok {
+		return call, false
+	}
+	if g.calls == nil {
+		g.calls = make(map[string]*loadCall)
+	}
+	call := &loadCall{done: make(chan struct{})}
+	g.calls[key] = call
+	return call, true
+}
+
+// finish publishes the result of call to all waiters and forgets it.
+func (g *loadGroup) finish(key string, call *loadCall) {
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+	close(call.done)
+}
+
+// GetOrLoad returns the cached value for key, or calls loader to produce it.
+// Concurrent misses for the same key are deduplicated: the loader runs once
+// and every caller receives its result, preventing a cache stampede on the
+// backing store. A successful result is stored with ttl (the default TTL when
+// ttl is 0). Loader errors are returned to all waiting callers and are never
+// cached, so the next call retries the load.
+//
+// The loader runs with the context of the caller that started the load. Other
+// callers stop waiting when their own context is done.
+//
+// Example:
+//
+//	user, err := cache.GetOrLoad(ctx, "user:123", 5*time.Minute, func(ctx context.Context) (interface{}, error) {
+//	    return db.FindUser(ctx, 123)
+//	})
+func (m *CacheModule) GetOrLoad(ctx context.Context, key string, ttl time.Duration, loader LoaderFunc) (interface{}, error) {
+	if loader == nil {
+		return nil, ErrNilLoader
+	}
+
+	if value, found := m.Get(ctx, key); found {
+		return value, nil
+	}
+
+	// Loads are keyed like the cache entry so tenants never share a load
+	engineKey := m.tenantPrefix(ctx) + key
+	call, leader := m.loads.join(engineKey)
+	if !leader {
+		select {
+		case <-call.done:
+			return call.value, call.err
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for cache load of key %s: %w", key, ctx.Err())
+		}
+	}
+
+	// Another load may have completed between the miss above and join
+	if value, found := m.cacheEngine.Get(ctx, engineKey); found {
+		call.value = value
+		m.loads.finish(engineKey, call)
+		return value, nil
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				call.err = fmt.Errorf("%w: key %s: %v", ErrLoaderPanicked, key, r)
+			}
+		}()
+		call.value, call.err = loader(ctx)
+	}()
+
+	if call.err != nil {
+		call.value = nil
+		call.err = fmt.Errorf("failed to load cache item: %w", call.err)
+	} else if err := m.Set(ctx, key, call.value, ttl); err != nil {
+		// The loaded value is still valid; only caching it failed
+		m.logger.Warn("Failed to cache loaded value", "key", key, "error", err)
+	}
+	m.loads.finish(engineKey, call)
+	return call.value, call.err
+}
@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheModule_GetOrLoad(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ConcurrentMissesLoadOnce", func(t *testing.T) {
+		t.Parallel()
+		module, ctx := newTestCacheModule(t)
+
+		var loads atomic.Int32
+		loader := func(ctx context.Context) (interface{}, error) {
+			loads.Add(1)
+			time.Sleep(50 * time.Millisecond)
+			return "loaded", nil
+		}
+
+		const callers = 50
+		start := make(chan struct{})
+		var wg sync.WaitGroup
+		results := make([]interface{}, callers)
+		errs := make([]error, callers)
+		for i := range callers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				results[i], errs[i] = module.GetOrLoad(ctx, "cold", time.Minute, loader)
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), loads.Load(), "loader must run exactly once")
+		for i := range callers {
+			require.NoError(t, errs[i])
+			assert.Equal(t, "loaded", results[i])
+		}
+
+		value, found := module.Get(ctx, "cold")
+		require.True(t, found)
+		assert.Equal(t, "loaded", value)
+	})
+
+	t.Run("ErrorsPropagateAndAreNotCached", func(t *testing.T) {
+		t.Parallel()
+		module, ctx := newTestCacheModule(t)
+
+		errBackend := errors.New("backend down")
+		release := make(chan struct{})
+		var loads atomic.Int32
+		failing := func(ctx context.Context) (interface{}, error) {
+			loads.Add(1)
+			<-release
+			return nil, errBackend
+		}
+
+		const callers = 5
+		errs := make(chan error, callers)
+		for range callers {
+			go func() {
+				_, err := module.GetOrLoad(ctx, "flaky", time.Minute, failing)
+				errs <- err
+			}()
+		}
+		// Give the callers time to join the in-flight load before it fails
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		for range callers {
+			require.ErrorIs(t, <-errs, errBackend)
+		}
+		assert.Equal(t, int32(1), loads.Load())
+
+		_, found := module.Get(ctx, "flaky")
+		assert.False(t, found, "errors must not be cached")
+
+		value, err := module.GetOrLoad(ctx, "flaky", time.Minute, func(ctx context.Context) (interface{}, error) {
+			return "recovered", nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "recovered", value)
+	})
+
+	t.Run("HitSkipsLoader", func(t *testing.T) {
+		t.Parallel()
+		module, ctx := newTestCacheModule(t)
+		require.NoError(t, module.Set(ctx, "warm", "cached", time.Minute))
+
+		value, err := module.GetOrLoad(ctx, "warm", time.Minute, func(ctx context.Context) (interface{}, error) {
+			t.Error("loader must not run on a hit")
+			return nil, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "cached", value)
+	})
+
+	t.Run("NilLoader", func(t *testing.T) {
+		t.Parallel()
+		module, ctx := newTestCacheModule(t)
+		_, err := module.GetOrLoad(ctx, "key", time.Minute, nil)
+		require.ErrorIs(t, err, ErrNilLoader)
+	})
+
+	t.Run("LoaderPanic", func(t *testing.T) {
+		t.Parallel()
+		module, ctx := newTestCacheModule(t)
+		_, err := module.GetOrLoad(ctx, "key", time.Minute, func(context.Context) (interface{}, error) {
+			panic("boom")
+		})
+		require.ErrorIs(t, err, ErrLoaderPanicked)
+		assert.Contains(t, err.Error(), "boom")
+	})
+}
@@ -3,14 +3,16 @@ package cache
 import (
 	"context"
 	"log/slog"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/GoCodeAlone/modular"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 )
 
-// MemoryCache implements CacheEngine using in-memory storage
+// MemoryCache implements CacheEngine and PrefixClearer using in-memory storage
 type MemoryCache struct {
 	config       *CacheConfig
 	items        map[string]cacheItem
@@ -19,11 +21,14 @@ type MemoryCache struct {
 	cancelFunc   context.CancelFunc
 	eventEmitter func(ctx context.Context, event cloudevents.Event) // Callback for emitting events
 	lastCleanup  time.Time                                          // Tracks when cleanup was last run
+	evictions    atomic.Uint64                                      // Items rejected because the cache was full
+	memoryBytes  int64                                              // Approximate size of all items, guarded by mutex
 }
 
 type cacheItem struct {
 	value      interface{}
 	expiration time.Time
+	size       int64 // Approximate size of key and value, see approximateSize
 }
 
 // NewMemoryCache creates a new memory cache engine
@@ -109,9 +114,11 @@ func (c *MemoryCache) Get(ctx context.Context, key string) (interface{}, bool) {
 	}
 
 	// Check if the item has expired
-	if !item.expiration.IsZero() && time.Now().After(item.expiration) {
+	if item.expired(time.Now()) {
 		c.mutex.Lock()
-		delete(c.items, key)
+		if current, ok := c.items[key]; ok && current.expired(time.Now()) {
+			c.removeLocked(key)
+		}
 		c.mutex.Unlock()
 		return nil, false
 	}
@@ -131,6 +138,7 @@ func (c *MemoryCache) Set(ctx context.Context, key string, value interface{}, tt
 	if c.config.MaxItems > 0 && len(c.items) >= c.config.MaxItems {
 		_, exists := c.items[key]
 		if !exists {
+			c.evictions.Add(1)
 			// Cache is full and this is a new key, emit eviction event
 			if c.eventEmitter != nil {
 				event := modular.NewCloudEvent(EventTypeCacheEvicted, "cache-service", map[string]interface{}{
@@ -150,10 +158,14 @@ func (c *MemoryCache) Set(ctx context.Context, key string, value interface{}, tt
 		exp = time.Now().Add(ttl)
 	}
 
-	c.items[key] = cacheItem{
+	c.removeLocked(key)
+	item := cacheItem{
 		value:      value,
 		expiration: exp,
+		size:       int64(len(key)) + approximateSize(value) + cacheItemOverhead,
 	}
+	c.items[key] = item
+	c.memoryBytes += item.size
 
 	return nil
 }
@@ -163,16 +175,67 @@ func (c *MemoryCache) Delete(_ context.Context, key string) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	delete(c.items, key)
+	c.removeLocked(key)
 	return nil
 }
 
+// DeleteCount removes keys from the cache and returns how many of them held
+// an unexpired item, implementing removalCounter
+func (c *MemoryCache) DeleteCount(_ context.Context, keys []string) (int64, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	var removed int64
+	for _, key := range keys {
+		if item, ok := c.items[key]; ok {
+			if !item.expired(now) {
+				removed++
+			}
+			c.removeLocked(key)
+		}
+	}
+	return removed, nil
+}
+
+// removeLocked deletes key and its size from the cache; c.mutex must be held
+func (c *MemoryCache) removeLocked(key string) {
+	if item, ok := c.items[key]; ok {
+		c.memoryBytes -= item.size
+		delete(c.items, key)
+	}
+}
+
+// expired reports whether the item's TTL has passed at now
+func (i cacheItem) expired(now time.Time) bool {
+	return !i.expiration.IsZero() && now.After(i.expiration)
+}
+
 // Flush removes all items from the cache
-func (c *MemoryCache) Flush(_ context.Context) error {
+func (c *MemoryCache) Flush(ctx context.Context) error {
+	return c.Clear(ctx)
+}
+
+// Clear removes all items from the cache by resetting the item map
+func (c *MemoryCache) Clear(_ context.Context) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
 	c.items = make(map[string]cacheItem)
+	c.memoryBytes = 0
+	return nil
+}
+
+// ClearPrefix removes all items whose key starts with prefix
+func (c *MemoryCache) ClearPrefix(_ context.Context, prefix string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for key := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			c.removeLocked(key)
+		}
+	}
 	return nil
 }
 
@@ -210,15 +273,26 @@ func (c *MemoryCache) DeleteMulti(ctx context.Context, keys []string) error {
 // Stats returns memory cache metrics.
 func (c *MemoryCache) Stats(_ context.Context) map[string]float64 {
 	c.mutex.RLock()
-	count := float64(len(c.items))
 	maxItems := float64(c.config.MaxItems)
 	c.mutex.RUnlock()
+	count, memoryBytes := c.usage()
 	return map[string]float64{
-		"item_count": count,
-		"max_items":  maxItems,
+		"item_count":   float64(count),
+		"max_items":    maxItems,
+		"evictions":    float64(c.evictions.Load()),
+		"memory_bytes": float64(memoryBytes),
 	}
 }
 
+// usage returns the number of cached items and their approximate size in
+// bytes. Sizes are tracked as items are stored and removed, so this is cheap
+// enough to call on every stats or health check.
+func (c *MemoryCache) usage() (int64, int64) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return int64(len(c.items)), c.memoryBytes
+}
+
 // startCleanupTimer starts the cleanup timer for expired items
 func (c *MemoryCache) startCleanupTimer(ctx context.Context) {
 	// Run cleanup immediately on start
@@ -261,9 +335,9 @@ func (c *MemoryCache) cleanupExpiredItems(ctx context.Context) {
 	expiredKeys := make([]string, 0)
 
 	for key, item := range c.items {
-		if !item.expiration.IsZero() && now.After(item.expiration) {
+		if item.expired(now) {
 			expiredKeys = append(expiredKeys, key)
-			delete(c.items, key)
+			c.removeLocked(key)
 		}
 	}
 
@@ -66,6 +66,7 @@ package cache
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -111,6 +112,13 @@ type CacheModule struct {
 	// EmitEvent (read) when asynchronous emissions occur before observer registration completes.
 	subject   modular.Subject
 	subjectMu sync.RWMutex
+	counters  cacheCounters
+	loads     loadGroup
+
+	// instances holds the named caches declared in CacheConfig.Instances.
+	// They share the parent's event subject through parent.
+	instances map[string]*CacheModule
+	parent    *CacheModule
 }
 
 // NewModule creates a new instance of the cache module.
@@ -152,9 +160,13 @@ func (m *CacheModule) RegisterConfig(app modular.Application) error {
 		return nil
 	}
 
-	// Register empty config - defaults come from struct tags
+	// Register empty config - defaults come from struct tags. Named instances
+	// are fed from CACHE_<INSTANCE>_* environment variables.
 	m.config = &CacheConfig{}
-	app.RegisterConfigSection(m.Name(), modular.NewStdConfigProvider(m.config))
+	instancePrefixFunc := func(instanceKey string) string {
+		return "CACHE_" + instanceKey + "_"
+	}
+	app.RegisterConfigSection(m.Name(), modular.NewInstanceAwareConfigProvider(m.config, instancePrefixFunc))
 	return nil
 }
 
@@ -182,7 +194,29 @@ func (m *CacheModule) Init(app modular.Application) error {
 	m.config = cfg.GetConfig().(*CacheConfig)
 	m.logger = app.Logger()
 
-	// Initialize the appropriate cache engine based on configuration
+	m.initEngine()
+
+	m.instances = make(map[string]*CacheModule, len(m.config.Instances))
+	for name, instanceCfg := range m.config.Instances {
+		if instanceCfg == nil {
+			continue
+		}
+		instance := &CacheModule{
+			name:   m.name + "." + name,
+			config: m.config.instanceConfig(instanceCfg),
+			logger: m.logger,
+			parent: m,
+		}
+		instance.initEngine()
+		m.instances[name] = instance
+	}
+
+	m.logger.Info("Cache module initialized", "instances", len(m.instances))
+	return nil
+}
+
+// initEngine creates the cache engine selected by the module's configuration.
+func (m *CacheModule) initEngine() {
 	switch m.config.Engine {
 	case "memory":
 		memCache := NewMemoryCache(m.config)
@@ -193,10 +227,10 @@ func (m *CacheModule) Init(app modular.Application) error {
 			}
 		})
 		m.cacheEngine = memCache
-		m.logger.Info("Initialized memory cache engine", "maxItems", m.config.MaxItems)
+		m.logger.Info("Initialized memory cache engine", "cache", m.name, "maxItems", m.config.MaxItems)
 	case "redis":
 		m.cacheEngine = NewRedisCache(m.config)
-		m.logger.Info("Initialized Redis cache engine", "url", m.config.RedisURL)
+		m.logger.Info("Initialized Redis cache engine", "cache", m.name, "url", m.config.RedisURL)
 	default:
 		memCache := NewMemoryCache(m.config)
 		// Provide event emission callback to memory cache for fallback case too
@@ -206,11 +240,37 @@ func (m *CacheModule) Init(app modular.Application) error {
 			}
 		})
 		m.cacheEngine = memCache
-		m.logger.Warn("Unknown cache engine specified, using memory cache", "specified", m.config.Engine)
+		m.logger.Warn("Unknown cache engine specified, using memory cache", "cache", m.name, "specified", m.config.Engine)
 	}
+}
 
-	m.logger.Info("Cache module initialized")
-	return nil
+// Instance returns the named cache declared in CacheConfig.Instances. The
+// returned cache supports the same operations as the module itself, but
+// stores its entries in the instance's own engine.
+//
+// Example:
+//
+//	sessions, err := cacheModule.Instance("sessions")
+//	if err != nil {
+//	    return err
+//	}
+//	err = sessions.Set(ctx, "session:abc", session, 30*time.Minute)
+func (m *CacheModule) Instance(name string) (*CacheModule, error) {
+	instance, ok := m.instances[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInstanceNotFound, name)
+	}
+	return instance, nil
+}
+
+// instanceNames returns the names of the configured instances in sorted order.
+func (m *CacheModule) instanceNames() []string {
+	names := make([]string, 0, len(m.instances))
+	for name := range m.instances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 // Start performs startup logic for the module.
@@ -244,6 +304,12 @@ func (m *CacheModule) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to connect cache engine: %w", err)
 	}
 
+	for _, name := range m.instanceNames() {
+		if err := m.instances[name].cacheEngine.Connect(ctx); err != nil {
+			return fmt.Errorf("failed to connect cache instance '%s': %w", name, err)
+		}
+	}
+
 	// Emit cache connected event
 	event := modular.NewCloudEvent(EventTypeCacheConnected, "cache-service", map[string]interface{}{
 		"engine": m.config.Engine,
@@ -276,6 +342,11 @@ func (m *CacheModule) Stop(ctx context.Context) error {
 	if err := m.cacheEngine.Close(ctx); err != nil {
 		return fmt.Errorf("failed to close cache engine: %w", err)
 	}
+	for _, name := range m.instanceNames() {
+		if err := m.instances[name].cacheEngine.Close(ctx); err != nil {
+			return fmt.Errorf("failed to close cache instance '%s': %w", name, err)
+		}
+	}
 
 	// Emit cache disconnected event
 	event := modular.NewCloudEvent(EventTypeCacheDisconnected, "cache-service", map[string]interface{}{
@@ -307,14 +378,23 @@ func (m *CacheModule) Dependencies() []string {
 //
 // Provided services:
 //   - "cache.provider": The main cache service interface
+//   - "cache.provider.<instance>": Each named cache instance
 func (m *CacheModule) ProvidesServices() []modular.ServiceProvider {
-	return []modular.ServiceProvider{
+	services := []modular.ServiceProvider{
 		{
 			Name:        ServiceName,
 			Description: "Cache service for storing and retrieving data",
 			Instance:    m,
 		},
 	}
+	for _, name := range m.instanceNames() {
+		services = append(services, modular.ServiceProvider{
+			Name:        ServiceName + "." + name,
+			Description: fmt.Sprintf("Cache service for the '%s' cache instance", name),
+			Instance:    m.instances[name],
+		})
+	}
+	return services
 }
 
 // RequiresServices declares services required by this module.
@@ -344,7 +424,12 @@ func (m *CacheModule) Constructor() modular.ModuleConstructor {
 //	    // process user data
 //	}
 func (m *CacheModule) Get(ctx context.Context, key string) (interface{}, bool) {
-	value, found := m.cacheEngine.Get(ctx, key)
+	value, found := m.cacheEngine.Get(ctx, m.tenantPrefix(ctx)+key)
+	if found {
+		m.counters.hits.Add(1)
+	} else {
+		m.counters.misses.Add(1)
+	}
 
 	// Emit cache get event (independent of hit/miss) for observability of read attempts
 	getEvent := modular.NewCloudEvent(EventTypeCacheGet, "cache-service", map[string]interface{}{
@@ -408,9 +493,10 @@ func (m *CacheModule) Set(ctx context.Context, key string, value interface{}, tt
 		m.configMu.RUnlock()
 	}
 
-	if err := m.cacheEngine.Set(ctx, key, value, ttl); err != nil {
+	if err := m.cacheEngine.Set(ctx, m.tenantPrefix(ctx)+key, value, ttl); err != nil {
 		return fmt.Errorf("failed to set cache item: %w", err)
 	}
+	m.counters.sets.Add(1)
 
 	// Emit cache set event
 	event := modular.NewCloudEvent(EventTypeCacheSet, "cache-service", map[string]interface{}{
@@ -444,9 +530,11 @@ func (m *CacheModule) Set(ctx context.Context, key string, value interface{}, tt
 //	    // handle deletion error
 //	}
 func (m *CacheModule) Delete(ctx context.Context, key string) error {
-	if err := m.cacheEngine.Delete(ctx, key); err != nil {
+	removed, err := m.deleteKeys(ctx, []string{m.tenantPrefix(ctx) + key})
+	if err != nil {
 		return fmt.Errorf("failed to delete cache item: %w", err)
 	}
+	m.counters.deletes.Add(removed)
 
 	// Emit cache delete event
 	event := modular.NewCloudEvent(EventTypeCacheDelete, "cache-service", map[string]interface{}{
@@ -504,6 +592,53 @@ func (m *CacheModule) Flush(ctx context.Context) error {
 	return nil
 }
 
+// Clear removes all items belonging to this cache instance.
+// Unlike a database-wide flush, Clear on the Redis engine only deletes keys
+// under the configured KeyPrefix, leaving other applications' keys intact.
+// With TenantIsolation enabled, only the items of the tenant in ctx are
+// removed, or the non-tenant items when ctx carries no tenant.
+//
+// Example:
+//
+//	err := cache.Clear(ctx)
+//	if err != nil {
+//	    // handle clear error
+//	}
+func (m *CacheModule) Clear(ctx context.Context) error {
+	var err error
+	if prefix := m.tenantPrefix(ctx); prefix != "" {
+		clearer, ok := m.cacheEngine.(PrefixClearer)
+		if !ok {
+			return ErrPrefixClearNotSupported
+		}
+		err = clearer.ClearPrefix(ctx, prefix)
+	} else {
+		err = m.cacheEngine.Clear(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to clear cache: %w", err)
+	}
+
+	// Emit cache flush event
+	event := modular.NewCloudEvent(EventTypeCacheFlush, "cache-service", map[string]interface{}{
+		"engine": m.config.Engine,
+	}, nil)
+
+	// Emit event in background to avoid blocking cache operations
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				m.logger.Error("observer panic", "error", r)
+			}
+		}()
+		if err := m.EmitEvent(ctx, event); err != nil {
+			m.logger.Debug("Failed to emit cache event", "error", err, "event_type", EventTypeCacheFlush)
+		}
+	}()
+
+	return nil
+}
+
 // GetMulti retrieves multiple items from the cache in a single operation.
 // Returns a map of key-value pairs for found items and an error if the operation fails.
 // Missing keys are simply not included in the result map.
@@ -519,10 +654,14 @@ func (m *CacheModule) Flush(ctx context.Context) error {
 //	    // process found values
 //	}
 func (m *CacheModule) GetMulti(ctx context.Context, keys []string) (map[string]interface{}, error) {
-	result, err := m.cacheEngine.GetMulti(ctx, keys)
+	prefix := m.tenantPrefix(ctx)
+	result, err := m.cacheEngine.GetMulti(ctx, prefixCacheKeys(prefix, keys))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get multiple cache items: %w", err)
 	}
+	result = unprefixCacheItems(prefix, result)
+	m.counters.hits.Add(uint64(len(result)))
+	m.counters.misses.Add(uint64(len(keys) - len(result)))
 
 	// Emit a single batch get event (best-effort; non-blocking)
 	batchEvent := modular.NewCloudEvent(EventTypeCacheGet, "cache-service", map[string]interface{}{
@@ -562,9 +701,10 @@ func (m *CacheModule) SetMulti(ctx context.Context, items map[string]interface{}
 		ttl = m.config.DefaultTTL
 		m.configMu.RUnlock()
 	}
-	if err := m.cacheEngine.SetMulti(ctx, items, ttl); err != nil {
+	if err := m.cacheEngine.SetMulti(ctx, prefixCacheItems(m.tenantPrefix(ctx), items), ttl); err != nil {
 		return fmt.Errorf("failed to set multiple cache items: %w", err)
 	}
+	m.counters.sets.Add(uint64(len(items)))
 	return nil
 }
 
@@ -580,9 +720,11 @@ func (m *CacheModule) SetMulti(ctx context.Context, items map[string]interface{}
 //	    // handle deletion error
 //	}
 func (m *CacheModule) DeleteMulti(ctx context.Context, keys []string) error {
-	if err := m.cacheEngine.DeleteMulti(ctx, keys); err != nil {
+	removed, err := m.deleteKeys(ctx, prefixCacheKeys(m.tenantPrefix(ctx), keys))
+	if err != nil {
 		return fmt.Errorf("failed to delete multiple cache items: %w", err)
 	}
+	m.counters.deletes.Add(removed)
 	return nil
 }
 
@@ -600,6 +742,9 @@ func (m *CacheModule) RegisterObservers(subject modular.Subject) error {
 // EmitEvent implements the ObservableModule interface.
 // This allows the cache module to emit events to registered observers.
 func (m *CacheModule) EmitEvent(ctx context.Context, event cloudevents.Event) error {
+	if m.parent != nil {
+		return m.parent.EmitEvent(ctx, event)
+	}
 	m.subjectMu.RLock()
 	subj := m.subject
 	m.subjectMu.RUnlock()
@@ -349,6 +349,9 @@ func TestRedisOperationsWithMockBehavior(t *testing.T) {
 	err = cache.Flush(ctx)
 	assert.Equal(t, ErrNotConnected, err)
 
+	err = cache.Clear(ctx)
+	assert.Equal(t, ErrNotConnected, err)
+
 	_, err = cache.GetMulti(ctx, []string{"key1", "key2"})
 	assert.Equal(t, ErrNotConnected, err)
 
@@ -576,6 +579,154 @@ func TestRedisFullOperations(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// TestRedisClearWithoutKeyPrefix verifies Clear refuses to delete anything
+// when no KeyPrefix separates the cache's keys from foreign ones
+func TestRedisClearWithoutKeyPrefix(t *testing.T) {
+	t.Parallel()
+	s := miniredis.RunT(t)
+
+	config := &CacheConfig{
+		Engine:           "redis",
+		DefaultTTL:       300 * time.Second,
+		RedisURL:         "redis://" + s.Addr(),
+		ConnectionMaxAge: 60 * time.Second,
+	}
+
+	cache := NewRedisCache(config)
+	ctx := context.Background()
+	require.NoError(t, cache.Connect(ctx))
+	defer cache.Close(ctx)
+
+	// An unprefixed key owned by another application in the same database
+	require.NoError(t, s.Set("foreign", `"other"`))
+	require.NoError(t, cache.Set(ctx, "key1", "value1", time.Minute))
+
+	require.ErrorIs(t, cache.Clear(ctx), ErrClearRequiresKeyPrefix)
+	assert.True(t, s.Exists("foreign"), "a foreign key in the same database must survive Clear")
+
+	// Namespaced clears still work without a KeyPrefix
+	require.NoError(t, cache.Set(ctx, "tenant:acme:key", "value", time.Minute))
+	require.NoError(t, cache.ClearPrefix(ctx, "tenant:acme:"))
+	assert.False(t, s.Exists("tenant:acme:key"))
+	assert.True(t, s.Exists("foreign"))
+	assert.True(t, s.Exists("key1"))
+}
+
+// TestRedisClearScopedToKeyPrefix verifies Clear removes only the cache's own keys
+func TestRedisClearScopedToKeyPrefix(t *testing.T) {
+	t.Parallel()
+	s := miniredis.RunT(t)
+
+	config := &CacheConfig{
+		Engine:           "redis",
+		DefaultTTL:       300 * time.Second,
+		RedisURL:         "redis://" + s.Addr(),
+		ConnectionMaxAge: 60 * time.Second,
+		KeyPrefix:        "app1:",
+	}
+
+	cache := NewRedisCache(config)
+	ctx := context.Background()
+	require.NoError(t, cache.Connect(ctx))
+	defer cache.Close(ctx)
+
+	// Keys owned by another application sharing the same database
+	require.NoError(t, s.Set("app2:key1", `"other"`))
+	require.NoError(t, s.Set("unprefixed", `"other"`))
+
+	require.NoError(t, cache.Set(ctx, "key1", "value1", time.Minute))
+	require.NoError(t, cache.SetMulti(ctx, map[string]interface{}{"key2": "value2", "key3": "value3"}, time.Minute))
+	assert.True(t, s.Exists("app1:key1"), "keys should be stored under the prefix")
+
+	require.NoError(t, cache.Clear(ctx))
+
+	results, err := cache.GetMulti(ctx, []string{"key1", "key2", "key3"})
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.True(t, s.Exists("app2:key1"), "other namespace must survive Clear")
+	assert.True(t, s.Exists("unprefixed"), "other namespace must survive Clear")
+}
+
+// TestRedisKeyPrefixIsolation verifies two cache modules with different key
+// prefixes sharing one Redis server cannot see each other's data
+func TestRedisKeyPrefixIsolation(t *testing.T) {
+	t.Parallel()
+	s := miniredis.RunT(t)
+	ctx := context.Background()
+
+	newPrefixedModule := func(prefix string) *CacheModule {
+		module := NewModule().(*CacheModule)
+		app := newMockApp()
+		app.RegisterConfigSection(ModuleName, modular.NewStdConfigProvider(&CacheConfig{
+			Engine:           "redis",
+			DefaultTTL:       300 * time.Second,
+			RedisURL:         "redis://" + s.Addr(),
+			ConnectionMaxAge: 60 * time.Second,
+			KeyPrefix:        prefix,
+		}))
+		require.NoError(t, module.Init(app))
+		require.NoError(t, module.Start(ctx))
+		t.Cleanup(func() { _ = module.Stop(ctx) })
+		return module
+	}
+
+	app1 := newPrefixedModule("app1:")
+	app2 := newPrefixedModule("app2:")
+
+	require.NoError(t, app1.Set(ctx, "user:1", "alice", time.Minute))
+	require.NoError(t, app2.Set(ctx, "user:1", "bob", time.Minute))
+	require.NoError(t, app1.SetMulti(ctx, map[string]interface{}{"a": "1", "b": "2"}, time.Minute))
+
+	value, found := app1.Get(ctx, "user:1")
+	require.True(t, found)
+	assert.Equal(t, "alice", value)
+	value, found = app2.Get(ctx, "user:1")
+	require.True(t, found)
+	assert.Equal(t, "bob", value)
+
+	_, found = app2.Get(ctx, "a")
+	assert.False(t, found, "app2 must not see app1's keys")
+
+	// GetMulti returns bare keys without the prefix
+	results, err := app1.GetMulti(ctx, []string{"a", "b", "user:1"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": "1", "b": "2", "user:1": "alice"}, results)
+
+	assert.True(t, s.Exists("app1:user:1"))
+	assert.True(t, s.Exists("app2:user:1"))
+	assert.False(t, s.Exists("user:1"))
+
+	// Deletes are scoped to the instance's namespace too
+	require.NoError(t, app1.DeleteMulti(ctx, []string{"a", "user:1"}))
+	_, found = app1.Get(ctx, "user:1")
+	assert.False(t, found)
+	value, found = app2.Get(ctx, "user:1")
+	require.True(t, found)
+	assert.Equal(t, "bob", value)
+
+	require.NoError(t, app2.Delete(ctx, "user:1"))
+	_, found = app1.Get(ctx, "b")
+	assert.True(t, found)
+}
+
+func TestMemoryCacheClear(t *testing.T) {
+	t.Parallel()
+	cache := NewMemoryCache(&CacheConfig{MaxItems: 100, DefaultTTL: time.Minute, CleanupInterval: time.Minute})
+	ctx := context.Background()
+
+	require.NoError(t, cache.SetMulti(ctx, map[string]interface{}{"a": 1, "b": 2}, time.Minute))
+	require.NoError(t, cache.Clear(ctx))
+
+	results, err := cache.GetMulti(ctx, []string{"a", "b"})
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	// The cache remains usable after Clear
+	require.NoError(t, cache.Set(ctx, "c", 3, time.Minute))
+	_, found := cache.Get(ctx, "c")
+	assert.True(t, found)
+}
+
 // TestRedisGetJSONUnmarshalError tests JSON unmarshaling errors in Get
 func TestRedisGetJSONUnmarshalError(t *testing.T) {
 	t.Parallel()
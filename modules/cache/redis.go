@@ -5,12 +5,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
-// RedisCache implements CacheEngine using Redis
+// redisScanBatchSize is the number of keys requested per SCAN iteration by Clear
+const redisScanBatchSize = 500
+
+// RedisCache implements CacheEngine and PrefixClearer using Redis.
+// When CacheConfig.KeyPrefix is set, every key is stored under that prefix so
+// that several applications can share one Redis database.
 type RedisCache struct {
 	config *CacheConfig
 	client *redis.Client
@@ -62,7 +68,7 @@ func (c *RedisCache) Get(ctx context.Context, key string) (interface{}, bool) {
 		return nil, false
 	}
 
-	val, err := c.client.Get(ctx, key).Result()
+	val, err := c.client.Get(ctx, c.prefixKey(key)).Result()
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
 			return nil, false
@@ -89,7 +95,7 @@ func (c *RedisCache) Set(ctx context.Context, key string, value interface{}, ttl
 		return ErrInvalidValue
 	}
 
-	if err := c.client.Set(ctx, key, data, ttl).Err(); err != nil {
+	if err := c.client.Set(ctx, c.prefixKey(key), data, ttl).Err(); err != nil {
 		return fmt.Errorf("failed to set Redis key %s: %w", key, err)
 	}
 	return nil
@@ -101,7 +107,7 @@ func (c *RedisCache) Delete(ctx context.Context, key string) error {
 		return ErrNotConnected
 	}
 
-	if err := c.client.Del(ctx, key).Err(); err != nil {
+	if err := c.client.Del(ctx, c.prefixKey(key)).Err(); err != nil {
 		return fmt.Errorf("failed to delete Redis key %s: %w", key, err)
 	}
 	return nil
@@ -119,6 +125,45 @@ func (c *RedisCache) Flush(ctx context.Context) error {
 	return nil
 }
 
+// Clear removes all items in the cache's key namespace using SCAN and DEL.
+// Keys outside the configured KeyPrefix are left untouched. Without a
+// KeyPrefix the cache cannot tell its keys from other applications' keys, so
+// Clear returns ErrClearRequiresKeyPrefix rather than deleting the database.
+func (c *RedisCache) Clear(ctx context.Context) error {
+	return c.ClearPrefix(ctx, "")
+}
+
+// ClearPrefix removes all items in the cache's key namespace whose key starts
+// with prefix, using SCAN and DEL. It returns ErrClearRequiresKeyPrefix when
+// both prefix and the configured KeyPrefix are empty.
+func (c *RedisCache) ClearPrefix(ctx context.Context, prefix string) error {
+	if c.client == nil {
+		return ErrNotConnected
+	}
+	namespace := c.prefixKey(prefix)
+	if namespace == "" {
+		return ErrClearRequiresKeyPrefix
+	}
+
+	pattern := escapeRedisPattern(namespace) + "*"
+	var cursor uint64
+	for {
+		keys, next, err := c.client.Scan(ctx, cursor, pattern, redisScanBatchSize).Result()
+		if err != nil {
+			return fmt.Errorf("failed to scan Redis keys: %w", err)
+		}
+		if len(keys) > 0 {
+			if err := c.client.Del(ctx, keys...).Err(); err != nil {
+				return fmt.Errorf("failed to delete Redis keys: %w", err)
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
+
 // GetMulti retrieves multiple items from the Redis cache
 func (c *RedisCache) GetMulti(ctx context.Context, keys []string) (map[string]interface{}, error) {
 	if len(keys) == 0 {
@@ -129,7 +174,7 @@ func (c *RedisCache) GetMulti(ctx context.Context, keys []string) (map[string]in
 		return nil, ErrNotConnected
 	}
 
-	vals, err := c.client.MGet(ctx, keys...).Result()
+	vals, err := c.client.MGet(ctx, c.prefixKeys(keys)...).Result()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get multiple Redis keys: %w", err)
 	}
@@ -165,7 +210,7 @@ func (c *RedisCache) SetMulti(ctx context.Context, items map[string]interface{},
 		if err != nil {
 			return ErrInvalidValue
 		}
-		pipe.Set(ctx, key, data, ttl)
+		pipe.Set(ctx, c.prefixKey(key), data, ttl)
 	}
 
 	_, err := pipe.Exec(ctx)
@@ -185,12 +230,60 @@ func (c *RedisCache) DeleteMulti(ctx context.Context, keys []string) error {
 		return ErrNotConnected
 	}
 
-	if err := c.client.Del(ctx, keys...).Err(); err != nil {
+	if err := c.client.Del(ctx, c.prefixKeys(keys)...).Err(); err != nil {
 		return fmt.Errorf("failed to delete multiple Redis keys: %w", err)
 	}
 	return nil
 }
 
+// DeleteCount removes keys from Redis and returns how many of them existed,
+// implementing removalCounter
+func (c *RedisCache) DeleteCount(ctx context.Context, keys []string) (int64, error) {
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	if c.client == nil {
+		return 0, ErrNotConnected
+	}
+
+	removed, err := c.client.Del(ctx, c.prefixKeys(keys)...).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete Redis keys: %w", err)
+	}
+	return removed, nil
+}
+
+// prefixKey returns key qualified with the configured key prefix
+func (c *RedisCache) prefixKey(key string) string {
+	return c.config.KeyPrefix + key
+}
+
+// prefixKeys returns keys qualified with the configured key prefix
+func (c *RedisCache) prefixKeys(keys []string) []string {
+	if c.config.KeyPrefix == "" {
+		return keys
+	}
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = c.prefixKey(key)
+	}
+	return prefixed
+}
+
+// escapeRedisPattern escapes glob metacharacters so s matches literally in SCAN MATCH
+func escapeRedisPattern(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
 // Stats returns redis cache metrics using pool statistics (no network round-trip).
 func (c *RedisCache) Stats(_ context.Context) map[string]float64 {
 	if c.client == nil {
@@ -0,0 +1,175 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+)
+
+// Compile-time interface check.
+var _ modular.HealthProvider = (*CacheModule)(nil)
+
+// CacheStats is a snapshot of cache activity counters returned by CacheModule.Stats.
+type CacheStats struct {
+	// Hits is the number of keys found by Get and GetMulti.
+	Hits uint64 `json:"hits"`
+
+	// Misses is the number of keys not found by Get and GetMulti.
+	Misses uint64 `json:"misses"`
+
+	// Sets is the number of items stored by Set and SetMulti.
+	Sets uint64 `json:"sets"`
+
+	// Deletes is the number of keys removed by Delete and DeleteMulti. Keys
+	// that did not exist are not counted, except on custom engines that do
+	// not report how many keys a delete removed.
+	Deletes uint64 `json:"deletes"`
+
+	// Evictions is the number of items the engine refused or dropped because
+	// the cache was full. Only reported by the memory engine.
+	Evictions uint64 `json:"evictions"`
+
+	// Entries is the current number of items in the cache.
+	// Only reported by the memory engine.
+	Entries int64 `json:"entries"`
+
+	// MemoryBytes is an approximation of the memory held by cached keys and
+	// values. Only reported by the memory engine.
+	MemoryBytes int64 `json:"memoryBytes"`
+}
+
+// HitRatio returns the fraction of lookups that were hits, or 0 when no
+// lookups have been made.
+func (s CacheStats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// cacheCounters holds the module-level operation counters.
+type cacheCounters struct {
+	hits    atomic.Uint64
+	misses  atomic.Uint64
+	sets    atomic.Uint64
+	deletes atomic.Uint64
+}
+
+// removalCounter is implemented by engines that can report how many of the
+// given keys a delete actually removed, so Stats counts only real deletes.
+// Both built-in engines implement it.
+type removalCounter interface {
+	DeleteCount(ctx context.Context, keys []string) (int64, error)
+}
+
+// deleteKeys removes keys from the engine and returns how many were removed.
+// Engines that do not implement removalCounter are assumed to have removed
+// every key.
+func (m *CacheModule) deleteKeys(ctx context.Context, keys []string) (uint64, error) {
+	if counter, ok := m.cacheEngine.(removalCounter); ok {
+		removed, err := counter.DeleteCount(ctx, keys)
+		return uint64(max(removed, 0)), err //nolint:wrapcheck // callers wrap the engine error
+	}
+	if len(keys) == 1 {
+		return 1, m.cacheEngine.Delete(ctx, keys[0]) //nolint:wrapcheck // callers wrap the engine error
+	}
+	return uint64(len(keys)), m.cacheEngine.DeleteMulti(ctx, keys) //nolint:wrapcheck // callers wrap the engine error
+}
+
+// Stats returns a snapshot of the cache's hit, miss, set, delete and eviction
+// counters. For the memory engine the current entry count and approximate
+// memory usage are included as well.
+//
+// Example:
+//
+//	stats := cache.Stats()
+//	fmt.Printf("hit ratio: %.2f\n", stats.HitRatio())
+func (m *CacheModule) Stats() CacheStats {
+	stats := CacheStats{
+		Hits:    m.counters.hits.Load(),
+		Misses:  m.counters.misses.Load(),
+		Sets:    m.counters.sets.Load(),
+		Deletes: m.counters.deletes.Load(),
+	}
+	if mc, ok := m.cacheEngine.(*MemoryCache); ok {
+		stats.Evictions = mc.evictions.Load()
+		stats.Entries, stats.MemoryBytes = mc.usage()
+	}
+	return stats
+}
+
+// HealthCheck implements modular.HealthProvider.
+// The report's Details map carries the current cache statistics.
+func (m *CacheModule) HealthCheck(ctx context.Context) ([]modular.HealthReport, error) {
+	report := modular.HealthReport{
+		Module:    m.name,
+		Component: "engine",
+		Status:    modular.StatusHealthy,
+		Message:   "cache is operational",
+		CheckedAt: time.Now(),
+	}
+
+	if m.cacheEngine == nil {
+		report.Status = modular.StatusUnhealthy
+		report.Message = "cache engine not initialized"
+		return []modular.HealthReport{report}, nil
+	}
+
+	m.configMu.RLock()
+	report.Component = m.config.Engine
+	m.configMu.RUnlock()
+
+	if connected, ok := m.cacheEngine.Stats(ctx)["connected"]; ok && connected == 0 {
+		report.Status = modular.StatusUnhealthy
+		report.Message = "cache engine not connected"
+	}
+
+	stats := m.Stats()
+	report.Details = map[string]any{
+		"hits":         stats.Hits,
+		"misses":       stats.Misses,
+		"sets":         stats.Sets,
+		"deletes":      stats.Deletes,
+		"evictions":    stats.Evictions,
+		"entries":      stats.Entries,
+		"memory_bytes": stats.MemoryBytes,
+		"hit_ratio":    stats.HitRatio(),
+	}
+	return []modular.HealthReport{report}, nil
+}
+
+// cacheItemOverhead approximates the per-entry bookkeeping cost of the memory
+// engine (map bucket slot, interface header and expiration timestamp).
+const cacheItemOverhead = 64
+
+// approximateSize estimates the number of bytes held by a cached value.
+// Common scalar and string types are sized directly; other values fall back to
+// the length of their JSON encoding.
+func approximateSize(value interface{}) int64 {
+	switch v := value.(type) {
+	case nil:
+		return 0
+	case string:
+		return int64(len(v))
+	case []byte:
+		return int64(len(v))
+	case bool, int8, uint8:
+		return 1
+	case int16, uint16:
+		return 2
+	case int32, uint32, float32:
+		return 4
+	case int, int64, uint, uint64, uintptr, float64, time.Duration:
+		return 8
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return 0
+		}
+		return int64(len(data))
+	}
+}
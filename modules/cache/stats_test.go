@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheModule_Stats(t *testing.T) {
+	t.Parallel()
+
+	module, ctx := newTestCacheModule(t)
+
+	require.NoError(t, module.Set(ctx, "key1", "value1", time.Minute))
+	require.NoError(t, module.SetMulti(ctx, map[string]interface{}{"key2": 2, "key3": true}, time.Minute))
+
+	_, found := module.Get(ctx, "key1")
+	require.True(t, found)
+	_, found = module.Get(ctx, "never-set")
+	require.False(t, found)
+
+	results, err := module.GetMulti(ctx, []string{"key2", "key3", "missing"})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	require.NoError(t, module.Delete(ctx, "key1"))
+
+	stats := module.Stats()
+	assert.Equal(t, uint64(3), stats.Hits)
+	assert.Equal(t, uint64(2), stats.Misses)
+	assert.Equal(t, uint64(3), stats.Sets)
+	assert.Equal(t, uint64(1), stats.Deletes)
+	assert.Zero(t, stats.Evictions)
+	assert.Equal(t, int64(2), stats.Entries)
+	assert.Positive(t, stats.MemoryBytes)
+	assert.InDelta(t, 0.6, stats.HitRatio(), 0.0001)
+}
+
+func TestCacheModule_StatsDeletesCountRemovedKeys(t *testing.T) {
+	t.Parallel()
+
+	module, ctx := newTestCacheModule(t)
+
+	require.NoError(t, module.SetMulti(ctx, map[string]interface{}{"key1": 1, "key2": 2}, time.Minute))
+	require.NoError(t, module.Delete(ctx, "missing"))
+	require.NoError(t, module.DeleteMulti(ctx, []string{"key1", "key2", "missing", "also-missing"}))
+	require.NoError(t, module.Delete(ctx, "key1"))
+
+	assert.Equal(t, uint64(2), module.Stats().Deletes)
+}
+
+func TestMemoryCache_UsageTrackedIncrementally(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	cache := NewMemoryCache(&CacheConfig{CleanupInterval: time.Minute})
+
+	require.NoError(t, cache.Set(ctx, "key", "12345", 0))
+	count, size := cache.usage()
+	assert.Equal(t, int64(1), count)
+	assert.Equal(t, int64(len("key")+5+cacheItemOverhead), size)
+
+	// Overwriting replaces the old size instead of adding to it
+	require.NoError(t, cache.Set(ctx, "key", "1234567890", 0))
+	_, size = cache.usage()
+	assert.Equal(t, int64(len("key")+10+cacheItemOverhead), size)
+
+	require.NoError(t, cache.Set(ctx, "other", "x", 0))
+	require.NoError(t, cache.Delete(ctx, "key"))
+	_, size = cache.usage()
+	assert.Equal(t, int64(len("other")+1+cacheItemOverhead), size)
+
+	require.NoError(t, cache.Clear(ctx))
+	count, size = cache.usage()
+	assert.Zero(t, count)
+	assert.Zero(t, size)
+}
+
+func TestCacheModule_StatsEvictions(t *testing.T) {
+	t.Parallel()
+
+	module, ctx := newTestCacheModule(t)
+	module.config.MaxItems = 1
+
+	require.NoError(t, module.Set(ctx, "key1", "value1", time.Minute))
+	require.ErrorIs(t, module.Set(ctx, "key2", "value2", time.Minute), ErrCacheFull)
+
+	stats := module.Stats()
+	assert.Equal(t, uint64(1), stats.Sets, "rejected sets are not counted")
+	assert.Equal(t, uint64(1), stats.Evictions)
+	assert.Equal(t, int64(1), stats.Entries)
+}
+
+func TestCacheModule_HealthCheck(t *testing.T) {
+	t.Parallel()
+
+	module, ctx := newTestCacheModule(t)
+	require.NoError(t, module.Set(ctx, "key1", "value1", time.Minute))
+	module.Get(ctx, "missing")
+
+	reports, err := module.HealthCheck(ctx)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+
+	report := reports[0]
+	assert.Equal(t, "cache", report.Module)
+	assert.Equal(t, "memory", report.Component)
+	assert.Equal(t, modular.StatusHealthy, report.Status)
+	assert.Equal(t, uint64(1), report.Details["sets"])
+	assert.Equal(t, uint64(1), report.Details["misses"])
+	assert.Equal(t, int64(1), report.Details["entries"])
+}
+
+func TestCacheModule_HealthCheckNotInitialized(t *testing.T) {
+	t.Parallel()
+
+	module := NewModule().(*CacheModule)
+	reports, err := module.HealthCheck(t.Context())
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, modular.StatusUnhealthy, reports[0].Status)
+}
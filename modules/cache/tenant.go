@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/GoCodeAlone/modular"
+)
+
+// globalKeyPrefix is the key namespace of operations without a tenant when
+// tenant isolation is enabled, keeping them apart from every tenant's keys.
+const globalKeyPrefix = "global:"
+
+// tenantKeyPrefix returns the key namespace of a tenant. The tenant ID is
+// escaped so that no tenant's namespace can overlap another's.
+func tenantKeyPrefix(tenantID modular.TenantID) string {
+	return "tenant:" + url.QueryEscape(string(tenantID)) + ":"
+}
+
+// tenantPrefix returns the key namespace for the tenant in ctx, the global
+// namespace when ctx carries no tenant, or an empty string when tenant
+// isolation is disabled.
+func (m *CacheModule) tenantPrefix(ctx context.Context) string {
+	m.configMu.RLock()
+	isolated := m.config != nil && m.config.TenantIsolation
+	m.configMu.RUnlock()
+	if !isolated {
+		return ""
+	}
+	tenantID, ok := modular.GetTenantIDFromContext(ctx)
+	if !ok || tenantID == "" {
+		return globalKeyPrefix
+	}
+	return tenantKeyPrefix(tenantID)
+}
+
+// prefixCacheKeys returns keys qualified with prefix
+func prefixCacheKeys(prefix string, keys []string) []string {
+	if prefix == "" {
+		return keys
+	}
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = prefix + key
+	}
+	return prefixed
+}
+
+// prefixCacheItems returns items with their keys qualified with prefix
+func prefixCacheItems(prefix string, items map[string]interface{}) map[string]interface{} {
+	if prefix == "" {
+		return items
+	}
+	prefixed := make(map[string]interface{}, len(items))
+	for key, value := range items {
+		prefixed[prefix+key] = value
+	}
+	return prefixed
+}
+
+// unprefixCacheItems returns items with prefix removed from their keys
+func unprefixCacheItems(prefix string, items map[string]interface{}) map[string]interface{} {
+	if prefix == "" {
+		return items
+	}
+	unprefixed := make(map[string]interface{}, len(items))
+	for key, value := range items {
+		unprefixed[strings.TrimPrefix(key, prefix)] = value
+	}
+	return unprefixed
+}
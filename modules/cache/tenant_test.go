@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTenantCacheModule(t *testing.T) (*CacheModule, context.Context, context.Context, context.Context) {
+	t.Helper()
+	module, ctx := newTestCacheModule(t)
+	module.config.TenantIsolation = true
+	return module, ctx, modular.NewTenantContext(ctx, "acme"), modular.NewTenantContext(ctx, "globex")
+}
+
+func TestCacheModule_TenantIsolation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("SameKeyDoesNotCollide", func(t *testing.T) {
+		t.Parallel()
+		module, _, acme, globex := newTenantCacheModule(t)
+
+		require.NoError(t, module.Set(acme, "user:1", "acme-user", time.Minute))
+		require.NoError(t, module.Set(globex, "user:1", "globex-user", time.Minute))
+
+		value, found := module.Get(acme, "user:1")
+		require.True(t, found)
+		assert.Equal(t, "acme-user", value)
+		value, found = module.Get(globex, "user:1")
+		require.True(t, found)
+		assert.Equal(t, "globex-user", value)
+	})
+
+	t.Run("CrossTenantReadsMiss", func(t *testing.T) {
+		t.Parallel()
+		module, ctx, acme, globex := newTenantCacheModule(t)
+
+		require.NoError(t, module.Set(acme, "secret", "acme-only", time.Minute))
+		require.NoError(t, module.SetMulti(acme, map[string]interface{}{"a": 1, "b": 2}, time.Minute))
+
+		_, found := module.Get(globex, "secret")
+		assert.False(t, found)
+		_, found = module.Get(ctx, "secret")
+		assert.False(t, found, "operations without a tenant use the shared namespace")
+
+		results, err := module.GetMulti(globex, []string{"secret", "a", "b"})
+		require.NoError(t, err)
+		assert.Empty(t, results)
+		results, err = module.GetMulti(acme, []string{"secret", "a", "b"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"secret": "acme-only", "a": 1, "b": 2}, results)
+
+		require.NoError(t, module.Delete(globex, "secret"))
+		_, found = module.Get(acme, "secret")
+		assert.True(t, found, "another tenant cannot delete the entry")
+	})
+
+	t.Run("ClearOnlyAffectsTenant", func(t *testing.T) {
+		t.Parallel()
+		module, ctx, acme, globex := newTenantCacheModule(t)
+
+		require.NoError(t, module.Set(acme, "k", "acme", time.Minute))
+		require.NoError(t, module.Set(globex, "k", "globex", time.Minute))
+		require.NoError(t, module.Set(ctx, "k", "shared", time.Minute))
+
+		require.NoError(t, module.Clear(acme))
+
+		_, found := module.Get(acme, "k")
+		assert.False(t, found)
+		value, found := module.Get(globex, "k")
+		require.True(t, found)
+		assert.Equal(t, "globex", value)
+		value, found = module.Get(ctx, "k")
+		require.True(t, found)
+		assert.Equal(t, "shared", value)
+	})
+
+	t.Run("ClearWithoutTenantKeepsTenants", func(t *testing.T) {
+		t.Parallel()
+		module, ctx, acme, _ := newTenantCacheModule(t)
+
+		require.NoError(t, module.Set(acme, "k", "acme", time.Minute))
+		require.NoError(t, module.Set(ctx, "k", "shared", time.Minute))
+
+		require.NoError(t, module.Clear(ctx))
+
+		_, found := module.Get(ctx, "k")
+		assert.False(t, found)
+		value, found := module.Get(acme, "k")
+		require.True(t, found)
+		assert.Equal(t, "acme", value)
+	})
+
+	t.Run("GetOrLoadPerTenant", func(t *testing.T) {
+		t.Parallel()
+		module, _, acme, globex := newTenantCacheModule(t)
+
+		for _, tc := range []struct {
+			ctx  context.Context
+			want string
+		}{{acme, "acme"}, {globex, "globex"}} {
+			tenantID, _ := modular.GetTenantIDFromContext(tc.ctx)
+			value, err := module.GetOrLoad(tc.ctx, "config", time.Minute, func(context.Context) (interface{}, error) {
+				return string(tenantID), nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, value)
+		}
+	})
+
+	t.Run("DisabledSharesKeys", func(t *testing.T) {
+		t.Parallel()
+		module, ctx := newTestCacheModule(t)
+		acme := modular.NewTenantContext(ctx, "acme")
+
+		require.NoError(t, module.Set(acme, "k", "v", time.Minute))
+		value, found := module.Get(ctx, "k")
+		require.True(t, found)
+		assert.Equal(t, "v", value)
+	})
+}
+
+func TestTenantKeyPrefix(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "tenant:acme:", tenantKeyPrefix("acme"))
+	assert.NotEqual(t, tenantKeyPrefix("a:b")+"c", tenantKeyPrefix("a")+"b:c", "tenant namespaces must not overlap")
+}
@@ -11,6 +11,14 @@ type Config struct {
 
 	// Default specifies the name of the default connection
 	Default string `json:"default" yaml:"default" env:"DEFAULT_DB_CONNECTION"`
+
+	// MigrationsEnabled controls whether registered migration providers run
+	// during Start. Disable it in read-only environments. Default is true
+	MigrationsEnabled bool `json:"migrations_enabled" yaml:"migrations_enabled" env:"DB_MIGRATIONS_ENABLED"`
+
+	// MigrationOrder lists migration provider names in the order they should run.
+	// Providers not listed run afterwards in registration order.
+	MigrationOrder []string `json:"migration_order,omitempty" yaml:"migration_order,omitempty"`
 }
 
 // Validate implements ConfigValidator interface
@@ -51,6 +59,25 @@ type ConnectionConfig struct {
 
 	// AWSIAMAuth contains AWS IAM authentication configuration
 	AWSIAMAuth *AWSIAMAuthConfig `json:"aws_iam_auth,omitempty" yaml:"aws_iam_auth,omitempty"`
+
+	// Replicas lists DSNs of read replicas for this connection. Replicas use the
+	// same driver and pool settings as the primary and are served by QueryReplica.
+	Replicas []string `json:"replicas,omitempty" yaml:"replicas,omitempty"`
+
+	// PoolDegradedThreshold is the pool utilization (in-use connections divided by
+	// MaxOpenConnections) at which the connection reports degraded health.
+	// Default is 0.8; utilization is only computed when MaxOpenConnections is set
+	PoolDegradedThreshold float64 `json:"pool_degraded_threshold" yaml:"pool_degraded_threshold" env:"POOL_DEGRADED_THRESHOLD"`
+
+	// PoolUnhealthyThreshold is the pool utilization at which the connection
+	// reports unhealthy. Zero disables it, since a saturated pool still serves
+	// requests, just with added latency
+	PoolUnhealthyThreshold float64 `json:"pool_unhealthy_threshold" yaml:"pool_unhealthy_threshold" env:"POOL_UNHEALTHY_THRESHOLD"`
+
+	// ReplicaHealthCheckInterval sets how often replicas are pinged. A replica that
+	// fails its ping is removed from rotation until a later ping succeeds.
+	// Default is 30 seconds
+	ReplicaHealthCheckInterval time.Duration `json:"replica_health_check_interval" yaml:"replica_health_check_interval" env:"REPLICA_HEALTH_CHECK_INTERVAL"`
 }
 
 // AWSIAMAuthConfig represents AWS IAM authentication configuration.
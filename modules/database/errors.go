@@ -13,4 +13,19 @@ var (
 	// ErrMigrationServiceNotInitialized is returned when migration operations are attempted
 	// without proper migration service initialization
 	ErrMigrationServiceNotInitialized = errors.New("migration service not initialized")
+
+	// ErrNilMigrationProvider is returned when registering a nil migration provider
+	ErrNilMigrationProvider = errors.New("migration provider cannot be nil")
+
+	// ErrDuplicateMigrationProvider is returned when a migration provider name is registered twice
+	ErrDuplicateMigrationProvider = errors.New("migration provider already registered")
+
+	// ErrMigrationFailed is returned by Start when a registered migration provider fails
+	ErrMigrationFailed = errors.New("database migration failed")
+
+	// ErrUnknownConnection is returned when a named connection is not configured
+	ErrUnknownConnection = errors.New("unknown database connection")
+
+	// ErrPreparedQueryMismatch is returned when a prepared statement name is reused for a different query
+	ErrPreparedQueryMismatch = errors.New("prepared statement name already used for a different query")
 )
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+)
+
+// DefaultPoolDegradedThreshold is the pool utilization at which a connection
+// reports degraded health when ConnectionConfig.PoolDegradedThreshold is not set
+const DefaultPoolDegradedThreshold = 0.8
+
+// Compile-time check that the module reports its health
+var _ modular.HealthProvider = (*Module)(nil)
+
+// HealthCheck implements modular.HealthProvider.
+// It reports one component per connection with the pool statistics
+// (open_connections, in_use, idle, wait_count, wait_duration_ms and, when
+// MaxOpenConnections is set, utilization) in Details. A connection is
+// unhealthy if it fails its ping or reaches PoolUnhealthyThreshold and
+// degraded once it reaches PoolDegradedThreshold. Read replicas are reported
+// as optional components.
+func (m *Module) HealthCheck(ctx context.Context) ([]modular.HealthReport, error) {
+	m.connMu.RLock()
+	services := make(map[string]DatabaseService, len(m.services))
+	for name, service := range m.services {
+		services[name] = service
+	}
+	replicas := make(map[string]*replicaSet, len(m.replicas))
+	for name, set := range m.replicas {
+		replicas[name] = set
+	}
+	m.connMu.RUnlock()
+
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	reports := make([]modular.HealthReport, 0, len(names))
+	for _, name := range names {
+		var connConfig ConnectionConfig
+		if m.config != nil && m.config.Connections[name] != nil {
+			connConfig = *m.config.Connections[name]
+		}
+		reports = append(reports, connectionHealth(ctx, name, services[name], connConfig))
+		if set := replicas[name]; set != nil {
+			reports = append(reports, set.health()...)
+		}
+	}
+	return reports, nil
+}
+
+// connectionHealth builds the health report for a single connection
+func connectionHealth(ctx context.Context, name string, service DatabaseService, config ConnectionConfig) modular.HealthReport {
+	stats := service.Stats()
+	report := modular.HealthReport{
+		Module:    Name,
+		Component: name,
+		Status:    modular.StatusHealthy,
+		Message:   "connection pool is healthy",
+		CheckedAt: time.Now(),
+		Details:   poolDetails(stats),
+	}
+
+	// A pool with every connection in use is evidently reachable, and pinging
+	// it would only queue behind the busy connections
+	exhausted := stats.MaxOpenConnections > 0 && stats.InUse >= stats.MaxOpenConnections
+	if !exhausted {
+		pingCtx, cancel := context.WithTimeout(ctx, DefaultConnectionTimeout)
+		err := service.Ping(pingCtx)
+		cancel()
+		if err != nil {
+			report.Status = modular.StatusUnhealthy
+			report.Message = fmt.Sprintf("ping failed: %v", err)
+			return report
+		}
+	}
+
+	if stats.MaxOpenConnections <= 0 {
+		return report
+	}
+
+	utilization := float64(stats.InUse) / float64(stats.MaxOpenConnections)
+	report.Details["utilization"] = utilization
+
+	degradedThreshold := config.PoolDegradedThreshold
+	if degradedThreshold <= 0 {
+		degradedThreshold = DefaultPoolDegradedThreshold
+	}
+	switch {
+	case config.PoolUnhealthyThreshold > 0 && utilization >= config.PoolUnhealthyThreshold:
+		report.Status = modular.StatusUnhealthy
+		report.Message = fmt.Sprintf("connection pool utilization %.0f%% exceeds unhealthy threshold", utilization*100)
+	case utilization >= degradedThreshold:
+		report.Status = modular.StatusDegraded
+		report.Message = fmt.Sprintf("connection pool utilization %.0f%% exceeds degraded threshold", utilization*100)
+	}
+	return report
+}
+
+// poolDetails converts pool statistics into health report details
+func poolDetails(stats sql.DBStats) map[string]any {
+	return map[string]any{
+		"max_open_connections": stats.MaxOpenConnections,
+		"open_connections":     stats.OpenConnections,
+		"in_use":               stats.InUse,
+		"idle":                 stats.Idle,
+		"wait_count":           stats.WaitCount,
+		"wait_duration_ms":     stats.WaitDuration.Milliseconds(),
+	}
+}
+
+// health reports the rotation state of each replica as of its last health check
+func (s *replicaSet) health() []modular.HealthReport {
+	reports := make([]modular.HealthReport, 0, len(s.replicas))
+	for i, r := range s.replicas {
+		report := modular.HealthReport{
+			Module:    Name,
+			Component: fmt.Sprintf("%s/replica-%d", s.connection, i),
+			Status:    modular.StatusHealthy,
+			Message:   "replica in rotation",
+			CheckedAt: time.Now(),
+			Optional:  true,
+			Details:   poolDetails(r.service.Stats()),
+		}
+		if !r.healthy.Load() {
+			report.Status = modular.StatusUnhealthy
+			report.Message = "replica removed from rotation after failed health check"
+		}
+		reports = append(reports, report)
+	}
+	return reports
+}
@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHealthTestModule initializes a module with a single SQLite connection
+func newHealthTestModule(t *testing.T, connConfig *ConnectionConfig) *Module {
+	t.Helper()
+	module := NewModule()
+	app := NewMockApplication()
+	require.NoError(t, module.RegisterConfig(app))
+
+	connConfig.Driver = "sqlite"
+	connConfig.DSN = createNamedSQLiteDB(t, "main")
+	config := &Config{
+		Default:     "main",
+		Connections: map[string]*ConnectionConfig{"main": connConfig},
+	}
+	app.RegisterConfigSection("database", &MockConfigProvider{config: config})
+	require.NoError(t, module.Init(app))
+	t.Cleanup(func() { _ = module.Stop(context.Background()) })
+	return module
+}
+
+// holdConnections checks out n connections from db until the test ends
+func holdConnections(t *testing.T, db *sql.DB, n int) {
+	t.Helper()
+	for range n {
+		conn, err := db.Conn(context.Background())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+	}
+}
+
+func TestModule_HealthCheck(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Healthy", func(t *testing.T) {
+		module := newHealthTestModule(t, &ConnectionConfig{MaxOpenConnections: 4})
+
+		reports, err := module.HealthCheck(ctx)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		assert.Equal(t, "database", reports[0].Module)
+		assert.Equal(t, "main", reports[0].Component)
+		assert.Equal(t, modular.StatusHealthy, reports[0].Status)
+		assert.Equal(t, 4, reports[0].Details["max_open_connections"])
+		assert.InDelta(t, 0.0, reports[0].Details["utilization"], 0.001)
+	})
+
+	t.Run("PoolExhaustionIsDegraded", func(t *testing.T) {
+		module := newHealthTestModule(t, &ConnectionConfig{MaxOpenConnections: 2})
+		db, _ := module.GetConnection("main")
+		holdConnections(t, db, 2)
+
+		reports, err := module.HealthCheck(ctx)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		assert.Equal(t, modular.StatusDegraded, reports[0].Status)
+		assert.Equal(t, 2, reports[0].Details["in_use"])
+		assert.InDelta(t, 1.0, reports[0].Details["utilization"], 0.001)
+	})
+
+	t.Run("ConfigurableThresholds", func(t *testing.T) {
+		module := newHealthTestModule(t, &ConnectionConfig{
+			MaxOpenConnections:     4,
+			PoolDegradedThreshold:  0.25,
+			PoolUnhealthyThreshold: 0.75,
+		})
+		db, _ := module.GetConnection("main")
+
+		holdConnections(t, db, 1)
+		reports, err := module.HealthCheck(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, modular.StatusDegraded, reports[0].Status)
+
+		holdConnections(t, db, 2)
+		reports, err = module.HealthCheck(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, modular.StatusUnhealthy, reports[0].Status)
+	})
+
+	t.Run("PingFailureIsUnhealthy", func(t *testing.T) {
+		module := newHealthTestModule(t, &ConnectionConfig{})
+		db, _ := module.GetConnection("main")
+		require.NoError(t, db.Close())
+
+		reports, err := module.HealthCheck(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, modular.StatusUnhealthy, reports[0].Status)
+		assert.NotContains(t, reports[0].Details, "utilization")
+	})
+
+	t.Run("ReplicasAreOptional", func(t *testing.T) {
+		module := newHealthTestModule(t, &ConnectionConfig{
+			Replicas: []string{createNamedSQLiteDB(t, "replica")},
+		})
+
+		reports, err := module.HealthCheck(ctx)
+		require.NoError(t, err)
+		require.Len(t, reports, 2)
+		assert.Equal(t, "main/replica-0", reports[1].Component)
+		assert.True(t, reports[1].Optional)
+		assert.Equal(t, modular.StatusHealthy, reports[1].Status)
+	})
+}
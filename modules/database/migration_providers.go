@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// MigrationProvider applies schema migrations to a database connection.
+// Providers registered with Module.RegisterMigrationProvider run during the
+// database module's Start, after the connection has been pinged, so modules
+// can own their schema instead of relying on a manual migration step.
+type MigrationProvider interface {
+	Migrate(ctx context.Context, db *sql.DB) error
+}
+
+// MigrationProviderFunc adapts an ordinary function to a MigrationProvider
+type MigrationProviderFunc func(ctx context.Context, db *sql.DB) error
+
+// Migrate calls f(ctx, db)
+func (f MigrationProviderFunc) Migrate(ctx context.Context, db *sql.DB) error {
+	return f(ctx, db)
+}
+
+// SQLMigrations is a MigrationProvider that applies a fixed set of SQL
+// migrations with a MigrationRunner, skipping those already recorded in the
+// schema_migrations table.
+type SQLMigrations []Migration
+
+// Migrate applies the migrations that have not been applied yet
+func (s SQLMigrations) Migrate(ctx context.Context, db *sql.DB) error {
+	runner := NewMigrationRunner(NewMigrationService(db, nil))
+	if err := runner.RunMigrations(ctx, slices.Clone(s)); err != nil {
+		return fmt.Errorf("failed to apply SQL migrations: %w", err)
+	}
+	return nil
+}
+
+// registeredMigrationProvider is a MigrationProvider bound to a connection
+type registeredMigrationProvider struct {
+	name       string
+	connection string
+	provider   MigrationProvider
+}
+
+// RegisterMigrationProvider registers a migration provider for the named
+// connection; an empty connection name selects the default connection.
+// Providers must be registered before the module starts, typically from a
+// dependent module's Init. They run in the order given by the
+// migration_order configuration, followed by any unlisted providers in
+// registration order.
+//
+// Example:
+//
+//	err := dbModule.RegisterMigrationProvider("", "orders", database.SQLMigrations{
+//	    {ID: "001_create_orders", Version: "001", SQL: "CREATE TABLE orders (id INTEGER PRIMARY KEY)"},
+//	})
+func (m *Module) RegisterMigrationProvider(connection, name string, provider MigrationProvider) error {
+	if provider == nil {
+		return ErrNilMigrationProvider
+	}
+
+	m.migrationMu.Lock()
+	defer m.migrationMu.Unlock()
+	for _, registered := range m.migrationProviders {
+		if registered.name == name {
+			return fmt.Errorf("%w: %s", ErrDuplicateMigrationProvider, name)
+		}
+	}
+	m.migrationProviders = append(m.migrationProviders, registeredMigrationProvider{
+		name:       name,
+		connection: connection,
+		provider:   provider,
+	})
+	return nil
+}
+
+// orderedMigrationProviders returns the registered providers in execution order
+func (m *Module) orderedMigrationProviders() []registeredMigrationProvider {
+	m.migrationMu.Lock()
+	providers := slices.Clone(m.migrationProviders)
+	m.migrationMu.Unlock()
+
+	var order []string
+	if m.config != nil {
+		order = m.config.MigrationOrder
+	}
+	rank := func(name string) int {
+		if i := slices.Index(order, name); i >= 0 {
+			return i
+		}
+		return len(order)
+	}
+	slices.SortStableFunc(providers, func(a, b registeredMigrationProvider) int {
+		return rank(a.name) - rank(b.name)
+	})
+	return providers
+}
+
+// runMigrationProviders runs every registered provider against its connection,
+// stopping at the first failure.
+func (m *Module) runMigrationProviders(ctx context.Context) error {
+	for _, registered := range m.orderedMigrationProviders() {
+		connection := registered.connection
+		if connection == "" && m.config != nil {
+			connection = m.config.Default
+		}
+		db, exists := m.GetConnection(connection)
+		if !exists {
+			return fmt.Errorf("%w: provider '%s' targets unknown connection '%s'", ErrMigrationFailed, registered.name, connection)
+		}
+
+		m.logger.Info("Running database migrations", "provider", registered.name, "connection", connection)
+		m.emitEvent(ctx, EventTypeMigrationStarted, map[string]interface{}{
+			"provider":   registered.name,
+			"connection": connection,
+		})
+
+		startTime := time.Now()
+		if err := registered.provider.Migrate(ctx, db); err != nil {
+			m.emitEvent(ctx, EventTypeMigrationFailed, map[string]interface{}{
+				"provider":    registered.name,
+				"connection":  connection,
+				"error":       err.Error(),
+				"duration_ms": time.Since(startTime).Milliseconds(),
+			})
+			return fmt.Errorf("%w: provider '%s' on connection '%s': %w", ErrMigrationFailed, registered.name, connection, err)
+		}
+
+		m.emitEvent(ctx, EventTypeMigrationCompleted, map[string]interface{}{
+			"provider":    registered.name,
+			"connection":  connection,
+			"duration_ms": time.Since(startTime).Milliseconds(),
+		})
+	}
+	return nil
+}
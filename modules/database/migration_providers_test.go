@@ -0,0 +1,109 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestMigration = errors.New("migration exploded")
+
+// newMigrationTestModule initializes a module with a single SQLite connection
+func newMigrationTestModule(t *testing.T, migrationsEnabled bool, order ...string) *Module {
+	t.Helper()
+	module := NewModule()
+	app := NewMockApplication()
+	require.NoError(t, module.RegisterConfig(app))
+
+	config := &Config{
+		Default:           "main",
+		MigrationsEnabled: migrationsEnabled,
+		MigrationOrder:    order,
+		Connections: map[string]*ConnectionConfig{
+			"main": {Driver: "sqlite", DSN: createNamedSQLiteDB(t, "main")},
+		},
+	}
+	app.RegisterConfigSection("database", &MockConfigProvider{config: config})
+	require.NoError(t, module.Init(app))
+	t.Cleanup(func() { _ = module.Stop(context.Background()) })
+	return module
+}
+
+func recordingProvider(name string, ran *[]string) MigrationProvider {
+	return MigrationProviderFunc(func(ctx context.Context, db *sql.DB) error {
+		*ran = append(*ran, name)
+		return nil
+	})
+}
+
+func TestModule_MigrationProviders(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RunInConfiguredOrder", func(t *testing.T) {
+		module := newMigrationTestModule(t, true, "third", "first")
+		var ran []string
+		require.NoError(t, module.RegisterMigrationProvider("", "first", recordingProvider("first", &ran)))
+		require.NoError(t, module.RegisterMigrationProvider("main", "second", recordingProvider("second", &ran)))
+		require.NoError(t, module.RegisterMigrationProvider("", "third", recordingProvider("third", &ran)))
+
+		require.NoError(t, module.Start(ctx))
+		assert.Equal(t, []string{"third", "first", "second"}, ran)
+	})
+
+	t.Run("SQLMigrations", func(t *testing.T) {
+		module := newMigrationTestModule(t, true)
+		require.NoError(t, module.RegisterMigrationProvider("", "orders", SQLMigrations{
+			{ID: "001_create_orders", Version: "001", SQL: "CREATE TABLE orders (id INTEGER PRIMARY KEY)"},
+		}))
+
+		require.NoError(t, module.Start(ctx))
+		db, _ := module.GetConnection("main")
+		_, err := db.Exec("INSERT INTO orders (id) VALUES (1)")
+		require.NoError(t, err)
+	})
+
+	t.Run("FailureBlocksStart", func(t *testing.T) {
+		module := newMigrationTestModule(t, true)
+		var ran []string
+		require.NoError(t, module.RegisterMigrationProvider("", "broken", MigrationProviderFunc(func(ctx context.Context, db *sql.DB) error {
+			return errTestMigration
+		})))
+		require.NoError(t, module.RegisterMigrationProvider("", "after", recordingProvider("after", &ran)))
+
+		err := module.Start(ctx)
+		require.ErrorIs(t, err, ErrMigrationFailed)
+		require.ErrorIs(t, err, errTestMigration)
+		assert.Empty(t, ran, "providers after a failure must not run")
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		module := newMigrationTestModule(t, false)
+		var ran []string
+		require.NoError(t, module.RegisterMigrationProvider("", "skipped", recordingProvider("skipped", &ran)))
+
+		require.NoError(t, module.Start(ctx))
+		assert.Empty(t, ran)
+	})
+
+	t.Run("UnknownConnection", func(t *testing.T) {
+		module := newMigrationTestModule(t, true)
+		var ran []string
+		require.NoError(t, module.RegisterMigrationProvider("missing", "lost", recordingProvider("lost", &ran)))
+
+		require.ErrorIs(t, module.Start(ctx), ErrMigrationFailed)
+		assert.Empty(t, ran)
+	})
+
+	t.Run("RegistrationErrors", func(t *testing.T) {
+		module := NewModule()
+		require.ErrorIs(t, module.RegisterMigrationProvider("", "nil", nil), ErrNilMigrationProvider)
+
+		var ran []string
+		require.NoError(t, module.RegisterMigrationProvider("", "dup", recordingProvider("dup", &ran)))
+		require.ErrorIs(t, module.RegisterMigrationProvider("", "dup", recordingProvider("dup", &ran)), ErrDuplicateMigrationProvider)
+	})
+}
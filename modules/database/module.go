@@ -412,6 +412,7 @@ func (l *lazyDefaultService) SetEventEmitter(emitter EventEmitter) {
 //   - Multiple named database connections
 //   - Automatic connection health monitoring
 //   - Default connection selection
+//   - Read replica routing with health-based failover
 //   - Service abstraction for easier testing
 //   - Instance-aware configuration
 //   - Event observation and emission for database operations
@@ -428,9 +429,19 @@ type Module struct {
 	connections map[string]*sql.DB
 	connMu      sync.RWMutex // Protects connections map
 	services    map[string]DatabaseService
-	subject     modular.Subject // For event observation
-	subjectMu   sync.RWMutex    // Protects subject field from race conditions
-	logger      modular.Logger  // For structured logging
+	replicas    map[string]*replicaSet // Read replicas by connection name
+	subject     modular.Subject        // For event observation
+	subjectMu   sync.RWMutex           // Protects subject field from race conditions
+	logger      modular.Logger         // For structured logging
+
+	replicaCancel context.CancelFunc // Stops replica health checks
+	replicaWG     sync.WaitGroup
+
+	migrationProviders []registeredMigrationProvider
+	migrationMu        sync.Mutex // Protects migrationProviders
+
+	prepared   map[preparedKey]*preparedStatement
+	preparedMu sync.Mutex // Protects prepared
 }
 
 var (
@@ -450,6 +461,8 @@ func NewModule() *Module {
 	return &Module{
 		connections: make(map[string]*sql.DB),
 		services:    make(map[string]DatabaseService),
+		replicas:    make(map[string]*replicaSet),
+		prepared:    make(map[preparedKey]*preparedStatement),
 	}
 }
 
@@ -473,8 +486,9 @@ func (m *Module) Name() string {
 func (m *Module) RegisterConfig(app modular.Application) error {
 	// Register the configuration with default values
 	defaultConfig := &Config{
-		Default:     "default",
-		Connections: make(map[string]*ConnectionConfig),
+		Default:           "default",
+		Connections:       make(map[string]*ConnectionConfig),
+		MigrationsEnabled: true,
 	}
 
 	// Create instance-aware config provider with database-specific prefix
@@ -552,7 +566,9 @@ func (m *Module) Init(app modular.Application) error {
 
 // Start starts the database module and verifies connectivity.
 // This method performs health checks on all database connections
-// to ensure they are ready for use by other modules.
+// to ensure they are ready for use by other modules, then runs the
+// registered migration providers unless migrations are disabled.
+// A failing migration fails Start.
 func (m *Module) Start(ctx context.Context) error {
 	// Test connections to make sure they're still alive
 	m.connMu.RLock()
@@ -566,6 +582,16 @@ func (m *Module) Start(ctx context.Context) error {
 			return fmt.Errorf("failed to ping database connection '%s': %w", name, err)
 		}
 	}
+
+	if m.config != nil && m.config.MigrationsEnabled {
+		if err := m.runMigrationProviders(ctx); err != nil {
+			return err
+		}
+	} else if len(m.orderedMigrationProviders()) > 0 {
+		m.logger.Info("Database migrations disabled, skipping registered migration providers")
+	}
+
+	m.startReplicaMonitoring(ctx)
 	return nil
 }
 
@@ -573,6 +599,11 @@ func (m *Module) Start(ctx context.Context) error {
 // This method gracefully closes all database connections and services,
 // ensuring proper cleanup during application shutdown.
 func (m *Module) Stop(ctx context.Context) error {
+	m.stopReplicas()
+
+	// Statements must be closed before the pools they were prepared on
+	m.closePreparedStatements()
+
 	// Snapshot services under read lock
 	m.connMu.RLock()
 	services := make(map[string]DatabaseService, len(m.services))
@@ -820,9 +851,20 @@ func (m *Module) initializeConnections(app modular.Application) error {
 				}
 			}()
 
+			var replicas *replicaSet
+			if len(connConfig.Replicas) > 0 {
+				replicas, err = newReplicaSet(name, *connConfig, app.Logger())
+				if err != nil {
+					return err
+				}
+			}
+
 			m.connMu.Lock()
 			m.connections[name] = dbService.DB()
 			m.services[name] = dbService
+			if replicas != nil {
+				m.replicas[name] = replicas
+			}
 			m.connMu.Unlock()
 		}
 	}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// preparedKey identifies a named statement on a connection
+type preparedKey struct {
+	connection string
+	name       string
+}
+
+// preparedStatement is a cached statement and the pool it was prepared on
+type preparedStatement struct {
+	query string
+	db    *sql.DB
+	stmt  *sql.Stmt
+}
+
+// Prepared returns the statement cached under name for the named connection,
+// preparing query on first use. A *sql.Stmt is safe for concurrent use and is
+// transparently re-prepared on each pooled connection by database/sql; if the
+// connection's pool itself is replaced (for example after a reconnect), the
+// statement is prepared again on the new pool. Cached statements are closed
+// when the module stops.
+//
+// Example:
+//
+//	stmt, err := dbModule.Prepared("main", "user-by-id", "SELECT name FROM users WHERE id = ?")
+//	if err != nil {
+//	    return err
+//	}
+//	err = stmt.QueryRowContext(ctx, id).Scan(&name)
+func (m *Module) Prepared(connName, name, query string) (*sql.Stmt, error) {
+	service, exists := m.GetService(connName)
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownConnection, connName)
+	}
+	db := service.DB()
+	if db == nil {
+		return nil, ErrDatabaseNotConnected
+	}
+
+	key := preparedKey{connection: connName, name: name}
+	if stmt, ok, err := m.cachedPrepared(key, query, db); ok || err != nil {
+		return stmt, err
+	}
+
+	// Prepare without holding the lock so a slow round trip to one database
+	// does not block lookups of other statements
+	stmt, err := db.PrepareContext(context.Background(), query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare statement '%s': %w", name, err)
+	}
+
+	m.preparedMu.Lock()
+	defer m.preparedMu.Unlock()
+
+	// Another caller may have prepared the same statement meanwhile
+	if cached, ok := m.prepared[key]; ok && cached.db == db {
+		_ = stmt.Close()
+		if cached.query != query {
+			return nil, fmt.Errorf("%w: %s", ErrPreparedQueryMismatch, name)
+		}
+		return cached.stmt, nil
+	} else if ok {
+		// The pool was replaced; the old statement belongs to a closed pool
+		_ = cached.stmt.Close()
+	}
+	m.prepared[key] = &preparedStatement{query: query, db: db, stmt: stmt}
+	return stmt, nil
+}
+
+// cachedPrepared returns the statement cached under key if it was prepared for
+// query on db. It reports false when the statement still needs preparing.
+func (m *Module) cachedPrepared(key preparedKey, query string, db *sql.DB) (*sql.Stmt, bool, error) {
+	m.preparedMu.Lock()
+	defer m.preparedMu.Unlock()
+
+	cached, ok := m.prepared[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if cached.query != query {
+		return nil, false, fmt.Errorf("%w: %s", ErrPreparedQueryMismatch, key.name)
+	}
+	if cached.db != db {
+		return nil, false, nil
+	}
+	return cached.stmt, true, nil
+}
+
+// closePreparedStatements closes and forgets every cached statement
+func (m *Module) closePreparedStatements() {
+	m.preparedMu.Lock()
+	defer m.preparedMu.Unlock()
+	for key, cached := range m.prepared {
+		if err := cached.stmt.Close(); err != nil {
+			m.logger.Error("Failed to close prepared statement", "connection", key.connection, "name", key.name, "error", err)
+		}
+	}
+	m.prepared = make(map[preparedKey]*preparedStatement)
+}
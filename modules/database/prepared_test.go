@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModule_Prepared(t *testing.T) {
+	ctx := context.Background()
+	const query = "SELECT name FROM whoami WHERE name = ?"
+
+	t.Run("PreparedOnce", func(t *testing.T) {
+		module := newHealthTestModule(t, &ConnectionConfig{})
+
+		first, err := module.Prepared("main", "whoami", query)
+		require.NoError(t, err)
+		for range 10 {
+			stmt, err := module.Prepared("main", "whoami", query)
+			require.NoError(t, err)
+			assert.Same(t, first, stmt)
+		}
+		assert.Len(t, module.prepared, 1)
+
+		var name string
+		require.NoError(t, first.QueryRowContext(ctx, "main").Scan(&name))
+		assert.Equal(t, "main", name)
+	})
+
+	t.Run("ConcurrentCallersShareStatement", func(t *testing.T) {
+		module := newHealthTestModule(t, &ConnectionConfig{})
+
+		const callers = 20
+		stmts := make([]*sql.Stmt, callers)
+		var wg sync.WaitGroup
+		for i := range callers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				stmt, err := module.Prepared("main", "whoami", query)
+				assert.NoError(t, err)
+				stmts[i] = stmt
+			}()
+		}
+		wg.Wait()
+
+		// Statements prepared by callers that lost the race are discarded
+		for _, stmt := range stmts {
+			assert.Same(t, stmts[0], stmt)
+		}
+		assert.Len(t, module.prepared, 1)
+	})
+
+	t.Run("RepreparedAfterReconnect", func(t *testing.T) {
+		module := newHealthTestModule(t, &ConnectionConfig{})
+
+		before, err := module.Prepared("main", "whoami", query)
+		require.NoError(t, err)
+
+		service, _ := module.GetService("main")
+		require.NoError(t, service.Close())
+		require.NoError(t, service.Connect())
+
+		after, err := module.Prepared("main", "whoami", query)
+		require.NoError(t, err)
+		assert.NotSame(t, before, after)
+
+		var name string
+		require.NoError(t, after.QueryRowContext(ctx, "main").Scan(&name))
+	})
+
+	t.Run("StopClosesStatements", func(t *testing.T) {
+		module := newHealthTestModule(t, &ConnectionConfig{})
+
+		stmt, err := module.Prepared("main", "whoami", query)
+		require.NoError(t, err)
+		require.NoError(t, module.Stop(ctx))
+
+		_, err = stmt.QueryContext(ctx, "main")
+		require.Error(t, err)
+		assert.Empty(t, module.prepared)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		module := newHealthTestModule(t, &ConnectionConfig{})
+
+		_, err := module.Prepared("missing", "whoami", query)
+		require.ErrorIs(t, err, ErrUnknownConnection)
+
+		_, err = module.Prepared("main", "whoami", query)
+		require.NoError(t, err)
+		_, err = module.Prepared("main", "whoami", "SELECT 1")
+		require.ErrorIs(t, err, ErrPreparedQueryMismatch)
+	})
+}
@@ -0,0 +1,205 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+)
+
+// DefaultReplicaHealthCheckInterval is how often replicas are pinged when
+// ConnectionConfig.ReplicaHealthCheckInterval is not set
+const DefaultReplicaHealthCheckInterval = 30 * time.Second
+
+// replica is a single read replica of a connection
+type replica struct {
+	service DatabaseService
+	healthy atomic.Bool
+}
+
+// replicaSet routes reads for one connection across its read replicas
+type replicaSet struct {
+	connection string
+	replicas   []*replica
+	next       atomic.Uint64
+	interval   time.Duration
+	logger     modular.Logger
+}
+
+// newReplicaSet creates and connects the replicas configured for a connection.
+// Replicas that cannot be reached are kept out of rotation until a later
+// health check succeeds, so an unavailable replica never blocks startup.
+func newReplicaSet(name string, config ConnectionConfig, logger modular.Logger) (*replicaSet, error) {
+	set := &replicaSet{
+		connection: name,
+		interval:   config.ReplicaHealthCheckInterval,
+		logger:     logger,
+	}
+	if set.interval <= 0 {
+		set.interval = DefaultReplicaHealthCheckInterval
+	}
+
+	for i, dsn := range config.Replicas {
+		replicaConfig := config
+		replicaConfig.DSN = dsn
+		replicaConfig.Replicas = nil
+
+		service, err := NewDatabaseService(replicaConfig, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create replica %d for '%s': %w", i, name, err)
+		}
+
+		r := &replica{service: service}
+		if err := service.Connect(); err != nil {
+			logger.Warn("Database replica unavailable, excluding from rotation", "connection", name, "replica", i, "error", err)
+		} else {
+			r.healthy.Store(true)
+		}
+		set.replicas = append(set.replicas, r)
+	}
+	return set, nil
+}
+
+// pick returns the next healthy replica in round-robin order, or nil if none are healthy
+func (s *replicaSet) pick() *sql.DB {
+	count := uint64(len(s.replicas))
+	if count == 0 {
+		return nil
+	}
+	start := s.next.Add(1) - 1
+	for i := range count {
+		r := s.replicas[(start+i)%count]
+		if !r.healthy.Load() {
+			continue
+		}
+		if db := r.service.DB(); db != nil {
+			return db
+		}
+	}
+	return nil
+}
+
+// check pings every replica, removing failing replicas from rotation and
+// restoring recovered ones. Replicas that never connected are reconnected.
+func (s *replicaSet) check(ctx context.Context) {
+	for i, r := range s.replicas {
+		pingCtx, cancel := context.WithTimeout(ctx, DefaultConnectionTimeout)
+		err := r.service.Ping(pingCtx)
+		cancel()
+		if err != nil && r.service.DB() == nil {
+			err = r.service.Connect()
+		}
+
+		wasHealthy := r.healthy.Swap(err == nil)
+		switch {
+		case err != nil && wasHealthy:
+			s.logger.Warn("Database replica failed health check, removing from rotation", "connection", s.connection, "replica", i, "error", err)
+		case err == nil && !wasHealthy:
+			s.logger.Info("Database replica recovered, returning to rotation", "connection", s.connection, "replica", i)
+		}
+	}
+}
+
+// monitor runs health checks until ctx is cancelled
+func (s *replicaSet) monitor(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.check(ctx)
+		}
+	}
+}
+
+// close closes all replica connections
+func (s *replicaSet) close() error {
+	var firstErr error
+	for i, r := range s.replicas {
+		r.healthy.Store(false)
+		if err := r.service.Close(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to close replica %d of '%s': %w", i, s.connection, err)
+		}
+	}
+	return firstErr
+}
+
+// QueryReplica returns a connection for read-only queries on the named
+// connection. Healthy replicas are chosen in round-robin order; if the
+// connection has no replicas, or none passed their last health check, the
+// primary is returned. Writes should always go through GetConnection.
+//
+// Example:
+//
+//	if db, ok := dbModule.QueryReplica("primary"); ok {
+//	    rows, err := db.QueryContext(ctx, "SELECT id, name FROM users")
+//	    // ...
+//	}
+func (m *Module) QueryReplica(connName string) (*sql.DB, bool) {
+	m.connMu.RLock()
+	primary, exists := m.connections[connName]
+	set := m.replicas[connName]
+	m.connMu.RUnlock()
+	if !exists {
+		return nil, false
+	}
+
+	if set != nil {
+		if db := set.pick(); db != nil {
+			return db, true
+		}
+	}
+	return primary, true
+}
+
+// startReplicaMonitoring checks all replicas once and then keeps checking
+// them in the background until stopReplicas is called
+func (m *Module) startReplicaMonitoring(ctx context.Context) {
+	m.connMu.Lock()
+	if m.replicaCancel != nil || len(m.replicas) == 0 {
+		m.connMu.Unlock()
+		return
+	}
+
+	// The monitors outlive Start's context, so they run on their own
+	monitorCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	m.replicaCancel = cancel
+	sets := make([]*replicaSet, 0, len(m.replicas))
+	for _, set := range m.replicas {
+		sets = append(sets, set)
+	}
+	m.replicaWG.Add(len(sets))
+	m.connMu.Unlock()
+
+	for _, set := range sets {
+		set.check(ctx)
+		go set.monitor(monitorCtx, &m.replicaWG)
+	}
+}
+
+// stopReplicas stops replica health checks and closes all replica connections
+func (m *Module) stopReplicas() {
+	m.connMu.Lock()
+	cancel := m.replicaCancel
+	m.replicaCancel = nil
+	sets := m.replicas
+	m.replicas = make(map[string]*replicaSet)
+	m.connMu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		m.replicaWG.Wait()
+	}
+	for _, set := range sets {
+		if err := set.close(); err != nil {
+			m.logger.Error("Failed to close database replica", "error", err)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createNamedSQLiteDB creates a SQLite database file whose whoami table holds name
+func createNamedSQLiteDB(t *testing.T, name string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name+".db")
+	db, err := sql.Open("sqlite", path)
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.Exec("CREATE TABLE whoami (name TEXT)")
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO whoami (name) VALUES (?)", name)
+	require.NoError(t, err)
+	return path
+}
+
+func whoami(t *testing.T, db *sql.DB) string {
+	t.Helper()
+	var name string
+	require.NoError(t, db.QueryRow("SELECT name FROM whoami").Scan(&name))
+	return name
+}
+
+func TestModule_QueryReplica(t *testing.T) {
+	module := NewModule()
+	app := NewMockApplication()
+	require.NoError(t, module.RegisterConfig(app))
+
+	config := &Config{
+		Default: "main",
+		Connections: map[string]*ConnectionConfig{
+			"main": {
+				Driver:   "sqlite",
+				DSN:      createNamedSQLiteDB(t, "primary"),
+				Replicas: []string{createNamedSQLiteDB(t, "replica-1"), createNamedSQLiteDB(t, "replica-2")},
+			},
+			"standalone": {
+				Driver: "sqlite",
+				DSN:    createNamedSQLiteDB(t, "standalone"),
+			},
+		},
+	}
+	app.RegisterConfigSection("database", &MockConfigProvider{config: config})
+
+	ctx := context.Background()
+	require.NoError(t, module.Init(app))
+	require.NoError(t, module.Start(ctx))
+	defer func() { _ = module.Stop(ctx) }()
+
+	t.Run("RoundRobinAcrossReplicas", func(t *testing.T) {
+		var names []string
+		for range 4 {
+			db, ok := module.QueryReplica("main")
+			require.True(t, ok)
+			names = append(names, whoami(t, db))
+		}
+		assert.ElementsMatch(t, []string{"replica-1", "replica-2", "replica-1", "replica-2"}, names)
+		assert.NotEqual(t, names[0], names[1])
+	})
+
+	t.Run("WritesUsePrimary", func(t *testing.T) {
+		db, ok := module.GetConnection("main")
+		require.True(t, ok)
+		assert.Equal(t, "primary", whoami(t, db))
+	})
+
+	t.Run("NoReplicasFallsBackToPrimary", func(t *testing.T) {
+		db, ok := module.QueryReplica("standalone")
+		require.True(t, ok)
+		assert.Equal(t, "standalone", whoami(t, db))
+	})
+
+	t.Run("UnknownConnection", func(t *testing.T) {
+		db, ok := module.QueryReplica("missing")
+		assert.False(t, ok)
+		assert.Nil(t, db)
+	})
+
+	t.Run("FailedReplicaIsRemovedFromRotation", func(t *testing.T) {
+		set := module.replicas["main"]
+		require.NoError(t, set.replicas[0].service.DB().Close())
+		set.check(ctx)
+
+		for range 4 {
+			db, ok := module.QueryReplica("main")
+			require.True(t, ok)
+			assert.Equal(t, "replica-2", whoami(t, db))
+		}
+	})
+
+	t.Run("AllReplicasDownFallsBackToPrimary", func(t *testing.T) {
+		set := module.replicas["main"]
+		require.NoError(t, set.replicas[1].service.DB().Close())
+		set.check(ctx)
+
+		db, ok := module.QueryReplica("main")
+		require.True(t, ok)
+		assert.Equal(t, "primary", whoami(t, db))
+	})
+}
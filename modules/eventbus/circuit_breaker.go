@@ -0,0 +1,103 @@
+package eventbus
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultCircuitBreakerThreshold is the number of consecutive failures
+	// that open a circuit breaker when the engine config does not set one.
+	defaultCircuitBreakerThreshold = 5
+
+	// defaultCircuitBreakerProbeInterval is how often an open circuit breaker
+	// probes the broker for recovery when the engine config does not set it.
+	defaultCircuitBreakerProbeInterval = 5 * time.Second
+)
+
+// circuitBreaker stops an engine from calling a failing external broker.
+// It opens after threshold consecutive failures; while open, callers fail
+// fast and the engine probes the broker, closing the breaker on the first
+// successful probe.
+type circuitBreaker struct {
+	threshold int
+
+	mu       sync.Mutex
+	open     bool
+	failures int
+	lastErr  error
+	openedAt time.Time
+}
+
+// newCircuitBreaker creates a closed circuit breaker. A threshold of zero uses
+// defaultCircuitBreakerThreshold; a negative threshold disables the breaker,
+// returning nil.
+func newCircuitBreaker(threshold int) *circuitBreaker {
+	if threshold < 0 {
+		return nil
+	}
+	if threshold == 0 {
+		threshold = defaultCircuitBreakerThreshold
+	}
+	return &circuitBreaker{threshold: threshold}
+}
+
+// openCause returns why the breaker is open, or nil when it is closed. A nil
+// breaker is always closed.
+func (b *circuitBreaker) openCause() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.open {
+		return nil
+	}
+	return fmt.Errorf("%w since %s after %d consecutive failures: %w",
+		ErrCircuitBreakerOpen, b.openedAt.Format(time.RFC3339), b.failures, b.lastErr)
+}
+
+// recordSuccess resets the consecutive failure count of a closed breaker.
+func (b *circuitBreaker) recordSuccess() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.open {
+		b.failures = 0
+	}
+}
+
+// recordFailure counts a failed call and reports whether it opened the breaker.
+func (b *circuitBreaker) recordFailure(err error) bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.open {
+		return false
+	}
+	b.failures++
+	b.lastErr = err
+	if b.failures < b.threshold {
+		return false
+	}
+	b.open = true
+	b.openedAt = time.Now()
+	return true
+}
+
+// close closes the breaker after a successful probe.
+func (b *circuitBreaker) close() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.open = false
+	b.failures = 0
+	b.lastErr = nil
+}
@@ -0,0 +1,255 @@
+package eventbus
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errBrokerDown = errors.New("broker down")
+
+func TestCircuitBreaker(t *testing.T) {
+	breaker := newCircuitBreaker(3)
+
+	assert.False(t, breaker.recordFailure(errBrokerDown))
+	breaker.recordSuccess()
+	assert.False(t, breaker.recordFailure(errBrokerDown))
+	assert.False(t, breaker.recordFailure(errBrokerDown))
+	require.NoError(t, breaker.openCause(), "a success resets the consecutive failures")
+
+	assert.True(t, breaker.recordFailure(errBrokerDown))
+	cause := breaker.openCause()
+	require.ErrorIs(t, cause, ErrCircuitBreakerOpen)
+	require.ErrorIs(t, cause, errBrokerDown)
+	assert.False(t, breaker.recordFailure(errBrokerDown), "an open breaker does not open again")
+
+	breaker.close()
+	require.NoError(t, breaker.openCause())
+
+	t.Run("disabled", func(t *testing.T) {
+		var disabled *circuitBreaker = newCircuitBreaker(-1)
+		require.Nil(t, disabled)
+		assert.False(t, disabled.recordFailure(errBrokerDown))
+		require.NoError(t, disabled.openCause())
+	})
+
+	t.Run("default threshold", func(t *testing.T) {
+		assert.Equal(t, defaultCircuitBreakerThreshold, newCircuitBreaker(0).threshold)
+	})
+}
+
+// fakeRedis is a minimal RESP2 server answering PING and PUBLISH. While down,
+// it drops every connection so that client calls fail.
+type fakeRedis struct {
+	listener  net.Listener
+	down      atomic.Bool
+	published atomic.Int64
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &fakeRedis{listener: listener, conns: make(map[net.Conn]struct{})}
+	go server.serve()
+	t.Cleanup(func() {
+		_ = listener.Close()
+		server.setDown(true)
+	})
+	return server
+}
+
+func (s *fakeRedis) url() string {
+	return fmt.Sprintf("redis://%s?max_retries=-1&dial_timeout=100ms", s.listener.Addr())
+}
+
+// setDown switches the server between failing and answering, dropping the
+// open connections when it goes down.
+func (s *fakeRedis) setDown(down bool) {
+	s.down.Store(down)
+	if !down {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.conns {
+		_ = conn.Close()
+		delete(s.conns, conn)
+	}
+}
+
+func (s *fakeRedis) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		if s.down.Load() {
+			_ = conn.Close()
+			continue
+		}
+		s.mu.Lock()
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeRedis) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		args, err := readRESPCommand(reader)
+		if err != nil || s.down.Load() {
+			return
+		}
+		reply := "+OK\r\n"
+		switch strings.ToUpper(args[0]) {
+		case "PING":
+			reply = "+PONG\r\n"
+		case "HELLO":
+			// Make the client fall back to RESP2 without a handshake
+			reply = "-ERR unknown command 'HELLO'\r\n"
+		case "PUBLISH":
+			s.published.Add(1)
+			reply = ":0\r\n"
+		}
+		if _, err := io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+// readRESPCommand reads one command sent as a RESP array of bulk strings.
+func readRESPCommand(reader *bufio.Reader) ([]string, error) {
+	header, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "*")))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		if _, err := reader.ReadString('\n'); err != nil { // $<length>
+			return nil, err
+		}
+		arg, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, strings.TrimSuffix(arg, "\r\n"))
+	}
+	return args, nil
+}
+
+func TestRedisCircuitBreakerRoutesToFallback(t *testing.T) {
+	server := newFakeRedis(t)
+	router, err := NewEngineRouter(&EventBusConfig{
+		Engines: []EngineConfig{
+			{Name: "memory", Type: "memory"},
+			{Name: "redis", Type: "redis", Config: map[string]interface{}{
+				"url":                         server.url(),
+				"circuitBreakerThreshold":     2,
+				"circuitBreakerProbeInterval": "20ms",
+			}},
+		},
+		Routing: []RoutingRule{{Topics: []string{"orders.*"}, Engine: "redis"}},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, router.Start(ctx))
+	defer router.Stop(ctx) //nolint:errcheck
+
+	fallback := make(chan Event, 10)
+	_, err = router.engines["memory"].Subscribe(ctx, "orders.*", func(_ context.Context, e Event) error {
+		fallback <- e
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, router.Publish(ctx, evt112("orders.placed")))
+	assert.Equal(t, int64(1), server.published.Load())
+
+	expectFallback := func(event Event) {
+		t.Helper()
+		select {
+		case received := <-fallback:
+			assert.Equal(t, event.ID(), received.ID())
+		case <-time.After(2 * time.Second):
+			t.Fatal("event was not delivered by the fallback engine")
+		}
+	}
+
+	// Consecutive failures open the breaker; the publish that opens it is
+	// retried on the fallback engine
+	server.setDown(true)
+	require.Error(t, router.Publish(ctx, evt112("orders.placed")))
+	event := evt112("orders.placed")
+	require.NoError(t, router.Publish(ctx, event))
+	expectFallback(event)
+	require.ErrorIs(t, router.UnavailableEngines()["redis"], ErrCircuitBreakerOpen)
+
+	// While open, the topic routes to the fallback engine
+	assert.Equal(t, "memory", router.GetEngineForTopic("orders.placed"))
+	event = evt112("orders.placed")
+	require.NoError(t, router.Publish(ctx, event))
+	expectFallback(event)
+
+	// A successful probe closes the breaker and routes back to Redis
+	server.setDown(false)
+	require.Eventually(t, func() bool {
+		return len(router.UnavailableEngines()) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "redis", router.GetEngineForTopic("orders.placed"))
+	require.NoError(t, router.Publish(ctx, evt112("orders.placed")))
+	assert.Equal(t, int64(2), server.published.Load())
+	assert.Empty(t, fallback)
+}
+
+func TestEventBusModuleHealthCheckCircuitBreakerOpen(t *testing.T) {
+	server := newFakeRedis(t)
+	module, _ := newShutdownModule(t, &EventBusConfig{
+		Engines: []EngineConfig{
+			{Name: "memory", Type: "memory"},
+			{Name: "redis", Type: "redis", Config: map[string]interface{}{
+				"url":                     server.url(),
+				"circuitBreakerThreshold": 1,
+				// Keep the breaker open for the duration of the test
+				"circuitBreakerProbeInterval": time.Minute,
+			}},
+		},
+		Routing: []RoutingRule{{Topics: []string{"orders.*"}, Engine: "redis"}},
+	})
+	defer module.Stop(context.Background()) //nolint:errcheck
+
+	server.setDown(true)
+	// The failing publish opens the breaker and is retried on the fallback engine
+	require.NoError(t, module.Publish(context.Background(), "orders.placed", 1))
+
+	reports, err := module.HealthCheck(context.Background())
+	require.NoError(t, err)
+	statuses := make(map[string]modular.HealthStatus, len(reports))
+	for _, report := range reports {
+		statuses[report.Component] = report.Status
+	}
+	assert.Equal(t, modular.StatusDegraded, statuses["redis"])
+	assert.Equal(t, modular.StatusHealthy, statuses["memory"])
+}
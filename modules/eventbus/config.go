@@ -10,6 +10,17 @@ import (
 var (
 	ErrDuplicateEngineName = errors.New("duplicate engine name")
 	ErrUnknownEngineRef    = errors.New("routing rule references unknown engine")
+
+	ErrInvalidDeadLetterRetries = errors.New("dead-letter maxRetries must not be negative")
+	ErrInvalidDeadLetterBackoff = errors.New("dead-letter retry backoff must not be negative")
+	ErrInvalidOverflowPolicy    = errors.New("overflowPolicy must be block, dropNewest or dropOldest")
+)
+
+// Overflow policies of the memory engine, see EventBusConfig.OverflowPolicy
+const (
+	OverflowBlock      = "block"
+	OverflowDropNewest = "dropNewest"
+	OverflowDropOldest = "dropOldest"
 )
 
 // EngineConfig defines the configuration for an individual event bus engine.
@@ -26,6 +37,31 @@ type EngineConfig struct {
 	// Config contains engine-specific configuration as a map.
 	// The structure depends on the engine type.
 	Config map[string]interface{} `json:"config,omitempty" yaml:"config,omitempty"`
+
+	// URL, Username and Password override the "url", "username" and
+	// "password" entries of Config when set. Unlike Config they can be fed
+	// per engine from EVENTBUS_<ENGINE>_URL, EVENTBUS_<ENGINE>_USERNAME and
+	// EVENTBUS_<ENGINE>_PASSWORD environment variables, so connection
+	// details need not be stored in configuration files.
+	// Used by the redis and nats engines.
+	URL      string `json:"url,omitempty" yaml:"url,omitempty" env:"URL"`
+	Username string `json:"username,omitempty" yaml:"username,omitempty" env:"USERNAME"`
+	Password string `json:"password,omitempty" yaml:"password,omitempty" env:"PASSWORD"` //nolint:gosec // config field, not a hardcoded secret
+}
+
+// engineSettings returns the engine-specific configuration with the
+// connection overrides applied. Config itself is left untouched.
+func (e *EngineConfig) engineSettings() map[string]interface{} {
+	settings := make(map[string]interface{}, len(e.Config)+3)
+	for k, v := range e.Config {
+		settings[k] = v
+	}
+	for key, value := range map[string]string{"url": e.URL, "username": e.Username, "password": e.Password} {
+		if value != "" {
+			settings[key] = value
+		}
+	}
+	return settings
 }
 
 // RoutingRule defines how topics are routed to engines.
@@ -37,6 +73,23 @@ type RoutingRule struct {
 	// Engine is the name of the engine to route matching topics to.
 	// Must match the name of a configured engine.
 	Engine string `json:"engine" yaml:"engine" validate:"required"`
+
+	// Ordered delivers the matching topics to each subscriber in publish
+	// order, also for SubscribeAsync. The memory engine then handles the
+	// topics of async subscriptions on the subscription's own goroutine
+	// instead of its concurrent worker pool. In single-engine mode Engine is
+	// ignored and ordered rules apply to the only engine.
+	Ordered bool `json:"ordered,omitempty" yaml:"ordered,omitempty"`
+}
+
+// TenantRoutingRule routes all events of some tenants to a dedicated engine.
+type TenantRoutingRule struct {
+	// Tenants is a list of tenant IDs whose events use Engine.
+	Tenants []string `json:"tenants" yaml:"tenants" validate:"required,min=1"`
+
+	// Engine is the name of the engine to route the tenants' events to.
+	// Must match the name of a configured engine.
+	Engine string `json:"engine" yaml:"engine" validate:"required"`
 }
 
 // EventBusConfig defines the configuration for the event bus module.
@@ -66,6 +119,9 @@ type RoutingRule struct {
 //	    engine: "memory"
 //	  - topics: ["*"]
 //	    engine: "redis"
+//	tenantRouting:
+//	  - tenants: ["acme"]
+//	    engine: "memory"
 type EventBusConfig struct {
 	// Source is the CloudEvents source identifier for this service.
 	// Used by NewEvent to automatically populate the source field.
@@ -109,6 +165,22 @@ type EventBusConfig struct {
 	// PublishBlockTimeout is used when DeliveryMode == "timeout". Zero means no wait.
 	PublishBlockTimeout time.Duration `json:"publishBlockTimeout,omitempty" yaml:"publishBlockTimeout,omitempty" env:"PUBLISH_BLOCK_TIMEOUT"`
 
+	// ShutdownTimeout bounds how long Stop waits for each in-process engine
+	// (memory, custom, durable-memory) to handle its queued and in-flight
+	// events before stopping it. Events left after the timeout are dropped
+	// and Stop returns ErrShutdownFlushTimeout. Defaults to 5s.
+	ShutdownTimeout time.Duration `json:"shutdownTimeout,omitempty" yaml:"shutdownTimeout,omitempty" env:"SHUTDOWN_TIMEOUT"`
+
+	// OverflowPolicy controls what the memory engine does with an event when a
+	// subscriber queue is full, replacing DeliveryMode when set:
+	//   block      - wait for space; Publish returns ErrPublishCancelled if its
+	//                context is done first
+	//   dropNewest - drop the event being published (DeliveryMode "drop")
+	//   dropOldest - evict the oldest queued event to make room for the new one
+	// Every dropped event is counted in the dropped stats and emitted as an
+	// EventTypeMessageDropped event.
+	OverflowPolicy string `json:"overflowPolicy,omitempty" yaml:"overflowPolicy,omitempty" validate:"omitempty,oneof=block dropNewest dropOldest" env:"OVERFLOW_POLICY"`
+
 	// MaxDurableQueueDepth is the per-subscriber queue depth for the "durable-memory" engine.
 	// When a subscriber's queue is full, publishers block (backpressure) until the subscriber
 	// consumes an event, ensuring zero event loss.
@@ -151,6 +223,10 @@ type EventBusConfig struct {
 	// This should be kept secure and may be provided via environment variables.
 	ExternalBrokerPassword string `json:"externalBrokerPassword,omitempty" yaml:"externalBrokerPassword,omitempty" env:"EXTERNAL_BROKER_PASSWORD"`
 
+	// DeadLetter configures retrying of failed handlers and republishing of
+	// events that still fail to a dead-letter topic. It applies to every engine.
+	DeadLetter DeadLetterConfig `json:"deadLetter,omitempty" yaml:"deadLetter,omitempty"`
+
 	// --- Multi-Engine Configuration (New) ---
 
 	// Engines defines multiple event bus engines that can be used simultaneously.
@@ -162,6 +238,78 @@ type EventBusConfig struct {
 	// If no routing rules are specified and multiple engines are configured,
 	// all topics will be routed to the first engine.
 	Routing []RoutingRule `json:"routing,omitempty" yaml:"routing,omitempty" validate:"dive"`
+
+	// TenantRouting routes the events of specific tenants to dedicated engines,
+	// taking precedence over Routing. The tenant is taken from the event's
+	// ExtensionTenantID attribute when publishing and from the context when
+	// subscribing, so tenant subscribers must subscribe with a tenant context.
+	TenantRouting []TenantRoutingRule `json:"tenantRouting,omitempty" yaml:"tenantRouting,omitempty" validate:"dive"`
+}
+
+// DeadLetterConfig defines dead-letter handling for failed event handlers.
+//
+// Example YAML configuration:
+//
+//	deadLetter:
+//	  enabled: true
+//	  topic: "orders.deadletter"
+//	  maxRetries: 3
+//	  retryBackoff: 200ms
+type DeadLetterConfig struct {
+	// Enabled turns on retries and dead-lettering for handler errors.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+
+	// Topic is the topic failed events are republished to.
+	// Default: "eventbus.deadletter"
+	Topic string `json:"topic,omitempty" yaml:"topic,omitempty"`
+
+	// MaxRetries is the number of times a failed handler is retried before the
+	// event is dead-lettered. Zero dead-letters after the first failure.
+	MaxRetries int `json:"maxRetries,omitempty" yaml:"maxRetries,omitempty" validate:"omitempty,min=0"`
+
+	// RetryBackoff is the delay before the first retry; each further retry
+	// doubles it, up to MaxRetryBackoff.
+	// Default: 100ms
+	RetryBackoff time.Duration `json:"retryBackoff,omitempty" yaml:"retryBackoff,omitempty"`
+
+	// MaxRetryBackoff caps the delay between retries.
+	// Default: 5s
+	MaxRetryBackoff time.Duration `json:"maxRetryBackoff,omitempty" yaml:"maxRetryBackoff,omitempty"`
+}
+
+// topic returns the dead-letter topic, applying the default.
+func (c DeadLetterConfig) topic() string {
+	if c.Topic == "" {
+		return DefaultDeadLetterTopic
+	}
+	return c.Topic
+}
+
+// retryBackoff returns the delay before the first retry, applying the default.
+func (c DeadLetterConfig) retryBackoff() time.Duration {
+	if c.RetryBackoff <= 0 {
+		return DefaultDeadLetterRetryBackoff
+	}
+	return c.RetryBackoff
+}
+
+// maxRetryBackoff returns the maximum delay between retries, applying the default.
+func (c DeadLetterConfig) maxRetryBackoff() time.Duration {
+	if c.MaxRetryBackoff <= 0 {
+		return max(DefaultDeadLetterMaxRetryBackoff, c.retryBackoff())
+	}
+	return max(c.MaxRetryBackoff, c.retryBackoff())
+}
+
+// GetInstanceConfigs returns the configured engines keyed by name for
+// instance-aware configuration. The returned values point into Engines so
+// that EVENTBUS_<ENGINE>_* environment variables can be fed into them.
+func (c *EventBusConfig) GetInstanceConfigs() map[string]interface{} {
+	instances := make(map[string]interface{}, len(c.Engines))
+	for i := range c.Engines {
+		instances[c.Engines[i].Name] = &c.Engines[i]
+	}
+	return instances
 }
 
 // IsMultiEngine returns true if this configuration uses multiple engines.
@@ -200,6 +348,11 @@ func (c *EventBusConfig) ValidateConfig() error {
 				return fmt.Errorf("%w: %s", ErrUnknownEngineRef, rule.Engine)
 			}
 		}
+		for _, rule := range c.TenantRouting {
+			if _, exists := engineNames[rule.Engine]; !exists {
+				return fmt.Errorf("%w: %s", ErrUnknownEngineRef, rule.Engine)
+			}
+		}
 	} else {
 		// Validate single-engine configuration has required fields
 		if c.Engine == "" {
@@ -229,6 +382,24 @@ func (c *EventBusConfig) ValidateConfig() error {
 		}
 	}
 
+	if c.DeadLetter.Enabled {
+		if c.DeadLetter.MaxRetries < 0 {
+			return fmt.Errorf("%w: %d", ErrInvalidDeadLetterRetries, c.DeadLetter.MaxRetries)
+		}
+		if c.DeadLetter.RetryBackoff < 0 {
+			return fmt.Errorf("%w: retryBackoff %v", ErrInvalidDeadLetterBackoff, c.DeadLetter.RetryBackoff)
+		}
+		if c.DeadLetter.MaxRetryBackoff < 0 {
+			return fmt.Errorf("%w: maxRetryBackoff %v", ErrInvalidDeadLetterBackoff, c.DeadLetter.MaxRetryBackoff)
+		}
+	}
+
+	switch c.OverflowPolicy {
+	case "", OverflowBlock, OverflowDropNewest, OverflowDropOldest:
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidOverflowPolicy, c.OverflowPolicy)
+	}
+
 	// Default source if not specified
 	if c.Source == "" {
 		c.Source = "eventbus"
@@ -0,0 +1,63 @@
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventBusConfig_GetInstanceConfigs_ReturnsOriginalPointers(t *testing.T) {
+	config := &EventBusConfig{
+		Engines: []EngineConfig{
+			{Name: "events", Type: "redis"},
+			{Name: "jobs", Type: "nats"},
+		},
+	}
+
+	instances := config.GetInstanceConfigs()
+	require.Len(t, instances, 2)
+
+	events, ok := instances["events"].(*EngineConfig)
+	require.True(t, ok, "expected *EngineConfig, got %T", instances["events"])
+	assert.Same(t, &config.Engines[0], events)
+}
+
+// TestEventBusConfig_InstanceAwareFeeding verifies that EVENTBUS_<ENGINE>_* environment
+// variables are fed into the matching engine and override its engine-specific config.
+func TestEventBusConfig_InstanceAwareFeeding(t *testing.T) {
+	envVars := map[string]string{
+		"EVENTBUS_EVENTS_URL":      "redis://events:6379",
+		"EVENTBUS_EVENTS_PASSWORD": "secret",
+		"EVENTBUS_JOBS_URL":        "nats://jobs:4222",
+	}
+	for key, value := range envVars {
+		t.Setenv(key, value)
+	}
+
+	config := &EventBusConfig{
+		Engines: []EngineConfig{
+			{Name: "events", Type: "redis", Config: map[string]interface{}{"url": "redis://localhost:6379", "db": 1}},
+			{Name: "jobs", Type: "nats"},
+		},
+	}
+
+	feeder := modular.NewInstanceAwareEnvFeeder(func(instanceKey string) string {
+		return "EVENTBUS_" + instanceKey + "_"
+	})
+	for instanceKey, instanceConfig := range config.GetInstanceConfigs() {
+		require.NoError(t, feeder.FeedKey(instanceKey, instanceConfig), "feeding engine %s", instanceKey)
+	}
+
+	events := config.Engines[0]
+	assert.Equal(t, map[string]interface{}{
+		"url":      "redis://events:6379",
+		"password": "secret",
+		"db":       1,
+	}, events.engineSettings())
+	assert.Equal(t, "redis://localhost:6379", events.Config["url"], "the configured map is not modified")
+
+	jobs := config.Engines[1]
+	assert.Equal(t, map[string]interface{}{"url": "nats://jobs:4222"}, jobs.engineSettings())
+}
@@ -26,6 +26,7 @@ type CustomMemoryEventBus struct {
 	wg             sync.WaitGroup // tracks handler goroutines for deterministic shutdown
 	deliveredCount uint64         // stats
 	droppedCount   uint64         // stats
+	attemptedCount uint64         // deliveries attempted by Publish, each ends up delivered or dropped
 }
 
 // CustomMemoryConfig holds configuration for the custom memory engine
@@ -322,6 +323,7 @@ func (c *CustomMemoryEventBus) Publish(ctx context.Context, event Event) error {
 		}
 		sub.mutex.RUnlock()
 
+		atomic.AddUint64(&c.attemptedCount, 1)
 		select {
 		case sub.eventCh <- event:
 			// Event sent to subscriber
@@ -461,18 +463,7 @@ func (c *CustomMemoryEventBus) SubscriberCount(topic string) int {
 
 // matchesTopic checks if an event topic matches a subscription topic pattern
 func (c *CustomMemoryEventBus) matchesTopic(eventTopic, subscriptionTopic string) bool {
-	// Exact match
-	if eventTopic == subscriptionTopic {
-		return true
-	}
-
-	// Wildcard match
-	if len(subscriptionTopic) > 1 && subscriptionTopic[len(subscriptionTopic)-1] == '*' {
-		prefix := subscriptionTopic[:len(subscriptionTopic)-1]
-		return len(eventTopic) >= len(prefix) && eventTopic[:len(prefix)] == prefix
-	}
-
-	return false
+	return matchesTopic(eventTopic, subscriptionTopic)
 }
 
 // handleEvents processes events for a custom subscription
@@ -565,6 +556,28 @@ func (c *CustomMemoryEventBus) Stats() (delivered uint64, dropped uint64) {
 	return atomic.LoadUint64(&c.deliveredCount), atomic.LoadUint64(&c.droppedCount)
 }
 
+// flush waits until every delivery attempted by Publish has been handled or
+// dropped, mirroring MemoryEventBus.flush.
+func (c *CustomMemoryEventBus) flush(ctx context.Context) error {
+	return waitUntil(ctx, func() bool {
+		delivered, dropped := c.Stats()
+		return delivered+dropped >= atomic.LoadUint64(&c.attemptedCount)
+	})
+}
+
+// queuedEvents returns the number of events waiting in subscriber queues.
+func (c *CustomMemoryEventBus) queuedEvents() int {
+	c.topicMutex.RLock()
+	defer c.topicMutex.RUnlock()
+	queued := 0
+	for _, subs := range c.subscriptions {
+		for _, sub := range subs {
+			queued += len(sub.eventCh)
+		}
+	}
+	return queued
+}
+
 // metricsCollector periodically logs metrics
 func (c *CustomMemoryEventBus) metricsCollector() {
 	defer func() {
@@ -0,0 +1,107 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultDeadLetterTopic is the dead-letter topic used when DeadLetterConfig.Topic is empty.
+const DefaultDeadLetterTopic = "eventbus.deadletter"
+
+// Dead-letter retry backoff defaults, used when DeadLetterConfig.RetryBackoff
+// and MaxRetryBackoff are zero.
+const (
+	DefaultDeadLetterRetryBackoff    = 100 * time.Millisecond
+	DefaultDeadLetterMaxRetryBackoff = 5 * time.Second
+)
+
+// CloudEvents extension attributes describing a dead-lettered event. The
+// dead-letter event keeps the data and content type of the failed event.
+// Extension names must be lowercase alphanumeric per the CloudEvents spec.
+const (
+	// ExtensionOriginalTopic carries the topic the failed event was published to.
+	ExtensionOriginalTopic = "originaltopic"
+
+	// ExtensionOriginalID carries the CloudEvents ID of the failed event.
+	ExtensionOriginalID = "originalid"
+
+	// ExtensionOriginalSource carries the CloudEvents source of the failed event.
+	ExtensionOriginalSource = "originalsource"
+
+	// ExtensionDeadLetterError carries the error returned by the last handler attempt.
+	ExtensionDeadLetterError = "deadlettererror"
+
+	// ExtensionDeadLetterAttempts carries the number of times the handler was invoked.
+	ExtensionDeadLetterAttempts = "deadletterattempts"
+)
+
+// withDeadLetter wraps handler so that errors are retried up to
+// DeadLetter.MaxRetries times, with exponential backoff, and the event is then
+// republished to the dead-letter topic. Wrapping happens at the module level
+// so every engine, including memory and redis, honors the same policy.
+func (m *EventBusModule) withDeadLetter(handler EventHandler) EventHandler {
+	if handler == nil || m.config == nil || !m.config.DeadLetter.Enabled {
+		return handler
+	}
+	cfg := m.config.DeadLetter
+	topic := cfg.topic()
+
+	return func(ctx context.Context, event Event) error {
+		// Never dead-letter failures of dead-letter events to avoid loops
+		if event.Type() == topic {
+			return handler(ctx, event)
+		}
+
+		var err error
+		attempts := 0
+		backoff := cfg.retryBackoff()
+		for {
+			attempts++
+			if err = handler(ctx, event); err == nil {
+				return nil
+			}
+			if attempts > cfg.MaxRetries || !sleepContext(ctx, backoff) {
+				break
+			}
+			backoff = min(2*backoff, cfg.maxRetryBackoff())
+		}
+
+		deadLetter := event.Clone()
+		deadLetter.SetType(topic)
+		deadLetter.SetID(uuid.New().String())
+		deadLetter.SetSource(m.config.Source)
+		deadLetter.SetTime(time.Now())
+		deadLetter.SetExtension(ExtensionOriginalTopic, event.Type())
+		deadLetter.SetExtension(ExtensionOriginalID, event.ID())
+		deadLetter.SetExtension(ExtensionOriginalSource, event.Source())
+		deadLetter.SetExtension(ExtensionDeadLetterError, err.Error())
+		deadLetter.SetExtension(ExtensionDeadLetterAttempts, attempts)
+
+		if pubErr := m.publishEvent(ctx, deadLetter); pubErr != nil {
+			if m.logger != nil {
+				m.logger.Error("Failed to publish event to dead-letter topic",
+					"topic", event.Type(), "deadLetterTopic", topic, "error", pubErr)
+			}
+			return fmt.Errorf("handler failed after %d attempts: %w (dead-letter publish failed: %w)", attempts, err, pubErr)
+		}
+		return fmt.Errorf("handler failed after %d attempts, sent to dead-letter topic %s: %w", attempts, topic, err)
+	}
+}
+
+// sleepContext waits for d, returning false if ctx is done first.
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
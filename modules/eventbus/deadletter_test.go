@@ -0,0 +1,138 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeadLetterTestModule(t *testing.T, dl DeadLetterConfig) *EventBusModule {
+	t.Helper()
+	module := NewModule().(*EventBusModule)
+	app := newMockApp()
+	app.RegisterConfigSection(ModuleName, modular.NewStdConfigProvider(&EventBusConfig{
+		Engine:     "memory",
+		DeadLetter: dl,
+	}))
+	require.NoError(t, module.Init(app))
+	require.NoError(t, module.Start(context.Background()))
+	t.Cleanup(func() { _ = module.Stop(context.Background()) })
+	return module
+}
+
+func TestDeadLetterPoisonMessage(t *testing.T) {
+	ctx := context.Background()
+	module := newDeadLetterTestModule(t, DeadLetterConfig{
+		Enabled:      true,
+		Topic:        "orders.dlq",
+		MaxRetries:   2,
+		RetryBackoff: 20 * time.Millisecond,
+	})
+
+	dead := make(chan Event, 1)
+	_, err := module.Subscribe(ctx, "orders.dlq", func(ctx context.Context, event Event) error {
+		dead <- event
+		return nil
+	})
+	require.NoError(t, err)
+
+	var attempts atomic.Int32
+	_, err = module.SubscribeAsync(ctx, "orders.created", func(ctx context.Context, event Event) error {
+		attempts.Add(1)
+		return errors.New("poison message")
+	})
+	require.NoError(t, err)
+
+	published := time.Now()
+	require.NoError(t, module.Publish(ctx, "orders.created", map[string]string{"order": "42"}))
+
+	select {
+	case event := <-dead:
+		// Retries back off 20ms then 40ms before dead-lettering
+		assert.GreaterOrEqual(t, time.Since(published), 60*time.Millisecond)
+
+		extensions := event.Extensions()
+		assert.Equal(t, "orders.dlq", event.Type())
+		assert.Equal(t, "orders.created", extensions[ExtensionOriginalTopic])
+		assert.Equal(t, "poison message", extensions[ExtensionDeadLetterError])
+		assert.EqualValues(t, 3, extensions[ExtensionDeadLetterAttempts])
+		assert.NotEmpty(t, extensions[ExtensionOriginalID])
+		assert.NotEqual(t, extensions[ExtensionOriginalID], event.ID())
+
+		var data map[string]string
+		require.NoError(t, event.DataAs(&data))
+		assert.Equal(t, map[string]string{"order": "42"}, data)
+	case <-time.After(2 * time.Second):
+		t.Fatal("poison message did not reach the dead-letter topic")
+	}
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestDeadLetterRetrySucceeds(t *testing.T) {
+	ctx := context.Background()
+	module := newDeadLetterTestModule(t, DeadLetterConfig{Enabled: true, MaxRetries: 3, RetryBackoff: time.Millisecond})
+
+	var deadLettered atomic.Int32
+	_, err := module.Subscribe(ctx, DefaultDeadLetterTopic, func(ctx context.Context, event Event) error {
+		deadLettered.Add(1)
+		return nil
+	})
+	require.NoError(t, err)
+
+	var attempts atomic.Int32
+	done := make(chan struct{})
+	_, err = module.Subscribe(ctx, "flaky", func(ctx context.Context, event Event) error {
+		if attempts.Add(1) < 2 {
+			return errors.New("transient")
+		}
+		close(done)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, module.Publish(ctx, "flaky", "payload"))
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("handler did not succeed on retry")
+	}
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(2), attempts.Load())
+	assert.Zero(t, deadLettered.Load())
+}
+
+func TestDeadLetterConfigValidation(t *testing.T) {
+	cfg := &EventBusConfig{DeadLetter: DeadLetterConfig{Enabled: true, MaxRetries: -1}}
+	require.ErrorIs(t, cfg.ValidateConfig(), ErrInvalidDeadLetterRetries)
+
+	cfg = &EventBusConfig{DeadLetter: DeadLetterConfig{Enabled: true, RetryBackoff: -time.Second}}
+	err := cfg.ValidateConfig()
+	require.ErrorIs(t, err, ErrInvalidDeadLetterBackoff)
+	assert.Contains(t, err.Error(), "retryBackoff -1s")
+
+	cfg = &EventBusConfig{DeadLetter: DeadLetterConfig{Enabled: true, RetryBackoff: time.Second, MaxRetryBackoff: -time.Minute}}
+	err = cfg.ValidateConfig()
+	require.ErrorIs(t, err, ErrInvalidDeadLetterBackoff)
+	assert.Contains(t, err.Error(), "maxRetryBackoff -1m0s", "the invalid field is reported")
+
+	// Validation applies no defaults; the default topic is resolved at use
+	cfg = &EventBusConfig{DeadLetter: DeadLetterConfig{Enabled: true}}
+	require.NoError(t, cfg.ValidateConfig())
+	assert.Empty(t, cfg.DeadLetter.Topic)
+	assert.Equal(t, DefaultDeadLetterTopic, cfg.DeadLetter.topic())
+}
+
+func TestDeadLetterBackoffCapped(t *testing.T) {
+	cfg := DeadLetterConfig{RetryBackoff: time.Second, MaxRetryBackoff: 10 * time.Millisecond}
+	assert.Equal(t, time.Second, cfg.maxRetryBackoff())
+
+	cfg = DeadLetterConfig{}
+	assert.Equal(t, DefaultDeadLetterRetryBackoff, cfg.retryBackoff())
+	assert.Equal(t, DefaultDeadLetterMaxRetryBackoff, cfg.maxRetryBackoff())
+}
@@ -174,6 +174,7 @@ type DurableMemoryEventBus struct {
 	isStarted      atomic.Bool
 	module         *EventBusModule
 	deliveredCount uint64
+	handling       atomic.Int64 // subscriptions popping or handling an event
 }
 
 // NewDurableMemoryEventBus is the engine factory for "durable-memory".
@@ -416,6 +417,41 @@ func (d *DurableMemoryEventBus) Stats() (delivered uint64) {
 	return atomic.LoadUint64(&d.deliveredCount)
 }
 
+// handleEvent invokes the subscription's handler for an event popped after
+// raising handling, which it lowers once done.
+func (d *DurableMemoryEventBus) handleEvent(sub *durableSub, event Event) {
+	defer d.handling.Add(-1)
+	err := sub.handler(d.ctx, event)
+	if err != nil {
+		slog.Error("Durable event handler failed",
+			"error", err,
+			"topic", event.Type(),
+			"subscription_id", sub.id)
+	}
+	atomic.AddUint64(&d.deliveredCount, 1)
+}
+
+// flush waits until the subscriber queues are empty and no event is being
+// handled.
+func (d *DurableMemoryEventBus) flush(ctx context.Context) error {
+	return waitUntil(ctx, func() bool {
+		return d.queuedEvents() == 0 && d.handling.Load() == 0
+	})
+}
+
+// queuedEvents returns the number of events waiting in subscriber queues.
+func (d *DurableMemoryEventBus) queuedEvents() int {
+	d.topicMutex.RLock()
+	defer d.topicMutex.RUnlock()
+	queued := 0
+	for _, subs := range d.subscriptions {
+		for _, sub := range subs {
+			queued += sub.queue.Len()
+		}
+	}
+	return queued
+}
+
 // handleEvents is the per-subscription event dispatch loop.
 // It drains the subscription's durableQueue and invokes the handler for each event.
 // The loop exits when the bus context is cancelled or the subscription is cancelled.
@@ -428,21 +464,19 @@ func (d *DurableMemoryEventBus) handleEvents(sub *durableSub) {
 			return
 		}
 
-		// Fast path: drain any available events without blocking.
+		// Fast path: drain any available events without blocking. handling is
+		// raised before popping so flush never sees an event neither queued
+		// nor being handled.
+		d.handling.Add(1)
 		if event, ok := sub.queue.TryPop(); ok {
 			if sub.isCancelled() {
+				d.handling.Add(-1)
 				return
 			}
-			err := sub.handler(d.ctx, event)
-			if err != nil {
-				slog.Error("Durable event handler failed",
-					"error", err,
-					"topic", event.Type(),
-					"subscription_id", sub.id)
-			}
-			atomic.AddUint64(&d.deliveredCount, 1)
+			d.handleEvent(sub, event)
 			continue
 		}
+		d.handling.Add(-1)
 
 		// Queue is empty; wait for the next push notification or shutdown.
 		select {
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
 )
 
 // Static errors for engine registry
@@ -12,6 +15,7 @@ var (
 	ErrUnknownEngineType    = errors.New("unknown engine type")
 	ErrEngineNotFound       = errors.New("engine not found")
 	ErrSubscriptionNotFound = errors.New("subscription not found in any engine")
+	ErrNoFallbackEngine     = errors.New("engine unavailable and no fallback engine is available")
 )
 
 // EngineFactory is a function that creates an EventBus implementation.
@@ -43,36 +47,62 @@ func GetRegisteredEngines() []string {
 }
 
 // EngineRouter manages multiple event bus engines and routes events based on configuration.
+//
+// In multi-engine mode, engines backed by external brokers (Redis, NATS) that
+// cannot be reached are marked unavailable instead of failing the whole bus.
+// Routing rules that target an unavailable engine are skipped, so matching
+// topics fall through to later rules (typically the "*" wildcard rule) or the
+// default engine.
 type EngineRouter struct {
 	engines       map[string]EventBus // Map of engine name to EventBus instance
 	routing       []RoutingRule       // Routing rules in order of precedence
+	tenantRouting []TenantRoutingRule // Tenant routing rules, checked before routing
 	defaultEngine string              // Default engine name for unmatched topics
+
+	unavailable      map[string]error // Engines that could not reach their broker, keyed by name
+	unavailableMutex sync.RWMutex
+
+	counters map[string]*engineCounters // Publish and handler failure counters, keyed by engine name
 }
 
 // NewEngineRouter creates a new engine router with the given configuration.
 func NewEngineRouter(config *EventBusConfig) (*EngineRouter, error) {
 	router := &EngineRouter{
 		engines:       make(map[string]EventBus),
+		counters:      make(map[string]*engineCounters),
 		routing:       config.Routing,
+		tenantRouting: config.TenantRouting,
 		defaultEngine: config.GetDefaultEngine(),
+		unavailable:   make(map[string]error),
 	}
 
 	if config.IsMultiEngine() {
 		// Create engines from multi-engine configuration
 		for _, engineConfig := range config.Engines {
-			engine, err := createEngine(engineConfig.Type, engineConfig.Config)
+			engine, err := createEngine(engineConfig.Type, engineConfig.engineSettings())
 			if err != nil {
+				if errors.Is(err, ErrEngineUnavailable) {
+					// Broker unreachable: route around this engine instead of failing
+					router.unavailable[engineConfig.Name] = err
+					continue
+				}
 				return nil, fmt.Errorf("failed to create engine %s (%s): %w",
 					engineConfig.Name, engineConfig.Type, err)
 			}
 			router.engines[engineConfig.Name] = engine
 		}
+		if err := router.checkFallbackAvailable(); err != nil {
+			return nil, err
+		}
 	} else {
 		// Create single engine from legacy configuration
 		engineConfig := map[string]interface{}{
 			"maxEventQueueSize":      config.MaxEventQueueSize,
 			"defaultEventBufferSize": config.DefaultEventBufferSize,
 			"workerCount":            config.WorkerCount,
+			"deliveryMode":           config.DeliveryMode,
+			"publishBlockTimeout":    config.PublishBlockTimeout,
+			"overflowPolicy":         config.OverflowPolicy,
 			"eventTTL":               config.EventTTL,
 			"retentionDays":          config.RetentionDays,
 			"externalBrokerURL":      config.ExternalBrokerURL,
@@ -86,10 +116,36 @@ func NewEngineRouter(config *EventBusConfig) (*EngineRouter, error) {
 		}
 		router.engines["default"] = engine
 	}
+	router.applyOrderedRouting(config.IsMultiEngine())
+	for name := range router.engines {
+		router.counters[name] = &engineCounters{}
+	}
+	if !config.IsMultiEngine() {
+		// With a single engine routing rules only select ordered topics
+		router.routing = nil
+	}
 
 	return router, nil
 }
 
+// applyOrderedRouting passes the topics of ordered routing rules to the memory
+// engines they target. Other engines already deliver each subscription's
+// events in order.
+func (r *EngineRouter) applyOrderedRouting(multiEngine bool) {
+	for _, rule := range r.routing {
+		if !rule.Ordered {
+			continue
+		}
+		name := rule.Engine
+		if !multiEngine {
+			name = "default"
+		}
+		if memoryEngine, ok := r.engines[name].(*MemoryEventBus); ok {
+			memoryEngine.SetOrderedTopics(rule.Topics...)
+		}
+	}
+}
+
 // createEngine creates an engine instance using the registered factory.
 func createEngine(engineType string, config map[string]interface{}) (EventBus, error) {
 	factory, exists := engineRegistry[engineType]
@@ -114,13 +170,78 @@ func (r *EngineRouter) SetModuleReference(module *EventBusModule) {
 }
 
 // Start starts all managed engines.
+// Engines that fail with ErrEngineUnavailable are marked unavailable and their
+// topics are routed to the fallback engine, as long as the default engine and
+// at least one fallback remain available.
 func (r *EngineRouter) Start(ctx context.Context) error {
 	for name, engine := range r.engines {
 		if err := engine.Start(ctx); err != nil {
+			if errors.Is(err, ErrEngineUnavailable) && len(r.engines) > 1 {
+				r.unavailableMutex.Lock()
+				r.unavailable[name] = err
+				r.unavailableMutex.Unlock()
+				continue
+			}
 			return fmt.Errorf("failed to start engine %s: %w", name, err)
 		}
 	}
-	return nil
+	return r.checkFallbackAvailable()
+}
+
+// checkFallbackAvailable verifies that the default engine is usable so that
+// topics routed away from unavailable engines still have somewhere to go.
+func (r *EngineRouter) checkFallbackAvailable() error {
+	if r.isEngineAvailable(r.defaultEngine) {
+		return nil
+	}
+	r.unavailableMutex.RLock()
+	defer r.unavailableMutex.RUnlock()
+	if cause, ok := r.unavailable[r.defaultEngine]; ok {
+		return fmt.Errorf("%w: default engine %s: %w", ErrNoFallbackEngine, r.defaultEngine, cause)
+	}
+	return fmt.Errorf("%w: default engine %s", ErrNoFallbackEngine, r.defaultEngine)
+}
+
+// availabilityReporter is implemented by engines that can lose their external
+// broker after start, such as the Redis engine with its circuit breaker.
+type availabilityReporter interface {
+	// unavailableCause returns why the engine cannot currently be used, or nil.
+	unavailableCause() error
+}
+
+// isEngineAvailable reports whether the named engine has not been marked
+// unavailable and does not report itself unavailable.
+func (r *EngineRouter) isEngineAvailable(name string) bool {
+	r.unavailableMutex.RLock()
+	_, down := r.unavailable[name]
+	r.unavailableMutex.RUnlock()
+	if down {
+		return false
+	}
+	if reporter, ok := r.engines[name].(availabilityReporter); ok {
+		return reporter.unavailableCause() == nil
+	}
+	return true
+}
+
+// UnavailableEngines returns the engines that could not reach their external
+// broker and are currently bypassed by routing, keyed by engine name with the
+// error that made each one unavailable.
+func (r *EngineRouter) UnavailableEngines() map[string]error {
+	r.unavailableMutex.RLock()
+	defer r.unavailableMutex.RUnlock()
+	result := make(map[string]error, len(r.unavailable))
+	for name, err := range r.unavailable {
+		result[name] = err
+	}
+	for name, engine := range r.engines {
+		if reporter, ok := engine.(availabilityReporter); ok {
+			if cause := reporter.unavailableCause(); cause != nil {
+				result[name] = fmt.Errorf("%w: %w", ErrEngineUnavailable, cause)
+			}
+		}
+	}
+	return result
 }
 
 // Stop stops all managed engines.
@@ -135,29 +256,43 @@ func (r *EngineRouter) Stop(ctx context.Context) error {
 }
 
 // Publish publishes an event to the appropriate engine based on routing rules.
+// Events stamped with a tenant that has a tenant routing rule go to that
+// tenant's engine.
 func (r *EngineRouter) Publish(ctx context.Context, event Event) error {
-	engineName := r.getEngineForTopic(event.Type())
+	tenantID, _ := TenantIDFromEvent(event)
+	engineName := r.getEngine(event.Type(), tenantID)
 	engine, exists := r.engines[engineName]
 	if !exists {
 		return fmt.Errorf("%w for topic %s: %s", ErrEngineNotFound, event.Type(), engineName)
 	}
 
-	if err := engine.Publish(ctx, event); err != nil {
+	err := engine.Publish(ctx, event)
+	if errors.Is(err, ErrEngineUnavailable) {
+		// The engine lost its broker (e.g. its circuit breaker opened), so
+		// routing now resolves the topic to a fallback engine
+		if fallback := r.getEngine(event.Type(), tenantID); fallback != engineName {
+			engineName, engine = fallback, r.engines[fallback]
+			err = engine.Publish(ctx, event)
+		}
+	}
+	if err != nil {
 		return fmt.Errorf("publishing to engine %s: %w", engineName, err)
 	}
+	r.counters[engineName].recordPublish()
 	return nil
 }
 
 // Subscribe subscribes to a topic using the appropriate engine.
-// The subscription is created on the engine that handles the specified topic.
+// The subscription is created on the engine that handles the specified topic,
+// or on the tenant's engine when ctx carries a tenant with a tenant routing rule.
 func (r *EngineRouter) Subscribe(ctx context.Context, topic string, handler EventHandler) (Subscription, error) {
-	engineName := r.getEngineForTopic(topic)
+	engineName := r.getEngineForContext(ctx, topic)
 	engine, exists := r.engines[engineName]
 	if !exists {
 		return nil, fmt.Errorf("%w for topic %s: %s", ErrEngineNotFound, topic, engineName)
 	}
 
-	sub, err := engine.Subscribe(ctx, topic, handler)
+	sub, err := engine.Subscribe(ctx, topic, r.counters[engineName].countFailures(handler))
 	if err != nil {
 		return nil, fmt.Errorf("subscribing to engine %s: %w", engineName, err)
 	}
@@ -166,13 +301,13 @@ func (r *EngineRouter) Subscribe(ctx context.Context, topic string, handler Even
 
 // SubscribeAsync subscribes to a topic asynchronously using the appropriate engine.
 func (r *EngineRouter) SubscribeAsync(ctx context.Context, topic string, handler EventHandler) (Subscription, error) {
-	engineName := r.getEngineForTopic(topic)
+	engineName := r.getEngineForContext(ctx, topic)
 	engine, exists := r.engines[engineName]
 	if !exists {
 		return nil, fmt.Errorf("%w for topic %s: %s", ErrEngineNotFound, topic, engineName)
 	}
 
-	sub, err := engine.SubscribeAsync(ctx, topic, handler)
+	sub, err := engine.SubscribeAsync(ctx, topic, r.counters[engineName].countFailures(handler))
 	if err != nil {
 		return nil, fmt.Errorf("async subscribing to engine %s: %w", engineName, err)
 	}
@@ -218,12 +353,46 @@ func (r *EngineRouter) SubscriberCount(topic string) int {
 	return total
 }
 
+// getEngineForContext determines the engine for a subscription to topic made
+// with ctx, honoring the tenant ctx carries.
+func (r *EngineRouter) getEngineForContext(ctx context.Context, topic string) string {
+	tenantID, _ := modular.GetTenantIDFromContext(ctx)
+	return r.getEngine(topic, tenantID)
+}
+
+// getEngine determines which engine should handle topic for tenantID. A tenant
+// routing rule for the tenant whose engine is available wins over the topic
+// routing rules.
+func (r *EngineRouter) getEngine(topic string, tenantID modular.TenantID) string {
+	if tenantID != "" {
+		for _, rule := range r.tenantRouting {
+			if !r.isEngineAvailable(rule.Engine) {
+				continue
+			}
+			for _, tenant := range rule.Tenants {
+				if modular.TenantID(tenant) == tenantID {
+					return rule.Engine
+				}
+			}
+		}
+	}
+	return r.getEngineForTopic(topic)
+}
+
 // getEngineForTopic determines which engine should handle a given topic.
-// It evaluates routing rules in order and returns the first match.
-// If no rules match, it returns the default engine.
+// It evaluates routing rules in order and returns the first match whose engine
+// is available. If no rules match, it returns the default engine. Reply topics
+// are routed like the request topic they were derived from.
 func (r *EngineRouter) getEngineForTopic(topic string) string {
+	if requestTopic, ok := requestTopicOfReply(topic); ok {
+		topic = requestTopic
+	}
+
 	// Check routing rules in order
 	for _, rule := range r.routing {
+		if !r.isEngineAvailable(rule.Engine) {
+			continue
+		}
 		for _, pattern := range rule.Topics {
 			if r.topicMatches(topic, pattern) {
 				return rule.Engine
@@ -235,19 +404,9 @@ func (r *EngineRouter) getEngineForTopic(topic string) string {
 	return r.defaultEngine
 }
 
-// topicMatches checks if a topic matches a pattern.
-// Supports exact matches and wildcard patterns ending with '*'.
+// topicMatches checks if a topic matches a pattern, see matchesTopic.
 func (r *EngineRouter) topicMatches(topic, pattern string) bool {
-	if topic == pattern {
-		return true // Exact match
-	}
-
-	if strings.HasSuffix(pattern, "*") {
-		prefix := pattern[:len(pattern)-1]
-		return strings.HasPrefix(topic, prefix)
-	}
-
-	return false
+	return matchesTopic(topic, pattern)
 }
 
 // GetEngineNames returns the names of all configured engines.
@@ -338,6 +497,21 @@ func init() {
 				cfg.RetentionDays = intVal
 			}
 		}
+		if val, ok := config["deliveryMode"]; ok {
+			if strVal, ok := val.(string); ok {
+				cfg.DeliveryMode = strVal
+			}
+		}
+		if val, ok := config["publishBlockTimeout"]; ok {
+			if durVal, ok := val.(time.Duration); ok {
+				cfg.PublishBlockTimeout = durVal
+			}
+		}
+		if val, ok := config["overflowPolicy"]; ok {
+			if strVal, ok := val.(string); ok {
+				cfg.OverflowPolicy = strVal
+			}
+		}
 
 		return NewMemoryEventBus(cfg), nil
 	})
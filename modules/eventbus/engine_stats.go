@@ -0,0 +1,173 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+)
+
+// Compile-time interface check.
+var _ modular.HealthProvider = (*EventBusModule)(nil)
+
+// EngineStats is a snapshot of the activity of one event bus engine, returned
+// by EventBusModule.EngineStats. Counters increase monotonically from module
+// start.
+type EngineStats struct {
+	// Published is the number of events successfully published to the engine.
+	Published uint64 `json:"published" yaml:"published"`
+
+	// Delivered is the number of events handed to subscription handlers.
+	// Only reported by the in-process engines.
+	Delivered uint64 `json:"delivered" yaml:"delivered"`
+
+	// Dropped is the number of deliveries the engine gave up on, for example
+	// because a subscriber queue was full. Only reported by the memory and
+	// custom engines.
+	Dropped uint64 `json:"dropped" yaml:"dropped"`
+
+	// Failed is the number of deliveries whose handler returned an error.
+	Failed uint64 `json:"failed" yaml:"failed"`
+
+	// QueueDepth is the current number of events waiting to be handled.
+	// Only reported by the in-process engines.
+	QueueDepth int `json:"queueDepth" yaml:"queueDepth"`
+
+	// Subscribers is the current number of subscriptions on the engine.
+	Subscribers int `json:"subscribers" yaml:"subscribers"`
+}
+
+// engineCounters holds the router-level counters of an engine. A nil
+// *engineCounters counts nothing.
+type engineCounters struct {
+	published atomic.Uint64
+	failed    atomic.Uint64
+}
+
+// recordPublish counts an event published to the engine.
+func (c *engineCounters) recordPublish() {
+	if c != nil {
+		c.published.Add(1)
+	}
+}
+
+// countFailures wraps handler to count the errors it returns.
+func (c *engineCounters) countFailures(handler EventHandler) EventHandler {
+	if c == nil || handler == nil {
+		return handler
+	}
+	return func(ctx context.Context, event Event) error {
+		err := handler(ctx, event)
+		if err != nil {
+			c.failed.Add(1)
+		}
+		return err
+	}
+}
+
+// queuedEventsProvider is implemented by engines that can tell how many events
+// are waiting in their queues.
+type queuedEventsProvider interface {
+	queuedEvents() int
+}
+
+// deliveredStatsProvider is implemented by engines that only count delivered
+// events, such as DurableMemoryEventBus, which never drops events.
+type deliveredStatsProvider interface {
+	Stats() (delivered uint64)
+}
+
+// CollectEngineStats returns the activity of every engine, keyed by engine name.
+func (r *EngineRouter) CollectEngineStats() map[string]EngineStats {
+	stats := make(map[string]EngineStats, len(r.engines))
+	for name, engine := range r.engines {
+		var s EngineStats
+		if c := r.counters[name]; c != nil {
+			s.Published = c.published.Load()
+			s.Failed = c.failed.Load()
+		}
+		switch sp := engine.(type) {
+		case statsProvider:
+			s.Delivered, s.Dropped = sp.Stats()
+		case deliveredStatsProvider:
+			s.Delivered = sp.Stats()
+		}
+		if qp, ok := engine.(queuedEventsProvider); ok {
+			s.QueueDepth = qp.queuedEvents()
+		}
+		for _, topic := range engine.Topics() {
+			s.Subscribers += engine.SubscriberCount(topic)
+		}
+		stats[name] = s
+	}
+	return stats
+}
+
+// EngineStats returns the published, delivered, dropped and failed event
+// counters, current queue depth and subscriber count of each engine, keyed by
+// engine name ("default" in single-engine mode). Returns an empty map before
+// Init.
+//
+// Example:
+//
+//	for name, s := range eventBus.EngineStats() {
+//		fmt.Printf("%s: published=%d failed=%d queued=%d\n", name, s.Published, s.Failed, s.QueueDepth)
+//	}
+func (m *EventBusModule) EngineStats() map[string]EngineStats {
+	if m.router == nil {
+		return map[string]EngineStats{}
+	}
+	return m.router.CollectEngineStats()
+}
+
+// HealthCheck implements modular.HealthProvider with one report per engine.
+// Each report's Details map carries the engine's statistics. Engines whose
+// broker is unreachable are reported degraded, as their topics are routed to
+// the fallback engine.
+func (m *EventBusModule) HealthCheck(ctx context.Context) ([]modular.HealthReport, error) {
+	now := time.Now()
+	if m.router == nil || !m.isStarted.Load() {
+		return []modular.HealthReport{{
+			Module:    m.name,
+			Component: "router",
+			Status:    modular.StatusUnhealthy,
+			Message:   "event bus not started",
+			CheckedAt: now,
+		}}, nil
+	}
+
+	unavailable := m.router.UnavailableEngines()
+	reports := make([]modular.HealthReport, 0, len(m.router.engines)+len(unavailable))
+	for name, stats := range m.router.CollectEngineStats() {
+		if _, down := unavailable[name]; down {
+			continue
+		}
+		reports = append(reports, modular.HealthReport{
+			Module:    m.name,
+			Component: name,
+			Status:    modular.StatusHealthy,
+			Message:   "engine is operational",
+			CheckedAt: now,
+			Details: map[string]any{
+				"published":   stats.Published,
+				"delivered":   stats.Delivered,
+				"dropped":     stats.Dropped,
+				"failed":      stats.Failed,
+				"queue_depth": stats.QueueDepth,
+				"subscribers": stats.Subscribers,
+			},
+		})
+	}
+	for name, cause := range unavailable {
+		reports = append(reports, modular.HealthReport{
+			Module:    m.name,
+			Component: name,
+			Status:    modular.StatusDegraded,
+			Message:   fmt.Sprintf("engine unavailable, topics routed to the fallback engine: %v", cause),
+			CheckedAt: now,
+		})
+	}
+	return reports, nil
+}
@@ -0,0 +1,132 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errStatsHandler = errors.New("handler failed")
+
+func newStatsModule(t *testing.T) *EventBusModule {
+	t.Helper()
+	module := NewModule().(*EventBusModule)
+	app := newMockApp()
+	app.RegisterConfigSection(ModuleName, modular.NewStdConfigProvider(&EventBusConfig{
+		Engines: []EngineConfig{
+			{Name: "memory", Type: "memory", Config: map[string]interface{}{"defaultEventBufferSize": 16}},
+			{Name: "custom", Type: "custom"},
+			{Name: "durable", Type: "durable-memory"},
+		},
+		Routing: []RoutingRule{
+			{Topics: []string{"orders.*"}, Engine: "custom"},
+			{Topics: []string{"audit.*"}, Engine: "durable"},
+		},
+	}))
+	require.NoError(t, module.Init(app))
+	require.NoError(t, module.Start(context.Background()))
+	t.Cleanup(func() { _ = module.Stop(context.Background()) })
+	return module
+}
+
+func TestEventBusModuleEngineStats(t *testing.T) {
+	module := newStatsModule(t)
+	ctx := context.Background()
+
+	var handled atomic.Int64
+	_, err := module.Subscribe(ctx, "user.created", func(context.Context, Event) error {
+		handled.Add(1)
+		return nil
+	})
+	require.NoError(t, err)
+	_, err = module.SubscribeAsync(ctx, "orders.placed", func(context.Context, Event) error {
+		handled.Add(1)
+		return errStatsHandler
+	})
+	require.NoError(t, err)
+	_, err = module.Subscribe(ctx, "audit.login", func(context.Context, Event) error {
+		handled.Add(1)
+		return nil
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, module.Publish(ctx, "user.created", i))
+	}
+	for i := 0; i < 2; i++ {
+		require.NoError(t, module.Publish(ctx, "orders.placed", i))
+	}
+	require.NoError(t, module.Publish(ctx, "audit.login", "alice"))
+
+	require.Eventually(t, func() bool {
+		stats := module.EngineStats()
+		return handled.Load() == 6 && stats["custom"].Failed == 2 &&
+			stats["memory"].Delivered == 3 && stats["durable"].Delivered == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	stats := module.EngineStats()
+	require.Len(t, stats, 3)
+	assert.Equal(t, EngineStats{Published: 3, Delivered: 3, Subscribers: 1}, stats["memory"])
+	assert.Equal(t, uint64(2), stats["custom"].Published)
+	assert.Equal(t, uint64(2), stats["custom"].Failed)
+	assert.Equal(t, 1, stats["custom"].Subscribers)
+	assert.Equal(t, EngineStats{Published: 1, Delivered: 1, Subscribers: 1}, stats["durable"])
+}
+
+func TestEventBusModuleEngineStatsQueueDepth(t *testing.T) {
+	module := newStatsModule(t)
+	ctx := context.Background()
+
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{}, 1)
+	_, err := module.Subscribe(ctx, "user.created", func(context.Context, Event) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, module.Publish(ctx, "user.created", 0))
+	<-started
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, module.Publish(ctx, "user.created", i))
+	}
+
+	assert.Equal(t, 3, module.EngineStats()["memory"].QueueDepth)
+}
+
+func TestEventBusModuleHealthCheck(t *testing.T) {
+	t.Run("not started", func(t *testing.T) {
+		module := NewModule().(*EventBusModule)
+		reports, err := module.HealthCheck(context.Background())
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		assert.Equal(t, modular.StatusUnhealthy, reports[0].Status)
+	})
+
+	t.Run("one report per engine", func(t *testing.T) {
+		module := newStatsModule(t)
+		require.NoError(t, module.Publish(context.Background(), "user.created", "bob"))
+
+		reports, err := module.HealthCheck(context.Background())
+		require.NoError(t, err)
+		require.Len(t, reports, 3)
+		for _, report := range reports {
+			assert.Equal(t, ModuleName, report.Module)
+			assert.Equal(t, modular.StatusHealthy, report.Status, report.Component)
+			if report.Component == "memory" {
+				assert.Equal(t, uint64(1), report.Details["published"])
+			}
+		}
+	})
+}
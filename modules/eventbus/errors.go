@@ -12,4 +12,12 @@ var (
 
 	// ErrNATSConnectionNotEstablished is returned when NATS connection is not established
 	ErrNATSConnectionNotEstablished = errors.New("NATS connection is not established")
+
+	// ErrEngineUnavailable is returned when an engine cannot reach its external broker.
+	// The engine router treats this as recoverable and falls back to another engine.
+	ErrEngineUnavailable = errors.New("engine backend unavailable")
+
+	// ErrCircuitBreakerOpen is returned, wrapped in ErrEngineUnavailable, when
+	// an engine's circuit breaker is open after repeated broker failures.
+	ErrCircuitBreakerOpen = errors.New("circuit breaker open")
 )
@@ -13,6 +13,18 @@ var (
 	ErrEventBusShutdownTimeout = errors.New("event bus shutdown timed out")
 	ErrEventHandlerNil         = errors.New("event handler cannot be nil")
 	ErrInvalidSubscriptionType = errors.New("invalid subscription type")
+
+	// ErrSubscriptionCancelled is returned when unsubscribing a subscription
+	// that has already been cancelled.
+	ErrSubscriptionCancelled = errors.New("subscription already cancelled")
+
+	// ErrPublishCancelled is returned when the context of a publisher blocked
+	// on a full subscriber queue is done before the event could be queued.
+	ErrPublishCancelled = errors.New("publish cancelled while waiting for queue space")
+
+	// ErrShutdownFlushTimeout is returned by Stop when engines still had
+	// queued or in-flight events after ShutdownTimeout.
+	ErrShutdownFlushTimeout = errors.New("timed out flushing events on shutdown")
 )
 
 // Event is a CloudEvents SDK event. All events in the eventbus module are
@@ -7,6 +7,7 @@ const (
 	EventTypeMessagePublished = "com.modular.eventbus.message.published"
 	EventTypeMessageReceived  = "com.modular.eventbus.message.received"
 	EventTypeMessageFailed    = "com.modular.eventbus.message.failed"
+	EventTypeMessageDropped   = "com.modular.eventbus.message.dropped"
 
 	// Topic events
 	EventTypeTopicCreated = "com.modular.eventbus.topic.created"
@@ -19,6 +20,7 @@ const (
 	// Bus lifecycle events
 	EventTypeBusStarted = "com.modular.eventbus.bus.started"
 	EventTypeBusStopped = "com.modular.eventbus.bus.stopped"
+	EventTypeBusDrained = "com.modular.eventbus.bus.drained"
 
 	// Configuration events
 	EventTypeConfigLoaded = "com.modular.eventbus.config.loaded"
@@ -7,7 +7,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
-	"strings"
 	"sync"
 	"sync/atomic"
 
@@ -143,16 +142,7 @@ func (h *KafkaConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSes
 
 // topicMatches checks if a topic matches a subscription pattern
 func (h *KafkaConsumerGroupHandler) topicMatches(messageTopic, subscriptionTopic string) bool {
-	if messageTopic == subscriptionTopic {
-		return true
-	}
-
-	if strings.HasSuffix(subscriptionTopic, "*") {
-		prefix := subscriptionTopic[:len(subscriptionTopic)-1]
-		return strings.HasPrefix(messageTopic, prefix)
-	}
-
-	return false
+	return matchesTopic(messageTopic, subscriptionTopic)
 }
 
 // NewKafkaEventBus creates a new Kafka-based event bus
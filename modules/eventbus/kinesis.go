@@ -8,7 +8,6 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -534,16 +533,7 @@ func (k *KinesisEventBus) readShard(shardID string) {
 
 // topicMatches checks if a topic matches a subscription pattern
 func (k *KinesisEventBus) topicMatches(eventTopic, subscriptionTopic string) bool {
-	if eventTopic == subscriptionTopic {
-		return true
-	}
-
-	if strings.HasSuffix(subscriptionTopic, "*") {
-		prefix := subscriptionTopic[:len(subscriptionTopic)-1]
-		return strings.HasPrefix(eventTopic, prefix)
-	}
-
-	return false
+	return matchesTopic(eventTopic, subscriptionTopic)
 }
 
 // isExpiredIteratorError checks if the error is an AWS Kinesis ExpiredIteratorException
@@ -2,6 +2,7 @@ package eventbus
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"sync"
 	"sync/atomic"
@@ -28,6 +29,8 @@ type MemoryEventBus struct {
 	pubCounter     uint64          // for rotation fairness
 	deliveredCount uint64          // stats
 	droppedCount   uint64          // stats
+	attemptedCount uint64          // deliveries attempted by Publish, each ends up delivered or dropped
+	orderedTopics  []string        // topic patterns delivered in publish order
 }
 
 // memorySubscription represents a subscription in the memory event bus
@@ -38,7 +41,8 @@ type memorySubscription struct {
 	isAsync   bool
 	eventCh   chan Event
 	done      chan struct{}
-	finished  chan struct{} // closed when handler goroutine exits
+	finished  chan struct{}  // closed when handler goroutine exits
+	inflight  sync.WaitGroup // async deliveries queued to the worker pool
 	cancelled bool
 	mutex     sync.RWMutex
 }
@@ -94,6 +98,23 @@ func (m *MemoryEventBus) SetModule(module *EventBusModule) {
 	m.module = module
 }
 
+// SetOrderedTopics marks the topics matching the patterns as ordered: their events are handled in publish order by async subscriptions
+// too, on the subscription's own goroutine rather than the worker pool. It
+// must be called before Start.
+func (m *MemoryEventBus) SetOrderedTopics(topics ...string) {
+	m.orderedTopics = append(m.orderedTopics, topics...)
+}
+
+// isOrderedTopic reports whether events of topic must be handled in order
+func (m *MemoryEventBus) isOrderedTopic(topic string) bool {
+	for _, pattern := range m.orderedTopics {
+		if matchesTopic(topic, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
 // emitEvent emits an event through the module if available
 func (m *MemoryEventBus) emitEvent(ctx context.Context, eventType, source string, data map[string]interface{}) {
 	if m.module != nil {
@@ -194,23 +215,6 @@ func (m *MemoryEventBus) drainWorkerPool() {
 	}
 }
 
-// matchesTopic checks if an event topic matches a subscription topic pattern
-// Supports wildcard patterns like "user.*" matching "user.created", "user.updated", etc.
-func matchesTopic(eventTopic, subscriptionTopic string) bool {
-	// Exact match
-	if eventTopic == subscriptionTopic {
-		return true
-	}
-
-	// Wildcard match - check if subscription topic ends with *
-	if len(subscriptionTopic) > 1 && subscriptionTopic[len(subscriptionTopic)-1] == '*' {
-		prefix := subscriptionTopic[:len(subscriptionTopic)-1]
-		return len(eventTopic) >= len(prefix) && eventTopic[:len(prefix)] == prefix
-	}
-
-	return false
-}
-
 // Publish sends an event to the specified topic
 func (m *MemoryEventBus) Publish(ctx context.Context, event Event) error {
 	if !m.isStarted.Load() {
@@ -270,8 +274,17 @@ func (m *MemoryEventBus) Publish(ctx context.Context, event Event) error {
 	}
 
 	mode := m.config.DeliveryMode
+	switch m.config.OverflowPolicy {
+	case OverflowBlock:
+		mode = "block"
+	case OverflowDropNewest:
+		mode = "drop"
+	case OverflowDropOldest:
+		mode = OverflowDropOldest
+	}
 	blockTimeout := m.config.PublishBlockTimeout
 
+	var cancelled error
 	for _, sub := range allMatchingSubs {
 		sub.mutex.RLock()
 		if sub.cancelled {
@@ -280,7 +293,10 @@ func (m *MemoryEventBus) Publish(ctx context.Context, event Event) error {
 		}
 		sub.mutex.RUnlock()
 
+		atomic.AddUint64(&m.attemptedCount, 1)
 		var sent bool
+		var dropped []Event
+		reason := "queue_full"
 		switch mode {
 		case "block":
 			// block until space (respect context)
@@ -289,6 +305,10 @@ func (m *MemoryEventBus) Publish(ctx context.Context, event Event) error {
 				sent = true
 			case <-ctx.Done():
 				// treat as drop due to cancellation
+				reason = "publish_cancelled"
+				if cancelled == nil {
+					cancelled = fmt.Errorf("%w: %w", ErrPublishCancelled, ctx.Err())
+				}
 			}
 		case "timeout":
 			if blockTimeout <= 0 {
@@ -319,6 +339,8 @@ func (m *MemoryEventBus) Publish(ctx context.Context, event Event) error {
 					}
 				}
 			}
+		case OverflowDropOldest:
+			sent, dropped = sendDropOldest(sub.eventCh, event)
 		default: // "drop"
 			select {
 			case sub.eventCh <- event:
@@ -328,15 +350,51 @@ func (m *MemoryEventBus) Publish(ctx context.Context, event Event) error {
 		}
 		// Only count drops at publish time; successful sends accounted when processed.
 		if !sent {
-			atomic.AddUint64(&m.droppedCount, 1)
-			slog.Warn("Subscriber channel full, dropping event",
-				"topic", event.Type(),
-				"subscription_id", sub.id,
-				"delivery_mode", mode)
+			dropped = append(dropped, event)
+		}
+		for _, e := range dropped {
+			m.recordOverflowDrop(ctx, sub, e, mode, reason)
 		}
 	}
 
-	return nil
+	return cancelled
+}
+
+// sendDropOldest queues event on ch, evicting the oldest queued events while
+// the queue is full. It returns the evicted events. Sending only fails when
+// concurrent publishers keep refilling the queue.
+func sendDropOldest(ch chan Event, event Event) (bool, []Event) {
+	var evicted []Event
+	for attempt := 0; attempt < 3; attempt++ {
+		select {
+		case ch <- event:
+			return true, evicted
+		default:
+		}
+		select {
+		case oldest := <-ch:
+			evicted = append(evicted, oldest)
+		default:
+		}
+	}
+	return false, evicted
+}
+
+// recordOverflowDrop counts an event dropped because a subscriber queue was
+// full and reports it through a log and an EventTypeMessageDropped event
+func (m *MemoryEventBus) recordOverflowDrop(ctx context.Context, sub *memorySubscription, dropped Event, mode, reason string) {
+	atomic.AddUint64(&m.droppedCount, 1)
+	slog.Warn("Subscriber channel full, dropping event",
+		"topic", dropped.Type(),
+		"subscription_id", sub.id,
+		"delivery_mode", mode,
+		"reason", reason)
+	m.emitEvent(context.WithoutCancel(ctx), EventTypeMessageDropped, "memory-eventbus", map[string]interface{}{
+		"topic":           dropped.Type(),
+		"subscription_id": sub.id,
+		"delivery_mode":   mode,
+		"reason":          reason,
+	})
 }
 
 // Subscribe registers a handler for a topic
@@ -407,6 +465,10 @@ func (m *MemoryEventBus) subscribe(ctx context.Context, topic string, handler Ev
 	return sub, nil
 }
 
+// unsubscribeWaitTimeout bounds how long Unsubscribe waits for the
+// subscription's deliveries to finish
+const unsubscribeWaitTimeout = 100 * time.Millisecond
+
 // Unsubscribe removes a subscription
 func (m *MemoryEventBus) Unsubscribe(ctx context.Context, subscription Subscription) error {
 	if !m.isStarted.Load() {
@@ -436,10 +498,15 @@ func (m *MemoryEventBus) Unsubscribe(ctx context.Context, subscription Subscript
 	m.topicMutex.Unlock()
 
 	// Wait (briefly) for handler goroutine to terminate to avoid post-unsubscribe deliveries
-	t := time.NewTimer(100 * time.Millisecond)
+	t := time.NewTimer(unsubscribeWaitTimeout)
 	defer t.Stop()
 	select {
 	case <-sub.finished:
+		// No more deliveries can be queued: drain the async ones already handed
+		// to the worker pool, within the same bound. The bound matters when a
+		// handler unsubscribes its own subscription, as its delivery cannot
+		// finish before Unsubscribe returns.
+		m.waitInflight(ctx, sub, t.C)
 	case <-t.C:
 	}
 
@@ -451,6 +518,24 @@ func (m *MemoryEventBus) Unsubscribe(ctx context.Context, subscription Subscript
 	return nil
 }
 
+// waitInflight waits for the async deliveries queued for sub to finish, or
+// for ctx to be done, timeout to fire or the bus to stop. Deliveries still
+// running then complete in the background; Stop counts the unstarted ones as
+// dropped.
+func (m *MemoryEventBus) waitInflight(ctx context.Context, sub *memorySubscription, timeout <-chan time.Time) {
+	drained := make(chan struct{})
+	go func() {
+		sub.inflight.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-ctx.Done():
+	case <-timeout:
+	case <-m.ctx.Done():
+	}
+}
+
 // Topics returns a list of all active topics
 func (m *MemoryEventBus) Topics() []string {
 	m.topicMutex.RLock()
@@ -486,8 +571,9 @@ func (m *MemoryEventBus) handleEvents(sub *memorySubscription) {
 	defer m.drainSubscription(sub)
 
 	for {
-		// Fast path: if subscription cancelled, exit before selecting (avoids processing backlog after unsubscribe)
-		if sub.isCancelled() {
+		// Fast path: if subscription cancelled or the bus stopping, exit before
+		// selecting (avoids processing backlog after unsubscribe or Stop)
+		if sub.isCancelled() || m.ctx.Err() != nil {
 			return
 		}
 		select {
@@ -497,13 +583,13 @@ func (m *MemoryEventBus) handleEvents(sub *memorySubscription) {
 			return
 		case event := <-sub.eventCh:
 			// Re-check cancellation after dequeue to avoid processing additional events post-unsubscribe.
-			if sub.isCancelled() {
+			if sub.isCancelled() || m.ctx.Err() != nil {
 				// This event was dequeued but will not be handled — count it as
 				// dropped (the deferred drain handles the rest of the buffer).
 				atomic.AddUint64(&m.droppedCount, 1)
 				return
 			}
-			if sub.isAsync {
+			if sub.isAsync && !m.isOrderedTopic(event.Type()) {
 				m.queueEventHandler(sub, event)
 				continue
 			}
@@ -527,8 +613,20 @@ func (m *MemoryEventBus) handleEvents(sub *memorySubscription) {
 
 // queueEventHandler adds an event handler to the worker pool
 func (m *MemoryEventBus) queueEventHandler(sub *memorySubscription, event Event) {
+	// Deliveries queued before an Unsubscribe still run: Unsubscribe drains them
+	sub.inflight.Add(1)
 	select {
 	case m.workerPool <- func() {
+		defer sub.inflight.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				slog.Error("panic recovered in async event handler", "error", r, "topic", event.Type(), "subscription_id", sub.id)
+				// A panicking handler still processed the event, keeping the
+				// delivered/dropped accounting that flush relies on exact
+				atomic.AddUint64(&m.deliveredCount, 1)
+			}
+		}()
+
 		// Emit message received event
 		m.emitEvent(m.ctx, EventTypeMessageReceived, "memory-eventbus", map[string]interface{}{
 			"topic":           event.Type(),
@@ -554,6 +652,7 @@ func (m *MemoryEventBus) queueEventHandler(sub *memorySubscription, event Event)
 		// Successfully queued; delivered count increment deferred until post-processing
 	default:
 		// Worker pool task queue is full, drop async processing (count as dropped)
+		sub.inflight.Done()
 		atomic.AddUint64(&m.droppedCount, 1)
 		slog.Warn("Worker pool task queue full, dropping async event",
 			"topic", event.Type(),
@@ -595,6 +694,30 @@ func (m *MemoryEventBus) drainSubscription(sub *memorySubscription) {
 	}
 }
 
+// flush waits until every delivery attempted by Publish has been handled or
+// dropped. This relies on each attempt being counted exactly once as delivered
+// or dropped, which also covers events held by busy handlers.
+func (m *MemoryEventBus) flush(ctx context.Context) error {
+	return waitUntil(ctx, func() bool {
+		delivered, dropped := m.Stats()
+		return delivered+dropped >= atomic.LoadUint64(&m.attemptedCount)
+	})
+}
+
+// queuedEvents returns the number of events waiting in subscriber queues and
+// in the worker pool task queue.
+func (m *MemoryEventBus) queuedEvents() int {
+	m.topicMutex.RLock()
+	defer m.topicMutex.RUnlock()
+	queued := len(m.workerPool)
+	for _, subs := range m.subscriptions {
+		for _, sub := range subs {
+			queued += len(sub.eventCh)
+		}
+	}
+	return queued
+}
+
 // Stats returns basic delivery stats for monitoring/testing.
 func (m *MemoryEventBus) Stats() (delivered uint64, dropped uint64) {
 	return atomic.LoadUint64(&m.deliveredCount), atomic.LoadUint64(&m.droppedCount)
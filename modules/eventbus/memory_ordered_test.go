@@ -0,0 +1,119 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// orderRecorder collects the sequence numbers an async handler observes
+type orderRecorder struct {
+	mu   sync.Mutex
+	seqs []int
+}
+
+func (r *orderRecorder) handler(_ context.Context, e Event) error {
+	var payload map[string]int
+	if err := e.DataAs(&payload); err != nil {
+		return err
+	}
+	// Uneven handling times reorder events handled concurrently
+	if payload["seq"]%3 == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	r.mu.Lock()
+	r.seqs = append(r.seqs, payload["seq"])
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *orderRecorder) wait(t *testing.T, n int) []int {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return len(r.seqs) >= n
+	}, 5*time.Second, 10*time.Millisecond)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]int(nil), r.seqs...)
+}
+
+func startOrderedModule(t *testing.T, cfg *EventBusConfig) *EventBusModule {
+	t.Helper()
+	module := NewModule().(*EventBusModule)
+	app := newMockApp()
+	app.RegisterConfigSection(ModuleName, modular.NewStdConfigProvider(cfg))
+	require.NoError(t, module.Init(app))
+	require.NoError(t, module.Start(context.Background()))
+	t.Cleanup(func() { _ = module.Stop(context.Background()) })
+	return module
+}
+
+func publishSequence(t *testing.T, module *EventBusModule, topic string, n int) []int {
+	t.Helper()
+	want := make([]int, n)
+	for i := range want {
+		want[i] = i
+		require.NoError(t, module.Publish(context.Background(), topic, map[string]int{"seq": i}))
+	}
+	return want
+}
+
+func TestOrderedRoutingPreservesPublishOrder(t *testing.T) {
+	const events = 100
+
+	t.Run("single engine", func(t *testing.T) {
+		module := startOrderedModule(t, &EventBusConfig{
+			Engine:                 "memory",
+			WorkerCount:            8,
+			DefaultEventBufferSize: events,
+			MaxEventQueueSize:      events,
+			Routing:                []RoutingRule{{Topics: []string{"entity.*"}, Ordered: true}},
+		})
+
+		recorder := &orderRecorder{}
+		_, err := module.SubscribeAsync(context.Background(), "entity.changed", recorder.handler)
+		require.NoError(t, err)
+
+		want := publishSequence(t, module, "entity.changed", events)
+		assert.Equal(t, want, recorder.wait(t, events))
+	})
+
+	t.Run("multi engine", func(t *testing.T) {
+		module := startOrderedModule(t, &EventBusConfig{
+			Engines: []EngineConfig{
+				{Name: "default", Type: "memory", Config: map[string]interface{}{"workerCount": 8, "defaultEventBufferSize": events}},
+				{Name: "ordered", Type: "memory", Config: map[string]interface{}{"workerCount": 8, "defaultEventBufferSize": events}},
+			},
+			Routing: []RoutingRule{{Topics: []string{"entity.changed"}, Engine: "ordered", Ordered: true}},
+		})
+
+		recorder := &orderRecorder{}
+		_, err := module.SubscribeAsync(context.Background(), "entity.changed", recorder.handler)
+		require.NoError(t, err)
+
+		want := publishSequence(t, module, "entity.changed", events)
+		assert.Equal(t, want, recorder.wait(t, events))
+	})
+}
+
+func TestMemoryEventBusOrderedTopics(t *testing.T) {
+	bus := NewMemoryEventBus(&EventBusConfig{
+		MaxEventQueueSize:      10,
+		DefaultEventBufferSize: 10,
+		WorkerCount:            4,
+		RetentionDays:          1,
+	})
+	bus.SetOrderedTopics("entity.*", "audit.log")
+
+	assert.True(t, bus.isOrderedTopic("entity.changed"))
+	assert.True(t, bus.isOrderedTopic("audit.log"))
+	assert.False(t, bus.isOrderedTopic("audit.login"))
+	assert.False(t, bus.isOrderedTopic("orders.placed"))
+}
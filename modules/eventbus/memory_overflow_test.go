@@ -0,0 +1,215 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// eventRecordingSubject records the events emitted by the event bus module
+type eventRecordingSubject struct {
+	mu     sync.Mutex
+	events []cloudevents.Event
+}
+
+func (s *eventRecordingSubject) RegisterObserver(modular.Observer, ...string) error { return nil }
+func (s *eventRecordingSubject) UnregisterObserver(modular.Observer) error          { return nil }
+func (s *eventRecordingSubject) GetObservers() []modular.ObserverInfo               { return nil }
+
+func (s *eventRecordingSubject) NotifyObservers(_ context.Context, event cloudevents.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *eventRecordingSubject) ofType(eventType string) []cloudevents.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var matching []cloudevents.Event
+	for _, e := range s.events {
+		if e.Type() == eventType {
+			matching = append(matching, e)
+		}
+	}
+	return matching
+}
+
+// saturatedBus is a memory bus whose only subscriber is stuck handling the
+// first event, with its single-slot queue holding the second one
+type saturatedBus struct {
+	bus      *MemoryEventBus
+	subject  *eventRecordingSubject
+	release  func()
+	received func() []string
+}
+
+func newSaturatedBus(t *testing.T, policy string) *saturatedBus {
+	t.Helper()
+	bus := NewMemoryEventBus(&EventBusConfig{
+		MaxEventQueueSize:      10,
+		DefaultEventBufferSize: 1,
+		WorkerCount:            1,
+		DeliveryMode:           "drop",
+		OverflowPolicy:         policy,
+		RetentionDays:          1,
+	})
+	subject := &eventRecordingSubject{}
+	bus.SetModule(&EventBusModule{subject: subject})
+	ctx := context.Background()
+	require.NoError(t, bus.Start(ctx))
+
+	var (
+		mu       sync.Mutex
+		received []string
+		once     sync.Once
+	)
+	started := make(chan struct{}, 1)
+	gate := make(chan struct{})
+	release := func() { once.Do(func() { close(gate) }) }
+	t.Cleanup(func() {
+		release()
+		_ = bus.Stop(context.Background())
+	})
+
+	_, err := bus.Subscribe(ctx, "overflow.topic", func(_ context.Context, e Event) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-gate
+		mu.Lock()
+		received = append(received, e.ID())
+		mu.Unlock()
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, bus.Publish(ctx, overflowEvent("first")))
+	select {
+	case <-started:
+	case <-time.After(2 * time.Second):
+		t.Fatal("subscriber did not start handling the first event")
+	}
+	require.NoError(t, bus.Publish(ctx, overflowEvent("second")))
+
+	return &saturatedBus{
+		bus:     bus,
+		subject: subject,
+		release: release,
+		received: func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]string(nil), received...)
+		},
+	}
+}
+
+func overflowEvent(id string) Event {
+	e := cloudevents.NewEvent()
+	e.SetID(id)
+	e.SetType("overflow.topic")
+	e.SetSource("overflow-test")
+	return e
+}
+
+func (s *saturatedBus) waitReceived(t *testing.T, n int) []string {
+	t.Helper()
+	require.Eventually(t, func() bool { return len(s.received()) >= n }, 2*time.Second, 10*time.Millisecond)
+	return s.received()
+}
+
+func TestMemoryOverflowDropNewest(t *testing.T) {
+	s := newSaturatedBus(t, OverflowDropNewest)
+
+	for _, id := range []string{"third", "fourth"} {
+		require.NoError(t, s.bus.Publish(context.Background(), overflowEvent(id)))
+	}
+	s.release()
+
+	assert.Equal(t, []string{"first", "second"}, s.waitReceived(t, 2))
+	_, dropped := s.bus.Stats()
+	assert.Equal(t, uint64(2), dropped)
+}
+
+func TestMemoryOverflowDropOldest(t *testing.T) {
+	s := newSaturatedBus(t, OverflowDropOldest)
+
+	for _, id := range []string{"third", "fourth"} {
+		require.NoError(t, s.bus.Publish(context.Background(), overflowEvent(id)))
+	}
+	s.release()
+
+	assert.Equal(t, []string{"first", "fourth"}, s.waitReceived(t, 2))
+	_, dropped := s.bus.Stats()
+	assert.Equal(t, uint64(2), dropped)
+}
+
+func TestMemoryOverflowBlock(t *testing.T) {
+	t.Run("waits for queue space", func(t *testing.T) {
+		s := newSaturatedBus(t, OverflowBlock)
+
+		published := make(chan error, 1)
+		go func() { published <- s.bus.Publish(context.Background(), overflowEvent("third")) }()
+		select {
+		case err := <-published:
+			t.Fatalf("Publish returned %v while the queue was full", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		s.release()
+		select {
+		case err := <-published:
+			require.NoError(t, err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("Publish did not return once the queue had space")
+		}
+		assert.Equal(t, []string{"first", "second", "third"}, s.waitReceived(t, 3))
+		_, dropped := s.bus.Stats()
+		assert.Zero(t, dropped)
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		s := newSaturatedBus(t, OverflowBlock)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		err := s.bus.Publish(ctx, overflowEvent("third"))
+		require.ErrorIs(t, err, ErrPublishCancelled)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		s.release()
+		assert.Equal(t, []string{"first", "second"}, s.waitReceived(t, 2))
+		_, dropped := s.bus.Stats()
+		assert.Equal(t, uint64(1), dropped)
+	})
+}
+
+func TestMemoryOverflowEmitsDroppedEvents(t *testing.T) {
+	s := newSaturatedBus(t, OverflowDropOldest)
+
+	require.NoError(t, s.bus.Publish(context.Background(), overflowEvent("third")))
+
+	require.Eventually(t, func() bool {
+		return len(s.subject.ofType(EventTypeMessageDropped)) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	var data map[string]interface{}
+	require.NoError(t, s.subject.ofType(EventTypeMessageDropped)[0].DataAs(&data))
+	assert.Equal(t, "overflow.topic", data["topic"])
+	assert.Equal(t, OverflowDropOldest, data["delivery_mode"])
+	assert.Equal(t, "queue_full", data["reason"])
+}
+
+func TestMemoryOverflowPolicyValidation(t *testing.T) {
+	for _, policy := range []string{"", OverflowBlock, OverflowDropNewest, OverflowDropOldest} {
+		require.NoError(t, (&EventBusConfig{OverflowPolicy: policy}).ValidateConfig(), policy)
+	}
+	err := (&EventBusConfig{OverflowPolicy: "dropRandom"}).ValidateConfig()
+	require.ErrorIs(t, err, ErrInvalidOverflowPolicy)
+}
@@ -110,6 +110,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -149,6 +151,24 @@ type EventBusModule struct {
 	mutex     sync.RWMutex
 	isStarted atomic.Bool
 	subject   modular.Subject // For event observation (guarded by mutex)
+
+	// Active subscriptions created through the module, keyed by subscription ID
+	subscriptions map[string]subscriptionRecord
+	subsMutex     sync.RWMutex
+}
+
+// subscriptionRecord tracks a subscription and the engine that owns it.
+type subscriptionRecord struct {
+	sub        Subscription
+	engineName string
+}
+
+// SubscriptionInfo describes an active subscription for introspection.
+type SubscriptionInfo struct {
+	Topic      string `json:"topic" yaml:"topic"`
+	ID         string `json:"id" yaml:"id"`
+	IsAsync    bool   `json:"isAsync" yaml:"isAsync"`
+	EngineName string `json:"engineName" yaml:"engineName"`
 }
 
 // DeliveryStats represents basic delivery outcomes for an engine or aggregate.
@@ -205,7 +225,12 @@ func (m *EventBusModule) RegisterConfig(app modular.Application) error {
 		ExternalBrokerPassword: "",
 	}
 
-	app.RegisterConfigSection(m.Name(), modular.NewStdConfigProvider(defaultConfig))
+	// Engines declared in multi-engine mode are fed from
+	// EVENTBUS_<ENGINE>_* environment variables.
+	instancePrefixFunc := func(instanceKey string) string {
+		return "EVENTBUS_" + instanceKey + "_"
+	}
+	app.RegisterConfigSection(m.Name(), modular.NewInstanceAwareConfigProvider(defaultConfig, instancePrefixFunc))
 	return nil
 }
 
@@ -249,6 +274,7 @@ func (m *EventBusModule) Init(app modular.Application) error {
 
 	// Set module reference for memory engines to enable event emission
 	m.router.SetModuleReference(m)
+	m.logUnavailableEngines()
 
 	if m.config.IsMultiEngine() {
 		m.logger.Info("Initialized multi-engine eventbus",
@@ -300,6 +326,7 @@ func (m *EventBusModule) Start(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("starting engine router: %w", err)
 	}
+	m.logUnavailableEngines()
 
 	m.isStarted.Store(true)
 	if m.config.IsMultiEngine() {
@@ -366,13 +393,22 @@ func (m *EventBusModule) Stop(ctx context.Context) error {
 		return nil
 	}
 
-	// Stop the engine router (which stops all engines)
-	err := m.router.Stop(ctx)
+	// Flush queued and in-flight events, then stop all engines
+	var shutdownTimeout time.Duration
+	if m.config != nil {
+		shutdownTimeout = m.config.ShutdownTimeout
+	}
+	drainStats, err := m.router.Shutdown(ctx, shutdownTimeout)
 	if err != nil {
 		m.mutex.Unlock()
 		return fmt.Errorf("stopping engine router: %w", err)
 	}
 
+	// Engines cancel their subscriptions on stop
+	m.subsMutex.Lock()
+	m.subscriptions = nil
+	m.subsMutex.Unlock()
+
 	m.isStarted.Store(false)
 	engineName := "unknown"
 	if m.config != nil {
@@ -380,13 +416,38 @@ func (m *EventBusModule) Stop(ctx context.Context) error {
 	}
 	m.mutex.Unlock()
 
-	m.logger.Info("Event bus stopped")
+	var drained, dropped uint64
+	var timedOut []string
+	engines := make(map[string]interface{}, len(drainStats))
+	for name, stats := range drainStats {
+		drained += stats.Drained
+		dropped += stats.Dropped
+		if stats.TimedOut {
+			timedOut = append(timedOut, name)
+		}
+		engines[name] = map[string]interface{}{
+			"drained":   stats.Drained,
+			"dropped":   stats.Dropped,
+			"timed_out": stats.TimedOut,
+		}
+	}
+	sort.Strings(timedOut)
 
-	// Emit bus stopped event synchronously now that the mutex is released.
+	m.logger.Info("Event bus stopped", "drained", drained, "dropped", dropped)
+
+	// Emit drained and bus stopped events synchronously now that the mutex is released.
+	m.emitEvent(ctx, EventTypeBusDrained, map[string]interface{}{
+		"drained": drained,
+		"dropped": dropped,
+		"engines": engines,
+	})
 	m.emitEvent(ctx, EventTypeBusStopped, map[string]interface{}{
 		"engine": engineName,
 	})
 
+	if len(timedOut) > 0 {
+		return fmt.Errorf("%w: engines %s, %d event(s) dropped", ErrShutdownFlushTimeout, strings.Join(timedOut, ", "), dropped)
+	}
 	return nil
 }
 
@@ -436,19 +497,40 @@ func (m *EventBusModule) Constructor() modular.ModuleConstructor {
 // With multiple engines, the event is routed to the appropriate engine
 // based on the configured routing rules.
 //
+// When ctx carries a tenant (see modular.NewTenantContext), its ID is stamped
+// into the event's ExtensionTenantID attribute, and handlers receive a
+// context carrying the same tenant.
+//
 // Example:
 //
 //	err := eventBus.Publish(ctx, "user.created", userData)
 //	err := eventBus.Publish(ctx, "order.payment.failed", paymentData)
 func (m *EventBusModule) Publish(ctx context.Context, topic string, payload interface{}) error {
+	event, err := m.newEvent(topic, payload)
+	if err != nil {
+		return err
+	}
+	return m.publishEvent(ctx, event)
+}
+
+// newEvent builds a CloudEvent for topic with payload encoded as JSON data.
+func (m *EventBusModule) newEvent(topic string, payload interface{}) (Event, error) {
 	event := cevent.New()
 	event.SetType(topic)
 	event.SetSource(m.config.Source)
 	event.SetID(uuid.New().String())
 	event.SetTime(time.Now())
 	if err := event.SetData("application/json", payload); err != nil {
-		return fmt.Errorf("failed to set event data: %w", err)
+		return event, fmt.Errorf("failed to set event data: %w", err)
 	}
+	return event, nil
+}
+
+// publishEvent routes a fully built event to its engine and emits the
+// corresponding published/failed observer events.
+func (m *EventBusModule) publishEvent(ctx context.Context, event Event) error {
+	stampTenant(ctx, &event)
+	topic := event.Type()
 	startTime := time.Now()
 	err := m.router.Publish(ctx, event)
 	duration := time.Since(startTime)
@@ -494,10 +576,11 @@ func (m *EventBusModule) Publish(ctx context.Context, topic string, payload inte
 //	    return updateLastLoginTime(user.ID)
 //	})
 func (m *EventBusModule) Subscribe(ctx context.Context, topic string, handler EventHandler) (Subscription, error) {
-	sub, err := m.router.Subscribe(ctx, topic, handler)
+	sub, err := m.router.Subscribe(ctx, topic, withTenantContext(m.withDeadLetter(handler)))
 	if err != nil {
 		return nil, fmt.Errorf("subscribing to topic %s: %w", topic, err)
 	}
+	m.trackSubscription(sub)
 
 	// Emit subscription created event
 	go m.emitEvent(ctx, EventTypeSubscriptionCreated, map[string]interface{}{
@@ -532,10 +615,11 @@ func (m *EventBusModule) Subscribe(ctx context.Context, topic string, handler Ev
 //	    return generateThumbnails(imageData)
 //	})
 func (m *EventBusModule) SubscribeAsync(ctx context.Context, topic string, handler EventHandler) (Subscription, error) {
-	sub, err := m.router.SubscribeAsync(ctx, topic, handler)
+	sub, err := m.router.SubscribeAsync(ctx, topic, withTenantContext(m.withDeadLetter(handler)))
 	if err != nil {
 		return nil, fmt.Errorf("subscribing async to topic %s: %w", topic, err)
 	}
+	m.trackSubscription(sub)
 
 	// Emit subscription created event
 	go m.emitEvent(ctx, EventTypeSubscriptionCreated, map[string]interface{}{
@@ -549,21 +633,44 @@ func (m *EventBusModule) SubscribeAsync(ctx context.Context, topic string, handl
 
 // Unsubscribe cancels a subscription and stops receiving events.
 // The subscription will be removed from the event bus and no longer
-// receive events for its topic.
+// receive events for its topic. Asynchronous deliveries already handed to
+// the worker pool are drained rather than dropped: on the memory engine,
+// Unsubscribe waits briefly for them to finish, bounded by ctx, and the
+// rest complete in the background. Handlers may unsubscribe their own
+// subscription. Events still buffered for the subscription are counted as dropped.
 //
-// This method is idempotent - calling it multiple times on the same
-// subscription is safe and will not cause errors.
+// Returns ErrSubscriptionCancelled if the subscription was already
+// unsubscribed through the module.
 //
 // Example:
 //
 //	err := eventBus.Unsubscribe(ctx, subscription)
 func (m *EventBusModule) Unsubscribe(ctx context.Context, subscription Subscription) error {
+	if subscription == nil {
+		return ErrInvalidSubscriptionType
+	}
+
 	// Store subscription info before unsubscribing
 	topic := subscription.Topic()
 	subscriptionID := subscription.ID()
 
+	// Claim the record first so concurrent Unsubscribe calls cannot both succeed
+	m.subsMutex.Lock()
+	record, tracked := m.subscriptions[subscriptionID]
+	delete(m.subscriptions, subscriptionID)
+	m.subsMutex.Unlock()
+	if !tracked {
+		return fmt.Errorf("%w: %s", ErrSubscriptionCancelled, subscriptionID)
+	}
+
 	err := m.router.Unsubscribe(ctx, subscription)
 	if err != nil {
+		// Keep tracking the subscription so the caller can retry
+		m.subsMutex.Lock()
+		if m.subscriptions != nil {
+			m.subscriptions[subscriptionID] = record
+		}
+		m.subsMutex.Unlock()
 		return fmt.Errorf("unsubscribing: %w", err)
 	}
 
@@ -576,6 +683,84 @@ func (m *EventBusModule) Unsubscribe(ctx context.Context, subscription Subscript
 	return nil
 }
 
+// UnsubscribeTopic cancels every subscription created through the module for
+// the given topic pattern, including wildcard patterns such as "user.*".
+// Only subscriptions whose pattern equals topic are removed; a subscription
+// to "user.*" is not affected by UnsubscribeTopic(ctx, "user.created").
+// Returns the number of subscriptions removed.
+//
+// Example:
+//
+//	removed, err := eventBus.UnsubscribeTopic(ctx, "user.*")
+func (m *EventBusModule) UnsubscribeTopic(ctx context.Context, topic string) (int, error) {
+	m.subsMutex.RLock()
+	var matching []Subscription
+	for _, record := range m.subscriptions {
+		if record.sub.Topic() == topic {
+			matching = append(matching, record.sub)
+		}
+	}
+	m.subsMutex.RUnlock()
+
+	removed := 0
+	var errs []error
+	for _, sub := range matching {
+		if err := m.Unsubscribe(ctx, sub); err != nil {
+			// Lost a race with a concurrent Unsubscribe; nothing left to do
+			if errors.Is(err, ErrSubscriptionCancelled) {
+				continue
+			}
+			errs = append(errs, err)
+			continue
+		}
+		removed++
+	}
+	return removed, errors.Join(errs...)
+}
+
+// ListSubscriptions returns information about all active subscriptions created
+// through the module, sorted by topic and then by subscription ID.
+//
+// Example:
+//
+//	for _, info := range eventBus.ListSubscriptions() {
+//	    fmt.Printf("%s %s async=%v engine=%s\n", info.ID, info.Topic, info.IsAsync, info.EngineName)
+//	}
+func (m *EventBusModule) ListSubscriptions() []SubscriptionInfo {
+	m.subsMutex.RLock()
+	infos := make([]SubscriptionInfo, 0, len(m.subscriptions))
+	for id, record := range m.subscriptions {
+		infos = append(infos, SubscriptionInfo{
+			Topic:      record.sub.Topic(),
+			ID:         id,
+			IsAsync:    record.sub.IsAsync(),
+			EngineName: record.engineName,
+		})
+	}
+	m.subsMutex.RUnlock()
+
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Topic != infos[j].Topic {
+			return infos[i].Topic < infos[j].Topic
+		}
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
+// trackSubscription records a subscription created through the module.
+func (m *EventBusModule) trackSubscription(sub Subscription) {
+	m.subsMutex.Lock()
+	defer m.subsMutex.Unlock()
+	if m.subscriptions == nil {
+		m.subscriptions = make(map[string]subscriptionRecord)
+	}
+	m.subscriptions[sub.ID()] = subscriptionRecord{
+		sub:        sub,
+		engineName: m.router.GetEngineForTopic(sub.Topic()),
+	}
+}
+
 // Topics returns a list of all active topics that have subscribers.
 // This can be useful for debugging, monitoring, or building administrative
 // interfaces that show current event bus activity.
@@ -618,6 +803,18 @@ func (m *EventBusModule) GetRouter() *EngineRouter {
 	return m.router
 }
 
+// logUnavailableEngines warns about engines whose broker could not be reached
+// and whose topics are being routed to a fallback engine instead.
+func (m *EventBusModule) logUnavailableEngines() {
+	if m.router == nil || m.logger == nil {
+		return
+	}
+	for name, cause := range m.router.UnavailableEngines() {
+		m.logger.Warn("Event bus engine unavailable, routing its topics to fallback engine",
+			"engine", name, "error", cause)
+	}
+}
+
 // Stats returns aggregated delivery statistics for all underlying engines that
 // support them (currently only the in-memory engine). This is intended for
 // lightweight monitoring/metrics and testing. Returns zeros if the module has
@@ -720,12 +917,14 @@ func (m *EventBusModule) GetRegisteredEventTypes() []string {
 		EventTypeMessagePublished,
 		EventTypeMessageReceived,
 		EventTypeMessageFailed,
+		EventTypeMessageDropped,
 		EventTypeTopicCreated,
 		EventTypeTopicDeleted,
 		EventTypeSubscriptionCreated,
 		EventTypeSubscriptionRemoved,
 		EventTypeBusStarted,
 		EventTypeBusStopped,
+		EventTypeBusDrained,
 		EventTypeConfigLoaded,
 	}
 }
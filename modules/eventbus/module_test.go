@@ -3,6 +3,7 @@ package eventbus
 import (
 	"context"
 	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -374,3 +375,161 @@ func TestEventBusServiceProvider(t *testing.T) {
 	required := module.RequiresServices()
 	assert.Empty(t, required)
 }
+
+func newStartedTestModule(t *testing.T) *EventBusModule {
+	t.Helper()
+	module := NewModule().(*EventBusModule)
+	app := newMockApp()
+	require.NoError(t, module.RegisterConfig(app))
+	require.NoError(t, module.Init(app))
+	require.NoError(t, module.Start(context.Background()))
+	t.Cleanup(func() { _ = module.Stop(context.Background()) })
+	return module
+}
+
+func TestEventBusSubscriptionHandles(t *testing.T) {
+	ctx := context.Background()
+	handler := func(ctx context.Context, event Event) error { return nil }
+
+	t.Run("ListSubscriptions", func(t *testing.T) {
+		module := newStartedTestModule(t)
+
+		syncSub, err := module.Subscribe(ctx, "user.created", handler)
+		require.NoError(t, err)
+		asyncSub, err := module.SubscribeAsync(ctx, "user.*", handler)
+		require.NoError(t, err)
+
+		infos := module.ListSubscriptions()
+		require.Len(t, infos, 2)
+		assert.Equal(t, SubscriptionInfo{Topic: "user.*", ID: asyncSub.ID(), IsAsync: true, EngineName: "default"}, infos[0])
+		assert.Equal(t, SubscriptionInfo{Topic: "user.created", ID: syncSub.ID(), IsAsync: false, EngineName: "default"}, infos[1])
+
+		require.NoError(t, module.Unsubscribe(ctx, syncSub))
+		infos = module.ListSubscriptions()
+		require.Len(t, infos, 1)
+		assert.Equal(t, asyncSub.ID(), infos[0].ID)
+	})
+
+	t.Run("UnsubscribeTwiceReturnsError", func(t *testing.T) {
+		module := newStartedTestModule(t)
+
+		sub, err := module.Subscribe(ctx, "order.placed", handler)
+		require.NoError(t, err)
+		require.NoError(t, module.Unsubscribe(ctx, sub))
+
+		err = module.Unsubscribe(ctx, sub)
+		assert.ErrorIs(t, err, ErrSubscriptionCancelled)
+	})
+
+	t.Run("UnsubscribeTopicRemovesWildcardPattern", func(t *testing.T) {
+		module := newStartedTestModule(t)
+
+		_, err := module.Subscribe(ctx, "user.*", handler)
+		require.NoError(t, err)
+		_, err = module.SubscribeAsync(ctx, "user.*", handler)
+		require.NoError(t, err)
+		exact, err := module.Subscribe(ctx, "user.created", handler)
+		require.NoError(t, err)
+
+		removed, err := module.UnsubscribeTopic(ctx, "user.*")
+		require.NoError(t, err)
+		assert.Equal(t, 2, removed)
+
+		infos := module.ListSubscriptions()
+		require.Len(t, infos, 1)
+		assert.Equal(t, exact.ID(), infos[0].ID)
+		assert.Equal(t, 0, module.SubscriberCount("user.*"))
+	})
+
+	t.Run("UnsubscribeDuringAsyncDelivery", func(t *testing.T) {
+		module := newStartedTestModule(t)
+
+		started := make(chan struct{}, 1)
+		release := make(chan struct{})
+		var handled atomic.Int32
+		sub, err := module.SubscribeAsync(ctx, "slow.task", func(ctx context.Context, event Event) error {
+			select {
+			case started <- struct{}{}:
+			default:
+			}
+			<-release
+			handled.Add(1)
+			return nil
+		})
+		require.NoError(t, err)
+
+		const published = 5
+		for i := 0; i < published; i++ {
+			require.NoError(t, module.Publish(ctx, "slow.task", map[string]int{"n": i}))
+		}
+
+		select {
+		case <-started:
+		case <-time.After(2 * time.Second):
+			t.Fatal("async handler did not start")
+		}
+
+		// Unsubscribe while a delivery is in flight; it must not panic, and the
+		// context bounds how long it waits for the drain
+		unsubCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		require.NotPanics(t, func() {
+			require.NoError(t, module.Unsubscribe(unsubCtx, sub))
+		})
+		assert.Empty(t, module.ListSubscriptions())
+
+		// The queued deliveries are drained rather than dropped
+		close(release)
+		assert.Eventually(t, func() bool { return handled.Load() == published }, 2*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("UnsubscribeWaitsForDrain", func(t *testing.T) {
+		module := newStartedTestModule(t)
+
+		release := make(chan struct{})
+		var handled atomic.Int32
+		sub, err := module.SubscribeAsync(ctx, "slow.task", func(ctx context.Context, event Event) error {
+			<-release
+			handled.Add(1)
+			return nil
+		})
+		require.NoError(t, err)
+
+		const published = 3
+		for i := 0; i < published; i++ {
+			require.NoError(t, module.Publish(ctx, "slow.task", map[string]int{"n": i}))
+		}
+		// Let the deliveries reach the worker pool, then release them shortly
+		// after Unsubscribe starts waiting
+		time.Sleep(50 * time.Millisecond)
+		time.AfterFunc(20*time.Millisecond, func() { close(release) })
+
+		require.NoError(t, module.Unsubscribe(ctx, sub))
+		assert.Equal(t, int32(published), handled.Load(), "Unsubscribe returns once queued deliveries are drained")
+	})
+
+	t.Run("UnsubscribeFromOwnAsyncHandler", func(t *testing.T) {
+		module := newStartedTestModule(t)
+
+		var sub Subscription
+		subscribed := make(chan struct{})
+		unsubscribed := make(chan error, 1)
+		sub, err := module.SubscribeAsync(ctx, "one.shot", func(context.Context, Event) error {
+			<-subscribed
+			// The usual one-shot pattern, with a context that is never done
+			unsubscribed <- module.Unsubscribe(context.Background(), sub)
+			return nil
+		})
+		require.NoError(t, err)
+		close(subscribed)
+		require.NoError(t, module.Publish(ctx, "one.shot", map[string]int{"n": 1}))
+
+		select {
+		case err := <-unsubscribed:
+			require.NoError(t, err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("Unsubscribe from the subscription's own handler did not return")
+		}
+		assert.Empty(t, module.ListSubscriptions())
+	})
+}
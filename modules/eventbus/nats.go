@@ -39,6 +39,8 @@ type NatsConfig struct {
 	PingInterval     int    `json:"pingInterval"`
 	MaxPingsOut      int    `json:"maxPingsOut"`
 	SubscribeTimeout int    `json:"subscribeTimeout"`
+	SubjectPrefix    string `json:"subjectPrefix"`
+	QueueGroup       string `json:"queueGroup"`
 }
 
 // natsSubscription represents a subscription in the NATS event bus
@@ -133,6 +135,12 @@ func NewNatsEventBus(config map[string]interface{}) (EventBus, error) {
 	if subscribeTimeout, ok := config["subscribeTimeout"].(int); ok {
 		natsConfig.SubscribeTimeout = subscribeTimeout
 	}
+	if subjectPrefix, ok := config["subjectPrefix"].(string); ok {
+		natsConfig.SubjectPrefix = strings.TrimSuffix(subjectPrefix, ".")
+	}
+	if queueGroup, ok := config["queueGroup"].(string); ok {
+		natsConfig.QueueGroup = queueGroup
+	}
 
 	// Create NATS connection options
 	opts := []nats.Option{
@@ -157,7 +165,7 @@ func NewNatsEventBus(config map[string]interface{}) (EventBus, error) {
 	// Connect to NATS
 	conn, err := nats.Connect(natsConfig.URL, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+		return nil, fmt.Errorf("%w: failed to connect to NATS: %w", ErrEngineUnavailable, err)
 	}
 
 	return &NatsEventBus{
@@ -175,7 +183,7 @@ func (n *NatsEventBus) Start(ctx context.Context) error {
 
 	// Check if connection is valid
 	if n.conn.Status() != nats.CONNECTED {
-		return ErrNATSConnectionNotEstablished
+		return fmt.Errorf("%w: %w", ErrEngineUnavailable, ErrNATSConnectionNotEstablished)
 	}
 
 	n.ctx, n.cancel = context.WithCancel(ctx) //nolint:gosec // G118: cancel is stored in n.cancel and called in Stop()
@@ -290,7 +298,7 @@ func (n *NatsEventBus) subscribe(ctx context.Context, topic string, handler Even
 	}
 
 	// Create NATS subscription with message handler
-	natsSub, err := n.conn.Subscribe(subject, func(msg *nats.Msg) {
+	msgHandler := func(msg *nats.Msg) {
 		// Check if subscription is cancelled
 		sub.mutex.RLock()
 		if sub.cancelled {
@@ -307,6 +315,11 @@ func (n *NatsEventBus) subscribe(ctx context.Context, topic string, handler Even
 			return
 		}
 
+		// NATS wildcards also match reserved reply topics
+		if isReplyTopic(event.Type()) && !matchesTopic(event.Type(), sub.topic) {
+			return
+		}
+
 		// Process the event
 		if sub.isAsync {
 			// For async subscriptions, process in a separate goroutine
@@ -324,8 +337,17 @@ func (n *NatsEventBus) subscribe(ctx context.Context, topic string, handler Even
 			// For sync subscriptions, process immediately
 			n.processEvent(sub, event)
 		}
-	})
+	}
 
+	// Queue groups distribute each message to only one member of the group,
+	// allowing multiple application instances to share the work for a topic
+	var natsSub *nats.Subscription
+	var err error
+	if n.config.QueueGroup != "" {
+		natsSub, err = n.conn.QueueSubscribe(subject, n.config.QueueGroup, msgHandler)
+	} else {
+		natsSub, err = n.conn.Subscribe(subject, msgHandler)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to subscribe to NATS: %w", err)
 	}
@@ -408,14 +430,31 @@ func (n *NatsEventBus) processEvent(sub *natsSubscription, event Event) {
 }
 
 // topicToSubject converts an eventbus topic pattern to a NATS subject
-// EventBus uses "user.*" style wildcards, NATS uses "user.>" for multi-level wildcards
+// EventBus uses "user.*" style wildcards, NATS uses "user.>" for multi-level wildcards.
+// The '+' and '#' segment wildcards become NATS '*' and '>' wildcards; unlike
+// '#', '>' needs at least one segment, so "user.#" does not match "user".
+// If a subject prefix is configured it is prepended to every subject.
 func (n *NatsEventBus) topicToSubject(topic string) string {
+	subject := topic
 	// Replace trailing wildcard with NATS multi-level wildcard
 	if strings.HasSuffix(topic, ".*") {
-		return strings.TrimSuffix(topic, "*") + ">"
+		subject = strings.TrimSuffix(topic, "*") + ">"
+	} else if topic == "*" {
+		subject = ">"
+	} else if hasSegmentWildcard(topic) {
+		segments := strings.Split(topic, topicSeparator)
+		for i, segment := range segments {
+			switch {
+			case segment == topicSingleWildcard:
+				segments[i] = "*"
+			case segment == topicMultiLevelWildcard && i == len(segments)-1:
+				segments[i] = ">"
+			}
+		}
+		subject = strings.Join(segments, topicSeparator)
 	}
-	if topic == "*" {
-		return ">"
+	if n.config != nil && n.config.SubjectPrefix != "" {
+		return n.config.SubjectPrefix + "." + subject
 	}
-	return topic
+	return subject
 }
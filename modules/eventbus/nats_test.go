@@ -103,6 +103,10 @@ func TestNatsTopicToSubject(t *testing.T) {
 		{"nested wildcard", "events.user.*", "events.user.>"},
 		{"multiple segments with wildcard", "app.services.api.*", "app.services.api.>"},
 		{"single segment", "test", "test"},
+		{"single segment wildcard", "user.+.created", "user.*.created"},
+		{"multi-level wildcard", "user.#", "user.>"},
+		{"single and multi-level wildcards", "+.profile.#", "*.profile.>"},
+		{"wildcard characters within a segment", "user.a+b", "user.a+b"},
 	}
 
 	for _, tt := range tests {
@@ -113,6 +117,17 @@ func TestNatsTopicToSubject(t *testing.T) {
 	}
 }
 
+// TestNatsTopicToSubjectWithPrefix tests subject prefixing
+func TestNatsTopicToSubjectWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	natsBus := &NatsEventBus{config: &NatsConfig{SubjectPrefix: "myapp"}}
+
+	assert.Equal(t, "myapp.user.created", natsBus.topicToSubject("user.created"))
+	assert.Equal(t, "myapp.user.>", natsBus.topicToSubject("user.*"))
+	assert.Equal(t, "myapp.>", natsBus.topicToSubject("*"))
+}
+
 // TestNatsEventBusLifecycle tests the lifecycle of the NATS event bus
 func TestNatsEventBusLifecycle(t *testing.T) {
 	url := startTestNATSServer(t)
@@ -596,3 +611,121 @@ func TestNatsErrorCases(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to connect to NATS")
 	})
 }
+
+// TestNatsSubjectPrefixAndQueueGroup tests that prefixed subjects and queue groups
+// keep publish/subscribe semantics while load-balancing across group members
+func TestNatsSubjectPrefixAndQueueGroup(t *testing.T) {
+	url := startTestNATSServer(t)
+	ctx := context.Background()
+
+	newBus := func() EventBus {
+		bus, err := NewNatsEventBus(map[string]interface{}{
+			"url":           url,
+			"subjectPrefix": "myapp.",
+			"queueGroup":    "workers",
+		})
+		require.NoError(t, err)
+		require.NoError(t, bus.Start(ctx))
+		t.Cleanup(func() { _ = bus.Stop(context.Background()) })
+		return bus
+	}
+
+	busA := newBus()
+	busB := newBus()
+
+	received := make(chan string, 20)
+	_, err := busA.Subscribe(ctx, "orders.*", func(ctx context.Context, event Event) error {
+		received <- "a:" + event.Type()
+		return nil
+	})
+	require.NoError(t, err)
+	_, err = busB.Subscribe(ctx, "orders.*", func(ctx context.Context, event Event) error {
+		received <- "b:" + event.Type()
+		return nil
+	})
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+
+	const total = 10
+	for i := 0; i < total; i++ {
+		require.NoError(t, busA.Publish(ctx, newTestCloudEvent("orders.created", i)))
+	}
+
+	count := 0
+	timeout := time.After(2 * time.Second)
+	for count < total {
+		select {
+		case <-received:
+			count++
+		case <-timeout:
+			t.Fatalf("received %d of %d events", count, total)
+		}
+	}
+
+	// Queue group members share messages, so no extra deliveries should arrive
+	select {
+	case extra := <-received:
+		t.Fatalf("unexpected duplicate delivery: %s", extra)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+// TestNatsEngineFallback tests that topics routed to an unreachable NATS engine
+// fall back to the wildcard engine in a multi-engine configuration
+func TestNatsEngineFallback(t *testing.T) {
+	config := &EventBusConfig{
+		Engines: []EngineConfig{
+			{Name: "memory", Type: "memory"},
+			{Name: "nats-stream", Type: "nats", Config: map[string]interface{}{
+				"url":            "nats://127.0.0.1:1",
+				"allowReconnect": false,
+			}},
+		},
+		Routing: []RoutingRule{
+			{Topics: []string{"stream.*"}, Engine: "nats-stream"},
+			{Topics: []string{"*"}, Engine: "memory"},
+		},
+	}
+
+	router, err := NewEngineRouter(config)
+	require.NoError(t, err)
+	require.NoError(t, router.Start(context.Background()))
+	defer router.Stop(context.Background())
+
+	assert.Contains(t, router.UnavailableEngines(), "nats-stream")
+	assert.Equal(t, "memory", router.GetEngineForTopic("stream.orders"))
+
+	received := make(chan Event, 1)
+	_, err = router.Subscribe(context.Background(), "stream.orders", func(ctx context.Context, event Event) error {
+		received <- event
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, router.Publish(context.Background(), newTestCloudEvent("stream.orders", "data")))
+
+	select {
+	case event := <-received:
+		assert.Equal(t, "stream.orders", event.Type())
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timeout waiting for event on fallback engine")
+	}
+}
+
+// TestNatsEngineNoFallback tests that an unreachable default engine is still an error
+func TestNatsEngineNoFallback(t *testing.T) {
+	config := &EventBusConfig{
+		Engines: []EngineConfig{
+			{Name: "nats-stream", Type: "nats", Config: map[string]interface{}{
+				"url":            "nats://127.0.0.1:1",
+				"allowReconnect": false,
+			}},
+			{Name: "memory", Type: "memory"},
+		},
+	}
+
+	_, err := NewEngineRouter(config)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNoFallbackEngine)
+	assert.ErrorIs(t, err, ErrEngineUnavailable)
+}
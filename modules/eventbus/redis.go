@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
@@ -23,6 +24,8 @@ type RedisEventBus struct {
 	cancel        context.CancelFunc
 	wg            sync.WaitGroup
 	isStarted     atomic.Bool
+	breaker       *circuitBreaker // nil when disabled
+	probes        sync.WaitGroup  // recovery probes, stopped with the bus context
 }
 
 // RedisConfig holds Redis-specific configuration
@@ -32,6 +35,16 @@ type RedisConfig struct {
 	Username string `json:"username"`
 	Password string `json:"password"` //nolint:gosec // config field, not a hardcoded secret
 	PoolSize int    `json:"poolSize"`
+
+	// CircuitBreakerThreshold is the number of consecutive publish failures
+	// that open the circuit breaker, routing the engine's topics to the
+	// fallback engine until Redis answers again. Defaults to 5; a negative
+	// value disables the breaker.
+	CircuitBreakerThreshold int `json:"circuitBreakerThreshold"`
+
+	// CircuitBreakerProbeInterval is how often Redis is pinged while the
+	// circuit breaker is open. Defaults to 5s.
+	CircuitBreakerProbeInterval time.Duration `json:"circuitBreakerProbeInterval"`
 }
 
 // redisSubscription represents a subscription in the Redis event bus
@@ -79,6 +92,25 @@ func (s *redisSubscription) Cancel() error {
 	return nil
 }
 
+// unsubscribe tells Redis to stop sending messages to the subscription.
+// Failures are logged, closing the connection ends the subscription anyway.
+func (s *redisSubscription) unsubscribe(ctx context.Context) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	if s.cancelled || s.pubsub == nil {
+		return
+	}
+	var err error
+	if strings.Contains(s.topic, "*") || hasSegmentWildcard(s.topic) {
+		err = s.pubsub.PUnsubscribe(ctx)
+	} else {
+		err = s.pubsub.Unsubscribe(ctx)
+	}
+	if err != nil {
+		slog.Warn("failed to unsubscribe from Redis during shutdown", "error", err, "topic", s.topic)
+	}
+}
+
 // NewRedisEventBus creates a new Redis-based event bus
 func NewRedisEventBus(config map[string]interface{}) (EventBus, error) {
 	redisConfig := &RedisConfig{
@@ -103,6 +135,20 @@ func NewRedisEventBus(config map[string]interface{}) (EventBus, error) {
 	if poolSize, ok := config["poolSize"].(int); ok {
 		redisConfig.PoolSize = poolSize
 	}
+	if threshold, ok := config["circuitBreakerThreshold"].(int); ok {
+		redisConfig.CircuitBreakerThreshold = threshold
+	}
+	switch interval := config["circuitBreakerProbeInterval"].(type) {
+	case time.Duration:
+		redisConfig.CircuitBreakerProbeInterval = interval
+	case string:
+		if duration, err := time.ParseDuration(interval); err == nil {
+			redisConfig.CircuitBreakerProbeInterval = duration
+		}
+	}
+	if redisConfig.CircuitBreakerProbeInterval <= 0 {
+		redisConfig.CircuitBreakerProbeInterval = defaultCircuitBreakerProbeInterval
+	}
 
 	// Parse Redis connection URL
 	opts, err := redis.ParseURL(redisConfig.URL)
@@ -126,6 +172,7 @@ func NewRedisEventBus(config map[string]interface{}) (EventBus, error) {
 		config:        redisConfig,
 		client:        client,
 		subscriptions: make(map[string]map[string]*redisSubscription),
+		breaker:       newCircuitBreaker(redisConfig.CircuitBreakerThreshold),
 	}, nil
 }
 
@@ -138,7 +185,7 @@ func (r *RedisEventBus) Start(ctx context.Context) error {
 	// Test connection
 	_, err := r.client.Ping(ctx).Result()
 	if err != nil {
-		return fmt.Errorf("failed to connect to Redis: %w", err)
+		return fmt.Errorf("%w: failed to connect to Redis: %w", ErrEngineUnavailable, err)
 	}
 
 	r.ctx, r.cancel = context.WithCancel(ctx) //nolint:gosec // G118: cancel is stored in r.cancel and called in Stop()
@@ -152,15 +199,12 @@ func (r *RedisEventBus) Stop(ctx context.Context) error {
 		return nil
 	}
 
-	// Cancel context to signal all workers to stop
-	if r.cancel != nil {
-		r.cancel()
-	}
-
-	// Cancel all subscriptions
+	// Unsubscribe from Redis first so no new messages arrive, while the bus
+	// context stays live for the handlers still running
 	r.topicMutex.Lock()
 	for _, subs := range r.subscriptions {
 		for _, sub := range subs {
+			sub.unsubscribe(ctx)
 			if err := sub.Cancel(); err != nil {
 				slog.Warn("failed to cancel Redis subscription during shutdown", "error", err)
 			}
@@ -169,7 +213,14 @@ func (r *RedisEventBus) Stop(ctx context.Context) error {
 	r.subscriptions = make(map[string]map[string]*redisSubscription)
 	r.topicMutex.Unlock()
 
-	// Wait for all workers to finish
+	// Wait for the message listeners and in-flight async handlers to finish,
+	// then cancel the context, which also stops a circuit breaker probe
+	defer func() {
+		if r.cancel != nil {
+			r.cancel()
+		}
+		r.probes.Wait()
+	}()
 	done := make(chan struct{})
 	go func() {
 		defer func() {
@@ -203,6 +254,11 @@ func (r *RedisEventBus) Publish(ctx context.Context, event Event) error {
 		return ErrEventBusNotStarted
 	}
 
+	// Fail fast while Redis is known to be down
+	if cause := r.breaker.openCause(); cause != nil {
+		return fmt.Errorf("%w: %w", ErrEngineUnavailable, cause)
+	}
+
 	eventData, err := json.Marshal(event)
 	if err != nil {
 		return fmt.Errorf("failed to serialize event: %w", err)
@@ -211,12 +267,52 @@ func (r *RedisEventBus) Publish(ctx context.Context, event Event) error {
 	// Publish to Redis
 	err = r.client.Publish(ctx, event.Type(), eventData).Err()
 	if err != nil {
+		// A cancelled publisher says nothing about Redis health
+		if ctx.Err() == nil && r.breaker.recordFailure(err) {
+			slog.Warn("Redis circuit breaker opened, routing topics to the fallback engine",
+				"error", err, "probe_interval", r.config.CircuitBreakerProbeInterval)
+			r.probes.Add(1)
+			go r.probeUntilRecovered()
+			// Let the router retry this event on the fallback engine too
+			return fmt.Errorf("%w: failed to publish to Redis: %w", ErrEngineUnavailable, err)
+		}
 		return fmt.Errorf("failed to publish to Redis: %w", err)
 	}
+	r.breaker.recordSuccess()
 
 	return nil
 }
 
+// probeUntilRecovered pings Redis every CircuitBreakerProbeInterval while the
+// circuit breaker is open and closes it once Redis answers.
+func (r *RedisEventBus) probeUntilRecovered() {
+	defer r.probes.Done()
+	interval := r.config.CircuitBreakerProbeInterval
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			probeCtx, cancel := context.WithTimeout(r.ctx, interval)
+			err := r.client.Ping(probeCtx).Err()
+			cancel()
+			if err == nil {
+				r.breaker.close()
+				slog.Info("Redis circuit breaker closed, Redis is reachable again")
+				return
+			}
+		}
+	}
+}
+
+// unavailableCause reports why the engine's topics are routed to the fallback
+// engine, or nil while Redis is considered reachable.
+func (r *RedisEventBus) unavailableCause() error {
+	return r.breaker.openCause()
+}
+
 // Subscribe registers a handler for a topic
 func (r *RedisEventBus) Subscribe(ctx context.Context, topic string, handler EventHandler) (Subscription, error) {
 	return r.subscribe(ctx, topic, handler, false)
@@ -239,7 +335,11 @@ func (r *RedisEventBus) subscribe(ctx context.Context, topic string, handler Eve
 
 	// Create Redis subscription
 	var pubsub *redis.PubSub
-	if strings.Contains(topic, "*") {
+	if hasSegmentWildcard(topic) {
+		// Redis glob patterns have no segment wildcards: subscribe to a wider
+		// pattern and filter the messages with matchesTopic
+		pubsub = r.client.PSubscribe(ctx, redisSegmentPattern(topic))
+	} else if strings.Contains(topic, "*") {
 		// Use pattern subscription for wildcard topics
 		pubsub = r.client.PSubscribe(ctx, topic)
 	} else {
@@ -354,6 +454,12 @@ func (r *RedisEventBus) handleMessages(sub *redisSubscription) {
 				continue
 			}
 
+			// Redis patterns have no segment wildcards and match reserved
+			// reply topics, so filter those with matchesTopic
+			if (hasSegmentWildcard(sub.topic) || isReplyTopic(msg.Channel)) && !matchesTopic(msg.Channel, sub.topic) {
+				continue
+			}
+
 			// Deserialize event
 			var event Event
 			err := json.Unmarshal([]byte(msg.Payload), &event)
@@ -365,7 +471,12 @@ func (r *RedisEventBus) handleMessages(sub *redisSubscription) {
 			// Process the event
 			if sub.isAsync {
 				// For async subscriptions, process in a separate goroutine
-				go r.processEventAsync(sub, event)
+				// tracked by wg so Stop waits for it
+				r.wg.Add(1)
+				go func() {
+					defer r.wg.Done()
+					r.processEventAsync(sub, event)
+				}()
 			} else {
 				// For sync subscriptions, process immediately
 				r.processEvent(sub, event)
@@ -374,6 +485,27 @@ func (r *RedisEventBus) handleMessages(sub *redisSubscription) {
 	}
 }
 
+// redisSegmentPattern converts a topic pattern with '+' and '#' segment
+// wildcards to a Redis glob pattern matching at least the same channels
+func redisSegmentPattern(topic string) string {
+	segments := strings.Split(topic, topicSeparator)
+	multiLevel := segments[len(segments)-1] == topicMultiLevelWildcard
+	if multiLevel {
+		segments = segments[:len(segments)-1]
+	}
+	for i, segment := range segments {
+		if segment == topicSingleWildcard {
+			segments[i] = "*"
+		}
+	}
+	pattern := strings.Join(segments, topicSeparator)
+	if multiLevel {
+		// "a.#" also matches "a", so the separator is part of the wildcard
+		pattern += "*"
+	}
+	return pattern
+}
+
 // processEvent processes an event synchronously
 func (r *RedisEventBus) processEvent(sub *redisSubscription, event Event) {
 	err := sub.handler(r.ctx, event)
@@ -0,0 +1,122 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CloudEvents extension attributes used by the request/reply pattern.
+// Extension names must be lowercase alphanumeric per the CloudEvents spec.
+const (
+	// ExtensionCorrelationID carries the correlation ID shared by a request and its reply.
+	ExtensionCorrelationID = "correlationid"
+
+	// ExtensionReplyTo carries the topic a reply to a request must be published to.
+	ExtensionReplyTo = "replyto"
+)
+
+// Request/reply errors
+var (
+	// ErrRequestTimeout is returned by Request when no reply arrives in time.
+	ErrRequestTimeout = errors.New("request timed out waiting for reply")
+
+	// ErrNotARequest is returned by Reply when the original event carries no
+	// reply-to topic or correlation ID.
+	ErrNotARequest = errors.New("event is not a request")
+)
+
+// Request publishes payload to topic and waits for a single reply, providing
+// RPC-style messaging on top of pub/sub. The request event carries a generated
+// correlation ID and a reply-to topic as CloudEvents extensions; a handler
+// answers it by calling Reply with the received event.
+//
+// The reply topic lives in the reserved ReplyTopicPrefix namespace
+// ("_reply.<topic>.<id>"), which wildcard subscriptions do not match, and is
+// routed to the same engine as the request topic. A timeout of zero or less
+// waits until ctx is done.
+//
+// Example:
+//
+//	// Responder
+//	eventBus.Subscribe(ctx, "user.lookup", func(ctx context.Context, event eventbus.Event) error {
+//	    var req LookupRequest
+//	    if err := event.DataAs(&req); err != nil {
+//	        return err
+//	    }
+//	    return eventBus.Reply(ctx, event, findUser(req.ID))
+//	})
+//
+//	// Requester
+//	reply, err := eventBus.Request(ctx, "user.lookup", LookupRequest{ID: "42"}, 2*time.Second)
+func (m *EventBusModule) Request(ctx context.Context, topic string, payload interface{}, timeout time.Duration) (Event, error) {
+	correlationID := uuid.New().String()
+	replyTopic := replyTopicFor(topic, correlationID)
+
+	event, err := m.newEvent(topic, payload)
+	if err != nil {
+		return Event{}, err
+	}
+	event.SetExtension(ExtensionCorrelationID, correlationID)
+	event.SetExtension(ExtensionReplyTo, replyTopic)
+
+	replies := make(chan Event, 1)
+	sub, err := m.Subscribe(ctx, replyTopic, func(ctx context.Context, reply Event) error {
+		if id, _ := reply.Extensions()[ExtensionCorrelationID].(string); id != correlationID {
+			return nil
+		}
+		select {
+		case replies <- reply:
+		default:
+			// Only the first reply is returned; later ones are ignored
+		}
+		return nil
+	})
+	if err != nil {
+		return Event{}, fmt.Errorf("subscribing to reply topic: %w", err)
+	}
+	defer func() {
+		_ = m.Unsubscribe(context.Background(), sub)
+	}()
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	if err := m.publishEvent(ctx, event); err != nil {
+		return Event{}, err
+	}
+
+	select {
+	case reply := <-replies:
+		return reply, nil
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return Event{}, fmt.Errorf("%w: topic %s", ErrRequestTimeout, topic)
+		}
+		return Event{}, fmt.Errorf("waiting for reply on topic %s: %w", topic, ctx.Err())
+	}
+}
+
+// Reply publishes payload as the response to a request event received by a
+// handler. Returns ErrNotARequest if original was not published via Request.
+func (m *EventBusModule) Reply(ctx context.Context, original Event, payload interface{}) error {
+	extensions := original.Extensions()
+	replyTo, _ := extensions[ExtensionReplyTo].(string)
+	correlationID, _ := extensions[ExtensionCorrelationID].(string)
+	if replyTo == "" || correlationID == "" {
+		return fmt.Errorf("%w: %s", ErrNotARequest, original.ID())
+	}
+
+	event, err := m.newEvent(replyTo, payload)
+	if err != nil {
+		return err
+	}
+	event.SetExtension(ExtensionCorrelationID, correlationID)
+	return m.publishEvent(ctx, event)
+}
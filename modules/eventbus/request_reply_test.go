@@ -0,0 +1,119 @@
+package eventbus
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestReply(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ReceivesReply", func(t *testing.T) {
+		module := newStartedTestModule(t)
+
+		_, err := module.Subscribe(ctx, "math.double", func(ctx context.Context, event Event) error {
+			var n int
+			if err := event.DataAs(&n); err != nil {
+				return err
+			}
+			return module.Reply(ctx, event, n*2)
+		})
+		require.NoError(t, err)
+
+		reply, err := module.Request(ctx, "math.double", 21, 2*time.Second)
+		require.NoError(t, err)
+
+		var result int
+		require.NoError(t, reply.DataAs(&result))
+		assert.Equal(t, 42, result)
+		assert.NotEmpty(t, reply.Extensions()[ExtensionCorrelationID])
+
+		// The temporary reply subscription is removed once the reply arrives
+		for _, info := range module.ListSubscriptions() {
+			assert.Equal(t, "math.double", info.Topic)
+		}
+	})
+
+	t.Run("WildcardSubscribersDoNotSeeReplies", func(t *testing.T) {
+		module := newStartedTestModule(t)
+
+		_, err := module.Subscribe(ctx, "math.double", func(ctx context.Context, event Event) error {
+			return module.Reply(ctx, event, 2)
+		})
+		require.NoError(t, err)
+
+		var mu sync.Mutex
+		var seen []string
+		for _, pattern := range []string{"*", "#", "math.*"} {
+			_, err := module.Subscribe(ctx, pattern, func(ctx context.Context, event Event) error {
+				mu.Lock()
+				defer mu.Unlock()
+				seen = append(seen, event.Type())
+				return nil
+			})
+			require.NoError(t, err)
+		}
+
+		reply, err := module.Request(ctx, "math.double", 1, 2*time.Second)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(reply.Type(), ReplyTopicPrefix))
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, []string{"math.double", "math.double", "math.double"}, seen)
+	})
+
+	t.Run("ConcurrentRequestsAreCorrelated", func(t *testing.T) {
+		module := newStartedTestModule(t)
+
+		_, err := module.SubscribeAsync(ctx, "echo", func(ctx context.Context, event Event) error {
+			var s string
+			if err := event.DataAs(&s); err != nil {
+				return err
+			}
+			return module.Reply(ctx, event, s)
+		})
+		require.NoError(t, err)
+
+		inputs := []string{"a", "b", "c", "d"}
+		results := make([]string, len(inputs))
+		errs := make(chan error, len(inputs))
+		for i, in := range inputs {
+			go func() {
+				reply, err := module.Request(ctx, "echo", in, 2*time.Second)
+				if err == nil {
+					err = reply.DataAs(&results[i])
+				}
+				errs <- err
+			}()
+		}
+		for range inputs {
+			require.NoError(t, <-errs)
+		}
+		assert.Equal(t, inputs, results)
+	})
+
+	t.Run("TimesOut", func(t *testing.T) {
+		module := newStartedTestModule(t)
+
+		start := time.Now()
+		_, err := module.Request(ctx, "nobody.listens", "ping", 50*time.Millisecond)
+		require.ErrorIs(t, err, ErrRequestTimeout)
+		assert.Less(t, time.Since(start), time.Second)
+		assert.Empty(t, module.ListSubscriptions())
+	})
+
+	t.Run("ReplyToNonRequest", func(t *testing.T) {
+		module := newStartedTestModule(t)
+
+		event, err := module.newEvent("plain", "data")
+		require.NoError(t, err)
+		require.ErrorIs(t, module.Reply(ctx, event, "x"), ErrNotARequest)
+	})
+}
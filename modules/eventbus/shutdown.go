@@ -0,0 +1,106 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// defaultShutdownTimeout bounds the flush of each engine when
+	// EventBusConfig.ShutdownTimeout is not set.
+	defaultShutdownTimeout = 5 * time.Second
+
+	// flushPollInterval is how often a flushing engine checks whether all of
+	// its events have been handled.
+	flushPollInterval = 5 * time.Millisecond
+)
+
+// DrainStats tells how an engine's queued and in-flight events ended when the
+// event bus stopped.
+type DrainStats struct {
+	// Drained is the number of events handled while the engine was flushed.
+	Drained uint64 `json:"drained" yaml:"drained"`
+
+	// Dropped is the number of events discarded while stopping, either because
+	// the flush timed out or because the engine cannot be flushed.
+	Dropped uint64 `json:"dropped" yaml:"dropped"`
+
+	// TimedOut is true when the engine still had events after the flush timeout.
+	TimedOut bool `json:"timedOut" yaml:"timedOut"`
+}
+
+// flusher is implemented by engines that can wait for their queued and
+// in-flight events to be handled. flush returns ctx.Err() if events remain
+// when ctx is done.
+type flusher interface {
+	flush(ctx context.Context) error
+}
+
+// waitUntil polls drained until it returns true or ctx is done.
+func waitUntil(ctx context.Context, drained func() bool) error {
+	ticker := time.NewTicker(flushPollInterval)
+	defer ticker.Stop()
+	for !drained() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err() //nolint:wrapcheck // callers only check that the flush timed out
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// Shutdown flushes and stops all managed engines. Each engine that supports it
+// gets up to flushTimeout to handle its queued and in-flight events before it
+// is stopped; publishing keeps working meanwhile, so handlers can publish
+// follow-up events. The returned stats tell, per engine, how many events were
+// drained and dropped. The error reports engines that failed to stop; a flush
+// timeout is only reported through DrainStats.TimedOut.
+func (r *EngineRouter) Shutdown(ctx context.Context, flushTimeout time.Duration) (map[string]DrainStats, error) {
+	if flushTimeout <= 0 {
+		flushTimeout = defaultShutdownTimeout
+	}
+
+	stats := make(map[string]DrainStats, len(r.engines))
+	var errs []error
+	for name, engine := range r.engines {
+		deliveredBefore, droppedBefore := engineDeliveryCounts(engine)
+
+		var drain DrainStats
+		if f, ok := engine.(flusher); ok && r.isEngineAvailable(name) {
+			flushCtx, cancel := context.WithTimeout(ctx, flushTimeout)
+			drain.TimedOut = f.flush(flushCtx) != nil
+			cancel()
+		}
+		if _, counted := engine.(statsProvider); !counted {
+			// Engines without a dropped counter lose what is still queued
+			if q, ok := engine.(queuedEventsProvider); ok {
+				drain.Dropped += uint64(q.queuedEvents()) //nolint:gosec // queue lengths are never negative
+			}
+		}
+
+		if err := engine.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop engine %s: %w", name, err))
+		}
+
+		deliveredAfter, droppedAfter := engineDeliveryCounts(engine)
+		drain.Drained = deliveredAfter - deliveredBefore
+		drain.Dropped += droppedAfter - droppedBefore
+		stats[name] = drain
+	}
+	return stats, errors.Join(errs...)
+}
+
+// engineDeliveryCounts returns the delivered and dropped counters of engine,
+// zero for the counters it does not expose.
+func engineDeliveryCounts(engine EventBus) (delivered, dropped uint64) {
+	switch sp := engine.(type) {
+	case statsProvider:
+		return sp.Stats()
+	case deliveredStatsProvider:
+		return sp.Stats(), 0
+	}
+	return 0, 0
+}
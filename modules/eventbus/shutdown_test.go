@@ -0,0 +1,128 @@
+package eventbus
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newShutdownModule(t *testing.T, cfg *EventBusConfig) (*EventBusModule, *eventRecordingSubject) {
+	t.Helper()
+	module := NewModule().(*EventBusModule)
+	app := newMockApp()
+	app.RegisterConfigSection(ModuleName, modular.NewStdConfigProvider(cfg))
+	require.NoError(t, module.Init(app))
+	subject := &eventRecordingSubject{}
+	require.NoError(t, module.RegisterObservers(subject))
+	require.NoError(t, module.Start(context.Background()))
+	return module, subject
+}
+
+func drainedEventData(t *testing.T, subject *eventRecordingSubject) map[string]interface{} {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return len(subject.ofType(EventTypeBusDrained)) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	var data map[string]interface{}
+	require.NoError(t, subject.ofType(EventTypeBusDrained)[0].DataAs(&data))
+	return data
+}
+
+func TestEventBusModuleStopFlushesQueuedEvents(t *testing.T) {
+	const events = 20
+
+	for _, engineType := range []string{"memory", "custom", "durable-memory"} {
+		t.Run(engineType, func(t *testing.T) {
+			module, subject := newShutdownModule(t, &EventBusConfig{
+				Engines: []EngineConfig{{Name: "engine", Type: engineType, Config: map[string]interface{}{
+					"defaultEventBufferSize": events,
+				}}},
+				ShutdownTimeout: 2 * time.Second,
+			})
+			ctx := context.Background()
+
+			var syncHandled, asyncHandled atomic.Int64
+			_, err := module.Subscribe(ctx, "work.sync", func(context.Context, Event) error {
+				time.Sleep(5 * time.Millisecond)
+				syncHandled.Add(1)
+				return nil
+			})
+			require.NoError(t, err)
+			_, err = module.SubscribeAsync(ctx, "work.async", func(context.Context, Event) error {
+				time.Sleep(5 * time.Millisecond)
+				asyncHandled.Add(1)
+				return nil
+			})
+			require.NoError(t, err)
+
+			for i := 0; i < events; i++ {
+				require.NoError(t, module.Publish(ctx, "work.sync", i))
+				require.NoError(t, module.Publish(ctx, "work.async", i))
+			}
+
+			require.NoError(t, module.Stop(ctx))
+			assert.Equal(t, int64(events), syncHandled.Load())
+			assert.Equal(t, int64(events), asyncHandled.Load())
+
+			data := drainedEventData(t, subject)
+			assert.InDelta(t, 0, data["dropped"], 0)
+			assert.Positive(t, data["drained"])
+		})
+	}
+}
+
+func TestEventBusModuleStopFlushTimeout(t *testing.T) {
+	module, subject := newShutdownModule(t, &EventBusConfig{
+		Engine:                 "memory",
+		DefaultEventBufferSize: 10,
+		ShutdownTimeout:        50 * time.Millisecond,
+	})
+	ctx := context.Background()
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	_, err := module.Subscribe(ctx, "work.stuck", func(context.Context, Event) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		return nil
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, module.Publish(ctx, "work.stuck", i))
+	}
+	<-started
+	// Unblock the handler once the flush has timed out so the engine can stop
+	time.AfterFunc(200*time.Millisecond, func() { close(release) })
+
+	err = module.Stop(ctx)
+	require.ErrorIs(t, err, ErrShutdownFlushTimeout)
+
+	// The stuck event completes, the queued ones are dropped
+	data := drainedEventData(t, subject)
+	assert.InDelta(t, 1, data["drained"], 0)
+	assert.InDelta(t, 3, data["dropped"], 0)
+	engines, ok := data["engines"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, true, engines["default"].(map[string]interface{})["timed_out"])
+}
+
+func TestEventBusModuleStopWithoutQueuedEvents(t *testing.T) {
+	module, subject := newShutdownModule(t, &EventBusConfig{Engine: "memory"})
+
+	start := time.Now()
+	require.NoError(t, module.Stop(context.Background()))
+	assert.Less(t, time.Since(start), time.Second)
+
+	data := drainedEventData(t, subject)
+	assert.InDelta(t, 0, data["drained"], 0)
+	assert.InDelta(t, 0, data["dropped"], 0)
+}
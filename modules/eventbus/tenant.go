@@ -0,0 +1,68 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/GoCodeAlone/modular"
+)
+
+// ExtensionTenantID is the CloudEvents extension attribute carrying the ID of
+// the tenant an event was published for.
+const ExtensionTenantID = "tenantid"
+
+// TenantIDFromEvent returns the tenant ID stamped into event, if any.
+//
+// Events published through the module while ctx carries a tenant (see
+// modular.NewTenantContext) are stamped automatically.
+func TenantIDFromEvent(event Event) (modular.TenantID, bool) {
+	tenantID, _ := event.Extensions()[ExtensionTenantID].(string)
+	if tenantID == "" {
+		return "", false
+	}
+	return modular.TenantID(tenantID), true
+}
+
+// FilterByTenant wraps handler so that it only receives events published for
+// tenantID; events of other tenants, and events without a tenant, are
+// skipped without error.
+//
+// Example:
+//
+//	eventBus.Subscribe(ctx, "order.created", eventbus.FilterByTenant("acme", handleAcmeOrder))
+func FilterByTenant(tenantID modular.TenantID, handler EventHandler) EventHandler {
+	if handler == nil {
+		return nil
+	}
+	return func(ctx context.Context, event Event) error {
+		if eventTenant, ok := TenantIDFromEvent(event); !ok || eventTenant != tenantID {
+			return nil
+		}
+		return handler(ctx, event)
+	}
+}
+
+// stampTenant sets the tenant extension of event from the tenant in ctx,
+// keeping a tenant the caller already set explicitly.
+func stampTenant(ctx context.Context, event *Event) {
+	if _, ok := TenantIDFromEvent(*event); ok {
+		return
+	}
+	if tenantID, ok := modular.GetTenantIDFromContext(ctx); ok && tenantID != "" {
+		event.SetExtension(ExtensionTenantID, string(tenantID))
+	}
+}
+
+// withTenantContext wraps handler so that the context it receives carries
+// the tenant the event was published for, whatever context the engine
+// delivers it with.
+func withTenantContext(handler EventHandler) EventHandler {
+	if handler == nil {
+		return nil
+	}
+	return func(ctx context.Context, event Event) error {
+		if tenantID, ok := TenantIDFromEvent(event); ok {
+			ctx = modular.NewTenantContext(ctx, tenantID)
+		}
+		return handler(ctx, event)
+	}
+}
@@ -0,0 +1,165 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tenantRecorder collects the events and context tenants a handler receives
+type tenantRecorder struct {
+	mu      sync.Mutex
+	events  []Event
+	tenants []modular.TenantID
+}
+
+func (r *tenantRecorder) handle(ctx context.Context, event Event) error {
+	tenantID, _ := modular.GetTenantIDFromContext(ctx)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+	r.tenants = append(r.tenants, tenantID)
+	return nil
+}
+
+func (r *tenantRecorder) received() ([]Event, []modular.TenantID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Event(nil), r.events...), append([]modular.TenantID(nil), r.tenants...)
+}
+
+func TestTenantPropagation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TenantStampRoundTripsThroughMemory", func(t *testing.T) {
+		module := newStartedTestModule(t)
+		recorder := &tenantRecorder{}
+		_, err := module.Subscribe(ctx, "order.created", recorder.handle)
+		require.NoError(t, err)
+
+		tenantCtx := modular.NewTenantContext(ctx, "acme")
+		require.NoError(t, module.Publish(tenantCtx, "order.created", map[string]string{"order": "1"}))
+		require.NoError(t, module.Publish(ctx, "order.created", map[string]string{"order": "2"}))
+
+		require.Eventually(t, func() bool {
+			events, _ := recorder.received()
+			return len(events) == 2
+		}, 2*time.Second, 10*time.Millisecond)
+
+		events, tenants := recorder.received()
+		tenantID, ok := TenantIDFromEvent(events[0])
+		require.True(t, ok)
+		assert.Equal(t, modular.TenantID("acme"), tenantID)
+		assert.Equal(t, "acme", events[0].Extensions()[ExtensionTenantID])
+		assert.Equal(t, modular.TenantID("acme"), tenants[0], "handler context carries the event's tenant")
+
+		_, ok = TenantIDFromEvent(events[1])
+		assert.False(t, ok, "events published without a tenant are not stamped")
+		assert.Empty(t, tenants[1])
+	})
+
+	t.Run("TenantFilteredSubscriber", func(t *testing.T) {
+		module := newStartedTestModule(t)
+		acme := &tenantRecorder{}
+		all := &tenantRecorder{}
+		_, err := module.SubscribeAsync(ctx, "order.created", FilterByTenant("acme", acme.handle))
+		require.NoError(t, err)
+		_, err = module.SubscribeAsync(ctx, "order.created", all.handle)
+		require.NoError(t, err)
+
+		for _, tenant := range []modular.TenantID{"acme", "globex", "acme", ""} {
+			require.NoError(t, module.Publish(modular.NewTenantContext(ctx, tenant), "order.created", string(tenant)))
+		}
+
+		require.Eventually(t, func() bool {
+			events, _ := all.received()
+			return len(events) == 4
+		}, 2*time.Second, 10*time.Millisecond)
+
+		events, tenants := acme.received()
+		require.Len(t, events, 2)
+		for i, event := range events {
+			var payload string
+			require.NoError(t, event.DataAs(&payload))
+			assert.Equal(t, "acme", payload)
+			assert.Equal(t, modular.TenantID("acme"), tenants[i])
+		}
+	})
+
+	t.Run("ExplicitTenantIsKept", func(t *testing.T) {
+		module := newStartedTestModule(t)
+		recorder := &tenantRecorder{}
+		_, err := module.Subscribe(ctx, "order.created", recorder.handle)
+		require.NoError(t, err)
+
+		event, err := module.newEvent("order.created", "payload")
+		require.NoError(t, err)
+		event.SetExtension(ExtensionTenantID, "globex")
+		require.NoError(t, module.publishEvent(modular.NewTenantContext(ctx, "acme"), event))
+
+		require.Eventually(t, func() bool {
+			events, _ := recorder.received()
+			return len(events) == 1
+		}, 2*time.Second, 10*time.Millisecond)
+		events, _ := recorder.received()
+		tenantID, _ := TenantIDFromEvent(events[0])
+		assert.Equal(t, modular.TenantID("globex"), tenantID)
+	})
+}
+
+func TestTenantRouting(t *testing.T) {
+	ctx := context.Background()
+	module := NewModule().(*EventBusModule)
+	app := newMockApp()
+	app.RegisterConfigSection(ModuleName, modular.NewStdConfigProvider(&EventBusConfig{
+		Engines: []EngineConfig{
+			{Name: "shared", Type: "memory"},
+			{Name: "acme-engine", Type: "memory"},
+		},
+		TenantRouting: []TenantRoutingRule{{Tenants: []string{"acme"}, Engine: "acme-engine"}},
+	}))
+	require.NoError(t, module.Init(app))
+	require.NoError(t, module.Start(ctx))
+	t.Cleanup(func() { _ = module.Stop(ctx) })
+
+	acmeCtx := modular.NewTenantContext(ctx, "acme")
+	acme := &tenantRecorder{}
+	shared := &tenantRecorder{}
+	_, err := module.Subscribe(acmeCtx, "order.created", acme.handle)
+	require.NoError(t, err)
+	_, err = module.Subscribe(ctx, "order.created", shared.handle)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, module.GetRouter().engines["acme-engine"].SubscriberCount("order.created"))
+	assert.Equal(t, 1, module.GetRouter().engines["shared"].SubscriberCount("order.created"))
+
+	require.NoError(t, module.Publish(acmeCtx, "order.created", "acme"))
+	require.NoError(t, module.Publish(modular.NewTenantContext(ctx, "globex"), "order.created", "globex"))
+
+	require.Eventually(t, func() bool {
+		acmeEvents, _ := acme.received()
+		sharedEvents, _ := shared.received()
+		return len(acmeEvents) == 1 && len(sharedEvents) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	acmeEvents, _ := acme.received()
+	sharedEvents, _ := shared.received()
+	var payload string
+	require.NoError(t, acmeEvents[0].DataAs(&payload))
+	assert.Equal(t, "acme", payload)
+	require.NoError(t, sharedEvents[0].DataAs(&payload))
+	assert.Equal(t, "globex", payload)
+}
+
+func TestTenantRoutingValidation(t *testing.T) {
+	cfg := &EventBusConfig{
+		Engines:       []EngineConfig{{Name: "shared", Type: "memory"}},
+		TenantRouting: []TenantRoutingRule{{Tenants: []string{"acme"}, Engine: "missing"}},
+	}
+	assert.ErrorIs(t, cfg.ValidateConfig(), ErrUnknownEngineRef)
+}
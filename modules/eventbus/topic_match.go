@@ -0,0 +1,104 @@
+package eventbus
+
+import "strings"
+
+// Topics are dot-separated segments, such as "user.profile.updated".
+// Subscriptions and routing rules select topics with patterns, which are one of:
+//
+//   - an exact topic
+//   - a prefix ending with '*', matching every topic starting with the prefix,
+//     across any number of segments: "user.*" matches "user.created" and
+//     "user.profile.updated". A lone "*" matches every topic.
+//   - MQTT-style segment wildcards: '+' matches exactly one segment and '#',
+//     as the last segment, matches zero or more trailing segments. "a.+.c"
+//     matches "a.b.c" but not "a.b.x.c"; "a.#" matches "a", "a.b" and
+//     "a.b.c". '+' and '#' are only wildcards when they make up a whole
+//     segment.
+//
+// An event matching several subscriptions of a topic is delivered once to
+// each of them.
+//
+// Topics starting with ReplyTopicPrefix are reserved for request/reply and
+// are only matched by patterns that themselves start with the prefix, so
+// wildcard subscribers such as "*" never see replies meant for a requester.
+const (
+	topicSeparator          = "."
+	topicPrefixWildcard     = "*"
+	topicSingleWildcard     = "+"
+	topicMultiLevelWildcard = "#"
+)
+
+// ReplyTopicPrefix is the reserved prefix of the reply topics used by
+// Request. Reply topics are "_reply.<request topic>.<correlation id>".
+const ReplyTopicPrefix = "_reply."
+
+// isReplyTopic reports whether topic is in the reserved reply namespace
+func isReplyTopic(topic string) bool {
+	return strings.HasPrefix(topic, ReplyTopicPrefix)
+}
+
+// replyTopicFor returns the reply topic for a request on topic
+func replyTopicFor(topic, correlationID string) string {
+	return ReplyTopicPrefix + topic + topicSeparator + correlationID
+}
+
+// requestTopicOfReply returns the request topic a reply topic was derived
+// from, or false if topic is not a reply topic
+func requestTopicOfReply(topic string) (string, bool) {
+	if !isReplyTopic(topic) {
+		return "", false
+	}
+	rest := strings.TrimPrefix(topic, ReplyTopicPrefix)
+	i := strings.LastIndex(rest, topicSeparator)
+	if i <= 0 {
+		return "", false
+	}
+	return rest[:i], true
+}
+
+// matchesTopic reports whether eventTopic matches the subscription or routing
+// pattern
+func matchesTopic(eventTopic, pattern string) bool {
+	if eventTopic == pattern {
+		return true
+	}
+	if isReplyTopic(eventTopic) && !isReplyTopic(pattern) {
+		return false
+	}
+	if strings.HasSuffix(pattern, topicPrefixWildcard) {
+		return strings.HasPrefix(eventTopic, strings.TrimSuffix(pattern, topicPrefixWildcard))
+	}
+	if !hasSegmentWildcard(pattern) {
+		return false
+	}
+	return matchesTopicSegments(strings.Split(eventTopic, topicSeparator), strings.Split(pattern, topicSeparator))
+}
+
+// hasSegmentWildcard reports whether pattern uses '+' or '#' segments
+func hasSegmentWildcard(pattern string) bool {
+	if !strings.ContainsAny(pattern, topicSingleWildcard+topicMultiLevelWildcard) {
+		return false
+	}
+	for _, segment := range strings.Split(pattern, topicSeparator) {
+		if segment == topicSingleWildcard || segment == topicMultiLevelWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesTopicSegments matches topic segments against pattern segments
+func matchesTopicSegments(topic, pattern []string) bool {
+	for i, segment := range pattern {
+		if segment == topicMultiLevelWildcard && i == len(pattern)-1 {
+			return true
+		}
+		if i >= len(topic) {
+			return false
+		}
+		if segment != topicSingleWildcard && segment != topic[i] {
+			return false
+		}
+	}
+	return len(topic) == len(pattern)
+}
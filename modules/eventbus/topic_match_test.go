@@ -0,0 +1,141 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesTopic(t *testing.T) {
+	tests := []struct {
+		pattern string
+		topic   string
+		want    bool
+	}{
+		{"user.created", "user.created", true},
+		{"user.created", "user.updated", false},
+
+		// '*' is a prefix wildcard spanning any number of segments
+		{"user.*", "user.created", true},
+		{"user.*", "user.profile.updated", true},
+		{"user.*", "user", false},
+		{"*", "anything.at.all", true},
+
+		// '+' matches exactly one segment
+		{"a.+.c", "a.b.c", true},
+		{"a.+.c", "a.x.c", true},
+		{"a.+.c", "a.b.x.c", false},
+		{"a.+.c", "a.c", false},
+		{"a.+", "a.b", true},
+		{"a.+", "a.b.c", false},
+		{"+", "a", true},
+		{"+", "a.b", false},
+
+		// '#' matches zero or more trailing segments
+		{"a.#", "a", true},
+		{"a.#", "a.b", true},
+		{"a.#", "a.b.c", true},
+		{"a.#", "ab", false},
+		{"a.#", "b.a", false},
+		{"#", "a.b.c", true},
+		{"a.+.#", "a.b", true},
+		{"a.+.#", "a.b.c.d", true},
+		{"a.+.#", "a", false},
+
+		// '+' and '#' only are wildcards as whole segments
+		{"a.b+", "a.bc", false},
+		{"a.b+", "a.b+", true},
+		{"a.#.c", "a.b.c", false},
+		{"a.#.c", "a.#.c", true},
+
+		// Reserved reply topics are only matched explicitly
+		{"*", "_reply.user.lookup.42", false},
+		{"#", "_reply.user.lookup.42", false},
+		{"_*", "_reply.user.lookup.42", false},
+		{"_reply.*", "_reply.user.lookup.42", true},
+		{"_reply.user.lookup.+", "_reply.user.lookup.42", true},
+		{"_reply.user.lookup.42", "_reply.user.lookup.42", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.topic, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchesTopic(tt.topic, tt.pattern))
+		})
+	}
+}
+
+func TestMemoryEventBusOverlappingWildcardSubscriptions(t *testing.T) {
+	bus := NewMemoryEventBus(&EventBusConfig{
+		MaxEventQueueSize:      10,
+		DefaultEventBufferSize: 10,
+		WorkerCount:            2,
+		RetentionDays:          1,
+	})
+	ctx := context.Background()
+	require.NoError(t, bus.Start(ctx))
+	defer bus.Stop(ctx) //nolint:errcheck
+
+	var (
+		mu       sync.Mutex
+		received = make(map[string][]string)
+	)
+	patterns := []string{"a.b.c", "a.+.c", "a.#", "a.*", "+.b.+", "#", "a.+", "x.#"}
+	for _, pattern := range patterns {
+		_, err := bus.Subscribe(ctx, pattern, func(_ context.Context, e Event) error {
+			mu.Lock()
+			received[pattern] = append(received[pattern], e.ID())
+			mu.Unlock()
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	event := evt112("a.b.c")
+	require.NoError(t, bus.Publish(ctx, event))
+
+	matching := patterns[:6]
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == len(matching)
+	}, 2*time.Second, 10*time.Millisecond)
+	// Give a duplicate delivery the chance to show up
+	time.Sleep(50 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, pattern := range matching {
+		assert.Equal(t, []string{event.ID()}, received[pattern], pattern)
+	}
+	assert.NotContains(t, received, "a.+")
+	assert.NotContains(t, received, "x.#")
+}
+
+func TestEngineRouterSegmentWildcardRouting(t *testing.T) {
+	router, err := NewEngineRouter(&EventBusConfig{
+		Engines: []EngineConfig{
+			{Name: "default", Type: "memory"},
+			{Name: "entities", Type: "memory"},
+			{Name: "audit", Type: "memory"},
+		},
+		Routing: []RoutingRule{
+			{Topics: []string{"entity.+.changed"}, Engine: "entities"},
+			{Topics: []string{"audit.#"}, Engine: "audit"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "entities", router.GetEngineForTopic("entity.user.changed"))
+	assert.Equal(t, "default", router.GetEngineForTopic("entity.user.profile.changed"))
+	assert.Equal(t, "audit", router.GetEngineForTopic("audit"))
+	assert.Equal(t, "audit", router.GetEngineForTopic("audit.login.failed"))
+	assert.Equal(t, "default", router.GetEngineForTopic("auditing"))
+
+	// Reply topics follow the routing of their request topic
+	assert.Equal(t, "audit", router.GetEngineForTopic(replyTopicFor("audit.login.failed", "42")))
+	assert.Equal(t, "entities", router.GetEngineForTopic(replyTopicFor("entity.user.changed", "42")))
+}
@@ -0,0 +1,102 @@
+package jsonschema_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/GoCodeAlone/modular/modules/jsonschema"
+)
+
+func TestCompileSchemaBytes(t *testing.T) {
+	service := jsonschema.NewJSONSchemaService()
+
+	t.Run("RelativeRef", func(t *testing.T) {
+		// The referenced schema lives next to the in-memory schema's base URI
+		dir := t.TempDir()
+		address := `{"type": "object", "properties": {"city": {"type": "string"}}, "required": ["city"]}`
+		if err := os.WriteFile(filepath.Join(dir, "address.json"), []byte(address), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		schema, err := service.CompileSchemaBytes(filepath.Join(dir, "user.json"), []byte(`{
+			"type": "object",
+			"properties": {
+				"address": {"$ref": "address.json"},
+				"nickname": {"$ref": "#/$defs/name"}
+			},
+			"$defs": {"name": {"type": "string", "minLength": 2}}
+		}`))
+		if err != nil {
+			t.Fatalf("CompileSchemaBytes failed: %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "user.json")); !errors.Is(err, os.ErrNotExist) {
+			t.Error("compiling from bytes must not write the schema to disk")
+		}
+
+		valid := map[string]interface{}{"address": map[string]interface{}{"city": "Oslo"}, "nickname": "Al"}
+		if err := service.ValidateInterface(schema, valid); err != nil {
+			t.Errorf("expected valid data to pass, got %v", err)
+		}
+		if err := service.ValidateInterface(schema, map[string]interface{}{"address": map[string]interface{}{}}); err == nil {
+			t.Error("expected referenced schema's required property to be enforced")
+		}
+		if err := service.ValidateInterface(schema, map[string]interface{}{"nickname": "A"}); err == nil {
+			t.Error("expected $defs reference to be enforced")
+		}
+	})
+
+	t.Run("RefToRegisteredSchema", func(t *testing.T) {
+		if err := service.RegisterSchema("money", "1", []byte(`{"type": "number", "minimum": 0}`)); err != nil {
+			t.Fatal(err)
+		}
+		schema, err := service.CompileSchemaReader("registry:///invoice", strings.NewReader(`{"properties": {"total": {"$ref": "money"}}}`))
+		if err != nil {
+			t.Fatalf("CompileSchemaReader failed: %v", err)
+		}
+		if err := service.ValidateInterface(schema, map[string]interface{}{"total": -1}); err == nil {
+			t.Error("expected registered schema reference to be enforced")
+		}
+	})
+
+	t.Run("InvalidSchema", func(t *testing.T) {
+		_, err := service.CompileSchemaBytes("https://example.com/broken.json", []byte(`{"type": 12}`))
+		if err == nil {
+			t.Fatal("expected invalid schema to fail")
+		}
+		if !strings.Contains(err.Error(), "https://example.com/broken.json") {
+			t.Errorf("expected error to name the schema id, got %v", err)
+		}
+
+		_, err = service.CompileSchemaBytes("https://example.com/garbage.json", []byte(`{not json`))
+		if err == nil || !strings.Contains(err.Error(), "parsing schema") {
+			t.Errorf("expected a parse error, got %v", err)
+		}
+	})
+
+	t.Run("ConcurrentDistinctIDs", func(t *testing.T) {
+		var wg sync.WaitGroup
+		errs := make(chan error, 20)
+		for i := range 20 {
+			wg.Go(func() {
+				id := fmt.Sprintf("https://example.com/schemas/s%d.json", i)
+				schema, err := service.CompileSchemaBytes(id, []byte(fmt.Sprintf(`{"const": %d}`, i)))
+				if err == nil {
+					err = service.ValidateInterface(schema, i)
+				}
+				errs <- err
+			})
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			if err != nil {
+				t.Error(err)
+			}
+		}
+	})
+}
@@ -8,4 +8,13 @@ import (
 var (
 	// ErrNoSubjectForEventEmission is returned when trying to emit events without a subject
 	ErrNoSubjectForEventEmission = errors.New("no subject available for event emission")
+
+	// ErrInvalidSchemaName is returned when a schema is registered with an empty or malformed name or version
+	ErrInvalidSchemaName = errors.New("invalid schema name or version")
+
+	// ErrSchemaAlreadyRegistered is returned when a schema name and version is registered twice
+	ErrSchemaAlreadyRegistered = errors.New("schema already registered")
+
+	// ErrSchemaNotFound is returned when compiling a schema that was not registered
+	ErrSchemaNotFound = errors.New("schema not registered")
 )
@@ -0,0 +1,129 @@
+package jsonschema
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// registryBaseURL is the base URL under which registered schemas are exposed
+// to the compiler. A schema registered as "user" version "2" lives at
+// registry:///user@2, and registry:///user aliases the most recently
+// registered version, so a relative "$ref": "user" or "$ref": "user@2"
+// resolves between registered schemas.
+const registryBaseURL = "registry:///"
+
+// schemaRegistry holds registered schema documents and their compiled forms
+type schemaRegistry struct {
+	mutex    sync.RWMutex
+	docs     map[string]any    // name@version -> parsed document
+	latest   map[string]string // name -> most recently registered version
+	compiled map[string]Schema // name@version -> compiled schema
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{
+		docs:     make(map[string]any),
+		latest:   make(map[string]string),
+		compiled: make(map[string]Schema),
+	}
+}
+
+// registryKey identifies a registered schema version
+func registryKey(name, version string) string {
+	return name + "@" + version
+}
+
+// addResources makes every registered schema resolvable by compiler.
+// Callers must hold the registry mutex.
+func (r *schemaRegistry) addResources(compiler *jsonschema.Compiler) error {
+	for key, doc := range r.docs {
+		if err := compiler.AddResource(registryBaseURL+key, doc); err != nil {
+			return fmt.Errorf("failed to add schema %s: %w", key, err)
+		}
+	}
+	for name, version := range r.latest {
+		if err := compiler.AddResource(registryBaseURL+name, r.docs[registryKey(name, version)]); err != nil {
+			return fmt.Errorf("failed to add schema %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// RegisterSchema stores a schema document under name and version so it can be
+// compiled with CompileRegistered and referenced from other registered schemas.
+func (s *schemaServiceImpl) RegisterSchema(name, version string, schema []byte) error {
+	if name == "" || version == "" || strings.ContainsAny(name, "@/#") || strings.ContainsAny(version, "/#") {
+		return fmt.Errorf("%w: %q version %q", ErrInvalidSchemaName, name, version)
+	}
+
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schema))
+	if err != nil {
+		return fmt.Errorf("failed to parse schema %s: %w", registryKey(name, version), err)
+	}
+
+	s.registry.mutex.Lock()
+	defer s.registry.mutex.Unlock()
+
+	key := registryKey(name, version)
+	if _, exists := s.registry.docs[key]; exists {
+		return fmt.Errorf("%w: %s", ErrSchemaAlreadyRegistered, key)
+	}
+	s.registry.docs[key] = doc
+	s.registry.latest[name] = version
+
+	// Unversioned references may now resolve to the new version
+	s.registry.compiled = make(map[string]Schema)
+	return nil
+}
+
+// CompileRegistered compiles the registered schema with the given name and
+// version, resolving references to other registered schemas. Compiled schemas
+// are cached, so repeated calls return the same Schema.
+func (s *schemaServiceImpl) CompileRegistered(name, version string) (Schema, error) {
+	key := registryKey(name, version)
+
+	s.registry.mutex.RLock()
+	cached, ok := s.registry.compiled[key]
+	s.registry.mutex.RUnlock()
+	if ok {
+		return cached, nil
+	}
+
+	s.registry.mutex.Lock()
+	defer s.registry.mutex.Unlock()
+
+	if cached, ok := s.registry.compiled[key]; ok {
+		return cached, nil
+	}
+	if _, exists := s.registry.docs[key]; !exists {
+		return nil, fmt.Errorf("%w: %s", ErrSchemaNotFound, key)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := s.registry.addResources(compiler); err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	compiled, err := compiler.Compile(registryBaseURL + key)
+	if err != nil {
+		s.emitEvent(ctx, EventTypeSchemaError, map[string]interface{}{
+			"source": key,
+			"error":  err.Error(),
+		})
+		return nil, fmt.Errorf("failed to compile schema %s: %w", key, err)
+	}
+
+	s.emitEvent(ctx, EventTypeSchemaCompiled, map[string]interface{}{
+		"source": key,
+	})
+
+	schema := &schemaWrapper{schema: compiled, service: s}
+	s.registry.compiled[key] = schema
+	return schema, nil
+}
@@ -0,0 +1,119 @@
+package jsonschema_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GoCodeAlone/modular/modules/jsonschema"
+)
+
+func TestSchemaRegistry(t *testing.T) {
+	service := jsonschema.NewJSONSchemaService()
+
+	mustRegister := func(name, version, schema string) {
+		t.Helper()
+		if err := service.RegisterSchema(name, version, []byte(schema)); err != nil {
+			t.Fatalf("RegisterSchema(%s, %s) failed: %v", name, version, err)
+		}
+	}
+
+	mustRegister("address", "1.0.0", `{
+		"type": "object",
+		"properties": {"city": {"type": "string"}},
+		"required": ["city"]
+	}`)
+	mustRegister("address", "2.0.0", `{
+		"type": "object",
+		"properties": {"city": {"type": "string"}, "zip": {"type": "string"}},
+		"required": ["city", "zip"]
+	}`)
+	mustRegister("user", "1.0.0", `{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"home": {"$ref": "address@1.0.0"},
+			"work": {"$ref": "address"}
+		},
+		"required": ["name"]
+	}`)
+
+	t.Run("ResolvesRefsBetweenRegisteredSchemas", func(t *testing.T) {
+		schema, err := service.CompileRegistered("user", "1.0.0")
+		if err != nil {
+			t.Fatalf("CompileRegistered failed: %v", err)
+		}
+
+		valid := map[string]interface{}{
+			"name": "Alice",
+			"home": map[string]interface{}{"city": "Berlin"},
+			"work": map[string]interface{}{"city": "Paris", "zip": "75001"},
+		}
+		if err := service.ValidateInterface(schema, valid); err != nil {
+			t.Errorf("expected valid data to pass, got %v", err)
+		}
+
+		// "work" references the latest address version, which requires zip
+		invalid := map[string]interface{}{
+			"name": "Alice",
+			"work": map[string]interface{}{"city": "Paris"},
+		}
+		if err := service.ValidateInterface(schema, invalid); err == nil {
+			t.Error("expected missing zip in latest address version to fail")
+		}
+
+		// "home" is pinned to address 1.0.0, which does not require zip
+		pinned := map[string]interface{}{
+			"name": "Alice",
+			"home": map[string]interface{}{"city": "Berlin"},
+		}
+		if err := service.ValidateInterface(schema, pinned); err != nil {
+			t.Errorf("expected pinned address version to pass, got %v", err)
+		}
+	})
+
+	t.Run("CompiledOnce", func(t *testing.T) {
+		first, err := service.CompileRegistered("address", "2.0.0")
+		if err != nil {
+			t.Fatalf("CompileRegistered failed: %v", err)
+		}
+		second, err := service.CompileRegistered("address", "2.0.0")
+		if err != nil {
+			t.Fatalf("CompileRegistered failed: %v", err)
+		}
+		if first != second {
+			t.Error("expected the compiled schema to be cached")
+		}
+	})
+
+	t.Run("VersionedLookupMiss", func(t *testing.T) {
+		_, err := service.CompileRegistered("address", "3.0.0")
+		if !errors.Is(err, jsonschema.ErrSchemaNotFound) {
+			t.Errorf("expected ErrSchemaNotFound, got %v", err)
+		}
+		_, err = service.CompileRegistered("unknown", "1.0.0")
+		if !errors.Is(err, jsonschema.ErrSchemaNotFound) {
+			t.Errorf("expected ErrSchemaNotFound, got %v", err)
+		}
+	})
+
+	t.Run("UnresolvableRef", func(t *testing.T) {
+		mustRegister("order", "1.0.0", `{"properties": {"customer": {"$ref": "customer"}}}`)
+		if _, err := service.CompileRegistered("order", "1.0.0"); err == nil {
+			t.Error("expected reference to unregistered schema to fail compilation")
+		}
+	})
+
+	t.Run("RegistrationErrors", func(t *testing.T) {
+		err := service.RegisterSchema("address", "1.0.0", []byte(`{}`))
+		if !errors.Is(err, jsonschema.ErrSchemaAlreadyRegistered) {
+			t.Errorf("expected ErrSchemaAlreadyRegistered, got %v", err)
+		}
+		err = service.RegisterSchema("bad@name", "1.0.0", []byte(`{}`))
+		if !errors.Is(err, jsonschema.ErrInvalidSchemaName) {
+			t.Errorf("expected ErrInvalidSchemaName, got %v", err)
+		}
+		if err := service.RegisterSchema("broken", "1.0.0", []byte(`{not json`)); err == nil {
+			t.Error("expected invalid JSON to be rejected")
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package jsonschema
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -68,6 +69,20 @@ type JSONSchemaService interface {
 	//	}
 	CompileSchema(source string) (Schema, error)
 
+	// CompileSchemaBytes compiles a JSON schema held in memory, without touching
+	// the filesystem. The id is used as the schema's base URI, so relative
+	// "$ref" values resolve against it; registered schemas (see RegisterSchema)
+	// are resolvable as well. Each call uses its own compiler, so concurrent
+	// compiles with different ids do not interfere.
+	//
+	// Example:
+	//	schema, err := service.CompileSchemaBytes("https://example.com/schemas/user.json", schemaJSON)
+	CompileSchemaBytes(id string, data []byte) (Schema, error)
+
+	// CompileSchemaReader compiles a JSON schema read from reader, using id as
+	// its base URI. It behaves like CompileSchemaBytes.
+	CompileSchemaReader(id string, reader io.Reader) (Schema, error)
+
 	// ValidateBytes validates raw JSON data against a compiled schema.
 	// The data parameter should contain valid JSON bytes. The method
 	// unmarshals the JSON and validates it against the schema.
@@ -121,6 +136,40 @@ type JSONSchemaService interface {
 	//	    return fmt.Errorf("user data invalid: %w", err)
 	//	}
 	ValidateInterface(schema Schema, data interface{}) error
+
+	// ValidateInterfaceDetailed validates a Go interface{} against a compiled
+	// schema and returns one ValidationError per violation, or nil if the data
+	// is valid. Unlike ValidateInterface, each error carries the JSON pointer of
+	// the failing value, so API responses can point to the exact field.
+	//
+	// Example:
+	//	for _, violation := range service.ValidateInterfaceDetailed(schema, userData) {
+	//	    fmt.Printf("%s: %s\n", violation.InstancePath, violation.Message)
+	//	}
+	ValidateInterfaceDetailed(schema Schema, data interface{}) []ValidationError
+
+	// RegisterSchema stores a schema document in memory under a name and version.
+	// Registered schemas can reference each other by name with a relative
+	// "$ref": "address" resolving to the most recently registered version of
+	// "address" and "$ref": "address@1.0.0" to a specific version.
+	// Registering the same name and version twice returns ErrSchemaAlreadyRegistered.
+	//
+	// Example:
+	//	err := service.RegisterSchema("address", "1.0.0", []byte(`{"type": "object"}`))
+	RegisterSchema(name, version string, schema []byte) error
+
+	// CompileRegistered compiles a registered schema, resolving references to
+	// other registered schemas without touching the filesystem. The compiled
+	// schema is cached, so it is compiled only once.
+	// Returns ErrSchemaNotFound if the name and version were not registered.
+	//
+	// Example:
+	//	schema, err := service.CompileRegistered("user", "1.0.0")
+	//	if err != nil {
+	//	    return err
+	//	}
+	//	err = service.ValidateInterface(schema, userData)
+	CompileRegistered(name, version string) (Schema, error)
 }
 
 // EventEmitter interface for emitting events from the service
@@ -135,6 +184,7 @@ type EventEmitter interface {
 type schemaServiceImpl struct {
 	compiler     *jsonschema.Compiler
 	eventEmitter EventEmitter
+	registry     *schemaRegistry
 }
 
 // schemaWrapper wraps the jsonschema.Schema to implement our Schema interface.
@@ -178,6 +228,7 @@ func (s *schemaWrapper) Validate(value interface{}) error {
 func NewJSONSchemaService() JSONSchemaService {
 	return &schemaServiceImpl{
 		compiler: jsonschema.NewCompiler(),
+		registry: newSchemaRegistry(),
 	}
 }
 
@@ -186,6 +237,7 @@ func NewJSONSchemaServiceWithEventEmitter(eventEmitter EventEmitter) JSONSchemaS
 	return &schemaServiceImpl{
 		compiler:     jsonschema.NewCompiler(),
 		eventEmitter: eventEmitter,
+		registry:     newSchemaRegistry(),
 	}
 }
 
@@ -224,6 +276,57 @@ func (s *schemaServiceImpl) CompileSchema(source string) (Schema, error) {
 	return &schemaWrapper{schema: schema, service: s}, nil
 }
 
+// CompileSchemaBytes compiles a JSON schema from memory using id as its base URI.
+func (s *schemaServiceImpl) CompileSchemaBytes(id string, data []byte) (Schema, error) {
+	return s.CompileSchemaReader(id, bytes.NewReader(data))
+}
+
+// CompileSchemaReader compiles a JSON schema read from reader using id as its base URI.
+// A dedicated compiler is used per call since compilers are not safe for concurrent use.
+func (s *schemaServiceImpl) CompileSchemaReader(id string, reader io.Reader) (Schema, error) {
+	ctx := context.Background()
+
+	compiled, err := s.compileDocument(id, reader)
+	if err != nil {
+		s.emitEvent(ctx, EventTypeSchemaError, map[string]interface{}{
+			"source": id,
+			"error":  err.Error(),
+		})
+		return nil, fmt.Errorf("failed to compile schema from %s: %w", id, err)
+	}
+
+	s.emitEvent(ctx, EventTypeSchemaCompiled, map[string]interface{}{
+		"source": id,
+	})
+
+	return &schemaWrapper{schema: compiled, service: s}, nil
+}
+
+// compileDocument parses and compiles a single in-memory schema document
+func (s *schemaServiceImpl) compileDocument(id string, reader io.Reader) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(reader)
+	if err != nil {
+		return nil, fmt.Errorf("parsing schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	s.registry.mutex.RLock()
+	err = s.registry.addResources(compiler)
+	s.registry.mutex.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if err := compiler.AddResource(id, doc); err != nil {
+		return nil, fmt.Errorf("adding schema: %w", err)
+	}
+
+	compiled, err := compiler.Compile(id)
+	if err != nil {
+		return nil, fmt.Errorf("compiling schema: %w", err)
+	}
+	return compiled, nil
+}
+
 // ValidateBytes validates raw JSON data against a compiled schema.
 // The method unmarshals the JSON data and then validates it against the schema.
 // Returns an error if either unmarshaling or validation fails.
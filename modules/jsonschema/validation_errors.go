@@ -0,0 +1,101 @@
+package jsonschema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// ValidationError describes a single schema violation.
+//
+// InstancePath and SchemaPath are JSON pointers (RFC 6901). For a missing
+// required property InstancePath points at the missing property itself
+// rather than at the object that lacks it, so API responses can name the
+// exact field.
+type ValidationError struct {
+	// InstancePath locates the failing value within the validated data, e.g. "/user/address/city"
+	InstancePath string `json:"instancePath"`
+	// SchemaPath locates the failing keyword within its schema document, e.g. "/properties/user/required"
+	SchemaPath string `json:"schemaPath"`
+	// Keyword is the schema keyword that failed, e.g. "required" or "enum"
+	Keyword string `json:"keyword"`
+	// Message is a human-readable description of the failure
+	Message string `json:"message"`
+}
+
+// validationMessagePrinter renders validation messages
+var validationMessagePrinter = message.NewPrinter(language.English)
+
+// ValidateInterfaceDetailed validates data against a compiled schema and
+// returns one ValidationError per violation, or nil if the data is valid.
+func (s *schemaServiceImpl) ValidateInterfaceDetailed(schema Schema, data interface{}) []ValidationError {
+	s.emitEvent(context.Background(), EventTypeValidateInterface, map[string]interface{}{})
+
+	err := schema.Validate(data)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		// Not a schema violation, e.g. data that is not JSON-compatible
+		return []ValidationError{{Message: err.Error()}}
+	}
+	return collectValidationErrors(validationErr, nil)
+}
+
+// collectValidationErrors flattens the leaf errors of a validation error tree
+func collectValidationErrors(err *jsonschema.ValidationError, result []ValidationError) []ValidationError {
+	if len(err.Causes) > 0 {
+		for _, cause := range err.Causes {
+			result = collectValidationErrors(cause, result)
+		}
+		return result
+	}
+
+	keywordPath := err.ErrorKind.KeywordPath()
+	schemaPath := jsonPointer(keywordPath)
+	if _, fragment, found := strings.Cut(err.SchemaURL, "#"); found {
+		schemaPath = fragment + schemaPath
+	}
+	var keyword string
+	if len(keywordPath) > 0 {
+		keyword = keywordPath[len(keywordPath)-1]
+	}
+
+	if required, ok := err.ErrorKind.(*kind.Required); ok {
+		for _, property := range required.Missing {
+			result = append(result, ValidationError{
+				InstancePath: jsonPointer(append(append([]string(nil), err.InstanceLocation...), property)),
+				SchemaPath:   schemaPath,
+				Keyword:      keyword,
+				Message:      fmt.Sprintf("missing property %q", property),
+			})
+		}
+		return result
+	}
+
+	return append(result, ValidationError{
+		InstancePath: jsonPointer(err.InstanceLocation),
+		SchemaPath:   schemaPath,
+		Keyword:      keyword,
+		Message:      err.ErrorKind.LocalizedString(validationMessagePrinter),
+	})
+}
+
+// jsonPointer encodes path segments as an RFC 6901 JSON pointer
+func jsonPointer(segments []string) string {
+	var sb strings.Builder
+	for _, segment := range segments {
+		sb.WriteByte('/')
+		segment = strings.ReplaceAll(segment, "~", "~0")
+		sb.WriteString(strings.ReplaceAll(segment, "/", "~1"))
+	}
+	return sb.String()
+}
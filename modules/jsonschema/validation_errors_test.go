@@ -0,0 +1,91 @@
+package jsonschema_test
+
+import (
+	"testing"
+
+	"github.com/GoCodeAlone/modular/modules/jsonschema"
+)
+
+func TestValidateInterfaceDetailed(t *testing.T) {
+	service := jsonschema.NewJSONSchemaService()
+	if err := service.RegisterSchema("order", "1.0.0", []byte(`{
+		"type": "object",
+		"properties": {
+			"status": {"enum": ["pending", "shipped"]},
+			"customer": {
+				"type": "object",
+				"properties": {
+					"address": {
+						"type": "object",
+						"properties": {"city": {"type": "string"}},
+						"required": ["city"]
+					}
+				}
+			}
+		}
+	}`)); err != nil {
+		t.Fatal(err)
+	}
+	schema, err := service.CompileRegistered("order", "1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		data := map[string]interface{}{"status": "pending"}
+		if violations := service.ValidateInterfaceDetailed(schema, data); violations != nil {
+			t.Errorf("expected no violations, got %+v", violations)
+		}
+	})
+
+	t.Run("NestedRequired", func(t *testing.T) {
+		data := map[string]interface{}{
+			"customer": map[string]interface{}{"address": map[string]interface{}{}},
+		}
+		violations := service.ValidateInterfaceDetailed(schema, data)
+		if len(violations) != 1 {
+			t.Fatalf("expected 1 violation, got %+v", violations)
+		}
+		got := violations[0]
+		if got.InstancePath != "/customer/address/city" {
+			t.Errorf("InstancePath = %q, want /customer/address/city", got.InstancePath)
+		}
+		if got.SchemaPath != "/properties/customer/properties/address/required" {
+			t.Errorf("SchemaPath = %q, want /properties/customer/properties/address/required", got.SchemaPath)
+		}
+		if got.Keyword != "required" {
+			t.Errorf("Keyword = %q, want required", got.Keyword)
+		}
+		if got.Message == "" {
+			t.Error("expected a message")
+		}
+	})
+
+	t.Run("Enum", func(t *testing.T) {
+		data := map[string]interface{}{"status": "lost"}
+		violations := service.ValidateInterfaceDetailed(schema, data)
+		if len(violations) != 1 {
+			t.Fatalf("expected 1 violation, got %+v", violations)
+		}
+		got := violations[0]
+		if got.InstancePath != "/status" {
+			t.Errorf("InstancePath = %q, want /status", got.InstancePath)
+		}
+		if got.SchemaPath != "/properties/status/enum" {
+			t.Errorf("SchemaPath = %q, want /properties/status/enum", got.SchemaPath)
+		}
+		if got.Keyword != "enum" {
+			t.Errorf("Keyword = %q, want enum", got.Keyword)
+		}
+	})
+
+	t.Run("MultipleViolations", func(t *testing.T) {
+		data := map[string]interface{}{
+			"status":   "lost",
+			"customer": map[string]interface{}{"address": map[string]interface{}{}},
+		}
+		if violations := service.ValidateInterfaceDetailed(schema, data); len(violations) != 2 {
+			t.Errorf("expected 2 violations, got %+v", violations)
+		}
+	})
+}
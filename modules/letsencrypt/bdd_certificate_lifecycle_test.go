@@ -65,17 +65,65 @@ func (ctx *LetsEncryptBDDTestContext) certificatesAreRenewed() error {
 
 	// Simulate certificate renewal for each domain
 	for _, domain := range ctx.config.Domains {
+		ctx.module.emitEvent(context.Background(), EventTypeRenewalStarted, map[string]interface{}{
+			"domain": domain,
+		})
 		ctx.module.emitEvent(context.Background(), EventTypeCertificateRenewed, map[string]interface{}{
 			"domain": domain,
 		})
+		ctx.module.emitEvent(context.Background(), EventTypeRenewalSucceeded, map[string]interface{}{
+			"domain":    domain,
+			"not_after": time.Now().Add(90 * 24 * time.Hour).Format(time.RFC3339),
+		})
+	}
+
+	// Give a small delay to allow event propagation
+	time.Sleep(10 * time.Millisecond)
+
+	return nil
+}
+
+func (ctx *LetsEncryptBDDTestContext) aCertificateRenewalFails() error {
+	if ctx.module == nil {
+		return fmt.Errorf("module not initialized")
 	}
 
+	// Simulate a renewal that the ACME server rejects
+	ctx.module.emitEvent(context.Background(), EventTypeRenewalStarted, map[string]interface{}{
+		"domain": ctx.config.Domains[0],
+	})
+	ctx.module.emitEvent(context.Background(), EventTypeRenewalFailed, map[string]interface{}{
+		"domain": ctx.config.Domains[0],
+		"error":  "ACME server returned error 429: Too Many Requests",
+	})
+
 	// Give a small delay to allow event propagation
 	time.Sleep(10 * time.Millisecond)
 
 	return nil
 }
 
+func (ctx *LetsEncryptBDDTestContext) aRenewalFailedEventShouldBeEmitted() error {
+	if ctx.eventObserver == nil {
+		return fmt.Errorf("event observer not configured")
+	}
+
+	for _, event := range ctx.eventObserver.GetEvents() {
+		if event.Type() != EventTypeRenewalFailed {
+			continue
+		}
+		data := make(map[string]interface{})
+		if err := event.DataAs(&data); err != nil {
+			return fmt.Errorf("failed to parse renewal failed event data: %w", err)
+		}
+		if _, ok := data["error"]; !ok {
+			return fmt.Errorf("renewal failed event missing error field")
+		}
+		return nil
+	}
+	return fmt.Errorf("renewal failed event not found")
+}
+
 func (ctx *LetsEncryptBDDTestContext) thereShouldBeARenewalEventForEachDomain() error {
 	if ctx.eventObserver == nil || ctx.config == nil {
 		return fmt.Errorf("test context not properly initialized")
@@ -220,6 +268,8 @@ func initCertificateLifecycleBDDSteps(s *godog.ScenarioContext, ctx *LetsEncrypt
 	s.Given(`^I have existing certificates that need renewal$`, ctx.iHaveExistingCertificatesThatNeedRenewal)
 	s.When(`^certificates are renewed$`, ctx.certificatesAreRenewed)
 	s.Then(`^there should be a renewal event for each domain$`, ctx.thereShouldBeARenewalEventForEachDomain)
+	s.When(`^a certificate renewal fails$`, ctx.aCertificateRenewalFails)
+	s.Then(`^a renewal failed event should be emitted$`, ctx.aRenewalFailedEventShouldBeEmitted)
 
 	// Certificate expiry events (use Step to allow Given/When/Then/And keyword flexibility in aggregated scenario)
 	s.Step(`^I have certificates approaching expiry$`, ctx.iHaveCertificatesApproachingExpiry)
@@ -0,0 +1,80 @@
+package letsencrypt
+
+import (
+	"crypto/tls"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// newCertInfo describes cert, stored under domain. Only Domain is set if the
+// leaf certificate cannot be parsed.
+func newCertInfo(domain string, cert *tls.Certificate) CertInfo {
+	info := CertInfo{Domain: domain}
+
+	leaf := certificateLeaf(cert)
+	if leaf == nil {
+		return info
+	}
+
+	info.DNSNames = leaf.DNSNames
+	info.Issuer = leaf.Issuer.CommonName
+	info.SerialNumber = fmt.Sprintf("%x", leaf.SerialNumber)
+	info.NotBefore = leaf.NotBefore
+	info.NotAfter = leaf.NotAfter
+	return info
+}
+
+// Certificates returns details of every certificate currently held in
+// memory, sorted by domain
+func (m *LetsEncryptModule) Certificates() []CertInfo {
+	m.certMutex.RLock()
+	defer m.certMutex.RUnlock()
+
+	infos := make([]CertInfo, 0, len(m.certificates))
+	for domain, cert := range m.certificates {
+		infos = append(infos, newCertInfo(domain, cert))
+	}
+	slices.SortFunc(infos, func(a, b CertInfo) int {
+		return strings.Compare(a.Domain, b.Domain)
+	})
+	return infos
+}
+
+// OnRenewal registers a hook that is called after a certificate has been
+// renewed and the new certificate is being served. Hooks run synchronously
+// on the renewal goroutine, in registration order, so long-running work
+// should be handed off.
+//
+// Example:
+//
+//	certService.OnRenewal(func(info letsencrypt.CertInfo) {
+//	    logger.Info("Certificate rotated", "domain", info.Domain, "expires", info.NotAfter)
+//	})
+func (m *LetsEncryptModule) OnRenewal(hook func(CertInfo)) {
+	if hook == nil {
+		return
+	}
+	m.renewalHooksMu.Lock()
+	defer m.renewalHooksMu.Unlock()
+	m.renewalHooks = append(m.renewalHooks, hook)
+}
+
+// runRenewalHooks calls every renewal hook with info. A panicking hook is
+// logged and does not prevent the remaining hooks from running.
+func (m *LetsEncryptModule) runRenewalHooks(info CertInfo) {
+	m.renewalHooksMu.RLock()
+	hooks := slices.Clone(m.renewalHooks)
+	m.renewalHooksMu.RUnlock()
+
+	for _, hook := range hooks {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					m.logger.Error("panic recovered in certificate renewal hook", "domain", info.Domain, "error", r)
+				}
+			}()
+			hook(info)
+		}()
+	}
+}
@@ -0,0 +1,117 @@
+package letsencrypt
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/GoCodeAlone/modular"
+)
+
+// startObservedDNSModule starts a module against server and records its events
+func startObservedDNSModule(t *testing.T, server *mockACMEServer) (*LetsEncryptModule, *testEventObserver) {
+	t.Helper()
+	module := newDNSTestModule(t, server, "fake", "example.com")
+	module.logger = &testLogger{}
+	if err := module.RegisterDNSProvider("fake", func(*DNSProviderConfig) (DNSProvider, error) {
+		return &fakeDNSProvider{}, nil
+	}); err != nil {
+		t.Fatalf("Failed to register DNS provider: %v", err)
+	}
+
+	observer := newTestEventObserver()
+	app := modular.NewObservableApplication(modular.NewStdConfigProvider(struct{}{}), &testLogger{})
+	if err := app.RegisterObserver(observer); err != nil {
+		t.Fatalf("Failed to register observer: %v", err)
+	}
+	if err := module.RegisterObservers(app); err != nil {
+		t.Fatalf("Failed to register module observers: %v", err)
+	}
+
+	if err := module.Start(modular.WithSynchronousNotification(context.Background())); err != nil {
+		t.Fatalf("Failed to start module: %v", err)
+	}
+	return module, observer
+}
+
+// renewalEvents returns the types of the renewal events observed, in order
+func renewalEvents(observer *testEventObserver) []string {
+	var types []string
+	for _, event := range observer.GetEvents() {
+		if strings.HasPrefix(event.Type(), "com.modular.letsencrypt.renewal.") {
+			types = append(types, event.Type())
+		}
+	}
+	return types
+}
+
+func TestCertificateRenewalEventsAndHooks(t *testing.T) {
+	server := newMockACMEServer(t)
+	module, observer := startObservedDNSModule(t, server)
+
+	before := module.Certificates()
+	if len(before) != 1 || before[0].Domain != "example.com" {
+		t.Fatalf("Expected one certificate for example.com, got %+v", before)
+	}
+	if before[0].Issuer != "Mock ACME CA" || before[0].NotAfter.IsZero() {
+		t.Errorf("Expected issuer and validity details, got %+v", before[0])
+	}
+
+	var renewed []CertInfo
+	module.OnRenewal(func(CertInfo) { panic("hook failure") })
+	module.OnRenewal(func(info CertInfo) { renewed = append(renewed, info) })
+
+	// The mock CA issues day-long certificates, well inside the renewal window
+	module.checkAndRenewCertificates(modular.WithSynchronousNotification(context.Background()))
+
+	want := []string{EventTypeRenewalStarted, EventTypeRenewalSucceeded}
+	if got := renewalEvents(observer); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected renewal events %v, got %v", want, got)
+	}
+
+	if len(renewed) != 1 {
+		t.Fatalf("Expected renewal hook to run once despite an earlier panicking hook, ran %d times", len(renewed))
+	}
+	if renewed[0].Domain != "example.com" || renewed[0].SerialNumber == before[0].SerialNumber {
+		t.Errorf("Expected hook to receive the new certificate, got %+v (previous serial %s)", renewed[0], before[0].SerialNumber)
+	}
+	if after := module.Certificates(); len(after) != 1 || after[0].SerialNumber != renewed[0].SerialNumber {
+		t.Errorf("Expected Certificates to report the renewed certificate, got %+v", after)
+	}
+}
+
+func TestCertificateRenewalFailureEvent(t *testing.T) {
+	server := newMockACMEServer(t)
+	module, observer := startObservedDNSModule(t, server)
+	before := module.Certificates()
+
+	hookCalled := false
+	module.OnRenewal(func(CertInfo) { hookCalled = true })
+
+	server.Close()
+	module.checkAndRenewCertificates(modular.WithSynchronousNotification(context.Background()))
+
+	want := []string{EventTypeRenewalStarted, EventTypeRenewalFailed}
+	if got := renewalEvents(observer); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected renewal events %v, got %v", want, got)
+	}
+	for _, event := range observer.GetEvents() {
+		if event.Type() != EventTypeRenewalFailed {
+			continue
+		}
+		data := make(map[string]interface{})
+		if err := event.DataAs(&data); err != nil {
+			t.Fatalf("Failed to decode event data: %v", err)
+		}
+		if errMsg, _ := data["error"].(string); data["domain"] != "example.com" || errMsg == "" {
+			t.Errorf("Expected domain and error in renewal failed event, got %v", data)
+		}
+	}
+
+	if hookCalled {
+		t.Error("Expected renewal hook not to run after a failed renewal")
+	}
+	if after := module.Certificates(); len(after) != 1 || after[0].SerialNumber != before[0].SerialNumber {
+		t.Errorf("Expected the existing certificate to be kept, got %+v", after)
+	}
+}
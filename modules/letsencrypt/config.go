@@ -9,6 +9,12 @@ import (
 	"path/filepath"
 )
 
+// Supported ACME challenge types
+const (
+	ChallengeTypeHTTP01 = "http-01"
+	ChallengeTypeDNS01  = "dns-01"
+)
+
 // LetsEncryptConfig defines the configuration for the Let's Encrypt module.
 type LetsEncryptConfig struct {
 	// Email is the email address to use for registration with Let's Encrypt
@@ -36,18 +42,36 @@ type LetsEncryptConfig struct {
 	// AutoRenew enables automatic certificate renewal
 	AutoRenew bool `yaml:"auto_renew" json:"auto_renew" env:"AUTO_RENEW"`
 
+	// CADirURL overrides the ACME directory URL, e.g. for a private ACME CA.
+	// When empty, the staging or production Let's Encrypt directory is used.
+	CADirURL string `yaml:"ca_dir_url" json:"ca_dir_url" env:"CA_DIR_URL"`
+
+	// ChallengeType selects the ACME challenge ("http-01" or "dns-01").
+	// Setting it to "dns-01" is equivalent to setting UseDNS.
+	ChallengeType string `yaml:"challenge_type" json:"challenge_type" env:"CHALLENGE_TYPE"`
+
 	// UseDNS indicates whether to use DNS challenges instead of HTTP
 	UseDNS bool `yaml:"use_dns" json:"use_dns" env:"USE_DNS"`
 
 	// DNSProvider configuration for DNS challenges
 	DNSProvider *DNSProviderConfig `yaml:"dns_provider,omitempty" json:"dns_provider,omitempty"`
 
+	// SkipDNSPropagationCheck notifies the CA as soon as the DNS provider has
+	// presented the TXT record, without first querying the authoritative
+	// nameservers. Use it when the CA resolves records that are not publicly
+	// visible, such as a private ACME server with its own resolver.
+	SkipDNSPropagationCheck bool `yaml:"skip_dns_propagation_check" json:"skip_dns_propagation_check" env:"SKIP_DNS_PROPAGATION_CHECK"`
+
 	// DNSConfig is a map of DNS provider specific configuration parameters
 	DNSConfig map[string]string `yaml:"dns_config,omitempty" json:"dns_config,omitempty" env:"DNS_CONFIG"`
 
 	// HTTPProvider configuration for HTTP challenges
 	HTTPProvider *HTTPProviderConfig `yaml:"http_provider,omitempty" json:"http_provider,omitempty"`
 
+	// OCSPStapling attaches a cached OCSP response to certificates served via
+	// GetCertificate, saving clients a round trip to the CA's OCSP responder
+	OCSPStapling bool `yaml:"ocsp_stapling" json:"ocsp_stapling" env:"OCSP_STAPLING"`
+
 	// HTTPChallengeHandler is an HTTP handler for HTTP-01 challenges
 	HTTPChallengeHandler http.Handler `yaml:"-" json:"-"`
 
@@ -130,6 +154,19 @@ func (c *LetsEncryptConfig) Validate() error {
 		c.RenewBefore = 30 // 30 days
 	}
 
+	// Resolve the challenge type
+	switch c.ChallengeType {
+	case "":
+	case ChallengeTypeDNS01:
+		c.UseDNS = true
+	case ChallengeTypeHTTP01:
+		if c.UseDNS {
+			return ErrConflictingProviders
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedChallengeType, c.ChallengeType)
+	}
+
 	// Validate HTTP provider config
 	if c.HTTPProvider != nil {
 		if c.HTTPProvider.Port <= 0 {
@@ -143,7 +180,7 @@ func (c *LetsEncryptConfig) Validate() error {
 	}
 
 	// If no provider is specified, default to HTTP with built-in server
-	if c.HTTPProvider == nil && c.DNSProvider == nil {
+	if c.HTTPProvider == nil && c.DNSProvider == nil && !c.UseDNS {
 		c.HTTPProvider = &HTTPProviderConfig{
 			UseBuiltIn: true,
 			Port:       80,
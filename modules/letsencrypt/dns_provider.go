@@ -0,0 +1,88 @@
+package letsencrypt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+)
+
+// RegisterDNSProvider makes a DNS-01 provider available under name, so it can
+// be selected with dns_provider.provider in the module configuration. This is
+// the extension point for DNS services the module does not support natively;
+// a registered name takes precedence over a built-in provider of the same name.
+// Providers must be registered before the module starts.
+//
+// Example:
+//
+//	err := leModule.RegisterDNSProvider("acme-dns", func(cfg *letsencrypt.DNSProviderConfig) (letsencrypt.DNSProvider, error) {
+//	    return acmedns.NewDNSProvider()
+//	})
+func (m *LetsEncryptModule) RegisterDNSProvider(name string, factory DNSProviderFactory) error {
+	if name == "" || factory == nil {
+		return ErrInvalidDNSProvider
+	}
+
+	m.dnsProvidersMu.Lock()
+	defer m.dnsProvidersMu.Unlock()
+
+	if _, exists := m.dnsProviders[name]; exists {
+		return fmt.Errorf("%w: %s", ErrDuplicateDNSProvider, name)
+	}
+	if m.dnsProviders == nil {
+		m.dnsProviders = make(map[string]DNSProviderFactory)
+	}
+	m.dnsProviders[name] = factory
+	return nil
+}
+
+// ManualDNSProvider is a DNSProvider for DNS services without an API. It
+// prints the TXT record to create and waits for the operator to confirm it
+// has been published by entering a line. It is used for the "manual" provider.
+type ManualDNSProvider struct {
+	in  *bufio.Reader
+	out io.Writer
+	mu  sync.Mutex
+}
+
+// NewManualDNSProvider creates a ManualDNSProvider that writes instructions to
+// out and reads confirmations from in
+func NewManualDNSProvider(in io.Reader, out io.Writer) *ManualDNSProvider {
+	return &ManualDNSProvider{
+		in:  bufio.NewReader(in),
+		out: out,
+	}
+}
+
+// Present prints the TXT record for domain and blocks until it is confirmed
+func (p *ManualDNSProvider) Present(domain, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := fmt.Fprintf(p.out, "Please create the following TXT record for %s:\n%s %d IN TXT %q\nPress Enter once the record has been published.\n",
+		domain, info.EffectiveFQDN, dns01.DefaultTTL, info.Value); err != nil {
+		return fmt.Errorf("failed to print DNS challenge for %s: %w", domain, err)
+	}
+	if _, err := p.in.ReadString('\n'); err != nil {
+		return fmt.Errorf("failed to read DNS challenge confirmation for %s: %w", domain, err)
+	}
+	return nil
+}
+
+// CleanUp prints the TXT record for domain that may now be removed
+func (p *ManualDNSProvider) CleanUp(domain, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := fmt.Fprintf(p.out, "The following TXT record for %s can now be removed:\n%s %d IN TXT %q\n",
+		domain, info.EffectiveFQDN, dns01.DefaultTTL, info.Value); err != nil {
+		return fmt.Errorf("failed to print DNS challenge cleanup for %s: %w", domain, err)
+	}
+	return nil
+}
@@ -0,0 +1,473 @@
+package letsencrypt
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+)
+
+// fakeDNSProvider records the DNS-01 records it is asked to present and clean up
+type fakeDNSProvider struct {
+	mu       sync.Mutex
+	presents []string
+	cleanups []string
+	keyAuths []string
+}
+
+func (p *fakeDNSProvider) Present(domain, token, keyAuth string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.presents = append(p.presents, domain)
+	p.keyAuths = append(p.keyAuths, keyAuth)
+	return nil
+}
+
+func (p *fakeDNSProvider) CleanUp(domain, token, keyAuth string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cleanups = append(p.cleanups, domain)
+	return nil
+}
+
+// Timeout keeps lego's propagation polling short
+func (p *fakeDNSProvider) Timeout() (time.Duration, time.Duration) {
+	return time.Second, 10 * time.Millisecond
+}
+
+// mockACMEServer is a minimal ACME directory that accepts every challenge
+// the client asks it to validate and signs certificates with a test CA.
+// Request signatures are not verified.
+type mockACMEServer struct {
+	*httptest.Server
+	caKey  *ecdsa.PrivateKey
+	caCert *x509.Certificate
+
+	mu          sync.Mutex
+	nonce       int
+	identifiers []map[string]string
+	validated   []string // challenge types the client asked the server to validate
+	issuedChain []byte
+}
+
+func newMockACMEServer(t *testing.T) *mockACMEServer {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate CA key: %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Mock ACME CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("Failed to create CA certificate: %v", err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatalf("Failed to parse CA certificate: %v", err)
+	}
+
+	s := &mockACMEServer{caKey: caKey, caCert: caCert}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /directory", s.handleDirectory)
+	mux.HandleFunc("/nonce", s.handleNonce)
+	mux.HandleFunc("POST /account", s.handleAccount)
+	mux.HandleFunc("POST /order", s.handleOrder)
+	mux.HandleFunc("POST /authz/{id}", s.handleAuthorization)
+	mux.HandleFunc("POST /challenge/{type}/{id}", s.handleChallenge)
+	mux.HandleFunc("POST /finalize", s.handleFinalize)
+	mux.HandleFunc("POST /certificate", s.handleCertificate)
+	s.Server = httptest.NewTLSServer(s.withNonce(mux))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// serverCertificatePEM returns the TLS certificate the client must trust
+func (s *mockACMEServer) serverCertificatePEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.Certificate().Raw})
+}
+
+func (s *mockACMEServer) withNonce(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.nonce++
+		nonce := fmt.Sprintf("nonce-%d", s.nonce)
+		s.mu.Unlock()
+		w.Header().Set("Replay-Nonce", nonce)
+		w.Header().Set("Cache-Control", "no-store")
+		next.ServeHTTP(w, r)
+	})
+}
+
+// payload decodes the JWS payload of an ACME request into v
+func (s *mockACMEServer) payload(r *http.Request, v any) error {
+	var jws struct {
+		Payload string `json:"payload"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&jws); err != nil {
+		return err
+	}
+	if jws.Payload == "" {
+		return nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+func (s *mockACMEServer) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (s *mockACMEServer) handleDirectory(w http.ResponseWriter, r *http.Request) {
+	s.writeJSON(w, http.StatusOK, map[string]string{
+		"newNonce":   s.URL + "/nonce",
+		"newAccount": s.URL + "/account",
+		"newOrder":   s.URL + "/order",
+		"revokeCert": s.URL + "/revoke",
+		"keyChange":  s.URL + "/key-change",
+	})
+}
+
+func (s *mockACMEServer) handleNonce(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *mockACMEServer) handleAccount(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Location", s.URL+"/account/1")
+	s.writeJSON(w, http.StatusCreated, map[string]any{"status": "valid"})
+}
+
+func (s *mockACMEServer) order(status string) map[string]any {
+	authorizations := make([]string, len(s.identifiers))
+	for i := range s.identifiers {
+		authorizations[i] = fmt.Sprintf("%s/authz/%d", s.URL, i)
+	}
+	order := map[string]any{
+		"status":         status,
+		"identifiers":    s.identifiers,
+		"authorizations": authorizations,
+		"finalize":       s.URL + "/finalize",
+	}
+	if status == "valid" {
+		order["certificate"] = s.URL + "/certificate"
+	}
+	return order
+}
+
+func (s *mockACMEServer) handleOrder(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Identifiers []map[string]string `json:"identifiers"`
+	}
+	if err := s.payload(r, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.identifiers = req.Identifiers
+	w.Header().Set("Location", s.URL+"/order/1")
+	s.writeJSON(w, http.StatusCreated, s.order("pending"))
+}
+
+func (s *mockACMEServer) handleAuthorization(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var index int
+	if _, err := fmt.Sscan(id, &index); err != nil || index >= len(s.identifiers) {
+		http.NotFound(w, r)
+		return
+	}
+
+	value := s.identifiers[index]["value"]
+	wildcard := strings.HasPrefix(value, "*.")
+	challenges := []map[string]string{
+		{"type": "dns-01", "url": s.URL + "/challenge/dns-01/" + id, "token": "dns-token-" + id, "status": "pending"},
+	}
+	if !wildcard {
+		// Offer HTTP-01 first so the test proves the client chose DNS-01
+		challenges = append([]map[string]string{
+			{"type": "http-01", "url": s.URL + "/challenge/http-01/" + id, "token": "http-token-" + id, "status": "pending"},
+		}, challenges...)
+	}
+	s.writeJSON(w, http.StatusOK, map[string]any{
+		"status":     "pending",
+		"identifier": map[string]string{"type": "dns", "value": strings.TrimPrefix(value, "*.")},
+		"wildcard":   wildcard,
+		"challenges": challenges,
+	})
+}
+
+func (s *mockACMEServer) handleChallenge(w http.ResponseWriter, r *http.Request) {
+	challengeType, id := r.PathValue("type"), r.PathValue("id")
+
+	s.mu.Lock()
+	s.validated = append(s.validated, challengeType)
+	s.mu.Unlock()
+
+	w.Header().Add("Link", fmt.Sprintf(`<%s/authz/%s>;rel="up"`, s.URL, id))
+	s.writeJSON(w, http.StatusOK, map[string]string{
+		"type":   challengeType,
+		"url":    s.URL + r.URL.Path,
+		"token":  strings.TrimSuffix(challengeType, "-01") + "-token-" + id,
+		"status": "valid",
+	})
+}
+
+func (s *mockACMEServer) handleFinalize(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		CSR string `json:"csr"`
+	}
+	if err := s.payload(r, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := s.issue(req.CSR); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writeJSON(w, http.StatusOK, s.order("valid"))
+}
+
+// issue signs the CSR with the test CA and keeps the chain for download
+func (s *mockACMEServer) issue(encodedCSR string) error {
+	der, err := base64.RawURLEncoding.DecodeString(encodedCSR)
+	if err != nil {
+		return err
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		return err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      csr.Subject,
+		DNSNames:     csr.DNSNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	leaf, err := x509.CreateCertificate(rand.Reader, template, s.caCert, csr.PublicKey, s.caKey)
+	if err != nil {
+		return err
+	}
+
+	var chain bytes.Buffer
+	_ = pem.Encode(&chain, &pem.Block{Type: "CERTIFICATE", Bytes: leaf})
+	_ = pem.Encode(&chain, &pem.Block{Type: "CERTIFICATE", Bytes: s.caCert.Raw})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.issuedChain = chain.Bytes()
+	return nil
+}
+
+func (s *mockACMEServer) handleCertificate(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/pem-certificate-chain")
+	_, _ = w.Write(s.issuedChain)
+}
+
+// newDNSTestModule creates a module that obtains certificates for domains
+// from server using DNS-01 challenges answered by the named provider
+func newDNSTestModule(t *testing.T, server *mockACMEServer, provider string, domains ...string) *LetsEncryptModule {
+	t.Helper()
+
+	// Keep lego from resolving CNAMEs for the challenge records
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	module, err := New(&LetsEncryptConfig{
+		Email:                   "test@example.com",
+		Domains:                 domains,
+		StoragePath:             t.TempDir(),
+		CADirURL:                server.URL + "/directory",
+		CustomCACertificate:     server.serverCertificatePEM(),
+		ChallengeType:           ChallengeTypeDNS01,
+		DNSProvider:             &DNSProviderConfig{Provider: provider},
+		SkipDNSPropagationCheck: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create LetsEncrypt module: %v", err)
+	}
+	t.Cleanup(func() { _ = module.Stop(context.Background()) })
+	return module
+}
+
+func TestDNS01ChallengeWithRegisteredProvider(t *testing.T) {
+	server := newMockACMEServer(t)
+	module := newDNSTestModule(t, server, "fake", "example.com", "*.example.com")
+
+	fake := &fakeDNSProvider{}
+	var factoryConfig *DNSProviderConfig
+	err := module.RegisterDNSProvider("fake", func(config *DNSProviderConfig) (DNSProvider, error) {
+		factoryConfig = config
+		return fake, nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to register DNS provider: %v", err)
+	}
+
+	if err := module.Start(context.Background()); err != nil {
+		t.Fatalf("Failed to start module: %v", err)
+	}
+
+	if factoryConfig == nil || factoryConfig.Provider != "fake" {
+		t.Errorf("Expected factory to receive the DNS provider config, got %+v", factoryConfig)
+	}
+
+	// Both the apex and the wildcard are validated with a record on the apex
+	wantDomains := []string{"example.com", "example.com"}
+	if fmt.Sprint(fake.presents) != fmt.Sprint(wantDomains) {
+		t.Errorf("Expected Present for %v, got %v", wantDomains, fake.presents)
+	}
+	if fmt.Sprint(fake.cleanups) != fmt.Sprint(wantDomains) {
+		t.Errorf("Expected CleanUp for %v, got %v", wantDomains, fake.cleanups)
+	}
+	for _, keyAuth := range fake.keyAuths {
+		if !strings.HasPrefix(keyAuth, "dns-token-") {
+			t.Errorf("Expected key authorization for a DNS-01 token, got %q", keyAuth)
+		}
+	}
+	wantValidated := []string{ChallengeTypeDNS01, ChallengeTypeDNS01}
+	if fmt.Sprint(server.validated) != fmt.Sprint(wantValidated) {
+		t.Errorf("Expected validation of %v, got %v", wantValidated, server.validated)
+	}
+
+	cert, err := module.GetCertificateForDomain("www.example.com")
+	if err != nil {
+		t.Fatalf("Expected wildcard certificate for www.example.com: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatalf("Failed to parse issued certificate: %v", err)
+	}
+	if err := leaf.VerifyHostname("www.example.com"); err != nil {
+		t.Errorf("Issued certificate does not cover www.example.com: %v", err)
+	}
+}
+
+func TestDNS01ChallengeWithUnknownProvider(t *testing.T) {
+	server := newMockACMEServer(t)
+	module := newDNSTestModule(t, server, "unknown", "example.com")
+
+	err := module.Start(context.Background())
+	if !errors.Is(err, ErrUnsupportedDNSProvider) {
+		t.Fatalf("Expected ErrUnsupportedDNSProvider, got %v", err)
+	}
+	if len(server.validated) != 0 {
+		t.Errorf("Expected no challenges to be validated, got %v", server.validated)
+	}
+}
+
+func TestRegisterDNSProvider(t *testing.T) {
+	module := &LetsEncryptModule{}
+	factory := func(*DNSProviderConfig) (DNSProvider, error) { return &fakeDNSProvider{}, nil }
+
+	if err := module.RegisterDNSProvider("", factory); !errors.Is(err, ErrInvalidDNSProvider) {
+		t.Errorf("Expected ErrInvalidDNSProvider for empty name, got %v", err)
+	}
+	if err := module.RegisterDNSProvider("fake", nil); !errors.Is(err, ErrInvalidDNSProvider) {
+		t.Errorf("Expected ErrInvalidDNSProvider for nil factory, got %v", err)
+	}
+	if err := module.RegisterDNSProvider("fake", factory); err != nil {
+		t.Fatalf("Failed to register DNS provider: %v", err)
+	}
+	if err := module.RegisterDNSProvider("fake", factory); !errors.Is(err, ErrDuplicateDNSProvider) {
+		t.Errorf("Expected ErrDuplicateDNSProvider, got %v", err)
+	}
+}
+
+func TestChallengeTypeValidation(t *testing.T) {
+	newConfig := func(challengeType string) *LetsEncryptConfig {
+		return &LetsEncryptConfig{
+			Email:         "test@example.com",
+			Domains:       []string{"example.com"},
+			StoragePath:   t.TempDir(),
+			ChallengeType: challengeType,
+		}
+	}
+
+	config := newConfig(ChallengeTypeDNS01)
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Expected dns-01 to be valid: %v", err)
+	}
+	if !config.UseDNS || config.HTTPProvider != nil {
+		t.Errorf("Expected dns-01 to enable DNS without an HTTP provider, got UseDNS=%v HTTPProvider=%+v", config.UseDNS, config.HTTPProvider)
+	}
+
+	config = newConfig(ChallengeTypeHTTP01)
+	config.UseDNS = true
+	if err := config.Validate(); !errors.Is(err, ErrConflictingProviders) {
+		t.Errorf("Expected ErrConflictingProviders for http-01 with use_dns, got %v", err)
+	}
+
+	if err := newConfig("tls-alpn-01").Validate(); !errors.Is(err, ErrUnsupportedChallengeType) {
+		t.Errorf("Expected ErrUnsupportedChallengeType, got %v", err)
+	}
+}
+
+func TestManualDNSProvider(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+	info := dns01.GetChallengeInfo("example.com", "token.thumbprint")
+
+	var out bytes.Buffer
+	provider := NewManualDNSProvider(strings.NewReader("\n"), &out)
+
+	if err := provider.Present("example.com", "token", "token.thumbprint"); err != nil {
+		t.Fatalf("Present failed: %v", err)
+	}
+	record := fmt.Sprintf("_acme-challenge.example.com. %d IN TXT %q", dns01.DefaultTTL, info.Value)
+	if !strings.Contains(out.String(), record) {
+		t.Errorf("Expected instructions to contain %q, got %q", record, out.String())
+	}
+
+	out.Reset()
+	if err := provider.CleanUp("example.com", "token", "token.thumbprint"); err != nil {
+		t.Fatalf("CleanUp failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "can now be removed") || !strings.Contains(out.String(), record) {
+		t.Errorf("Expected cleanup instructions for %q, got %q", record, out.String())
+	}
+
+	// Without a confirmation the challenge must not proceed
+	if err := provider.Present("example.com", "token", "token.thumbprint"); err == nil {
+		t.Error("Expected Present to fail when no confirmation can be read")
+	}
+}
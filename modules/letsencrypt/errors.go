@@ -20,6 +20,12 @@ var (
 	ErrAzureDNSConfigIncomplete   = errors.New("azure DNS provider requires client_id, client_secret, subscription_id, tenant_id, and resource_group")
 	ErrNamecheapConfigIncomplete  = errors.New("namecheap DNS provider requires api_user, api_key, and username")
 	ErrHTTPChallengeNotConfigured = errors.New("HTTP challenge handler not configured")
+	ErrUnsupportedChallengeType   = errors.New("unsupported challenge type")
+	ErrInvalidCustomCACertificate = errors.New("custom CA certificate contains no valid PEM certificates")
+
+	// DNS provider registration errors
+	ErrInvalidDNSProvider   = errors.New("DNS provider name and factory are required")
+	ErrDuplicateDNSProvider = errors.New("DNS provider already registered")
 
 	// Service dependency errors
 	ErrLoggerServiceUnavailable = errors.New("required logger service not found or invalid type")
@@ -28,6 +34,7 @@ var (
 	ErrCertificateFileNotFound = errors.New("certificate file not found")
 	ErrKeyFileNotFound         = errors.New("key file not found")
 	ErrPEMDecodeFailure        = errors.New("failed to decode PEM block containing certificate")
+	ErrOCSPResponderStatus     = errors.New("OCSP responder returned an error status")
 
 	// Event observation errors
 	ErrNoSubjectForEventEmission = errors.New("no subject available for event emission")
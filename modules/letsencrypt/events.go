@@ -15,6 +15,11 @@ const (
 	EventTypeCertificateExpiring  = "com.modular.letsencrypt.certificate.expiring"
 	EventTypeCertificateExpired   = "com.modular.letsencrypt.certificate.expired"
 
+	// Renewal events
+	EventTypeRenewalStarted   = "com.modular.letsencrypt.renewal.started"
+	EventTypeRenewalSucceeded = "com.modular.letsencrypt.renewal.succeeded"
+	EventTypeRenewalFailed    = "com.modular.letsencrypt.renewal.failed"
+
 	// ACME protocol events
 	EventTypeAcmeChallenge     = "com.modular.letsencrypt.acme.challenge"
 	EventTypeAcmeAuthorization = "com.modular.letsencrypt.acme.authorization"
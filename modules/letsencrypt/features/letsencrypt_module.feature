@@ -90,6 +90,12 @@ Feature: LetsEncrypt Module
     Then certificate renewed events should be emitted
     And the events should contain renewal details
 
+  Scenario: Emit events when certificate renewal fails
+    Given I have a LetsEncrypt module with event observation enabled
+    And I have existing certificates that need renewal
+    When a certificate renewal fails
+    Then a renewal failed event should be emitted
+
   Scenario: Emit events during ACME protocol operations
     Given I have a LetsEncrypt module with event observation enabled
     When ACME challenges are processed
@@ -177,6 +183,7 @@ Feature: LetsEncrypt Module
     When a certificate is requested for domains
     And the certificate is successfully issued
     And certificates are renewed
+    And a certificate renewal fails
     And ACME challenges are processed
     And ACME authorization is completed
     And ACME orders are processed
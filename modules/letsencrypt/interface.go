@@ -2,6 +2,7 @@ package letsencrypt
 
 import (
 	"crypto/tls"
+	"time"
 )
 
 // CertificateService defines the interface for a service that can provide TLS certificates
@@ -14,6 +15,32 @@ type CertificateService interface {
 
 	// Domains returns a list of domains this service can provide certificates for
 	Domains() []string
+
+	// Certificates returns details of every certificate currently held
+	Certificates() []CertInfo
+
+	// OnRenewal registers a hook called with the new certificate's details
+	// after a certificate is renewed, e.g. to reload dependent TLS listeners
+	OnRenewal(hook func(CertInfo))
+}
+
+// CertInfo describes a certificate held by a CertificateService
+type CertInfo struct {
+	// Domain is the domain the certificate is stored under
+	Domain string `json:"domain"`
+
+	// DNSNames are the subject alternative names the certificate covers
+	DNSNames []string `json:"dns_names"`
+
+	// Issuer is the common name of the issuing CA
+	Issuer string `json:"issuer"`
+
+	// SerialNumber is the certificate serial number in hexadecimal
+	SerialNumber string `json:"serial_number"`
+
+	// NotBefore and NotAfter bound the certificate's validity period
+	NotBefore time.Time `json:"not_before"`
+	NotAfter  time.Time `json:"not_after"`
 }
 
 // ChallengeHandler defines the interface for handlers that can handle ACME challenges
@@ -24,3 +51,19 @@ type ChallengeHandler interface {
 	// CleanupChallenge is called when a challenge token needs to be removed
 	CleanupChallenge(domain, token, keyAuth string) error
 }
+
+// DNSProvider defines the interface for providers that publish the TXT records
+// used by ACME DNS-01 challenges. It matches lego's challenge.Provider, so any
+// lego DNS provider can be used directly. A provider may also implement
+// Timeout() (timeout, interval time.Duration) to control how long record
+// propagation is awaited.
+type DNSProvider interface {
+	// Present creates the TXT record proving control of domain
+	Present(domain, token, keyAuth string) error
+
+	// CleanUp removes the TXT record created by Present
+	CleanUp(domain, token, keyAuth string) error
+}
+
+// DNSProviderFactory creates a DNSProvider from the module's DNS provider configuration
+type DNSProviderFactory func(config *DNSProviderConfig) (DNSProvider, error)
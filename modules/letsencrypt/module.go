@@ -138,6 +138,7 @@ import (
 	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/certificate"
 	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/lego"
 	"github.com/go-acme/lego/v4/providers/dns/azuredns"
 	"github.com/go-acme/lego/v4/providers/dns/cloudflare"
@@ -195,6 +196,17 @@ type LetsEncryptModule struct {
 	subjectMu     sync.RWMutex        // Protects subject publication & reads during emission
 	storage       *certificateStorage // Certificate storage service
 	logger        modular.Logger      // Logger for the module
+
+	dnsProviders   map[string]DNSProviderFactory // Application-registered DNS-01 providers
+	dnsProvidersMu sync.RWMutex
+
+	renewalHooks   []func(CertInfo) // Called after each successful renewal
+	renewalHooksMu sync.RWMutex
+
+	ocspStaples map[string]*ocspStaple // Cached OCSP responses by certificate serial
+	ocspMu      sync.Mutex
+	ocspWg      sync.WaitGroup
+	ocspStopped bool // set under ocspMu once Stop starts waiting on ocspWg
 }
 
 // Compile-time assertions to ensure interface compliance
@@ -358,6 +370,16 @@ func (m *LetsEncryptModule) Constructor() modular.ModuleConstructor {
 			logger:       logger,
 		}
 
+		// Carry over DNS providers registered before construction
+		m.dnsProvidersMu.RLock()
+		for name, factory := range m.dnsProviders {
+			if module.dnsProviders == nil {
+				module.dnsProviders = make(map[string]DNSProviderFactory)
+			}
+			module.dnsProviders[name] = factory
+		}
+		m.dnsProvidersMu.RUnlock()
+
 		return module, nil
 	}
 }
@@ -401,6 +423,18 @@ func (m *LetsEncryptModule) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to obtain certificates: %w", err)
 	}
 
+	// Fetch OCSP responses up front so the first handshakes can be stapled
+	if m.config.OCSPStapling {
+		m.ocspMu.Lock()
+		m.ocspStopped = false
+		m.ocspMu.Unlock()
+		m.certMutex.RLock()
+		for _, cert := range m.certificates {
+			m.stapled(cert)
+		}
+		m.certMutex.RUnlock()
+	}
+
 	// Start the renewal timer if auto-renew is enabled
 	if m.config.AutoRenew {
 		m.startRenewalTimer(ctx)
@@ -424,6 +458,12 @@ func (m *LetsEncryptModule) Stop(ctx context.Context) error {
 		m.renewalWg.Wait()
 	}
 
+	// Stop scheduling OCSP fetches, then wait for those in flight
+	m.ocspMu.Lock()
+	m.ocspStopped = true
+	m.ocspMu.Unlock()
+	m.ocspWg.Wait()
+
 	// Emit service stopped event
 	m.emitEvent(ctx, EventTypeServiceStopped, map[string]interface{}{
 		"certificates_count": len(m.certificates),
@@ -444,7 +484,11 @@ func (m *LetsEncryptModule) GetCertificate(clientHello *tls.ClientHelloInfo) (*t
 		return nil, ErrServerNameEmpty
 	}
 
-	return m.GetCertificateForDomain(clientHello.ServerName)
+	cert, err := m.GetCertificateForDomain(clientHello.ServerName)
+	if err != nil {
+		return nil, err
+	}
+	return m.stapled(cert), nil
 }
 
 // GetCertificateForDomain returns a certificate for the specified domain
@@ -569,16 +613,14 @@ func (m *LetsEncryptModule) initClient() error {
 		return nil
 	}
 
-	// Create ACME user
-	if err := m.createUser(); err != nil {
-		return fmt.Errorf("failed to create ACME user: %w", err)
-	}
-
 	// Configure client based on the module configuration
 	caCertificates := CAStaging
 	if m.config.UseProduction {
 		caCertificates = CAProduction
 	}
+	if m.config.CADirURL != "" {
+		caCertificates = m.config.CADirURL
+	}
 
 	config := lego.NewConfig(m.user)
 	config.CADirURL = caCertificates
@@ -586,6 +628,13 @@ func (m *LetsEncryptModule) initClient() error {
 
 	// Set custom CA certificate if provided
 	if len(m.config.CustomCACertificate) > 0 {
+		if m.rootCAs == nil {
+			rootCAs := x509.NewCertPool()
+			if !rootCAs.AppendCertsFromPEM(m.config.CustomCACertificate) {
+				return ErrInvalidCustomCACertificate
+			}
+			m.rootCAs = rootCAs
+		}
 		config.HTTPClient.Transport = &http.Transport{
 			TLSClientConfig: &tls.Config{
 				RootCAs:    m.rootCAs,
@@ -600,9 +649,12 @@ func (m *LetsEncryptModule) initClient() error {
 		return fmt.Errorf("failed to create ACME client: %w", err)
 	}
 
+	m.client = client
+
 	// Configure challenge type
 	if m.config.UseDNS {
 		if err := m.configureDNSProvider(); err != nil {
+			m.client = nil
 			return fmt.Errorf("failed to configure DNS provider: %w", err)
 		}
 	} else {
@@ -610,11 +662,16 @@ func (m *LetsEncryptModule) initClient() error {
 		if err := client.Challenge.SetHTTP01Provider(&letsEncryptHTTPProvider{
 			handler: m.config.HTTPChallengeHandler,
 		}); err != nil {
+			m.client = nil
 			return fmt.Errorf("failed to set HTTP challenge provider: %w", err)
 		}
 	}
 
-	m.client = client
+	// Register the ACME account now that the client can reach the CA
+	if err := m.createUser(); err != nil {
+		m.client = nil
+		return fmt.Errorf("failed to create ACME user: %w", err)
+	}
 	return nil
 }
 
@@ -826,6 +883,10 @@ func (m *LetsEncryptModule) checkInMemoryCertificateExpiry(domain string, daysUn
 
 // renewCertificateForDomain renews the certificate for a specific domain
 func (m *LetsEncryptModule) renewCertificateForDomain(ctx context.Context, domain string) error {
+	m.emitEvent(ctx, EventTypeRenewalStarted, map[string]interface{}{
+		"domain": domain,
+	})
+
 	// Request certificate for the domain
 	request := certificate.ObtainRequest{
 		Domains: []string{domain},
@@ -839,6 +900,10 @@ func (m *LetsEncryptModule) renewCertificateForDomain(ctx context.Context, domai
 			"domain": domain,
 			"stage":  "certificate_renewal",
 		})
+		m.emitEvent(ctx, EventTypeRenewalFailed, map[string]interface{}{
+			"domain": domain,
+			"error":  err.Error(),
+		})
 		return fmt.Errorf("failed to obtain certificate for domain %s: %w", domain, err)
 	}
 
@@ -850,6 +915,10 @@ func (m *LetsEncryptModule) renewCertificateForDomain(ctx context.Context, domai
 			"domain": domain,
 			"stage":  "certificate_parse_renewal",
 		})
+		m.emitEvent(ctx, EventTypeRenewalFailed, map[string]interface{}{
+			"domain": domain,
+			"error":  err.Error(),
+		})
 		return fmt.Errorf("failed to parse renewed certificate for %s: %w", domain, err)
 	}
 
@@ -857,6 +926,9 @@ func (m *LetsEncryptModule) renewCertificateForDomain(ctx context.Context, domai
 	m.certificates[domain] = &cert
 	m.certMutex.Unlock()
 
+	// Fetch a staple for the new certificate before clients ask for it
+	m.stapled(&cert)
+
 	// Save renewed certificate to storage
 	if m.storage != nil {
 		if err := m.storage.SaveCertificate(domain, certificates); err != nil {
@@ -879,6 +951,13 @@ func (m *LetsEncryptModule) renewCertificateForDomain(ctx context.Context, domai
 		"domain": domain,
 	})
 
+	info := newCertInfo(domain, &cert)
+	m.emitEvent(ctx, EventTypeRenewalSucceeded, map[string]interface{}{
+		"domain":    domain,
+		"not_after": info.NotAfter.Format(time.RFC3339),
+	})
+	m.runRenewalHooks(info)
+
 	return nil
 }
 
@@ -998,7 +1077,22 @@ func (m *LetsEncryptModule) configureDNSProvider() error {
 		return ErrDNSConfigMissing
 	}
 
-	switch m.config.DNSProvider.Provider {
+	// Registered providers take precedence, so applications can replace a built-in one
+	name := m.config.DNSProvider.Provider
+	m.dnsProvidersMu.RLock()
+	factory, registered := m.dnsProviders[name]
+	m.dnsProvidersMu.RUnlock()
+	if registered {
+		provider, err := factory(m.config.DNSProvider)
+		if err != nil {
+			return fmt.Errorf("failed to create %s DNS provider: %w", name, err)
+		}
+		return m.setDNS01Provider(provider)
+	}
+
+	switch name {
+	case "manual":
+		return m.setDNS01Provider(NewManualDNSProvider(os.Stdin, os.Stdout))
 	case "cloudflare":
 		return m.configureCloudflare()
 	case "route53":
@@ -1012,18 +1106,27 @@ func (m *LetsEncryptModule) configureDNSProvider() error {
 	case "namecheap":
 		return m.configureNamecheap()
 	default:
-		return fmt.Errorf("%w: %s", ErrUnsupportedDNSProvider, m.config.DNSProvider.Provider)
+		return fmt.Errorf("%w: %s", ErrUnsupportedDNSProvider, name)
+	}
+}
+
+// setDNS01Provider installs provider as the ACME client's DNS-01 challenge solver
+func (m *LetsEncryptModule) setDNS01Provider(provider DNSProvider) error {
+	err := m.client.Challenge.SetDNS01Provider(provider,
+		dns01.CondOption(m.config.SkipDNSPropagationCheck, dns01.PropagationWait(0, true)))
+	if err != nil {
+		return fmt.Errorf("failed to set DNS01 provider: %w", err)
 	}
-} // configureCloudflare configures the Cloudflare DNS provider
+	return nil
+}
+
+// configureCloudflare configures the Cloudflare DNS provider
 func (m *LetsEncryptModule) configureCloudflare() error {
 	provider, err := m.createCloudflareProvider()
 	if err != nil {
 		return fmt.Errorf("failed to create Cloudflare provider: %w", err)
 	}
-	if err := m.client.Challenge.SetDNS01Provider(provider); err != nil {
-		return fmt.Errorf("failed to set DNS01 provider: %w", err)
-	}
-	return nil
+	return m.setDNS01Provider(provider)
 }
 
 // configureRoute53 configures the Route53 DNS provider
@@ -1032,10 +1135,7 @@ func (m *LetsEncryptModule) configureRoute53() error {
 	if err != nil {
 		return fmt.Errorf("failed to create Route53 provider: %w", err)
 	}
-	if err := m.client.Challenge.SetDNS01Provider(provider); err != nil {
-		return fmt.Errorf("failed to set DNS01 provider: %w", err)
-	}
-	return nil
+	return m.setDNS01Provider(provider)
 }
 
 // configureDigitalOcean configures the DigitalOcean DNS provider
@@ -1044,10 +1144,7 @@ func (m *LetsEncryptModule) configureDigitalOcean() error {
 	if err != nil {
 		return fmt.Errorf("failed to create DigitalOcean provider: %w", err)
 	}
-	if err := m.client.Challenge.SetDNS01Provider(provider); err != nil {
-		return fmt.Errorf("failed to set DNS01 provider: %w", err)
-	}
-	return nil
+	return m.setDNS01Provider(provider)
 }
 
 // configureGoogleCloudDNS configures the Google Cloud DNS provider
@@ -1073,10 +1170,7 @@ func (m *LetsEncryptModule) configureGoogleCloudDNS() error {
 		return fmt.Errorf("failed to initialize Google Cloud DNS provider: %w", err)
 	}
 
-	if err := m.client.Challenge.SetDNS01Provider(provider); err != nil {
-		return fmt.Errorf("failed to set Google Cloud DNS provider: %w", err)
-	}
-	return nil
+	return m.setDNS01Provider(provider)
 }
 
 // configureAzureDNS configures the Azure DNS provider
@@ -1103,10 +1197,7 @@ func (m *LetsEncryptModule) configureAzureDNS() error {
 		return fmt.Errorf("failed to initialize Azure DNS provider: %w", err)
 	}
 
-	if err := m.client.Challenge.SetDNS01Provider(provider); err != nil {
-		return fmt.Errorf("failed to set Azure DNS provider: %w", err)
-	}
-	return nil
+	return m.setDNS01Provider(provider)
 }
 
 // configureNamecheap configures the Namecheap DNS provider
@@ -1140,10 +1231,7 @@ func (m *LetsEncryptModule) configureNamecheap() error {
 		return fmt.Errorf("failed to initialize Namecheap DNS provider: %w", err)
 	}
 
-	if err := m.client.Challenge.SetDNS01Provider(provider); err != nil {
-		return fmt.Errorf("failed to set Namecheap DNS provider: %w", err)
-	}
-	return nil
+	return m.setDNS01Provider(provider)
 }
 
 // letsEncryptHTTPProvider implements the HTTP-01 challenge provider
@@ -1243,6 +1331,9 @@ func (m *LetsEncryptModule) GetRegisteredEventTypes() []string {
 		EventTypeCertificateRevoked,
 		EventTypeCertificateExpiring,
 		EventTypeCertificateExpired,
+		EventTypeRenewalStarted,
+		EventTypeRenewalSucceeded,
+		EventTypeRenewalFailed,
 		EventTypeAcmeChallenge,
 		EventTypeAcmeAuthorization,
 		EventTypeAcmeOrder,
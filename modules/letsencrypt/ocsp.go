@@ -0,0 +1,199 @@
+package letsencrypt
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	// ocspFetchTimeout bounds a single request to an OCSP responder
+	ocspFetchTimeout = 10 * time.Second
+
+	// ocspRetryInterval is how long to wait before retrying a failed fetch
+	ocspRetryInterval = 5 * time.Minute
+
+	// ocspMaxResponseSize caps the size of an OCSP response body
+	ocspMaxResponseSize = 1 << 20
+)
+
+// ocspStaple is a cached OCSP response for one certificate
+type ocspStaple struct {
+	leaf       *x509.Certificate
+	issuer     *x509.Certificate // parsed once when the certificate is first seen
+	raw        []byte            // DER response, nil until a fetch succeeds
+	nextUpdate time.Time         // the response must not be served after this
+	refreshAt  time.Time         // when to fetch a fresh response
+	fetching   bool
+}
+
+// stapled returns cert with a cached OCSP response attached when stapling is
+// enabled. Fetching happens in the background: a missing or stale response
+// triggers a refresh, and until one is available the certificate is served
+// without a staple so the handshake is never delayed by the OCSP responder.
+func (m *LetsEncryptModule) stapled(cert *tls.Certificate) *tls.Certificate {
+	if !m.config.OCSPStapling {
+		return cert
+	}
+	leaf := certificateLeaf(cert)
+	if leaf == nil || len(leaf.OCSPServer) == 0 {
+		return cert
+	}
+
+	// Keying by serial means a renewed certificate never receives its
+	// predecessor's response
+	key := leaf.SerialNumber.String()
+	now := time.Now()
+
+	m.ocspMu.Lock()
+	if m.ocspStaples == nil {
+		m.ocspStaples = make(map[string]*ocspStaple)
+	}
+	staple := m.ocspStaples[key]
+	if staple == nil {
+		issuer := certificateIssuer(cert)
+		if issuer == nil {
+			m.ocspMu.Unlock()
+			return cert
+		}
+		staple = &ocspStaple{leaf: leaf, issuer: issuer}
+		m.ocspStaples[key] = staple
+	}
+	// Once Stop is waiting on ocspWg no new fetches may be added to it
+	if !m.ocspStopped && !staple.fetching && !now.Before(staple.refreshAt) {
+		staple.fetching = true
+		m.ocspWg.Add(1)
+		go m.refreshOCSPStaple(key, staple.leaf, staple.issuer)
+	}
+	var raw []byte
+	if staple.raw != nil && now.Before(staple.nextUpdate) {
+		raw = staple.raw
+	}
+	m.ocspMu.Unlock()
+
+	if raw == nil {
+		return cert
+	}
+	withStaple := *cert
+	withStaple.OCSPStaple = raw
+	return &withStaple
+}
+
+// refreshOCSPStaple fetches a fresh OCSP response for leaf and caches it.
+// On failure the previous response, if still valid, keeps being served.
+func (m *LetsEncryptModule) refreshOCSPStaple(key string, leaf, issuer *x509.Certificate) {
+	defer m.ocspWg.Done()
+
+	response, raw, err := fetchOCSPResponse(leaf, issuer)
+	now := time.Now()
+
+	m.ocspMu.Lock()
+	defer m.ocspMu.Unlock()
+
+	staple := m.ocspStaples[key]
+	if staple == nil {
+		staple = &ocspStaple{leaf: leaf, issuer: issuer}
+		m.ocspStaples[key] = staple
+	}
+	staple.fetching = false
+
+	if err != nil {
+		staple.refreshAt = now.Add(ocspRetryInterval)
+		m.logger.Warn("Failed to fetch OCSP response, serving certificate without staple",
+			"domains", leaf.DNSNames, "responder", leaf.OCSPServer[0], "error", err)
+		return
+	}
+	if response.Status == ocsp.Revoked {
+		m.logger.Error("OCSP responder reports certificate revoked", "domains", leaf.DNSNames, "revoked_at", response.RevokedAt)
+	}
+
+	staple.raw = raw
+	staple.nextUpdate = response.NextUpdate
+	if staple.nextUpdate.IsZero() {
+		// No nextUpdate means newer information is always available
+		staple.nextUpdate = now.Add(ocspRetryInterval)
+	}
+	// Refresh halfway through the validity window, well before expiry
+	staple.refreshAt = response.ThisUpdate.Add(staple.nextUpdate.Sub(response.ThisUpdate) / 2)
+
+	// Drop responses for certificates that have since been replaced
+	for k, s := range m.ocspStaples {
+		if k != key && !s.fetching && !now.Before(s.nextUpdate) {
+			delete(m.ocspStaples, k)
+		}
+	}
+}
+
+// fetchOCSPResponse requests the status of leaf from its OCSP responder
+func fetchOCSPResponse(leaf, issuer *x509.Certificate) (*ocsp.Response, []byte, error) {
+	request, err := ocsp.CreateRequest(leaf, issuer, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create OCSP request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ocspFetchTimeout)
+	defer cancel()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, leaf.OCSPServer[0], bytes.NewReader(request))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create OCSP HTTP request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/ocsp-request")
+	httpReq.Header.Set("Accept", "application/ocsp-response")
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, nil, fmt.Errorf("OCSP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("%w: %s", ErrOCSPResponderStatus, resp.Status)
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, ocspMaxResponseSize))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read OCSP response: %w", err)
+	}
+
+	response, err := ocsp.ParseResponseForCert(raw, leaf, issuer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid OCSP response: %w", err)
+	}
+	return response, raw, nil
+}
+
+// certificateLeaf returns the parsed leaf of cert. Certificates loaded by the
+// module already carry it in cert.Leaf, so parsing only happens for
+// certificates built by hand.
+func certificateLeaf(cert *tls.Certificate) *x509.Certificate {
+	if cert == nil || len(cert.Certificate) == 0 {
+		return nil
+	}
+	if cert.Leaf != nil {
+		return cert.Leaf
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil
+	}
+	return leaf
+}
+
+// certificateIssuer returns the parsed issuer of cert, or nil if the chain
+// has none or it cannot be parsed
+func certificateIssuer(cert *tls.Certificate) *x509.Certificate {
+	if cert == nil || len(cert.Certificate) < 2 {
+		return nil
+	}
+	issuer, err := x509.ParseCertificate(cert.Certificate[1])
+	if err != nil {
+		return nil
+	}
+	return issuer
+}
@@ -0,0 +1,281 @@
+package letsencrypt
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+// mockOCSPResponder signs OCSP responses for certificates issued by its CA
+type mockOCSPResponder struct {
+	*httptest.Server
+	caKey  *ecdsa.PrivateKey
+	caCert *x509.Certificate
+
+	mu       sync.Mutex
+	requests int
+	fail     bool
+	validity time.Duration // NextUpdate - ThisUpdate
+	age      time.Duration // how long before now ThisUpdate is
+}
+
+func newMockOCSPResponder(t *testing.T) *mockOCSPResponder {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate CA key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "OCSP Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("Failed to create CA certificate: %v", err)
+	}
+	caCert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse CA certificate: %v", err)
+	}
+
+	r := &mockOCSPResponder{caKey: caKey, caCert: caCert, validity: time.Hour}
+	r.Server = httptest.NewServer(http.HandlerFunc(r.handle))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *mockOCSPResponder) handle(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	r.requests++
+	fail, validity, age := r.fail, r.validity, r.age
+	r.mu.Unlock()
+
+	if fail {
+		http.Error(w, "responder unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ocspReq, err := ocsp.ParseRequest(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	thisUpdate := time.Now().Add(-age)
+	response, err := ocsp.CreateResponse(r.caCert, r.caCert, ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: ocspReq.SerialNumber,
+		ThisUpdate:   thisUpdate,
+		NextUpdate:   thisUpdate.Add(validity),
+	}, r.caKey)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/ocsp-response")
+	_, _ = w.Write(response)
+}
+
+func (r *mockOCSPResponder) requestCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.requests
+}
+
+// issue creates a certificate for domain that names the responder for OCSP
+func (r *mockOCSPResponder) issue(t *testing.T, domain string, serial int64) *tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: domain},
+		DNSNames:     []string{domain},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		OCSPServer:   []string{r.URL},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, r.caCert, &key.PublicKey, r.caKey)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	return &tls.Certificate{Certificate: [][]byte{der, r.caCert.Raw}, PrivateKey: key}
+}
+
+func newOCSPTestModule(cert *tls.Certificate, stapling bool) *LetsEncryptModule {
+	return &LetsEncryptModule{
+		config:       &LetsEncryptConfig{OCSPStapling: stapling},
+		certificates: map[string]*tls.Certificate{"example.com": cert},
+		logger:       &testLogger{},
+	}
+}
+
+// handshakeCertificate returns the certificate served for example.com once
+// any OCSP fetch triggered by the handshake has finished
+func handshakeCertificate(t *testing.T, module *LetsEncryptModule) *tls.Certificate {
+	t.Helper()
+	cert, err := module.GetCertificate(&tls.ClientHelloInfo{ServerName: "example.com"})
+	if err != nil {
+		t.Fatalf("GetCertificate failed: %v", err)
+	}
+	module.ocspWg.Wait()
+	return cert
+}
+
+func TestOCSPStapling(t *testing.T) {
+	responder := newMockOCSPResponder(t)
+	cert := responder.issue(t, "example.com", 100)
+	module := newOCSPTestModule(cert, true)
+
+	// The first handshake is not held up by the fetch
+	if first := handshakeCertificate(t, module); first.OCSPStaple != nil {
+		t.Error("Expected first handshake to be served without a staple")
+	}
+
+	stapled := handshakeCertificate(t, module)
+	if stapled.OCSPStaple == nil {
+		t.Fatal("Expected OCSP staple after the response was fetched")
+	}
+	response, err := ocsp.ParseResponse(stapled.OCSPStaple, responder.caCert)
+	if err != nil {
+		t.Fatalf("Stapled response is invalid: %v", err)
+	}
+	if response.SerialNumber.Int64() != 100 || response.Status != ocsp.Good {
+		t.Errorf("Expected good status for serial 100, got status %d for serial %v", response.Status, response.SerialNumber)
+	}
+	if cert.OCSPStaple != nil {
+		t.Error("Expected the stored certificate not to be modified")
+	}
+	if got := responder.requestCount(); got != 1 {
+		t.Errorf("Expected the response to be cached, responder saw %d requests", got)
+	}
+}
+
+func TestOCSPStaplingRefreshesBeforeExpiry(t *testing.T) {
+	responder := newMockOCSPResponder(t)
+	// Responses are past the midpoint of their validity when issued
+	responder.age = 50 * time.Minute
+	module := newOCSPTestModule(responder.issue(t, "example.com", 100), true)
+
+	handshakeCertificate(t, module)
+	if stapled := handshakeCertificate(t, module); stapled.OCSPStaple == nil {
+		t.Fatal("Expected the still-valid response to be stapled while refreshing")
+	}
+	if got := responder.requestCount(); got != 2 {
+		t.Errorf("Expected a refresh once the response passed its midpoint, responder saw %d requests", got)
+	}
+}
+
+func TestOCSPStaplingResponderUnavailable(t *testing.T) {
+	responder := newMockOCSPResponder(t)
+	responder.fail = true
+	module := newOCSPTestModule(responder.issue(t, "example.com", 100), true)
+
+	handshakeCertificate(t, module)
+	cert := handshakeCertificate(t, module)
+	if cert.OCSPStaple != nil {
+		t.Error("Expected no staple when the responder is unavailable")
+	}
+	if got := responder.requestCount(); got != 1 {
+		t.Errorf("Expected failed fetches to back off, responder saw %d requests", got)
+	}
+}
+
+func TestOCSPStaplingAfterRenewal(t *testing.T) {
+	responder := newMockOCSPResponder(t)
+	module := newOCSPTestModule(responder.issue(t, "example.com", 100), true)
+	handshakeCertificate(t, module)
+	if handshakeCertificate(t, module).OCSPStaple == nil {
+		t.Fatal("Expected OCSP staple for the original certificate")
+	}
+
+	module.certMutex.Lock()
+	module.certificates["example.com"] = responder.issue(t, "example.com", 200)
+	module.certMutex.Unlock()
+
+	if handshakeCertificate(t, module).OCSPStaple != nil {
+		t.Error("Expected the renewed certificate not to carry its predecessor's staple")
+	}
+	stapled := handshakeCertificate(t, module)
+	if stapled.OCSPStaple == nil {
+		t.Fatal("Expected OCSP staple for the renewed certificate")
+	}
+	response, err := ocsp.ParseResponse(stapled.OCSPStaple, responder.caCert)
+	if err != nil {
+		t.Fatalf("Stapled response is invalid: %v", err)
+	}
+	if response.SerialNumber.Int64() != 200 {
+		t.Errorf("Expected staple for serial 200, got %v", response.SerialNumber)
+	}
+}
+
+func TestOCSPStaplingDisabled(t *testing.T) {
+	responder := newMockOCSPResponder(t)
+	module := newOCSPTestModule(responder.issue(t, "example.com", 100), false)
+
+	handshakeCertificate(t, module)
+	if cert := handshakeCertificate(t, module); cert.OCSPStaple != nil {
+		t.Error("Expected no staple when stapling is disabled")
+	}
+	if got := responder.requestCount(); got != 0 {
+		t.Errorf("Expected no OCSP requests, responder saw %d", got)
+	}
+}
+
+func TestOCSPStaplingParsesIssuerOnce(t *testing.T) {
+	responder := newMockOCSPResponder(t)
+	module := newOCSPTestModule(responder.issue(t, "example.com", 100), true)
+
+	handshakeCertificate(t, module)
+	module.ocspMu.Lock()
+	issuer := module.ocspStaples["100"].issuer
+	module.ocspMu.Unlock()
+	if issuer == nil {
+		t.Fatal("Expected the issuer to be cached with the staple")
+	}
+
+	handshakeCertificate(t, module)
+	module.ocspMu.Lock()
+	defer module.ocspMu.Unlock()
+	if module.ocspStaples["100"].issuer != issuer {
+		t.Error("Expected later handshakes to reuse the parsed issuer")
+	}
+}
+
+func TestOCSPStaplingNoFetchAfterStop(t *testing.T) {
+	responder := newMockOCSPResponder(t)
+	module := newOCSPTestModule(responder.issue(t, "example.com", 100), true)
+
+	if err := module.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+	handshakeCertificate(t, module)
+	if got := responder.requestCount(); got != 0 {
+		t.Errorf("Expected no OCSP fetches after Stop, responder saw %d requests", got)
+	}
+}
@@ -0,0 +1,28 @@
+// Package metrics provides Prometheus metrics exposition for the modular framework.
+package metrics
+
+// MetricsConfig defines the configuration for the metrics module.
+type MetricsConfig struct {
+	// Path is the route the metrics handler is registered on
+	Path string `yaml:"path" json:"path" toml:"path" env:"METRICS_PATH" default:"/metrics"`
+
+	// Namespace prefixes the names of the default collectors' metrics
+	Namespace string `yaml:"namespace" json:"namespace" toml:"namespace" env:"METRICS_NAMESPACE" default:"modular"`
+
+	// RuntimeMetrics adds the Go runtime and process collectors
+	RuntimeMetrics bool `yaml:"runtime_metrics" json:"runtime_metrics" toml:"runtime_metrics" env:"METRICS_RUNTIME" default:"true"`
+}
+
+// Validate checks the configuration, filling in defaults for empty values
+func (c *MetricsConfig) Validate() error {
+	if c.Path == "" {
+		c.Path = DefaultPath
+	}
+	if c.Path[0] != '/' {
+		return ErrInvalidPath
+	}
+	if c.Namespace == "" {
+		c.Namespace = DefaultNamespace
+	}
+	return nil
+}
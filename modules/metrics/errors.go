@@ -0,0 +1,12 @@
+package metrics
+
+import "errors"
+
+// Error definitions
+var (
+	// ErrInvalidPath is returned when the metrics path does not start with a slash
+	ErrInvalidPath = errors.New("metrics path must start with /")
+
+	// ErrNoSubjectForEventEmission is returned when trying to emit events without a subject
+	ErrNoSubjectForEventEmission = errors.New("no subject available for event emission")
+)
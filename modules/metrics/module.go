@@ -0,0 +1,190 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/GoCodeAlone/modular"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// ModuleName is the unique identifier for the metrics module.
+const ModuleName = "metrics"
+
+// ServiceName is the name of the MetricsRegistry service the module provides.
+const ServiceName = "metrics.registry"
+
+// Configuration defaults
+const (
+	DefaultPath      = "/metrics"
+	DefaultNamespace = "modular"
+)
+
+// routerService is implemented by routers, such as the chimux module, that the
+// metrics handler can be registered on.
+type routerService interface {
+	Handle(pattern string, handler http.Handler)
+}
+
+// MetricsModule exposes Prometheus metrics for the application. Registering it
+// is what enables metrics: applications that do not use this module do not
+// depend on the Prometheus client.
+//
+// The module provides a MetricsRegistry service with default collectors for
+// module start and stop durations and, when the reverseproxy module is used,
+// request counts and latency by backend and circuit breaker states. The
+// collectors are fed from the events emitted by an ObservableApplication, so
+// the application must be created with modular.NewObservableApplication or the
+// builder's observer support for them to be populated.
+//
+// When a router service is available, the metrics handler is registered on it
+// at the configured path (default /metrics).
+type MetricsModule struct {
+	config   *MetricsConfig
+	logger   modular.Logger
+	router   routerService
+	subject  modular.Subject
+	registry *MetricsRegistry
+	ready    atomic.Bool // Set once Init has registered the default collectors
+}
+
+// NewModule creates a new metrics module.
+//
+// Example:
+//
+//	app.RegisterModule(chimux.NewChiMuxModule())
+//	app.RegisterModule(metrics.NewModule())
+func NewModule() modular.Module {
+	return &MetricsModule{registry: newEmptyRegistry()}
+}
+
+// Name returns the name of the module
+func (m *MetricsModule) Name() string {
+	return ModuleName
+}
+
+// RegisterConfig registers the module's configuration section with defaults
+func (m *MetricsModule) RegisterConfig(app modular.Application) error {
+	app.RegisterConfigSection(m.Name(), modular.NewStdConfigProvider(&MetricsConfig{
+		Path:           DefaultPath,
+		Namespace:      DefaultNamespace,
+		RuntimeMetrics: true,
+	}))
+	return nil
+}
+
+// Init creates the metrics registry and registers the metrics handler on the
+// router, if one is available
+func (m *MetricsModule) Init(app modular.Application) error {
+	cfg, err := app.GetConfigSection(m.Name())
+	if err != nil {
+		return fmt.Errorf("failed to get config section '%s': %w", m.Name(), err)
+	}
+	m.config = cfg.GetConfig().(*MetricsConfig)
+	if err := m.config.Validate(); err != nil {
+		return fmt.Errorf("invalid metrics config: %w", err)
+	}
+	m.logger = app.Logger()
+
+	m.registry.registerDefaultCollectors(m.config.Namespace)
+	if m.config.RuntimeMetrics {
+		m.registry.MustRegister(
+			collectors.NewGoCollector(),
+			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		)
+	}
+	m.ready.Store(true)
+
+	if m.router != nil {
+		m.router.Handle(m.config.Path, m.registry.Handler())
+		m.logger.Info("Registered metrics handler", "path", m.config.Path)
+	} else {
+		m.logger.Debug("No router available, metrics handler not registered")
+	}
+	return nil
+}
+
+// Registry returns the metrics registry. The default collectors are added
+// to it during Init.
+func (m *MetricsModule) Registry() *MetricsRegistry {
+	return m.registry
+}
+
+// ProvidesServices declares the MetricsRegistry service
+func (m *MetricsModule) ProvidesServices() []modular.ServiceProvider {
+	return []modular.ServiceProvider{
+		{
+			Name:        ServiceName,
+			Description: "Prometheus metrics registry exposed on the metrics handler",
+			Instance:    m.registry,
+		},
+	}
+}
+
+// RequiresServices declares the optional router the metrics handler is
+// registered on
+func (m *MetricsModule) RequiresServices() []modular.ServiceDependency {
+	return []modular.ServiceDependency{
+		{
+			Name:               "router",
+			Required:           false,
+			MatchByInterface:   true,
+			SatisfiesInterface: reflect.TypeOf((*routerService)(nil)).Elem(),
+		},
+	}
+}
+
+// Constructor returns a constructor that injects the optional router service
+func (m *MetricsModule) Constructor() modular.ModuleConstructor {
+	return func(app modular.Application, services map[string]any) (modular.Module, error) {
+		if router, ok := services["router"].(routerService); ok {
+			m.router = router
+		}
+		return m, nil
+	}
+}
+
+// RegisterObservers registers the module as an observer of the lifecycle and
+// reverseproxy events the default collectors are fed from
+func (m *MetricsModule) RegisterObservers(subject modular.Subject) error {
+	m.subject = subject
+	if err := subject.RegisterObserver(m, observedEventTypes...); err != nil {
+		return fmt.Errorf("failed to register metrics observer: %w", err)
+	}
+	return nil
+}
+
+// EmitEvent implements the ObservableModule interface
+func (m *MetricsModule) EmitEvent(ctx context.Context, event cloudevents.Event) error {
+	if m.subject == nil {
+		return ErrNoSubjectForEventEmission
+	}
+	if err := m.subject.NotifyObservers(ctx, event); err != nil {
+		return fmt.Errorf("failed to notify observers: %w", err)
+	}
+	return nil
+}
+
+// GetRegisteredEventTypes implements the ObservableModule interface. The
+// metrics module observes events but emits none.
+func (m *MetricsModule) GetRegisteredEventTypes() []string {
+	return nil
+}
+
+// OnEvent updates the default collectors from an observed event. Events
+// received before Init are dropped.
+func (m *MetricsModule) OnEvent(_ context.Context, event cloudevents.Event) error {
+	if m.ready.Load() {
+		m.registry.observe(event)
+	}
+	return nil
+}
+
+// ObserverID returns the observer identifier of the module
+func (m *MetricsModule) ObserverID() string {
+	return ModuleName
+}
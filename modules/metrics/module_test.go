@@ -0,0 +1,118 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoCodeAlone/modular"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRouter records the handlers registered on it
+type testRouter struct {
+	handlers map[string]http.Handler
+}
+
+func (r *testRouter) Handle(pattern string, handler http.Handler) {
+	r.handlers[pattern] = handler
+}
+
+type testLogger struct{}
+
+func (testLogger) Info(string, ...any)  {}
+func (testLogger) Error(string, ...any) {}
+func (testLogger) Warn(string, ...any)  {}
+func (testLogger) Debug(string, ...any) {}
+
+// newMetricsApp initializes an observable application with the metrics module
+// and a router to register the metrics handler on
+func newMetricsApp(t *testing.T) (*modular.ObservableApplication, *testRouter) {
+	t.Helper()
+	app := modular.NewObservableApplication(modular.NewStdConfigProvider(&struct{}{}), testLogger{})
+	router := &testRouter{handlers: make(map[string]http.Handler)}
+	require.NoError(t, app.RegisterService("router", router))
+	app.RegisterModule(NewModule())
+	require.NoError(t, app.Init())
+	return app, router
+}
+
+// scrape returns the body served by the metrics handler
+func scrape(t *testing.T, handler http.Handler) string {
+	t.Helper()
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	body, err := io.ReadAll(w.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestMetricsModule_ExposesDefaultCollectors(t *testing.T) {
+	app, router := newMetricsApp(t)
+	require.Contains(t, router.handlers, DefaultPath)
+
+	ctx := modular.WithSynchronousNotification(context.Background())
+	events := []struct {
+		eventType string
+		data      any
+	}{
+		{eventTypeProxyRequestProxied, map[string]any{"backend": "api", "status": 200, "duration_seconds": 0.05}},
+		{eventTypeProxyRequestFailed, map[string]any{"backend": "api", "status": 502, "duration_seconds": 0.2}},
+		// Transport error events without a duration are not counted twice
+		{eventTypeProxyRequestFailed, map[string]any{"backend": "api", "error": "connection refused"}},
+		{eventTypeCircuitBreakerOpen, map[string]any{"backend": "api", "state": "open"}},
+	}
+	for _, e := range events {
+		require.NoError(t, app.NotifyObservers(ctx, modular.NewCloudEvent(e.eventType, "reverseproxy", e.data, nil)))
+	}
+	require.NoError(t, app.NotifyObservers(ctx, modular.NewModuleLifecycleEvent("application", "module", "cache", "", "started",
+		map[string]any{"duration_seconds": 0.01})))
+	require.NoError(t, app.NotifyObservers(ctx, modular.NewModuleLifecycleEvent("application", "module", "cache", "", "stopped",
+		map[string]any{"duration_seconds": 0.02})))
+
+	body := scrape(t, router.handlers[DefaultPath])
+	assert.Contains(t, body, `modular_reverseproxy_requests_total{backend="api",status="200"} 1`)
+	assert.Contains(t, body, `modular_reverseproxy_requests_total{backend="api",status="502"} 1`)
+	assert.Contains(t, body, `modular_reverseproxy_request_duration_seconds_count{backend="api"} 2`)
+	assert.Contains(t, body, `modular_reverseproxy_circuit_breaker_state{backend="api"} 2`)
+	assert.Contains(t, body, `modular_module_start_duration_seconds_count{module="cache"} 1`)
+	assert.Contains(t, body, `modular_module_stop_duration_seconds_count{module="cache"} 1`)
+	assert.Contains(t, body, "go_goroutines", "runtime metrics are enabled by default")
+}
+
+func TestMetricsModule_ProvidesRegistryService(t *testing.T) {
+	app, router := newMetricsApp(t)
+
+	var registry *MetricsRegistry
+	require.NoError(t, app.GetService(ServiceName, &registry))
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "custom_jobs_total", Help: "Jobs run."})
+	require.NoError(t, registry.Register(counter))
+	counter.Inc()
+
+	assert.Contains(t, scrape(t, router.handlers[DefaultPath]), "custom_jobs_total 1")
+}
+
+func TestMetricsRegistry_CircuitBreakerStates(t *testing.T) {
+	registry := NewMetricsRegistry("test")
+	registry.observe(modular.NewCloudEvent(eventTypeCircuitBreakerOpen, "reverseproxy", map[string]any{"backend": "a"}, nil))
+	registry.observe(modular.NewCloudEvent(eventTypeCircuitBreakerHalfOpen, "reverseproxy", map[string]any{"backend": "a"}, nil))
+	registry.observe(modular.NewCloudEvent(eventTypeCircuitBreakerClosed, "reverseproxy", map[string]any{"backend": "b"}, nil))
+
+	body := scrape(t, registry.Handler())
+	assert.Contains(t, body, `test_reverseproxy_circuit_breaker_state{backend="a"} 1`)
+	assert.Contains(t, body, `test_reverseproxy_circuit_breaker_state{backend="b"} 0`)
+}
+
+func TestMetricsConfig_Validate(t *testing.T) {
+	cfg := &MetricsConfig{}
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, DefaultPath, cfg.Path)
+	assert.Equal(t, DefaultNamespace, cfg.Namespace)
+
+	assert.ErrorIs(t, (&MetricsConfig{Path: "metrics"}).Validate(), ErrInvalidPath)
+}
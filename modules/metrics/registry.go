@@ -0,0 +1,203 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/GoCodeAlone/modular"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Event types the default collectors are fed from. The reverseproxy types
+// mirror the constants of the reverseproxy module, which is not imported so
+// that applications without a proxy do not depend on it.
+const (
+	eventTypeProxyRequestProxied    = "com.modular.reverseproxy.request.proxied"
+	eventTypeProxyRequestFailed     = "com.modular.reverseproxy.request.failed"
+	eventTypeCircuitBreakerOpen     = "com.modular.reverseproxy.circuitbreaker.open"
+	eventTypeCircuitBreakerClosed   = "com.modular.reverseproxy.circuitbreaker.closed"
+	eventTypeCircuitBreakerHalfOpen = "com.modular.reverseproxy.circuitbreaker.halfopen"
+)
+
+// observedEventTypes lists the event types the module observes
+var observedEventTypes = []string{
+	modular.EventTypeModuleStarted,
+	modular.EventTypeModuleStopped,
+	eventTypeProxyRequestProxied,
+	eventTypeProxyRequestFailed,
+	eventTypeCircuitBreakerOpen,
+	eventTypeCircuitBreakerClosed,
+	eventTypeCircuitBreakerHalfOpen,
+}
+
+// Circuit breaker states reported by the circuit breaker state gauge
+const (
+	CircuitClosed   = 0
+	CircuitHalfOpen = 1
+	CircuitOpen     = 2
+)
+
+// MetricsRegistry is the Prometheus registry provided as the "metrics.registry"
+// service. It holds the default collectors, and modules can register their own
+// collectors with it to have them exposed on the metrics handler.
+type MetricsRegistry struct {
+	registry *prometheus.Registry
+
+	moduleStartDuration *prometheus.HistogramVec
+	moduleStopDuration  *prometheus.HistogramVec
+	proxyRequests       *prometheus.CounterVec
+	proxyDuration       *prometheus.HistogramVec
+	circuitBreakerState *prometheus.GaugeVec
+}
+
+// NewMetricsRegistry creates a registry with the default collectors, naming
+// their metrics with the given namespace.
+func NewMetricsRegistry(namespace string) *MetricsRegistry {
+	r := newEmptyRegistry()
+	r.registerDefaultCollectors(namespace)
+	return r
+}
+
+// newEmptyRegistry creates a registry without the default collectors
+func newEmptyRegistry() *MetricsRegistry {
+	return &MetricsRegistry{registry: prometheus.NewRegistry()}
+}
+
+// registerDefaultCollectors creates and registers the default collectors
+func (r *MetricsRegistry) registerDefaultCollectors(namespace string) {
+	r.moduleStartDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "module_start_duration_seconds",
+		Help:      "Time taken by module Start calls.",
+	}, []string{"module"})
+	r.moduleStopDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "module_stop_duration_seconds",
+		Help:      "Time taken by module Stop calls.",
+	}, []string{"module"})
+	r.proxyRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "reverseproxy",
+		Name:      "requests_total",
+		Help:      "Requests proxied to backends, by backend and response status.",
+	}, []string{"backend", "status"})
+	r.proxyDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "reverseproxy",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests proxied to backends.",
+	}, []string{"backend"})
+	r.circuitBreakerState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "reverseproxy",
+		Name:      "circuit_breaker_state",
+		Help:      "Circuit breaker state by backend: 0 closed, 1 half-open, 2 open.",
+	}, []string{"backend"})
+	r.registry.MustRegister(
+		r.moduleStartDuration,
+		r.moduleStopDuration,
+		r.proxyRequests,
+		r.proxyDuration,
+		r.circuitBreakerState,
+	)
+}
+
+// Register registers a collector to be exposed with the default metrics
+func (r *MetricsRegistry) Register(c prometheus.Collector) error {
+	return r.registry.Register(c) //nolint:wrapcheck // callers check for prometheus.AlreadyRegisteredError
+}
+
+// MustRegister registers collectors, panicking if any cannot be registered
+func (r *MetricsRegistry) MustRegister(cs ...prometheus.Collector) {
+	r.registry.MustRegister(cs...)
+}
+
+// Unregister removes a collector, reporting whether it was registered
+func (r *MetricsRegistry) Unregister(c prometheus.Collector) bool {
+	return r.registry.Unregister(c)
+}
+
+// Gatherer returns the registry for gathering metrics directly
+func (r *MetricsRegistry) Gatherer() prometheus.Gatherer {
+	return r.registry
+}
+
+// Handler returns an HTTP handler serving the registered metrics in the
+// Prometheus exposition format
+func (r *MetricsRegistry) Handler() http.Handler {
+	return promhttp.HandlerFor(r.registry, promhttp.HandlerOpts{Registry: r.registry})
+}
+
+// observe updates the default collectors from an application or module event.
+// It must not be called before the default collectors are registered.
+func (r *MetricsRegistry) observe(event cloudevents.Event) {
+	switch event.Type() {
+	case modular.EventTypeModuleStarted, modular.EventTypeModuleStopped:
+		var payload modular.ModuleLifecyclePayload
+		if err := event.DataAs(&payload); err != nil || payload.Name == "" {
+			return
+		}
+		duration, ok := floatValue(payload.Metadata["duration_seconds"])
+		if !ok {
+			return
+		}
+		if event.Type() == modular.EventTypeModuleStarted {
+			r.moduleStartDuration.WithLabelValues(payload.Name).Observe(duration)
+		} else {
+			r.moduleStopDuration.WithLabelValues(payload.Name).Observe(duration)
+		}
+
+	case eventTypeProxyRequestProxied, eventTypeProxyRequestFailed:
+		var data map[string]any
+		if err := event.DataAs(&data); err != nil {
+			return
+		}
+		// Only the events emitted once a request completes carry its duration;
+		// the transport's error events for the same request are skipped.
+		duration, ok := floatValue(data["duration_seconds"])
+		if !ok {
+			return
+		}
+		backend, _ := data["backend"].(string)
+		status := "error"
+		if code, ok := floatValue(data["status"]); ok {
+			status = strconv.Itoa(int(code))
+		}
+		r.proxyRequests.WithLabelValues(backend, status).Inc()
+		r.proxyDuration.WithLabelValues(backend).Observe(duration)
+
+	case eventTypeCircuitBreakerOpen, eventTypeCircuitBreakerHalfOpen, eventTypeCircuitBreakerClosed:
+		var data map[string]any
+		if err := event.DataAs(&data); err != nil {
+			return
+		}
+		backend, _ := data["backend"].(string)
+		state := CircuitClosed
+		switch event.Type() {
+		case eventTypeCircuitBreakerOpen:
+			state = CircuitOpen
+		case eventTypeCircuitBreakerHalfOpen:
+			state = CircuitHalfOpen
+		}
+		r.circuitBreakerState.WithLabelValues(backend).Set(float64(state))
+	}
+}
+
+// floatValue converts a numeric event data value, which is a float64 after a
+// JSON round trip, to float64
+func floatValue(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
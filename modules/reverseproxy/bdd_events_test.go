@@ -307,6 +307,9 @@ func (ctx *ReverseProxyBDDTestContext) theEventShouldContainBackendAndResponseDe
 			if _, exists := data["backend"]; !exists {
 				return fmt.Errorf("request proxied event should contain backend field")
 			}
+			if _, exists := data["duration_seconds"]; !exists {
+				return fmt.Errorf("request proxied event should contain duration_seconds field")
+			}
 
 			return nil
 		}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -32,6 +33,22 @@ const (
 	StrategySequential CompositeStrategy = "sequential"
 )
 
+// PartialResultPolicy defines how the merge strategy handles backends that fail.
+type PartialResultPolicy string
+
+const (
+	// PartialResultsBestEffort merges the responses that succeeded and omits failed
+	// backends. The request fails only if no backend responds.
+	PartialResultsBestEffort PartialResultPolicy = "best-effort"
+
+	// PartialResultsFailFast fails the whole request as soon as any backend errors,
+	// times out, has an open circuit, or returns a 5xx status, canceling the others.
+	PartialResultsFailFast PartialResultPolicy = "fail-fast"
+)
+
+// defaultCompositeTimeout bounds composite backend requests when the route sets no timeout.
+const defaultCompositeTimeout = 30 * time.Second
+
 // ResponseTransformer is a function that can transform backend responses.
 // It receives a map of backend responses (keyed by backend ID) and can modify them
 // or create a new combined response. This allows for complex response manipulation
@@ -64,6 +81,9 @@ type CompositeHandler struct {
 
 	// Empty response policy for pipeline and fan-out-merge strategies
 	emptyResponsePolicy EmptyResponsePolicy
+
+	// Partial result policy for the merge strategy
+	partialResultPolicy PartialResultPolicy
 }
 
 // NewCompositeHandler creates a new composite handler with the given backends and strategy.
@@ -145,6 +165,11 @@ func (h *CompositeHandler) SetEmptyResponsePolicy(policy EmptyResponsePolicy) {
 	h.emptyResponsePolicy = policy
 }
 
+// SetPartialResultPolicy sets how the merge strategy handles failed backends.
+func (h *CompositeHandler) SetPartialResultPolicy(policy PartialResultPolicy) {
+	h.partialResultPolicy = policy
+}
+
 // ServeHTTP handles the request by forwarding it to all backends
 // and merging the responses.
 func (h *CompositeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -287,10 +312,30 @@ func (h *CompositeHandler) executeFirstSuccess(ctx context.Context, w http.Respo
 }
 
 // executeMerge executes all backend requests in parallel and merges their responses.
+// Each response body is read before the request completes, so a backend that
+// times out does not affect the responses already received.
 func (h *CompositeHandler) executeMerge(ctx context.Context, w http.ResponseWriter, r *http.Request, bodyBytes []byte) {
+	failFast := h.partialResultPolicy == PartialResultsFailFast
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	var wg sync.WaitGroup
 	var mu sync.Mutex
 	responses := make(map[string]*http.Response)
+	var failedBackend string
+	var failure error
+
+	fail := func(backendID string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failure == nil {
+			failedBackend, failure = backendID, err
+		}
+		if failFast {
+			// Abandon the remaining backends
+			cancel()
+		}
+	}
 
 	// Create a wait group to track each backend request.
 	for _, backend := range h.backends {
@@ -300,15 +345,24 @@ func (h *CompositeHandler) executeMerge(ctx context.Context, w http.ResponseWrit
 			circuitBreaker := h.circuitBreakers[b.ID]
 			if circuitBreaker != nil && circuitBreaker.IsOpen() {
 				// Circuit is open, skip this backend.
+				fail(b.ID, ErrCircuitOpen)
 				return
 			}
 
-			// Execute the request.
-			resp, err := h.executeBackendRequest(ctx, b, r, bodyBytes) //nolint:bodyclose // Response body is closed in mergeResponses cleanup
+			// Execute the request and read the body while the context is live.
+			resp, err := h.executeBackendRequest(ctx, b, r, bodyBytes)
+			var body []byte
+			if err == nil {
+				body, err = io.ReadAll(resp.Body)
+				resp.Body.Close()
+				resp.Body = io.NopCloser(bytes.NewReader(body))
+			}
 			if err != nil {
-				if circuitBreaker != nil {
+				// Backends canceled by fail-fast are not at fault
+				if circuitBreaker != nil && !(failFast && errors.Is(err, context.Canceled)) {
 					circuitBreaker.RecordFailure()
 				}
+				fail(b.ID, err)
 				return
 			}
 
@@ -317,6 +371,11 @@ func (h *CompositeHandler) executeMerge(ctx context.Context, w http.ResponseWrit
 				circuitBreaker.RecordSuccess()
 			}
 
+			if failFast && resp.StatusCode >= http.StatusInternalServerError {
+				fail(b.ID, fmt.Errorf("%w: %d", ErrBackendErrorStatus, resp.StatusCode))
+				return
+			}
+
 			// Store the response.
 			mu.Lock()
 			responses[b.ID] = resp
@@ -327,6 +386,16 @@ func (h *CompositeHandler) executeMerge(ctx context.Context, w http.ResponseWrit
 	// Wait for all requests to complete.
 	wg.Wait()
 
+	if failFast && failure != nil {
+		status := http.StatusBadGateway
+		if errors.Is(failure, context.DeadlineExceeded) {
+			status = http.StatusGatewayTimeout
+		}
+		w.WriteHeader(status)
+		_, _ = fmt.Fprintf(w, "Backend %s failed: %v", failedBackend, failure)
+		return
+	}
+
 	// If custom transformer is set, use it
 	if h.responseTransformer != nil {
 		transformedResp, err := h.responseTransformer(responses)
@@ -341,13 +410,6 @@ func (h *CompositeHandler) executeMerge(ctx context.Context, w http.ResponseWrit
 		// Default merge behavior: merge JSON responses
 		h.mergeJSONResponses(responses, w)
 	}
-
-	// Close all response bodies to prevent resource leaks
-	for _, resp := range responses {
-		if resp != nil && resp.Body != nil {
-			resp.Body.Close()
-		}
-	}
 }
 
 // executeSequential executes backend requests one at a time, returning the last successful response.
@@ -495,7 +557,10 @@ func (m *ReverseProxyModule) createCompositeHandler(ctx context.Context, routeCo
 	var backends []*Backend
 
 	// Default response timeout if not specified in config
-	responseTimeout := 30 * time.Second
+	responseTimeout := defaultCompositeTimeout
+	if routeConfig.Timeout > 0 {
+		responseTimeout = routeConfig.Timeout
+	}
 
 	for _, backendName := range routeConfig.Backends {
 		var backendURL string
@@ -543,6 +608,16 @@ func (m *ReverseProxyModule) createCompositeHandler(ctx context.Context, routeCo
 		}
 	}
 
+	// Set partial result policy from config if specified
+	switch PartialResultPolicy(routeConfig.PartialResults) {
+	case "", PartialResultsBestEffort:
+	case PartialResultsFailFast:
+		handler.SetPartialResultPolicy(PartialResultsFailFast)
+	default:
+		return nil, fmt.Errorf("route %q partial_results %q: %w",
+			routeConfig.Pattern, routeConfig.PartialResults, ErrInvalidPartialResultPolicy)
+	}
+
 	// Set event emitter for circuit breaker events
 	handler.SetEventEmitter(func(eventType string, data map[string]interface{}) {
 		m.emitEvent(ctx, eventType, data)
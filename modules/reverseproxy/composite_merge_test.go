@@ -0,0 +1,180 @@
+package reverseproxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newJSONBackend starts a backend that responds with body after delay
+func newJSONBackend(t *testing.T, status int, body string, delay time.Duration) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newMergeHandler creates a merge handler for the given backend servers keyed by ID
+func newMergeHandler(servers map[string]*httptest.Server, timeout time.Duration, policy PartialResultPolicy) *CompositeHandler {
+	var backends []*Backend
+	for _, id := range []string{"users", "orders", "billing"} {
+		if server, ok := servers[id]; ok {
+			backends = append(backends, &Backend{ID: id, URL: server.URL, Client: http.DefaultClient})
+		}
+	}
+	handler := NewCompositeHandler(backends, StrategyMerge, timeout)
+	handler.SetPartialResultPolicy(policy)
+	return handler
+}
+
+func TestCompositeMerge_MergesResponsesByBackend(t *testing.T) {
+	handler := newMergeHandler(map[string]*httptest.Server{
+		"users":  newJSONBackend(t, http.StatusOK, `{"name":"alice"}`, 0),
+		"orders": newJSONBackend(t, http.StatusOK, `[{"id":1}]`, 0),
+	}, time.Second, PartialResultsBestEffort)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/profile", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"users":{"name":"alice"},"orders":[{"id":1}]}`, recorder.Body.String())
+}
+
+func TestCompositeMerge_BestEffortOmitsTimedOutBackend(t *testing.T) {
+	handler := newMergeHandler(map[string]*httptest.Server{
+		"users":   newJSONBackend(t, http.StatusOK, `{"name":"alice"}`, 0),
+		"orders":  newJSONBackend(t, http.StatusOK, `[{"id":1}]`, 50*time.Millisecond),
+		"billing": newJSONBackend(t, http.StatusOK, `{"balance":10}`, 5*time.Second),
+	}, 300*time.Millisecond, PartialResultsBestEffort)
+
+	start := time.Now()
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/profile", nil))
+
+	assert.Less(t, time.Since(start), 2*time.Second, "the route timeout bounds the request")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"users":{"name":"alice"},"orders":[{"id":1}]}`, recorder.Body.String())
+}
+
+func TestCompositeMerge_FailFast(t *testing.T) {
+	t.Run("backend error", func(t *testing.T) {
+		var slowCanceled atomic.Bool
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-time.After(5 * time.Second):
+			case <-r.Context().Done():
+				slowCanceled.Store(true)
+			}
+		}))
+		defer slow.Close()
+
+		handler := newMergeHandler(map[string]*httptest.Server{
+			"users":   newJSONBackend(t, http.StatusOK, `{"name":"alice"}`, 0),
+			"orders":  newJSONBackend(t, http.StatusInternalServerError, `{"error":"boom"}`, 0),
+			"billing": slow,
+		}, 5*time.Second, PartialResultsFailFast)
+
+		start := time.Now()
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/profile", nil))
+
+		assert.Less(t, time.Since(start), 2*time.Second, "remaining backends are canceled on the first failure")
+		assert.Equal(t, http.StatusBadGateway, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "orders")
+		assert.Eventually(t, slowCanceled.Load, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("backend timeout", func(t *testing.T) {
+		handler := newMergeHandler(map[string]*httptest.Server{
+			"users":  newJSONBackend(t, http.StatusOK, `{"name":"alice"}`, 0),
+			"orders": newJSONBackend(t, http.StatusOK, `[]`, 5*time.Second),
+		}, 100*time.Millisecond, PartialResultsFailFast)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/profile", nil))
+
+		assert.Equal(t, http.StatusGatewayTimeout, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "orders")
+	})
+}
+
+func TestCompositeMerge_RespectsOpenCircuit(t *testing.T) {
+	var ordersCalls atomic.Int32
+	orders := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ordersCalls.Add(1)
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer orders.Close()
+
+	servers := map[string]*httptest.Server{
+		"users":  newJSONBackend(t, http.StatusOK, `{"name":"alice"}`, 0),
+		"orders": orders,
+	}
+	cbConfig := map[string]CircuitBreakerConfig{
+		"orders": {Enabled: true, FailureThreshold: 1, OpenTimeout: time.Minute},
+	}
+
+	t.Run("best-effort skips the backend", func(t *testing.T) {
+		handler := newMergeHandler(servers, time.Second, PartialResultsBestEffort)
+		handler.ConfigureCircuitBreakers(CircuitBreakerConfig{}, cbConfig)
+		handler.circuitBreakers["orders"].RecordFailure()
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/profile", nil))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `{"users":{"name":"alice"}}`, recorder.Body.String())
+	})
+
+	t.Run("fail-fast fails the request", func(t *testing.T) {
+		handler := newMergeHandler(servers, time.Second, PartialResultsFailFast)
+		handler.ConfigureCircuitBreakers(CircuitBreakerConfig{}, cbConfig)
+		handler.circuitBreakers["orders"].RecordFailure()
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/profile", nil))
+
+		assert.Equal(t, http.StatusBadGateway, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), ErrCircuitOpen.Error())
+	})
+
+	assert.Zero(t, ordersCalls.Load(), "backends with an open circuit are not called")
+}
+
+func TestCreateCompositeHandler_PartialResultsValidation(t *testing.T) {
+	module := NewModule()
+	module.config = &ReverseProxyConfig{
+		BackendServices: map[string]string{"users": "http://users", "orders": "http://orders"},
+	}
+
+	route := CompositeRoute{
+		Pattern:        "/api/profile",
+		Backends:       []string{"users", "orders"},
+		Strategy:       string(StrategyMerge),
+		PartialResults: "fail-fast",
+		Timeout:        250 * time.Millisecond,
+	}
+	handler, err := module.createCompositeHandler(context.Background(), route, nil)
+	require.NoError(t, err)
+	assert.Equal(t, PartialResultsFailFast, handler.partialResultPolicy)
+	assert.Equal(t, 250*time.Millisecond, handler.responseTimeout)
+
+	route.PartialResults = "sometimes"
+	_, err = module.createCompositeHandler(context.Background(), route, nil)
+	assert.ErrorIs(t, err, ErrInvalidPartialResultPolicy)
+}
@@ -13,6 +13,7 @@ type ReverseProxyConfig struct {
 	BackendCircuitBreakers map[string]CircuitBreakerConfig `json:"backend_circuit_breakers" yaml:"backend_circuit_breakers" toml:"backend_circuit_breakers"`
 	CompositeRoutes        map[string]CompositeRoute       `json:"composite_routes" yaml:"composite_routes" toml:"composite_routes"`
 	TenantIDHeader         string                          `json:"tenant_id_header" yaml:"tenant_id_header" toml:"tenant_id_header" env:"TENANT_ID_HEADER" default:"X-Tenant-ID"`
+	RequestIDHeader        string                          `json:"request_id_header" yaml:"request_id_header" toml:"request_id_header" env:"REQUEST_ID_HEADER" default:"X-Request-ID"`
 	RequireTenantID        bool                            `json:"require_tenant_id" yaml:"require_tenant_id" toml:"require_tenant_id" env:"REQUIRE_TENANT_ID"`
 	CacheEnabled           bool                            `json:"cache_enabled" yaml:"cache_enabled" toml:"cache_enabled" env:"CACHE_ENABLED"`
 	CacheTTL               time.Duration                   `json:"cache_ttl" yaml:"cache_ttl" toml:"cache_ttl" env:"CACHE_TTL"`
@@ -75,9 +76,12 @@ type RouteConfig struct {
 	// CompositeBackends defines multiple backends for composite responses
 	CompositeBackends []string `json:"composite_backends" yaml:"composite_backends" toml:"composite_backends" env:"COMPOSITE_BACKENDS"`
 
-	// PathRewrite defines path rewriting for this route
+	// PathRewrite is shorthand for PathRewriting.BasePathRewrite
 	PathRewrite string `json:"path_rewrite" yaml:"path_rewrite" toml:"path_rewrite" env:"PATH_REWRITE"`
 
+	// PathRewriting defines path rewriting rules for this route, applied before any backend rules
+	PathRewriting PathRewritingConfig `json:"path_rewriting" yaml:"path_rewriting" toml:"path_rewriting"`
+
 	// Timeout defines a custom timeout for this route
 	Timeout time.Duration `json:"timeout" yaml:"timeout" toml:"timeout" env:"TIMEOUT"`
 
@@ -88,6 +92,27 @@ type RouteConfig struct {
 	// DryRunBackend specifies the backend to compare against in dry-run mode
 	// If not specified, uses the AlternativeBackend for comparison
 	DryRunBackend string `json:"dry_run_backend" yaml:"dry_run_backend" toml:"dry_run_backend" env:"DRY_RUN_BACKEND"`
+
+	// Mirror copies a sample of this route's traffic to another backend without affecting the response
+	Mirror MirrorConfig `json:"mirror" yaml:"mirror" toml:"mirror"`
+}
+
+// MirrorConfig defines fire-and-forget traffic mirroring for a route, e.g. to
+// load test a new backend with live traffic. Mirrored responses are discarded
+// and failures are only logged.
+type MirrorConfig struct {
+	// Backend is the backend ID that receives mirrored requests; mirroring is disabled if empty
+	Backend string `json:"backend" yaml:"backend" toml:"backend" env:"BACKEND"`
+
+	// SampleRate is the fraction of requests to mirror, from 0 to 1
+	SampleRate float64 `json:"sample_rate" yaml:"sample_rate" toml:"sample_rate" env:"SAMPLE_RATE"`
+
+	// IncludeBody sends the request body with mirrored requests; it is buffered in memory
+	IncludeBody bool `json:"include_body" yaml:"include_body" toml:"include_body" env:"INCLUDE_BODY"`
+
+	// MaxBodySize is the largest body, in bytes, buffered for mirroring. Requests
+	// with larger bodies are not mirrored. Default: DefaultMirrorMaxBodySize
+	MaxBodySize int64 `json:"max_body_size" yaml:"max_body_size" toml:"max_body_size" env:"MAX_BODY_SIZE"`
 }
 
 // CompositeRoute defines a route that combines responses from multiple backends.
@@ -101,6 +126,13 @@ type CompositeRoute struct {
 	// This is used by pipeline and fan-out-merge strategies.
 	EmptyPolicy string `json:"empty_policy" yaml:"empty_policy" toml:"empty_policy" env:"EMPTY_POLICY"`
 
+	// Timeout bounds the backend requests of this composite route (default 30s).
+	Timeout time.Duration `json:"timeout" yaml:"timeout" toml:"timeout" env:"TIMEOUT"`
+
+	// PartialResults defines how the merge strategy handles failed backends.
+	// Valid values: "best-effort" (default), "fail-fast".
+	PartialResults string `json:"partial_results" yaml:"partial_results" toml:"partial_results" env:"PARTIAL_RESULTS"`
+
 	// FeatureFlagID is the ID of the feature flag that controls whether this composite route is enabled
 	// If specified and the feature flag evaluates to false, this route will return 404
 	FeatureFlagID string `json:"feature_flag_id" yaml:"feature_flag_id" toml:"feature_flag_id" env:"FEATURE_FLAG_ID"`
@@ -120,6 +152,22 @@ type PathRewritingConfig struct {
 
 	// EndpointRewrites defines per-endpoint path rewriting rules
 	EndpointRewrites map[string]EndpointRewriteRule `json:"endpoint_rewrites" yaml:"endpoint_rewrites" toml:"endpoint_rewrites"`
+
+	// RegexRewrites are regular expression replacements applied in order after the other rules
+	RegexRewrites []RegexRewriteRule `json:"regex_rewrites" yaml:"regex_rewrites" toml:"regex_rewrites"`
+
+	// TrailingSlash controls the trailing slash of the rewritten path:
+	// "preserve" (default), "strip" or "add"
+	TrailingSlash string `json:"trailing_slash" yaml:"trailing_slash" toml:"trailing_slash" env:"TRAILING_SLASH"`
+}
+
+// RegexRewriteRule replaces matches of a regular expression in the request path.
+type RegexRewriteRule struct {
+	// Pattern is the regular expression to match (e.g., "^/v1/users/([0-9]+)$")
+	Pattern string `json:"pattern" yaml:"pattern" toml:"pattern" env:"PATTERN"`
+
+	// Replacement is the replacement text, which may reference capture groups (e.g., "/users/$1")
+	Replacement string `json:"replacement" yaml:"replacement" toml:"replacement" env:"REPLACEMENT"`
 }
 
 // EndpointRewriteRule defines a rewrite rule for a specific endpoint pattern.
@@ -154,6 +202,10 @@ type BackendServiceConfig struct {
 	// ResponseHeaderRewriting defines response header rewriting rules specific to this backend
 	ResponseHeaderRewriting ResponseHeaderRewritingConfig `json:"response_header_rewriting" yaml:"response_header_rewriting" toml:"response_header_rewriting"`
 
+	// ResponseHeaderRewrites defines regex-based rewrite rules applied to response header values
+	// returned by this backend (e.g. rewriting internal hostnames in Location or Set-Cookie headers)
+	ResponseHeaderRewrites []HeaderRewrite `json:"response_header_rewrites" yaml:"response_header_rewrites" toml:"response_header_rewrites"`
+
 	// Hostname handling mode for this backend
 	HostnameHandling string `json:"hostname_handling" yaml:"hostname_handling" toml:"hostname_handling" env:"HOSTNAME_HANDLING"`
 
@@ -245,6 +297,21 @@ type ResponseHeaderRewritingConfig struct {
 	RemoveHeaders []string `json:"remove_headers" yaml:"remove_headers" toml:"remove_headers"`
 }
 
+// HeaderRewrite defines a regex-based rewrite rule for the values of a single header.
+// Every value of the named header is matched against Pattern and all matches are
+// replaced with Replacement, which may reference capture groups (e.g. "$1").
+// Values that do not match Pattern are left untouched.
+type HeaderRewrite struct {
+	// Header is the name of the header to rewrite (e.g. "Location", "Set-Cookie")
+	Header string `json:"header" yaml:"header" toml:"header"`
+
+	// Pattern is the regular expression matched against each header value
+	Pattern string `json:"pattern" yaml:"pattern" toml:"pattern"`
+
+	// Replacement is the replacement string applied to each match of Pattern
+	Replacement string `json:"replacement" yaml:"replacement" toml:"replacement"`
+}
+
 // HostnameHandlingMode defines how the Host header should be handled when forwarding requests.
 type HostnameHandlingMode string
 
@@ -280,6 +347,20 @@ type HealthCheckConfig struct {
 	HealthEndpoints          map[string]string              `json:"health_endpoints" yaml:"health_endpoints" toml:"health_endpoints" env:"HEALTH_ENDPOINTS" desc:"Custom health check endpoints for specific backends (defaults to base URL)"`
 	ExpectedStatusCodes      []int                          `json:"expected_status_codes" yaml:"expected_status_codes" toml:"expected_status_codes" env:"EXPECTED_STATUS_CODES" default:"[200]" desc:"HTTP status codes considered healthy"`
 	BackendHealthCheckConfig map[string]BackendHealthConfig `json:"backend_health_check_config" yaml:"backend_health_check_config" toml:"backend_health_check_config" desc:"Per-backend health check configuration"`
+	Jitter                   float64                        `json:"jitter" yaml:"jitter" toml:"jitter" env:"JITTER" default:"0" desc:"Fraction of each interval randomly added or removed so replicas do not poll in lockstep (e.g. 0.1 for +/-10%)"`
+	BackoffMultiplier        float64                        `json:"backoff_multiplier" yaml:"backoff_multiplier" toml:"backoff_multiplier" env:"BACKOFF_MULTIPLIER" default:"2" desc:"Factor the interval grows by for each consecutive failed check"`
+	MaxBackoffInterval       time.Duration                  `json:"max_backoff_interval" yaml:"max_backoff_interval" toml:"max_backoff_interval" env:"MAX_BACKOFF_INTERVAL" desc:"Cap on the interval of failing backends; backoff is disabled when not above the interval"`
+	ExpectedBodyRegex        string                         `json:"expected_body_regex" yaml:"expected_body_regex" toml:"expected_body_regex" env:"EXPECTED_BODY_REGEX" desc:"Regular expression the health check response body must match to be considered healthy"`
+	Passive                  PassiveHealthCheckConfig       `json:"passive" yaml:"passive" toml:"passive" desc:"Passive health checking from live request outcomes"`
+}
+
+// PassiveHealthCheckConfig provides configuration for passive health checking, which marks a backend
+// unhealthy when the error rate of its live requests over a sliding window exceeds a threshold.
+type PassiveHealthCheckConfig struct {
+	Enabled            bool          `json:"enabled" yaml:"enabled" toml:"enabled" env:"ENABLED" default:"false" desc:"Track live request outcomes to detect failing backends between active checks"`
+	Window             time.Duration `json:"window" yaml:"window" toml:"window" env:"WINDOW" default:"30s" desc:"Sliding window the error rate is computed over"`
+	ErrorRateThreshold float64       `json:"error_rate_threshold" yaml:"error_rate_threshold" toml:"error_rate_threshold" env:"ERROR_RATE_THRESHOLD" default:"0.5" desc:"Error rate above which the backend is marked unhealthy"`
+	MinRequests        int           `json:"min_requests" yaml:"min_requests" toml:"min_requests" env:"MIN_REQUESTS" default:"10" desc:"Requests required in the window before the error rate is evaluated"`
 }
 
 // BackendHealthConfig provides per-backend health check configuration.
@@ -289,6 +370,7 @@ type BackendHealthConfig struct {
 	Interval            time.Duration `json:"interval" yaml:"interval" toml:"interval" env:"INTERVAL" desc:"Override global interval for this backend"`
 	Timeout             time.Duration `json:"timeout" yaml:"timeout" toml:"timeout" env:"TIMEOUT" desc:"Override global timeout for this backend"`
 	ExpectedStatusCodes []int         `json:"expected_status_codes" yaml:"expected_status_codes" toml:"expected_status_codes" env:"EXPECTED_STATUS_CODES" desc:"Override global expected status codes for this backend"`
+	ExpectedBodyRegex   string        `json:"expected_body_regex" yaml:"expected_body_regex" toml:"expected_body_regex" env:"EXPECTED_BODY_REGEX" desc:"Override global expected body regular expression for this backend"`
 }
 
 // FeatureFlagsConfig provides configuration for the built-in feature flag evaluator.
@@ -297,7 +379,16 @@ type FeatureFlagsConfig struct {
 	Enabled bool `json:"enabled" yaml:"enabled" toml:"enabled" env:"ENABLED" default:"false" desc:"Enable the built-in file-based feature flag evaluator service"`
 
 	// Flags defines default values for feature flags. Tenant-specific overrides come from tenant config files.
-	Flags map[string]bool `json:"flags" yaml:"flags" toml:"flags" desc:"Default values for feature flags"`
+	// Flags, Rules and AttributeHeaders are dynamic: in applications built with modular.WithDynamicReload,
+	// edits to the watched config files take effect without a restart.
+	Flags map[string]bool `json:"flags" yaml:"flags" toml:"flags" dynamic:"true" desc:"Default values for feature flags"`
+
+	// Rules defines targeted and percentage rollout flags. Flags wins if both define a flag.
+	Rules map[string]FeatureFlagRule `json:"rules" yaml:"rules" toml:"rules" dynamic:"true" desc:"Targeted and percentage rollout flags by flag name"`
+
+	// AttributeHeaders maps the attributes matched by Rules to the request headers they are
+	// read from, e.g. userId: X-User-ID. The tenant ID is always available as tenantId.
+	AttributeHeaders map[string]string `json:"attribute_headers" yaml:"attribute_headers" toml:"attribute_headers" dynamic:"true" desc:"Request headers providing feature flag attributes, by attribute name"`
 }
 
 // MetricsConfig provides configuration for metrics collection.
@@ -459,7 +459,7 @@ func testEndToEndDryRunWithRequestBody(t *testing.T) {
 		t.Errorf("Response should contain primary backend data, got: %s", responseBody)
 	}
 
-	// Verify both backends received requests (primary for immediate response, both for dry-run)
+	// Verify both backends received requests (primary for the immediate response, secondary for dry-run)
 	mu.Lock()
 	primaryCount := primaryRequestCount
 	secondaryCount := secondaryRequestCount
@@ -467,9 +467,9 @@ func testEndToEndDryRunWithRequestBody(t *testing.T) {
 	secondaryBody := secondaryBodyReceived
 	mu.Unlock()
 
-	// Primary should receive 2 requests: one for immediate response, one for dry-run comparison
-	if primaryCount != 2 {
-		t.Errorf("Expected primary to receive 2 requests, got %d", primaryCount)
+	// Primary should receive 1 request: its response is both returned and compared
+	if primaryCount != 1 {
+		t.Errorf("Expected primary to receive 1 request, got %d", primaryCount)
 	}
 
 	// Secondary should receive 1 request: one for dry-run comparison
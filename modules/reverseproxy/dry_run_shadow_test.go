@@ -0,0 +1,225 @@
+package reverseproxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDryRunTestModule starts a module routing /api/users to the primary
+// backend with dry run against the shadow backend, returning the route
+// handler and an observer of the module's events
+func newDryRunTestModule(t *testing.T, primaryURL, shadowURL string, dryRun DryRunConfig) (http.HandlerFunc, *testEventObserver) {
+	t.Helper()
+	return startRouteTestModule(t, "/api/users", &ReverseProxyConfig{
+		BackendServices: map[string]string{
+			"primary": primaryURL,
+			"shadow":  shadowURL,
+		},
+		Routes: map[string]string{
+			"/api/users": "primary",
+		},
+		RouteConfigs: map[string]RouteConfig{
+			"/api/users": {
+				DryRun:        true,
+				DryRunBackend: "shadow",
+			},
+		},
+		DefaultBackend: "primary",
+		DryRun:         dryRun,
+	})
+}
+
+// startRouteTestModule starts an observable application with the module
+// configured by config, returning the handler registered for route and an
+// observer of the module's events
+func startRouteTestModule(t *testing.T, route string, config *ReverseProxyConfig) (http.HandlerFunc, *testEventObserver) {
+	t.Helper()
+
+	var app modular.Application = modular.NewObservableApplication(modular.NewStdConfigProvider(struct{}{}), &testLogger{})
+	if cfSetter, ok := app.(interface{ SetConfigFeeders([]modular.Feeder) }); ok {
+		cfSetter.SetConfigFeeders([]modular.Feeder{})
+	}
+	router := &testRouter{routes: make(map[string]http.HandlerFunc)}
+	require.NoError(t, app.RegisterService("router", router))
+
+	observer := newTestEventObserver()
+	require.NoError(t, app.(modular.Subject).RegisterObserver(observer))
+
+	app.RegisterModule(NewModule())
+	app.RegisterConfigSection("reverseproxy", modular.NewStdConfigProvider(config))
+
+	require.NoError(t, app.Init())
+	require.NoError(t, app.Start())
+	t.Cleanup(func() { _ = app.Stop() })
+
+	router.mu.RLock()
+	handler := router.routes[route]
+	router.mu.RUnlock()
+	require.NotNil(t, handler, "route %s should be registered", route)
+	return handler, observer
+}
+
+// waitForComparisonEvent waits for a dry-run comparison event
+func waitForComparisonEvent(t *testing.T, observer *testEventObserver, timeout time.Duration) *cloudevents.Event {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		for _, event := range observer.GetEvents() {
+			if event.Type() == EventTypeDryRunComparison {
+				return &event
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return nil
+}
+
+func TestDryRunShadow_EmitsDiffWhenResponsesDiverge(t *testing.T) {
+	var primaryCalls, shadowCalls atomic.Int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryCalls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Api-Version", "1")
+		_, _ = w.Write([]byte(`{"id":1,"name":"alice","roles":["admin"],"active":true}`))
+	}))
+	defer primary.Close()
+
+	shadow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		shadowCalls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Api-Version", "2")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"active":true,"id":1,"name":"Alice","roles":["admin","user"]}`))
+	}))
+	defer shadow.Close()
+
+	handler, observer := newDryRunTestModule(t, primary.URL, shadow.URL, DryRunConfig{
+		Enabled:         true,
+		MaxResponseSize: 1024,
+		CompareHeaders:  []string{"Content-Type", "X-Api-Version"},
+	})
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/api/users", nil))
+
+	// The client gets the primary response
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"id":1,"name":"alice","roles":["admin"],"active":true}`, recorder.Body.String())
+
+	event := waitForComparisonEvent(t, observer, 2*time.Second)
+	require.NotNil(t, event, "expected a dry-run comparison event for divergent responses")
+	assert.Equal(t, int32(1), primaryCalls.Load(), "the primary backend is called once")
+	assert.Equal(t, int32(1), shadowCalls.Load())
+
+	var data struct {
+		StatusCodeMatch bool `json:"statusCodeMatch"`
+		BodyMatch       bool `json:"bodyMatch"`
+		HeadersMatch    bool `json:"headersMatch"`
+		Diff            struct {
+			Status  map[string]int        `json:"status"`
+			Headers map[string]HeaderDiff `json:"headers"`
+			Body    []BodyDiff            `json:"body"`
+		} `json:"diff"`
+	}
+	require.NoError(t, event.DataAs(&data))
+	assert.False(t, data.StatusCodeMatch)
+	assert.False(t, data.BodyMatch)
+	assert.False(t, data.HeadersMatch)
+	assert.Equal(t, map[string]int{"primary": 200, "secondary": 201}, data.Diff.Status)
+	assert.Equal(t, map[string]HeaderDiff{"X-Api-Version": {Primary: "1", Secondary: "2"}}, data.Diff.Headers)
+	assert.Equal(t, []BodyDiff{
+		{Path: "/name", Primary: "alice", Secondary: "Alice"},
+		{Path: "/roles/1", Primary: missingValue, Secondary: "user"},
+	}, data.Diff.Body)
+}
+
+func TestDryRunShadow_NoEventWhenResponsesMatch(t *testing.T) {
+	var shadowCalls atomic.Int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"name":"alice"}`))
+	}))
+	defer primary.Close()
+
+	shadow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer shadowCalls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		// Same document, different key order and formatting
+		_, _ = w.Write([]byte(`{ "name": "alice", "id": 1 }`))
+	}))
+	defer shadow.Close()
+
+	handler, observer := newDryRunTestModule(t, primary.URL, shadow.URL, DryRunConfig{
+		Enabled:         true,
+		MaxResponseSize: 1024,
+	})
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/api/users", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	require.Eventually(t, func() bool { return shadowCalls.Load() == 1 }, 2*time.Second, 10*time.Millisecond)
+	assert.Nil(t, waitForComparisonEvent(t, observer, 200*time.Millisecond), "matching responses should not emit a comparison event")
+}
+
+func TestDryRunShadow_SlowShadowDoesNotBlockClient(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer primary.Close()
+
+	release := make(chan struct{})
+	shadow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer shadow.Close()
+	defer close(release)
+
+	handler, observer := newDryRunTestModule(t, primary.URL, shadow.URL, DryRunConfig{
+		Enabled:         true,
+		MaxResponseSize: 1024,
+		Timeout:         100 * time.Millisecond,
+	})
+
+	start := time.Now()
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/api/users", nil))
+	assert.Less(t, time.Since(start), 100*time.Millisecond, "the client response should not wait for the shadow backend")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	// The shadow call times out on its own and is reported as a divergence
+	event := waitForComparisonEvent(t, observer, 2*time.Second)
+	require.NotNil(t, event, "expected a comparison event for the timed out shadow call")
+	var data map[string]interface{}
+	require.NoError(t, event.DataAs(&data))
+	assert.NotEmpty(t, data["secondaryError"])
+	assert.Empty(t, data["primaryError"])
+}
+
+func TestDiffJSONBodies(t *testing.T) {
+	diffs := diffJSONBodies(
+		[]byte(`{"a":{"b":1,"c/d":[1,2]},"e":null,"f":"x"}`),
+		[]byte(`{"a":{"b":1.0,"c/d":[1]},"f":{"g":true}}`),
+	)
+	assert.Equal(t, []BodyDiff{
+		{Path: "/a/c~1d/1", Primary: json.Number("2"), Secondary: missingValue},
+		{Path: "/e", Primary: nil, Secondary: missingValue},
+		{Path: "/f", Primary: "x", Secondary: map[string]any{"g": true}},
+	}, diffs)
+
+	assert.Empty(t, diffJSONBodies([]byte(`{"a":1,"b":2}`), []byte(`{"b":2,"a":1}`)))
+	assert.Nil(t, diffJSONBodies([]byte(`plain text`), []byte(`{"a":1}`)), "non-JSON bodies are not diffed")
+	assert.Nil(t, diffJSONBodies([]byte(`{"a":1} trailing`), []byte(`{"a":1}`)))
+}
@@ -3,9 +3,15 @@ package reverseproxy
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/GoCodeAlone/modular"
@@ -30,8 +36,20 @@ type DryRunConfig struct {
 
 	// DefaultResponseBackend specifies which backend response to return by default ("primary" or "secondary")
 	DefaultResponseBackend string `json:"default_response_backend" yaml:"default_response_backend" toml:"default_response_backend" env:"DRY_RUN_DEFAULT_RESPONSE_BACKEND" default:"primary"`
+
+	// Timeout bounds each dry-run backend call, independently of the client request
+	Timeout time.Duration `json:"timeout" yaml:"timeout" toml:"timeout" env:"DRY_RUN_TIMEOUT" default:"30s"`
 }
 
+// defaultDryRunTimeout is used when DryRunConfig.Timeout is not set.
+const defaultDryRunTimeout = 30 * time.Second
+
+// maxBodyDiffs limits the number of body differences recorded per comparison.
+const maxBodyDiffs = 50
+
+// missingValue marks a header or JSON field present in only one response.
+const missingValue = "<missing>"
+
 // DryRunResult represents the result of a dry-run comparison.
 type DryRunResult struct {
 	Timestamp         time.Time        `json:"timestamp"`
@@ -56,6 +74,10 @@ type ResponseInfo struct {
 	BodySize     int64             `json:"bodySize"`
 	ResponseTime time.Duration     `json:"responseTime"`
 	Error        string            `json:"error,omitempty"`
+
+	// body is the response body used for comparison, kept even when
+	// LogResponses is disabled
+	body []byte
 }
 
 // ComparisonResult contains the results of comparing two responses.
@@ -65,6 +87,13 @@ type ComparisonResult struct {
 	BodyMatch       bool                  `json:"bodyMatch"`
 	Differences     []string              `json:"differences,omitempty"`
 	HeaderDiffs     map[string]HeaderDiff `json:"headerDiffs,omitempty"`
+	BodyDiffs       []BodyDiff            `json:"bodyDiffs,omitempty"`
+}
+
+// Matches reports whether the comparison found no differences.
+func (c ComparisonResult) Matches() bool {
+	return c.StatusCodeMatch && c.HeadersMatch && c.BodyMatch &&
+		len(c.Differences) == 0 && len(c.HeaderDiffs) == 0
 }
 
 // HeaderDiff represents a difference in header values.
@@ -73,6 +102,14 @@ type HeaderDiff struct {
 	Secondary string `json:"secondary"`
 }
 
+// BodyDiff represents a difference between two JSON response bodies. Path is
+// a JSON Pointer (RFC 6901) to the differing value, empty for the whole body.
+type BodyDiff struct {
+	Path      string `json:"path"`
+	Primary   any    `json:"primary"`
+	Secondary any    `json:"secondary"`
+}
+
 // DurationInfo contains timing information for the dry-run.
 type DurationInfo struct {
 	Total     time.Duration `json:"total"`
@@ -93,11 +130,14 @@ func NewDryRunHandler(config DryRunConfig, tenantIDHeader string, logger modular
 	if tenantIDHeader == "" {
 		tenantIDHeader = "X-Tenant-ID" // Default fallback
 	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultDryRunTimeout
+	}
 	return &DryRunHandler{
 		config:         config,
 		tenantIDHeader: tenantIDHeader,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: config.Timeout,
 		},
 		logger: logger,
 	}
@@ -110,73 +150,93 @@ func (d *DryRunHandler) ProcessDryRun(ctx context.Context, req *http.Request, pr
 	}
 
 	startTime := time.Now()
+	result := d.newResult(startTime, req, primaryBackend, secondaryBackend)
 
-	// Create dry-run result
-	result := &DryRunResult{
-		Timestamp:        startTime,
-		RequestID:        req.Header.Get("X-Request-ID"),
-		TenantID:         req.Header.Get(d.tenantIDHeader),
-		Endpoint:         req.URL.Path,
-		Method:           req.Method,
-		PrimaryBackend:   primaryBackend,
-		SecondaryBackend: secondaryBackend,
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
 	}
 
-	// Read and store request body for replication
-	var requestBody []byte
-	if req.Body != nil {
-		var err error
-		requestBody, err = io.ReadAll(req.Body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read request body: %w", err)
-		}
-		req.Body.Close()
+	// Send requests to both backends concurrently
+	primaryChan := d.sendAsync(ctx, req, primaryBackend, requestBody, "primary")
+	secondaryChan := d.sendAsync(ctx, req, secondaryBackend, requestBody, "secondary")
+
+	// Collect responses, respecting context cancellation.
+	result.PrimaryResponse = awaitResponse(ctx, primaryChan)
+	result.SecondaryResponse = awaitResponse(ctx, secondaryChan)
+
+	d.completeResult(result, startTime)
+	return result, nil
+}
+
+// ProcessShadow completes a dry run for a request whose response has already
+// been returned to the client. Only the backend that did not serve returned
+// is called; the call is bounded by the configured Timeout, so callers
+// running it after the client response should pass a context that is not
+// canceled with the request.
+func (d *DryRunHandler) ProcessShadow(ctx context.Context, req *http.Request, primaryBackend, secondaryBackend string, returned ResponseInfo) (*DryRunResult, error) {
+	if !d.config.Enabled {
+		return nil, ErrDryRunModeNotEnabled
 	}
 
-	// Send requests to both backends concurrently
-	primaryChan := make(chan ResponseInfo, 1)
-	secondaryChan := make(chan ResponseInfo, 1)
+	startTime := time.Now()
+	result := d.newResult(startTime, req, primaryBackend, secondaryBackend)
 
-	// Send request to primary backend
-	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				d.logger.Error("panic recovered in dry-run primary request", "error", r)
-				primaryChan <- ResponseInfo{Error: fmt.Sprintf("panic: %v", r)}
-			}
-		}()
-		primaryStart := time.Now()
-		response := d.sendRequest(ctx, req, primaryBackend, requestBody)
-		response.ResponseTime = time.Since(primaryStart)
-		primaryChan <- response
-	}()
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
 
-	// Send request to secondary backend
-	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				d.logger.Error("panic recovered in dry-run secondary request", "error", r)
-				secondaryChan <- ResponseInfo{Error: fmt.Sprintf("panic: %v", r)}
-			}
-		}()
-		secondaryStart := time.Now()
-		response := d.sendRequest(ctx, req, secondaryBackend, requestBody)
-		response.ResponseTime = time.Since(secondaryStart)
-		secondaryChan <- response
-	}()
+	ctx, cancel := context.WithTimeout(ctx, d.config.Timeout)
+	defer cancel()
 
-	// Collect responses, respecting context cancellation.
-	select {
-	case result.PrimaryResponse = <-primaryChan:
-	case <-ctx.Done():
-		result.PrimaryResponse = ResponseInfo{Error: ctx.Err().Error()}
+	if d.returnsSecondary() {
+		result.SecondaryResponse = returned
+		result.PrimaryResponse = awaitResponse(ctx, d.sendAsync(ctx, req, primaryBackend, requestBody, "primary"))
+	} else {
+		result.PrimaryResponse = returned
+		result.SecondaryResponse = awaitResponse(ctx, d.sendAsync(ctx, req, secondaryBackend, requestBody, "secondary"))
 	}
-	select {
-	case result.SecondaryResponse = <-secondaryChan:
-	case <-ctx.Done():
-		result.SecondaryResponse = ResponseInfo{Error: ctx.Err().Error()}
+
+	d.completeResult(result, startTime)
+	return result, nil
+}
+
+// NewResponseInfo builds the ResponseInfo for a response captured outside the
+// handler, such as the one returned to the client, applying the configured
+// MaxResponseSize and LogResponses settings.
+func (d *DryRunHandler) NewResponseInfo(statusCode int, header http.Header, body []byte, responseTime time.Duration) ResponseInfo {
+	if d.config.MaxResponseSize > 0 && int64(len(body)) > d.config.MaxResponseSize {
+		body = body[:d.config.MaxResponseSize]
+	}
+	response := ResponseInfo{
+		StatusCode:   statusCode,
+		Headers:      firstHeaderValues(header),
+		BodySize:     int64(len(body)),
+		ResponseTime: responseTime,
+		body:         body,
 	}
+	if d.config.LogResponses {
+		response.Body = string(body)
+	}
+	return response
+}
 
+// newResult creates a dry-run result describing req
+func (d *DryRunHandler) newResult(startTime time.Time, req *http.Request, primaryBackend, secondaryBackend string) *DryRunResult {
+	return &DryRunResult{
+		Timestamp:        startTime,
+		RequestID:        req.Header.Get("X-Request-ID"),
+		TenantID:         req.Header.Get(d.tenantIDHeader),
+		Endpoint:         req.URL.Path,
+		Method:           req.Method,
+		PrimaryBackend:   primaryBackend,
+		SecondaryBackend: secondaryBackend,
+	}
+}
+
+// completeResult records timing, compares the responses and logs the result
+func (d *DryRunHandler) completeResult(result *DryRunResult, startTime time.Time) {
 	// Calculate timing
 	result.Duration = DurationInfo{
 		Total:     time.Since(startTime),
@@ -185,7 +245,7 @@ func (d *DryRunHandler) ProcessDryRun(ctx context.Context, req *http.Request, pr
 	}
 
 	// Determine which response to return based on configuration
-	if d.config.DefaultResponseBackend == "secondary" {
+	if d.returnsSecondary() {
 		result.ReturnedResponse = "secondary"
 	} else {
 		result.ReturnedResponse = "primary" // Default to primary
@@ -196,8 +256,52 @@ func (d *DryRunHandler) ProcessDryRun(ctx context.Context, req *http.Request, pr
 
 	// Log the dry-run result
 	d.logDryRunResult(result)
+}
 
-	return result, nil
+// returnsSecondary reports whether the secondary backend's response is returned to the client
+func (d *DryRunHandler) returnsSecondary() bool {
+	return d.config.DefaultResponseBackend == "secondary"
+}
+
+// readRequestBody reads and closes the request body so it can be replayed to each backend
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	requestBody, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	req.Body.Close()
+	return requestBody, nil
+}
+
+// sendAsync sends the request to backend in the background
+func (d *DryRunHandler) sendAsync(ctx context.Context, req *http.Request, backend string, requestBody []byte, role string) <-chan ResponseInfo {
+	responseChan := make(chan ResponseInfo, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				d.logger.Error("panic recovered in dry-run "+role+" request", "error", r)
+				responseChan <- ResponseInfo{Error: fmt.Sprintf("panic: %v", r)}
+			}
+		}()
+		start := time.Now()
+		response := d.sendRequest(ctx, req, backend, requestBody)
+		response.ResponseTime = time.Since(start)
+		responseChan <- response
+	}()
+	return responseChan
+}
+
+// awaitResponse waits for a backend response or for ctx to end
+func awaitResponse(ctx context.Context, responseChan <-chan ResponseInfo) ResponseInfo {
+	select {
+	case response := <-responseChan:
+		return response
+	case <-ctx.Done():
+		return ResponseInfo{Error: ctx.Err().Error()}
+	}
 }
 
 // GetReturnedResponse returns the response information that should be sent to the client.
@@ -258,19 +362,26 @@ func (d *DryRunHandler) sendRequest(ctx context.Context, originalReq *http.Reque
 	}
 
 	response.BodySize = int64(len(bodyBytes))
+	response.body = bodyBytes
 	if d.config.LogResponses {
 		response.Body = string(bodyBytes)
 	}
 
 	// Copy response headers
-	response.Headers = make(map[string]string)
-	for key, values := range resp.Header {
+	response.Headers = firstHeaderValues(resp.Header)
+
+	return response
+}
+
+// firstHeaderValues returns the first value of each header
+func firstHeaderValues(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for key, values := range header {
 		if len(values) > 0 {
-			response.Headers[key] = values[0] // Take first value
+			headers[key] = values[0] // Take first value
 		}
 	}
-
-	return response
+	return headers
 }
 
 // compareResponses compares two responses and returns the comparison result.
@@ -291,8 +402,13 @@ func (d *DryRunHandler) compareResponses(primary, secondary ResponseInfo) Compar
 	result.HeadersMatch = d.compareHeaders(primary.Headers, secondary.Headers, result)
 
 	// Compare response bodies
-	result.BodyMatch = primary.Body == secondary.Body
-	if !result.BodyMatch && primary.Body != "" && secondary.Body != "" {
+	result.BodyMatch = bytes.Equal(primary.body, secondary.body)
+	if !result.BodyMatch {
+		// JSON bodies differing only in formatting or key order still match
+		result.BodyDiffs = diffJSONBodies(primary.body, secondary.body)
+		result.BodyMatch = result.BodyDiffs != nil && len(result.BodyDiffs) == 0
+	}
+	if !result.BodyMatch {
 		result.Differences = append(result.Differences, "Response body content differs")
 	}
 
@@ -321,6 +437,8 @@ func (d *DryRunHandler) compareHeaders(primaryHeaders, secondaryHeaders map[stri
 	ignoreMap["Date"] = true
 	ignoreMap["X-Request-ID"] = true
 	ignoreMap["X-Trace-ID"] = true
+	// Body length differences are covered by the body comparison
+	ignoreMap["Content-Length"] = true
 
 	// Compare headers that should be compared
 	compareMap := make(map[string]bool)
@@ -346,7 +464,7 @@ func (d *DryRunHandler) compareHeaders(primaryHeaders, secondaryHeaders map[stri
 			headersMatch = false
 			result.HeaderDiffs[key] = HeaderDiff{
 				Primary:   primaryValue,
-				Secondary: "<missing>",
+				Secondary: missingValue,
 			}
 		} else if primaryValue != secondaryValue {
 			headersMatch = false
@@ -370,7 +488,7 @@ func (d *DryRunHandler) compareHeaders(primaryHeaders, secondaryHeaders map[stri
 		if _, exists := primaryHeaders[key]; !exists {
 			headersMatch = false
 			result.HeaderDiffs[key] = HeaderDiff{
-				Primary:   "<missing>",
+				Primary:   missingValue,
 				Secondary: secondaryValue,
 			}
 		}
@@ -379,6 +497,107 @@ func (d *DryRunHandler) compareHeaders(primaryHeaders, secondaryHeaders map[stri
 	return headersMatch
 }
 
+// diffJSONBodies returns the differences between two JSON bodies, ignoring
+// formatting and object key order. It returns nil if either body is not JSON.
+func diffJSONBodies(primary, secondary []byte) []BodyDiff {
+	primaryValue, ok := decodeJSONBody(primary)
+	if !ok {
+		return nil
+	}
+	secondaryValue, ok := decodeJSONBody(secondary)
+	if !ok {
+		return nil
+	}
+	diffs := []BodyDiff{}
+	diffJSONValues("", primaryValue, secondaryValue, &diffs)
+	return diffs
+}
+
+// decodeJSONBody decodes a JSON body, keeping numbers exact
+func decodeJSONBody(body []byte) (any, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, false
+	}
+	return value, true
+}
+
+// diffJSONValues appends the differences between two decoded JSON values at path
+func diffJSONValues(path string, primary, secondary any, diffs *[]BodyDiff) {
+	if len(*diffs) >= maxBodyDiffs {
+		return
+	}
+	switch p := primary.(type) {
+	case map[string]any:
+		if s, ok := secondary.(map[string]any); ok {
+			keys := make([]string, 0, len(p)+len(s))
+			for key := range p {
+				keys = append(keys, key)
+			}
+			for key := range s {
+				if _, exists := p[key]; !exists {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				primaryValue, inPrimary := p[key]
+				secondaryValue, inSecondary := s[key]
+				if !inPrimary {
+					primaryValue = missingValue
+				}
+				if !inSecondary {
+					secondaryValue = missingValue
+				}
+				diffJSONValues(path+"/"+escapeJSONPointer(key), primaryValue, secondaryValue, diffs)
+			}
+			return
+		}
+	case json.Number:
+		if s, ok := secondary.(json.Number); ok && jsonNumbersEqual(p, s) {
+			return
+		}
+	case []any:
+		if s, ok := secondary.([]any); ok {
+			for i := 0; i < max(len(p), len(s)); i++ {
+				var primaryValue, secondaryValue any = missingValue, missingValue
+				if i < len(p) {
+					primaryValue = p[i]
+				}
+				if i < len(s) {
+					secondaryValue = s[i]
+				}
+				diffJSONValues(path+"/"+strconv.Itoa(i), primaryValue, secondaryValue, diffs)
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(primary, secondary) {
+		*diffs = append(*diffs, BodyDiff{Path: path, Primary: primary, Secondary: secondary})
+	}
+}
+
+// jsonNumbersEqual reports whether two JSON numbers have the same value, so
+// 1 and 1.0 match
+func jsonNumbersEqual(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+	x, okX := new(big.Rat).SetString(string(a))
+	y, okY := new(big.Rat).SetString(string(b))
+	return okX && okY && x.Cmp(y) == 0
+}
+
+// escapeJSONPointer escapes a JSON object key for use in a JSON Pointer
+func escapeJSONPointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
 // logDryRunResult logs the dry-run result.
 func (d *DryRunHandler) logDryRunResult(result *DryRunResult) {
 	logLevel := "info"
@@ -418,6 +637,10 @@ func (d *DryRunHandler) logDryRunResult(result *DryRunResult) {
 		logAttrs = append(logAttrs, "headerDifferences", result.Comparison.HeaderDiffs)
 	}
 
+	if len(result.Comparison.BodyDiffs) > 0 {
+		logAttrs = append(logAttrs, "bodyDifferences", result.Comparison.BodyDiffs)
+	}
+
 	if result.PrimaryResponse.Error != "" {
 		logAttrs = append(logAttrs, "primaryError", result.PrimaryResponse.Error)
 	}
@@ -25,6 +25,7 @@ var (
 	ErrInvalidFeatureFlagConfigType    = errors.New("invalid feature flag configuration type")
 	ErrNoFeatureFlagConfigProvider     = errors.New("no configuration provider available for feature flags")
 	ErrInvalidDefaultFeatureFlagConfig = errors.New("invalid default configuration type for feature flags")
+	ErrInvalidFeatureFlagRule          = errors.New("feature flag rule percentage must be between 0 and 100")
 	ErrConfigurationNotLoaded          = errors.New("configuration not loaded")
 	ErrBackendErrorStatus              = errors.New("backend returned non-success status")
 
@@ -45,4 +46,24 @@ var (
 	ErrNoBackendsConfigured       = errors.New("no backends configured")
 	ErrBackendNotConfigured       = errors.New("backend not configured")
 	ErrInvalidEmptyResponsePolicy = errors.New("invalid empty_policy: must be one of allow-empty, skip-empty, fail-on-empty")
+	ErrInvalidPartialResultPolicy = errors.New("invalid partial_results: must be one of best-effort, fail-fast")
+
+	// Response header rewrite errors
+	ErrHeaderRewriteHeaderRequired = errors.New("response header rewrite requires a header name")
+	ErrInvalidHeaderRewritePattern = errors.New("invalid response header rewrite pattern")
+
+	// Path rewrite errors
+	ErrInvalidPathRewritePattern = errors.New("invalid path rewrite pattern")
+	ErrInvalidTrailingSlash      = errors.New("invalid trailing_slash: must be one of preserve, strip, add")
+	ErrConflictingPathRewrite    = errors.New("path_rewrite conflicts with path_rewriting.base_path_rewrite")
+
+	// Request mirroring errors
+	ErrInvalidMirrorSampleRate = errors.New("mirror sample_rate must be between 0 and 1")
+	ErrInvalidMirrorBodySize   = errors.New("mirror max_body_size must not be negative")
+
+	// Health check errors
+	ErrInvalidHealthCheckJitter  = errors.New("health check jitter must be at least 0 and less than 1")
+	ErrInvalidHealthCheckBackoff = errors.New("health check backoff_multiplier must be at least 1")
+	ErrInvalidHealthBodyPattern  = errors.New("invalid health check expected_body_regex")
+	ErrInvalidPassiveErrorRate   = errors.New("passive health check error_rate_threshold must be between 0 and 1")
 )
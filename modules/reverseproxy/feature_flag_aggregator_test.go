@@ -291,3 +291,79 @@ func TestFeatureFlagAggregator_PreventSelfIngestion(t *testing.T) {
 		}
 	}
 }
+
+// mockCoreEvaluator implements only the core modular.FeatureFlagEvaluator method set
+type mockCoreEvaluator struct {
+	flags       map[string]bool
+	tenantFlags map[modular.TenantID]map[string]bool
+}
+
+func (m *mockCoreEvaluator) Evaluate(ctx context.Context, flag string, defaultVal bool) bool {
+	if value, ok := m.flags[flag]; ok {
+		return value
+	}
+	return defaultVal
+}
+
+func (m *mockCoreEvaluator) EvaluateForTenant(ctx context.Context, tenantID modular.TenantID, flag string, defaultVal bool) bool {
+	if value, ok := m.tenantFlags[tenantID][flag]; ok {
+		return value
+	}
+	return m.Evaluate(ctx, flag, defaultVal)
+}
+
+// The evaluators of this module can be used wherever a modular.FeatureFlagEvaluator is expected
+var (
+	_ coreFeatureFlagEvaluator = (*FileBasedFeatureFlagEvaluator)(nil)
+	_ coreFeatureFlagEvaluator = (*FeatureFlagAggregator)(nil)
+)
+
+// Test that the aggregator consults evaluators implementing only the core interface
+func TestFeatureFlagAggregator_CoreEvaluator(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	app := NewMockTenantApplication()
+
+	core := &mockCoreEvaluator{
+		flags:       map[string]bool{"core-flag": false},
+		tenantFlags: map[modular.TenantID]map[string]bool{"acme": {"core-flag": true}},
+	}
+	if err := app.RegisterService("featureFlags", core); err != nil {
+		t.Fatalf("Failed to register core evaluator: %v", err)
+	}
+	app.RegisterConfigSection("reverseproxy", modular.NewStdConfigProvider(&ReverseProxyConfig{
+		FeatureFlags: FeatureFlagsConfig{Enabled: true, Flags: map[string]bool{"file-flag": true}},
+	}))
+	fileEvaluator, err := NewFileBasedFeatureFlagEvaluator(context.Background(), app, logger)
+	if err != nil {
+		t.Fatalf("Failed to create file evaluator: %v", err)
+	}
+	if err := app.RegisterService("featureFlagEvaluator.file", fileEvaluator); err != nil {
+		t.Fatalf("Failed to register file evaluator: %v", err)
+	}
+
+	aggregator := NewFeatureFlagAggregator(app, logger)
+	ctx := context.Background()
+
+	result, err := aggregator.EvaluateFlag(ctx, "core-flag", "", nil)
+	if err != nil || result {
+		t.Errorf("Expected core-flag to be false from the core evaluator, got %v (err %v)", result, err)
+	}
+	result, err = aggregator.EvaluateFlag(ctx, "core-flag", "acme", nil)
+	if err != nil || !result {
+		t.Errorf("Expected core-flag to be true for tenant acme, got %v (err %v)", result, err)
+	}
+
+	// The core evaluator abstains on undefined flags, so the file evaluator decides
+	result, err = aggregator.EvaluateFlag(ctx, "file-flag", "", nil)
+	if err != nil || !result {
+		t.Errorf("Expected file-flag to fall through to the file evaluator, got %v (err %v)", result, err)
+	}
+
+	// The aggregator itself serves the core interface, e.g. for modular.IsFeatureEnabled
+	if !aggregator.Evaluate(modular.NewTenantContext(ctx, "acme"), "core-flag", false) {
+		t.Error("Expected Evaluate to use the tenant of the context")
+	}
+	if !aggregator.EvaluateForTenant(ctx, "other", "missing-flag", true) {
+		t.Error("Expected the default value for an undefined flag")
+	}
+}
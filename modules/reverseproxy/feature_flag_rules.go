@@ -0,0 +1,118 @@
+package reverseproxy
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"slices"
+
+	"github.com/GoCodeAlone/modular"
+)
+
+// Well-known feature flag attributes, named as in the core modular package
+const (
+	// FeatureFlagUserIDAttribute is the default bucketing key of percentage rollouts
+	FeatureFlagUserIDAttribute = "userId"
+	// FeatureFlagTenantIDAttribute is set to the tenant a flag is evaluated for
+	FeatureFlagTenantIDAttribute = "tenantId"
+)
+
+// featureFlagBuckets is the number of rollout buckets, giving percentages a
+// resolution of 0.01
+const featureFlagBuckets = 10000
+
+// FeatureFlagRule enables a flag for targeted subjects and for a stable
+// percentage of the rest, with the semantics of modular.FeatureFlagRule.
+// Targets are checked first; a subject matching none is in the rollout if its
+// bucketing key hashes into the first Percentage percent of buckets. Subjects
+// are bucketed the same way as by the core evaluator, so both agree on who is
+// in a rollout.
+//
+// Example:
+//
+//	feature_flags:
+//	  enabled: true
+//	  attribute_headers:
+//	    userId: X-User-ID
+//	    plan: X-Plan
+//	  rules:
+//	    checkout-v2:
+//	      targets:
+//	        - attribute: plan
+//	          values: [enterprise]
+//	      percentage: 25
+type FeatureFlagRule struct {
+	Targets    []FeatureFlagTarget `json:"targets" yaml:"targets" toml:"targets" desc:"Attribute rules enabling the flag, checked in order"`
+	Percentage float64             `json:"percentage" yaml:"percentage" toml:"percentage" desc:"Percentage (0-100) of subjects the flag is enabled for"`
+	BucketBy   string              `json:"bucket_by" yaml:"bucket_by" toml:"bucket_by" desc:"Attribute used as the rollout bucketing key (default userId)"`
+}
+
+// FeatureFlagTarget matches subjects whose attribute has one of the values
+type FeatureFlagTarget struct {
+	Attribute string   `json:"attribute" yaml:"attribute" toml:"attribute" desc:"Attribute to match"`
+	Values    []string `json:"values" yaml:"values" toml:"values" desc:"Attribute values enabling the flag"`
+	Enabled   *bool    `json:"enabled" yaml:"enabled" toml:"enabled" desc:"Flag value for matching subjects (default true)"`
+}
+
+// evaluate returns whether the rule enables the flag for attrs
+func (r *FeatureFlagRule) evaluate(flag string, attrs map[string]string) bool {
+	for _, target := range r.Targets {
+		if value, ok := attrs[target.Attribute]; ok && slices.Contains(target.Values, value) {
+			return target.Enabled == nil || *target.Enabled
+		}
+	}
+
+	switch {
+	case r.Percentage <= 0:
+		return false
+	case r.Percentage >= 100:
+		return true
+	}
+	bucketBy := r.BucketBy
+	if bucketBy == "" {
+		bucketBy = FeatureFlagUserIDAttribute
+	}
+	key, ok := attrs[bucketBy]
+	if !ok || key == "" {
+		// Subjects without a bucketing key are outside partial rollouts
+		return false
+	}
+	return float64(featureFlagBucket(flag, key)) < r.Percentage*featureFlagBuckets/100
+}
+
+// featureFlagBucket deterministically maps a flag and bucketing key to a
+// bucket in [0, featureFlagBuckets), exactly as the core evaluator does
+func featureFlagBucket(flag, key string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(flag))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(key))
+	return h.Sum32() % featureFlagBuckets
+}
+
+// featureFlagAttributes returns the attributes rules are evaluated against:
+// the tenant, and the request headers mapped by attributeHeaders
+func featureFlagAttributes(attributeHeaders map[string]string, tenantID modular.TenantID, req *http.Request) map[string]string {
+	attrs := make(map[string]string, len(attributeHeaders)+1)
+	if req != nil {
+		for attribute, header := range attributeHeaders {
+			if value := req.Header.Get(header); value != "" {
+				attrs[attribute] = value
+			}
+		}
+	}
+	if tenantID != "" {
+		attrs[FeatureFlagTenantIDAttribute] = string(tenantID)
+	}
+	return attrs
+}
+
+// validateFeatureFlagRules checks that every rollout percentage is between 0 and 100
+func validateFeatureFlagRules(config *FeatureFlagsConfig) error {
+	for name, rule := range config.Rules {
+		if rule.Percentage < 0 || rule.Percentage > 100 {
+			return fmt.Errorf("feature flag rule '%s': %w, got %v", name, ErrInvalidFeatureFlagRule, rule.Percentage)
+		}
+	}
+	return nil
+}
@@ -35,6 +35,48 @@ type FeatureFlagEvaluator interface {
 	EvaluateFlagWithDefault(ctx context.Context, flagID string, tenantID modular.TenantID, req *http.Request, defaultValue bool) bool
 }
 
+// coreFeatureFlagEvaluator has the method set of modular.FeatureFlagEvaluator, the
+// application-wide feature flag interface of the core framework. The evaluators of this
+// module implement it, so modular.IsFeatureEnabled resolves flags through the aggregator,
+// and the aggregator consults evaluators registered only against the core interface.
+type coreFeatureFlagEvaluator interface {
+	Evaluate(ctx context.Context, flag string, defaultVal bool) bool
+	EvaluateForTenant(ctx context.Context, tenantID modular.TenantID, flag string, defaultVal bool) bool
+}
+
+// coreEvaluatorAdapter adapts a core feature flag evaluator to FeatureFlagEvaluator.
+// The core interface cannot report an undefined flag, so a flag is treated as undefined,
+// and the adapter abstains with ErrNoDecision, when evaluating it with either default
+// gives different results.
+type coreEvaluatorAdapter struct {
+	evaluator coreFeatureFlagEvaluator
+}
+
+// EvaluateFlag evaluates flagID with the core evaluator, for tenantID if one is given.
+func (a coreEvaluatorAdapter) EvaluateFlag(ctx context.Context, flagID string, tenantID modular.TenantID, _ *http.Request) (bool, error) {
+	evaluate := func(defaultVal bool) bool {
+		if tenantID != "" {
+			return a.evaluator.EvaluateForTenant(ctx, tenantID, flagID, defaultVal)
+		}
+		return a.evaluator.Evaluate(ctx, flagID, defaultVal)
+	}
+	value := evaluate(false)
+	if value != evaluate(true) {
+		return false, fmt.Errorf("%w: %s", ErrNoDecision, flagID)
+	}
+	return value, nil
+}
+
+// EvaluateFlagWithDefault evaluates flagID with the core evaluator, returning defaultValue
+// if the flag is undefined.
+func (a coreEvaluatorAdapter) EvaluateFlagWithDefault(ctx context.Context, flagID string, tenantID modular.TenantID, req *http.Request, defaultValue bool) bool {
+	value, err := a.EvaluateFlag(ctx, flagID, tenantID, req)
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
 // WeightedEvaluator is an optional interface that FeatureFlagEvaluator implementations
 // can implement to specify their priority in the evaluation chain.
 // Lower weight values indicate higher priority (evaluated first).
@@ -191,6 +233,15 @@ func (f *FileBasedFeatureFlagEvaluator) EvaluateFlag(ctx context.Context, flagID
 		}
 	}
 
+	if rule, exists := config.FeatureFlags.Rules[flagID]; exists {
+		value := rule.evaluate(flagID, featureFlagAttributes(config.FeatureFlags.AttributeHeaders, tenantID, req))
+		f.logger.DebugContext(ctx, "Feature flag rule evaluated",
+			"flag", flagID,
+			"tenant", tenantID,
+			"value", value)
+		return value, nil
+	}
+
 	f.logger.DebugContext(ctx, "Feature flag not found in configuration",
 		"flag", flagID,
 		"tenant", tenantID)
@@ -206,9 +257,22 @@ func (f *FileBasedFeatureFlagEvaluator) EvaluateFlagWithDefault(ctx context.Cont
 	return value
 }
 
+// Evaluate implements modular.FeatureFlagEvaluator, evaluating flag for the tenant of ctx, if any.
+func (f *FileBasedFeatureFlagEvaluator) Evaluate(ctx context.Context, flag string, defaultVal bool) bool {
+	tenantID, _ := modular.GetTenantIDFromContext(ctx)
+	return f.EvaluateFlagWithDefault(ctx, flag, tenantID, nil, defaultVal)
+}
+
+// EvaluateForTenant implements modular.FeatureFlagEvaluator, evaluating flag for tenantID.
+func (f *FileBasedFeatureFlagEvaluator) EvaluateForTenant(ctx context.Context, tenantID modular.TenantID, flag string, defaultVal bool) bool {
+	return f.EvaluateFlagWithDefault(ctx, flag, tenantID, nil, defaultVal)
+}
+
 // FeatureFlagAggregator implements FeatureFlagEvaluator by aggregating multiple
 // evaluators and calling them in priority order (weight-based).
-// It discovers evaluators from the service registry by name prefix pattern.
+// It discovers evaluators from the service registry by interface, including evaluators
+// implementing only modular.FeatureFlagEvaluator. It also implements
+// modular.FeatureFlagEvaluator itself, evaluating flags without a request.
 type FeatureFlagAggregator struct {
 	app    modular.Application
 	logger *slog.Logger
@@ -281,6 +345,32 @@ func (a *FeatureFlagAggregator) discoverEvaluators() []weightedEvaluatorInstance
 			"weight", weight, "type", fmt.Sprintf("%T", evaluator))
 	}
 
+	// Include evaluators registered only against the core modular.FeatureFlagEvaluator interface
+	coreEvaluatorType := reflect.TypeOf((*coreFeatureFlagEvaluator)(nil)).Elem()
+	for _, entry := range a.app.GetServicesByInterface(coreEvaluatorType) {
+		// Evaluators of this module, and the aggregator itself, were handled above
+		if _, ok := entry.Service.(FeatureFlagEvaluator); ok {
+			continue
+		}
+
+		uniqueName := a.generateUniqueNameWithModuleInfo(entry, nameCounters)
+		weight := 100 // default weight
+		if weighted, ok := entry.Service.(interface{ Weight() int }); ok {
+			weight = weighted.Weight()
+		}
+
+		evaluators = append(evaluators, weightedEvaluatorInstance{
+			evaluator: coreEvaluatorAdapter{evaluator: entry.Service.(coreFeatureFlagEvaluator)},
+			weight:    weight,
+			name:      uniqueName,
+		})
+
+		a.logger.Debug("Discovered core feature flag evaluator",
+			"originalName", entry.OriginalName, "actualName", entry.ActualName,
+			"uniqueName", uniqueName, "moduleName", entry.ModuleName,
+			"weight", weight, "type", fmt.Sprintf("%T", entry.Service))
+	}
+
 	// Also include the file evaluator with weight 1000 (lowest priority)
 	var fileEvaluator FeatureFlagEvaluator
 	if err := a.app.GetService("featureFlagEvaluator.file", &fileEvaluator); err == nil && fileEvaluator != nil {
@@ -406,3 +496,16 @@ func (a *FeatureFlagAggregator) EvaluateFlagWithDefault(ctx context.Context, fla
 	}
 	return result
 }
+
+// Evaluate implements modular.FeatureFlagEvaluator, evaluating flag for the tenant of ctx,
+// if any. Evaluators are called without a request.
+func (a *FeatureFlagAggregator) Evaluate(ctx context.Context, flag string, defaultVal bool) bool {
+	tenantID, _ := modular.GetTenantIDFromContext(ctx)
+	return a.EvaluateFlagWithDefault(ctx, flag, tenantID, nil, defaultVal)
+}
+
+// EvaluateForTenant implements modular.FeatureFlagEvaluator, evaluating flag for tenantID.
+// Evaluators are called without a request.
+func (a *FeatureFlagAggregator) EvaluateForTenant(ctx context.Context, tenantID modular.TenantID, flag string, defaultVal bool) bool {
+	return a.EvaluateFlagWithDefault(ctx, flag, tenantID, nil, defaultVal)
+}
@@ -2,9 +2,13 @@ package reverseproxy
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/GoCodeAlone/modular"
@@ -154,3 +158,114 @@ func TestFileBasedFeatureFlagEvaluator_Disabled(t *testing.T) {
 		t.Error("Expected default value when feature flags are disabled")
 	}
 }
+
+// TestFileBasedFeatureFlagEvaluator_Rules tests targeted and percentage rollout flags
+func TestFileBasedFeatureFlagEvaluator_Rules(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	disabled := false
+	config := &ReverseProxyConfig{
+		FeatureFlags: FeatureFlagsConfig{
+			Enabled:          true,
+			AttributeHeaders: map[string]string{FeatureFlagUserIDAttribute: "X-User-ID", "plan": "X-Plan"},
+			Rules: map[string]FeatureFlagRule{
+				"checkout-v2": {
+					Targets: []FeatureFlagTarget{
+						{Attribute: "plan", Values: []string{"enterprise"}},
+						{Attribute: FeatureFlagTenantIDAttribute, Values: []string{"blocked"}, Enabled: &disabled},
+					},
+					Percentage: 50,
+				},
+			},
+		},
+	}
+
+	app := NewMockTenantApplication()
+	app.RegisterConfigSection("reverseproxy", modular.NewStdConfigProvider(config))
+	evaluator, err := NewFileBasedFeatureFlagEvaluator(context.Background(), app, logger)
+	if err != nil {
+		t.Fatalf("Failed to create feature flag evaluator: %v", err)
+	}
+
+	request := func(headers map[string]string) *http.Request {
+		req := httptest.NewRequest("GET", "/test", nil)
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		return req
+	}
+
+	// Targets are matched against request headers and the tenant
+	enabled, err := evaluator.EvaluateFlag(context.Background(), "checkout-v2", "", request(map[string]string{"X-Plan": "enterprise"}))
+	if err != nil || !enabled {
+		t.Errorf("Expected targeted plan to enable the flag, got %v (err %v)", enabled, err)
+	}
+	enabled, err = evaluator.EvaluateFlag(context.Background(), "checkout-v2", "blocked", request(map[string]string{"X-User-ID": "u-1"}))
+	if err != nil || enabled {
+		t.Errorf("Expected targeted tenant to disable the flag, got %v (err %v)", enabled, err)
+	}
+
+	// Subjects without a bucketing key are outside the rollout
+	enabled, err = evaluator.EvaluateFlag(context.Background(), "checkout-v2", "", request(nil))
+	if err != nil || enabled {
+		t.Errorf("Expected no rollout without a user ID, got %v (err %v)", enabled, err)
+	}
+
+	// Rollouts are stable per user and cover roughly the configured percentage
+	inRollout := 0
+	for i := 0; i < 1000; i++ {
+		req := request(map[string]string{"X-User-ID": fmt.Sprintf("user-%d", i)})
+		first := evaluator.EvaluateFlagWithDefault(context.Background(), "checkout-v2", "", req, false)
+		if first != evaluator.EvaluateFlagWithDefault(context.Background(), "checkout-v2", "", req, false) {
+			t.Fatalf("Expected a stable result for user-%d", i)
+		}
+		if first {
+			inRollout++
+		}
+	}
+	if inRollout < 400 || inRollout > 600 {
+		t.Errorf("Expected about half of the users in a 50%% rollout, got %d of 1000", inRollout)
+	}
+}
+
+// TestValidateFeatureFlagRules tests that rollout percentages outside 0-100 are rejected
+func TestValidateFeatureFlagRules(t *testing.T) {
+	if err := validateFeatureFlagRules(&FeatureFlagsConfig{Rules: map[string]FeatureFlagRule{"ok": {Percentage: 100}}}); err != nil {
+		t.Errorf("Expected a valid percentage to pass, got %v", err)
+	}
+	err := validateFeatureFlagRules(&FeatureFlagsConfig{Rules: map[string]FeatureFlagRule{"bad": {Percentage: 101}}})
+	if !errors.Is(err, ErrInvalidFeatureFlagRule) {
+		t.Errorf("Expected ErrInvalidFeatureFlagRule, got %v", err)
+	}
+}
+
+// TestFileBasedFeatureFlagEvaluator_ReadsLiveConfig tests that flag changes applied to the
+// registered config, as a dynamic config reload does, take effect without a new evaluator
+func TestFileBasedFeatureFlagEvaluator_ReadsLiveConfig(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	config := &ReverseProxyConfig{
+		FeatureFlags: FeatureFlagsConfig{Enabled: true, Flags: map[string]bool{"new-ui": false}},
+	}
+	app := NewMockTenantApplication()
+	app.RegisterConfigSection("reverseproxy", modular.NewStdConfigProvider(config))
+	evaluator, err := NewFileBasedFeatureFlagEvaluator(context.Background(), app, logger)
+	if err != nil {
+		t.Fatalf("Failed to create feature flag evaluator: %v", err)
+	}
+	if evaluator.EvaluateFlagWithDefault(context.Background(), "new-ui", "", nil, true) {
+		t.Fatal("Expected new-ui to start disabled")
+	}
+
+	config.FeatureFlags.Flags = map[string]bool{"new-ui": true}
+	if !evaluator.EvaluateFlagWithDefault(context.Background(), "new-ui", "", nil, false) {
+		t.Error("Expected the reloaded flag value")
+	}
+
+	// The flag fields must stay dynamic for the config reloader to copy them
+	flagsType := reflect.TypeOf(FeatureFlagsConfig{})
+	for _, name := range []string{"Flags", "Rules", "AttributeHeaders"} {
+		field, _ := flagsType.FieldByName(name)
+		if field.Tag.Get("dynamic") != "true" {
+			t.Errorf("Expected FeatureFlagsConfig.%s to be tagged dynamic", name)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package reverseproxy
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// validateHeaderRewrites checks that every rule names a header and has a valid pattern.
+func validateHeaderRewrites(rules []HeaderRewrite) error {
+	for i, rule := range rules {
+		if rule.Header == "" {
+			return fmt.Errorf("%w (rule %d)", ErrHeaderRewriteHeaderRequired, i)
+		}
+		if _, err := compilePattern(rule.Pattern, ErrInvalidHeaderRewritePattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyResponseHeaderRewrites applies regex-based rewrite rules to the response headers.
+// Each value of a multi-valued header (such as Set-Cookie) is rewritten independently,
+// and headers whose values do not match a rule are left untouched.
+func (m *ReverseProxyModule) applyResponseHeaderRewrites(resp *http.Response, backendID string, rules []HeaderRewrite) {
+	if resp == nil || resp.Header == nil {
+		return
+	}
+
+	for _, rule := range rules {
+		values := resp.Header.Values(rule.Header)
+		if len(values) == 0 {
+			continue
+		}
+
+		re, err := compilePattern(rule.Pattern, ErrInvalidHeaderRewritePattern)
+		if err != nil {
+			if m.app != nil && m.app.Logger() != nil {
+				m.app.Logger().Warn("Skipping invalid response header rewrite", "backend", backendID, "header", rule.Header, "error", err.Error())
+			}
+			continue
+		}
+
+		changed := false
+		rewritten := make([]string, len(values))
+		for i, value := range values {
+			rewritten[i] = re.ReplaceAllString(value, rule.Replacement)
+			if rewritten[i] != value {
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+
+		resp.Header.Del(rule.Header)
+		for _, value := range rewritten {
+			resp.Header.Add(rule.Header, value)
+		}
+	}
+}
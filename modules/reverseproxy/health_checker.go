@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"math"
+	"math/rand/v2"
 	"net"
 	"net/http"
 	"net/url"
 	"path"
 	"sync"
 	"time"
+	"unicode/utf8"
 )
 
 // ErrNoHostname is returned when a URL has no hostname
@@ -19,6 +23,15 @@ var ErrNoHostname = errors.New("no hostname in URL")
 // ErrUnexpectedStatusCode is returned when a health check receives an unexpected status code
 var ErrUnexpectedStatusCode = errors.New("unexpected status code")
 
+// ErrUnexpectedHealthBody is returned when a health check response body does not match the expected body regex
+var ErrUnexpectedHealthBody = errors.New("health check response body does not match expected pattern")
+
+// ErrUnreadableHealthBody is returned when a health check response body cannot be read or is not valid UTF-8 text
+var ErrUnreadableHealthBody = errors.New("health check response body could not be parsed")
+
+// maxHealthCheckBodySize caps how much of a health check response body is matched against the expected body regex.
+const maxHealthCheckBodySize = 1 << 20
+
 // ErrUnexpectedConfigType is returned when an unexpected config type is passed to Init
 var ErrUnexpectedConfigType = errors.New("unexpected config type")
 
@@ -43,6 +56,17 @@ type HealthStatus struct {
 	CircuitFailureCount int    `json:"circuit_failure_count,omitempty"`
 	// Health check result (independent of circuit breaker status)
 	HealthCheckPassing bool `json:"health_check_passing"`
+	// ConsecutiveFailures counts the failed checks since the last passing one
+	ConsecutiveFailures int64 `json:"consecutive_failures"`
+	// Passive health check result from live request outcomes (independent of circuit breaker status)
+	PassiveUnhealthy bool    `json:"passive_unhealthy"`
+	PassiveErrorRate float64 `json:"passive_error_rate"`
+}
+
+// overallHealthy reports whether a backend is healthy: its active check passes, its circuit breaker
+// is not open and its live requests are not failing at a rate above the passive threshold.
+func (s *HealthStatus) overallHealthy() bool {
+	return s.HealthCheckPassing && !s.CircuitBreakerOpen && !s.PassiveUnhealthy
 }
 
 // HealthCircuitBreakerInfo provides circuit breaker status information for health checks.
@@ -81,6 +105,11 @@ type HealthChecker struct {
 	healthEndpoints          map[string]string
 	backendHealthCheckConfig map[string]BackendHealthConfig
 	expectedStatusCodes      []int
+	expectedBodyRegex        string
+	passiveConfig            PassiveHealthCheckConfig
+
+	// Live request outcomes per backend for passive health checking (protected by statusMutex)
+	passiveWindows map[string]*passiveHealthWindow
 
 	// Context for running health check goroutines (protected by runningMutex)
 	ctx    context.Context
@@ -116,6 +145,9 @@ func NewHealthChecker(config *HealthCheckConfig, backends map[string]string, htt
 		healthEndpoints:          healthEndpointsCopy,
 		backendHealthCheckConfig: backendHealthCfgCopy,
 		expectedStatusCodes:      expectedCodesCopy,
+		expectedBodyRegex:        config.ExpectedBodyRegex,
+		passiveConfig:            config.Passive,
+		passiveWindows:           make(map[string]*passiveHealthWindow),
 	}
 }
 
@@ -138,6 +170,8 @@ func (hc *HealthChecker) UpdateHealthConfig(ctx context.Context, cfg *HealthChec
 	hc.healthEndpoints = healthEndpointsCopy
 	hc.backendHealthCheckConfig = backendHealthCfgCopy
 	hc.expectedStatusCodes = expectedCodesCopy
+	hc.expectedBodyRegex = cfg.ExpectedBodyRegex
+	hc.passiveConfig = cfg.Passive
 	hc.configMutex.Unlock()
 	hc.logger.DebugContext(ctx, "Health checker config updated", "health_endpoints", len(healthEndpointsCopy), "backend_specific", len(backendHealthCfgCopy))
 }
@@ -262,7 +296,7 @@ func (hc *HealthChecker) GetHealthStatus() map[string]*HealthStatus {
 				status.CircuitBreakerState = cbInfo.State
 				status.CircuitFailureCount = cbInfo.FailureCount
 				// Update overall health status considering circuit breaker
-				status.Healthy = status.HealthCheckPassing && !status.CircuitBreakerOpen
+				status.Healthy = status.overallHealthy()
 			}
 		}
 	}
@@ -293,7 +327,7 @@ func (hc *HealthChecker) GetBackendHealthStatus(backendID string) (*HealthStatus
 			status.CircuitBreakerState = cbInfo.State
 			status.CircuitFailureCount = cbInfo.FailureCount
 			// Update overall health status considering circuit breaker
-			status.Healthy = status.HealthCheckPassing && !status.CircuitBreakerOpen
+			status.Healthy = status.overallHealthy()
 		}
 	}
 
@@ -354,9 +388,10 @@ func (hc *HealthChecker) runPeriodicHealthCheck(backendID, baseURL string) {
 		return
 	}
 
-	interval := hc.getBackendInterval(backendID)
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
+	// A timer rather than a ticker, as each wait is computed anew with jitter
+	// and backoff applied
+	timer := time.NewTimer(hc.nextCheckInterval(backendID))
+	defer timer.Stop()
 
 	for {
 		select {
@@ -366,13 +401,14 @@ func (hc *HealthChecker) runPeriodicHealthCheck(backendID, baseURL string) {
 		case <-hc.stopChan:
 			hc.logger.Debug("Health check goroutine stopped due to stop channel", "backend", backendID)
 			return
-		case <-ticker.C:
+		case <-timer.C:
 			// Check context again before performing health check
 			if ctx.Err() != nil {
 				hc.logger.Debug("Health check goroutine stopping - context cancelled", "backend", backendID)
 				return
 			}
 			hc.performHealthCheck(ctx, backendID, baseURL)
+			timer.Reset(hc.nextCheckInterval(backendID))
 		}
 	}
 }
@@ -524,9 +560,36 @@ func (hc *HealthChecker) performHTTPCheck(ctx context.Context, backendID, baseUR
 		return false, responseTime, fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, resp.StatusCode)
 	}
 
+	// Match the body when the backend reports degraded state in an otherwise successful response
+	if pattern := hc.getExpectedBodyRegex(backendID); pattern != "" {
+		if err := matchHealthCheckBody(resp.Body, pattern); err != nil {
+			return false, time.Since(start), err
+		}
+	}
+
 	return true, responseTime, nil
 }
 
+// matchHealthCheckBody reads a health check response body and checks it against the expected body regex.
+// A body that cannot be read, including one cut short by the check timeout, or that is not valid UTF-8 does not match.
+func matchHealthCheckBody(body io.Reader, pattern string) error {
+	re, err := compilePattern(pattern, ErrInvalidHealthBodyPattern)
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(io.LimitReader(body, maxHealthCheckBodySize))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnreadableHealthBody, err)
+	}
+	if !utf8.Valid(data) {
+		return fmt.Errorf("%w: body is not valid UTF-8", ErrUnreadableHealthBody)
+	}
+	if !re.Match(data) {
+		return fmt.Errorf("%w %q", ErrUnexpectedHealthBody, pattern)
+	}
+	return nil
+}
+
 // updateHealthStatus updates the health status for a backend.
 func (hc *HealthChecker) updateHealthStatus(backendID string, healthy bool, responseTime time.Duration, dnsResolved bool, resolvedIPs []string, dnsErr, httpErr error) {
 	hc.statusMutex.Lock()
@@ -557,14 +620,16 @@ func (hc *HealthChecker) updateHealthStatus(backendID string, healthy bool, resp
 		}
 	}
 
-	// A backend is overall healthy if health check passes AND circuit breaker is not open
-	status.Healthy = healthCheckPassing && !status.CircuitBreakerOpen
+	// A backend is overall healthy if health check passes AND circuit breaker is not open AND live requests are not failing
+	status.Healthy = status.overallHealthy()
 
 	if healthCheckPassing {
 		status.LastSuccess = time.Now()
 		status.LastError = ""
 		status.SuccessfulChecks++
+		status.ConsecutiveFailures = 0
 	} else {
+		status.ConsecutiveFailures++
 		// Record the error
 		if dnsErr != nil {
 			status.LastError = dnsErr.Error()
@@ -643,6 +708,75 @@ func (hc *HealthChecker) getBackendInterval(backendID string) time.Duration {
 	return interval
 }
 
+// nextCheckInterval returns how long to wait before the next health check of a
+// backend. The interval grows by BackoffMultiplier for each consecutive failed
+// check, up to MaxBackoffInterval, and returns to normal after the first
+// passing check. Jitter is then applied so replicas do not poll in lockstep.
+func (hc *HealthChecker) nextCheckInterval(backendID string) time.Duration {
+	interval := hc.getBackendInterval(backendID)
+
+	hc.configMutex.RLock()
+	jitter := hc.config.Jitter
+	multiplier := hc.config.BackoffMultiplier
+	maxBackoff := hc.config.MaxBackoffInterval
+	hc.configMutex.RUnlock()
+
+	hc.statusMutex.RLock()
+	var failures int64
+	if status, exists := hc.healthStatus[backendID]; exists {
+		failures = status.ConsecutiveFailures
+	}
+	hc.statusMutex.RUnlock()
+
+	return healthCheckInterval(interval, failures, multiplier, maxBackoff, jitter, rand.Float64()) //nolint:gosec // jitter does not need a secure source
+}
+
+// healthCheckInterval computes a health check interval from the base interval
+// and the number of consecutive failures. random is a value in [0, 1) that
+// selects the jitter applied.
+func healthCheckInterval(interval time.Duration, failures int64, multiplier float64, maxBackoff time.Duration, jitter, random float64) time.Duration {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	if failures > 0 && maxBackoff > interval {
+		backoff := float64(interval) * math.Pow(multiplier, float64(failures))
+		interval = time.Duration(min(backoff, float64(maxBackoff)))
+	}
+	if jitter > 0 {
+		interval += time.Duration(float64(interval) * jitter * (2*random - 1))
+	}
+	return interval
+}
+
+// validateHealthCheckConfig checks the health check jitter, backoff and passive settings and that expected body regexes compile
+func validateHealthCheckConfig(config *HealthCheckConfig) error {
+	if config.Jitter < 0 || config.Jitter >= 1 {
+		return fmt.Errorf("%w, got %v", ErrInvalidHealthCheckJitter, config.Jitter)
+	}
+	if config.BackoffMultiplier != 0 && config.BackoffMultiplier < 1 {
+		return fmt.Errorf("%w, got %v", ErrInvalidHealthCheckBackoff, config.BackoffMultiplier)
+	}
+	if config.ExpectedBodyRegex != "" {
+		if _, err := compilePattern(config.ExpectedBodyRegex, ErrInvalidHealthBodyPattern); err != nil {
+			return err
+		}
+	}
+	if config.Passive.ErrorRateThreshold < 0 || config.Passive.ErrorRateThreshold > 1 {
+		return fmt.Errorf("%w, got %v", ErrInvalidPassiveErrorRate, config.Passive.ErrorRateThreshold)
+	}
+	for backendID, backendConfig := range config.BackendHealthCheckConfig {
+		if backendConfig.ExpectedBodyRegex != "" {
+			if _, err := compilePattern(backendConfig.ExpectedBodyRegex, ErrInvalidHealthBodyPattern); err != nil {
+				return fmt.Errorf("backend %s: %w", backendID, err)
+			}
+		}
+	}
+	return nil
+}
+
 // getBackendTimeout returns the health check timeout for a backend.
 func (hc *HealthChecker) getBackendTimeout(backendID string) time.Duration {
 	hc.configMutex.RLock()
@@ -670,6 +804,18 @@ func (hc *HealthChecker) getExpectedStatusCodes(backendID string) []int {
 	return []int{200}
 }
 
+// getExpectedBodyRegex returns the expected body regex for a backend, or "" when the body is not checked.
+func (hc *HealthChecker) getExpectedBodyRegex(backendID string) string {
+	hc.configMutex.RLock()
+	backendHealthCfg := hc.backendHealthCheckConfig
+	pattern := hc.expectedBodyRegex
+	hc.configMutex.RUnlock()
+	if backendConfig, exists := backendHealthCfg[backendID]; exists && backendConfig.ExpectedBodyRegex != "" {
+		return backendConfig.ExpectedBodyRegex
+	}
+	return pattern
+}
+
 // isBackendHealthCheckEnabled returns whether health checking is enabled for a backend.
 func (hc *HealthChecker) isBackendHealthCheckEnabled(backendID string) bool {
 	hc.configMutex.RLock()
@@ -694,6 +840,7 @@ func (hc *HealthChecker) UpdateBackends(ctx context.Context, backends map[string
 	for backendID := range hc.healthStatus {
 		if _, exists := cloned[backendID]; !exists {
 			delete(hc.healthStatus, backendID)
+			delete(hc.passiveWindows, backendID)
 			hc.logger.DebugContext(ctx, "Removed health status for backend", "backend", backendID)
 		}
 	}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/http/httputil"
 	"os"
 	"strings"
 	"testing"
@@ -716,3 +717,278 @@ func TestModule_HealthCheckDisabled(t *testing.T) {
 	err = module.Stop(ctx)
 	assert.NoError(t, err)
 }
+
+// TestHealthChecker_IntervalJitter tests that jitter spreads intervals within bounds
+func TestHealthChecker_IntervalJitter(t *testing.T) {
+	config := &HealthCheckConfig{
+		Enabled:  true,
+		Interval: 10 * time.Second,
+		Jitter:   0.2,
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	hc := NewHealthChecker(config, map[string]string{"backend1": "http://127.0.0.1:9003"}, http.DefaultClient, logger)
+	hc.initializeBackendStatus("backend1", "http://127.0.0.1:9003")
+
+	seen := make(map[time.Duration]bool)
+	for range 200 {
+		interval := hc.nextCheckInterval("backend1")
+		assert.GreaterOrEqual(t, interval, 8*time.Second)
+		assert.LessOrEqual(t, interval, 12*time.Second)
+		seen[interval] = true
+	}
+	assert.Greater(t, len(seen), 1, "intervals should vary")
+
+	// The bounds are reached at the extremes of the random value
+	assert.Equal(t, 8*time.Second, healthCheckInterval(10*time.Second, 0, 2, 0, 0.2, 0))
+	assert.Equal(t, 10*time.Second, healthCheckInterval(10*time.Second, 0, 2, 0, 0.2, 0.5))
+
+	// Without jitter the interval is fixed
+	config.Jitter = 0
+	assert.Equal(t, 10*time.Second, hc.nextCheckInterval("backend1"))
+}
+
+// TestHealthChecker_BackoffForFailingBackend tests that a down backend is polled
+// less often up to the cap, and at the normal interval again once it recovers
+func TestHealthChecker_BackoffForFailingBackend(t *testing.T) {
+	healthy := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if healthy {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	config := &HealthCheckConfig{
+		Enabled:             true,
+		Interval:            time.Second,
+		Timeout:             time.Second,
+		ExpectedStatusCodes: []int{http.StatusOK},
+		BackoffMultiplier:   2,
+		MaxBackoffInterval:  5 * time.Second,
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	hc := NewHealthChecker(config, map[string]string{"backend1": server.URL}, server.Client(), logger)
+	hc.initializeBackendStatus("backend1", server.URL)
+
+	ctx := context.Background()
+	var intervals []time.Duration
+	for range 5 {
+		hc.performHealthCheck(ctx, "backend1", server.URL)
+		intervals = append(intervals, hc.nextCheckInterval("backend1"))
+	}
+	assert.Equal(t, []time.Duration{2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second, 5 * time.Second}, intervals)
+
+	status, _ := hc.GetBackendHealthStatus("backend1")
+	assert.Equal(t, int64(5), status.ConsecutiveFailures)
+
+	// The first passing check restores the normal interval
+	healthy = true
+	hc.performHealthCheck(ctx, "backend1", server.URL)
+	assert.Equal(t, time.Second, hc.nextCheckInterval("backend1"))
+
+	// Backoff is disabled when the cap is not above the interval
+	config.MaxBackoffInterval = 0
+	assert.Equal(t, time.Second, healthCheckInterval(time.Second, 3, 2, 0, 0, 0))
+}
+
+// TestValidateHealthCheckConfig tests validation of jitter, backoff, expected body and passive settings
+func TestValidateHealthCheckConfig(t *testing.T) {
+	require.NoError(t, validateHealthCheckConfig(&HealthCheckConfig{Jitter: 0.5, BackoffMultiplier: 1.5}))
+	require.NoError(t, validateHealthCheckConfig(&HealthCheckConfig{}))
+	require.ErrorIs(t, validateHealthCheckConfig(&HealthCheckConfig{Jitter: 1}), ErrInvalidHealthCheckJitter)
+	require.ErrorIs(t, validateHealthCheckConfig(&HealthCheckConfig{Jitter: -0.1}), ErrInvalidHealthCheckJitter)
+	require.ErrorIs(t, validateHealthCheckConfig(&HealthCheckConfig{BackoffMultiplier: 0.5}), ErrInvalidHealthCheckBackoff)
+	require.ErrorIs(t, validateHealthCheckConfig(&HealthCheckConfig{ExpectedBodyRegex: "("}), ErrInvalidHealthBodyPattern)
+	require.ErrorIs(t, validateHealthCheckConfig(&HealthCheckConfig{
+		BackendHealthCheckConfig: map[string]BackendHealthConfig{"api": {ExpectedBodyRegex: "["}},
+	}), ErrInvalidHealthBodyPattern)
+	require.ErrorIs(t, validateHealthCheckConfig(&HealthCheckConfig{
+		Passive: PassiveHealthCheckConfig{Enabled: true, ErrorRateThreshold: 1.5},
+	}), ErrInvalidPassiveErrorRate)
+}
+
+// TestHealthChecker_ExpectedBodyRegex tests health determined by the expected status codes and body regex
+func TestHealthChecker_ExpectedBodyRegex(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			_, _ = w.Write([]byte(`{"status":"ok"}`))
+		case "/degraded":
+			_, _ = w.Write([]byte(`{"status":"degraded"}`))
+		case "/binary":
+			_, _ = w.Write([]byte{0xff, 0xfe, 0xfd})
+		case "/accepted":
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"status":"ok"}`))
+		case "/stalled":
+			// Send the headers, then stall the body past the check timeout
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}
+	}))
+	defer server.Close()
+
+	config := &HealthCheckConfig{
+		Enabled:             true,
+		Timeout:             200 * time.Millisecond,
+		ExpectedStatusCodes: []int{200},
+		ExpectedBodyRegex:   `"status":\s*"ok"`,
+		BackendHealthCheckConfig: map[string]BackendHealthConfig{
+			"accepted": {Enabled: true, ExpectedStatusCodes: []int{202}},
+			"degraded": {Enabled: true, ExpectedBodyRegex: `"status":"(ok|degraded)"`},
+		},
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	hc := NewHealthChecker(config, map[string]string{}, server.Client(), logger)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		backendID string
+		path      string
+		wantErr   error
+	}{
+		{"matching body", "api", "/ok", nil},
+		{"degraded body", "api", "/degraded", ErrUnexpectedHealthBody},
+		{"per-backend body regex", "degraded", "/degraded", nil},
+		{"unparseable body", "api", "/binary", ErrUnreadableHealthBody},
+		{"unexpected status", "api", "/accepted", ErrUnexpectedStatusCode},
+		{"per-backend status", "accepted", "/accepted", nil},
+		{"body read timeout", "api", "/stalled", ErrUnreadableHealthBody},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			healthy, _, err := hc.performHTTPCheck(ctx, tt.backendID, server.URL+tt.path)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				assert.True(t, healthy)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.False(t, healthy)
+		})
+	}
+}
+
+// TestHealthChecker_PassiveErrorRate tests that a burst of failed live requests marks a backend
+// unhealthy between active checks and that it recovers once requests succeed again
+func TestHealthChecker_PassiveErrorRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := &HealthCheckConfig{
+		Enabled:             true,
+		Timeout:             time.Second,
+		ExpectedStatusCodes: []int{200},
+		Passive: PassiveHealthCheckConfig{
+			Enabled:            true,
+			Window:             time.Minute,
+			ErrorRateThreshold: 0.5,
+			MinRequests:        5,
+		},
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	hc := NewHealthChecker(config, map[string]string{"api": server.URL}, server.Client(), logger)
+	var events []string
+	hc.SetEventEmitter(func(eventType string, data map[string]interface{}) {
+		events = append(events, eventType)
+	})
+	module := &ReverseProxyModule{healthChecker: hc}
+
+	hc.initializeBackendStatus("api", server.URL)
+	hc.performHealthCheck(context.Background(), "api", server.URL)
+	status, _ := hc.GetBackendHealthStatus("api")
+	require.True(t, status.Healthy)
+	events = nil
+
+	// A burst of 5xx responses flips the backend to unhealthy; client errors do not count
+	module.emitRequestCompleted(context.Background(), EventTypeRequestFailed, map[string]interface{}{"backend": "api", "status": http.StatusNotFound})
+	for i := 0; i < 5; i++ {
+		module.emitRequestCompleted(context.Background(), EventTypeRequestFailed, map[string]interface{}{"backend": "api", "status": http.StatusBadGateway})
+	}
+	status, _ = hc.GetBackendHealthStatus("api")
+	assert.False(t, status.Healthy)
+	assert.True(t, status.PassiveUnhealthy)
+	assert.True(t, status.HealthCheckPassing, "the active check result is kept")
+	assert.InDelta(t, 5.0/6.0, status.PassiveErrorRate, 0.001)
+	assert.Equal(t, []string{EventTypeBackendUnhealthy}, events)
+
+	// Successes bring the error rate back under the threshold
+	for i := 0; i < 5; i++ {
+		module.emitRequestCompleted(context.Background(), EventTypeRequestProxied, map[string]interface{}{"backend": "api", "status": http.StatusOK})
+	}
+	status, _ = hc.GetBackendHealthStatus("api")
+	assert.True(t, status.Healthy)
+	assert.False(t, status.PassiveUnhealthy)
+	assert.Equal(t, []string{EventTypeBackendUnhealthy, EventTypeBackendHealthy}, events)
+}
+
+// TestPassiveHealthWindow tests that outcomes age out of the sliding window
+func TestPassiveHealthWindow(t *testing.T) {
+	var w passiveHealthWindow
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		w.record(start, 10*time.Second, true)
+	}
+	w.record(start.Add(5*time.Second), 10*time.Second, false)
+
+	requests, failures := w.counts(start.Add(5*time.Second), 10*time.Second)
+	assert.Equal(t, int64(5), requests)
+	assert.Equal(t, int64(4), failures)
+
+	requests, failures = w.counts(start.Add(12*time.Second), 10*time.Second)
+	assert.Equal(t, int64(1), requests)
+	assert.Equal(t, int64(0), failures)
+}
+
+// TestPassiveHealth_IgnoresCircuitBreakerRejections tests that the 503 returned while a circuit
+// breaker is open is not counted against the backend's passive error rate
+func TestPassiveHealth_IgnoresCircuitBreakerRejections(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := &HealthCheckConfig{
+		Enabled: true,
+		Timeout: time.Second,
+		Passive: PassiveHealthCheckConfig{
+			Enabled:            true,
+			Window:             time.Minute,
+			ErrorRateThreshold: 0.5,
+			MinRequests:        1,
+		},
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	hc := NewHealthChecker(config, map[string]string{"api": server.URL}, server.Client(), logger)
+	hc.initializeBackendStatus("api", server.URL)
+
+	cb := NewCircuitBreakerWithConfig("api", CircuitBreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute}, nil)
+	cb.RecordFailure()
+	require.True(t, cb.IsOpen())
+
+	module := &ReverseProxyModule{
+		config:          &ReverseProxyConfig{CircuitBreakerConfig: CircuitBreakerConfig{Enabled: true}},
+		healthChecker:   hc,
+		circuitBreakers: map[string]*CircuitBreaker{"api": cb},
+		backendProxies:  map[string]*httputil.ReverseProxy{"api": {}},
+	}
+	handler := module.createBackendProxyHandlerForTenant("tenant1", "api")
+	for i := 0; i < 3; i++ {
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(http.MethodGet, "/api/test", nil))
+		require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	}
+
+	status, _ := hc.GetBackendHealthStatus("api")
+	assert.False(t, status.PassiveUnhealthy)
+	assert.Zero(t, status.PassiveErrorRate)
+}
@@ -0,0 +1,139 @@
+package reverseproxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+)
+
+// DefaultMirrorMaxBodySize is the largest request body buffered for mirroring
+// when MirrorConfig.MaxBodySize is not set
+const DefaultMirrorMaxBodySize = 1 << 20
+
+// validateMirrorConfigs checks that every route mirror targets a configured
+// backend with a sample rate between 0 and 1.
+func validateMirrorConfigs(config *ReverseProxyConfig) error {
+	for routePath, routeConfig := range config.RouteConfigs {
+		mirror := routeConfig.Mirror
+		if mirror.Backend == "" {
+			continue
+		}
+		if _, exists := config.BackendServices[mirror.Backend]; !exists {
+			return fmt.Errorf("route '%s' mirror: %w: %s", routePath, ErrBackendNotFound, mirror.Backend)
+		}
+		if mirror.SampleRate < 0 || mirror.SampleRate > 1 {
+			return fmt.Errorf("route '%s': %w, got %v", routePath, ErrInvalidMirrorSampleRate, mirror.SampleRate)
+		}
+		if mirror.MaxBodySize < 0 {
+			return fmt.Errorf("route '%s': %w, got %d", routePath, ErrInvalidMirrorBodySize, mirror.MaxBodySize)
+		}
+	}
+	return nil
+}
+
+// mirrorRequest sends a copy of r to the route's mirror backend in the
+// background if the request is sampled. The response is discarded and
+// failures are only logged. When the body is mirrored it is buffered, up to
+// MaxBodySize, and r.Body is replaced so the primary backend still reads it in
+// full. Requests whose body exceeds MaxBodySize are not mirrored.
+func (m *ReverseProxyModule) mirrorRequest(r *http.Request, config *ReverseProxyConfig, mirror MirrorConfig) {
+	if mirror.Backend == "" || mirror.SampleRate <= 0 || rand.Float64() >= mirror.SampleRate { //nolint:gosec // sampling does not need a secure source
+		return
+	}
+	backendURL := config.BackendServices[mirror.Backend]
+	if backendURL == "" {
+		if m.app != nil && m.app.Logger() != nil {
+			m.app.Logger().Warn("Mirror backend has no URL, skipping mirrored request", "backend", mirror.Backend)
+		}
+		return
+	}
+
+	var body []byte
+	if mirror.IncludeBody && r.Body != nil && r.Body != http.NoBody {
+		maxBodySize := mirror.MaxBodySize
+		if maxBodySize == 0 {
+			maxBodySize = DefaultMirrorMaxBodySize
+		}
+		var err error
+		body, err = io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+		// On a read error or an oversized body the primary backend sees what
+		// was read followed by the rest of the original body, and nothing is
+		// mirrored
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+		if err != nil {
+			if m.app != nil && m.app.Logger() != nil {
+				m.app.Logger().Warn("Failed to buffer request body for mirroring", "backend", mirror.Backend, "error", err)
+			}
+			return
+		}
+		if int64(len(body)) > maxBodySize {
+			if m.app != nil && m.app.Logger() != nil {
+				m.app.Logger().Debug("Request body too large to mirror, skipping mirrored request", "backend", mirror.Backend, "max_body_size", maxBodySize)
+			}
+			return
+		}
+	}
+
+	target := singleJoiningSlash(backendURL, r.URL.Path)
+	if r.URL.RawQuery != "" {
+		target += "?" + r.URL.RawQuery
+	}
+	header := r.Header.Clone()
+	method := r.Method
+	ctx := context.WithoutCancel(r.Context())
+
+	go func() {
+		defer func() {
+			if rec := recover(); rec != nil && m.app != nil && m.app.Logger() != nil {
+				m.app.Logger().Error("Mirrored request panicked", "backend", mirror.Backend, "panic", rec)
+			}
+		}()
+		m.sendMirroredRequest(ctx, method, target, header, body, mirror.Backend)
+	}()
+}
+
+// sendMirroredRequest makes a mirrored request and discards the response
+func (m *ReverseProxyModule) sendMirroredRequest(ctx context.Context, method, target string, header http.Header, body []byte, backendID string) {
+	ctx, cancel := context.WithTimeout(ctx, m.config.RequestTimeout)
+	defer cancel()
+
+	var bodyReader io.Reader
+	if len(body) > 0 {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, bodyReader) //nolint:gosec // G704: target is built from a configured backend address
+	if err != nil {
+		m.logMirrorFailure(backendID, err)
+		return
+	}
+	req.Header = header
+
+	client := m.httpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req) //nolint:gosec // G704: mirroring intentionally calls the configured backend
+	if err != nil {
+		m.logMirrorFailure(backendID, err)
+		return
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	if m.app != nil && m.app.Logger() != nil {
+		m.app.Logger().Debug("Mirrored request completed", "backend", backendID, "method", method, "status", resp.StatusCode)
+	}
+}
+
+// logMirrorFailure logs a mirrored request that could not be sent
+func (m *ReverseProxyModule) logMirrorFailure(backendID string, err error) {
+	if m.app != nil && m.app.Logger() != nil {
+		m.app.Logger().Warn("Mirrored request failed", "backend", backendID, "error", err)
+	}
+}
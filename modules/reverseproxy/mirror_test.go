@@ -0,0 +1,221 @@
+package reverseproxy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMirrorTestHandler starts a module routing /api/orders to the primary
+// backend and mirroring it to the mirror backend
+func newMirrorTestHandler(t *testing.T, primaryURL, mirrorURL string, mirror MirrorConfig) http.HandlerFunc {
+	t.Helper()
+	mirror.Backend = "mirror"
+	handler, _ := startRouteTestModule(t, "/api/orders", &ReverseProxyConfig{
+		BackendServices: map[string]string{
+			"primary": primaryURL,
+			"mirror":  mirrorURL,
+		},
+		Routes: map[string]string{
+			"/api/orders": "primary",
+		},
+		RouteConfigs: map[string]RouteConfig{
+			"/api/orders": {Mirror: mirror},
+		},
+		DefaultBackend: "primary",
+	})
+	return handler
+}
+
+func TestMirror_SampleRate(t *testing.T) {
+	var primaryCalls atomic.Int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryCalls.Add(1)
+		_, _ = w.Write([]byte("primary"))
+	}))
+	defer primary.Close()
+
+	var mirrorCalls atomic.Int32
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mirrorCalls.Add(1)
+	}))
+	defer mirror.Close()
+
+	tests := []struct {
+		rate     float64
+		min, max int32
+	}{
+		{rate: 0, min: 0, max: 0},
+		{rate: 0.25, min: 150, max: 350},
+		{rate: 1, min: 1000, max: 1000},
+	}
+	for _, tt := range tests {
+		primaryCalls.Store(0)
+		mirrorCalls.Store(0)
+		handler := newMirrorTestHandler(t, primary.URL, mirror.URL, MirrorConfig{SampleRate: tt.rate})
+
+		const requests = 1000
+		for range requests {
+			recorder := httptest.NewRecorder()
+			handler(recorder, httptest.NewRequest(http.MethodGet, "/api/orders", nil))
+			require.Equal(t, "primary", recorder.Body.String())
+		}
+		assert.Equal(t, int32(requests), primaryCalls.Load(), "every request reaches the primary backend")
+
+		// Wait for the mirrored requests to drain
+		last := int32(-1)
+		for last != mirrorCalls.Load() {
+			last = mirrorCalls.Load()
+			time.Sleep(50 * time.Millisecond)
+		}
+		assert.GreaterOrEqual(t, last, tt.min, "rate %v", tt.rate)
+		assert.LessOrEqual(t, last, tt.max, "rate %v", tt.rate)
+	}
+}
+
+func TestMirror_FailuresDoNotAffectPrimary(t *testing.T) {
+	var mu sync.Mutex
+	var primaryBody string
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		primaryBody = string(body)
+		mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"order-1"}`))
+	}))
+	defer primary.Close()
+
+	t.Run("unreachable mirror", func(t *testing.T) {
+		unreachable := httptest.NewServer(http.NotFoundHandler())
+		unreachable.Close()
+		handler := newMirrorTestHandler(t, primary.URL, unreachable.URL, MirrorConfig{SampleRate: 1, IncludeBody: true})
+
+		recorder := httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(http.MethodPost, "/api/orders", strings.NewReader(`{"item":"book"}`)))
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+		assert.Equal(t, `{"id":"order-1"}`, recorder.Body.String())
+		mu.Lock()
+		assert.Equal(t, `{"item":"book"}`, primaryBody, "the primary backend still receives the full body")
+		mu.Unlock()
+	})
+
+	t.Run("slow failing mirror", func(t *testing.T) {
+		release := make(chan struct{})
+		var mirroredBody atomic.Value
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mirroredBody.Store(string(body))
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer slow.Close()
+		defer close(release)
+		handler := newMirrorTestHandler(t, primary.URL, slow.URL, MirrorConfig{SampleRate: 1, IncludeBody: true})
+
+		start := time.Now()
+		recorder := httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(http.MethodPost, "/api/orders", strings.NewReader(`{"item":"pen"}`)))
+		assert.Less(t, time.Since(start), time.Second, "the client response should not wait for the mirror")
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+		mu.Lock()
+		assert.Equal(t, `{"item":"pen"}`, primaryBody)
+		mu.Unlock()
+
+		require.Eventually(t, func() bool { return mirroredBody.Load() != nil }, 2*time.Second, 10*time.Millisecond)
+		assert.Equal(t, `{"item":"pen"}`, mirroredBody.Load(), "the mirror receives the body when IncludeBody is set")
+	})
+}
+
+func TestMirror_BodyOmittedByDefault(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(w, r.Body)
+	}))
+	defer primary.Close()
+
+	mirrored := make(chan string, 1)
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mirrored <- r.Method + " " + r.URL.RequestURI() + " " + string(body)
+	}))
+	defer mirror.Close()
+
+	handler := newMirrorTestHandler(t, primary.URL, mirror.URL, MirrorConfig{SampleRate: 1})
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPut, "/api/orders?id=7", strings.NewReader("payload")))
+	assert.Equal(t, "payload", recorder.Body.String())
+
+	select {
+	case got := <-mirrored:
+		assert.Equal(t, "PUT /api/orders?id=7 ", got)
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected a mirrored request")
+	}
+}
+
+func TestMirror_OversizedBodyNotMirrored(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(w, r.Body)
+	}))
+	defer primary.Close()
+
+	mirrored := make(chan string, 2)
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mirrored <- string(body)
+	}))
+	defer mirror.Close()
+
+	handler := newMirrorTestHandler(t, primary.URL, mirror.URL, MirrorConfig{SampleRate: 1, IncludeBody: true, MaxBodySize: 8})
+
+	large := strings.Repeat("x", 32)
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/api/orders", strings.NewReader(large)))
+	assert.Equal(t, large, recorder.Body.String(), "the primary backend still receives the full body")
+
+	recorder = httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, "/api/orders", strings.NewReader("small")))
+	assert.Equal(t, "small", recorder.Body.String())
+
+	select {
+	case got := <-mirrored:
+		assert.Equal(t, "small", got, "only the body within the limit is mirrored")
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected a mirrored request")
+	}
+	select {
+	case got := <-mirrored:
+		t.Fatalf("unexpected mirrored request with body %q", got)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestValidateMirrorConfigs(t *testing.T) {
+	config := &ReverseProxyConfig{
+		BackendServices: map[string]string{"primary": "http://primary", "mirror": "http://mirror"},
+		RouteConfigs: map[string]RouteConfig{
+			"/api/orders": {Mirror: MirrorConfig{Backend: "mirror", SampleRate: 0.5}},
+		},
+	}
+	require.NoError(t, validateMirrorConfigs(config))
+
+	config.RouteConfigs["/api/orders"] = RouteConfig{Mirror: MirrorConfig{Backend: "missing", SampleRate: 0.5}}
+	assert.ErrorIs(t, validateMirrorConfigs(config), ErrBackendNotFound)
+
+	config.RouteConfigs["/api/orders"] = RouteConfig{Mirror: MirrorConfig{Backend: "mirror", SampleRate: 1.5}}
+	assert.ErrorIs(t, validateMirrorConfigs(config), ErrInvalidMirrorSampleRate)
+
+	config.RouteConfigs["/api/orders"] = RouteConfig{Mirror: MirrorConfig{Backend: "mirror", SampleRate: 0.5, MaxBodySize: -1}}
+	assert.ErrorIs(t, validateMirrorConfigs(config), ErrInvalidMirrorBodySize)
+}
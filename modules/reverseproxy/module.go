@@ -509,6 +509,32 @@ func (m *ReverseProxyModule) validateConfig() error {
 		return ErrTenantIDRequired
 	}
 
+	// Validate response header rewrite rules so bad patterns fail fast
+	for backendID, backendConfig := range m.config.BackendConfigs {
+		if err := validateHeaderRewrites(backendConfig.ResponseHeaderRewrites); err != nil {
+			return fmt.Errorf("backend '%s': %w", backendID, err)
+		}
+	}
+
+	// Validate path rewriting rules so bad patterns fail fast
+	if err := validatePathRewritingConfigs(m.config); err != nil {
+		return err
+	}
+
+	// Validate feature flag rollout percentages
+	if err := validateFeatureFlagRules(&m.config.FeatureFlags); err != nil {
+		return err
+	}
+
+	// Validate route mirrors reference known backends
+	if err := validateMirrorConfigs(m.config); err != nil {
+		return err
+	}
+
+	if err := validateHealthCheckConfig(&m.config.HealthCheck); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1218,9 +1244,12 @@ func (m *ReverseProxyModule) registerBasicRoutes() error {
 						resolvedBackendID = selected
 					}
 				}
-				// Check if this route has feature flag configuration
+				// Check if this route has feature flag or dry run configuration.
+				// A route without a feature flag evaluates as enabled.
 				if m.config.RouteConfigs != nil {
-					if routeConfig, ok := m.config.RouteConfigs[routePath]; ok && routeConfig.FeatureFlagID != "" {
+					if routeConfig, ok := m.config.RouteConfigs[routePath]; ok {
+						m.mirrorRequest(r, m.config, routeConfig.Mirror)
+						r = withRoutePathRewriting(r, routeConfig.pathRewriting())
 						if !m.evaluateFeatureFlag(routeConfig.FeatureFlagID, r) {
 							// Feature flag is disabled, use alternative backend
 							alternativeBackend := m.getAlternativeBackend(routeConfig.AlternativeBackend)
@@ -1524,6 +1553,22 @@ func TenantIDFromRequest(tenantHeader string, r *http.Request) (string, bool) {
 	return tenantIDStr, tenantIDStr != ""
 }
 
+// DefaultRequestIDHeader is the request ID header forwarded to backends when
+// ReverseProxyConfig.RequestIDHeader is not set
+const DefaultRequestIDHeader = "X-Request-ID"
+
+// forwardRequestID copies the incoming request ID to the outgoing request,
+// restoring it if header rewriting removed or replaced it
+func forwardRequestID(in, out *http.Request, config *ReverseProxyConfig) {
+	header := DefaultRequestIDHeader
+	if config != nil && config.RequestIDHeader != "" {
+		header = config.RequestIDHeader
+	}
+	if id := in.Header.Get(header); id != "" {
+		out.Header.Set(header, id)
+	}
+}
+
 // GetConfig returns the module's configuration.
 func (m *ReverseProxyModule) GetConfig() *ReverseProxyConfig {
 	return m.config
@@ -1656,8 +1701,12 @@ func (m *ReverseProxyModule) createReverseProxyForBackend(ctx context.Context, t
 			config = m.config
 		}
 
-		// Apply path rewriting if configured
-		rewrittenPath := m.applyPathRewritingForBackend(pr.In.URL.Path, config, backendID, endpoint)
+		// Apply path rewriting if configured: the matched route's rules first, then the backend's
+		rewrittenPath := pr.In.URL.Path
+		if routeRewriting, ok := routePathRewritingFromContext(pr.In.Context()); ok {
+			rewrittenPath = m.applySpecificPathRewriting(rewrittenPath, routeRewriting)
+		}
+		rewrittenPath = m.applyPathRewritingForBackend(rewrittenPath, config, backendID, endpoint)
 
 		// Set up the request URL
 		req.URL.Scheme = originalTarget.Scheme
@@ -1673,6 +1722,7 @@ func (m *ReverseProxyModule) createReverseProxyForBackend(ctx context.Context, t
 
 		// Apply header rewriting
 		m.applyHeaderRewritingForBackend(req, config, backendID, endpoint, &originalTarget)
+		forwardRequestID(pr.In, req, config)
 
 		// Preserve X-Forwarded-* headers
 		pr.SetXForwarded()
@@ -2134,6 +2184,12 @@ func (m *ReverseProxyModule) applySpecificPathRewriting(originalPath string, con
 		}
 	}
 
+	// Apply regex rewrites, then trailing slash handling
+	rewrittenPath = m.applyRegexRewrites(rewrittenPath, config.RegexRewrites)
+	if rewrittenPath != originalPath || config.TrailingSlash != "" {
+		rewrittenPath = normalizeRewrittenPath(rewrittenPath, TrailingSlashPolicy(config.TrailingSlash))
+	}
+
 	return rewrittenPath
 }
 
@@ -2220,6 +2276,9 @@ func (m *ReverseProxyModule) applyResponseHeaderRewritingForBackend(resp *http.R
 			// Apply backend-specific response header rewriting
 			m.applySpecificResponseHeaderRewriting(resp, &backendConfig.ResponseHeaderRewriting)
 
+			// Apply backend-specific regex rewrites to header values
+			m.applyResponseHeaderRewrites(resp, backendID, backendConfig.ResponseHeaderRewrites)
+
 			// Then check for endpoint-specific configuration
 			if endpoint != "" && backendConfig.Endpoints != nil {
 				if endpointConfig, exists := backendConfig.Endpoints[endpoint]; exists {
@@ -2393,6 +2452,7 @@ func (w *bufferingResponseWriter) flushTo(target http.ResponseWriter) error {
 // to a specific backend, with support for tenant-specific backends and feature flag evaluation
 func (m *ReverseProxyModule) createBackendProxyHandler(backend string) http.HandlerFunc {
 	handler := func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
 		// Emit request received event
 		m.emitEvent(r.Context(), EventTypeRequestReceived, map[string]interface{}{
 			"backend":     backend,
@@ -2631,11 +2691,13 @@ func (m *ReverseProxyModule) createBackendProxyHandler(backend string) http.Hand
 
 				if contextCancelled || timeoutError {
 					// Context was cancelled (timeout occurred) - treat as timeout regardless of backend response
-					m.emitEvent(r.Context(), EventTypeRequestFailed, map[string]interface{}{
-						"backend": backend,
-						"method":  r.Method,
-						"path":    r.URL.Path,
-						"error":   "request timeout",
+					m.emitRequestCompleted(r.Context(), EventTypeRequestFailed, map[string]interface{}{
+						"backend":          backend,
+						"method":           r.Method,
+						"path":             r.URL.Path,
+						"error":            "request timeout",
+						"status":           http.StatusGatewayTimeout,
+						"duration_seconds": time.Since(start).Seconds(),
 					})
 
 					// Use thread-safe timeout response handling
@@ -2681,12 +2743,13 @@ func (m *ReverseProxyModule) createBackendProxyHandler(backend string) http.Hand
 					// Check if this is a backend error status that should be passed through
 					if errors.Is(cbErr, ErrBackendErrorStatus) && sw != nil {
 						// Backend returned an error status - pass through the original response
-						m.emitEvent(r.Context(), EventTypeRequestFailed, map[string]interface{}{
-							"backend": backend,
-							"method":  r.Method,
-							"path":    r.URL.Path,
-							"status":  sw.status,
-							"error":   cbErr.Error(),
+						m.emitRequestCompleted(r.Context(), EventTypeRequestFailed, map[string]interface{}{
+							"backend":          backend,
+							"method":           r.Method,
+							"path":             r.URL.Path,
+							"status":           sw.status,
+							"error":            cbErr.Error(),
+							"duration_seconds": time.Since(start).Seconds(),
 						})
 						// Flush the buffered backend response (with original error status)
 						if bufWriter, ok := sw.ResponseWriter.(*bufferingResponseWriter); ok {
@@ -2698,12 +2761,13 @@ func (m *ReverseProxyModule) createBackendProxyHandler(backend string) http.Hand
 					}
 					// Some other error occurred (connection failure, etc.) - emit failed event before returning
 					if sw != nil {
-						m.emitEvent(r.Context(), EventTypeRequestFailed, map[string]interface{}{
-							"backend": backend,
-							"method":  r.Method,
-							"path":    r.URL.Path,
-							"status":  sw.status,
-							"error":   cbErr.Error(),
+						m.emitRequestCompleted(r.Context(), EventTypeRequestFailed, map[string]interface{}{
+							"backend":          backend,
+							"method":           r.Method,
+							"path":             r.URL.Path,
+							"status":           sw.status,
+							"error":            cbErr.Error(),
+							"duration_seconds": time.Since(start).Seconds(),
 						})
 					}
 					// Only write error response if headers haven't been written yet
@@ -2724,11 +2788,13 @@ func (m *ReverseProxyModule) createBackendProxyHandler(backend string) http.Hand
 			case <-r.Context().Done():
 				// Request timed out
 				// Emit request failed event for timeout
-				m.emitEvent(r.Context(), EventTypeRequestFailed, map[string]interface{}{
-					"backend": backend,
-					"method":  r.Method,
-					"path":    r.URL.Path,
-					"error":   "request timeout",
+				m.emitRequestCompleted(r.Context(), EventTypeRequestFailed, map[string]interface{}{
+					"backend":          backend,
+					"method":           r.Method,
+					"path":             r.URL.Path,
+					"error":            "request timeout",
+					"status":           http.StatusGatewayTimeout,
+					"duration_seconds": time.Since(start).Seconds(),
 				})
 				// Since we used a buffering response writer, write timeout response through buffer
 				// This is safe because bufferingResponseWriter doesn't write to actual response yet
@@ -2742,19 +2808,21 @@ func (m *ReverseProxyModule) createBackendProxyHandler(backend string) http.Hand
 			// Emit success or failure event based on status code
 			if sw != nil {
 				if sw.status >= 400 {
-					m.emitEvent(r.Context(), EventTypeRequestFailed, map[string]interface{}{
-						"backend": backend,
-						"method":  r.Method,
-						"path":    r.URL.Path,
-						"status":  sw.status,
-						"error":   fmt.Sprintf("upstream returned status %d", sw.status),
+					m.emitRequestCompleted(r.Context(), EventTypeRequestFailed, map[string]interface{}{
+						"backend":          backend,
+						"method":           r.Method,
+						"path":             r.URL.Path,
+						"status":           sw.status,
+						"error":            fmt.Sprintf("upstream returned status %d", sw.status),
+						"duration_seconds": time.Since(start).Seconds(),
 					})
 				} else {
-					m.emitEvent(r.Context(), EventTypeRequestProxied, map[string]interface{}{
-						"backend": backend,
-						"method":  r.Method,
-						"path":    r.URL.Path,
-						"status":  sw.status,
+					m.emitRequestCompleted(r.Context(), EventTypeRequestProxied, map[string]interface{}{
+						"backend":          backend,
+						"method":           r.Method,
+						"path":             r.URL.Path,
+						"status":           sw.status,
+						"duration_seconds": time.Since(start).Seconds(),
 					})
 				}
 			}
@@ -2834,11 +2902,13 @@ func (m *ReverseProxyModule) createBackendProxyHandler(backend string) http.Hand
 			case <-r.Context().Done():
 				// Request timed out
 				// Emit request failed event for timeout
-				m.emitEvent(r.Context(), EventTypeRequestFailed, map[string]interface{}{
-					"backend": backend,
-					"method":  r.Method,
-					"path":    r.URL.Path,
-					"error":   "request timeout",
+				m.emitRequestCompleted(r.Context(), EventTypeRequestFailed, map[string]interface{}{
+					"backend":          backend,
+					"method":           r.Method,
+					"path":             r.URL.Path,
+					"error":            "request timeout",
+					"status":           http.StatusGatewayTimeout,
+					"duration_seconds": time.Since(start).Seconds(),
 				})
 
 				// Use thread-safe access to status writer
@@ -2879,19 +2949,21 @@ func (m *ReverseProxyModule) createBackendProxyHandler(backend string) http.Hand
 				localSW.mu.Unlock()
 
 				if status >= 400 {
-					m.emitEvent(r.Context(), EventTypeRequestFailed, map[string]interface{}{
-						"backend": backend,
-						"method":  r.Method,
-						"path":    r.URL.Path,
-						"status":  status,
-						"error":   fmt.Sprintf("upstream returned status %d", status),
+					m.emitRequestCompleted(r.Context(), EventTypeRequestFailed, map[string]interface{}{
+						"backend":          backend,
+						"method":           r.Method,
+						"path":             r.URL.Path,
+						"status":           status,
+						"error":            fmt.Sprintf("upstream returned status %d", status),
+						"duration_seconds": time.Since(start).Seconds(),
 					})
 				} else {
-					m.emitEvent(r.Context(), EventTypeRequestProxied, map[string]interface{}{
-						"backend": backend,
-						"method":  r.Method,
-						"path":    r.URL.Path,
-						"status":  status,
+					m.emitRequestCompleted(r.Context(), EventTypeRequestProxied, map[string]interface{}{
+						"backend":          backend,
+						"method":           r.Method,
+						"path":             r.URL.Path,
+						"status":           status,
+						"duration_seconds": time.Since(start).Seconds(),
 					})
 				}
 			}
@@ -2942,6 +3014,7 @@ func (m *ReverseProxyModule) createBackendProxyHandlerForTenant(tenantID modular
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
 		// Emit request received event (tenant-aware)
 		m.emitEvent(r.Context(), EventTypeRequestReceived, map[string]interface{}{
 			"backend": backend,
@@ -3056,26 +3129,29 @@ func (m *ReverseProxyModule) createBackendProxyHandlerForTenant(tenantID modular
 						m.app.Logger().Error("Failed to write circuit breaker response", "error", err)
 					}
 				}
-				// Emit failed event for tenant path when circuit is open
+				// Emit failed event for tenant path when circuit is open. The 503 comes from the circuit
+				// breaker rather than the backend, so it is not recorded for passive health checking.
 				m.emitEvent(ctx, EventTypeRequestFailed, map[string]interface{}{
-					"backend": backend,
-					"method":  r.Method,
-					"path":    r.URL.Path,
-					"tenant":  string(tenantID),
-					"status":  http.StatusServiceUnavailable,
-					"error":   "circuit open",
+					"backend":          backend,
+					"method":           r.Method,
+					"path":             r.URL.Path,
+					"tenant":           string(tenantID),
+					"status":           http.StatusServiceUnavailable,
+					"error":            "circuit open",
+					"duration_seconds": time.Since(start).Seconds(),
 				})
 				return
 			} else if err != nil {
 				// Some other error occurred
 				http.Error(w, "Internal Server Error", http.StatusInternalServerError)
-				m.emitEvent(ctx, EventTypeRequestFailed, map[string]interface{}{
-					"backend": backend,
-					"method":  r.Method,
-					"path":    r.URL.Path,
-					"tenant":  string(tenantID),
-					"status":  http.StatusInternalServerError,
-					"error":   err.Error(),
+				m.emitRequestCompleted(ctx, EventTypeRequestFailed, map[string]interface{}{
+					"backend":          backend,
+					"method":           r.Method,
+					"path":             r.URL.Path,
+					"tenant":           string(tenantID),
+					"status":           http.StatusInternalServerError,
+					"error":            err.Error(),
+					"duration_seconds": time.Since(start).Seconds(),
 				})
 				return
 			}
@@ -3094,21 +3170,23 @@ func (m *ReverseProxyModule) createBackendProxyHandlerForTenant(tenantID modular
 
 			// Emit event based on response status
 			if resp.StatusCode >= 400 {
-				m.emitEvent(ctx, EventTypeRequestFailed, map[string]interface{}{
-					"backend": backend,
-					"method":  r.Method,
-					"path":    r.URL.Path,
-					"tenant":  string(tenantID),
-					"status":  resp.StatusCode,
-					"error":   fmt.Sprintf("upstream returned status %d", resp.StatusCode),
+				m.emitRequestCompleted(ctx, EventTypeRequestFailed, map[string]interface{}{
+					"backend":          backend,
+					"method":           r.Method,
+					"path":             r.URL.Path,
+					"tenant":           string(tenantID),
+					"status":           resp.StatusCode,
+					"error":            fmt.Sprintf("upstream returned status %d", resp.StatusCode),
+					"duration_seconds": time.Since(start).Seconds(),
 				})
 			} else {
-				m.emitEvent(ctx, EventTypeRequestProxied, map[string]interface{}{
-					"backend": backend,
-					"method":  r.Method,
-					"path":    r.URL.Path,
-					"tenant":  string(tenantID),
-					"status":  resp.StatusCode,
+				m.emitRequestCompleted(ctx, EventTypeRequestProxied, map[string]interface{}{
+					"backend":          backend,
+					"method":           r.Method,
+					"path":             r.URL.Path,
+					"tenant":           string(tenantID),
+					"status":           resp.StatusCode,
+					"duration_seconds": time.Since(start).Seconds(),
 				})
 			}
 		} else {
@@ -3118,21 +3196,23 @@ func (m *ReverseProxyModule) createBackendProxyHandlerForTenant(tenantID modular
 
 			// Emit success or failure event based on status code
 			if sw.status >= 400 {
-				m.emitEvent(ctx, EventTypeRequestFailed, map[string]interface{}{
-					"backend": backend,
-					"method":  r.Method,
-					"path":    r.URL.Path,
-					"tenant":  string(tenantID),
-					"status":  sw.status,
-					"error":   fmt.Sprintf("upstream returned status %d", sw.status),
+				m.emitRequestCompleted(ctx, EventTypeRequestFailed, map[string]interface{}{
+					"backend":          backend,
+					"method":           r.Method,
+					"path":             r.URL.Path,
+					"tenant":           string(tenantID),
+					"status":           sw.status,
+					"error":            fmt.Sprintf("upstream returned status %d", sw.status),
+					"duration_seconds": time.Since(start).Seconds(),
 				})
 			} else {
-				m.emitEvent(ctx, EventTypeRequestProxied, map[string]interface{}{
-					"backend": backend,
-					"method":  r.Method,
-					"path":    r.URL.Path,
-					"tenant":  string(tenantID),
-					"status":  sw.status,
+				m.emitRequestCompleted(ctx, EventTypeRequestProxied, map[string]interface{}{
+					"backend":          backend,
+					"method":           r.Method,
+					"path":             r.URL.Path,
+					"tenant":           string(tenantID),
+					"status":           sw.status,
+					"duration_seconds": time.Since(start).Seconds(),
 				})
 			}
 		}
@@ -3720,6 +3800,9 @@ func (m *ReverseProxyModule) createTenantAwareHandler(path string) http.HandlerF
 		// First priority: Check route configs with feature flag evaluation
 		if effectiveConfig.RouteConfigs != nil {
 			if routeConfig, ok := effectiveConfig.RouteConfigs[path]; ok {
+				m.mirrorRequest(r, effectiveConfig, routeConfig.Mirror)
+				r = withRoutePathRewriting(r, routeConfig.pathRewriting())
+
 				// Get the primary backend from the static routes
 				if primaryBackend, routeExists := effectiveConfig.Routes[path]; routeExists {
 					// Evaluate feature flag to determine which backend to use
@@ -4323,7 +4406,9 @@ func (m *ReverseProxyModule) handleDryRunRequest(ctx context.Context, w http.Res
 	}
 
 	// Send request to the return backend and capture response
+	returnStart := time.Now()
 	returnHandler(recorder, returnRequest)
+	returnedResponse := m.dryRunHandler.NewResponseInfo(recorder.Code, recorder.Header(), recorder.Body.Bytes(), time.Since(returnStart))
 
 	// Emit request processed event for successful dry run processing
 	m.emitEvent(ctx, EventTypeRequestProcessed, map[string]interface{}{
@@ -4344,8 +4429,10 @@ func (m *ReverseProxyModule) handleDryRunRequest(ctx context.Context, w http.Res
 		m.app.Logger().Error("Failed to write response body", "error", err)
 	}
 
-	// Now perform dry run comparison in the background (async)
-	go func(requestCtx context.Context) {
+	// Now call the shadow backend and compare in the background. The request
+	// context ends with the client response, so the shadow call runs detached
+	// from it and is bounded by the dry-run timeout instead.
+	go func(shadowCtx context.Context) {
 		// Add panic recovery for background goroutine
 		defer func() {
 			if r := recover(); r != nil {
@@ -4355,9 +4442,8 @@ func (m *ReverseProxyModule) handleDryRunRequest(ctx context.Context, w http.Res
 			}
 		}()
 
-		// Use the passed context for background processing
 		// Create a copy of the request for background comparison with preserved body
-		reqCopy := r.Clone(requestCtx)
+		reqCopy := r.Clone(shadowCtx)
 		if len(bodyBytes) > 0 {
 			reqCopy.Body = io.NopCloser(bytes.NewReader(bodyBytes))
 			reqCopy.ContentLength = int64(len(bodyBytes))
@@ -4383,8 +4469,8 @@ func (m *ReverseProxyModule) handleDryRunRequest(ctx context.Context, w http.Res
 		// Capture endpoint path before processing to avoid accessing potentially invalid request
 		endpointPath := reqCopy.URL.Path
 
-		// Process dry run comparison with actual URLs using the background context
-		result, err := m.dryRunHandler.ProcessDryRun(requestCtx, reqCopy, primaryURL, secondaryURL)
+		// Call the shadow backend and compare with the response already returned
+		result, err := m.dryRunHandler.ProcessShadow(shadowCtx, reqCopy, primaryURL, secondaryURL, returnedResponse)
 		if err != nil {
 			if m.app != nil && m.app.Logger() != nil {
 				m.app.Logger().Error("Background dry run processing failed", "error", err)
@@ -4392,84 +4478,56 @@ func (m *ReverseProxyModule) handleDryRunRequest(ctx context.Context, w http.Res
 			return
 		}
 
-		// Add nil checks before accessing result fields
-		if result != nil && !isEmptyComparisonResult(result.Comparison) {
-			// Emit dry run comparison event
-			m.emitEvent(requestCtx, EventTypeDryRunComparison, map[string]interface{}{
-				"endpoint":         endpointPath,
-				"primaryBackend":   primaryBackend,
-				"secondaryBackend": secondaryBackend,
-				"returnedBackend":  returnBackend,
-				"statusCodeMatch":  result.Comparison.StatusCodeMatch,
-				"bodyMatch":        result.Comparison.BodyMatch,
-				"headersMatch":     result.Comparison.HeadersMatch,
-				"differences":      len(result.Comparison.Differences),
-				"primaryStatus":    result.PrimaryResponse.StatusCode,
-				"secondaryStatus":  result.SecondaryResponse.StatusCode,
-				"timestamp":        result.Timestamp,
-			})
-
+		if result.Comparison.Matches() {
 			if m.app != nil && m.app.Logger() != nil {
-				m.app.Logger().Debug("Dry run comparison completed",
+				m.app.Logger().Debug("Dry run responses match",
 					"endpoint", endpointPath,
 					"primaryBackend", primaryBackend,
 					"secondaryBackend", secondaryBackend,
-					"returnedBackend", returnBackend,
-					"statusCodeMatch", result.Comparison.StatusCodeMatch,
-					"bodyMatch", result.Comparison.BodyMatch,
-					"differences", len(result.Comparison.Differences),
 				)
 			}
-		} else {
-			if m.app != nil && m.app.Logger() != nil {
-				if result == nil {
-					m.app.Logger().Error("Dry run result is nil")
-				} else {
-					m.app.Logger().Error("Dry run result comparison is empty")
-				}
-			}
-		}
-	}(ctx)
-}
-
-// isEmptyComparisonResult checks if a ComparisonResult is empty or represents no differences.
-// isEmptyComparisonResult determines whether a ComparisonResult is considered "empty".
-//
-// An "empty" ComparisonResult means that either:
-//   - No matches were found (all match fields are false) and there are no recorded differences,
-//   - Or, the result does not indicate any differences (Differences and HeaderDiffs are empty).
-//
-// Specifically, this function returns true if:
-//   - All of StatusCodeMatch, HeadersMatch, and BodyMatch are false, and both Differences and HeaderDiffs are empty.
-//   - There are no differences recorded at all.
-//
-// It returns false if:
-//   - Any differences are present (Differences or HeaderDiffs are non-empty), or
-//   - All match fields are true (indicating a successful comparison).
-//
-// This is used to determine if a dry run comparison yielded any differences or if the result is a default/empty value.
-func isEmptyComparisonResult(result ComparisonResult) bool {
-	// Check if all boolean fields are false (indicating no matches found)
-	if !result.StatusCodeMatch && !result.HeadersMatch && !result.BodyMatch {
-		// If no matches and no differences recorded, it's likely an empty/default result
-		if len(result.Differences) == 0 && len(result.HeaderDiffs) == 0 {
-			return true
+			return
 		}
-	}
 
-	// If there are differences recorded, it's not empty
-	if len(result.Differences) > 0 || len(result.HeaderDiffs) > 0 {
-		return false
-	}
-
-	// If all match fields are true and no differences, it's a successful comparison (not empty)
-	if result.StatusCodeMatch && result.HeadersMatch && result.BodyMatch {
-		return false
-	}
+		// Emit dry run comparison event with the structured diff
+		m.emitEvent(shadowCtx, EventTypeDryRunComparison, map[string]interface{}{
+			"endpoint":         endpointPath,
+			"method":           result.Method,
+			"primaryBackend":   primaryBackend,
+			"secondaryBackend": secondaryBackend,
+			"returnedBackend":  returnBackend,
+			"statusCodeMatch":  result.Comparison.StatusCodeMatch,
+			"bodyMatch":        result.Comparison.BodyMatch,
+			"headersMatch":     result.Comparison.HeadersMatch,
+			"differences":      len(result.Comparison.Differences),
+			"primaryStatus":    result.PrimaryResponse.StatusCode,
+			"secondaryStatus":  result.SecondaryResponse.StatusCode,
+			"primaryError":     result.PrimaryResponse.Error,
+			"secondaryError":   result.SecondaryResponse.Error,
+			"diff": map[string]interface{}{
+				"status": map[string]int{
+					"primary":   result.PrimaryResponse.StatusCode,
+					"secondary": result.SecondaryResponse.StatusCode,
+				},
+				"headers":     result.Comparison.HeaderDiffs,
+				"body":        result.Comparison.BodyDiffs,
+				"differences": result.Comparison.Differences,
+			},
+			"timestamp": result.Timestamp,
+		})
 
-	// Default case: If none of the above conditions matched, we conservatively assume the result is empty.
-	// This ensures that only explicit differences or matches are treated as non-empty; ambiguous or default-initialized results are considered empty.
-	return true
+		if m.app != nil && m.app.Logger() != nil {
+			m.app.Logger().Debug("Dry run comparison completed",
+				"endpoint", endpointPath,
+				"primaryBackend", primaryBackend,
+				"secondaryBackend", secondaryBackend,
+				"returnedBackend", returnBackend,
+				"statusCodeMatch", result.Comparison.StatusCodeMatch,
+				"bodyMatch", result.Comparison.BodyMatch,
+				"differences", len(result.Comparison.Differences),
+			)
+		}
+	}(context.WithoutCancel(ctx))
 }
 
 // RegisterObservers implements the ObservableModule interface.
@@ -4498,6 +4556,20 @@ func (m *ReverseProxyModule) EmitEvent(ctx context.Context, event cloudevents.Ev
 	return nil
 }
 
+// emitRequestCompleted emits the event for a completed proxied request and records its outcome
+// for passive health checking. Server errors, timeouts and transport failures count as failed
+// requests; client errors returned by the backend do not. Responses generated by the proxy itself,
+// such as circuit breaker rejections, must be emitted with emitEvent instead.
+func (m *ReverseProxyModule) emitRequestCompleted(ctx context.Context, eventType string, data map[string]interface{}) {
+	if m.healthChecker != nil {
+		backend, _ := data["backend"].(string)
+		status, _ := data["status"].(int)
+		failed := eventType == EventTypeRequestFailed && (status < 400 || status >= 500)
+		m.healthChecker.RecordBackendResult(backend, failed)
+	}
+	m.emitEvent(ctx, eventType, data)
+}
+
 // emitEvent is a helper method to create and emit CloudEvents for the reverseproxy module.
 // This centralizes the event creation logic and ensures consistent event formatting.
 // emitEvent is a helper method to create and emit CloudEvents for the reverseproxy module.
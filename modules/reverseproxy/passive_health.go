@@ -0,0 +1,119 @@
+package reverseproxy
+
+import (
+	"time"
+)
+
+// Defaults used when passive health check settings are left at zero.
+const (
+	defaultPassiveWindow             = 30 * time.Second
+	defaultPassiveErrorRateThreshold = 0.5
+	defaultPassiveMinRequests        = 10
+)
+
+// passiveHealthBuckets is the number of buckets a passive health window is split into.
+// Outcomes age out of the window one bucket at a time.
+const passiveHealthBuckets = 10
+
+// passiveHealthBucket counts the request outcomes of one slice of the window.
+type passiveHealthBucket struct {
+	start    time.Time
+	requests int64
+	failures int64
+}
+
+// passiveHealthWindow counts the live request outcomes of a backend over a sliding window.
+type passiveHealthWindow struct {
+	buckets [passiveHealthBuckets]passiveHealthBucket
+}
+
+// record adds a request outcome to the bucket covering now, resetting the bucket if it holds an older slice.
+func (w *passiveHealthWindow) record(now time.Time, window time.Duration, failed bool) {
+	width := bucketWidth(window)
+	start := now.Truncate(width)
+	b := &w.buckets[(start.UnixNano()/int64(width))%passiveHealthBuckets]
+	if !b.start.Equal(start) {
+		*b = passiveHealthBucket{start: start}
+	}
+	b.requests++
+	if failed {
+		b.failures++
+	}
+}
+
+// counts returns the requests and failures recorded within the window ending at now.
+func (w *passiveHealthWindow) counts(now time.Time, window time.Duration) (requests, failures int64) {
+	for _, b := range w.buckets {
+		if b.requests > 0 && now.Sub(b.start) < window {
+			requests += b.requests
+			failures += b.failures
+		}
+	}
+	return requests, failures
+}
+
+// bucketWidth returns the time slice covered by each bucket of a window.
+func bucketWidth(window time.Duration) time.Duration {
+	if width := window / passiveHealthBuckets; width > 0 {
+		return width
+	}
+	return 1
+}
+
+// RecordBackendResult records the outcome of a live request to a backend for passive health checking.
+// When the error rate over the passive window exceeds the threshold, the backend is marked unhealthy
+// in the health status map until enough requests succeed, or the failures age out of the window.
+// It does nothing unless passive health checking is enabled.
+func (hc *HealthChecker) RecordBackendResult(backendID string, failed bool) {
+	hc.configMutex.RLock()
+	cfg := hc.passiveConfig
+	hc.configMutex.RUnlock()
+	if !cfg.Enabled {
+		return
+	}
+	window := cfg.Window
+	if window <= 0 {
+		window = defaultPassiveWindow
+	}
+	threshold := cfg.ErrorRateThreshold
+	if threshold <= 0 {
+		threshold = defaultPassiveErrorRateThreshold
+	}
+	minRequests := int64(cfg.MinRequests)
+	if minRequests <= 0 {
+		minRequests = defaultPassiveMinRequests
+	}
+
+	now := time.Now()
+	hc.statusMutex.Lock()
+	status, exists := hc.healthStatus[backendID]
+	if !exists {
+		hc.statusMutex.Unlock()
+		return
+	}
+	w, ok := hc.passiveWindows[backendID]
+	if !ok {
+		w = &passiveHealthWindow{}
+		hc.passiveWindows[backendID] = w
+	}
+	w.record(now, window, failed)
+	requests, failures := w.counts(now, window)
+
+	prevHealthy := status.Healthy
+	status.PassiveErrorRate = float64(failures) / float64(requests)
+	status.PassiveUnhealthy = requests >= minRequests && status.PassiveErrorRate > threshold
+	status.Healthy = status.overallHealthy()
+
+	needsCallback := hc.eventEmitter != nil && prevHealthy != status.Healthy
+	isNowHealthy := status.Healthy
+	errorRate := status.PassiveErrorRate
+	hc.statusMutex.Unlock()
+
+	if needsCallback {
+		if isNowHealthy {
+			hc.eventEmitter(EventTypeBackendHealthy, map[string]interface{}{"backend_id": backendID, "passive_error_rate": errorRate})
+		} else {
+			hc.eventEmitter(EventTypeBackendUnhealthy, map[string]interface{}{"backend_id": backendID, "passive_error_rate": errorRate})
+		}
+	}
+}
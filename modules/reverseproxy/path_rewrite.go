@@ -0,0 +1,132 @@
+package reverseproxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// TrailingSlashPolicy defines how a rewritten path's trailing slash is handled.
+type TrailingSlashPolicy string
+
+const (
+	// TrailingSlashPreserve leaves the trailing slash as the rewrite rules produced it.
+	TrailingSlashPreserve TrailingSlashPolicy = "preserve"
+
+	// TrailingSlashStrip removes a trailing slash, except from the root path.
+	TrailingSlashStrip TrailingSlashPolicy = "strip"
+
+	// TrailingSlashAdd ensures the path ends with a slash.
+	TrailingSlashAdd TrailingSlashPolicy = "add"
+)
+
+// routePathRewritingKey is the context key for the path rewriting rules of the matched route
+type routePathRewritingKey struct{}
+
+// validatePathRewriting checks that regex rewrite patterns compile and the trailing slash policy is known.
+func validatePathRewriting(config *PathRewritingConfig) error {
+	for _, rule := range config.RegexRewrites {
+		if _, err := compilePattern(rule.Pattern, ErrInvalidPathRewritePattern); err != nil {
+			return err
+		}
+	}
+	switch TrailingSlashPolicy(config.TrailingSlash) {
+	case "", TrailingSlashPreserve, TrailingSlashStrip, TrailingSlashAdd:
+		return nil
+	default:
+		return fmt.Errorf("%w, got %q", ErrInvalidTrailingSlash, config.TrailingSlash)
+	}
+}
+
+// validatePathRewritingConfigs validates the route and backend path rewriting rules.
+func validatePathRewritingConfigs(config *ReverseProxyConfig) error {
+	for routePath, routeConfig := range config.RouteConfigs {
+		if routeConfig.PathRewrite != "" && routeConfig.PathRewriting.BasePathRewrite != "" {
+			return fmt.Errorf("route '%s': %w", routePath, ErrConflictingPathRewrite)
+		}
+		if err := validatePathRewriting(&routeConfig.PathRewriting); err != nil {
+			return fmt.Errorf("route '%s' path_rewriting: %w", routePath, err)
+		}
+	}
+	for backendID, backendConfig := range config.BackendConfigs {
+		if err := validatePathRewriting(&backendConfig.PathRewriting); err != nil {
+			return fmt.Errorf("backend '%s' path_rewriting: %w", backendID, err)
+		}
+		for endpointID, endpointConfig := range backendConfig.Endpoints {
+			if err := validatePathRewriting(&endpointConfig.PathRewriting); err != nil {
+				return fmt.Errorf("backend '%s' endpoint '%s' path_rewriting: %w", backendID, endpointID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// applyRegexRewrites applies each regex rewrite rule to the path in order.
+func (m *ReverseProxyModule) applyRegexRewrites(path string, rules []RegexRewriteRule) string {
+	for _, rule := range rules {
+		re, err := compilePattern(rule.Pattern, ErrInvalidPathRewritePattern)
+		if err != nil {
+			if m.app != nil && m.app.Logger() != nil {
+				m.app.Logger().Warn("Skipping invalid path rewrite", "pattern", rule.Pattern, "error", err.Error())
+			}
+			continue
+		}
+		path = re.ReplaceAllString(path, rule.Replacement)
+	}
+	return path
+}
+
+// normalizeRewrittenPath applies the trailing slash policy and turns an empty path into "/".
+func normalizeRewrittenPath(path string, policy TrailingSlashPolicy) string {
+	if path == "" {
+		return "/"
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	switch policy {
+	case TrailingSlashStrip:
+		trimmed := strings.TrimRight(path, "/")
+		if trimmed == "" {
+			return "/"
+		}
+		return trimmed
+	case TrailingSlashAdd:
+		if !strings.HasSuffix(path, "/") {
+			return path + "/"
+		}
+	}
+	return path
+}
+
+// isEmpty reports whether the config defines no path rewriting.
+func (c *PathRewritingConfig) isEmpty() bool {
+	return c.StripBasePath == "" && c.BasePathRewrite == "" && len(c.EndpointRewrites) == 0 &&
+		len(c.RegexRewrites) == 0 && c.TrailingSlash == ""
+}
+
+// pathRewriting returns the route's path rewriting rules with the PathRewrite
+// shorthand folded in as the base path rewrite.
+func (c RouteConfig) pathRewriting() PathRewritingConfig {
+	config := c.PathRewriting
+	if c.PathRewrite != "" {
+		config.BasePathRewrite = c.PathRewrite
+	}
+	return config
+}
+
+// withRoutePathRewriting attaches the matched route's path rewriting rules to
+// the request, for the backend proxy to apply before the backend's own rules.
+func withRoutePathRewriting(r *http.Request, config PathRewritingConfig) *http.Request {
+	if config.isEmpty() {
+		return r
+	}
+	return r.WithContext(context.WithValue(r.Context(), routePathRewritingKey{}, &config))
+}
+
+// routePathRewritingFromContext returns the route path rewriting rules attached to a request context.
+func routePathRewritingFromContext(ctx context.Context) (*PathRewritingConfig, bool) {
+	config, ok := ctx.Value(routePathRewritingKey{}).(*PathRewritingConfig)
+	return config, ok
+}
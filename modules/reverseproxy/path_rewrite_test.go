@@ -0,0 +1,196 @@
+package reverseproxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplySpecificPathRewriting_RegexAndTrailingSlash(t *testing.T) {
+	m := &ReverseProxyModule{}
+
+	tests := []struct {
+		name   string
+		config PathRewritingConfig
+		path   string
+		want   string
+	}{
+		{
+			name:   "strip prefix",
+			config: PathRewritingConfig{StripBasePath: "/legacy"},
+			path:   "/legacy/status",
+			want:   "/status",
+		},
+		{
+			name:   "strip prefix to empty becomes root",
+			config: PathRewritingConfig{StripBasePath: "/legacy"},
+			path:   "/legacy",
+			want:   "/",
+		},
+		{
+			name: "regex rewrite with capture groups",
+			config: PathRewritingConfig{RegexRewrites: []RegexRewriteRule{
+				{Pattern: `^/v1/users/([0-9]+)/orders$`, Replacement: "/orders/by-user/$1"},
+			}},
+			path: "/v1/users/42/orders",
+			want: "/orders/by-user/42",
+		},
+		{
+			name: "regex rewrites apply in order after strip",
+			config: PathRewritingConfig{
+				StripBasePath: "/legacy",
+				RegexRewrites: []RegexRewriteRule{
+					{Pattern: `^/api`, Replacement: ""},
+					{Pattern: `/items/`, Replacement: "/products/"},
+				},
+			},
+			path: "/legacy/api/items/7",
+			want: "/products/7",
+		},
+		{
+			name:   "regex rewrite to empty becomes root",
+			config: PathRewritingConfig{RegexRewrites: []RegexRewriteRule{{Pattern: `^/status$`, Replacement: ""}}},
+			path:   "/status",
+			want:   "/",
+		},
+		{
+			name:   "trailing slash preserved by default",
+			config: PathRewritingConfig{StripBasePath: "/legacy"},
+			path:   "/legacy/status/",
+			want:   "/status/",
+		},
+		{
+			name:   "trailing slash stripped",
+			config: PathRewritingConfig{StripBasePath: "/legacy", TrailingSlash: "strip"},
+			path:   "/legacy/status/",
+			want:   "/status",
+		},
+		{
+			name:   "root kept when stripping trailing slash",
+			config: PathRewritingConfig{StripBasePath: "/legacy", TrailingSlash: "strip"},
+			path:   "/legacy/",
+			want:   "/",
+		},
+		{
+			name:   "trailing slash added",
+			config: PathRewritingConfig{TrailingSlash: "add"},
+			path:   "/status",
+			want:   "/status/",
+		},
+		{
+			name:   "unmatched path untouched",
+			config: PathRewritingConfig{StripBasePath: "/legacy"},
+			path:   "/current/status",
+			want:   "/current/status",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, m.applySpecificPathRewriting(tt.path, &tt.config))
+		})
+	}
+}
+
+func TestRoutePathRewriting_Forwarding(t *testing.T) {
+	var gotURI string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURI = r.URL.RequestURI()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer backend.Close()
+
+	handler, _ := startRouteTestModule(t, "/legacy/*", &ReverseProxyConfig{
+		BackendServices: map[string]string{"api": backend.URL},
+		Routes:          map[string]string{"/legacy/*": "api"},
+		RouteConfigs: map[string]RouteConfig{
+			"/legacy/*": {
+				PathRewriting: PathRewritingConfig{
+					StripBasePath: "/legacy",
+					RegexRewrites: []RegexRewriteRule{{Pattern: `^/v([0-9]+)/`, Replacement: "/api/v$1/"}},
+				},
+			},
+		},
+		BackendConfigs: map[string]BackendServiceConfig{
+			"api": {PathRewriting: PathRewritingConfig{TrailingSlash: "strip"}},
+		},
+		DefaultBackend: "api",
+	})
+
+	tests := map[string]string{
+		"/legacy/status":               "/status",
+		"/legacy":                      "/",
+		"/legacy/status?verbose=1&x=2": "/status?verbose=1&x=2",
+		"/legacy/v2/users/?page=3":     "/api/v2/users?page=3",
+	}
+	for requestURI, want := range tests {
+		recorder := httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(http.MethodGet, requestURI, nil))
+		require.Equal(t, http.StatusOK, recorder.Code, requestURI)
+		assert.Equal(t, want, gotURI, "request %s", requestURI)
+	}
+}
+
+func TestRoutePathRewrite_Shorthand(t *testing.T) {
+	var gotURI string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURI = r.URL.RequestURI()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer backend.Close()
+
+	handler, _ := startRouteTestModule(t, "/legacy/*", &ReverseProxyConfig{
+		BackendServices: map[string]string{"api": backend.URL},
+		Routes:          map[string]string{"/legacy/*": "api"},
+		RouteConfigs: map[string]RouteConfig{
+			"/legacy/*": {
+				PathRewrite:   "/internal/api",
+				PathRewriting: PathRewritingConfig{StripBasePath: "/legacy"},
+			},
+		},
+		DefaultBackend: "api",
+	})
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, "/legacy/status?verbose=1", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "/internal/api/status?verbose=1", gotURI)
+}
+
+func TestValidatePathRewritingConfigs(t *testing.T) {
+	valid := &ReverseProxyConfig{
+		RouteConfigs: map[string]RouteConfig{
+			"/legacy/*": {PathRewriting: PathRewritingConfig{
+				RegexRewrites: []RegexRewriteRule{{Pattern: `^/legacy(/.*)$`, Replacement: "$1"}},
+				TrailingSlash: "strip",
+			}},
+		},
+	}
+	require.NoError(t, validatePathRewritingConfigs(valid))
+
+	badPattern := &ReverseProxyConfig{
+		RouteConfigs: map[string]RouteConfig{
+			"/legacy/*": {PathRewriting: PathRewritingConfig{RegexRewrites: []RegexRewriteRule{{Pattern: `^/legacy(`}}}},
+		},
+	}
+	assert.ErrorIs(t, validatePathRewritingConfigs(badPattern), ErrInvalidPathRewritePattern)
+
+	badTrailingSlash := &ReverseProxyConfig{
+		BackendConfigs: map[string]BackendServiceConfig{
+			"api": {PathRewriting: PathRewritingConfig{TrailingSlash: "remove"}},
+		},
+	}
+	assert.ErrorIs(t, validatePathRewritingConfigs(badTrailingSlash), ErrInvalidTrailingSlash)
+
+	conflictingRewrite := &ReverseProxyConfig{
+		RouteConfigs: map[string]RouteConfig{
+			"/legacy/*": {
+				PathRewrite:   "/internal/api",
+				PathRewriting: PathRewritingConfig{BasePathRewrite: "/internal/v2"},
+			},
+		},
+	}
+	assert.ErrorIs(t, validatePathRewritingConfigs(conflictingRewrite), ErrConflictingPathRewrite)
+}
@@ -0,0 +1,27 @@
+package reverseproxy
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// compiledPatterns caches compiled regular expressions keyed by their source.
+// Rewrite rules and health check body patterns are evaluated on every request
+// or check, so each pattern is compiled once and shared.
+var compiledPatterns sync.Map // map[string]*regexp.Regexp
+
+// compilePattern returns the compiled regular expression for pattern. A
+// pattern that fails to compile is reported as invalidErr, wrapping the
+// compile error.
+func compilePattern(pattern string, invalidErr error) (*regexp.Regexp, error) {
+	if cached, ok := compiledPatterns.Load(pattern); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", invalidErr, pattern, err)
+	}
+	actual, _ := compiledPatterns.LoadOrStore(pattern, re)
+	return actual.(*regexp.Regexp), nil
+}
@@ -0,0 +1,19 @@
+package reverseproxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompilePattern(t *testing.T) {
+	first, err := compilePattern(`^/api/v(\d+)/`, ErrInvalidPathRewritePattern)
+	require.NoError(t, err)
+	second, err := compilePattern(`^/api/v(\d+)/`, ErrInvalidHeaderRewritePattern)
+	require.NoError(t, err)
+	assert.Same(t, first, second, "a pattern is compiled once and shared between callers")
+
+	_, err = compilePattern(`(`, ErrInvalidHealthBodyPattern)
+	assert.ErrorIs(t, err, ErrInvalidHealthBodyPattern)
+}
@@ -0,0 +1,60 @@
+package reverseproxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestIDForwardedToBackend(t *testing.T) {
+	var received http.Header
+	backendServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer backendServer.Close()
+
+	apiURL, err := url.Parse(backendServer.URL)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		header   string
+		rewrites HeaderRewritingConfig
+	}{
+		{name: "default header"},
+		{name: "custom header", header: "X-Correlation-ID"},
+		{name: "survives header removal", rewrites: HeaderRewritingConfig{RemoveHeaders: []string{"X-Request-ID"}}},
+		{name: "survives header override", rewrites: HeaderRewritingConfig{SetHeaders: map[string]string{"X-Request-ID": "static"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = nil
+			module := NewModule()
+			module.config = &ReverseProxyConfig{
+				BackendServices: map[string]string{"api": backendServer.URL},
+				BackendConfigs:  map[string]BackendServiceConfig{"api": {URL: backendServer.URL, HeaderRewriting: tt.rewrites}},
+				RequestIDHeader: tt.header,
+			}
+			header := tt.header
+			if header == "" {
+				header = DefaultRequestIDHeader
+			}
+
+			proxy := module.createReverseProxyForBackend(context.Background(), apiURL, "api", "")
+			req := httptest.NewRequest(http.MethodGet, "http://client.example.com/api/test", nil)
+			req.Header.Set(header, "req-42")
+			w := httptest.NewRecorder()
+			proxy.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.NotNil(t, received)
+			assert.Equal(t, []string{"req-42"}, received.Values(header))
+		})
+	}
+}
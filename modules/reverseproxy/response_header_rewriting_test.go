@@ -514,3 +514,98 @@ func TestResponseHeaderRewritingPriority(t *testing.T) {
 		assert.Equal(t, "global", resp.Header.Get("X-Global-Only"))
 	})
 }
+
+// TestResponseHeaderRewrites tests regex-based response header value rewriting
+func TestResponseHeaderRewrites(t *testing.T) {
+	backendServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "http://internal.svc.local:8080/login?next=/home")
+		w.Header().Add("Set-Cookie", "session=abc; Domain=internal.svc.local; Path=/")
+		w.Header().Add("Set-Cookie", "theme=dark; Path=/")
+		w.Header().Add("X-Served-By", "node-1.internal.svc.local")
+		w.Header().Add("X-Served-By", "node-2.internal.svc.local")
+		w.Header().Set("X-Untouched", "keep-me")
+		w.WriteHeader(http.StatusFound)
+	}))
+	defer backendServer.Close()
+
+	module := NewModule()
+	module.config = &ReverseProxyConfig{
+		BackendServices: map[string]string{
+			"api": backendServer.URL,
+		},
+		BackendConfigs: map[string]BackendServiceConfig{
+			"api": {
+				URL: backendServer.URL,
+				ResponseHeaderRewrites: []HeaderRewrite{
+					{Header: "Location", Pattern: `^http://internal\.svc\.local:8080`, Replacement: "https://api.example.com"},
+					{Header: "Set-Cookie", Pattern: `(?i)Domain=internal\.svc\.local`, Replacement: "Domain=example.com"},
+					{Header: "X-Served-By", Pattern: `^(node-\d+)\.internal\.svc\.local$`, Replacement: "$1"},
+					{Header: "X-Untouched", Pattern: `no-match`, Replacement: "changed"},
+					{Header: "X-Missing", Pattern: `.*`, Replacement: "should-not-appear"},
+				},
+			},
+		},
+		TenantIDHeader: "X-Tenant-ID",
+	}
+
+	apiURL, err := url.Parse(backendServer.URL)
+	require.NoError(t, err)
+	proxy := module.createReverseProxyForBackend(context.Background(), apiURL, "api", "")
+
+	req := httptest.NewRequest("GET", "http://client.example.com/login", nil)
+	w := httptest.NewRecorder()
+	proxy.ServeHTTP(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, "https://api.example.com/login?next=/home", resp.Header.Get("Location"))
+	assert.Equal(t, []string{
+		"session=abc; Domain=example.com; Path=/",
+		"theme=dark; Path=/",
+	}, resp.Header.Values("Set-Cookie"), "each Set-Cookie value should be rewritten independently")
+	assert.Equal(t, []string{"node-1", "node-2"}, resp.Header.Values("X-Served-By"))
+	assert.Equal(t, "keep-me", resp.Header.Get("X-Untouched"), "non-matching rule should leave header untouched")
+	assert.Empty(t, resp.Header.Values("X-Missing"), "rule for absent header should not add it")
+}
+
+// TestResponseHeaderRewritesValidation tests that invalid rewrite rules are rejected
+func TestResponseHeaderRewritesValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []HeaderRewrite
+		wantErr error
+	}{
+		{
+			name:  "valid rule",
+			rules: []HeaderRewrite{{Header: "Location", Pattern: `internal`, Replacement: "external"}},
+		},
+		{
+			name:    "missing header",
+			rules:   []HeaderRewrite{{Pattern: `internal`, Replacement: "external"}},
+			wantErr: ErrHeaderRewriteHeaderRequired,
+		},
+		{
+			name:    "invalid pattern",
+			rules:   []HeaderRewrite{{Header: "Location", Pattern: `(unclosed`, Replacement: "x"}},
+			wantErr: ErrInvalidHeaderRewritePattern,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			module := NewModule()
+			module.config = &ReverseProxyConfig{
+				BackendServices: map[string]string{"api": "http://localhost:9999"},
+				BackendConfigs: map[string]BackendServiceConfig{
+					"api": {ResponseHeaderRewrites: tt.rules},
+				},
+			}
+			err := module.validateConfig()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
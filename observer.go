@@ -5,6 +5,7 @@ package modular
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -99,6 +100,7 @@ const (
 	EventTypeConfigReloadCompleted = "com.modular.config.reload.completed"
 	EventTypeConfigReloadFailed    = "com.modular.config.reload.failed"
 	EventTypeConfigReloadNoop      = "com.modular.config.reload.noop"
+	EventTypeConfigReloadRollback  = "com.modular.config.reload.rollback"
 
 	// Health events
 	EventTypeHealthEvaluated     = "com.modular.health.evaluated"
@@ -106,6 +108,9 @@ const (
 
 	// Phase events
 	EventTypeAppPhaseChanged = "com.modular.application.phase.changed"
+
+	// Feature flag events
+	EventTypeFeatureFlagsReloaded = "com.modular.featureflags.reloaded"
 )
 
 // ObservableModule is an optional interface that modules can implement
@@ -156,6 +161,123 @@ func (f *FunctionalObserver) ObserverID() string {
 	return f.id
 }
 
+// DecodeEventData decodes the data of a CloudEvent into a value of type T,
+// according to the event's content type (JSON for events built with
+// NewCloudEvent). Events without data and data that does not fit T return an
+// ErrEventDataDecode error naming the event type and T.
+func DecodeEventData[T any](event cloudevents.Event) (T, error) {
+	var data T
+	if len(event.Data()) == 0 {
+		return data, fmt.Errorf("%w: %s event has no data to decode as %T", ErrEventDataDecode, event.Type(), data)
+	}
+	if err := event.DataAs(&data); err != nil {
+		return data, fmt.Errorf("%w: %s event data as %T: %w", ErrEventDataDecode, event.Type(), data, err)
+	}
+	return data, nil
+}
+
+// TypedObserver is an observer whose handler receives the decoded data of
+// each event rather than the raw CloudEvent.
+type TypedObserver[T any] struct {
+	id         string
+	eventTypes eventTypeFilter
+	handler    func(ctx context.Context, data T) error
+}
+
+// NewTypedObserver creates an observer that decodes the data of each event
+// into T with DecodeEventData before calling handler. If eventTypes, event
+// types or glob patterns as accepted by RegisterObserver, are given, events of
+// other types are ignored; pass them to RegisterObserver as well so that they
+// are not delivered at all. Malformed patterns match no event. Events whose data cannot be decoded are
+// not passed to handler and OnEvent returns the decode error, which subjects
+// log.
+//
+// Example:
+//
+//	observer := modular.NewTypedObserver("module-events",
+//	    func(ctx context.Context, p modular.ModuleLifecyclePayload) error {
+//	        log.Printf("module %s %s", p.Name, p.Action)
+//	        return nil
+//	    }, modular.EventTypeModuleStarted, modular.EventTypeModuleStopped)
+func NewTypedObserver[T any](id string, handler func(ctx context.Context, data T) error, eventTypes ...string) *TypedObserver[T] {
+	filter, _ := newEventTypeFilter(eventTypes)
+	return &TypedObserver[T]{id: id, eventTypes: filter, handler: handler}
+}
+
+// OnEvent implements the Observer interface by decoding the event data and
+// calling the handler with it.
+func (o *TypedObserver[T]) OnEvent(ctx context.Context, event cloudevents.Event) error {
+	if !o.eventTypes.matches(event.Type()) {
+		return nil
+	}
+	data, err := DecodeEventData[T](event)
+	if err != nil {
+		return fmt.Errorf("observer %s: %w", o.id, err)
+	}
+	return o.handler(ctx, data)
+}
+
+// ObserverID implements the Observer interface by returning the observer ID.
+func (o *TypedObserver[T]) ObserverID() string {
+	return o.id
+}
+
+// ObserverRetryPolicy controls how a failing observer is retried when an
+// event is delivered to it. Panics are never retried.
+type ObserverRetryPolicy struct {
+	// MaxAttempts is the number of deliveries attempted, including the first.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// Backoff is the delay before the first retry, doubled before each
+	// following one.
+	Backoff time.Duration
+
+	// Retryable reports whether an error is transient and worth retrying.
+	// All errors are retried when it is nil.
+	Retryable func(err error) bool
+}
+
+// next reports whether the delivery should be retried after the given failed
+// attempt, and how long to wait before retrying
+func (p ObserverRetryPolicy) next(attempt int, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts || (p.Retryable != nil && !p.Retryable(err)) {
+		return 0, false
+	}
+	return p.Backoff << (attempt - 1), true
+}
+
+// RetryableObserver is an observer with a retry policy for transient failures.
+// Subjects such as StdApplication retry its deliveries as the policy allows.
+type RetryableObserver interface {
+	Observer
+	RetryPolicy() ObserverRetryPolicy
+}
+
+// retryingObserver attaches a retry policy to an observer
+type retryingObserver struct {
+	Observer
+	policy ObserverRetryPolicy
+}
+
+// WithObserverRetry returns the observer with the retry policy attached, to be
+// registered in its place.
+//
+// Example:
+//
+//	_ = app.RegisterObserver(modular.WithObserverRetry(webhookObserver, modular.ObserverRetryPolicy{
+//	    MaxAttempts: 3,
+//	    Backoff:     100 * time.Millisecond,
+//	}))
+func WithObserverRetry(observer Observer, policy ObserverRetryPolicy) RetryableObserver {
+	return &retryingObserver{Observer: observer, policy: policy}
+}
+
+// RetryPolicy implements the RetryableObserver interface.
+func (o *retryingObserver) RetryPolicy() ObserverRetryPolicy {
+	return o.policy
+}
+
 // EventValidationObserver is a special observer that tracks which events
 // have been emitted and can validate against a whitelist of expected events.
 // This is primarily used in testing to ensure all module events are emitted.
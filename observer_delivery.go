@@ -0,0 +1,184 @@
+package modular
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DeliveryMode tells a Subject how to deliver events to an observer.
+type DeliveryMode int
+
+const (
+	// DeliveryDefault delivers events synchronously when the notification
+	// context requests it (see WithSynchronousNotification) and
+	// asynchronously otherwise.
+	DeliveryDefault DeliveryMode = iota
+	// DeliverySync always delivers events inline, before NotifyObservers
+	// returns, so the observer receives them in the order they are notified.
+	// Suited to observers that must not miss or reorder events, such as audit
+	// logs.
+	DeliverySync
+	// DeliveryAsync always delivers events on the subject's worker pool,
+	// without blocking the notifier. Suited to observers such as metrics.
+	DeliveryAsync
+)
+
+// String returns the string representation of a DeliveryMode.
+func (m DeliveryMode) String() string {
+	switch m {
+	case DeliverySync:
+		return "sync"
+	case DeliveryAsync:
+		return "async"
+	default:
+		return "default"
+	}
+}
+
+// DeliveryModeObserver is an observer declaring how events are delivered to it.
+// Observers that don't implement it use DeliveryDefault.
+type DeliveryModeObserver interface {
+	Observer
+	DeliveryMode() DeliveryMode
+}
+
+// observerDeliveryMode returns the delivery mode declared by the observer
+func observerDeliveryMode(observer Observer) DeliveryMode {
+	if aware, ok := observer.(DeliveryModeObserver); ok {
+		return aware.DeliveryMode()
+	}
+	return DeliveryDefault
+}
+
+// observerDrainTimeout bounds the wait for asynchronous deliveries after the
+// application stopped event
+const observerDrainTimeout = 5 * time.Second
+
+// defaultObserverWorkers bounds the concurrent asynchronous deliveries of a subject
+const defaultObserverWorkers = 8
+
+// observerWorkerPool runs asynchronous deliveries on a bounded number of
+// workers. Workers are started on demand and exit when the queue is empty. The
+// zero value is ready to use.
+type observerWorkerPool struct {
+	mu      sync.Mutex
+	queue   []func()
+	workers int
+	pending int
+	idle    chan struct{} // closed when the last pending delivery completes
+}
+
+// submit queues a delivery, starting a worker if fewer than
+// defaultObserverWorkers are running
+func (p *observerWorkerPool) submit(task func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.pending == 0 {
+		p.idle = make(chan struct{})
+	}
+	p.pending++
+	p.queue = append(p.queue, task)
+	if p.workers < defaultObserverWorkers {
+		p.workers++
+		go p.work()
+	}
+}
+
+func (p *observerWorkerPool) work() {
+	for {
+		p.mu.Lock()
+		if len(p.queue) == 0 {
+			p.workers--
+			p.mu.Unlock()
+			return
+		}
+		task := p.queue[0]
+		p.queue[0] = nil
+		p.queue = p.queue[1:]
+		p.mu.Unlock()
+
+		task()
+
+		p.mu.Lock()
+		p.pending--
+		if p.pending == 0 {
+			close(p.idle)
+		}
+		p.mu.Unlock()
+	}
+}
+
+// drain waits until all queued deliveries have completed, or ctx is done.
+func (p *observerWorkerPool) drain(ctx context.Context) error {
+	p.mu.Lock()
+	if p.pending == 0 {
+		p.mu.Unlock()
+		return nil
+	}
+	idle := p.idle
+	p.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck // callers report the context error as is
+	}
+}
+
+// eventTypeFilter selects the event types an observer receives: exact types,
+// and glob patterns matched with path.Match. The zero value matches all types.
+type eventTypeFilter struct {
+	exact    map[string]bool
+	patterns []string
+}
+
+// newEventTypeFilter builds the filter of the given event types and patterns,
+// returning ErrInvalidEventTypePattern for malformed patterns. Malformed
+// patterns are left out of the returned filter.
+func newEventTypeFilter(eventTypes []string) (eventTypeFilter, error) {
+	var (
+		filter eventTypeFilter
+		errs   []error
+	)
+	for _, eventType := range eventTypes {
+		if !strings.ContainsAny(eventType, `*?[\`) {
+			if filter.exact == nil {
+				filter.exact = make(map[string]bool, len(eventTypes))
+			}
+			filter.exact[eventType] = true
+			continue
+		}
+		if _, err := path.Match(eventType, ""); err != nil {
+			errs = append(errs, fmt.Errorf("%w %q: %w", ErrInvalidEventTypePattern, eventType, err))
+			continue
+		}
+		filter.patterns = append(filter.patterns, eventType)
+	}
+	if len(errs) > 0 && filter.exact == nil && filter.patterns == nil {
+		// Keep an invalid filter from matching everything
+		filter.exact = map[string]bool{}
+	}
+	return filter, errors.Join(errs...)
+}
+
+// matches reports whether events of the given type pass the filter
+func (f eventTypeFilter) matches(eventType string) bool {
+	if f.exact == nil && f.patterns == nil {
+		return true
+	}
+	if f.exact[eventType] {
+		return true
+	}
+	for _, pattern := range f.patterns {
+		if ok, _ := path.Match(pattern, eventType); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package modular
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// modeObserver is a functional observer declaring a delivery mode
+type modeObserver struct {
+	Observer
+	mode DeliveryMode
+}
+
+func (o *modeObserver) DeliveryMode() DeliveryMode { return o.mode }
+
+func TestDeliveryMode_String(t *testing.T) {
+	assert.Equal(t, "default", DeliveryDefault.String())
+	assert.Equal(t, "sync", DeliverySync.String())
+	assert.Equal(t, "async", DeliveryAsync.String())
+}
+
+func TestNotifyObservers_SyncObserverOrdering(t *testing.T) {
+	t.Parallel()
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &TestObserverLogger{}).(*StdApplication)
+
+	var received []string // only written inline, by the notifying goroutine
+	require.NoError(t, app.RegisterObserver(&modeObserver{
+		Observer: NewFunctionalObserver("audit", func(ctx context.Context, event cloudevents.Event) error {
+			received = append(received, event.ID())
+			return nil
+		}),
+		mode: DeliverySync,
+	}))
+
+	var want []string
+	for i := range 50 {
+		event := NewCloudEvent("test.event", "test", i, nil)
+		event.SetID(fmt.Sprintf("event-%d", i))
+		want = append(want, event.ID())
+		// Without a synchronous context, the sync observer must still be called inline
+		require.NoError(t, app.NotifyObservers(context.Background(), event))
+		require.Len(t, received, i+1, "the sync observer must receive the event before NotifyObservers returns")
+	}
+	assert.Equal(t, want, received)
+}
+
+func TestNotifyObservers_AsyncObserverDoesNotBlock(t *testing.T) {
+	t.Parallel()
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &TestObserverLogger{}).(*StdApplication)
+
+	release := make(chan struct{})
+	var delivered atomic.Int32
+	require.NoError(t, app.RegisterObserver(&modeObserver{
+		Observer: NewFunctionalObserver("metrics", func(ctx context.Context, event cloudevents.Event) error {
+			<-release
+			delivered.Add(1)
+			return nil
+		}),
+		mode: DeliveryAsync,
+	}))
+
+	// Even a synchronous context does not make an async observer block
+	ctx := WithSynchronousNotification(context.Background())
+	for range 3 * defaultObserverWorkers {
+		require.NoError(t, app.NotifyObservers(ctx, NewCloudEvent("test.event", "test", nil, nil)))
+	}
+	assert.Equal(t, int32(0), delivered.Load())
+
+	close(release)
+	drainCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, app.observerPool.drain(drainCtx))
+	assert.Equal(t, int32(3*defaultObserverWorkers), delivered.Load())
+}
+
+func TestObserverWorkerPool_Bounded(t *testing.T) {
+	t.Parallel()
+	var pool observerWorkerPool
+
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+	for range 4 * defaultObserverWorkers {
+		wg.Add(1)
+		pool.submit(func() {
+			defer wg.Done()
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+		})
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, peak.Load(), int32(defaultObserverWorkers))
+
+	// Drained pools report idle at once
+	require.NoError(t, pool.drain(context.Background()))
+}
+
+func TestStop_DrainsAsyncDeliveries(t *testing.T) {
+	t.Parallel()
+	app := NewObservableApplication(NewStdConfigProvider(&struct{}{}), &TestObserverLogger{})
+
+	var (
+		mu         sync.Mutex
+		eventTypes []string
+	)
+	require.NoError(t, app.RegisterObserver(&modeObserver{
+		Observer: NewFunctionalObserver("slow-metrics", func(ctx context.Context, event cloudevents.Event) error {
+			time.Sleep(20 * time.Millisecond)
+			mu.Lock()
+			defer mu.Unlock()
+			eventTypes = append(eventTypes, event.Type())
+			return nil
+		}),
+		mode: DeliveryAsync,
+	}, EventTypeModuleStopped, EventTypeApplicationStopped))
+
+	app.RegisterModule(&lifecycleObserverModule{TestObserverModule: TestObserverModule{name: "worker"}})
+	require.NoError(t, app.Init())
+	require.NoError(t, app.Start())
+	require.NoError(t, app.Stop())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.ElementsMatch(t, []string{EventTypeModuleStopped, EventTypeApplicationStopped}, eventTypes,
+		"async deliveries must complete before Stop returns")
+}
+
+func TestEventTypeFilter(t *testing.T) {
+	tests := []struct {
+		name      string
+		types     []string
+		eventType string
+		want      bool
+	}{
+		{"no types match all", nil, "com.example.any", true},
+		{"exact match", []string{"com.modular.module.started"}, "com.modular.module.started", true},
+		{"exact mismatch", []string{"com.modular.module.started"}, "com.modular.module.stopped", false},
+		{"prefix glob", []string{"com.modular.module.*"}, "com.modular.module.stopped", true},
+		{"prefix glob spans dots", []string{"com.modular.*"}, "com.modular.module.stopped", true},
+		{"prefix glob mismatch", []string{"com.modular.module.*"}, "com.modular.service.registered", false},
+		{"inner glob", []string{"com.modular.*.failed"}, "com.modular.application.failed", true},
+		{"single character glob", []string{"com.modular.module.?tarted"}, "com.modular.module.started", true},
+		{"any of several", []string{"a.b", "com.*"}, "com.example", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := newEventTypeFilter(tt.types)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, filter.matches(tt.eventType))
+		})
+	}
+
+	filter, err := newEventTypeFilter([]string{"com.[modular"})
+	require.ErrorIs(t, err, ErrInvalidEventTypePattern)
+	assert.False(t, filter.matches("com.modular"), "an invalid filter must not match everything")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -107,6 +108,95 @@ func TestFunctionalObserverWithError(t *testing.T) {
 	}
 }
 
+type typedTestPayload struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func TestDecodeEventData(t *testing.T) {
+	t.Parallel()
+	event := NewCloudEvent("test.event", "test", map[string]any{"name": "cache", "count": 3}, nil)
+
+	payload, err := DecodeEventData[typedTestPayload](event)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if payload.Name != "cache" || payload.Count != 3 {
+		t.Errorf("Expected decoded payload {cache 3}, got %+v", payload)
+	}
+
+	lifecycle := NewModuleLifecycleEvent("application", "module", "db", "", "started", nil)
+	lifecyclePayload, err := DecodeEventData[ModuleLifecyclePayload](lifecycle)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if lifecyclePayload.Name != "db" || lifecyclePayload.Action != "started" {
+		t.Errorf("Expected the lifecycle payload of db, got %+v", lifecyclePayload)
+	}
+}
+
+func TestDecodeEventDataMismatch(t *testing.T) {
+	t.Parallel()
+	event := NewCloudEvent("test.event", "test", "not an object", nil)
+
+	_, err := DecodeEventData[typedTestPayload](event)
+	if !errors.Is(err, ErrEventDataDecode) {
+		t.Fatalf("Expected ErrEventDataDecode, got %v", err)
+	}
+	for _, want := range []string{"test.event", "modular.typedTestPayload"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error %q to mention %q", err, want)
+		}
+	}
+
+	empty := cloudevents.NewEvent()
+	empty.SetType("test.empty")
+	if _, err := DecodeEventData[typedTestPayload](empty); !errors.Is(err, ErrEventDataDecode) {
+		t.Errorf("Expected ErrEventDataDecode for an event without data, got %v", err)
+	}
+}
+
+func TestTypedObserver(t *testing.T) {
+	t.Parallel()
+	var received []typedTestPayload
+	observer := NewTypedObserver("typed-observer", func(ctx context.Context, payload typedTestPayload) error {
+		received = append(received, payload)
+		return nil
+	}, "test.event")
+
+	if observer.ObserverID() != "typed-observer" {
+		t.Errorf("Expected ObserverID to be 'typed-observer', got %s", observer.ObserverID())
+	}
+
+	ctx := context.Background()
+	if err := observer.OnEvent(ctx, NewCloudEvent("test.event", "test", typedTestPayload{Name: "a", Count: 1}, nil)); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := observer.OnEvent(ctx, NewCloudEvent("other.event", "test", "ignored", nil)); err != nil {
+		t.Errorf("Expected events of other types to be ignored, got %v", err)
+	}
+	err := observer.OnEvent(ctx, NewCloudEvent("test.event", "test", []string{"mismatch"}, nil))
+	if !errors.Is(err, ErrEventDataDecode) {
+		t.Errorf("Expected ErrEventDataDecode for mismatched data, got %v", err)
+	}
+
+	if len(received) != 1 || received[0] != (typedTestPayload{Name: "a", Count: 1}) {
+		t.Errorf("Expected the handler to receive only the decoded payload, got %+v", received)
+	}
+}
+
+func TestTypedObserverHandlerError(t *testing.T) {
+	t.Parallel()
+	observer := NewTypedObserver("typed-observer", func(ctx context.Context, payload typedTestPayload) error {
+		return errTest
+	})
+
+	err := observer.OnEvent(context.Background(), NewCloudEvent("any.event", "test", typedTestPayload{}, nil))
+	if !errors.Is(err, errTest) {
+		t.Errorf("Expected error %v, got %v", errTest, err)
+	}
+}
+
 func TestEventTypeConstants(t *testing.T) {
 	t.Parallel()
 	// Test that our event type constants are properly defined with reverse domain notation
@@ -148,6 +148,8 @@ const (
 	ReloadAPIRequest
 	// ReloadScheduled indicates a reload triggered by a periodic schedule.
 	ReloadScheduled
+	// ReloadSignal indicates a reload triggered by a SIGHUP signal.
+	ReloadSignal
 )
 
 // String returns the string representation of a ReloadTrigger.
@@ -161,6 +163,8 @@ func (rt ReloadTrigger) String() string {
 		return "api_request"
 	case ReloadScheduled:
 		return "scheduled"
+	case ReloadSignal:
+		return "signal"
 	default:
 		return "unknown"
 	}
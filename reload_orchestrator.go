@@ -14,6 +14,8 @@ type ReloadRequest struct {
 	Trigger ReloadTrigger
 	Diff    ConfigDiff
 	Ctx     context.Context
+
+	done chan<- error // receives the result once the request is processed, if set
 }
 
 // reloadEntry pairs a module name with its Reloadable implementation.
@@ -38,6 +40,9 @@ type ReloadOrchestrator struct {
 	requestCh chan ReloadRequest
 	stopped   atomic.Bool
 	stopOnce  sync.Once
+	started   atomic.Bool
+	exited    chan struct{} // closed when the request loop exits
+	exitOnce  sync.Once
 
 	processing atomic.Bool
 
@@ -68,6 +73,7 @@ func NewReloadOrchestrator(logger Logger, subject Subject) *ReloadOrchestrator {
 	return &ReloadOrchestrator{
 		reloadables: make(map[string]Reloadable),
 		requestCh:   make(chan ReloadRequest, 100),
+		exited:      make(chan struct{}),
 		logger:      logger,
 		subject:     subject,
 	}
@@ -86,7 +92,37 @@ func (o *ReloadOrchestrator) RegisterReloadable(name string, module Reloadable)
 // The method is safe to call concurrently with Stop(). A recover guard protects
 // against the send-on-closed-channel panic that can occur when Stop() closes
 // requestCh between the stopped check and the channel send.
-func (o *ReloadOrchestrator) RequestReload(ctx context.Context, trigger ReloadTrigger, diff ConfigDiff) (retErr error) {
+func (o *ReloadOrchestrator) RequestReload(ctx context.Context, trigger ReloadTrigger, diff ConfigDiff) error {
+	return o.enqueue(ReloadRequest{Trigger: trigger, Diff: diff, Ctx: ctx})
+}
+
+// requestReloadAndWait enqueues a reload like RequestReload and blocks until it
+// has been processed, returning the reload's result.
+func (o *ReloadOrchestrator) requestReloadAndWait(ctx context.Context, trigger ReloadTrigger, diff ConfigDiff) error {
+	if !o.started.Load() {
+		return ErrReloadNotStarted
+	}
+	done := make(chan error, 1)
+	if err := o.enqueue(ReloadRequest{Trigger: trigger, Diff: diff, Ctx: ctx, done: done}); err != nil {
+		return err
+	}
+	select {
+	case err := <-done:
+		return err
+	case <-o.exited:
+		select {
+		case err := <-done:
+			return err
+		default:
+			return ErrReloadStopped
+		}
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for reload: %w", ctx.Err())
+	}
+}
+
+// enqueue adds req to the request queue
+func (o *ReloadOrchestrator) enqueue(req ReloadRequest) (retErr error) {
 	if o.stopped.Load() {
 		return ErrReloadStopped
 	}
@@ -103,7 +139,7 @@ func (o *ReloadOrchestrator) RequestReload(ctx context.Context, trigger ReloadTr
 	}()
 
 	select {
-	case o.requestCh <- ReloadRequest{Trigger: trigger, Diff: diff, Ctx: ctx}:
+	case o.requestCh <- req:
 		return nil
 	default:
 		return ErrReloadChannelFull
@@ -112,7 +148,9 @@ func (o *ReloadOrchestrator) RequestReload(ctx context.Context, trigger ReloadTr
 
 // Start begins the background goroutine that drains the reload request queue.
 func (o *ReloadOrchestrator) Start(ctx context.Context) {
+	o.started.Store(true)
 	go func() {
+		defer o.exitOnce.Do(func() { close(o.exited) })
 		defer func() {
 			if r := recover(); r != nil {
 				o.logger.Error("panic recovered in reload orchestrator loop", "error", r)
@@ -169,9 +207,13 @@ func (o *ReloadOrchestrator) handleReload(parentCtx context.Context, req ReloadR
 		}()
 	}
 
-	if err := o.processReload(rctx, req); err != nil {
+	err := o.processReload(rctx, req)
+	if err != nil {
 		o.logger.Error("Reload failed", "trigger", req.Trigger.String(), "error", err)
 	}
+	if req.done != nil {
+		req.done <- err
+	}
 }
 
 // Stop signals the background goroutine to exit. It is safe to call multiple times.
@@ -224,21 +266,47 @@ func (o *ReloadOrchestrator) processReload(ctx context.Context, req ReloadReques
 		return targets[i].name < targets[j].name
 	})
 
-	// Track which modules have been successfully reloaded (for rollback).
-	var applied []reloadEntry
-
+	// Skip modules that cannot currently reload.
+	ready := targets[:0]
 	for _, t := range targets {
 		if !t.module.CanReload() {
 			o.logger.Info("Module cannot reload, skipping", "module", t.name)
 			continue
 		}
+		ready = append(ready, t)
+	}
 
-		timeout := t.module.ReloadTimeout()
-		if timeout <= 0 {
-			timeout = defaultReloadTimeout
+	// Phase one: every module able to validate the changes must accept them
+	// before any module applies them.
+	for _, t := range ready {
+		validator, ok := t.module.(ReloadValidator)
+		if !ok {
+			continue
 		}
-		rctx, cancel := context.WithTimeout(ctx, timeout)
+		rctx, cancel := context.WithTimeout(ctx, reloadTimeout(t.module))
+		err := validator.Validate(rctx, changes)
+		cancel()
 
+		if err != nil {
+			o.logger.Error("Module rejected reload, aborting", "module", t.name, "error", err)
+			o.recordFailure()
+			o.emitEvent(ctx, EventTypeConfigReloadFailed, map[string]any{
+				"trigger":      req.Trigger.String(),
+				"diffId":       req.Diff.DiffID,
+				"phase":        "validate",
+				"failedModule": t.name,
+				"error":        err.Error(),
+			})
+			return fmt.Errorf("%w: module %s: %w", ErrReloadValidationFailed, t.name, err)
+		}
+	}
+
+	// Phase two: apply the changes, tracking which modules have been
+	// successfully reloaded (for rollback).
+	var applied []reloadEntry
+
+	for _, t := range ready {
+		rctx, cancel := context.WithTimeout(ctx, reloadTimeout(t.module))
 		err := t.module.Reload(rctx, changes)
 		cancel()
 
@@ -247,12 +315,13 @@ func (o *ReloadOrchestrator) processReload(ctx context.Context, req ReloadReques
 				"module", t.name, "error", err)
 
 			// Rollback already-applied modules in reverse order.
-			o.rollback(ctx, applied, changes)
+			o.rollback(ctx, req, t.name, applied, changes)
 
 			o.recordFailure()
 			o.emitEvent(ctx, EventTypeConfigReloadFailed, map[string]any{
 				"trigger":      req.Trigger.String(),
 				"diffId":       req.Diff.DiffID,
+				"phase":        "reload",
 				"failedModule": t.name,
 				"error":        err.Error(),
 			})
@@ -271,39 +340,43 @@ func (o *ReloadOrchestrator) processReload(ctx context.Context, req ReloadReques
 	return nil
 }
 
-// buildChanges converts a ConfigDiff into a flat slice of ConfigChange entries.
+// buildChanges converts a ConfigDiff into a flat slice of ConfigChange entries,
+// sorted by field path.
 func (o *ReloadOrchestrator) buildChanges(diff ConfigDiff) []ConfigChange {
 	var changes []ConfigChange
-	for path, fc := range diff.Added {
-		changes = append(changes, ConfigChange{
-			FieldPath: path,
-			OldValue:  fmt.Sprintf("%v", fc.OldValue),
-			NewValue:  fmt.Sprintf("%v", fc.NewValue),
-			Source:    "diff",
-		})
-	}
-	for path, fc := range diff.Changed {
-		changes = append(changes, ConfigChange{
-			FieldPath: path,
-			OldValue:  fmt.Sprintf("%v", fc.OldValue),
-			NewValue:  fmt.Sprintf("%v", fc.NewValue),
-			Source:    "diff",
-		})
-	}
-	for path, fc := range diff.Removed {
-		changes = append(changes, ConfigChange{
-			FieldPath: path,
-			OldValue:  fmt.Sprintf("%v", fc.OldValue),
-			NewValue:  fmt.Sprintf("%v", fc.NewValue),
-			Source:    "diff",
-		})
+	for _, fields := range []map[string]FieldChange{diff.Added, diff.Changed, diff.Removed} {
+		for path, fc := range fields {
+			changes = append(changes, ConfigChange{
+				FieldPath: path,
+				OldValue:  formatChangeValue(fc.OldValue),
+				NewValue:  formatChangeValue(fc.NewValue),
+				Source:    "diff",
+			})
+		}
 	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].FieldPath < changes[j].FieldPath
+	})
 	return changes
 }
 
-// rollback attempts to reverse already-applied changes on modules in reverse order.
+// formatChangeValue formats a field value for a ConfigChange. Missing values,
+// such as the old value of an added field, are formatted as an empty string.
+func formatChangeValue(value any) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// rollback attempts to reverse already-applied changes on modules in reverse order,
+// emitting a rollback event listing the modules that were and were not restored.
 // This is best-effort: errors are logged but not propagated.
-func (o *ReloadOrchestrator) rollback(ctx context.Context, applied []reloadEntry, originalChanges []ConfigChange) {
+func (o *ReloadOrchestrator) rollback(ctx context.Context, req ReloadRequest, failedModule string, applied []reloadEntry, originalChanges []ConfigChange) {
+	if len(applied) == 0 {
+		return
+	}
+
 	// Build reverse changes (swap old and new values).
 	reverseChanges := make([]ConfigChange, len(originalChanges))
 	for i, c := range originalChanges {
@@ -317,21 +390,38 @@ func (o *ReloadOrchestrator) rollback(ctx context.Context, applied []reloadEntry
 	}
 
 	// Apply in reverse order.
+	rolledBack := make([]string, 0, len(applied))
+	var rollbackFailed []string
 	for i := len(applied) - 1; i >= 0; i-- {
 		t := applied[i]
-		timeout := t.module.ReloadTimeout()
-		if timeout <= 0 {
-			timeout = defaultReloadTimeout
-		}
-		rctx, cancel := context.WithTimeout(ctx, timeout)
+		rctx, cancel := context.WithTimeout(ctx, reloadTimeout(t.module))
 
 		if err := t.module.Reload(rctx, reverseChanges); err != nil {
 			o.logger.Error("Rollback failed for module", "module", t.name, "error", err)
+			rollbackFailed = append(rollbackFailed, t.name)
 		} else {
 			o.logger.Info("Rollback succeeded for module", "module", t.name)
+			rolledBack = append(rolledBack, t.name)
 		}
 		cancel()
 	}
+
+	o.emitEvent(ctx, EventTypeConfigReloadRollback, map[string]any{
+		"trigger":        req.Trigger.String(),
+		"diffId":         req.Diff.DiffID,
+		"failedModule":   failedModule,
+		"rolledBack":     rolledBack,
+		"rollbackFailed": rollbackFailed,
+	})
+}
+
+// reloadTimeout returns the module's reload timeout, falling back to
+// defaultReloadTimeout for non-positive values.
+func reloadTimeout(module Reloadable) time.Duration {
+	if timeout := module.ReloadTimeout(); timeout > 0 {
+		return timeout
+	}
+	return defaultReloadTimeout
 }
 
 // emitEvent sends a CloudEvent via the configured subject.
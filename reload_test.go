@@ -467,3 +467,127 @@ func TestReloadOrchestrator_NoopOnEmptyDiff(t *testing.T) {
 		t.Errorf("expected 0 reload calls for empty diff, got %d", mod.reloadCalls.Load())
 	}
 }
+
+// validatingReloadable is a mockReloadable that also implements ReloadValidator.
+type validatingReloadable struct {
+	mockReloadable
+	validateErr   error
+	validateCalls atomic.Int32
+}
+
+func (m *validatingReloadable) Validate(_ context.Context, _ []ConfigChange) error {
+	m.validateCalls.Add(1)
+	return m.validateErr
+}
+
+func TestReloadOrchestrator_ValidationFailureAborts(t *testing.T) {
+	logger := &reloadTestLogger{}
+	subject := &reloadTestSubject{}
+	orch := NewReloadOrchestrator(logger, subject)
+
+	first := &validatingReloadable{mockReloadable: mockReloadable{canReload: true, timeout: 5 * time.Second}}
+	plain := &mockReloadable{canReload: true, timeout: 5 * time.Second}
+	rejecting := &validatingReloadable{
+		mockReloadable: mockReloadable{canReload: true, timeout: 5 * time.Second},
+		validateErr:    errors.New("ttl too short"),
+	}
+	orch.RegisterReloadable("aaa_first", first)
+	orch.RegisterReloadable("bbb_plain", plain)
+	orch.RegisterReloadable("zzz_rejecting", rejecting)
+
+	ctx := t.Context()
+	orch.Start(ctx)
+
+	if err := orch.RequestReload(ctx, ReloadManual, newTestDiff()); err != nil {
+		t.Fatalf("RequestReload failed: %v", err)
+	}
+
+	if !waitFor(t, 2*time.Second, func() bool {
+		return slices.Contains(subject.eventTypes(), EventTypeConfigReloadFailed)
+	}) {
+		t.Fatal("timed out waiting for reload failure event")
+	}
+
+	if first.validateCalls.Load() != 1 || rejecting.validateCalls.Load() != 1 {
+		t.Errorf("expected every validator to be called once, got %d and %d",
+			first.validateCalls.Load(), rejecting.validateCalls.Load())
+	}
+	for name, calls := range map[string]int32{
+		"aaa_first":     first.reloadCalls.Load(),
+		"bbb_plain":     plain.reloadCalls.Load(),
+		"zzz_rejecting": rejecting.reloadCalls.Load(),
+	} {
+		if calls != 0 {
+			t.Errorf("expected no Reload calls on %s after a rejected validation, got %d", name, calls)
+		}
+	}
+	if slices.Contains(subject.eventTypes(), EventTypeConfigReloadRollback) {
+		t.Error("expected no rollback when nothing was applied")
+	}
+
+	failed := subject.getEvents()[len(subject.getEvents())-1]
+	var data map[string]any
+	if err := failed.DataAs(&data); err != nil {
+		t.Fatalf("decoding failure event: %v", err)
+	}
+	if data["phase"] != "validate" || data["failedModule"] != "zzz_rejecting" {
+		t.Errorf("unexpected failure event data: %v", data)
+	}
+}
+
+func TestReloadOrchestrator_RollbackEmitsEvent(t *testing.T) {
+	logger := &reloadTestLogger{}
+	subject := &reloadTestSubject{}
+	orch := NewReloadOrchestrator(logger, subject)
+
+	first := &validatingReloadable{mockReloadable: mockReloadable{canReload: true, timeout: 5 * time.Second}}
+	second := &mockReloadable{canReload: true, timeout: 5 * time.Second}
+	failing := &mockReloadable{canReload: true, timeout: 5 * time.Second, reloadErr: errors.New("boom")}
+	orch.RegisterReloadable("aaa_first", first)
+	orch.RegisterReloadable("bbb_second", second)
+	orch.RegisterReloadable("zzz_failing", failing)
+
+	ctx := t.Context()
+	orch.Start(ctx)
+
+	if err := orch.RequestReload(ctx, ReloadManual, newTestDiff()); err != nil {
+		t.Fatalf("RequestReload failed: %v", err)
+	}
+
+	if !waitFor(t, 2*time.Second, func() bool {
+		return slices.Contains(subject.eventTypes(), EventTypeConfigReloadFailed)
+	}) {
+		t.Fatal("timed out waiting for reload failure event")
+	}
+
+	// Already reloaded modules get the inverse changes.
+	wantRollback := []ConfigChange{{FieldPath: "db.host", OldValue: "remotehost", NewValue: "localhost", Source: "rollback"}}
+	for name, mod := range map[string]*mockReloadable{"aaa_first": &first.mockReloadable, "bbb_second": second} {
+		if calls := mod.reloadCalls.Load(); calls != 2 {
+			t.Errorf("expected %s to be called 2 times (apply+rollback), got %d", name, calls)
+		}
+		if got := mod.getLastChanges(); !slices.Equal(got, wantRollback) {
+			t.Errorf("expected %s to be rolled back with %v, got %v", name, wantRollback, got)
+		}
+	}
+
+	var rollback *cloudevents.Event
+	for _, e := range subject.getEvents() {
+		if e.Type() == EventTypeConfigReloadRollback {
+			rollback = &e
+		}
+	}
+	if rollback == nil {
+		t.Fatalf("expected a rollback event, got %v", subject.eventTypes())
+	}
+	var data struct {
+		FailedModule string   `json:"failedModule"`
+		RolledBack   []string `json:"rolledBack"`
+	}
+	if err := rollback.DataAs(&data); err != nil {
+		t.Fatalf("decoding rollback event: %v", err)
+	}
+	if data.FailedModule != "zzz_failing" || !slices.Equal(data.RolledBack, []string{"bbb_second", "aaa_first"}) {
+		t.Errorf("unexpected rollback event data: %+v", data)
+	}
+}
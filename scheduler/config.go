@@ -9,6 +9,7 @@ import (
 var (
 	ErrNoPersistenceHandler      = errors.New("no persistence handler configured")
 	ErrUnknownPersistenceBackend = errors.New("unknown persistence backend")
+	ErrPersistenceFileRequired   = errors.New("persistence file path is required for the file backend")
 )
 
 // PersistenceBackend defines the type of persistence backend to use
@@ -21,6 +22,8 @@ const (
 	PersistenceBackendMemory PersistenceBackend = "memory"
 	// PersistenceBackendCustom allows injection of custom persistence handlers
 	PersistenceBackendCustom PersistenceBackend = "custom"
+	// PersistenceBackendFile stores jobs in a JSON file so they survive restarts
+	PersistenceBackendFile PersistenceBackend = "file"
 )
 
 // PersistenceHandler defines the interface for custom persistence backends
@@ -54,6 +57,10 @@ type SchedulerConfig struct {
 	// PersistenceBackend determines the type of persistence to use
 	PersistenceBackend PersistenceBackend `json:"persistenceBackend" yaml:"persistenceBackend" env:"PERSISTENCE_BACKEND" default:"none"`
 
+	// PersistenceFile is the path of the JSON file used by the file backend.
+	// Setting it while PersistenceBackend is "none" selects the file backend.
+	PersistenceFile string `json:"persistenceFile" yaml:"persistenceFile" env:"PERSISTENCE_FILE"`
+
 	// PersistenceHandler allows injection of custom persistence logic
 	// This field is not serializable and must be set programmatically
 	PersistenceHandler PersistenceHandler `json:"-" yaml:"-"`
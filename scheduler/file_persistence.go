@@ -0,0 +1,137 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FilePersistenceHandler implements PersistenceHandler and DurableJobStore
+// using a JSON file on disk, allowing scheduled jobs to survive process
+// restarts.
+type FilePersistenceHandler struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFilePersistenceHandler creates a persistence handler that stores jobs at path
+func NewFilePersistenceHandler(path string) *FilePersistenceHandler {
+	return &FilePersistenceHandler{path: path}
+}
+
+// Path returns the file the handler persists jobs to
+func (h *FilePersistenceHandler) Path() string {
+	return h.path
+}
+
+// Save writes jobs to the persistence file. The file is replaced atomically so
+// a crash during Save never leaves a truncated file behind.
+func (h *FilePersistenceHandler) Save(jobs []Job) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.save(jobs)
+}
+
+// save writes jobs to the persistence file; h.mu must be held
+func (h *FilePersistenceHandler) save(jobs []Job) error {
+	persistedData := struct {
+		Jobs []Job `json:"jobs"`
+	}{
+		Jobs: make([]Job, len(jobs)),
+	}
+	for i, job := range jobs {
+		jobCopy := job
+		jobCopy.JobFunc = nil
+		persistedData.Jobs[i] = jobCopy
+	}
+
+	data, err := json.MarshalIndent(persistedData, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal scheduler jobs to JSON: %w", err)
+	}
+
+	dir := filepath.Dir(h.path)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create persistence directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(h.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary persistence file: %w", err)
+	}
+	tmpName := tmp.Name()
+	defer func() { _ = os.Remove(tmpName) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write persistence file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close persistence file: %w", err)
+	}
+	if err := os.Rename(tmpName, h.path); err != nil {
+		return fmt.Errorf("failed to replace persistence file: %w", err)
+	}
+	return nil
+}
+
+// Load reads jobs from the persistence file. A missing file yields no jobs.
+func (h *FilePersistenceHandler) Load() ([]Job, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.load()
+}
+
+// load reads jobs from the persistence file; h.mu must be held
+func (h *FilePersistenceHandler) load() ([]Job, error) {
+	data, err := os.ReadFile(h.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read persistence file: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var persistedData struct {
+		Jobs []Job `json:"jobs"`
+	}
+	if err := json.Unmarshal(data, &persistedData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scheduler jobs from JSON: %w", err)
+	}
+	return persistedData.Jobs, nil
+}
+
+// SaveJob persists a single job, replacing the persisted job with the same ID
+func (h *FilePersistenceHandler) SaveJob(job Job) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	jobs, err := h.load()
+	if err != nil {
+		return err
+	}
+	return h.save(upsertJob(jobs, job))
+}
+
+// LoadJobs reads all persisted jobs, see Load
+func (h *FilePersistenceHandler) LoadJobs() ([]Job, error) {
+	return h.Load()
+}
+
+// DeleteJob removes a single job from the persistence file
+func (h *FilePersistenceHandler) DeleteJob(jobID string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	jobs, err := h.load()
+	if err != nil {
+		return err
+	}
+	return h.save(removeJob(jobs, jobID))
+}
@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -50,3 +51,88 @@ type PersistableJobStore interface {
 	// SaveJobs saves jobs using the configured persistence handler
 	SaveJobs(jobs []Job, handler PersistenceHandler) error
 }
+
+// DurableJobStore is implemented by persistence handlers that can persist
+// individual jobs. When the configured handler implements it, every job added,
+// updated or removed is written through to the handler, so jobs survive a
+// crash instead of only being saved on shutdown.
+type DurableJobStore interface {
+	// SaveJob persists job, replacing any persisted job with the same ID
+	SaveJob(job Job) error
+
+	// LoadJobs retrieves all persisted jobs
+	LoadJobs() ([]Job, error)
+
+	// DeleteJob removes the persisted job with the given ID
+	DeleteJob(jobID string) error
+}
+
+// writeThroughJobStore is a JobStore that persists every job change to a
+// DurableJobStore after applying it to the wrapped store
+type writeThroughJobStore struct {
+	JobStore
+	durable DurableJobStore
+}
+
+// newWriteThroughJobStore wraps store so job changes are persisted to durable
+func newWriteThroughJobStore(store JobStore, durable DurableJobStore) *writeThroughJobStore {
+	return &writeThroughJobStore{JobStore: store, durable: durable}
+}
+
+// AddJob stores a new job and persists it. If persisting fails the job is
+// removed again so the store and the persisted jobs stay consistent.
+func (s *writeThroughJobStore) AddJob(job Job) error {
+	if err := s.JobStore.AddJob(job); err != nil {
+		return err //nolint:wrapcheck // passthrough of the wrapped store's error
+	}
+	if err := s.durable.SaveJob(job); err != nil {
+		_ = s.JobStore.DeleteJob(job.ID)
+		return fmt.Errorf("failed to persist job %s: %w", job.ID, err)
+	}
+	return nil
+}
+
+// UpdateJob updates an existing job and persists it
+func (s *writeThroughJobStore) UpdateJob(job Job) error {
+	if err := s.JobStore.UpdateJob(job); err != nil {
+		return err //nolint:wrapcheck // passthrough of the wrapped store's error
+	}
+	if err := s.durable.SaveJob(job); err != nil {
+		return fmt.Errorf("failed to persist job %s: %w", job.ID, err)
+	}
+	return nil
+}
+
+// DeleteJob removes a job and its persisted copy
+func (s *writeThroughJobStore) DeleteJob(jobID string) error {
+	if err := s.JobStore.DeleteJob(jobID); err != nil {
+		return err //nolint:wrapcheck // passthrough of the wrapped store's error
+	}
+	if err := s.durable.DeleteJob(jobID); err != nil {
+		return fmt.Errorf("failed to remove persisted job %s: %w", jobID, err)
+	}
+	return nil
+}
+
+// upsertJob returns jobs with job replacing the entry with the same ID, or
+// appended if there is none. JobFunc is cleared as it cannot be persisted.
+func upsertJob(jobs []Job, job Job) []Job {
+	job.JobFunc = nil
+	for i := range jobs {
+		if jobs[i].ID == job.ID {
+			jobs[i] = job
+			return jobs
+		}
+	}
+	return append(jobs, job)
+}
+
+// removeJob returns jobs without the entry with the given ID
+func removeJob(jobs []Job, jobID string) []Job {
+	for i := range jobs {
+		if jobs[i].ID == jobID {
+			return append(jobs[:i], jobs[i+1:]...)
+		}
+	}
+	return jobs
+}
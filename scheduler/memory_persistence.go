@@ -6,7 +6,7 @@ import (
 	"sync"
 )
 
-// MemoryPersistenceHandler implements PersistenceHandler using in-memory storage
+// MemoryPersistenceHandler implements PersistenceHandler and DurableJobStore using in-memory storage
 // This is useful for testing and scenarios where file system persistence isn't needed
 type MemoryPersistenceHandler struct {
 	mu   sync.RWMutex
@@ -22,7 +22,11 @@ func NewMemoryPersistenceHandler() *MemoryPersistenceHandler {
 func (h *MemoryPersistenceHandler) Save(jobs []Job) error {
 	h.mu.Lock()
 	defer h.mu.Unlock()
+	return h.save(jobs)
+}
 
+// save stores jobs; h.mu must be held
+func (h *MemoryPersistenceHandler) save(jobs []Job) error {
 	// Create data structure for persistence
 	persistedData := struct {
 		Jobs []Job `json:"jobs"`
@@ -51,7 +55,11 @@ func (h *MemoryPersistenceHandler) Save(jobs []Job) error {
 func (h *MemoryPersistenceHandler) Load() ([]Job, error) {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
+	return h.load()
+}
 
+// load retrieves the stored jobs; h.mu must be held
+func (h *MemoryPersistenceHandler) load() ([]Job, error) {
 	if len(h.data) == 0 {
 		return nil, nil
 	}
@@ -73,6 +81,35 @@ func (h *MemoryPersistenceHandler) Load() ([]Job, error) {
 	return persistedData.Jobs, nil
 }
 
+// SaveJob persists a single job, replacing the stored job with the same ID
+func (h *MemoryPersistenceHandler) SaveJob(job Job) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	jobs, err := h.load()
+	if err != nil {
+		return err
+	}
+	return h.save(upsertJob(jobs, job))
+}
+
+// LoadJobs retrieves all stored jobs, see Load
+func (h *MemoryPersistenceHandler) LoadJobs() ([]Job, error) {
+	return h.Load()
+}
+
+// DeleteJob removes a single job from memory storage
+func (h *MemoryPersistenceHandler) DeleteJob(jobID string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	jobs, err := h.load()
+	if err != nil {
+		return err
+	}
+	return h.save(removeJob(jobs, jobID))
+}
+
 // GetStoredData returns the raw stored data for inspection (testing purposes)
 func (h *MemoryPersistenceHandler) GetStoredData() []byte {
 	h.mu.RLock()
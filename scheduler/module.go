@@ -45,6 +45,15 @@
 //	scheduledTime := time.Now().Add(time.Minute * 30)
 //	job := scheduler.ScheduleJob("send-reminder", reminderJob, scheduledTime)
 //
+// Persisting jobs across restarts (handlers implementing DurableJobStore, such
+// as the file backend, persist each job as it is added, updated or removed):
+//
+//	// scheduler config (YAML)
+//	//   persistenceFile: "data/scheduler-jobs.json"
+//
+//	// Register task functions by name before Start so persisted jobs can be resumed
+//	scheduler.RegisterTask("nightly-report", reportJob)
+//
 // Job with custom options:
 //
 //	// Create scheduler with custom options
@@ -70,6 +79,8 @@ import (
 // Module errors
 var (
 	ErrJobStoreNotPersistable = errors.New("job store does not implement PersistableJobStore interface")
+	ErrTaskNameRequired       = errors.New("task name is required")
+	ErrTaskFuncNil            = errors.New("task function cannot be nil")
 )
 
 // ModuleName is the unique identifier for the scheduler module.
@@ -100,6 +111,12 @@ type SchedulerModule struct {
 	running       bool
 	schedulerLock sync.Mutex
 	subject       modular.Subject // Added for event observation
+
+	// Task registry used to re-attach job functions to persisted jobs by name
+	tasks      map[string]JobFunc
+	tasksMutex sync.RWMutex
+	// Persisted jobs whose task is not registered; kept so they are saved again
+	orphanedJobs []Job
 }
 
 // NewModule creates a new instance of the scheduler module.
@@ -111,7 +128,8 @@ type SchedulerModule struct {
 //	app.RegisterModule(scheduler.NewModule())
 func NewModule() modular.Module {
 	return &SchedulerModule{
-		name: ModuleName,
+		name:  ModuleName,
+		tasks: make(map[string]JobFunc),
 	}
 }
 
@@ -166,6 +184,11 @@ func (m *SchedulerModule) Init(app modular.Application) error {
 	m.config = cfg.GetConfig().(*SchedulerConfig)
 	m.logger = app.Logger()
 
+	// A persistence file implies the file backend unless another backend is chosen
+	if m.config.PersistenceFile != "" && m.config.PersistenceBackend == PersistenceBackendNone {
+		m.config.PersistenceBackend = PersistenceBackendFile
+	}
+
 	// Emit config loaded event
 	m.emitEvent(context.Background(), EventTypeConfigLoaded, map[string]interface{}{
 		"worker_count":        m.config.WorkerCount,
@@ -187,17 +210,29 @@ func (m *SchedulerModule) Init(app modular.Application) error {
 		m.logger.Warn("Unknown storage type, using memory job store", "specified", m.config.StorageType)
 	}
 
+	// Persist job changes as they happen when the persistence handler supports it
+	schedulerStore := m.jobStore
+	if m.config.PersistenceBackend != PersistenceBackendNone {
+		if handler, err := m.getPersistenceHandler(); err == nil {
+			if durable, ok := handler.(DurableJobStore); ok {
+				schedulerStore = newWriteThroughJobStore(m.jobStore, durable)
+			}
+		}
+	}
+
 	// Initialize the scheduler
 	m.scheduler = NewScheduler(
-		m.jobStore,
+		schedulerStore,
 		WithWorkerCount(m.config.WorkerCount),
 		WithQueueSize(m.config.QueueSize),
 		WithCheckInterval(m.config.CheckInterval),
+		WithFinishedJobRetention(time.Duration(m.config.RetentionDays)*24*time.Hour),
 		WithLogger(m.logger),
 		WithEventEmitter(m),
 	)
 
 	// Load persisted jobs if enabled
+	m.orphanedJobs = nil
 	if m.config.PersistenceBackend != PersistenceBackendNone {
 		err := m.loadPersistedJobs()
 		if err != nil {
@@ -221,6 +256,9 @@ func (m *SchedulerModule) Start(ctx context.Context) error {
 		return nil
 	}
 
+	// Re-attach registered task functions to jobs recovered from persistence
+	m.restoreTaskFuncs()
+
 	// Start the scheduler
 	err := m.scheduler.Start(ctx)
 	if err != nil {
@@ -344,9 +382,39 @@ func (m *SchedulerModule) ScheduleJob(job Job) (string, error) {
 	return jobID, nil
 }
 
-// ScheduleRecurring schedules a recurring job using a cron expression
-func (m *SchedulerModule) ScheduleRecurring(name string, cronExpr string, jobFunc JobFunc) (string, error) {
-	return m.scheduler.ScheduleRecurring(name, cronExpr, jobFunc)
+// ScheduleRecurring schedules a recurring job using a cron expression.
+// Runs of the job never overlap unless WithAllowConcurrent(true) is passed:
+// a trigger that fires while the previous run is still executing is skipped
+// and recorded in the job history with status "skipped".
+func (m *SchedulerModule) ScheduleRecurring(name string, cronExpr string, jobFunc JobFunc, opts ...JobOption) (string, error) {
+	return m.scheduler.ScheduleRecurring(name, cronExpr, jobFunc, opts...)
+}
+
+// ScheduleOnce schedules a job that runs exactly once at runAt and then
+// removes itself; it no longer appears in ListJobs, but its final status
+// (completed or failed) remains available through GetJob for RetentionDays
+// (the most recent 1000 such jobs are kept). A runAt in the past
+// runs the job immediately. Pending one-time jobs are persisted like other
+// jobs and, if overdue after a restart, run as soon as the scheduler starts.
+//
+// Example:
+//
+//	jobID, err := sched.ScheduleOnce("send-reminder", time.Now().Add(time.Hour), reminderJob)
+func (m *SchedulerModule) ScheduleOnce(name string, runAt time.Time, job JobFunc) (string, error) {
+	jobID, err := m.scheduler.ScheduleOnce(name, runAt, job)
+	if err != nil {
+		return "", err
+	}
+
+	// Emit job scheduled event
+	m.emitEvent(context.Background(), EventTypeJobScheduled, map[string]interface{}{
+		"job_id":        jobID,
+		"job_name":      name,
+		"schedule_time": runAt.Format(time.RFC3339),
+		"is_recurring":  false,
+	})
+
+	return jobID, nil
 }
 
 // CancelJob cancels a scheduled job
@@ -364,6 +432,76 @@ func (m *SchedulerModule) ListJobs() ([]Job, error) {
 	return m.scheduler.ListJobs()
 }
 
+// RegisterTask registers the function that runs jobs with the given name.
+// Job functions cannot be persisted, so when a persisted job is reloaded on
+// Start its function is looked up here by the job's name. Register tasks
+// before the module starts, typically from a dependent module's Init.
+//
+// Example:
+//
+//	sched.RegisterTask("cleanup", cleanupFunc)
+//	sched.ScheduleRecurring("cleanup", "0 * * * *", cleanupFunc)
+func (m *SchedulerModule) RegisterTask(name string, fn JobFunc) error {
+	if name == "" {
+		return ErrTaskNameRequired
+	}
+	if fn == nil {
+		return fmt.Errorf("%w: %s", ErrTaskFuncNil, name)
+	}
+	m.tasksMutex.Lock()
+	defer m.tasksMutex.Unlock()
+	if m.tasks == nil {
+		m.tasks = make(map[string]JobFunc)
+	}
+	m.tasks[name] = fn
+	return nil
+}
+
+// lookupTask returns the registered function for a task name
+func (m *SchedulerModule) lookupTask(name string) (JobFunc, bool) {
+	m.tasksMutex.RLock()
+	defer m.tasksMutex.RUnlock()
+	fn, ok := m.tasks[name]
+	return fn, ok
+}
+
+// restoreTaskFuncs attaches registered task functions to jobs that were loaded
+// from persistence without one. Recurring jobs whose task is no longer
+// registered are logged and skipped: they are removed from the active store but
+// kept for persistence, so they resume once the task is registered again.
+func (m *SchedulerModule) restoreTaskFuncs() {
+	if m.jobStore == nil || m.config == nil || m.config.PersistenceBackend == PersistenceBackendNone {
+		return
+	}
+	jobs, err := m.jobStore.GetJobs()
+	if err != nil {
+		m.logger.Warn("Failed to list jobs for task restoration", "error", err)
+		return
+	}
+
+	for _, job := range jobs {
+		if job.JobFunc != nil || job.Status != JobStatusPending {
+			continue
+		}
+		if fn, ok := m.lookupTask(job.Name); ok {
+			job.JobFunc = fn
+			if err := m.jobStore.UpdateJob(job); err != nil {
+				m.logger.Warn("Failed to restore task for persisted job", "jobID", job.ID, "task", job.Name, "error", err)
+			}
+			continue
+		}
+		if !job.IsRecurring {
+			continue
+		}
+		m.logger.Warn("Skipping persisted recurring job with unregistered task", "jobID", job.ID, "task", job.Name)
+		if err := m.jobStore.DeleteJob(job.ID); err != nil {
+			m.logger.Warn("Failed to remove persisted job with unregistered task", "jobID", job.ID, "error", err)
+			continue
+		}
+		m.orphanedJobs = append(m.orphanedJobs, job)
+	}
+}
+
 // GetJobHistory returns the execution history for a job
 func (m *SchedulerModule) GetJobHistory(jobID string) ([]JobExecution, error) {
 	return m.scheduler.GetJobHistory(jobID)
@@ -480,6 +618,7 @@ func (m *SchedulerModule) savePersistedJobs() error {
 		if err != nil {
 			return fmt.Errorf("failed to list jobs for persistence: %w", err)
 		}
+		jobs = append(jobs, m.orphanedJobs...)
 
 		err = persistable.SaveJobs(jobs, handler)
 		if err != nil {
@@ -513,6 +652,14 @@ func (m *SchedulerModule) getPersistenceHandler() (PersistenceHandler, error) {
 			return nil, ErrNoPersistenceHandler
 		}
 		return m.config.PersistenceHandler, nil
+	case PersistenceBackendFile:
+		if m.config.PersistenceHandler != nil {
+			return m.config.PersistenceHandler, nil
+		}
+		if m.config.PersistenceFile == "" {
+			return nil, ErrPersistenceFileRequired
+		}
+		return NewFilePersistenceHandler(m.config.PersistenceFile), nil
 	default:
 		return nil, fmt.Errorf("%w: %s", ErrUnknownPersistenceBackend, m.config.PersistenceBackend)
 	}
@@ -3,8 +3,10 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -579,3 +581,309 @@ func TestJobPersistence(t *testing.T) {
 		persistenceHandler.Clear()
 	})
 }
+
+func TestFileJobPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.json")
+	newConfig := func() *SchedulerConfig {
+		return &SchedulerConfig{
+			WorkerCount:        2,
+			QueueSize:          10,
+			StorageType:        "memory",
+			CheckInterval:      50 * time.Millisecond,
+			PersistenceBackend: PersistenceBackendNone,
+			PersistenceFile:    path,
+			ShutdownTimeout:    1 * time.Second,
+		}
+	}
+	startModule := func(t *testing.T, register func(*SchedulerModule)) *SchedulerModule {
+		t.Helper()
+		module := NewModule().(*SchedulerModule)
+		app := newMockApp()
+		app.RegisterConfigSection(ModuleName, modular.NewStdConfigProvider(newConfig()))
+		require.NoError(t, module.Init(app))
+		assert.Equal(t, PersistenceBackendFile, module.config.PersistenceBackend)
+		if register != nil {
+			register(module)
+		}
+		require.NoError(t, module.Start(context.Background()))
+		return module
+	}
+	ctx := context.Background()
+
+	// First run schedules two recurring jobs and persists them on Stop
+	first := startModule(t, nil)
+	noop := func(ctx context.Context) error { return nil }
+	reportID, err := first.ScheduleRecurring("report", "*/5 * * * *", noop)
+	require.NoError(t, err)
+	legacyID, err := first.ScheduleRecurring("legacy", "*/5 * * * *", noop)
+	require.NoError(t, err)
+	require.NoError(t, first.Stop(ctx))
+	require.FileExists(t, path)
+
+	// Second run only registers "report"; "legacy" must be skipped, not crash startup
+	var ran sync.WaitGroup
+	ran.Add(1)
+	var once sync.Once
+	second := startModule(t, func(m *SchedulerModule) {
+		require.NoError(t, m.RegisterTask("report", func(ctx context.Context) error {
+			once.Do(ran.Done)
+			return nil
+		}))
+	})
+
+	job, err := second.GetJob(reportID)
+	require.NoError(t, err)
+	assert.NotNil(t, job.JobFunc, "registered task should be re-attached")
+	_, err = second.GetJob(legacyID)
+	require.ErrorIs(t, err, ErrJobNotFound)
+
+	// Run the restored job now instead of waiting for its cron schedule
+	now := time.Now()
+	job.NextRun = &now
+	require.NoError(t, second.jobStore.UpdateJob(job))
+	done := make(chan struct{})
+	go func() { ran.Wait(); close(done) }()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("restored task was not executed")
+	}
+	require.NoError(t, second.Stop(ctx))
+
+	// The skipped job is preserved in the file for a later run that registers it
+	jobs, err := NewFilePersistenceHandler(path).Load()
+	require.NoError(t, err)
+	names := make([]string, 0, len(jobs))
+	for _, j := range jobs {
+		names = append(names, j.Name)
+	}
+	assert.ElementsMatch(t, []string{"report", "legacy"}, names)
+}
+
+func TestFileJobPersistenceWritesThrough(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.json")
+	module := NewModule().(*SchedulerModule)
+	app := newMockApp()
+	app.RegisterConfigSection(ModuleName, modular.NewStdConfigProvider(&SchedulerConfig{
+		WorkerCount:        2,
+		QueueSize:          10,
+		StorageType:        "memory",
+		CheckInterval:      20 * time.Millisecond,
+		PersistenceBackend: PersistenceBackendNone,
+		PersistenceFile:    path,
+		ShutdownTimeout:    1 * time.Second,
+	}))
+	require.NoError(t, module.Init(app))
+	require.NoError(t, module.Start(context.Background()))
+	defer func() { _ = module.Stop(context.Background()) }()
+
+	persisted := func() map[string]Job {
+		jobs, err := NewFilePersistenceHandler(path).LoadJobs()
+		require.NoError(t, err)
+		byID := make(map[string]Job, len(jobs))
+		for _, job := range jobs {
+			byID[job.ID] = job
+		}
+		return byID
+	}
+	noop := func(ctx context.Context) error { return nil }
+
+	// Added jobs are persisted immediately, not only on Stop
+	reportID, err := module.ScheduleRecurring("report", "*/5 * * * *", noop)
+	require.NoError(t, err)
+	require.Contains(t, persisted(), reportID)
+
+	// Updates are persisted
+	require.NoError(t, module.CancelJob(reportID))
+	assert.Equal(t, JobStatusCancelled, persisted()[reportID].Status)
+
+	// Removed jobs are deleted from the file
+	onceID, err := module.ScheduleOnce("once", time.Now(), noop)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		_, ok := persisted()[onceID]
+		return !ok
+	}, 2*time.Second, 20*time.Millisecond)
+	assert.Contains(t, persisted(), reportID)
+}
+
+func TestRegisterTaskValidation(t *testing.T) {
+	module := NewModule().(*SchedulerModule)
+	require.ErrorIs(t, module.RegisterTask("", func(ctx context.Context) error { return nil }), ErrTaskNameRequired)
+	require.ErrorIs(t, module.RegisterTask("task", nil), ErrTaskFuncNil)
+}
+
+func TestScheduleOnce(t *testing.T) {
+	newStartedModule := func(t *testing.T, checkInterval time.Duration) *SchedulerModule {
+		t.Helper()
+		module := NewModule().(*SchedulerModule)
+		app := newMockApp()
+		app.RegisterConfigSection(ModuleName, modular.NewStdConfigProvider(&SchedulerConfig{
+			WorkerCount:        2,
+			QueueSize:          10,
+			StorageType:        "memory",
+			CheckInterval:      checkInterval,
+			PersistenceBackend: PersistenceBackendNone,
+			ShutdownTimeout:    1 * time.Second,
+		}))
+		require.NoError(t, module.Init(app))
+		require.NoError(t, module.Start(context.Background()))
+		t.Cleanup(func() { _ = module.Stop(context.Background()) })
+		return module
+	}
+	waitForStatus := func(t *testing.T, module *SchedulerModule, jobID string, status JobStatus) Job {
+		t.Helper()
+		var job Job
+		require.Eventually(t, func() bool {
+			var err error
+			job, err = module.GetJob(jobID)
+			return err == nil && job.Status == status
+		}, 2*time.Second, 10*time.Millisecond)
+		return job
+	}
+
+	t.Run("RunsOnceAndIsRemoved", func(t *testing.T) {
+		module := newStartedModule(t, 20*time.Millisecond)
+
+		var runs atomic.Int32
+		jobID, err := module.ScheduleOnce("once", time.Now().Add(50*time.Millisecond), func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		})
+		require.NoError(t, err)
+
+		job := waitForStatus(t, module, jobID, JobStatusCompleted)
+		assert.NotNil(t, job.LastRun)
+
+		// Give the dispatcher several more intervals to prove it never runs again
+		time.Sleep(150 * time.Millisecond)
+		assert.Equal(t, int32(1), runs.Load())
+
+		jobs, err := module.ListJobs()
+		require.NoError(t, err)
+		for _, j := range jobs {
+			assert.NotEqual(t, jobID, j.ID, "one-time job should be removed after running")
+		}
+	})
+
+	t.Run("PastRunAtRunsImmediately", func(t *testing.T) {
+		// With an hourly check interval only the immediate dispatch can run the job in time
+		module := newStartedModule(t, time.Hour)
+
+		ran := make(chan struct{}, 1)
+		jobID, err := module.ScheduleOnce("overdue", time.Now().Add(-time.Hour), func(ctx context.Context) error {
+			ran <- struct{}{}
+			return nil
+		})
+		require.NoError(t, err)
+
+		select {
+		case <-ran:
+		case <-time.After(time.Second):
+			t.Fatal("overdue one-time job did not run immediately")
+		}
+		waitForStatus(t, module, jobID, JobStatusCompleted)
+	})
+
+	t.Run("RecordsFailure", func(t *testing.T) {
+		module := newStartedModule(t, 20*time.Millisecond)
+
+		jobID, err := module.ScheduleOnce("failing", time.Now(), func(ctx context.Context) error {
+			return fmt.Errorf("boom")
+		})
+		require.NoError(t, err)
+		waitForStatus(t, module, jobID, JobStatusFailed)
+
+		jobs, err := module.ListJobs()
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+	})
+}
+
+func TestFinishedJobsAreBounded(t *testing.T) {
+	s := NewScheduler(NewMemoryJobStore(time.Hour), WithFinishedJobRetention(time.Minute))
+
+	// Entries past the retention are evicted and no longer returned
+	expired := Job{ID: "expired", Status: JobStatusCompleted, UpdatedAt: time.Now().Add(-2 * time.Minute)}
+	s.recordFinished(expired)
+	_, err := s.GetJob("expired")
+	require.Error(t, err)
+
+	// The number of kept entries is capped, evicting the oldest first
+	for i := 0; i < maxFinishedJobs+10; i++ {
+		s.recordFinished(Job{ID: fmt.Sprintf("job-%d", i), Status: JobStatusCompleted, UpdatedAt: time.Now()})
+	}
+	s.finishedMutex.RLock()
+	assert.Len(t, s.finishedJobs, maxFinishedJobs)
+	assert.Len(t, s.finishedOrder, maxFinishedJobs)
+	assert.NotContains(t, s.finishedJobs, "expired")
+	assert.NotContains(t, s.finishedJobs, "job-0")
+	s.finishedMutex.RUnlock()
+
+	job, err := s.GetJob(fmt.Sprintf("job-%d", maxFinishedJobs+9))
+	require.NoError(t, err)
+	assert.Equal(t, JobStatusCompleted, job.Status)
+}
+
+func TestRecurringJobOverlapProtection(t *testing.T) {
+	newRunningScheduler := func(t *testing.T) *Scheduler {
+		t.Helper()
+		s := NewScheduler(NewMemoryJobStore(time.Hour), WithWorkerCount(4), WithCheckInterval(50*time.Millisecond))
+		require.NoError(t, s.Start(context.Background()))
+		t.Cleanup(func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = s.Stop(ctx)
+		})
+		return s
+	}
+	// blockingJob blocks past the next fire time and tracks peak concurrency
+	blockingJob := func(active, peak *atomic.Int32) JobFunc {
+		return func(ctx context.Context) error {
+			n := active.Add(1)
+			defer active.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			select {
+			case <-time.After(2500 * time.Millisecond):
+			case <-ctx.Done():
+			}
+			return nil
+		}
+	}
+
+	t.Run("SkipsOverlappingRuns", func(t *testing.T) {
+		s := newRunningScheduler(t)
+		var active, peak atomic.Int32
+		jobID, err := s.ScheduleRecurring("slow", "@every 1s", blockingJob(&active, &peak))
+		require.NoError(t, err)
+
+		time.Sleep(3200 * time.Millisecond)
+		assert.Equal(t, int32(1), peak.Load(), "runs of the job must not overlap")
+
+		history, err := s.GetJobHistory(jobID)
+		require.NoError(t, err)
+		var skipped int
+		for _, exec := range history {
+			if exec.Status == ExecutionStatusSkipped {
+				skipped++
+				assert.Equal(t, "skipped: still running", exec.Error)
+			}
+		}
+		assert.Positive(t, skipped, "expected skipped executions while the job was running")
+	})
+
+	t.Run("AllowConcurrent", func(t *testing.T) {
+		s := newRunningScheduler(t)
+		var active, peak atomic.Int32
+		_, err := s.ScheduleRecurring("slow", "@every 1s", blockingJob(&active, &peak), WithAllowConcurrent(true))
+		require.NoError(t, err)
+
+		time.Sleep(2200 * time.Millisecond)
+		assert.GreaterOrEqual(t, peak.Load(), int32(2), "concurrent runs should be allowed")
+	})
+}
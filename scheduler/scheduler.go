@@ -64,8 +64,32 @@ type Job struct {
 	Status      JobStatus  `json:"status"`
 	LastRun     *time.Time `json:"lastRun,omitempty"`
 	NextRun     *time.Time `json:"nextRun,omitempty"`
+	// AutoRemove removes a one-time job from the store after it runs.
+	// Its final state remains available through GetJob.
+	AutoRemove bool `json:"autoRemove,omitempty"`
+	// AllowConcurrent permits a new run of the job to start while a previous
+	// run is still executing. When false (the default) such triggers are
+	// skipped and recorded as skipped executions.
+	AllowConcurrent bool `json:"allowConcurrent,omitempty"`
 }
 
+// JobOption configures optional job settings
+type JobOption func(*Job)
+
+// WithAllowConcurrent sets whether runs of a job may overlap
+func WithAllowConcurrent(allow bool) JobOption {
+	return func(j *Job) {
+		j.AllowConcurrent = allow
+	}
+}
+
+// ExecutionStatusSkipped is the JobExecution status recorded for a trigger
+// that was skipped because a previous run of the job was still executing.
+const ExecutionStatusSkipped = "skipped"
+
+// skippedStillRunning is the reason recorded for skipped executions
+const skippedStillRunning = "skipped: still running"
+
 // JobStatus represents the status of a job
 type JobStatus string
 
@@ -99,8 +123,21 @@ type Scheduler struct {
 	wg             sync.WaitGroup
 	isStarted      atomic.Bool
 	schedulerMutex sync.Mutex
+	finishedJobs   map[string]Job // Final state of auto-removed one-time jobs
+	finishedOrder  []string       // IDs in finishedJobs, oldest first
+	finishedTTL    time.Duration
+	finishedMutex  sync.RWMutex
+	runningJobs    map[string]int // Number of in-flight runs per job ID
+	runningMutex   sync.Mutex
 }
 
+// The final states of auto-removed one-time jobs are kept for GetJob for a
+// limited time and up to a limited count, so they do not accumulate forever.
+const (
+	defaultFinishedJobRetention = 24 * time.Hour
+	maxFinishedJobs             = 1000
+)
+
 // debugEnabled returns true when SCHEDULER_DEBUG env var is set to a non-empty value
 func debugEnabled() bool { return os.Getenv("SCHEDULER_DEBUG") != "" }
 
@@ -161,6 +198,16 @@ func WithEventEmitter(emitter EventEmitter) SchedulerOption {
 	}
 }
 
+// WithFinishedJobRetention sets how long GetJob keeps returning the final
+// state of a one-time job after it ran and was removed
+func WithFinishedJobRetention(retention time.Duration) SchedulerOption {
+	return func(s *Scheduler) {
+		if retention > 0 {
+			s.finishedTTL = retention
+		}
+	}
+}
+
 // NewScheduler creates a new scheduler
 func NewScheduler(jobStore JobStore, opts ...SchedulerOption) *Scheduler {
 	s := &Scheduler{
@@ -169,6 +216,9 @@ func NewScheduler(jobStore JobStore, opts ...SchedulerOption) *Scheduler {
 		queueSize:     100,
 		checkInterval: time.Second,
 		cronEntries:   make(map[string]cron.EntryID),
+		finishedJobs:  make(map[string]Job),
+		finishedTTL:   defaultFinishedJobRetention,
+		runningJobs:   make(map[string]int),
 	}
 
 	// Apply options
@@ -345,6 +395,12 @@ func (s *Scheduler) executeJob(job Job) {
 		}
 	}()
 
+	if !s.beginRun(job) {
+		s.recordSkippedRun(job)
+		return
+	}
+	defer s.endRun(job.ID)
+
 	if s.logger != nil {
 		s.logger.Debug("Executing job", "id", job.ID, "name", job.Name)
 	}
@@ -427,6 +483,15 @@ func (s *Scheduler) executeJob(job Job) {
 
 	// For non-recurring jobs, we're done
 	if !job.IsRecurring {
+		if job.AutoRemove {
+			job.NextRun = nil
+			job.UpdatedAt = now
+			s.recordFinished(job)
+			if err := s.jobStore.DeleteJob(job.ID); err != nil && s.logger != nil {
+				s.logger.Warn("Failed to remove one-time job", "jobID", job.ID, "error", err)
+			}
+			return
+		}
 		if err := s.jobStore.UpdateJob(job); err != nil && s.logger != nil {
 			s.logger.Warn("Failed to update completed job", "jobID", job.ID, "error", err)
 		}
@@ -450,6 +515,77 @@ func (s *Scheduler) executeJob(job Job) {
 	}
 }
 
+// recordFinished keeps the final state of an auto-removed one-time job for
+// GetJob, evicting entries that are expired or exceed maxFinishedJobs
+func (s *Scheduler) recordFinished(job Job) {
+	s.finishedMutex.Lock()
+	defer s.finishedMutex.Unlock()
+
+	if _, exists := s.finishedJobs[job.ID]; !exists {
+		s.finishedOrder = append(s.finishedOrder, job.ID)
+	}
+	s.finishedJobs[job.ID] = job
+
+	evict := 0
+	for _, id := range s.finishedOrder {
+		if len(s.finishedOrder)-evict <= maxFinishedJobs && time.Since(s.finishedJobs[id].UpdatedAt) < s.finishedTTL {
+			break
+		}
+		delete(s.finishedJobs, id)
+		evict++
+	}
+	s.finishedOrder = s.finishedOrder[evict:]
+}
+
+// beginRun registers a run of job, returning false if the job does not allow
+// concurrent runs and a previous run is still executing
+func (s *Scheduler) beginRun(job Job) bool {
+	s.runningMutex.Lock()
+	defer s.runningMutex.Unlock()
+	if !job.AllowConcurrent && s.runningJobs[job.ID] > 0 {
+		return false
+	}
+	s.runningJobs[job.ID]++
+	return true
+}
+
+// endRun unregisters a run started with beginRun
+func (s *Scheduler) endRun(jobID string) {
+	s.runningMutex.Lock()
+	defer s.runningMutex.Unlock()
+	if s.runningJobs[jobID] <= 1 {
+		delete(s.runningJobs, jobID)
+		return
+	}
+	s.runningJobs[jobID]--
+}
+
+// isRunning reports whether a run of the job is currently executing
+func (s *Scheduler) isRunning(jobID string) bool {
+	s.runningMutex.Lock()
+	defer s.runningMutex.Unlock()
+	return s.runningJobs[jobID] > 0
+}
+
+// recordSkippedRun records an execution for a trigger skipped because the
+// previous run of the job is still executing
+func (s *Scheduler) recordSkippedRun(job Job) {
+	if s.logger != nil {
+		s.logger.Warn("Skipping job trigger, previous run still executing", "id", job.ID, "name", job.Name)
+	}
+	now := time.Now()
+	execution := JobExecution{
+		JobID:     job.ID,
+		StartTime: now,
+		EndTime:   now,
+		Status:    ExecutionStatusSkipped,
+		Error:     skippedStillRunning,
+	}
+	if err := s.jobStore.AddJobExecution(execution); err != nil && s.logger != nil {
+		s.logger.Warn("Failed to record skipped job execution", "jobID", job.ID, "error", err)
+	}
+}
+
 // dispatchPendingJobs checks for and dispatches pending jobs
 func (s *Scheduler) dispatchPendingJobs() {
 	defer s.wg.Done()
@@ -594,8 +730,14 @@ func (s *Scheduler) registerWithCron(job Job) {
 			return
 		}
 
-		// Only queue if job still exists and isn't already running
-		if retrievedJob.Status != JobStatusRunning {
+		// Skip the trigger if the previous run is still executing and overlap is not allowed
+		if !retrievedJob.AllowConcurrent && (retrievedJob.Status == JobStatusRunning || s.isRunning(job.ID)) {
+			s.recordSkippedRun(retrievedJob)
+			return
+		}
+
+		// Only queue if job still exists and isn't cancelled
+		if retrievedJob.Status != JobStatusCancelled {
 			select {
 			case s.jobQueue <- retrievedJob:
 				if s.logger != nil {
@@ -616,14 +758,18 @@ func (s *Scheduler) registerWithCron(job Job) {
 	}
 }
 
-// ScheduleRecurring schedules a recurring job using a cron expression
-func (s *Scheduler) ScheduleRecurring(name string, cronExpr string, jobFunc JobFunc) (string, error) {
+// ScheduleRecurring schedules a recurring job using a cron expression.
+// By default runs never overlap; use WithAllowConcurrent(true) to permit it.
+func (s *Scheduler) ScheduleRecurring(name string, cronExpr string, jobFunc JobFunc, opts ...JobOption) (string, error) {
 	job := Job{
 		Name:        name,
 		Schedule:    cronExpr,
 		IsRecurring: true,
 		JobFunc:     jobFunc,
 	}
+	for _, opt := range opts {
+		opt(&job)
+	}
 	return s.ScheduleJob(job)
 }
 
@@ -662,10 +808,37 @@ func (s *Scheduler) CancelJob(jobID string) error {
 	return nil
 }
 
-// GetJob returns information about a scheduled job
+// ScheduleOnce schedules a job that runs exactly once at runAt and is then
+// removed from the job store. A runAt in the past runs the job immediately.
+func (s *Scheduler) ScheduleOnce(name string, runAt time.Time, jobFunc JobFunc) (string, error) {
+	jobID, err := s.ScheduleJob(Job{
+		Name:       name,
+		RunAt:      runAt,
+		JobFunc:    jobFunc,
+		AutoRemove: true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Dispatch overdue jobs now rather than waiting for the next check interval
+	if !runAt.After(time.Now()) && s.isStarted.Load() {
+		s.checkAndDispatchJobs()
+	}
+	return jobID, nil
+}
+
+// GetJob returns information about a scheduled job. For one-time jobs that
+// have already run and been removed, the job's final state is returned.
 func (s *Scheduler) GetJob(jobID string) (Job, error) {
 	job, err := s.jobStore.GetJob(jobID)
 	if err != nil {
+		s.finishedMutex.RLock()
+		finished, ok := s.finishedJobs[jobID]
+		s.finishedMutex.RUnlock()
+		if ok && time.Since(finished.UpdatedAt) < s.finishedTTL {
+			return finished, nil
+		}
 		return Job{}, fmt.Errorf("failed to get job: %w", err)
 	}
 	return job, nil
@@ -2,8 +2,13 @@ package modular
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"reflect"
 	"regexp"
+	"strings"
 )
 
 // SecretResolver resolves secret references in configuration values.
@@ -14,6 +19,229 @@ type SecretResolver interface {
 
 var secretRefPattern = regexp.MustCompile(`^\$\{([^:}]+:[^}]+)\}$`)
 
+// Prefixes of the references handled by the built-in secret resolvers
+const (
+	envSecretPrefix  = "env:"
+	fileSecretPrefix = "file:"
+)
+
+// EnvSecretResolver resolves ${env:NAME} references from environment variables.
+type EnvSecretResolver struct{}
+
+// NewEnvSecretResolver creates a resolver for ${env:NAME} references.
+func NewEnvSecretResolver() *EnvSecretResolver {
+	return &EnvSecretResolver{}
+}
+
+// CanResolve reports whether ref is an env reference.
+func (r *EnvSecretResolver) CanResolve(ref string) bool {
+	return strings.HasPrefix(ref, envSecretPrefix)
+}
+
+// ResolveSecret returns the value of the referenced environment variable.
+func (r *EnvSecretResolver) ResolveSecret(_ context.Context, ref string) (string, error) {
+	name := strings.TrimPrefix(ref, envSecretPrefix)
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("%w: environment variable %s is not set", ErrSecretNotFound, name)
+	}
+	return value, nil
+}
+
+// FileSecretResolver resolves ${file:/path} references by reading the file,
+// as mounted by Docker and Kubernetes secrets. A trailing newline is removed.
+type FileSecretResolver struct{}
+
+// NewFileSecretResolver creates a resolver for ${file:/path} references.
+func NewFileSecretResolver() *FileSecretResolver {
+	return &FileSecretResolver{}
+}
+
+// CanResolve reports whether ref is a file reference.
+func (r *FileSecretResolver) CanResolve(ref string) bool {
+	return strings.HasPrefix(ref, fileSecretPrefix)
+}
+
+// ResolveSecret returns the contents of the referenced file.
+func (r *FileSecretResolver) ResolveSecret(_ context.Context, ref string) (string, error) {
+	path := strings.TrimPrefix(ref, fileSecretPrefix)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("%w: file %s does not exist", ErrSecretNotFound, path)
+		}
+		return "", fmt.Errorf("reading secret file %s: %w", path, err)
+	}
+	value := strings.TrimSuffix(string(data), "\n")
+	return strings.TrimSuffix(value, "\r"), nil
+}
+
+// SecretFetchFunc fetches the secret stored at path in an external secret store.
+type SecretFetchFunc func(ctx context.Context, path string) (string, error)
+
+// ExternalSecretResolver resolves ${prefix:path} references through an external
+// secret store such as HashiCorp Vault or AWS Secrets Manager, keeping the
+// framework free of the store's client dependencies.
+type ExternalSecretResolver struct {
+	prefix string
+	fetch  SecretFetchFunc
+}
+
+// NewExternalSecretResolver creates a resolver that passes the path of
+// ${prefix:path} references to fetch.
+//
+// Example:
+//
+//	vault := modular.NewExternalSecretResolver("vault", func(ctx context.Context, path string) (string, error) {
+//	    secret, err := client.KVv2("secret").Get(ctx, path)
+//	    if err != nil {
+//	        return "", err
+//	    }
+//	    return secret.Data["value"].(string), nil
+//	})
+//	app, err := modular.NewApplication(modular.WithSecretResolvers(vault), ...)
+func NewExternalSecretResolver(prefix string, fetch SecretFetchFunc) *ExternalSecretResolver {
+	return &ExternalSecretResolver{prefix: prefix + ":", fetch: fetch}
+}
+
+// CanResolve reports whether ref uses the resolver's prefix.
+func (r *ExternalSecretResolver) CanResolve(ref string) bool {
+	return strings.HasPrefix(ref, r.prefix)
+}
+
+// ResolveSecret fetches the referenced secret from the external store.
+func (r *ExternalSecretResolver) ResolveSecret(ctx context.Context, ref string) (string, error) {
+	return r.fetch(ctx, strings.TrimPrefix(ref, r.prefix))
+}
+
+// DefaultSecretResolvers returns the built-in env and file resolvers, which
+// are always available to config fields tagged `secret:"true"`.
+func DefaultSecretResolvers() []SecretResolver {
+	return []SecretResolver{NewEnvSecretResolver(), NewFileSecretResolver()}
+}
+
+// ResolveSecretFields resolves the fields of the struct cfg points to that
+// are tagged `secret:"true"`. A string field holding a reference such as
+// ${file:/run/secrets/db-password} is replaced with the value returned by the
+// first resolver that can resolve it; other values are left unchanged. Nested
+// structs, pointers, maps and slices are walked. Errors name the field and the
+// reference but never the secret value.
+//
+// Example:
+//
+//	type DBConfig struct {
+//	    Password string `yaml:"password" secret:"true"`
+//	}
+//
+//	err := modular.ResolveSecretFields(ctx, &cfg, modular.DefaultSecretResolvers()...)
+func ResolveSecretFields(ctx context.Context, cfg any, resolvers ...SecretResolver) error {
+	if cfg == nil {
+		return ErrConfigNil
+	}
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer {
+		return ErrConfigNotPointer
+	}
+	return resolveSecretValue(ctx, v, "", resolvers)
+}
+
+// resolveSecretValue walks v looking for secret fields to resolve
+func resolveSecretValue(ctx context.Context, v reflect.Value, path string, resolvers []SecretResolver) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		return resolveSecretValue(ctx, v.Elem(), path, resolvers)
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fieldPath := field.Name
+			if path != "" {
+				fieldPath = path + "." + field.Name
+			}
+			if field.Tag.Get(tagSecret) == "true" {
+				if err := resolveSecretField(ctx, v.Field(i), fieldPath, resolvers); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := resolveSecretValue(ctx, v.Field(i), fieldPath, resolvers); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := resolveSecretValue(ctx, v.Index(i), fmt.Sprintf("%s[%d]", path, i), resolvers); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if v.IsNil() || !mayHoldSecretFields(v.Type().Elem()) {
+			return nil
+		}
+		for _, key := range v.MapKeys() {
+			// Map elements are not addressable, so resolve a copy and store it back
+			elem := reflect.New(v.Type().Elem()).Elem()
+			elem.Set(v.MapIndex(key))
+			if err := resolveSecretValue(ctx, elem, fmt.Sprintf("%s.%v", path, key), resolvers); err != nil {
+				return err
+			}
+			v.SetMapIndex(key, elem)
+		}
+	default:
+	}
+	return nil
+}
+
+// resolveSecretField replaces the secret reference held by a string or
+// *string field with the resolved secret
+func resolveSecretField(ctx context.Context, v reflect.Value, path string, resolvers []SecretResolver) error {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.String {
+		return nil
+	}
+
+	match := secretRefPattern.FindStringSubmatch(v.String())
+	if match == nil {
+		return nil
+	}
+	ref := match[1]
+	for _, r := range resolvers {
+		if !r.CanResolve(ref) {
+			continue
+		}
+		value, err := r.ResolveSecret(ctx, ref)
+		if err != nil {
+			return fmt.Errorf("resolving secret field %s from %q: %w", path, ref, err)
+		}
+		v.SetString(value)
+		return nil
+	}
+	return fmt.Errorf("%w %q in secret field %s", ErrSecretResolverNotFound, ref, path)
+}
+
+// mayHoldSecretFields reports whether values of type t can contain struct fields
+func mayHoldSecretFields(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return mayHoldSecretFields(t.Elem())
+	case reflect.Struct:
+		return true
+	default:
+		return false
+	}
+}
+
 // ExpandSecrets walks a config map and replaces string values matching
 // ${prefix:path} with the resolved secret value. Recurses into nested maps.
 func ExpandSecrets(ctx context.Context, config map[string]any, resolvers ...SecretResolver) error {
@@ -2,8 +2,14 @@ package modular
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/GoCodeAlone/modular/feeders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type mockSecretResolver struct {
@@ -65,3 +71,150 @@ func TestExpandSecrets_NoMatchingResolver(t *testing.T) {
 		t.Errorf("expected unchanged ref, got %v", config["password"])
 	}
 }
+
+type secretDBConfig struct {
+	Host     string  `yaml:"host"`
+	Password string  `yaml:"password" secret:"true"`
+	Token    *string `yaml:"token" secret:"true"`
+	Plain    string  `yaml:"plain"`
+}
+
+type secretAppConfig struct {
+	Database secretDBConfig             `yaml:"database"`
+	Replicas map[string]*secretDBConfig `yaml:"replicas"`
+	Shards   []secretDBConfig           `yaml:"shards"`
+}
+
+func writeSecretFile(t *testing.T, value string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(path, []byte(value), 0o600))
+	return path
+}
+
+func TestResolveSecretFields(t *testing.T) {
+	t.Setenv("SECRET_TEST_TOKEN", "env-token")
+	path := writeSecretFile(t, "file-password\n")
+	token := "${env:SECRET_TEST_TOKEN}"
+	vault := NewExternalSecretResolver("vault", func(_ context.Context, path string) (string, error) {
+		return "vault:" + path, nil
+	})
+
+	cfg := &secretAppConfig{
+		Database: secretDBConfig{Host: "db", Password: "${file:" + path + "}", Token: &token, Plain: "${env:SECRET_TEST_TOKEN}"},
+		Replicas: map[string]*secretDBConfig{"eu": {Password: "${vault:db/eu}"}},
+		Shards:   []secretDBConfig{{Password: "literal"}},
+	}
+	require.NoError(t, ResolveSecretFields(context.Background(), cfg, append([]SecretResolver{vault}, DefaultSecretResolvers()...)...))
+
+	assert.Equal(t, "file-password", cfg.Database.Password, "the trailing newline is removed")
+	assert.Equal(t, "env-token", *cfg.Database.Token)
+	assert.Equal(t, "${env:SECRET_TEST_TOKEN}", cfg.Database.Plain, "fields not tagged secret are left alone")
+	assert.Equal(t, "vault:db/eu", cfg.Replicas["eu"].Password)
+	assert.Equal(t, "literal", cfg.Shards[0].Password, "values that are not references are kept")
+}
+
+func TestResolveSecretFields_Errors(t *testing.T) {
+	t.Run("unknown provider", func(t *testing.T) {
+		cfg := &secretDBConfig{Password: "${aws:db/password}"}
+		err := ResolveSecretFields(context.Background(), cfg, DefaultSecretResolvers()...)
+		require.ErrorIs(t, err, ErrSecretResolverNotFound)
+		assert.Contains(t, err.Error(), "Password")
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		cfg := &secretAppConfig{Shards: []secretDBConfig{{Password: "${env:SECRET_TEST_MISSING}"}}}
+		err := ResolveSecretFields(context.Background(), cfg, DefaultSecretResolvers()...)
+		require.ErrorIs(t, err, ErrSecretNotFound)
+		assert.Contains(t, err.Error(), "Shards[0].Password")
+	})
+
+	t.Run("not a pointer", func(t *testing.T) {
+		require.ErrorIs(t, ResolveSecretFields(context.Background(), secretDBConfig{}), ErrConfigNotPointer)
+	})
+}
+
+// TestSecretField_LoadedFromFileAndRedacted verifies that a secret field is
+// populated from a file reference during config loading, and that neither
+// the secret nor its reference shows up in the verbose config output.
+func TestSecretField_LoadedFromFileAndRedacted(t *testing.T) {
+	feeders.ResetGlobalEnvCatalog()
+	t.Cleanup(feeders.ResetGlobalEnvCatalog)
+	secretPath := writeSecretFile(t, "s3cr3t-value")
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte(`database:
+  host: db.internal
+  password: ${file:`+secretPath+`}
+`), 0o600))
+
+	logger := &provenanceTestLogger{}
+	cfg := &secretAppConfig{}
+	app := NewStdApplication(NewStdConfigProvider(cfg), logger, feeders.NewYamlFeeder(yamlPath)).(*StdApplication)
+	app.SetVerboseConfig(true)
+
+	require.NoError(t, app.Init())
+	assert.Equal(t, "s3cr3t-value", cfg.Database.Password)
+	assert.Equal(t, "db.internal", cfg.Database.Host)
+
+	output := strings.Join(logger.messages(""), "\n")
+	assert.Contains(t, output, feeders.RedactedValue)
+	assert.Contains(t, output, "db.internal", "fields not tagged secret are logged as before")
+	assert.NotContains(t, output, "s3cr3t-value")
+	assert.NotContains(t, output, secretPath)
+	assert.Equal(t, feeders.RedactedValue, app.ConfigProvenance()["Database.Password"].RawValue)
+}
+
+type secretInstanceConfig struct {
+	Host     string `yaml:"host" env:"HOST"`
+	Password string `yaml:"password" env:"PASSWORD" secret:"true"`
+}
+
+type secretInstancesConfig struct {
+	Instances map[string]*secretInstanceConfig `yaml:"instances"`
+}
+
+func (c *secretInstancesConfig) GetInstanceConfigs() map[string]any {
+	instances := make(map[string]any, len(c.Instances))
+	for name, instance := range c.Instances {
+		instances[name] = instance
+	}
+	return instances
+}
+
+// TestSecretField_ResolvedForInstanceAwareFeeder verifies that a secret
+// reference fed by the instance-aware env feeder is resolved as well.
+func TestSecretField_ResolvedForInstanceAwareFeeder(t *testing.T) {
+	t.Setenv("SECRETDB_PRIMARY_PASSWORD", "${env:SECRET_INSTANCE_PASSWORD}")
+	t.Setenv("SECRET_INSTANCE_PASSWORD", "instance-s3cret")
+	feeders.ResetGlobalEnvCatalog()
+	t.Cleanup(feeders.ResetGlobalEnvCatalog)
+
+	cfg := &secretInstancesConfig{Instances: map[string]*secretInstanceConfig{"primary": {Host: "db"}}}
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), nopLogger{}).(*StdApplication)
+	app.SetConfigFeeders([]Feeder{feeders.NewEnvFeeder()})
+	app.RegisterConfigSection("secretdb", NewInstanceAwareConfigProvider(cfg, func(instanceKey string) string {
+		return "SECRETDB_" + instanceKey + "_"
+	}))
+
+	require.NoError(t, app.Init())
+	provider, err := app.GetConfigSection("secretdb")
+	require.NoError(t, err)
+	assert.Equal(t, "instance-s3cret", provider.GetConfig().(*secretInstancesConfig).Instances["primary"].Password)
+}
+
+func TestWithSecretResolvers(t *testing.T) {
+	cfg := &secretDBConfig{Password: "${vault:db/password}"}
+	vault := NewExternalSecretResolver("vault", func(_ context.Context, path string) (string, error) {
+		return "from-vault", nil
+	})
+	app, err := NewApplication(
+		WithLogger(nopLogger{}),
+		WithConfigProvider(NewStdConfigProvider(cfg)),
+		WithConfigFeeders(feeders.NewEnvFeeder()),
+		WithSecretResolvers(vault),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, app.Init())
+	assert.Equal(t, "from-vault", cfg.Password)
+}
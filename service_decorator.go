@@ -0,0 +1,114 @@
+package modular
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ServiceDecorator wraps a registered service. It receives the service as
+// registered, or as returned by the previous decorator, and returns the
+// value to register in its place.
+type ServiceDecorator func(existing any) (any, error)
+
+// DecorateService registers a decorator for the service provided under name,
+// so that behavior such as caching or instrumentation can be added around a
+// service without the module providing it knowing.
+//
+// Decorators are applied when the service is registered, before any module
+// that depends on it is initialized, so consumers only ever see the decorated
+// service. Call DecorateService after registering modules and before Init; a
+// service that is already registered is decorated immediately. Multiple
+// decorators for the same service are applied in the order they were added,
+// each wrapping the result of the previous one.
+//
+// The decorated service must still satisfy every interface the original
+// satisfied among those modules require by interface (see
+// ServiceDependency.SatisfiesInterface); otherwise registration fails with
+// ErrServiceDecoratorTypeMismatch.
+//
+// Example:
+//
+//	app.RegisterModule(auth.NewModule())
+//	err := app.DecorateService("auth", func(existing any) (any, error) {
+//	    return newCachingAuthService(existing.(auth.AuthService)), nil
+//	})
+func (app *StdApplication) DecorateService(name string, decorator ServiceDecorator) error {
+	if decorator == nil {
+		return fmt.Errorf("%w: %s", ErrServiceDecoratorNil, name)
+	}
+	app.decoratorMu.Lock()
+	if app.serviceDecorators == nil {
+		app.serviceDecorators = make(map[string][]ServiceDecorator)
+	}
+	app.serviceDecorators[name] = append(app.serviceDecorators[name], decorator)
+	app.decoratorMu.Unlock()
+
+	existing, registered := app.svcRegistry[name]
+	if !registered {
+		return nil
+	}
+	decorated, err := app.applyServiceDecorators(name, existing, []ServiceDecorator{decorator})
+	if err != nil {
+		return err
+	}
+	if app.enhancedSvcRegistry != nil {
+		app.enhancedSvcRegistry.replaceService(name, decorated)
+		app.svcRegistry = app.enhancedSvcRegistry.AsServiceRegistry()
+	} else {
+		app.svcRegistry[name] = decorated
+	}
+	return nil
+}
+
+// decorateService applies the decorators registered for name to a service
+// that is being registered
+func (app *StdApplication) decorateService(name string, service any) (any, error) {
+	app.decoratorMu.RLock()
+	decorators := append([]ServiceDecorator(nil), app.serviceDecorators[name]...)
+	app.decoratorMu.RUnlock()
+	if len(decorators) == 0 {
+		return service, nil
+	}
+	return app.applyServiceDecorators(name, service, decorators)
+}
+
+// applyServiceDecorators runs decorators in order, checking that each result
+// keeps the interfaces of the original service
+func (app *StdApplication) applyServiceDecorators(name string, service any, decorators []ServiceDecorator) (any, error) {
+	app.initMu.Lock()
+	interfaces := app.matchedInterfaces()
+	app.initMu.Unlock()
+
+	originalType := reflect.TypeOf(service)
+	current := service
+	for i, decorator := range decorators {
+		decorated, err := decorator(current)
+		if err != nil {
+			return nil, fmt.Errorf("decorator %d for service '%s' failed: %w", i, name, err)
+		}
+		if decorated == nil {
+			return nil, fmt.Errorf("%w: decorator %d for service '%s' returned nil", ErrServiceDecoratorNil, i, name)
+		}
+		decoratedType := reflect.TypeOf(decorated)
+		for _, iface := range interfaces {
+			if app.typeImplementsInterface(originalType, iface) && !app.typeImplementsInterface(decoratedType, iface) {
+				return nil, fmt.Errorf("%w: decorator %d for service '%s' returned %s, which does not implement %s",
+					ErrServiceDecoratorTypeMismatch, i, name, decoratedType, iface)
+			}
+		}
+		current = decorated
+	}
+	if app.logger != nil {
+		app.logger.Debug("Decorated service", "name", name, "decorators", len(decorators), "type", reflect.TypeOf(current).String())
+	}
+	return current, nil
+}
+
+// replaceService swaps the instance of a registered service
+func (r *EnhancedServiceRegistry) replaceService(name string, service any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if entry, exists := r.services[name]; exists {
+		entry.Service = service
+	}
+}
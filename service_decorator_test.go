@@ -0,0 +1,115 @@
+package modular
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// svcDecoratorGreeter wraps a greeter, adding a marker to its greeting
+type svcDecoratorGreeter struct {
+	inner  svcInfoGreeter
+	marker string
+}
+
+func (g *svcDecoratorGreeter) Greet() string { return g.marker + "(" + g.inner.Greet() + ")" }
+
+func wrapGreeter(marker string) ServiceDecorator {
+	return func(existing any) (any, error) {
+		return &svcDecoratorGreeter{inner: existing.(svcInfoGreeter), marker: marker}, nil
+	}
+}
+
+// svcDecoratorConsumerModule requires the greeter by interface and keeps it
+type svcDecoratorConsumerModule struct {
+	svcInfoConsumerModule
+	greeter svcInfoGreeter
+}
+
+func (m *svcDecoratorConsumerModule) Constructor() ModuleConstructor {
+	return func(_ Application, services map[string]any) (Module, error) {
+		m.greeter = services["greeting"].(svcInfoGreeter)
+		return m, nil
+	}
+}
+
+func newDecoratorApp(t *testing.T) (*StdApplication, *svcDecoratorConsumerModule) {
+	t.Helper()
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &logger{t}).(*StdApplication)
+	consumer := &svcDecoratorConsumerModule{}
+	app.RegisterModule(&svcInfoProviderModule{})
+	app.RegisterModule(consumer)
+	return app, consumer
+}
+
+func TestStdApplication_DecorateService(t *testing.T) {
+	t.Run("single decorator is seen by consumers", func(t *testing.T) {
+		app, consumer := newDecoratorApp(t)
+		require.NoError(t, app.DecorateService("greeter", wrapGreeter("a")))
+		require.NoError(t, app.Init())
+
+		require.NotNil(t, consumer.greeter)
+		assert.Equal(t, "a(hello)", consumer.greeter.Greet())
+
+		var registered svcInfoGreeter
+		require.NoError(t, app.GetService("greeter", &registered))
+		assert.Equal(t, "a(hello)", registered.Greet())
+	})
+
+	t.Run("stacked decorators apply in registration order", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			app, consumer := newDecoratorApp(t)
+			require.NoError(t, app.DecorateService("greeter", wrapGreeter("a")))
+			require.NoError(t, app.DecorateService("greeter", wrapGreeter("b")))
+			require.NoError(t, app.DecorateService("greeter", wrapGreeter("c")))
+			require.NoError(t, app.Init())
+			require.Equal(t, "c(b(a(hello)))", consumer.greeter.Greet())
+		}
+	})
+
+	t.Run("already registered service is decorated immediately", func(t *testing.T) {
+		app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &logger{t}).(*StdApplication)
+		require.NoError(t, app.RegisterService("greeter", &svcInfoEnglishGreeter{}))
+		require.NoError(t, app.DecorateService("greeter", wrapGreeter("a")))
+		require.NoError(t, app.DecorateService("greeter", wrapGreeter("b")))
+
+		var registered svcInfoGreeter
+		require.NoError(t, app.GetService("greeter", &registered))
+		assert.Equal(t, "b(a(hello))", registered.Greet())
+	})
+
+	t.Run("decorated service must keep required interfaces", func(t *testing.T) {
+		app, _ := newDecoratorApp(t)
+		require.NoError(t, app.DecorateService("greeter", func(any) (any, error) {
+			return "not a greeter", nil
+		}))
+
+		err := app.Init()
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrServiceDecoratorTypeMismatch)
+		assert.Contains(t, err.Error(), "greeter")
+		assert.Contains(t, err.Error(), "modular.svcInfoGreeter")
+	})
+
+	t.Run("decorator error is returned", func(t *testing.T) {
+		errDecorate := errors.New("decorate failed")
+		app, _ := newDecoratorApp(t)
+		require.NoError(t, app.DecorateService("greeter", func(any) (any, error) {
+			return nil, errDecorate
+		}))
+
+		err := app.Init()
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errDecorate)
+	})
+
+	t.Run("nil decorator and nil result are rejected", func(t *testing.T) {
+		app, _ := newDecoratorApp(t)
+		require.ErrorIs(t, app.DecorateService("greeter", nil), ErrServiceDecoratorNil)
+
+		require.NoError(t, app.DecorateService("greeter", func(any) (any, error) { return nil, nil }))
+		assert.ErrorIs(t, app.Init(), ErrServiceDecoratorNil)
+	})
+}
@@ -0,0 +1,128 @@
+package modular
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+)
+
+// ServiceInfo describes a service in the application's service registry. It
+// is returned by Services to help debug dependency injection.
+type ServiceInfo struct {
+	// Name is the name the service is registered under. It may differ from
+	// the name a module provided it as when that name was already taken.
+	Name string `json:"name"`
+
+	// TypeName is the Go type of the service instance, e.g. "*chimux.ChiMuxModule"
+	TypeName string `json:"type_name"`
+
+	// RegisteredBy is the name of the module that provided the service, or
+	// empty for services registered directly on the application
+	RegisteredBy string `json:"registered_by,omitempty"`
+
+	// Interfaces lists the interfaces the service satisfies among those
+	// modules require by interface matching (ServiceDependency.SatisfiesInterface)
+	Interfaces []string `json:"interfaces,omitempty"`
+}
+
+// ServiceLister is implemented by applications that can enumerate their
+// service registry. StdApplication and ObservableApplication implement it.
+type ServiceLister interface {
+	Services() []ServiceInfo
+}
+
+// Services returns every registered service sorted by name, with the module
+// that provided it and the interfaces it satisfies for interface-based
+// dependency matching.
+//
+// Example:
+//
+//	for _, svc := range app.Services() {
+//	    fmt.Printf("%s (%s) from %q\n", svc.Name, svc.TypeName, svc.RegisteredBy)
+//	}
+func (app *StdApplication) Services() []ServiceInfo {
+	interfaces := app.matchedInterfaces()
+
+	var services []ServiceInfo
+	if app.enhancedSvcRegistry != nil {
+		for _, entry := range app.enhancedSvcRegistry.entries() {
+			services = append(services, app.describeService(entry.ActualName, entry.Service, entry.ModuleName, interfaces))
+		}
+	} else {
+		for name, service := range app.svcRegistry {
+			services = append(services, app.describeService(name, service, "", interfaces))
+		}
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Name < services[j].Name })
+	return services
+}
+
+// describeService builds the ServiceInfo of a registered service
+func (app *StdApplication) describeService(name string, service any, moduleName string, interfaces []reflect.Type) ServiceInfo {
+	info := ServiceInfo{Name: name, TypeName: "<nil>", RegisteredBy: moduleName}
+	serviceType := reflect.TypeOf(service)
+	if serviceType == nil {
+		return info
+	}
+	info.TypeName = serviceType.String()
+	for _, iface := range interfaces {
+		if app.typeImplementsInterface(serviceType, iface) {
+			info.Interfaces = append(info.Interfaces, iface.String())
+		}
+	}
+	return info
+}
+
+// matchedInterfaces returns the interfaces registered modules require
+// services to satisfy, sorted by name
+func (app *StdApplication) matchedInterfaces() []reflect.Type {
+	seen := make(map[reflect.Type]bool)
+	var interfaces []reflect.Type
+	for _, module := range app.moduleRegistry {
+		svcAware, ok := module.(ServiceAware)
+		if !ok {
+			continue
+		}
+		for _, dep := range svcAware.RequiresServices() {
+			iface := dep.SatisfiesInterface
+			if iface == nil || iface.Kind() != reflect.Interface || seen[iface] {
+				continue
+			}
+			seen[iface] = true
+			interfaces = append(interfaces, iface)
+		}
+	}
+	sort.Slice(interfaces, func(i, j int) bool { return interfaces[i].String() < interfaces[j].String() })
+	return interfaces
+}
+
+// entries returns a snapshot of the registry entries
+func (r *EnhancedServiceRegistry) entries() []ServiceRegistryEntry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	entries := make([]ServiceRegistryEntry, 0, len(r.services))
+	for _, entry := range r.services {
+		entries = append(entries, *entry)
+	}
+	return entries
+}
+
+// NewServicesHandler returns an http.Handler that serves the services of app
+// as a JSON array. It is intended as an optional debug endpoint, read by the
+// "modcli services" command; register it only where exposing the service
+// registry is acceptable.
+//
+// Example:
+//
+//	router.Handle("/debug/services", modular.NewServicesHandler(app))
+func NewServicesHandler(app ServiceLister) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		services := app.Services()
+		if services == nil {
+			services = []ServiceInfo{}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(services)
+	})
+}
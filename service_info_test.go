@@ -0,0 +1,101 @@
+package modular
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type svcInfoGreeter interface {
+	Greet() string
+}
+
+type svcInfoEnglishGreeter struct{}
+
+func (svcInfoEnglishGreeter) Greet() string { return "hello" }
+
+// svcInfoProviderModule provides a greeter service and a plain map service
+type svcInfoProviderModule struct{}
+
+func (m *svcInfoProviderModule) Name() string                          { return "provider" }
+func (m *svcInfoProviderModule) Init(Application) error                { return nil }
+func (m *svcInfoProviderModule) RequiresServices() []ServiceDependency { return nil }
+func (m *svcInfoProviderModule) ProvidesServices() []ServiceProvider {
+	return []ServiceProvider{
+		{Name: "greeter", Instance: &svcInfoEnglishGreeter{}},
+		{Name: "settings", Instance: map[string]string{}},
+	}
+}
+
+// svcInfoConsumerModule requires a greeter by interface
+type svcInfoConsumerModule struct{}
+
+func (m *svcInfoConsumerModule) Name() string                        { return "consumer" }
+func (m *svcInfoConsumerModule) Init(Application) error              { return nil }
+func (m *svcInfoConsumerModule) ProvidesServices() []ServiceProvider { return nil }
+func (m *svcInfoConsumerModule) RequiresServices() []ServiceDependency {
+	return []ServiceDependency{{
+		Name:               "greeting",
+		Required:           true,
+		MatchByInterface:   true,
+		SatisfiesInterface: reflect.TypeOf((*svcInfoGreeter)(nil)).Elem(),
+	}}
+}
+
+func newServiceInfoApp(t *testing.T) *StdApplication {
+	t.Helper()
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), &logger{t}).(*StdApplication)
+	app.RegisterModule(&svcInfoProviderModule{})
+	app.RegisterModule(&svcInfoConsumerModule{})
+	require.NoError(t, app.RegisterService("clock", &MockStorage{}))
+	require.NoError(t, app.Init())
+	return app
+}
+
+func TestStdApplication_Services(t *testing.T) {
+	app := newServiceInfoApp(t)
+
+	services := make(map[string]ServiceInfo)
+	for _, svc := range app.Services() {
+		services[svc.Name] = svc
+	}
+
+	assert.Equal(t, ServiceInfo{
+		Name:         "greeter",
+		TypeName:     "*modular.svcInfoEnglishGreeter",
+		RegisteredBy: "provider",
+		Interfaces:   []string{"modular.svcInfoGreeter"},
+	}, services["greeter"])
+	assert.Equal(t, ServiceInfo{
+		Name:         "settings",
+		TypeName:     "map[string]string",
+		RegisteredBy: "provider",
+	}, services["settings"])
+	assert.Equal(t, "*modular.MockStorage", services["clock"].TypeName)
+	assert.Empty(t, services["clock"].RegisteredBy, "services registered on the application have no module")
+	assert.Contains(t, services, "logger")
+
+	names := make([]string, 0, len(services))
+	for _, svc := range app.Services() {
+		names = append(names, svc.Name)
+	}
+	assert.IsIncreasing(t, names)
+}
+
+func TestNewServicesHandler(t *testing.T) {
+	app := newServiceInfoApp(t)
+
+	w := httptest.NewRecorder()
+	NewServicesHandler(app).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/services", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	var services []ServiceInfo
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &services))
+	assert.Equal(t, app.Services(), services)
+}
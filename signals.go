@@ -0,0 +1,141 @@
+package modular
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// SignalOptions configures InstallSignalHandlers
+type SignalOptions struct {
+	// StopTimeout bounds how long app.Stop may take after SIGINT or SIGTERM.
+	// When it elapses, OnStop is called with ErrStopTimeout while Stop keeps
+	// running in the background. Zero waits for Stop to return.
+	StopTimeout time.Duration
+
+	// OnStop is called with the result of app.Stop after SIGINT or SIGTERM,
+	// typically to exit the process. Optional. It runs on the signal handling
+	// goroutine, so it must not call the cancel function returned by
+	// InstallSignalHandlers, which waits for that goroutine and would deadlock.
+	OnStop func(err error)
+
+	// Signals replaces the process signals as the source of signals, so that
+	// tests can inject them. When nil, SIGINT, SIGTERM and SIGHUP are
+	// delivered with signal.Notify.
+	Signals <-chan os.Signal
+}
+
+// signalReloader is implemented by applications that re-read their
+// configuration to find the changes to reload
+type signalReloader interface {
+	reloadFromFeeders(ctx context.Context, trigger ReloadTrigger) error
+}
+
+// InstallSignalHandlers handles the process signals of a running application:
+// SIGINT and SIGTERM stop it with app.Stop, at most once, and SIGHUP requests
+// a configuration reload with app.RequestReload. Applications built with
+// WithDynamicReload re-run their config feeders on SIGHUP and reload the
+// changed fields tagged `dynamic:"true"`. The returned function stops
+// handling signals, waiting for a stop in progress; it is safe to call
+// multiple times, but not from OnStop.
+//
+// Example:
+//
+//	if err := app.Start(); err != nil {
+//	    log.Fatal(err)
+//	}
+//	stopped := make(chan error, 1)
+//	cancel := modular.InstallSignalHandlers(app, modular.SignalOptions{
+//	    StopTimeout: 30 * time.Second,
+//	    OnStop:      func(err error) { stopped <- err },
+//	})
+//	defer cancel()
+//	if err := <-stopped; err != nil {
+//	    log.Fatal(err)
+//	}
+func InstallSignalHandlers(app Application, opts SignalOptions) func() {
+	signals := opts.Signals
+	release := func() {}
+	if signals == nil {
+		ch := make(chan os.Signal, 1)
+		signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+		signals = ch
+		release = func() { signal.Stop(ch) }
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var releaseOnce sync.Once
+	releaseSignals := func() { releaseOnce.Do(release) }
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case sig := <-signals:
+				if sig == syscall.SIGHUP {
+					reloadOnSignal(ctx, app)
+					continue
+				}
+				app.Logger().Info("Received signal, shutting down", "signal", sig)
+				// A second signal gets the default behavior, terminating a stuck shutdown
+				releaseSignals()
+				err := stopWithTimeout(app, opts.StopTimeout)
+				if opts.OnStop != nil {
+					opts.OnStop(err)
+				}
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return func() {
+		releaseSignals()
+		cancel()
+		<-done
+	}
+}
+
+// reloadOnSignal requests a configuration reload after SIGHUP
+func reloadOnSignal(ctx context.Context, app Application) {
+	app.Logger().Info("Received SIGHUP, reloading configuration")
+	var err error
+	switch reloader := app.(type) {
+	case signalReloader:
+		err = reloader.reloadFromFeeders(ctx, ReloadSignal)
+	case ReloadableApp:
+		err = reloader.RequestReload(ctx, ReloadSignal, ConfigDiff{})
+	default:
+		err = ErrDynamicReloadNotEnabled
+	}
+	switch {
+	case errors.Is(err, ErrDynamicReloadNotEnabled):
+		app.Logger().Warn("Ignoring SIGHUP, dynamic reload is not enabled")
+	case err != nil:
+		app.Logger().Error("Failed to reload configuration", "error", err)
+	}
+}
+
+// stopWithTimeout stops app, giving up waiting after timeout if positive
+func stopWithTimeout(app Application, timeout time.Duration) error {
+	if timeout <= 0 {
+		return app.Stop() //nolint:wrapcheck // Stop errors are returned as is
+	}
+	done := make(chan error, 1)
+	go func() { done <- app.Stop() }()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		return fmt.Errorf("%w: still stopping after %v", ErrStopTimeout, timeout)
+	}
+}
@@ -0,0 +1,171 @@
+package modular
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/GoCodeAlone/modular/feeders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signalTestApp records the lifecycle calls made by the signal handlers
+type signalTestApp struct {
+	Application
+
+	stopCalls    atomic.Int32
+	stopDelay    time.Duration
+	reloadCalls  atomic.Int32
+	lastTrigger  atomic.Int32
+	stopFinished chan struct{}
+}
+
+func newSignalTestApp() *signalTestApp {
+	return &signalTestApp{stopFinished: make(chan struct{}, 1)}
+}
+
+func (a *signalTestApp) Logger() Logger { return nopLogger{} }
+
+func (a *signalTestApp) Stop() error {
+	a.stopCalls.Add(1)
+	time.Sleep(a.stopDelay)
+	a.stopFinished <- struct{}{}
+	return nil
+}
+
+func (a *signalTestApp) RequestReload(_ context.Context, trigger ReloadTrigger, _ ConfigDiff) error {
+	a.lastTrigger.Store(int32(trigger))
+	a.reloadCalls.Add(1)
+	return nil
+}
+
+func TestInstallSignalHandlers(t *testing.T) {
+	t.Run("SIGTERM stops the application once", func(t *testing.T) {
+		app := newSignalTestApp()
+		signals := make(chan os.Signal, 2)
+		stopped := make(chan error, 2)
+		cancel := InstallSignalHandlers(app, SignalOptions{
+			Signals: signals,
+			OnStop:  func(err error) { stopped <- err },
+		})
+		defer cancel()
+
+		signals <- syscall.SIGTERM
+		select {
+		case err := <-stopped:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("OnStop was not called")
+		}
+		assert.Equal(t, int32(1), app.stopCalls.Load())
+		assert.Zero(t, app.reloadCalls.Load())
+
+		// Signals after the stop are no longer handled
+		signals <- syscall.SIGINT
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, int32(1), app.stopCalls.Load())
+	})
+
+	t.Run("SIGHUP requests a reload", func(t *testing.T) {
+		app := newSignalTestApp()
+		signals := make(chan os.Signal, 1)
+		cancel := InstallSignalHandlers(app, SignalOptions{Signals: signals})
+		defer cancel()
+
+		signals <- syscall.SIGHUP
+		signals <- syscall.SIGHUP
+		require.Eventually(t, func() bool { return app.reloadCalls.Load() == 2 }, time.Second, 5*time.Millisecond)
+		assert.Equal(t, ReloadSignal, ReloadTrigger(app.lastTrigger.Load()))
+		assert.Zero(t, app.stopCalls.Load(), "SIGHUP does not stop the application")
+	})
+
+	t.Run("stop timeout", func(t *testing.T) {
+		app := newSignalTestApp()
+		app.stopDelay = 500 * time.Millisecond
+		signals := make(chan os.Signal, 1)
+		stopped := make(chan error, 1)
+		cancel := InstallSignalHandlers(app, SignalOptions{
+			Signals:     signals,
+			StopTimeout: 20 * time.Millisecond,
+			OnStop:      func(err error) { stopped <- err },
+		})
+		defer cancel()
+
+		signals <- syscall.SIGINT
+		select {
+		case err := <-stopped:
+			require.ErrorIs(t, err, ErrStopTimeout)
+		case <-time.After(250 * time.Millisecond):
+			t.Fatal("OnStop was not called when the stop timeout elapsed")
+		}
+		<-app.stopFinished
+	})
+
+	t.Run("cancel stops handling signals", func(t *testing.T) {
+		app := newSignalTestApp()
+		signals := make(chan os.Signal, 1)
+		cancel := InstallSignalHandlers(app, SignalOptions{Signals: signals})
+		cancel()
+		cancel()
+
+		signals <- syscall.SIGTERM
+		time.Sleep(50 * time.Millisecond)
+		assert.Zero(t, app.stopCalls.Load())
+	})
+}
+
+func TestInstallSignalHandlers_SIGHUPReloadsConfig(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 1s\n  size: 10\n"), 0o600))
+	feeders.ResetGlobalEnvCatalog()
+	t.Cleanup(feeders.ResetGlobalEnvCatalog)
+
+	// Dynamic reload without watched files, reloading only on SIGHUP
+	module := &watchedCacheModule{
+		mockReloadable: mockReloadable{canReload: true, timeout: time.Second},
+		config:         &watchedCacheConfig{},
+	}
+	app, err := NewApplication(
+		WithLogger(&reloadTestLogger{}),
+		WithConfigFeeders(feeders.NewYamlFeeder(yamlPath)),
+		WithModules(module),
+		WithDynamicReload(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, app.Init())
+	require.NoError(t, app.Start())
+	t.Cleanup(func() { _ = app.Stop() })
+
+	signals := make(chan os.Signal, 1)
+	cancel := InstallSignalHandlers(app, SignalOptions{Signals: signals})
+	defer cancel()
+
+	require.NoError(t, os.WriteFile(yamlPath, []byte("cache:\n  ttl: 2m\n  size: 10\n"), 0o600))
+	time.Sleep(100 * time.Millisecond)
+	assert.Zero(t, module.reloadCalls.Load(), "files are not watched")
+
+	signals <- syscall.SIGHUP
+	require.Eventually(t, func() bool { return module.reloadCalls.Load() == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []ConfigChange{{FieldPath: "cache.TTL", OldValue: "1s", NewValue: "2m0s", Source: "diff"}},
+		module.getLastChanges())
+}
+
+func TestInstallSignalHandlers_SIGHUPWithoutDynamicReload(t *testing.T) {
+	logger := &reloadTestLogger{}
+	app, err := NewApplication(WithLogger(logger))
+	require.NoError(t, err)
+	require.NoError(t, app.Init())
+
+	signals := make(chan os.Signal, 1)
+	cancel := InstallSignalHandlers(app, SignalOptions{Signals: signals})
+	defer cancel()
+
+	signals <- syscall.SIGHUP
+	require.Eventually(t, func() bool { return len(logger.warnings()) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Contains(t, logger.warnings()[0], "dynamic reload is not enabled")
+}
@@ -161,6 +161,25 @@ type TenantService interface {
 	RegisterTenantAwareModule(module TenantAwareModule) error
 }
 
+// TenantRegistry is an optional interface for tenant services that can add
+// and remove tenants in bulk at runtime. StandardTenantService implements it;
+// callers holding a TenantService can check for it with a type assertion.
+//
+// Example:
+//
+//	if registry, ok := tenantSvc.(modular.TenantRegistry); ok {
+//	    err := registry.RegisterTenants(tenants)
+//	}
+type TenantRegistry interface {
+	// RegisterTenants registers many tenants at once. Implementations must
+	// validate every tenant first and register none of them on error.
+	RegisterTenants(tenants map[TenantID]map[string]ConfigProvider) error
+
+	// DeregisterTenant removes a tenant and its configurations and notifies
+	// tenant-aware modules of the removal.
+	DeregisterTenant(tenantID TenantID) error
+}
+
 // TenantAwareModule is an optional interface that modules can implement
 // to receive notifications about tenant lifecycle events.
 //
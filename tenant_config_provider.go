@@ -44,6 +44,14 @@ func (tcp *TenantConfigProvider) initializeConfigsForTenant(tenantID TenantID) {
 	}
 }
 
+// removeTenant drops all configurations of a tenant
+func (tcp *TenantConfigProvider) removeTenant(tenantID TenantID) {
+	tcp.mutex.Lock()
+	defer tcp.mutex.Unlock()
+
+	delete(tcp.tenantConfigs, tenantID)
+}
+
 // SetTenantConfig sets a configuration for a specific tenant and section
 func (tcp *TenantConfigProvider) SetTenantConfig(tenantID TenantID, section string, provider ConfigProvider) {
 	tcp.mutex.Lock()
@@ -0,0 +1,62 @@
+package modular
+
+import "fmt"
+
+// GetTenantConfig retrieves the configuration of a tenant for a config section
+// as a typed struct, with compile-time type safety.
+//
+// When app implements TenantApplication the lookup goes through its
+// GetTenantConfig, so application decorators wrapping app take part in it;
+// otherwise the registered "tenantService" is used directly. The returned
+// error wraps ErrTenantNotFound or ErrTenantConfigNotFound when the tenant or
+// section is missing, and ErrTenantConfigWrongType when the section's config
+// is not a T or *T.
+//
+// Example:
+//
+//	cfg, err := modular.GetTenantConfig[ReverseProxyConfig](app, tenantID, "reverseproxy")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(cfg.DefaultBackend)
+func GetTenantConfig[T any](app Application, tenantID TenantID, section string) (*T, error) {
+	provider, err := tenantConfigProvider(app, tenantID, section)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfg := provider.GetConfig().(type) {
+	case *T:
+		if cfg == nil {
+			return nil, fmt.Errorf("%w: section '%s' for tenant %s", ErrTenantConfigValueNil, section, tenantID)
+		}
+		return cfg, nil
+	case T:
+		return &cfg, nil
+	default:
+		var zero *T
+		return nil, fmt.Errorf("%w: section '%s' for tenant %s is %T, want %T",
+			ErrTenantConfigWrongType, section, tenantID, cfg, zero)
+	}
+}
+
+// tenantConfigProvider resolves the config provider of a tenant's section
+func tenantConfigProvider(app Application, tenantID TenantID, section string) (ConfigProvider, error) {
+	if tenantApp, ok := app.(TenantApplication); ok {
+		provider, err := tenantApp.GetTenantConfig(tenantID, section)
+		if err != nil {
+			return nil, fmt.Errorf("getting config section '%s' for tenant %s: %w", section, tenantID, err)
+		}
+		return provider, nil
+	}
+
+	var ts TenantService
+	if err := app.GetService("tenantService", &ts); err != nil {
+		return nil, fmt.Errorf("tenant service not available: %w", err)
+	}
+	provider, err := ts.GetTenantConfig(tenantID, section)
+	if err != nil {
+		return nil, fmt.Errorf("getting config section '%s' for tenant %s: %w", section, tenantID, err)
+	}
+	return provider, nil
+}
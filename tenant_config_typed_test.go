@@ -0,0 +1,74 @@
+package modular
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type typedTenantConfig struct {
+	Host string
+}
+
+// plainApplication hides the TenantApplication methods of the wrapped application
+type plainApplication struct {
+	Application
+}
+
+func newTypedTenantConfigApp(t *testing.T) Application {
+	t.Helper()
+	app := NewStdApplication(NewStdConfigProvider(&struct{}{}), nopLogger{})
+	ts := NewStandardTenantService(slog.Default())
+	require.NoError(t, ts.RegisterTenant("tenant-a", map[string]ConfigProvider{
+		"database": NewStdConfigProvider(&typedTenantConfig{Host: "a.db"}),
+		"cache":    NewStdConfigProvider(typedTenantConfig{Host: "a.cache"}),
+	}))
+	require.NoError(t, app.RegisterService("tenantService", ts))
+	return app
+}
+
+func TestGetTenantConfig(t *testing.T) {
+	app := newTypedTenantConfigApp(t)
+
+	for name, app := range map[string]Application{
+		"tenant application": app,
+		"decorated":          NewBaseApplicationDecorator(app),
+		"plain application":  plainApplication{app},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := GetTenantConfig[typedTenantConfig](app, "tenant-a", "database")
+			require.NoError(t, err)
+			assert.Equal(t, "a.db", cfg.Host)
+
+			cfg, err = GetTenantConfig[typedTenantConfig](app, "tenant-a", "cache")
+			require.NoError(t, err, "configs registered by value are returned too")
+			assert.Equal(t, "a.cache", cfg.Host)
+		})
+	}
+
+	t.Run("missing tenant", func(t *testing.T) {
+		_, err := GetTenantConfig[typedTenantConfig](app, "tenant-b", "database")
+		require.ErrorIs(t, err, ErrTenantNotFound)
+		assert.Contains(t, err.Error(), "tenant-b")
+	})
+
+	t.Run("missing section", func(t *testing.T) {
+		_, err := GetTenantConfig[typedTenantConfig](app, "tenant-a", "queue")
+		require.ErrorIs(t, err, ErrTenantConfigNotFound)
+		assert.Contains(t, err.Error(), "queue")
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		_, err := GetTenantConfig[struct{ Port int }](app, "tenant-a", "database")
+		require.ErrorIs(t, err, ErrTenantConfigWrongType)
+		assert.Contains(t, err.Error(), "*modular.typedTenantConfig")
+	})
+
+	t.Run("no tenant service", func(t *testing.T) {
+		app := NewStdApplication(NewStdConfigProvider(&struct{}{}), nopLogger{})
+		_, err := GetTenantConfig[typedTenantConfig](app, "tenant-a", "database")
+		require.ErrorIs(t, err, ErrServiceNotFound)
+	})
+}
@@ -68,23 +68,80 @@ func (ts *StandardTenantService) GetTenants() []TenantID {
 // RegisterTenant registers a new tenant with optional initial configs
 func (ts *StandardTenantService) RegisterTenant(tenantID TenantID, configs map[string]ConfigProvider) error {
 	ts.mutex.Lock()
+	notifications := ts.registerTenantLocked(tenantID, configs)
+	ts.mutex.Unlock()
+	ts.notifyModulesAboutTenants(notifications, "Notified module about tenant")
+
+	return nil
+}
+
+// RegisterTenants registers many tenants at once. All tenants and their
+// configs are validated first: if any tenant ID is empty, any provider or
+// config is nil, or any config implementing ConfigValidator fails validation,
+// an error is returned and no tenant is registered. Otherwise every tenant is
+// registered, or merged into an existing tenant, as with RegisterTenant.
+//
+// Example:
+//
+//	err := tenantSvc.RegisterTenants(map[modular.TenantID]map[string]modular.ConfigProvider{
+//	    "tenant-a": {"database": modular.NewStdConfigProvider(&DatabaseConfig{Host: "a.db"})},
+//	    "tenant-b": {"database": modular.NewStdConfigProvider(&DatabaseConfig{Host: "b.db"})},
+//	})
+func (ts *StandardTenantService) RegisterTenants(tenants map[TenantID]map[string]ConfigProvider) error {
+	tenantIDs := make([]TenantID, 0, len(tenants))
+	for tenantID, configs := range tenants {
+		if err := validateTenantRegistration(tenantID, configs); err != nil {
+			return err
+		}
+		tenantIDs = append(tenantIDs, tenantID)
+	}
+	slices.Sort(tenantIDs)
+
+	ts.mutex.Lock()
+	var notifications []tenantModuleNotification
+	for _, tenantID := range tenantIDs {
+		notifications = append(notifications, ts.registerTenantLocked(tenantID, tenants[tenantID])...)
+	}
+	ts.mutex.Unlock()
+	ts.notifyModulesAboutTenants(notifications, "Notified module about tenant")
+
+	return nil
+}
+
+// validateTenantRegistration checks a tenant and its configs before a bulk registration
+func validateTenantRegistration(tenantID TenantID, configs map[string]ConfigProvider) error {
+	if tenantID == "" {
+		return ErrTenantIDEmpty
+	}
+	for section, provider := range configs {
+		if provider == nil || provider.GetConfig() == nil {
+			return fmt.Errorf("%w: section '%s' for tenant %s", ErrTenantRegisterNilConfig, section, tenantID)
+		}
+		if validator, ok := provider.GetConfig().(ConfigValidator); ok {
+			if err := validator.Validate(); err != nil {
+				return fmt.Errorf("%w: section '%s' for tenant %s: %w", ErrTenantConfigInvalid, section, tenantID, err)
+			}
+		}
+	}
+	return nil
+}
 
+// registerTenantLocked registers or updates a tenant while ts.mutex is held,
+// returning the notifications to send once it is released.
+func (ts *StandardTenantService) registerTenantLocked(tenantID TenantID, configs map[string]ConfigProvider) []tenantModuleNotification {
 	// Check if tenant already exists and update existing configs instead of returning an error
 	if existingConfig, exists := ts.tenantConfigs[tenantID]; exists {
 		ts.logger.Info("Tenant already registered, merging configurations", "tenantID", tenantID)
 
 		// Add or update configs for existing tenant
-		if len(configs) > 0 {
-			for section, provider := range configs {
-				if provider == nil || provider.GetConfig() == nil {
-					ts.logger.Warn("Skipping nil config provider or config", "tenantID", tenantID, "section", section)
-					continue
-				}
-				ts.logger.Debug("Updating config for tenant", "tenantID", tenantID, "section", section)
-				existingConfig.SetTenantConfig(tenantID, section, provider)
+		for section, provider := range configs {
+			if provider == nil || provider.GetConfig() == nil {
+				ts.logger.Warn("Skipping nil config provider or config", "tenantID", tenantID, "section", section)
+				continue
 			}
+			ts.logger.Debug("Updating config for tenant", "tenantID", tenantID, "section", section)
+			existingConfig.SetTenantConfig(tenantID, section, provider)
 		}
-		ts.mutex.Unlock()
 		return nil
 	}
 
@@ -109,11 +166,7 @@ func (ts *StandardTenantService) RegisterTenant(tenantID TenantID, configs map[s
 
 	ts.logger.Info("Registered tenant", "tenantID", tenantID)
 
-	notifications := ts.prepareTenantNotificationsLocked(tenantID, ts.tenantAwareModules)
-	ts.mutex.Unlock()
-	ts.notifyModulesAboutTenants(notifications, "Notified module about tenant")
-
-	return nil
+	return ts.prepareTenantNotificationsLocked(tenantID, ts.tenantAwareModules)
 }
 
 // prepareTenantNotificationsLocked records pending tenant notifications while ts.mutex is held.
@@ -145,23 +198,37 @@ func (ts *StandardTenantService) notifyModulesAboutTenants(notifications []tenan
 	}
 }
 
-// RemoveTenant removes a tenant and its configurations
+// RemoveTenant removes a tenant and its configurations. It is equivalent to
+// DeregisterTenant.
 func (ts *StandardTenantService) RemoveTenant(tenantID TenantID) error {
+	return ts.DeregisterTenant(tenantID)
+}
+
+// DeregisterTenant removes a tenant at runtime. The tenant's configurations
+// are dropped along with the record of which modules were notified about it,
+// so registering the same tenant ID again starts from scratch, and
+// tenant-aware modules are notified of the removal.
+func (ts *StandardTenantService) DeregisterTenant(tenantID TenantID) error {
 	ts.mutex.Lock()
 
-	if _, exists := ts.tenantConfigs[tenantID]; !exists {
+	tenantCfg, exists := ts.tenantConfigs[tenantID]
+	if !exists {
 		ts.mutex.Unlock()
 		return fmt.Errorf("%w: %s", ErrTenantNotFound, tenantID)
 	}
 
 	delete(ts.tenantConfigs, tenantID)
+	if tenantCfg != nil {
+		// Release the configs even if the provider is still referenced elsewhere
+		tenantCfg.removeTenant(tenantID)
+	}
+	for _, notified := range ts.moduleNotifications {
+		delete(notified, tenantID)
+	}
 	ts.logger.Info("Removed tenant", "tenantID", tenantID)
 
 	notifications := make([]tenantModuleNotification, 0, len(ts.tenantAwareModules))
 	for _, module := range ts.tenantAwareModules {
-		if notifications, exists := ts.moduleNotifications[module]; exists {
-			delete(notifications, tenantID)
-		}
 		notifications = append(notifications, tenantModuleNotification{module: module, tenantID: tenantID})
 	}
 	ts.mutex.Unlock()
@@ -0,0 +1,166 @@
+package modular
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTenantAwareModule records the tenant lifecycle notifications it receives
+type recordingTenantAwareModule struct {
+	mu         sync.Mutex
+	registered []TenantID
+	removed    []TenantID
+}
+
+func (m *recordingTenantAwareModule) Name() string                          { return "recordingTenantAwareModule" }
+func (m *recordingTenantAwareModule) Init(Application) error                { return nil }
+func (m *recordingTenantAwareModule) Start(context.Context) error           { return nil }
+func (m *recordingTenantAwareModule) Stop(context.Context) error            { return nil }
+func (m *recordingTenantAwareModule) Dependencies() []string                { return nil }
+func (m *recordingTenantAwareModule) ProvidesServices() []ServiceProvider   { return nil }
+func (m *recordingTenantAwareModule) RequiresServices() []ServiceDependency { return nil }
+func (m *recordingTenantAwareModule) RegisterConfig(Application)            {}
+
+func (m *recordingTenantAwareModule) OnTenantRegistered(tenantID TenantID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.registered = append(m.registered, tenantID)
+}
+
+func (m *recordingTenantAwareModule) OnTenantRemoved(tenantID TenantID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.removed = append(m.removed, tenantID)
+}
+
+type tenantBulkConfig struct {
+	Host string
+}
+
+var errTenantBulkHostMissing = errors.New("host is required")
+
+func (c *tenantBulkConfig) Validate() error {
+	if c.Host == "" {
+		return errTenantBulkHostMissing
+	}
+	return nil
+}
+
+func tenantBulkConfigs(host string) map[string]ConfigProvider {
+	return map[string]ConfigProvider{"database": NewStdConfigProvider(&tenantBulkConfig{Host: host})}
+}
+
+var _ TenantRegistry = (*StandardTenantService)(nil)
+
+func TestStandardTenantService_RegisterTenants(t *testing.T) {
+	t.Run("available through TenantService", func(t *testing.T) {
+		var svc TenantService = NewStandardTenantService(slog.Default())
+		registry, ok := svc.(TenantRegistry)
+		require.True(t, ok)
+		require.NoError(t, registry.RegisterTenants(map[TenantID]map[string]ConfigProvider{"tenant-a": tenantBulkConfigs("a.db")}))
+		assert.Equal(t, []TenantID{"tenant-a"}, svc.GetTenants())
+	})
+
+	t.Run("registers all tenants", func(t *testing.T) {
+		ts := NewStandardTenantService(slog.Default())
+		module := &recordingTenantAwareModule{}
+		require.NoError(t, ts.RegisterTenantAwareModule(module))
+
+		require.NoError(t, ts.RegisterTenants(map[TenantID]map[string]ConfigProvider{
+			"tenant-b": tenantBulkConfigs("b.db"),
+			"tenant-a": tenantBulkConfigs("a.db"),
+		}))
+
+		assert.ElementsMatch(t, []TenantID{"tenant-a", "tenant-b"}, ts.GetTenants())
+		cfg, err := ts.GetTenantConfig("tenant-b", "database")
+		require.NoError(t, err)
+		assert.Equal(t, "b.db", cfg.GetConfig().(*tenantBulkConfig).Host)
+		assert.Equal(t, []TenantID{"tenant-a", "tenant-b"}, module.registered, "modules are notified in tenant order")
+	})
+
+	t.Run("validation failure registers nothing", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			tenants map[TenantID]map[string]ConfigProvider
+			wantErr error
+		}{
+			{
+				name: "invalid config",
+				tenants: map[TenantID]map[string]ConfigProvider{
+					"tenant-a": tenantBulkConfigs("a.db"),
+					"tenant-b": tenantBulkConfigs(""),
+				},
+				wantErr: errTenantBulkHostMissing,
+			},
+			{
+				name: "nil provider",
+				tenants: map[TenantID]map[string]ConfigProvider{
+					"tenant-a": tenantBulkConfigs("a.db"),
+					"tenant-b": {"database": nil},
+				},
+				wantErr: ErrTenantRegisterNilConfig,
+			},
+			{
+				name: "empty tenant ID",
+				tenants: map[TenantID]map[string]ConfigProvider{
+					"tenant-a": tenantBulkConfigs("a.db"),
+					"":         tenantBulkConfigs("b.db"),
+				},
+				wantErr: ErrTenantIDEmpty,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				ts := NewStandardTenantService(slog.Default())
+				module := &recordingTenantAwareModule{}
+				require.NoError(t, ts.RegisterTenantAwareModule(module))
+				require.NoError(t, ts.RegisterTenant("existing", tenantBulkConfigs("old.db")))
+
+				tc.tenants["existing"] = tenantBulkConfigs("new.db")
+				err := ts.RegisterTenants(tc.tenants)
+				require.ErrorIs(t, err, tc.wantErr)
+
+				assert.Equal(t, []TenantID{"existing"}, ts.GetTenants())
+				cfg, err := ts.GetTenantConfig("existing", "database")
+				require.NoError(t, err)
+				assert.Equal(t, "old.db", cfg.GetConfig().(*tenantBulkConfig).Host, "existing tenants are not updated")
+				assert.Equal(t, []TenantID{"existing"}, module.registered)
+			})
+		}
+	})
+}
+
+func TestStandardTenantService_DeregisterTenant(t *testing.T) {
+	ts := NewStandardTenantService(slog.Default())
+	module := &recordingTenantAwareModule{}
+	require.NoError(t, ts.RegisterTenantAwareModule(module))
+	require.NoError(t, ts.RegisterTenants(map[TenantID]map[string]ConfigProvider{
+		"tenant-a": tenantBulkConfigs("a.db"),
+		"tenant-b": tenantBulkConfigs("b.db"),
+	}))
+	tenantCfg := ts.tenantConfigs["tenant-a"]
+
+	require.NoError(t, ts.DeregisterTenant("tenant-a"))
+
+	assert.Equal(t, []TenantID{"tenant-b"}, ts.GetTenants())
+	_, err := ts.GetTenantConfig("tenant-a", "database")
+	require.ErrorIs(t, err, ErrTenantNotFound)
+	assert.False(t, tenantCfg.HasTenantConfig("tenant-a", "database"), "cached tenant config is released")
+	assert.NotContains(t, ts.moduleNotifications[module], TenantID("tenant-a"))
+	assert.Equal(t, []TenantID{"tenant-a"}, module.removed)
+
+	cfg, err := ts.GetTenantConfig("tenant-b", "database")
+	require.NoError(t, err)
+	assert.Equal(t, "b.db", cfg.GetConfig().(*tenantBulkConfig).Host)
+
+	require.ErrorIs(t, ts.DeregisterTenant("tenant-a"), ErrTenantNotFound)
+
+	// Registering the tenant again notifies modules afresh
+	require.NoError(t, ts.RegisterTenant("tenant-a", tenantBulkConfigs("a2.db")))
+	assert.Equal(t, []TenantID{"tenant-a", "tenant-b", "tenant-a"}, module.registered)
+}